@@ -11,6 +11,7 @@ import (
 
 	"github.com/seka/fish-auction/backend/config"
 	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
 	"github.com/seka/fish-auction/backend/internal/logger"
 	"github.com/seka/fish-auction/backend/internal/usecase/admin"
@@ -64,7 +65,7 @@ func run(cfg *config.InitAdminConfig) error {
 	repo := postgres.NewAdminStore(postgres.NewClient(db))
 	uc := admin.NewCreateAdminUseCase(repo)
 
-	if _, err = uc.Execute(ctx, email, password); err != nil {
+	if _, err = uc.Execute(ctx, email, password, model.AdminRoleSuperAdmin); err != nil {
 		var conflictErr *apperrors.ConflictError
 		if errors.As(err, &conflictErr) {
 			fmt.Printf("Admin user with email %s already exists. Skipping.\n", email)
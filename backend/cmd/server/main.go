@@ -17,6 +17,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/server"
 	adminHandler "github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	buyerHandler "github.com/seka/fish-auction/backend/internal/server/handler/buyer"
+	fishermanHandler "github.com/seka/fish-auction/backend/internal/server/handler/fisherman"
 	publicHandler "github.com/seka/fish-auction/backend/internal/server/handler/public"
 )
 
@@ -28,25 +29,42 @@ var (
 )
 
 type handlers struct {
-	health         *publicHandler.HealthHandler
-	fisherman      *adminHandler.FishermanHandler
-	buyerAuth      *publicHandler.BuyerAuthHandler
-	buyer          *buyerHandler.BuyerHandler
-	adminBuyer     *adminHandler.BuyerHandler
-	publicItem     *publicHandler.ItemHandler
-	adminItem      *adminHandler.ItemHandler
-	bid            *buyerHandler.BidHandler
-	invoice        *adminHandler.InvoiceHandler
-	adminAuth      *publicHandler.AdminAuthHandler
-	publicVenue    *publicHandler.VenueHandler
-	adminVenue     *adminHandler.VenueHandler
-	publicAuction  *publicHandler.AuctionHandler
-	adminAuction   *adminHandler.AuctionHandler
-	admin          *adminHandler.AdminHandler
-	authReset      *publicHandler.AuthResetHandler
-	adminAuthReset *adminHandler.AuthResetHandler
-	push           *buyerHandler.PushHandler
-	adminMe        *adminHandler.MeHandler
+	health                 *publicHandler.HealthHandler
+	fisherman              *adminHandler.FishermanHandler
+	buyerAuth              *publicHandler.BuyerAuthHandler
+	buyer                  *buyerHandler.BuyerHandler
+	adminBuyer             *adminHandler.BuyerHandler
+	publicItem             *publicHandler.ItemHandler
+	adminItem              *adminHandler.ItemHandler
+	bid                    *buyerHandler.BidHandler
+	invoice                *adminHandler.InvoiceHandler
+	adminAuth              *publicHandler.AdminAuthHandler
+	publicVenue            *publicHandler.VenueHandler
+	adminVenue             *adminHandler.VenueHandler
+	publicAuction          *publicHandler.AuctionHandler
+	adminAuction           *adminHandler.AuctionHandler
+	admin                  *adminHandler.AdminHandler
+	authReset              *publicHandler.AuthResetHandler
+	adminAuthReset         *adminHandler.AuthResetHandler
+	push                   *buyerHandler.PushHandler
+	adminMe                *adminHandler.MeHandler
+	adminPayment           *adminHandler.PaymentHandler
+	adminSettlement        *adminHandler.SettlementHandler
+	adminAccounting        *adminHandler.AccountingHandler
+	adminClaim             *adminHandler.ClaimHandler
+	buyerClaim             *buyerHandler.ClaimHandler
+	adminCharge            *adminHandler.ChargeHandler
+	adminLabel             *adminHandler.LabelHandler
+	buyerLabel             *buyerHandler.LabelHandler
+	adminBid               *adminHandler.BidHandler
+	adminResult            *adminHandler.ResultHandler
+	adminVenueRegistration *adminHandler.VenueRegistrationHandler
+	adminSession           *adminHandler.SessionHandler
+	buyerSession           *buyerHandler.SessionHandler
+	fishermanAuth          *publicHandler.FishermanAuthHandler
+	fishermanAuthReset     *fishermanHandler.AuthResetHandler
+	fishermanPortal        *fishermanHandler.FishermanHandler
+	fishermanSettlement    *fishermanHandler.SettlementHandler
 }
 
 func main() {
@@ -107,7 +125,25 @@ func run(cfg *config.AppServerConfig) error {
 		h.adminAuthReset,
 		h.push,
 		h.adminMe,
+		h.adminPayment,
+		h.adminSettlement,
+		h.adminAccounting,
+		h.adminClaim,
+		h.buyerClaim,
+		h.adminCharge,
+		h.adminLabel,
+		h.buyerLabel,
+		h.adminBid,
+		h.adminResult,
+		h.adminVenueRegistration,
+		h.adminSession,
+		h.buyerSession,
+		h.fishermanAuth,
+		h.fishermanAuthReset,
+		h.fishermanPortal,
+		h.fishermanSettlement,
 		sessionRepo,
+		repoReg.NewBuyerSuspensionRepository(),
 		rateLimitRepo,
 		strings.Split(cfg.AllowedOrigins, ","),
 		strings.Split(cfg.TrustedProxies, ","),
@@ -128,24 +164,41 @@ func run(cfg *config.AppServerConfig) error {
 
 func buildHandlers(reg registry.UseCase, sessionRepo domainrepo.SessionRepository, repoReg registry.Repository) *handlers {
 	return &handlers{
-		health:         publicHandler.NewHealthHandler(),
-		fisherman:      adminHandler.NewFishermanHandler(reg),
-		buyerAuth:      publicHandler.NewBuyerAuthHandler(reg, sessionRepo),
-		buyer:          buyerHandler.NewBuyerHandler(reg),
-		adminBuyer:     adminHandler.NewBuyerHandler(reg),
-		publicItem:     publicHandler.NewItemHandler(reg),
-		adminItem:      adminHandler.NewItemHandler(reg),
-		bid:            buyerHandler.NewBidHandler(reg),
-		invoice:        adminHandler.NewInvoiceHandler(reg),
-		adminAuth:      publicHandler.NewAdminAuthHandler(reg, sessionRepo),
-		publicVenue:    publicHandler.NewVenueHandler(reg),
-		adminVenue:     adminHandler.NewVenueHandler(reg),
-		publicAuction:  publicHandler.NewAuctionHandler(reg),
-		adminAuction:   adminHandler.NewAuctionHandler(reg),
-		admin:          adminHandler.NewAdminHandler(reg),
-		authReset:      publicHandler.NewAuthResetHandler(reg),
-		adminAuthReset: adminHandler.NewAuthResetHandler(reg),
-		push:           buyerHandler.NewPushHandler(reg),
-		adminMe:        adminHandler.NewMeHandler(repoReg.NewAdminRepository()),
+		health:                 publicHandler.NewHealthHandler(),
+		fisherman:              adminHandler.NewFishermanHandler(reg),
+		buyerAuth:              publicHandler.NewBuyerAuthHandler(reg, sessionRepo),
+		buyer:                  buyerHandler.NewBuyerHandler(reg),
+		adminBuyer:             adminHandler.NewBuyerHandler(reg),
+		publicItem:             publicHandler.NewItemHandler(reg),
+		adminItem:              adminHandler.NewItemHandler(reg),
+		bid:                    buyerHandler.NewBidHandler(reg),
+		invoice:                adminHandler.NewInvoiceHandler(reg),
+		adminAuth:              publicHandler.NewAdminAuthHandler(reg, sessionRepo),
+		publicVenue:            publicHandler.NewVenueHandler(reg),
+		adminVenue:             adminHandler.NewVenueHandler(reg),
+		publicAuction:          publicHandler.NewAuctionHandler(reg),
+		adminAuction:           adminHandler.NewAuctionHandler(reg),
+		admin:                  adminHandler.NewAdminHandler(reg),
+		authReset:              publicHandler.NewAuthResetHandler(reg),
+		adminAuthReset:         adminHandler.NewAuthResetHandler(reg),
+		push:                   buyerHandler.NewPushHandler(reg),
+		adminMe:                adminHandler.NewMeHandler(repoReg.NewAdminRepository()),
+		adminPayment:           adminHandler.NewPaymentHandler(reg),
+		adminSettlement:        adminHandler.NewSettlementHandler(reg),
+		adminAccounting:        adminHandler.NewAccountingHandler(reg),
+		adminClaim:             adminHandler.NewClaimHandler(reg),
+		buyerClaim:             buyerHandler.NewClaimHandler(reg),
+		adminCharge:            adminHandler.NewChargeHandler(reg),
+		adminLabel:             adminHandler.NewLabelHandler(reg),
+		buyerLabel:             buyerHandler.NewLabelHandler(reg),
+		adminBid:               adminHandler.NewBidHandler(reg),
+		adminResult:            adminHandler.NewResultHandler(reg),
+		adminVenueRegistration: adminHandler.NewVenueRegistrationHandler(reg),
+		adminSession:           adminHandler.NewSessionHandler(reg),
+		buyerSession:           buyerHandler.NewSessionHandler(reg),
+		fishermanAuth:          publicHandler.NewFishermanAuthHandler(reg, sessionRepo),
+		fishermanAuthReset:     fishermanHandler.NewAuthResetHandler(reg),
+		fishermanPortal:        fishermanHandler.NewFishermanHandler(reg),
+		fishermanSettlement:    fishermanHandler.NewSettlementHandler(reg),
 	}
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/server"
 	adminHandler "github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	buyerHandler "github.com/seka/fish-auction/backend/internal/server/handler/buyer"
+	fishermanHandler "github.com/seka/fish-auction/backend/internal/server/handler/fisherman"
 	publicHandler "github.com/seka/fish-auction/backend/internal/server/handler/public"
 	"github.com/seka/fish-auction/backend/internal/worker"
 	"github.com/seka/fish-auction/backend/internal/worker/handler"
@@ -103,7 +104,7 @@ func TestServerIntegration(t *testing.T) {
 
 	buyerEmailSvc := serviceReg.NewBuyerEmailService()
 	adminEmailSvc := serviceReg.NewAdminEmailService()
-	emailHandlerSvc := handler.NewEmailHandler(buyerEmailSvc, adminEmailSvc)
+	emailHandlerSvc := handler.NewEmailHandler(buyerEmailSvc, adminEmailSvc, serviceReg.NewFishermanEmailService())
 
 	w := worker.NewWorker(
 		queue,
@@ -122,7 +123,7 @@ func TestServerIntegration(t *testing.T) {
 
 	// 3. Handlers を初期化
 	healthHandler := publicHandler.NewHealthHandler()
-	fishermanHandler := adminHandler.NewFishermanHandler(useCaseReg)
+	adminFishermanHandler := adminHandler.NewFishermanHandler(useCaseReg)
 	sessionRepo := repoReg.NewSessionRepository()
 	buyerAuthHandler := publicHandler.NewBuyerAuthHandler(useCaseReg, sessionRepo)
 	buyerAccountHandler := buyerHandler.NewBuyerHandler(useCaseReg)
@@ -141,12 +142,29 @@ func TestServerIntegration(t *testing.T) {
 	adminAuthResetHandler := adminHandler.NewAuthResetHandler(useCaseReg)
 	pushHandler := buyerHandler.NewPushHandler(useCaseReg)
 	adminMeHandler := adminHandler.NewMeHandler(repoReg.NewAdminRepository())
+	adminPayment := adminHandler.NewPaymentHandler(useCaseReg)
+	adminSettlement := adminHandler.NewSettlementHandler(useCaseReg)
+	adminAccounting := adminHandler.NewAccountingHandler(useCaseReg)
+	adminClaim := adminHandler.NewClaimHandler(useCaseReg)
+	buyerClaim := buyerHandler.NewClaimHandler(useCaseReg)
+	adminCharge := adminHandler.NewChargeHandler(useCaseReg)
+	adminLabel := adminHandler.NewLabelHandler(useCaseReg)
+	buyerLabel := buyerHandler.NewLabelHandler(useCaseReg)
+	adminBid := adminHandler.NewBidHandler(useCaseReg)
+	adminResult := adminHandler.NewResultHandler(useCaseReg)
+	adminVenueRegistration := adminHandler.NewVenueRegistrationHandler(useCaseReg)
+	adminSession := adminHandler.NewSessionHandler(useCaseReg)
+	buyerSession := buyerHandler.NewSessionHandler(useCaseReg)
+	fishermanAuthHandler := publicHandler.NewFishermanAuthHandler(useCaseReg, sessionRepo)
+	fishermanAuthResetHandler := fishermanHandler.NewAuthResetHandler(useCaseReg)
+	fishermanPortal := fishermanHandler.NewFishermanHandler(useCaseReg)
+	fishermanSettlement := fishermanHandler.NewSettlementHandler(useCaseReg)
 	rateLimitRepo := repoReg.NewRateLimitRepository()
 
 	// 4. Server を起動
 	srv := server.NewServer(
 		healthHandler,
-		fishermanHandler,
+		adminFishermanHandler,
 		buyerAuthHandler,
 		buyerAccountHandler,
 		adminBuyerHandler,
@@ -164,7 +182,25 @@ func TestServerIntegration(t *testing.T) {
 		adminAuthResetHandler,
 		pushHandler,
 		adminMeHandler,
+		adminPayment,
+		adminSettlement,
+		adminAccounting,
+		adminClaim,
+		buyerClaim,
+		adminCharge,
+		adminLabel,
+		buyerLabel,
+		adminBid,
+		adminResult,
+		adminVenueRegistration,
+		adminSession,
+		buyerSession,
+		fishermanAuthHandler,
+		fishermanAuthResetHandler,
+		fishermanPortal,
+		fishermanSettlement,
 		sessionRepo,
+		repoReg.NewBuyerSuspensionRepository(),
 		rateLimitRepo,
 		[]string{"https://localhost", "http://localhost:3000"},
 		nil,
@@ -609,7 +645,7 @@ func verifyBid(t *testing.T, client *http.Client, urlStr string, itemID, expecte
 }
 
 func seedAdmin(t *testing.T, useCaseReg registry.UseCase, email, password string) {
-	_, err := useCaseReg.NewCreateAdminUseCase().Execute(context.Background(), email, password)
+	_, err := useCaseReg.NewCreateAdminUseCase().Execute(context.Background(), email, password, model.AdminRoleSuperAdmin)
 	if err != nil {
 		t.Fatalf("Failed to seed admin: %v", err)
 	}
@@ -668,7 +704,7 @@ func (w *wrappedServiceRegistry) NewAdminEmailService() service.AdminEmailServic
 	return w.adminEmailSvc
 }
 
-// emailCall captures a SendXxxPasswordReset invocation for assertion.
+// emailCall captures a SendXxx invocation for assertion.
 type emailCall struct {
 	to       string
 	resetURL string
@@ -686,6 +722,41 @@ func (m *mockBuyerEmailService) SendBuyerPasswordReset(_ context.Context, to, ur
 	return nil
 }
 
+func (m *mockBuyerEmailService) SendBuyerEmailChangeVerification(_ context.Context, to, url string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, emailCall{to: to, resetURL: url})
+	return nil
+}
+
+func (m *mockBuyerEmailService) SendBuyerEmailChanged(_ context.Context, to string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, emailCall{to: to})
+	return nil
+}
+
+func (m *mockBuyerEmailService) SendBuyerRegistrationVerification(_ context.Context, to, url string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, emailCall{to: to, resetURL: url})
+	return nil
+}
+
+func (m *mockBuyerEmailService) SendBuyerRegistrationApproved(_ context.Context, to, url string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, emailCall{to: to, resetURL: url})
+	return nil
+}
+
+func (m *mockBuyerEmailService) SendBuyerRegistrationRejected(_ context.Context, to, _ string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, emailCall{to: to})
+	return nil
+}
+
 func (m *mockBuyerEmailService) getCalls() []emailCall {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -54,7 +54,8 @@ func run(cfg *config.WorkerConfig) error {
 
 	buyerEmailSvc := serviceReg.NewBuyerEmailService()
 	adminEmailSvc := serviceReg.NewAdminEmailService()
-	emailHandler := handler.NewEmailHandler(buyerEmailSvc, adminEmailSvc)
+	fishermanEmailSvc := serviceReg.NewFishermanEmailService()
+	emailHandler := handler.NewEmailHandler(buyerEmailSvc, adminEmailSvc, fishermanEmailSvc)
 
 	queue := serviceReg.NewJobQueue()
 	w := worker.NewWorker(
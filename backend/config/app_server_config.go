@@ -34,8 +34,28 @@ type AppServerConfig struct {
 	WriteTimeout     time.Duration
 	IdleTimeout      time.Duration
 	FrontendURL      *url.URL
+	LabelSigningKey  string
+	LabelCodeTTL     time.Duration
+	// TOTPEncryptionKey は管理者の TOTP シークレットを DB に保存する際の暗号化鍵。
+	TOTPEncryptionKey string
+	// VenueRegistrationRequiredToView は、せりの品目や入札履歴の閲覧にも会場の登録を求めるかどうか。
+	// 対象は GET /api/auctions/{id}/items、GET /api/items（見られないせりの品目は除く）、
+	// GET /api/auctions/{id}/items/{itemId}/bids、GET /api/buyer/labels/scan。せり・会場の一覧は公開のまま。
+	VenueRegistrationRequiredToView bool
 }
 
+// developmentLabelSigningKey は開発環境用の既定値。production では Validate で拒否する。
+const developmentLabelSigningKey = "development-only-label-signing-key"
+
+// labelSigningKeyMinLen は HMAC-SHA256 の鍵として十分な長さ（32 バイト）。
+const labelSigningKeyMinLen = 32
+
+// developmentTOTPEncryptionKey は開発環境用の既定値。production では Validate で拒否する。
+const developmentTOTPEncryptionKey = "development-only-totp-encryption-key"
+
+// totpEncryptionKeyMinLen は AES-256 の鍵を導出する元として十分な長さ（32 バイト）。
+const totpEncryptionKeyMinLen = 32
+
 // NewAppServerConfig は API サーバ用の設定を環境変数からロードする。
 //
 // 本関数は値の妥当性を検証しない。FRONTEND_URL のパースに失敗した場合は
@@ -71,6 +91,12 @@ func NewAppServerConfig() *AppServerConfig {
 		WriteTimeout:     time.Duration(GetEnvInt("SERVER_WRITE_TIMEOUT_SEC", 60)) * time.Second,
 		IdleTimeout:      time.Duration(GetEnvInt("SERVER_IDLE_TIMEOUT_SEC", 60)) * time.Second,
 		FrontendURL:      frontendURL,
+		LabelSigningKey:  GetEnv("LABEL_SIGNING_KEY", developmentLabelSigningKey),
+		LabelCodeTTL:     time.Duration(GetEnvInt("LABEL_CODE_TTL_HOURS", 72)) * time.Hour,
+
+		TOTPEncryptionKey: GetEnv("TOTP_ENCRYPTION_KEY", developmentTOTPEncryptionKey),
+
+		VenueRegistrationRequiredToView: GetEnvBool("VENUE_REGISTRATION_REQUIRED_TO_VIEW", false),
 	}
 }
 
@@ -91,6 +117,15 @@ func (c *AppServerConfig) Validate() error {
 	if err := validateSSLMode(c.AppEnv, c.PostgresSslMode); err != nil {
 		return err
 	}
+	if c.AppEnv == "production" && (c.LabelSigningKey == developmentLabelSigningKey || len(c.LabelSigningKey) < labelSigningKeyMinLen) {
+		return fmt.Errorf("LABEL_SIGNING_KEY must be set to at least %d bytes in production", labelSigningKeyMinLen)
+	}
+	if c.AppEnv == "production" && (c.TOTPEncryptionKey == developmentTOTPEncryptionKey || len(c.TOTPEncryptionKey) < totpEncryptionKeyMinLen) {
+		return fmt.Errorf("TOTP_ENCRYPTION_KEY must be set to at least %d bytes in production", totpEncryptionKeyMinLen)
+	}
+	if c.LabelCodeTTL <= 0 {
+		return errors.New("invalid LABEL_CODE_TTL_HOURS: must be positive")
+	}
 	return nil
 }
 
@@ -130,3 +165,19 @@ func (c *AppServerConfig) DBConnectionURL() string {
 func (c *AppServerConfig) GetFrontendURL() *url.URL {
 	return c.FrontendURL
 }
+
+func (c *AppServerConfig) GetLabelSigningKey() []byte {
+	return []byte(c.LabelSigningKey)
+}
+
+func (c *AppServerConfig) GetLabelCodeTTL() time.Duration {
+	return c.LabelCodeTTL
+}
+
+func (c *AppServerConfig) GetTOTPEncryptionKey() []byte {
+	return []byte(c.TOTPEncryptionKey)
+}
+
+func (c *AppServerConfig) GetVenueRegistrationRequiredToView() bool {
+	return c.VenueRegistrationRequiredToView
+}
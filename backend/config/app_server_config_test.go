@@ -126,6 +126,43 @@ func TestAppServerConfig_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Development LABEL_SIGNING_KEY rejected in production",
+			env: map[string]string{
+				"APP_ENV":          "production",
+				"POSTGRES_SSLMODE": "require",
+			},
+			wantErr:     true,
+			errContains: "LABEL_SIGNING_KEY",
+		},
+		{
+			name: "Development TOTP_ENCRYPTION_KEY rejected in production",
+			env: map[string]string{
+				"APP_ENV":           "production",
+				"POSTGRES_SSLMODE":  "require",
+				"LABEL_SIGNING_KEY": "0123456789abcdef0123456789abcdef",
+			},
+			wantErr:     true,
+			errContains: "TOTP_ENCRYPTION_KEY",
+		},
+		{
+			name: "Keys set in production",
+			env: map[string]string{
+				"APP_ENV":             "production",
+				"POSTGRES_SSLMODE":    "require",
+				"LABEL_SIGNING_KEY":   "0123456789abcdef0123456789abcdef",
+				"TOTP_ENCRYPTION_KEY": "fedcba9876543210fedcba9876543210",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid LABEL_CODE_TTL_HOURS",
+			env: map[string]string{
+				"LABEL_CODE_TTL_HOURS": "0",
+			},
+			wantErr:     true,
+			errContains: "LABEL_CODE_TTL_HOURS",
+		},
 		{
 			name: "Invalid TRUSTED_PROXIES CIDR",
 			env: map[string]string{
@@ -94,6 +94,31 @@ func (n noFrontendConfig) GetFrontendURL() *url.URL { return nil }
 // NoFrontendConfig can be used when a process doesn't need to know the frontend URL.
 var NoFrontendConfig FrontendConfig = noFrontendConfig{}
 
+// LabelConfig holds the key and lifetime of the signed codes printed on lot labels.
+type LabelConfig interface {
+	GetLabelSigningKey() []byte
+	GetLabelCodeTTL() time.Duration
+}
+
+// TOTPConfig holds the key used to encrypt admin TOTP secrets at rest.
+type TOTPConfig interface {
+	GetTOTPEncryptionKey() []byte
+}
+
+// VenueRegistrationConfig controls how strictly buyer–venue registrations are enforced.
+// Bidding always requires an approved registration; viewing an auction's lots does only when this is on.
+type VenueRegistrationConfig interface {
+	GetVenueRegistrationRequiredToView() bool
+}
+
+// UseCaseConfig is the configuration the use case registry depends on.
+type UseCaseConfig interface {
+	FrontendConfig
+	LabelConfig
+	TOTPConfig
+	VenueRegistrationConfig
+}
+
 // noQueueConfig is a null implementation for processes that don't need a queue.
 type noQueueConfig struct{}
 
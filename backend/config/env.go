@@ -56,3 +56,17 @@ func GetEnvInt(key string, defaultValue int) int {
 	}
 	return value
 }
+
+// GetEnvBool retrieves the value of the environment variable named by the key as a boolean.
+func GetEnvBool(key string, defaultValue bool) bool {
+	valueStr := GetEnv(key, "")
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		slog.Warn("invalid env value, using default", "key", key, "value", valueStr, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
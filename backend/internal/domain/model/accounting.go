@@ -0,0 +1,88 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// accountNameMaxLen は弥生会計の勘定科目名の上限（24 文字）に合わせる。
+const accountNameMaxLen = 24
+
+// AccountingSettings holds the account titles (勘定科目) a venue uses when its
+// invoices, payments and settlements are exported as journal entries.
+type AccountingSettings struct {
+	VenueID           int
+	SalesAccount      string
+	ReceivableAccount string
+	CashAccount       string
+	DepositAccount    string
+	PurchaseAccount   string
+	PayableAccount    string
+	CommissionAccount string
+	UpdatedAt         time.Time
+}
+
+// DefaultAccountingSettings returns the account titles used for a venue that has not configured its own.
+func DefaultAccountingSettings(venueID int) *AccountingSettings {
+	return &AccountingSettings{
+		VenueID:           venueID,
+		SalesAccount:      "売上高",
+		ReceivableAccount: "売掛金",
+		CashAccount:       "現金",
+		DepositAccount:    "普通預金",
+		PurchaseAccount:   "仕入高",
+		PayableAccount:    "買掛金",
+		CommissionAccount: "受取手数料",
+	}
+}
+
+// Normalize trims surrounding whitespace from every account title.
+func (s *AccountingSettings) Normalize() {
+	for _, f := range s.fields() {
+		*f.value = strings.TrimSpace(*f.value)
+	}
+}
+
+// Validate checks that every account title is set and fits the accounting software limits.
+func (s *AccountingSettings) Validate() error {
+	for _, f := range s.fields() {
+		if *f.value == "" {
+			return &domainErrors.ValidationError{Field: f.name, Message: "account is required"}
+		}
+		if utf8.RuneCountInString(*f.value) > accountNameMaxLen {
+			return &domainErrors.ValidationError{Field: f.name, Message: "account must be at most 24 characters"}
+		}
+		if strings.ContainsAny(*f.value, ",\"\r\n") {
+			return &domainErrors.ValidationError{Field: f.name, Message: "account must not contain commas, quotes or line breaks"}
+		}
+	}
+	return nil
+}
+
+type accountingField struct {
+	name  string
+	value *string
+}
+
+func (s *AccountingSettings) fields() []accountingField {
+	return []accountingField{
+		{"sales_account", &s.SalesAccount},
+		{"receivable_account", &s.ReceivableAccount},
+		{"cash_account", &s.CashAccount},
+		{"deposit_account", &s.DepositAccount},
+		{"purchase_account", &s.PurchaseAccount},
+		{"payable_account", &s.PayableAccount},
+		{"commission_account", &s.CommissionAccount},
+	}
+}
+
+// PaymentAccount returns the account that receives a payment made with the given method.
+func (s *AccountingSettings) PaymentAccount(method PaymentMethod) string {
+	if method == PaymentMethodCash {
+		return s.CashAccount
+	}
+	return s.DepositAccount
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+func TestAccountingSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		modify    func(s *AccountingSettings)
+		wantField string
+	}{
+		{name: "Defaults", modify: func(*AccountingSettings) {}},
+		{name: "EmptySales", modify: func(s *AccountingSettings) { s.SalesAccount = "" }, wantField: "sales_account"},
+		{name: "TooLong", modify: func(s *AccountingSettings) { s.CashAccount = strings.Repeat("現", 25) }, wantField: "cash_account"},
+		{name: "Comma", modify: func(s *AccountingSettings) { s.PayableAccount = "買掛金,漁業者" }, wantField: "payable_account"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := DefaultAccountingSettings(1)
+			tt.modify(s)
+			err := s.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var vErr *domainErrors.ValidationError
+			require.ErrorAs(t, err, &vErr)
+			assert.Equal(t, tt.wantField, vErr.Field)
+		})
+	}
+}
+
+func TestAccountingSettings_Normalize(t *testing.T) {
+	s := DefaultAccountingSettings(1)
+	s.SalesAccount = "  売上高 "
+	s.Normalize()
+	assert.Equal(t, "売上高", s.SalesAccount)
+}
+
+func TestAccountingSettings_PaymentAccount(t *testing.T) {
+	s := DefaultAccountingSettings(1)
+	assert.Equal(t, "現金", s.PaymentAccount(PaymentMethodCash))
+	assert.Equal(t, "普通預金", s.PaymentAccount(PaymentMethodBankTransfer))
+}
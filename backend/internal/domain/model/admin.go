@@ -7,7 +7,17 @@ type Admin struct {
 	ID             int
 	Email          string
 	PasswordHash   string
+	Role           AdminRole
 	FailedAttempts int
 	LockedUntil    *time.Time
-	CreatedAt      time.Time
+	// DisabledAt is set when a super admin disables the account, for example when a clerk leaves.
+	DisabledAt *time.Time
+	// PasswordResetRequired blocks login until the admin sets a new password through a reset link.
+	PasswordResetRequired bool
+	CreatedAt             time.Time
+}
+
+// IsLocked reports whether the account is locked out by failed login attempts at now.
+func (a *Admin) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && now.Before(*a.LockedUntil)
 }
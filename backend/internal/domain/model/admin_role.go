@@ -0,0 +1,90 @@
+package model
+
+import (
+	"slices"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// AdminRole is the role of an admin, which decides what the admin may do.
+type AdminRole string
+
+const (
+	// AdminRoleSuperAdmin can do everything, including managing venues and other admins.
+	AdminRoleSuperAdmin AdminRole = "super_admin"
+	// AdminRoleClerk runs the auctions: lots, bids, results, buyers and fishermen.
+	AdminRoleClerk AdminRole = "clerk"
+	// AdminRoleAccountant handles invoices, payments and settlements, and can view the auctions.
+	AdminRoleAccountant AdminRole = "accountant"
+	// AdminRoleReadOnly can view the auctions and the accounts but change nothing.
+	AdminRoleReadOnly AdminRole = "read_only"
+)
+
+// AdminPermission is an action on one area of the admin console.
+type AdminPermission string
+
+const (
+	// AdminPermissionViewOperations allows viewing auctions, lots, bids, buyers and fishermen.
+	AdminPermissionViewOperations AdminPermission = "operations:view"
+	// AdminPermissionManageOperations allows running auctions and managing buyers and fishermen.
+	AdminPermissionManageOperations AdminPermission = "operations:manage"
+	// AdminPermissionViewAccounting allows viewing invoices, credit, settlements and the journal.
+	AdminPermissionViewAccounting AdminPermission = "accounting:view"
+	// AdminPermissionManageAccounting allows issuing invoices, recording payments and settling with fishermen.
+	AdminPermissionManageAccounting AdminPermission = "accounting:manage"
+	// AdminPermissionManageVenues allows creating, changing and deleting venues.
+	AdminPermissionManageVenues AdminPermission = "venues:manage"
+	// AdminPermissionManageAdmins allows creating admins and changing their roles.
+	AdminPermissionManageAdmins AdminPermission = "admins:manage"
+)
+
+// adminRolePermissions lists the permissions of each role. The super admin has every permission.
+var adminRolePermissions = map[AdminRole][]AdminPermission{
+	AdminRoleClerk: {
+		AdminPermissionViewOperations,
+		AdminPermissionManageOperations,
+	},
+	AdminRoleAccountant: {
+		AdminPermissionViewOperations,
+		AdminPermissionViewAccounting,
+		AdminPermissionManageAccounting,
+	},
+	AdminRoleReadOnly: {
+		AdminPermissionViewOperations,
+		AdminPermissionViewAccounting,
+	},
+}
+
+// IsValid reports whether the role is supported.
+func (r AdminRole) IsValid() bool {
+	switch r {
+	case AdminRoleSuperAdmin, AdminRoleClerk, AdminRoleAccountant, AdminRoleReadOnly:
+		return true
+	}
+	return false
+}
+
+// Has reports whether the role grants the permission.
+func (r AdminRole) Has(permission AdminPermission) bool {
+	if r == AdminRoleSuperAdmin {
+		return true
+	}
+	return slices.Contains(adminRolePermissions[r], permission)
+}
+
+// CheckPermission rejects an action the role does not grant.
+func (r AdminRole) CheckPermission(permission AdminPermission) error {
+	if !r.Has(permission) {
+		return &domainErrors.ForbiddenError{Message: "You do not have permission to do this"}
+	}
+	return nil
+}
+
+// ParseAdminRole validates a role given by an admin.
+func ParseAdminRole(s string) (AdminRole, error) {
+	r := AdminRole(s)
+	if !r.IsValid() {
+		return "", &domainErrors.ValidationError{Field: "role", Message: "must be super_admin, clerk, accountant or read_only"}
+	}
+	return r, nil
+}
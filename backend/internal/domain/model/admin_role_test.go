@@ -0,0 +1,57 @@
+package model
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+func TestAdminRole_Has(t *testing.T) {
+	tests := []struct {
+		role    AdminRole
+		allowed []AdminPermission
+	}{
+		{
+			role: AdminRoleSuperAdmin,
+			allowed: []AdminPermission{
+				AdminPermissionViewOperations, AdminPermissionManageOperations, AdminPermissionViewAccounting,
+				AdminPermissionManageAccounting, AdminPermissionManageVenues, AdminPermissionManageAdmins,
+			},
+		},
+		{role: AdminRoleClerk, allowed: []AdminPermission{AdminPermissionViewOperations, AdminPermissionManageOperations}},
+		{role: AdminRoleAccountant, allowed: []AdminPermission{AdminPermissionViewOperations, AdminPermissionViewAccounting, AdminPermissionManageAccounting}},
+		{role: AdminRoleReadOnly, allowed: []AdminPermission{AdminPermissionViewOperations, AdminPermissionViewAccounting}},
+		{role: AdminRole("")},
+	}
+	all := []AdminPermission{
+		AdminPermissionViewOperations, AdminPermissionManageOperations, AdminPermissionViewAccounting,
+		AdminPermissionManageAccounting, AdminPermissionManageVenues, AdminPermissionManageAdmins,
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			for _, p := range all {
+				assert.Equal(t, slices.Contains(tt.allowed, p), tt.role.Has(p), "permission %s", p)
+			}
+		})
+	}
+}
+
+func TestAdminRole_CheckPermission(t *testing.T) {
+	var fErr *domainErrors.ForbiddenError
+	assert.NoError(t, AdminRoleAccountant.CheckPermission(AdminPermissionManageAccounting))
+	assert.ErrorAs(t, AdminRoleReadOnly.CheckPermission(AdminPermissionManageOperations), &fErr)
+}
+
+func TestParseAdminRole(t *testing.T) {
+	r, err := ParseAdminRole("clerk")
+	assert.NoError(t, err)
+	assert.Equal(t, AdminRoleClerk, r)
+
+	var vErr *domainErrors.ValidationError
+	_, err = ParseAdminRole("owner")
+	assert.ErrorAs(t, err, &vErr)
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RecoveryCodeCount is the number of recovery codes issued when two-factor authentication is enabled.
+const RecoveryCodeCount = 10
+
+// recoveryCodeEncoding は読み間違えにくいよう小文字の base32 を使う。
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// AdminTwoFactor is an admin's TOTP enrollment.
+// It is pending until the admin proves the authenticator app works by entering a code.
+type AdminTwoFactor struct {
+	AdminID int
+	// SecretEncrypted is the TOTP secret sealed by SealTOTPSecret.
+	SecretEncrypted string
+	// EnabledAt is nil while the enrollment is pending.
+	EnabledAt *time.Time
+	// LastUsedStep is the time step of the last accepted code, used to reject replays.
+	LastUsedStep int64
+	CreatedAt    time.Time
+}
+
+// IsEnabled reports whether the enrollment has been confirmed and is required at login.
+func (t *AdminTwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
+
+// GenerateRecoveryCodes returns n new one-time recovery codes in the "xxxxx-xxxxx" form shown to the admin.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		buf := make([]byte, 7)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		code := recoveryCodeEncoding.EncodeToString(buf)[:10]
+		codes[i] = code[:5] + "-" + code[5:]
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode returns the stored form of a recovery code.
+// Case, spaces and hyphens are ignored so that codes typed from a printout still match.
+func HashRecoveryCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -63,3 +63,9 @@ func (a *Auction) ShouldBeCompleted(now time.Time) bool {
 
 	return false
 }
+
+// BidsVisible reports whether buyers may see the bid history of the auction's lots.
+// せりが始まるまでは入札がなく、中止されたせりの経過は見せない。
+func (a *Auction) BidsVisible() bool {
+	return a.Status == AuctionStatusInProgress || a.Status == AuctionStatusCompleted
+}
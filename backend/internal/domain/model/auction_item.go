@@ -3,17 +3,35 @@ package model
 import "time"
 
 // AuctionItem provides AuctionItem related functionality.
+// 最高値の入札者は買参権番号（HighestBidderPaddle）で表し、買受人の名前は持たない。
 type AuctionItem struct {
-	ID                int
-	AuctionID         int
-	FishermanID       int
-	FishType          string
-	Quantity          int
-	Unit              string
-	HighestBid        *BidPrice
-	HighestBidderID   *int
-	HighestBidderName *string
-	SortOrder         int
-	CreatedAt         time.Time
-	DeletedAt         *time.Time
+	ID                  int
+	AuctionID           int
+	FishermanID         int
+	FishType            string
+	Quantity            int
+	Unit                string
+	HighestBid          *BidPrice
+	HighestBidderID     *int
+	HighestBidderPaddle *string
+	SortOrder           int
+	CreatedAt           time.Time
+	DeletedAt           *time.Time
+}
+
+// HighestBidderFor returns how the current high bidder is shown to the buyer viewerID.
+func (i *AuctionItem) HighestBidderFor(viewerID int) *string {
+	if i.HighestBidderID == nil {
+		return nil
+	}
+	return DisplayBidder(*i.HighestBidderID, i.HighestBidderPaddle, viewerID)
+}
+
+// MinimumNextBid returns the lowest price the next bid on the item must reach.
+func (i *AuctionItem) MinimumNextBid() BidPrice {
+	current := NewBidPrice(0)
+	if i.HighestBid != nil {
+		current = *i.HighestBid
+	}
+	return current.Add(current.CalculateMinIncrement())
 }
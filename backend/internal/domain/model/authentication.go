@@ -3,12 +3,17 @@ package model
 import "time"
 
 // Authentication provides Authentication related functionality.
+// Each authentication is one login of a buyer organization: the owner, or a staff member added by the owner.
 type Authentication struct {
 	ID             int
 	BuyerID        int
 	Email          string
 	PasswordHash   string
 	AuthType       string
+	Name           string
+	Role           BuyerLoginRole
+	BidLimit       *int
+	DisabledAt     *time.Time
 	FailedAttempts int
 	LockedUntil    *time.Time
 	LastLoginAt    *time.Time
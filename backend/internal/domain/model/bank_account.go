@@ -0,0 +1,100 @@
+package model
+
+import (
+	"strings"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// BankAccountType is the 預金種目 code used by the Zengin (全銀協) format.
+type BankAccountType int
+
+const (
+	BankAccountTypeOrdinary BankAccountType = 1 // 普通
+	BankAccountTypeCurrent  BankAccountType = 2 // 当座
+	BankAccountTypeSavings  BankAccountType = 4 // 貯蓄
+)
+
+// IsValid reports whether the account type is a supported 預金種目.
+func (t BankAccountType) IsValid() bool {
+	switch t {
+	case BankAccountTypeOrdinary, BankAccountTypeCurrent, BankAccountTypeSavings:
+		return true
+	}
+	return false
+}
+
+const (
+	bankCodeLength          = 4
+	branchCodeLength        = 3
+	accountNumberMaxLength  = 7
+	accountHolderKanaMaxLen = 30
+)
+
+// BankAccount represents a domestic bank account that can receive Zengin transfers.
+// HolderKana is kept in half-width katakana so it can be written to the transfer file as is.
+type BankAccount struct {
+	BankCode      string
+	BranchCode    string
+	AccountType   BankAccountType
+	AccountNumber string
+	HolderKana    string
+}
+
+// NewBankAccount normalizes and validates bank account details.
+// 口座名義は全角カナ・ひらがなでも受け付け、全銀協で使える半角カナに変換して保持する。
+func NewBankAccount(bankCode, branchCode string, accountType BankAccountType, accountNumber, holderName string) (*BankAccount, error) {
+	a := &BankAccount{
+		BankCode:      strings.TrimSpace(bankCode),
+		BranchCode:    strings.TrimSpace(branchCode),
+		AccountType:   accountType,
+		AccountNumber: strings.TrimSpace(accountNumber),
+	}
+
+	kana, ok := ToZenginKana(strings.TrimSpace(holderName))
+	if !ok {
+		return nil, &domainErrors.ValidationError{Field: "account_holder_kana", Message: "must contain only katakana, alphanumerics and ( ) - . / , characters"}
+	}
+	a.HolderKana = kana
+
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Validate checks that the account can be written to a Zengin transfer record.
+func (a *BankAccount) Validate() error {
+	if len(a.BankCode) != bankCodeLength || !isDigits(a.BankCode) {
+		return &domainErrors.ValidationError{Field: "bank_code", Message: "must be 4 digits"}
+	}
+	if len(a.BranchCode) != branchCodeLength || !isDigits(a.BranchCode) {
+		return &domainErrors.ValidationError{Field: "branch_code", Message: "must be 3 digits"}
+	}
+	if !a.AccountType.IsValid() {
+		return &domainErrors.ValidationError{Field: "account_type", Message: "must be 1 (ordinary), 2 (current) or 4 (savings)"}
+	}
+	if a.AccountNumber == "" || len(a.AccountNumber) > accountNumberMaxLength || !isDigits(a.AccountNumber) {
+		return &domainErrors.ValidationError{Field: "account_number", Message: "must be up to 7 digits"}
+	}
+	n := len([]rune(a.HolderKana))
+	if n == 0 {
+		return &domainErrors.ValidationError{Field: "account_holder_kana", Message: "cannot be empty"}
+	}
+	if n > accountHolderKanaMaxLen {
+		return &domainErrors.ValidationError{Field: "account_holder_kana", Message: "must be at most 30 characters"}
+	}
+	if !IsZenginKana(a.HolderKana) {
+		return &domainErrors.ValidationError{Field: "account_holder_kana", Message: "contains characters not allowed in Zengin transfers"}
+	}
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
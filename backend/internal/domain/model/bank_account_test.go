@@ -0,0 +1,47 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+func TestNewBankAccount(t *testing.T) {
+	tests := []struct {
+		name        string
+		bankCode    string
+		branchCode  string
+		accountType BankAccountType
+		number      string
+		holder      string
+		wantKana    string
+		wantField   string
+	}{
+		{name: "Valid", bankCode: "0001", branchCode: "100", accountType: BankAccountTypeOrdinary, number: "1234567", holder: "ヤマダ タロウ", wantKana: "ﾔﾏﾀﾞ ﾀﾛｳ"},
+		{name: "ShortAccountNumber", bankCode: "0001", branchCode: "100", accountType: BankAccountTypeSavings, number: "42", holder: "ﾔﾏﾀﾞ", wantKana: "ﾔﾏﾀﾞ"},
+		{name: "BankCodeNotDigits", bankCode: "00A1", branchCode: "100", accountType: BankAccountTypeOrdinary, number: "1", holder: "ﾔﾏﾀﾞ", wantField: "bank_code"},
+		{name: "BranchCodeLength", bankCode: "0001", branchCode: "10", accountType: BankAccountTypeOrdinary, number: "1", holder: "ﾔﾏﾀﾞ", wantField: "branch_code"},
+		{name: "AccountType", bankCode: "0001", branchCode: "100", accountType: 3, number: "1", holder: "ﾔﾏﾀﾞ", wantField: "account_type"},
+		{name: "AccountNumberTooLong", bankCode: "0001", branchCode: "100", accountType: BankAccountTypeOrdinary, number: "12345678", holder: "ﾔﾏﾀﾞ", wantField: "account_number"},
+		{name: "HolderKanji", bankCode: "0001", branchCode: "100", accountType: BankAccountTypeOrdinary, number: "1", holder: "山田太郎", wantField: "account_holder_kana"},
+		{name: "HolderEmpty", bankCode: "0001", branchCode: "100", accountType: BankAccountTypeOrdinary, number: "1", holder: " ", wantField: "account_holder_kana"},
+		{name: "HolderTooLong", bankCode: "0001", branchCode: "100", accountType: BankAccountTypeOrdinary, number: "1", holder: "ｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱｱ", wantField: "account_holder_kana"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewBankAccount(tt.bankCode, tt.branchCode, tt.accountType, tt.number, tt.holder)
+			if tt.wantField != "" {
+				var vErr *domainErrors.ValidationError
+				require.ErrorAs(t, err, &vErr)
+				assert.Equal(t, tt.wantField, vErr.Field)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantKana, got.HolderKana)
+		})
+	}
+}
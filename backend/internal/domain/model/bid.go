@@ -1,14 +1,77 @@
 package model
 
 import (
+	"strings"
 	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 )
 
+// BidChannel is the route through which a bid was entered.
+type BidChannel string
+
+const (
+	// BidChannelOnline is a bid placed by the buyer from their own session.
+	BidChannelOnline BidChannel = "online"
+	// BidChannelFloor is a bid made on the auction floor (e.g. by hand sign) and entered by a clerk.
+	BidChannelFloor BidChannel = "floor"
+)
+
+// IsValid reports whether the channel is supported.
+func (c BidChannel) IsValid() bool {
+	switch c {
+	case BidChannelOnline, BidChannelFloor:
+		return true
+	}
+	return false
+}
+
 // Bid provides Bid related functionality.
+// A voided bid is kept for the audit history but no longer counts towards the highest bid or the award.
+// BuyerID is the bidding organization and LoginID the individual login that placed an online bid.
 type Bid struct {
-	ID        int
-	ItemID    int
-	BuyerID   int
-	Price     BidPrice
-	CreatedAt time.Time
+	ID         int
+	ItemID     int
+	BuyerID    int
+	LoginID    *int
+	Price      BidPrice
+	Channel    BidChannel
+	EnteredBy  *int
+	CreatedAt  time.Time
+	VoidedAt   *time.Time
+	VoidedBy   *int
+	VoidReason string
+}
+
+// Void marks the bid as voided by an admin. A reason is required so the void can be explained later.
+func (b *Bid) Void(adminID int, reason string, at time.Time) error {
+	if b.VoidedAt != nil {
+		return &domainErrors.ConflictError{Message: "bid is already voided"}
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return &domainErrors.ValidationError{Field: "reason", Message: "is required"}
+	}
+	b.VoidedAt = &at
+	b.VoidedBy = &adminID
+	b.VoidReason = reason
+	return nil
+}
+
+// ValidateChannel checks that the bid is tagged consistently with its channel:
+// floor bids carry the clerk who entered them, online bids do not, and only online bids carry a buyer login.
+func (b *Bid) ValidateChannel() error {
+	if !b.Channel.IsValid() {
+		return &domainErrors.ValidationError{Field: "channel", Message: "must be online or floor"}
+	}
+	if b.Channel == BidChannelFloor && b.EnteredBy == nil {
+		return &domainErrors.ValidationError{Field: "entered_by", Message: "is required for floor bids"}
+	}
+	if b.Channel == BidChannelOnline && b.EnteredBy != nil {
+		return &domainErrors.ValidationError{Field: "entered_by", Message: "must be empty for online bids"}
+	}
+	if b.Channel == BidChannelFloor && b.LoginID != nil {
+		return &domainErrors.ValidationError{Field: "login_id", Message: "must be empty for floor bids"}
+	}
+	return nil
 }
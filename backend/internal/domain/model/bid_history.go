@@ -0,0 +1,93 @@
+package model
+
+import (
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+const (
+	// DefaultBidHistoryLimit is the page size used when none is requested.
+	DefaultBidHistoryLimit = 50
+	// MaxBidHistoryLimit is the largest page size a client may request.
+	MaxBidHistoryLimit = 200
+)
+
+// BidHistoryEntry is one bid in the price ladder of a lot.
+// It carries everything admins see, including voids; buyers are shown only the paddle number through Bidder.
+type BidHistoryEntry struct {
+	ID          int
+	ItemID      int
+	BuyerID     int
+	BuyerName   string
+	BuyerPaddle *string
+	LoginID     *int
+	LoginName   *string
+	Price       int
+	Channel     BidChannel
+	EnteredBy   *int
+	CreatedAt   time.Time
+	VoidedAt    *time.Time
+	VoidedBy    *int
+	VoidReason  *string
+}
+
+// Bidder returns how the bidder is shown to the buyer viewerID.
+func (e *BidHistoryEntry) Bidder(viewerID int) *string {
+	return DisplayBidder(e.BuyerID, e.BuyerPaddle, viewerID)
+}
+
+// BidHistoryQuery selects one page of a lot's bids, newest first.
+// BeforeID is the ID of the last bid on the previous page; 0 starts from the newest bid.
+type BidHistoryQuery struct {
+	BeforeID int
+	Limit    int
+}
+
+// NewBidHistoryQuery decodes the cursor returned with the previous page and bounds the page size.
+// A limit of 0 uses DefaultBidHistoryLimit.
+func NewBidHistoryQuery(cursor string, limit int) (*BidHistoryQuery, error) {
+	q := &BidHistoryQuery{Limit: limit}
+	if q.Limit == 0 {
+		q.Limit = DefaultBidHistoryLimit
+	}
+	if q.Limit < 0 || q.Limit > MaxBidHistoryLimit {
+		return nil, &domainErrors.ValidationError{Field: "limit", Message: "must be between 1 and 200"}
+	}
+	if cursor == "" {
+		return q, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, &domainErrors.ValidationError{Field: "cursor", Message: "is invalid"}
+	}
+	id, err := strconv.Atoi(string(raw))
+	if err != nil || id <= 0 {
+		return nil, &domainErrors.ValidationError{Field: "cursor", Message: "is invalid"}
+	}
+	q.BeforeID = id
+	return q, nil
+}
+
+// BidHistoryPage is one page of a lot's bids.
+// NextCursor is empty on the last page.
+type BidHistoryPage struct {
+	Bids       []BidHistoryEntry
+	NextCursor string
+}
+
+// NewBidHistoryPage builds a page from up to Limit+1 bids read for the query.
+// 1 件多く読んでおき、その有無で次のページがあるかを判断する。
+func NewBidHistoryPage(q *BidHistoryQuery, bids []BidHistoryEntry) *BidHistoryPage {
+	if len(bids) <= q.Limit {
+		return &BidHistoryPage{Bids: bids}
+	}
+	bids = bids[:q.Limit]
+	last := bids[len(bids)-1].ID
+	return &BidHistoryPage{
+		Bids:       bids,
+		NextCursor: base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(last))),
+	}
+}
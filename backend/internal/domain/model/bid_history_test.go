@@ -0,0 +1,66 @@
+package model
+
+import (
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBidHistoryQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		cursor     string
+		limit      int
+		wantBefore int
+		wantLimit  int
+		wantField  string
+	}{
+		{name: "Defaults", wantLimit: DefaultBidHistoryLimit},
+		{name: "Cursor", cursor: "MTIz", limit: 10, wantBefore: 123, wantLimit: 10},
+		{name: "LimitTooLarge", limit: MaxBidHistoryLimit + 1, wantField: "limit"},
+		{name: "NegativeLimit", limit: -1, wantField: "limit"},
+		{name: "NotBase64", cursor: "!!", wantField: "cursor"},
+		{name: "NotAnID", cursor: "YWJj", wantField: "cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewBidHistoryQuery(tt.cursor, tt.limit)
+			if tt.wantField != "" {
+				var vErr *domainErrors.ValidationError
+				require.ErrorAs(t, err, &vErr)
+				assert.Equal(t, tt.wantField, vErr.Field)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBefore, q.BeforeID)
+			assert.Equal(t, tt.wantLimit, q.Limit)
+		})
+	}
+}
+
+func TestNewBidHistoryPage(t *testing.T) {
+	q := &BidHistoryQuery{Limit: 2}
+
+	last := NewBidHistoryPage(q, []BidHistoryEntry{{ID: 9}, {ID: 7}})
+	assert.Len(t, last.Bids, 2)
+	assert.Empty(t, last.NextCursor)
+
+	more := NewBidHistoryPage(q, []BidHistoryEntry{{ID: 9}, {ID: 7}, {ID: 4}})
+	assert.Len(t, more.Bids, 2)
+	require.NotEmpty(t, more.NextCursor)
+
+	// 次のページは前のページの最後の入札より古いものから始まる。
+	next, err := NewBidHistoryQuery(more.NextCursor, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 7, next.BeforeID)
+}
+
+func TestAuction_BidsVisible(t *testing.T) {
+	assert.False(t, (&Auction{Status: AuctionStatusScheduled}).BidsVisible())
+	assert.True(t, (&Auction{Status: AuctionStatusInProgress}).BidsVisible())
+	assert.True(t, (&Auction{Status: AuctionStatusCompleted}).BidsVisible())
+	assert.False(t, (&Auction{Status: AuctionStatusCancelled}).BidsVisible())
+}
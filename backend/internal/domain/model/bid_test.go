@@ -0,0 +1,59 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBid_ValidateChannel(t *testing.T) {
+	clerkID := 2
+	loginID := 5
+
+	tests := []struct {
+		name      string
+		bid       Bid
+		wantField string
+	}{
+		{name: "Online", bid: Bid{Channel: BidChannelOnline}},
+		{name: "OnlineWithLogin", bid: Bid{Channel: BidChannelOnline, LoginID: &loginID}},
+		{name: "Floor", bid: Bid{Channel: BidChannelFloor, EnteredBy: &clerkID}},
+		{name: "UnknownChannel", bid: Bid{Channel: "phone"}, wantField: "channel"},
+		{name: "FloorWithoutClerk", bid: Bid{Channel: BidChannelFloor}, wantField: "entered_by"},
+		{name: "OnlineWithClerk", bid: Bid{Channel: BidChannelOnline, EnteredBy: &clerkID}, wantField: "entered_by"},
+		{name: "FloorWithLogin", bid: Bid{Channel: BidChannelFloor, EnteredBy: &clerkID, LoginID: &loginID}, wantField: "login_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.bid.ValidateChannel()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var vErr *domainErrors.ValidationError
+			if assert.ErrorAs(t, err, &vErr) {
+				assert.Equal(t, tt.wantField, vErr.Field)
+			}
+		})
+	}
+}
+
+func TestBid_Void(t *testing.T) {
+	at := time.Date(2024, 1, 10, 5, 0, 0, 0, time.UTC)
+
+	b := &Bid{ID: 1}
+	var vErr *domainErrors.ValidationError
+	assert.ErrorAs(t, b.Void(3, " ", at), &vErr)
+	assert.Nil(t, b.VoidedAt)
+
+	assert.NoError(t, b.Void(3, " 桁違い ", at))
+	assert.Equal(t, at, *b.VoidedAt)
+	assert.Equal(t, 3, *b.VoidedBy)
+	assert.Equal(t, "桁違い", b.VoidReason)
+
+	var cErr *domainErrors.ConflictError
+	assert.ErrorAs(t, b.Void(4, "again", at), &cErr)
+}
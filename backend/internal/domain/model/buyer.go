@@ -1,9 +1,65 @@
 package model
 
+import (
+	"strings"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// paddleNumberMaxLength is the longest paddle number (買参権番号) printed on a buyer's cap badge.
+const paddleNumberMaxLength = 10
+
+// BidderYou is shown in place of the paddle number on the viewer's own bids.
+const BidderYou = "you"
+
 // Buyer provides Buyer related functionality.
+// PaddleNumber is the licensed buyer number (買参権番号) that identifies the buyer at the seri.
+// 他の買受人には名前や ID ではなくこの番号だけを見せる。未割当のときは空文字列。
 type Buyer struct {
 	ID           int
 	Name         string
 	Organization string
 	ContactInfo  string
+	PaddleNumber string
+	BuyerProfile
+	CreditTerms
+	Registration BuyerRegistration
+}
+
+// BuyerProfile is the master data the cooperative keeps on a buyer's business.
+// InvoiceRegistrationNumber is the qualified invoice issuer number (適格請求書発行事業者登録番号).
+// Fields that have not been registered are empty strings and a nil LicenseExpiresOn.
+type BuyerProfile struct {
+	BusinessName              string
+	InvoiceRegistrationNumber string
+	Address                   string
+	Phone                     string
+	LicenseNumber             string
+	LicenseExpiresOn          *time.Time
+}
+
+// NormalizePaddleNumber trims and upper-cases a paddle number and checks that it can be printed on a badge.
+// An empty string clears the number.
+func NormalizePaddleNumber(s string) (string, error) {
+	n := strings.ToUpper(strings.TrimSpace(s))
+	if len(n) > paddleNumberMaxLength {
+		return "", &domainErrors.ValidationError{Field: "paddle_number", Message: "must be at most 10 characters"}
+	}
+	for _, r := range n {
+		if (r < '0' || r > '9') && (r < 'A' || r > 'Z') && r != '-' {
+			return "", &domainErrors.ValidationError{Field: "paddle_number", Message: "must contain only letters, digits and hyphens"}
+		}
+	}
+	return n, nil
+}
+
+// DisplayBidder returns how a bidder is shown to the buyer viewerID: "you" for their own bids, otherwise the paddle number.
+// viewerID 0 is an anonymous viewer. Nil means the bidder has no paddle number to show.
+func DisplayBidder(bidderID int, paddleNumber *string, viewerID int) *string {
+	if viewerID != 0 && bidderID == viewerID {
+		you := BidderYou
+		return &you
+	}
+	return paddleNumber
 }
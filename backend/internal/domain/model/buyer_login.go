@@ -0,0 +1,48 @@
+package model
+
+import (
+	"fmt"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// BuyerLoginRole is the role of a login within a buyer organization.
+type BuyerLoginRole string
+
+const (
+	// BuyerLoginOwner is the organization's representative, who manages the profile and the staff logins.
+	BuyerLoginOwner BuyerLoginRole = "owner"
+	// BuyerLoginStaff is a staff member sent to the auction, who can bid on behalf of the organization.
+	BuyerLoginStaff BuyerLoginRole = "staff"
+)
+
+// IsOwner reports whether the login is the organization's owner.
+// 役割が空のログインは組織アカウント導入前のもので、代表者として扱う。
+func (a *Authentication) IsOwner() bool {
+	return a.Role == BuyerLoginOwner || a.Role == ""
+}
+
+// CheckOwner rejects organization-wide changes made from a staff login.
+func (a *Authentication) CheckOwner() error {
+	if !a.IsOwner() {
+		return &domainErrors.ForbiddenError{Message: "Only the organization owner can do this"}
+	}
+	return nil
+}
+
+// CheckBidLimit rejects a bid above the login's own bid limit.
+// The limit caps a single bid and is separate from the organization's credit limit.
+func (a *Authentication) CheckBidLimit(price int) error {
+	if a.BidLimit != nil && price > *a.BidLimit {
+		return &domainErrors.ForbiddenError{Message: fmt.Sprintf("Bid exceeds your bid limit of %d", *a.BidLimit)}
+	}
+	return nil
+}
+
+// ValidateBidLimit checks that a bid limit, when set, is positive.
+func ValidateBidLimit(limit *int) error {
+	if limit != nil && *limit <= 0 {
+		return &domainErrors.ValidationError{Field: "bid_limit", Message: "must be positive"}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+func TestAuthentication_CheckOwner(t *testing.T) {
+	var fErr *domainErrors.ForbiddenError
+	assert.NoError(t, (&Authentication{Role: BuyerLoginOwner}).CheckOwner())
+	assert.NoError(t, (&Authentication{}).CheckOwner())
+	assert.ErrorAs(t, (&Authentication{Role: BuyerLoginStaff}).CheckOwner(), &fErr)
+}
+
+func TestAuthentication_CheckBidLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		limit   *int
+		price   int
+		wantErr bool
+	}{
+		{name: "NoLimit", price: 1000000},
+		{name: "UpToLimit", limit: new(50000), price: 50000},
+		{name: "OverLimit", limit: new(50000), price: 50001, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Authentication{Role: BuyerLoginStaff, BidLimit: tt.limit}).CheckBidLimit(tt.price)
+			if tt.wantErr {
+				var fErr *domainErrors.ForbiddenError
+				assert.ErrorAs(t, err, &fErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateBidLimit(t *testing.T) {
+	var vErr *domainErrors.ValidationError
+	assert.NoError(t, ValidateBidLimit(nil))
+	assert.NoError(t, ValidateBidLimit(new(1)))
+	assert.ErrorAs(t, ValidateBidLimit(new(0)), &vErr)
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+func TestBuyerRegistration_Approve(t *testing.T) {
+	verified := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	now := verified.Add(time.Hour)
+
+	r := &BuyerRegistration{Status: BuyerRegistrationPending, EmailVerifiedAt: &verified}
+	require.NoError(t, r.Approve(3, now))
+	assert.Equal(t, BuyerRegistrationApproved, r.Status)
+	assert.Equal(t, 3, *r.ReviewedBy)
+	assert.Equal(t, now, *r.ReviewedAt)
+
+	var cErr *domainErrors.ConflictError
+	assert.ErrorAs(t, r.Approve(3, now), &cErr)
+
+	unverified := &BuyerRegistration{Status: BuyerRegistrationPending}
+	assert.ErrorAs(t, unverified.Approve(3, now), &cErr)
+	assert.Equal(t, BuyerRegistrationPending, unverified.Status)
+}
+
+func TestBuyerRegistration_Reject(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	r := &BuyerRegistration{Status: BuyerRegistrationPending}
+	var vErr *domainErrors.ValidationError
+	require.ErrorAs(t, r.Reject(3, "  ", now), &vErr)
+	assert.Equal(t, "reason", vErr.Field)
+
+	require.NoError(t, r.Reject(3, " 買参権が確認できません ", now))
+	assert.Equal(t, BuyerRegistrationRejected, r.Status)
+	assert.Equal(t, "買参権が確認できません", r.RejectionReason)
+	assert.Equal(t, 3, *r.ReviewedBy)
+
+	var cErr *domainErrors.ConflictError
+	assert.ErrorAs(t, r.Reject(3, "重複", now), &cErr)
+}
+
+func TestBuyerRegistration_Checks(t *testing.T) {
+	verified := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		r             BuyerRegistration
+		wantLoginErr  bool
+		wantBiddingOK bool
+	}{
+		{name: "Approved", r: BuyerRegistration{Status: BuyerRegistrationApproved}, wantBiddingOK: true},
+		{name: "Unverified", r: BuyerRegistration{Status: BuyerRegistrationPending}, wantLoginErr: true},
+		{name: "AwaitingReview", r: BuyerRegistration{Status: BuyerRegistrationPending, EmailVerifiedAt: &verified}},
+		{name: "Rejected", r: BuyerRegistration{Status: BuyerRegistrationRejected, EmailVerifiedAt: &verified}, wantLoginErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fErr *domainErrors.ForbiddenError
+			if tt.wantLoginErr {
+				assert.ErrorAs(t, tt.r.CheckLogin(), &fErr)
+			} else {
+				assert.NoError(t, tt.r.CheckLogin())
+			}
+			if tt.wantBiddingOK {
+				assert.NoError(t, tt.r.CheckBidding())
+				assert.NoError(t, tt.r.CheckStaffLogins())
+			} else {
+				assert.ErrorAs(t, tt.r.CheckBidding(), &fErr)
+				assert.ErrorAs(t, tt.r.CheckStaffLogins(), &fErr)
+			}
+		})
+	}
+}
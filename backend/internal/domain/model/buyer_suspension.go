@@ -0,0 +1,117 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// SuspensionSeverity represents what a buyer suspension blocks.
+type SuspensionSeverity string
+
+const (
+	// SuspensionSeverityLogin blocks login as well as bidding.
+	SuspensionSeverityLogin SuspensionSeverity = "login"
+	// SuspensionSeverityBidding blocks bidding only; the buyer can still log in, view lots and check invoices.
+	SuspensionSeverityBidding SuspensionSeverity = "bidding"
+)
+
+// IsValid reports whether the severity is supported.
+func (s SuspensionSeverity) IsValid() bool {
+	switch s {
+	case SuspensionSeverityLogin, SuspensionSeverityBidding:
+		return true
+	}
+	return false
+}
+
+// BuyerSuspension represents a period during which a buyer may not log in or bid.
+// EndsAt nil means the buyer is blacklisted until an admin lifts the suspension.
+type BuyerSuspension struct {
+	ID        int
+	BuyerID   int
+	Severity  SuspensionSeverity
+	Reason    string
+	StartsAt  time.Time
+	EndsAt    *time.Time
+	CreatedBy int
+	LiftedAt  *time.Time
+	LiftedBy  *int
+	CreatedAt time.Time
+}
+
+// Validate checks the severity, the reason and that the period is not reversed.
+func (s *BuyerSuspension) Validate() error {
+	if s.BuyerID <= 0 {
+		return &domainErrors.ValidationError{Field: "buyer_id", Message: "is required"}
+	}
+	if !s.Severity.IsValid() {
+		return &domainErrors.ValidationError{Field: "severity", Message: "must be login or bidding"}
+	}
+	if strings.TrimSpace(s.Reason) == "" {
+		return &domainErrors.ValidationError{Field: "reason", Message: "is required"}
+	}
+	if s.StartsAt.IsZero() {
+		return &domainErrors.ValidationError{Field: "starts_at", Message: "is required"}
+	}
+	if s.EndsAt != nil && !s.EndsAt.After(s.StartsAt) {
+		return &domainErrors.ValidationError{Field: "ends_at", Message: "must be after starts_at"}
+	}
+	return nil
+}
+
+// IsActive reports whether the suspension is in force at now.
+func (s *BuyerSuspension) IsActive(now time.Time) bool {
+	if s.LiftedAt != nil || now.Before(s.StartsAt) {
+		return false
+	}
+	return s.EndsAt == nil || now.Before(*s.EndsAt)
+}
+
+// Blocks reports whether the suspension stops the given action. A login suspension also stops bidding.
+func (s *BuyerSuspension) Blocks(action SuspensionSeverity) bool {
+	return s.Severity == SuspensionSeverityLogin || s.Severity == action
+}
+
+// Lift ends the suspension early. A suspension that was already lifted or has run out cannot be lifted.
+func (s *BuyerSuspension) Lift(adminID int, now time.Time) error {
+	if s.LiftedAt != nil {
+		return &domainErrors.ConflictError{Message: "Suspension has already been lifted"}
+	}
+	if s.EndsAt != nil && !now.Before(*s.EndsAt) {
+		return &domainErrors.ConflictError{Message: "Suspension has already ended"}
+	}
+	s.LiftedAt = &now
+	s.LiftedBy = &adminID
+	return nil
+}
+
+// CheckSuspensions returns a forbidden error when one of the suspensions stops the buyer from taking the action at now.
+// 複数の停止が重なる場合は、最も長く続くものの終了日時を案内する。停止理由は管理者向けの記録なので買受人には見せない。
+func CheckSuspensions(suspensions []BuyerSuspension, action SuspensionSeverity, now time.Time) error {
+	var blocking *BuyerSuspension
+	for i := range suspensions {
+		s := &suspensions[i]
+		if !s.IsActive(now) || !s.Blocks(action) {
+			continue
+		}
+		if blocking == nil || blocking.EndsAt != nil && (s.EndsAt == nil || s.EndsAt.After(*blocking.EndsAt)) {
+			blocking = s
+		}
+	}
+	if blocking == nil {
+		return nil
+	}
+
+	what := "Your account is suspended"
+	if action == SuspensionSeverityBidding {
+		what = "Your account is suspended from bidding"
+	}
+	until := "until further notice"
+	if blocking.EndsAt != nil {
+		until = "until " + NewTimeZone(LocationJST).At(*blocking.EndsAt).Format("2006-01-02 15:04") + " JST"
+	}
+	return &domainErrors.ForbiddenError{Message: fmt.Sprintf("%s %s. Please contact the market office.", what, until)}
+}
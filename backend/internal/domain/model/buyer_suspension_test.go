@@ -0,0 +1,129 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+func TestBuyerSuspension_Validate(t *testing.T) {
+	start := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(7 * 24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		s         BuyerSuspension
+		wantField string
+	}{
+		{name: "Valid", s: BuyerSuspension{BuyerID: 1, Severity: SuspensionSeverityBidding, Reason: "未払い", StartsAt: start, EndsAt: &end}},
+		{name: "Indefinite", s: BuyerSuspension{BuyerID: 1, Severity: SuspensionSeverityLogin, Reason: "迷惑行為", StartsAt: start}},
+		{name: "MissingBuyer", s: BuyerSuspension{Severity: SuspensionSeverityLogin, Reason: "迷惑行為", StartsAt: start}, wantField: "buyer_id"},
+		{name: "UnknownSeverity", s: BuyerSuspension{BuyerID: 1, Severity: "viewing", Reason: "迷惑行為", StartsAt: start}, wantField: "severity"},
+		{name: "BlankReason", s: BuyerSuspension{BuyerID: 1, Severity: SuspensionSeverityLogin, Reason: "  ", StartsAt: start}, wantField: "reason"},
+		{name: "MissingStart", s: BuyerSuspension{BuyerID: 1, Severity: SuspensionSeverityLogin, Reason: "迷惑行為"}, wantField: "starts_at"},
+		{
+			name:      "EndBeforeStart",
+			s:         BuyerSuspension{BuyerID: 1, Severity: SuspensionSeverityLogin, Reason: "迷惑行為", StartsAt: end, EndsAt: &start},
+			wantField: "ends_at",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.s.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var vErr *domainErrors.ValidationError
+			require.ErrorAs(t, err, &vErr)
+			assert.Equal(t, tt.wantField, vErr.Field)
+		})
+	}
+}
+
+func TestBuyerSuspension_Lift(t *testing.T) {
+	start := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(7 * 24 * time.Hour)
+	now := start.Add(24 * time.Hour)
+
+	s := &BuyerSuspension{Severity: SuspensionSeverityLogin, StartsAt: start, EndsAt: &end}
+	require.NoError(t, s.Lift(3, now))
+	assert.Equal(t, now, *s.LiftedAt)
+	assert.Equal(t, 3, *s.LiftedBy)
+	assert.False(t, s.IsActive(now))
+
+	var cErr *domainErrors.ConflictError
+	assert.ErrorAs(t, s.Lift(3, now), &cErr)
+
+	ended := &BuyerSuspension{Severity: SuspensionSeverityLogin, StartsAt: start, EndsAt: &end}
+	assert.ErrorAs(t, ended.Lift(3, end), &cErr)
+}
+
+func TestCheckSuspensions(t *testing.T) {
+	start := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	weekLater := start.Add(7 * 24 * time.Hour)
+	monthLater := start.Add(30 * 24 * time.Hour)
+	now := start.Add(time.Hour)
+	lifted := start.Add(30 * time.Minute)
+
+	tests := []struct {
+		name        string
+		suspensions []BuyerSuspension
+		action      SuspensionSeverity
+		wantMessage string
+	}{
+		{name: "None", action: SuspensionSeverityLogin},
+		{
+			name:        "BiddingSuspensionAllowsLogin",
+			suspensions: []BuyerSuspension{{Severity: SuspensionSeverityBidding, StartsAt: start, EndsAt: &weekLater}},
+			action:      SuspensionSeverityLogin,
+		},
+		{
+			name:        "BiddingSuspensionBlocksBidding",
+			suspensions: []BuyerSuspension{{Severity: SuspensionSeverityBidding, StartsAt: start, EndsAt: &weekLater}},
+			action:      SuspensionSeverityBidding,
+			wantMessage: "Your account is suspended from bidding until 2026-05-08 09:00 JST. Please contact the market office.",
+		},
+		{
+			name:        "LoginSuspensionBlocksBidding",
+			suspensions: []BuyerSuspension{{Severity: SuspensionSeverityLogin, StartsAt: start}},
+			action:      SuspensionSeverityBidding,
+			wantMessage: "Your account is suspended from bidding until further notice. Please contact the market office.",
+		},
+		{
+			name: "LongestWins",
+			suspensions: []BuyerSuspension{
+				{Severity: SuspensionSeverityLogin, StartsAt: start, EndsAt: &weekLater},
+				{Severity: SuspensionSeverityLogin, StartsAt: start, EndsAt: &monthLater},
+			},
+			action:      SuspensionSeverityLogin,
+			wantMessage: "Your account is suspended until 2026-05-31 09:00 JST. Please contact the market office.",
+		},
+		{
+			name: "IgnoresLiftedAndFuture",
+			suspensions: []BuyerSuspension{
+				{Severity: SuspensionSeverityLogin, StartsAt: start, LiftedAt: &lifted},
+				{Severity: SuspensionSeverityLogin, StartsAt: weekLater},
+			},
+			action: SuspensionSeverityLogin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckSuspensions(tt.suspensions, tt.action, now)
+			if tt.wantMessage == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var fErr *domainErrors.ForbiddenError
+			require.ErrorAs(t, err, &fErr)
+			assert.Equal(t, tt.wantMessage, fErr.Message)
+		})
+	}
+}
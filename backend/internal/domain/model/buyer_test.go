@@ -0,0 +1,63 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+func TestNormalizePaddleNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "Digits", input: "128", want: "128"},
+		{name: "TrimAndUpper", input: " a-12 ", want: "A-12"},
+		{name: "Clear", input: "  ", want: ""},
+		{name: "TooLong", input: "12345678901", wantErr: true},
+		{name: "Space", input: "A 12", wantErr: true},
+		{name: "FullWidth", input: "１２８", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizePaddleNumber(tt.input)
+			if tt.wantErr {
+				var vErr *domainErrors.ValidationError
+				require.ErrorAs(t, err, &vErr)
+				assert.Equal(t, "paddle_number", vErr.Field)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuctionItem_HighestBidderFor(t *testing.T) {
+	bidderID, paddle := 7, "128"
+	item := &AuctionItem{HighestBidderID: &bidderID, HighestBidderPaddle: &paddle}
+
+	tests := []struct {
+		name     string
+		item     *AuctionItem
+		viewerID int
+		want     *string
+	}{
+		{name: "OwnBid", item: item, viewerID: 7, want: func() *string { s := BidderYou; return &s }()},
+		{name: "OtherBuyer", item: item, viewerID: 8, want: &paddle},
+		{name: "Anonymous", item: item, viewerID: 0, want: &paddle},
+		{name: "NoBids", item: &AuctionItem{}, viewerID: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.item.HighestBidderFor(tt.viewerID))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// TaxRateExempt is used for charges outside the scope of consumption tax (不課税・非課税).
+const TaxRateExempt = 0
+
+const chargeItemNameMaxLen = 100
+
+// ChargeItem is an entry in a venue's catalog of billable extras (諸掛),
+// such as ice, styrofoam boxes or delivery.
+type ChargeItem struct {
+	ID        int
+	VenueID   int
+	Name      string
+	Unit      string
+	UnitPrice int
+	TaxRate   int
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Normalize trims surrounding whitespace from the name and unit.
+func (c *ChargeItem) Normalize() {
+	c.Name = strings.TrimSpace(c.Name)
+	c.Unit = strings.TrimSpace(c.Unit)
+}
+
+// Validate checks that the catalog entry can be billed.
+// 諸掛は氷・資材など標準税率のものが多いが、配送の立替金など不課税のものもあるため 0% も許可する。
+func (c *ChargeItem) Validate() error {
+	if c.Name == "" {
+		return &domainErrors.ValidationError{Field: "name", Message: "is required"}
+	}
+	if utf8.RuneCountInString(c.Name) > chargeItemNameMaxLen {
+		return &domainErrors.ValidationError{Field: "name", Message: "must be at most 100 characters"}
+	}
+	if c.UnitPrice < 0 {
+		return &domainErrors.ValidationError{Field: "unit_price", Message: "must not be negative"}
+	}
+	switch c.TaxRate {
+	case TaxRateExempt, TaxRateReduced, TaxRateStandard:
+	default:
+		return &domainErrors.ValidationError{Field: "tax_rate", Message: "must be 0, 8 or 10"}
+	}
+	return nil
+}
+
+// BuyerCharge is a quantity of a catalog charge billed to a buyer for an auction day.
+// Description, unit, price and tax rate are copied from the catalog when recorded.
+type BuyerCharge struct {
+	ID           int
+	AuctionID    int
+	BuyerID      int
+	BuyerName    string
+	ChargeItemID int
+	Description  string
+	Unit         string
+	UnitPrice    int
+	TaxRate      int
+	Quantity     int
+	Note         string
+	RecordedBy   *int
+	CreatedAt    time.Time
+}
+
+// NewBuyerCharge validates and builds a charge of quantity units of item for a buyer.
+func NewBuyerCharge(item *ChargeItem, auctionID, buyerID, quantity int, note string, recordedBy int) (*BuyerCharge, error) {
+	if !item.Active {
+		return nil, &domainErrors.ValidationError{Field: "charge_item_id", Message: "charge item is inactive"}
+	}
+	if quantity <= 0 {
+		return nil, &domainErrors.ValidationError{Field: "quantity", Message: "must be greater than 0"}
+	}
+	return &BuyerCharge{
+		AuctionID:    auctionID,
+		BuyerID:      buyerID,
+		ChargeItemID: item.ID,
+		Description:  item.Name,
+		Unit:         item.Unit,
+		UnitPrice:    item.UnitPrice,
+		TaxRate:      item.TaxRate,
+		Quantity:     quantity,
+		Note:         strings.TrimSpace(note),
+		RecordedBy:   &recordedBy,
+	}, nil
+}
+
+// Amount returns the tax-exclusive amount billed for the charge.
+func (c *BuyerCharge) Amount() int {
+	return c.UnitPrice * c.Quantity
+}
+
+// InvoiceLine returns the invoice line that bills the charge.
+func (c *BuyerCharge) InvoiceLine() InvoiceLine {
+	chargeID := c.ID
+	return InvoiceLine{
+		ChargeID:    &chargeID,
+		Description: c.Description,
+		Quantity:    c.Quantity,
+		Unit:        c.Unit,
+		Amount:      c.Amount(),
+		TaxRate:     c.TaxRate,
+	}
+}
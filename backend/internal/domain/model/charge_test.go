@@ -0,0 +1,76 @@
+package model
+
+import (
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChargeItem_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		item      ChargeItem
+		wantField string
+	}{
+		{name: "Valid", item: ChargeItem{Name: "氷", Unit: "袋", UnitPrice: 300, TaxRate: TaxRateStandard}},
+		{name: "ExemptAllowed", item: ChargeItem{Name: "配送料（立替）", UnitPrice: 1500, TaxRate: TaxRateExempt}},
+		{name: "BlankName", item: ChargeItem{Name: "", UnitPrice: 300, TaxRate: TaxRateStandard}, wantField: "name"},
+		{name: "NegativePrice", item: ChargeItem{Name: "氷", UnitPrice: -1, TaxRate: TaxRateStandard}, wantField: "unit_price"},
+		{name: "UnsupportedRate", item: ChargeItem{Name: "氷", UnitPrice: 300, TaxRate: 5}, wantField: "tax_rate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.item.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var vErr *domainErrors.ValidationError
+			if assert.ErrorAs(t, err, &vErr) {
+				assert.Equal(t, tt.wantField, vErr.Field)
+			}
+		})
+	}
+}
+
+func TestNewBuyerCharge(t *testing.T) {
+	item := &ChargeItem{ID: 4, Name: "発泡スチロール箱", Unit: "箱", UnitPrice: 250, TaxRate: TaxRateStandard, Active: true}
+
+	c, err := NewBuyerCharge(item, 1, 2, 6, " 大 ", 9)
+	assert.NoError(t, err)
+	assert.Equal(t, 1500, c.Amount())
+	assert.Equal(t, "大", c.Note)
+	assert.Equal(t, 9, *c.RecordedBy)
+
+	c.ID = 12
+	line := c.InvoiceLine()
+	assert.Nil(t, line.ItemID)
+	assert.Equal(t, 12, *line.ChargeID)
+	assert.Equal(t, 1500, line.Amount)
+	assert.Equal(t, TaxRateStandard, line.TaxRate)
+
+	_, err = NewBuyerCharge(item, 1, 2, 0, "", 9)
+	var vErr *domainErrors.ValidationError
+	assert.ErrorAs(t, err, &vErr)
+
+	_, err = NewBuyerCharge(&ChargeItem{ID: 5, Name: "氷"}, 1, 2, 1, "", 9)
+	assert.ErrorAs(t, err, &vErr)
+}
+
+func TestInvoice_Recalculate_WithCharges(t *testing.T) {
+	itemID := 1
+	inv := &Invoice{Lines: []InvoiceLine{
+		{ItemID: &itemID, Amount: 10000, TaxRate: TaxRateReduced},
+		(&BuyerCharge{ID: 1, UnitPrice: 300, Quantity: 3, TaxRate: TaxRateStandard}).InvoiceLine(),
+		(&BuyerCharge{ID: 2, UnitPrice: 1500, Quantity: 1, TaxRate: TaxRateExempt}).InvoiceLine(),
+	}}
+
+	inv.Recalculate()
+
+	assert.Equal(t, 12400, inv.Subtotal)
+	assert.Equal(t, 800+90, inv.TaxAmount)
+	assert.Equal(t, 13290, inv.TotalAmount)
+	assert.Len(t, inv.TaxBreakdown(), 3)
+}
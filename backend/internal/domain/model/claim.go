@@ -0,0 +1,211 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// ClaimType represents what the buyer asks for in a quality claim.
+type ClaimType string
+
+const (
+	ClaimTypeReturn   ClaimType = "return"
+	ClaimTypeDiscount ClaimType = "discount"
+)
+
+// IsValid reports whether the claim type is supported.
+func (t ClaimType) IsValid() bool {
+	switch t {
+	case ClaimTypeReturn, ClaimTypeDiscount:
+		return true
+	}
+	return false
+}
+
+// ClaimStatus represents the review state of a claim.
+type ClaimStatus string
+
+const (
+	ClaimStatusPending  ClaimStatus = "pending"
+	ClaimStatusApproved ClaimStatus = "approved"
+	ClaimStatusRejected ClaimStatus = "rejected"
+)
+
+// IsValid reports whether the claim status is supported.
+func (s ClaimStatus) IsValid() bool {
+	switch s {
+	case ClaimStatusPending, ClaimStatusApproved, ClaimStatusRejected:
+		return true
+	}
+	return false
+}
+
+// ClaimAction represents an entry in a claim's audit trail.
+type ClaimAction string
+
+const (
+	ClaimActionFiled    ClaimAction = "filed"
+	ClaimActionApproved ClaimAction = "approved"
+	ClaimActionRejected ClaimAction = "rejected"
+)
+
+// ClaimActorType identifies who performed a claim action.
+type ClaimActorType string
+
+const (
+	ClaimActorBuyer ClaimActorType = "buyer"
+	ClaimActorAdmin ClaimActorType = "admin"
+)
+
+// Claim represents a buyer's quality claim (返品・値引き) against a purchase.
+// Amounts are tax-exclusive, like the purchase price; the credit note adds the tax.
+type Claim struct {
+	ID              int
+	BuyerID         int
+	BuyerName       string
+	PurchaseID      int
+	ItemID          int
+	AuctionID       int
+	FishermanID     int
+	FishType        string
+	PurchasePrice   int
+	Type            ClaimType
+	Reason          string
+	RequestedAmount int
+	ApprovedAmount  *int
+	Clawback        bool
+	Status          ClaimStatus
+	ResolutionNote  string
+	ResolvedBy      *int
+	ResolvedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	Events          []ClaimEvent
+	CreditNote      *CreditNote
+}
+
+// ClaimEvent is an append-only audit record of a claim state change.
+type ClaimEvent struct {
+	ID        int
+	ClaimID   int
+	Action    ClaimAction
+	ActorType ClaimActorType
+	ActorID   int
+	Amount    *int
+	Note      string
+	CreatedAt time.Time
+}
+
+// NewClaim validates and builds a pending claim against a purchase.
+// 返品で金額が省略された場合は落札額の全額を請求額とする。
+func NewClaim(purchase *Purchase, claimType ClaimType, reason string, amount int) (*Claim, error) {
+	if !claimType.IsValid() {
+		return nil, &domainErrors.ValidationError{Field: "type", Message: "must be return or discount"}
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, &domainErrors.ValidationError{Field: "reason", Message: "is required"}
+	}
+	if claimType == ClaimTypeReturn && amount == 0 {
+		amount = purchase.Price
+	}
+	if amount <= 0 {
+		return nil, &domainErrors.ValidationError{Field: "amount", Message: "must be greater than 0"}
+	}
+	if amount > purchase.Price {
+		return nil, &domainErrors.ValidationError{Field: "amount", Message: "must not exceed the purchase price"}
+	}
+
+	return &Claim{
+		BuyerID:         purchase.BuyerID,
+		PurchaseID:      purchase.ID,
+		ItemID:          purchase.ItemID,
+		AuctionID:       purchase.AuctionID,
+		FishermanID:     purchase.FishermanID,
+		FishType:        purchase.FishType,
+		PurchasePrice:   purchase.Price,
+		Type:            claimType,
+		Reason:          reason,
+		RequestedAmount: amount,
+		Status:          ClaimStatusPending,
+	}, nil
+}
+
+// Approve accepts a pending claim. An amount of zero approves the requested amount.
+func (c *Claim) Approve(adminID, amount int, clawback bool, note string, at time.Time) error {
+	if c.Status != ClaimStatusPending {
+		return &domainErrors.ConflictError{Message: "claim has already been resolved"}
+	}
+	if amount == 0 {
+		amount = c.RequestedAmount
+	}
+	if amount < 0 {
+		return &domainErrors.ValidationError{Field: "amount", Message: "must be greater than 0"}
+	}
+	if amount > c.PurchasePrice {
+		return &domainErrors.ValidationError{Field: "amount", Message: "must not exceed the purchase price"}
+	}
+
+	c.Status = ClaimStatusApproved
+	c.ApprovedAmount = &amount
+	c.Clawback = clawback
+	c.resolve(adminID, note, at)
+	return nil
+}
+
+// Reject declines a pending claim. A note explaining the decision is required.
+func (c *Claim) Reject(adminID int, note string, at time.Time) error {
+	if c.Status != ClaimStatusPending {
+		return &domainErrors.ConflictError{Message: "claim has already been resolved"}
+	}
+	if strings.TrimSpace(note) == "" {
+		return &domainErrors.ValidationError{Field: "note", Message: "is required"}
+	}
+
+	c.Status = ClaimStatusRejected
+	c.resolve(adminID, note, at)
+	return nil
+}
+
+func (c *Claim) resolve(adminID int, note string, at time.Time) {
+	c.ResolutionNote = strings.TrimSpace(note)
+	c.ResolvedBy = &adminID
+	c.ResolvedAt = &at
+}
+
+// CreditNote represents a credit note (赤伝) issued for an approved claim or result correction.
+// Exactly one of ClaimID and CorrectionID is set.
+type CreditNote struct {
+	ID           int
+	ClaimID      *int
+	CorrectionID *int
+	InvoiceID    int
+	BuyerID      int
+	BuyerName    string
+	Amount       int
+	TaxRate      int
+	TaxAmount    int
+	TotalAmount  int
+	IssuedBy     *int
+	CreatedAt    time.Time
+}
+
+// NewCreditNote builds a credit note for the approved amount of a claim at the given tax rate.
+func NewCreditNote(claim *Claim, invoiceID, taxRate int) *CreditNote {
+	amount := *claim.ApprovedAmount
+	tax := CalculateTax(amount, taxRate)
+	claimID := claim.ID
+	return &CreditNote{
+		ClaimID:     &claimID,
+		InvoiceID:   invoiceID,
+		BuyerID:     claim.BuyerID,
+		BuyerName:   claim.BuyerName,
+		Amount:      amount,
+		TaxRate:     taxRate,
+		TaxAmount:   tax,
+		TotalAmount: amount + tax,
+		IssuedBy:    claim.ResolvedBy,
+	}
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClaim(t *testing.T) {
+	purchase := &Purchase{ID: 1, ItemID: 10, BuyerID: 2, FishermanID: 3, AuctionID: 4, Price: 5000}
+
+	tests := []struct {
+		name       string
+		claimType  ClaimType
+		reason     string
+		amount     int
+		wantAmount int
+		wantField  string
+	}{
+		{name: "ReturnDefaultsToFullPrice", claimType: ClaimTypeReturn, reason: "鮮度不良", wantAmount: 5000},
+		{name: "PartialDiscount", claimType: ClaimTypeDiscount, reason: "身割れ", amount: 1200, wantAmount: 1200},
+		{name: "DiscountWithoutAmount", claimType: ClaimTypeDiscount, reason: "身割れ", wantField: "amount"},
+		{name: "ExceedsPrice", claimType: ClaimTypeReturn, reason: "鮮度不良", amount: 5001, wantField: "amount"},
+		{name: "BlankReason", claimType: ClaimTypeReturn, reason: "  ", wantField: "reason"},
+		{name: "UnknownType", claimType: "refund", reason: "x", wantField: "type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClaim(purchase, tt.claimType, tt.reason, tt.amount)
+			if tt.wantField != "" {
+				var vErr *domainErrors.ValidationError
+				if assert.ErrorAs(t, err, &vErr) {
+					assert.Equal(t, tt.wantField, vErr.Field)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAmount, c.RequestedAmount)
+			assert.Equal(t, ClaimStatusPending, c.Status)
+			assert.Equal(t, 3, c.FishermanID)
+		})
+	}
+}
+
+func TestClaim_Approve(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("DefaultsToRequestedAmount", func(t *testing.T) {
+		c := &Claim{Status: ClaimStatusPending, RequestedAmount: 1200, PurchasePrice: 5000}
+		assert.NoError(t, c.Approve(7, 0, true, " ok ", now))
+		assert.Equal(t, ClaimStatusApproved, c.Status)
+		assert.Equal(t, 1200, *c.ApprovedAmount)
+		assert.True(t, c.Clawback)
+		assert.Equal(t, "ok", c.ResolutionNote)
+		assert.Equal(t, 7, *c.ResolvedBy)
+	})
+
+	t.Run("ExceedsPrice", func(t *testing.T) {
+		c := &Claim{Status: ClaimStatusPending, RequestedAmount: 1200, PurchasePrice: 5000}
+		var vErr *domainErrors.ValidationError
+		assert.ErrorAs(t, c.Approve(7, 6000, false, "", now), &vErr)
+	})
+
+	t.Run("AlreadyResolved", func(t *testing.T) {
+		c := &Claim{Status: ClaimStatusRejected}
+		var cErr *domainErrors.ConflictError
+		assert.ErrorAs(t, c.Approve(7, 0, false, "", now), &cErr)
+	})
+}
+
+func TestClaim_Reject(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	c := &Claim{Status: ClaimStatusPending}
+	var vErr *domainErrors.ValidationError
+	assert.ErrorAs(t, c.Reject(7, "", now), &vErr)
+
+	assert.NoError(t, c.Reject(7, "写真では確認できず", now))
+	assert.Equal(t, ClaimStatusRejected, c.Status)
+	assert.Nil(t, c.ApprovedAmount)
+}
+
+func TestNewCreditNote(t *testing.T) {
+	amount := 1000
+	admin := 7
+	c := &Claim{ID: 3, BuyerID: 2, ApprovedAmount: &amount, ResolvedBy: &admin}
+
+	cn := NewCreditNote(c, 9, TaxRateReduced)
+
+	assert.Equal(t, 3, *cn.ClaimID)
+	assert.Nil(t, cn.CorrectionID)
+	assert.Equal(t, 9, cn.InvoiceID)
+	assert.Equal(t, 80, cn.TaxAmount)
+	assert.Equal(t, 1080, cn.TotalAmount)
+	assert.Equal(t, &admin, cn.IssuedBy)
+}
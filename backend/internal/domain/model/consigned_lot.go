@@ -0,0 +1,21 @@
+package model
+
+// ConsignedLot is a lot as seen by the fisherman who consigned it.
+// Price is the current highest bid while the auction is running and the winning price once it has completed.
+// 出荷者には落札者を明かさないため、買受人の情報は持たない。
+type ConsignedLot struct {
+	ItemID        int
+	AuctionID     int
+	AuctionDate   string
+	AuctionStatus AuctionStatus
+	FishType      string
+	Quantity      int
+	Unit          string
+	Price         *int
+	BidCount      int
+}
+
+// IsSold reports whether the lot was knocked down to a buyer.
+func (l *ConsignedLot) IsSold() bool {
+	return l.AuctionStatus == AuctionStatusCompleted && l.Price != nil
+}
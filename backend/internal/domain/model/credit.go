@@ -0,0 +1,73 @@
+package model
+
+import (
+	"fmt"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// CreditTerms is a buyer's credit limit (与信枠) and the deposit (保証金) the cooperative holds for them.
+// A nil CreditLimit means the buyer has no limit.
+type CreditTerms struct {
+	CreditLimit *int
+	Deposit     int
+}
+
+// Validate checks that the limit and deposit are not negative.
+func (t CreditTerms) Validate() error {
+	if t.CreditLimit != nil && *t.CreditLimit < 0 {
+		return &domainErrors.ValidationError{Field: "credit_limit", Message: "must not be negative"}
+	}
+	if t.Deposit < 0 {
+		return &domainErrors.ValidationError{Field: "deposit", Message: "must not be negative"}
+	}
+	return nil
+}
+
+// CreditUtilization is how much of a buyer's credit limit their open commitments use.
+// 未請求の最高値入札（せり中の品目と、落札後まだ請求書を発行していない品目）と未払いの請求残高を合算する。
+type CreditUtilization struct {
+	BuyerID int
+	CreditTerms
+	LeadingBids    int
+	UnpaidInvoices int
+}
+
+// NewCreditUtilization builds the utilization of a buyer from the total of their leading bids and their invoices.
+func NewCreditUtilization(buyer *Buyer, leadingBids int, invoices []Invoice) *CreditUtilization {
+	return &CreditUtilization{
+		BuyerID:        buyer.ID,
+		CreditTerms:    buyer.CreditTerms,
+		LeadingBids:    leadingBids,
+		UnpaidInvoices: NewBuyerBalance(buyer.ID, invoices, nil).OutstandingAmount,
+	}
+}
+
+// Committed returns the buyer's open commitments.
+func (u *CreditUtilization) Committed() int {
+	return u.LeadingBids + u.UnpaidInvoices
+}
+
+// Available returns how much more the buyer may commit, or nil when they have no limit.
+// Commitments can exceed a limit that was lowered afterwards, in which case nothing is available.
+func (u *CreditUtilization) Available() *int {
+	if u.CreditLimit == nil {
+		return nil
+	}
+	available := max(*u.CreditLimit-u.Committed(), 0)
+	return &available
+}
+
+// CheckBid rejects a bid that would push the buyer's commitments over their limit.
+// replacedLead is the buyer's own leading bid on the same item, which the new bid replaces rather than adds to.
+func (u *CreditUtilization) CheckBid(price, replacedLead int) error {
+	if u.CreditLimit == nil {
+		return nil
+	}
+	if u.Committed()-replacedLead+price > *u.CreditLimit {
+		return &domainErrors.ForbiddenError{
+			Message: fmt.Sprintf("Bid exceeds credit limit (available %d)", max(*u.CreditLimit-u.Committed()+replacedLead, 0)),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+func TestCreditTerms_Validate(t *testing.T) {
+	assert.NoError(t, CreditTerms{}.Validate())
+	assert.NoError(t, CreditTerms{CreditLimit: new(0), Deposit: 100000}.Validate())
+
+	var valErr *domainErrors.ValidationError
+	require.ErrorAs(t, CreditTerms{CreditLimit: new(-1)}.Validate(), &valErr)
+	assert.Equal(t, "credit_limit", valErr.Field)
+	require.ErrorAs(t, CreditTerms{Deposit: -1}.Validate(), &valErr)
+	assert.Equal(t, "deposit", valErr.Field)
+}
+
+func TestNewCreditUtilization(t *testing.T) {
+	buyer := &Buyer{ID: 1, CreditTerms: CreditTerms{CreditLimit: new(50000), Deposit: 20000}}
+	invoices := []Invoice{
+		{Status: InvoiceStatusIssued, TotalAmount: 10000, PaidAmount: 4000},
+		{Status: InvoiceStatusIssued, TotalAmount: 5000, CreditedAmount: 1000},
+		{Status: InvoiceStatusPaid, TotalAmount: 8000, PaidAmount: 8000},
+		{Status: InvoiceStatusDraft, TotalAmount: 9000},
+	}
+
+	u := NewCreditUtilization(buyer, 30000, invoices)
+
+	assert.Equal(t, 1, u.BuyerID)
+	assert.Equal(t, 20000, u.Deposit)
+	assert.Equal(t, 30000, u.LeadingBids)
+	assert.Equal(t, 10000, u.UnpaidInvoices)
+	assert.Equal(t, 40000, u.Committed())
+	require.NotNil(t, u.Available())
+	assert.Equal(t, 10000, *u.Available())
+}
+
+func TestCreditUtilization_Available(t *testing.T) {
+	assert.Nil(t, (&CreditUtilization{LeadingBids: 100}).Available())
+
+	// 限度額を利用額より下げた場合はマイナスではなく 0 を返す
+	lowered := &CreditUtilization{CreditTerms: CreditTerms{CreditLimit: new(1000)}, LeadingBids: 3000}
+	require.NotNil(t, lowered.Available())
+	assert.Equal(t, 0, *lowered.Available())
+}
+
+func TestCreditUtilization_CheckBid(t *testing.T) {
+	limited := &CreditUtilization{CreditTerms: CreditTerms{CreditLimit: new(10000)}, LeadingBids: 4000, UnpaidInvoices: 3000}
+
+	tests := []struct {
+		name         string
+		u            *CreditUtilization
+		price        int
+		replacedLead int
+		wantErr      bool
+	}{
+		{name: "NoLimit", u: &CreditUtilization{LeadingBids: 1000000}, price: 5000},
+		{name: "UpToLimit", u: limited, price: 3000},
+		{name: "OverLimit", u: limited, price: 3500, wantErr: true},
+		{name: "RaiseOwnLead", u: limited, price: 5000, replacedLead: 2000},
+		{name: "RaiseOwnLeadOverLimit", u: limited, price: 5500, replacedLead: 2000, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.u.CheckBid(tt.price, tt.replacedLead)
+			if tt.wantErr {
+				var forbiddenErr *domainErrors.ForbiddenError
+				assert.ErrorAs(t, err, &forbiddenErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
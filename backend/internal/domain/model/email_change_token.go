@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// EmailChangeToken represents a pending change of a buyer's login email.
+// The new address only replaces the current one once the link sent to it is opened.
+type EmailChangeToken struct {
+	BuyerID   int
+	NewEmail  string
+	TokenHash string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+// EmailVerificationToken represents the link sent to a self-registered buyer to confirm their email address.
+type EmailVerificationToken struct {
+	BuyerID   int
+	TokenHash string
+	ExpiresAt time.Time
+}
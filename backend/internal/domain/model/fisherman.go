@@ -4,4 +4,16 @@ package model
 type Fisherman struct {
 	ID   int
 	Name string
+	FishermanProfile
+	BankAccount *BankAccount
+}
+
+// FishermanProfile is the master data (出荷者台帳) the cooperative keeps on a fisherman.
+// Fields that have not been registered are empty strings.
+type FishermanProfile struct {
+	CooperativeMemberNumber  string
+	VesselName               string
+	VesselRegistrationNumber string
+	Phone                    string
+	Address                  string
 }
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// FishermanAuthentication holds the portal login of a fisherman.
+// 買受人の Authentication と同じく、連続失敗回数とロック期限を持つ。
+type FishermanAuthentication struct {
+	ID             int
+	FishermanID    int
+	Email          string
+	PasswordHash   string
+	FailedAttempts int
+	LockedUntil    *time.Time
+	LastLoginAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -1,8 +1,253 @@
 package model
 
+import (
+	"slices"
+	"sort"
+	"time"
+)
+
 // InvoiceItem provides InvoiceItem related functionality.
 type InvoiceItem struct {
 	BuyerID     int
 	BuyerName   string
 	TotalAmount int
 }
+
+// 税率 (%)。鮮魚は軽減税率 8% の対象。
+const (
+	TaxRateReduced  = 8
+	TaxRateStandard = 10
+)
+
+// InvoiceStatus represents the lifecycle state of an invoice.
+type InvoiceStatus string
+
+const (
+	InvoiceStatusDraft  InvoiceStatus = "draft"
+	InvoiceStatusIssued InvoiceStatus = "issued"
+	InvoiceStatusPaid   InvoiceStatus = "paid"
+)
+
+// IsValid reports whether the status is a known invoice status.
+func (s InvoiceStatus) IsValid() bool {
+	switch s {
+	case InvoiceStatusDraft, InvoiceStatusIssued, InvoiceStatusPaid:
+		return true
+	}
+	return false
+}
+
+// InvoiceLine represents a single billed line on an invoice.
+// A line is either an awarded item (ItemID) or a miscellaneous charge (ChargeID).
+type InvoiceLine struct {
+	ID          int
+	InvoiceID   int
+	ItemID      *int
+	ChargeID    *int
+	Description string
+	Quantity    int
+	Unit        string
+	Amount      int
+	TaxRate     int
+}
+
+// Invoice represents a buyer's bill for a single auction (せり).
+// CreditedAmount is the total of credit notes (赤伝) issued against it.
+type Invoice struct {
+	ID             int
+	BuyerID        int
+	BuyerName      string
+	AuctionID      int
+	Lines          []InvoiceLine
+	Subtotal       int
+	TaxAmount      int
+	TotalAmount    int
+	CreditedAmount int
+	PaidAmount     int
+	Status         InvoiceStatus
+	IssuedAt       *time.Time
+	PaidAt         *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// TaxBreakdown is the taxable amount and consumption tax for a single tax rate.
+type TaxBreakdown struct {
+	Rate   int
+	Amount int
+	Tax    int
+}
+
+// TaxBreakdown sums the invoice lines per tax rate, ordered by rate.
+// 消費税は税率ごとに合計してから端数を切り捨てる（インボイス制度の計算方法）。
+func (i *Invoice) TaxBreakdown() []TaxBreakdown {
+	byRate := make(map[int]int)
+	for _, l := range i.Lines {
+		byRate[l.TaxRate] += l.Amount
+	}
+
+	rates := make([]int, 0, len(byRate))
+	for rate := range byRate {
+		rates = append(rates, rate)
+	}
+	sort.Ints(rates)
+
+	breakdown := make([]TaxBreakdown, len(rates))
+	for j, rate := range rates {
+		breakdown[j] = TaxBreakdown{Rate: rate, Amount: byRate[rate], Tax: CalculateTax(byRate[rate], rate)}
+	}
+	return breakdown
+}
+
+// Recalculate derives subtotal, tax and total from the invoice lines.
+func (i *Invoice) Recalculate() {
+	subtotal, tax := 0, 0
+	for _, b := range i.TaxBreakdown() {
+		subtotal += b.Amount
+		tax += b.Tax
+	}
+
+	i.Subtotal = subtotal
+	i.TaxAmount = tax
+	i.TotalAmount = subtotal + tax
+}
+
+// NetAmount returns the invoiced total after credit notes.
+func (i *Invoice) NetAmount() int {
+	return i.TotalAmount - i.CreditedAmount
+}
+
+// OutstandingAmount returns the amount still owed on the invoice.
+func (i *Invoice) OutstandingAmount() int {
+	if i.Status == InvoiceStatusDraft {
+		return 0
+	}
+	return max(i.NetAmount()-i.PaidAmount, 0)
+}
+
+// OverpaidAmount returns how much was paid beyond the invoice total after credit notes.
+// 入金後に赤伝が発行された場合に生じ、買受人の前受金（クレジット）として扱う。
+func (i *Invoice) OverpaidAmount() int {
+	return max(i.PaidAmount-i.NetAmount(), 0)
+}
+
+// Issue finalizes a draft invoice. An invoice with nothing to pay is settled immediately.
+func (i *Invoice) Issue(at time.Time) bool {
+	if i.Status != InvoiceStatusDraft {
+		return false
+	}
+	i.Status = InvoiceStatusIssued
+	i.IssuedAt = &at
+	i.settleIfPaid(at)
+	return true
+}
+
+// ApplyPayment applies up to amount to the invoice and returns the amount applied.
+// 全額が消し込まれた時点で paid に遷移する。
+func (i *Invoice) ApplyPayment(amount int, at time.Time) int {
+	if i.Status != InvoiceStatusIssued || amount <= 0 {
+		return 0
+	}
+	applied := min(amount, i.OutstandingAmount())
+	i.PaidAmount += applied
+	i.settleIfPaid(at)
+	return applied
+}
+
+// ApplyCredit reduces the invoice by up to amount for a credit note and returns the amount applied.
+// Only issued or paid invoices can be credited, and never below zero.
+func (i *Invoice) ApplyCredit(amount int, at time.Time) int {
+	if i.Status == InvoiceStatusDraft || amount <= 0 {
+		return 0
+	}
+	applied := min(amount, i.NetAmount())
+	i.CreditedAmount += applied
+	i.settleIfPaid(at)
+	return applied
+}
+
+// ReleaseOverpayment takes the part paid beyond the net amount off the invoice and returns
+// negative allocations that give it back to the payments it came from, latest payment first.
+// allocations are the net amounts each payment has applied to this invoice, oldest first.
+// 赤伝で過入金になった分を入金側の未充当残額に戻し、次の請求書の発行時に充当できるようにする。
+func (i *Invoice) ReleaseOverpayment(allocations []PaymentAllocation) []PaymentAllocation {
+	var released []PaymentAllocation
+	for _, a := range slices.Backward(allocations) {
+		excess := i.OverpaidAmount()
+		if excess == 0 {
+			break
+		}
+		amount := min(excess, a.Amount)
+		if amount <= 0 {
+			continue
+		}
+		i.PaidAmount -= amount
+		released = append(released, PaymentAllocation{PaymentID: a.PaymentID, InvoiceID: i.ID, Amount: -amount})
+	}
+	return released
+}
+
+func (i *Invoice) settleIfPaid(at time.Time) {
+	if i.Status == InvoiceStatusIssued && i.OutstandingAmount() == 0 {
+		i.Status = InvoiceStatusPaid
+		i.PaidAt = &at
+	}
+}
+
+// LineTaxRate returns the tax rate of the line billing the awarded item.
+func (i *Invoice) LineTaxRate(itemID int) (int, bool) {
+	for _, l := range i.Lines {
+		if l.ItemID != nil && *l.ItemID == itemID {
+			return l.TaxRate, true
+		}
+	}
+	return 0, false
+}
+
+// CalculateTax returns the consumption tax for amount at rate percent, rounded down.
+func CalculateTax(amount, rate int) int {
+	return amount * rate / 100
+}
+
+// BuildDraftInvoices groups awarded items and miscellaneous charges by buyer into draft invoices ordered by buyer ID.
+// 諸掛のみ計上され落札のない買受人にも請求書を作成する。明細は落札品、諸掛の順に並べる。
+func BuildDraftInvoices(auctionID int, awards []Purchase, charges []BuyerCharge) []*Invoice {
+	byBuyer := make(map[int]*Invoice)
+	invoiceFor := func(buyerID int) *Invoice {
+		inv, ok := byBuyer[buyerID]
+		if !ok {
+			inv = &Invoice{
+				BuyerID:   buyerID,
+				AuctionID: auctionID,
+				Status:    InvoiceStatusDraft,
+			}
+			byBuyer[buyerID] = inv
+		}
+		return inv
+	}
+
+	for _, a := range awards {
+		inv := invoiceFor(a.BuyerID)
+		itemID := a.ItemID
+		inv.Lines = append(inv.Lines, InvoiceLine{
+			ItemID:      &itemID,
+			Description: a.FishType,
+			Quantity:    a.Quantity,
+			Unit:        a.Unit,
+			Amount:      a.Price,
+			TaxRate:     TaxRateReduced,
+		})
+	}
+	for _, c := range charges {
+		inv := invoiceFor(c.BuyerID)
+		inv.Lines = append(inv.Lines, c.InvoiceLine())
+	}
+
+	invoices := make([]*Invoice, 0, len(byBuyer))
+	for _, inv := range byBuyer {
+		inv.Recalculate()
+		invoices = append(invoices, inv)
+	}
+	sort.Slice(invoices, func(i, j int) bool { return invoices[i].BuyerID < invoices[j].BuyerID })
+	return invoices
+}
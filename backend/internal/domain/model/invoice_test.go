@@ -0,0 +1,177 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvoice_Recalculate(t *testing.T) {
+	tests := []struct {
+		name      string
+		lines     []InvoiceLine
+		wantSub   int
+		wantTax   int
+		wantTotal int
+	}{
+		{
+			name:      "Empty",
+			wantSub:   0,
+			wantTax:   0,
+			wantTotal: 0,
+		},
+		{
+			name: "ReducedRateOnly",
+			lines: []InvoiceLine{
+				{Amount: 1000, TaxRate: TaxRateReduced},
+				{Amount: 2500, TaxRate: TaxRateReduced},
+			},
+			wantSub:   3500,
+			wantTax:   280,
+			wantTotal: 3780,
+		},
+		{
+			name: "RoundsDownPerRate",
+			lines: []InvoiceLine{
+				{Amount: 999, TaxRate: TaxRateReduced},
+				{Amount: 999, TaxRate: TaxRateStandard},
+			},
+			wantSub:   1998,
+			wantTax:   79 + 99,
+			wantTotal: 1998 + 79 + 99,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := &Invoice{Lines: tt.lines}
+			inv.Recalculate()
+			assert.Equal(t, tt.wantSub, inv.Subtotal)
+			assert.Equal(t, tt.wantTax, inv.TaxAmount)
+			assert.Equal(t, tt.wantTotal, inv.TotalAmount)
+		})
+	}
+}
+
+func TestInvoice_Issue(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("DraftBecomesIssued", func(t *testing.T) {
+		inv := &Invoice{Status: InvoiceStatusDraft, TotalAmount: 1000}
+		assert.True(t, inv.Issue(now))
+		assert.Equal(t, InvoiceStatusIssued, inv.Status)
+		assert.Equal(t, now, *inv.IssuedAt)
+		assert.Equal(t, 1000, inv.OutstandingAmount())
+	})
+
+	t.Run("ZeroTotalIsPaidImmediately", func(t *testing.T) {
+		inv := &Invoice{Status: InvoiceStatusDraft}
+		assert.True(t, inv.Issue(now))
+		assert.Equal(t, InvoiceStatusPaid, inv.Status)
+	})
+
+	t.Run("AlreadyIssued", func(t *testing.T) {
+		inv := &Invoice{Status: InvoiceStatusIssued}
+		assert.False(t, inv.Issue(now))
+	})
+}
+
+func TestInvoice_ApplyPayment(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		status      InvoiceStatus
+		paid        int
+		amount      int
+		wantApplied int
+		wantStatus  InvoiceStatus
+	}{
+		{"Partial", InvoiceStatusIssued, 0, 400, 400, InvoiceStatusIssued},
+		{"Exact", InvoiceStatusIssued, 400, 600, 600, InvoiceStatusPaid},
+		{"Overpayment", InvoiceStatusIssued, 0, 1500, 1000, InvoiceStatusPaid},
+		{"Draft", InvoiceStatusDraft, 0, 500, 0, InvoiceStatusDraft},
+		{"AlreadyPaid", InvoiceStatusPaid, 1000, 500, 0, InvoiceStatusPaid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := &Invoice{Status: tt.status, TotalAmount: 1000, PaidAmount: tt.paid}
+			applied := inv.ApplyPayment(tt.amount, now)
+			assert.Equal(t, tt.wantApplied, applied)
+			assert.Equal(t, tt.wantStatus, inv.Status)
+		})
+	}
+}
+
+func TestInvoice_ApplyCredit(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		status          InvoiceStatus
+		paid            int
+		amount          int
+		wantApplied     int
+		wantStatus      InvoiceStatus
+		wantOutstanding int
+		wantOverpaid    int
+	}{
+		{"Partial", InvoiceStatusIssued, 0, 300, 300, InvoiceStatusIssued, 700, 0},
+		{"ClearsBalance", InvoiceStatusIssued, 600, 400, 400, InvoiceStatusPaid, 0, 0},
+		{"AfterFullPayment", InvoiceStatusPaid, 1000, 300, 300, InvoiceStatusPaid, 0, 300},
+		{"CappedAtTotal", InvoiceStatusIssued, 0, 1500, 1000, InvoiceStatusPaid, 0, 0},
+		{"Draft", InvoiceStatusDraft, 0, 500, 0, InvoiceStatusDraft, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := &Invoice{Status: tt.status, TotalAmount: 1000, PaidAmount: tt.paid}
+			applied := inv.ApplyCredit(tt.amount, now)
+			assert.Equal(t, tt.wantApplied, applied)
+			assert.Equal(t, tt.wantStatus, inv.Status)
+			assert.Equal(t, tt.wantOutstanding, inv.OutstandingAmount())
+			assert.Equal(t, tt.wantOverpaid, inv.OverpaidAmount())
+		})
+	}
+}
+
+func TestInvoice_ReleaseOverpayment(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	inv := &Invoice{ID: 5, Status: InvoiceStatusIssued, TotalAmount: 1000}
+	inv.ApplyPayment(600, now)
+	inv.ApplyPayment(400, now)
+	inv.ApplyCredit(500, now)
+
+	released := inv.ReleaseOverpayment([]PaymentAllocation{
+		{PaymentID: 1, InvoiceID: 5, Amount: 600},
+		{PaymentID: 2, InvoiceID: 5, Amount: 400},
+	})
+
+	assert.Equal(t, []PaymentAllocation{
+		{PaymentID: 2, InvoiceID: 5, Amount: -400},
+		{PaymentID: 1, InvoiceID: 5, Amount: -100},
+	}, released)
+	assert.Equal(t, 500, inv.PaidAmount)
+	assert.Equal(t, 0, inv.OverpaidAmount())
+	assert.Equal(t, InvoiceStatusPaid, inv.Status)
+
+	assert.Empty(t, inv.ReleaseOverpayment([]PaymentAllocation{{PaymentID: 1, InvoiceID: 5, Amount: 500}}))
+}
+
+func TestInvoice_LineTaxRate(t *testing.T) {
+	itemID := 10
+	inv := &Invoice{Lines: []InvoiceLine{
+		{Description: "氷", TaxRate: TaxRateStandard},
+		{ItemID: &itemID, Description: "マグロ", TaxRate: TaxRateReduced},
+	}}
+
+	rate, ok := inv.LineTaxRate(10)
+	assert.True(t, ok)
+	assert.Equal(t, TaxRateReduced, rate)
+
+	_, ok = inv.LineTaxRate(11)
+	assert.False(t, ok)
+}
@@ -12,6 +12,8 @@ const (
 	JobTypePushOutbid JobType = "push.outbid"
 	// JobTypePushAuctionStatusChanged is the job type for notifying buyers that an auction status changed.
 	JobTypePushAuctionStatusChanged JobType = "push.auction_status_changed"
+	// JobTypePushLeadRestored is the job type for notifying a buyer who leads again after a higher bid was voided.
+	JobTypePushLeadRestored JobType = "push.lead_restored"
 	// JobTypeEmail is the job type for sending emails.
 	JobTypeEmail JobType = "email"
 )
@@ -23,6 +25,8 @@ func NewJobType(s string) (JobType, error) {
 		return JobTypePushOutbid, nil
 	case JobTypePushAuctionStatusChanged:
 		return JobTypePushAuctionStatusChanged, nil
+	case JobTypePushLeadRestored:
+		return JobTypePushLeadRestored, nil
 	case JobTypeEmail:
 		return JobTypeEmail, nil
 	default:
@@ -0,0 +1,211 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// journalLocation は仕訳日付を決めるタイムゾーン。せりの開催日と同じく日本時間で日付を切る。
+var journalLocation = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// TaxCategory is the consumption tax classification (税区分) of one side of a journal line.
+// Each export format maps it to its own label.
+type TaxCategory string
+
+const (
+	TaxCategoryNone          TaxCategory = "none"
+	TaxCategorySalesReduced  TaxCategory = "sales_reduced"
+	TaxCategorySalesStandard TaxCategory = "sales_standard"
+)
+
+// salesTaxCategory returns the taxable sales category for a tax rate.
+func salesTaxCategory(rate int) TaxCategory {
+	switch rate {
+	case TaxRateReduced:
+		return TaxCategorySalesReduced
+	case TaxRateStandard:
+		return TaxCategorySalesStandard
+	}
+	return TaxCategoryNone
+}
+
+// JournalSide is the debit or credit half of a journal line. An empty Account means the side is unused.
+// Amount includes consumption tax; TaxAmount is the tax contained in it.
+type JournalSide struct {
+	Account     string
+	TaxCategory TaxCategory
+	Amount      int
+	TaxAmount   int
+}
+
+// JournalLine is a single row of a journal voucher.
+type JournalLine struct {
+	Debit  JournalSide
+	Credit JournalSide
+}
+
+// JournalEntry is a journal voucher (仕訳伝票). Vouchers with more than one line are compound entries (複合仕訳).
+type JournalEntry struct {
+	VoucherNo   int
+	Date        time.Time
+	Description string
+	Lines       []JournalLine
+}
+
+// LocalDate returns the voucher date in Japan time.
+func (e *JournalEntry) LocalDate() time.Time {
+	return e.Date.In(journalLocation)
+}
+
+// Balanced reports whether the debit and credit totals of the voucher match.
+func (e *JournalEntry) Balanced() bool {
+	debit, credit := 0, 0
+	for _, l := range e.Lines {
+		debit += l.Debit.Amount
+		credit += l.Credit.Amount
+	}
+	return debit == credit
+}
+
+// JournalDayRange returns the half-open instant range [start, end) covering the Japan-time
+// calendar dates from through to, both inclusive.
+func JournalDayRange(from, to time.Time) (time.Time, time.Time) {
+	start := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, journalLocation)
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, journalLocation).AddDate(0, 0, 1)
+	return start, end
+}
+
+// PaymentReceipt is the part of a buyer payment that was applied to a single invoice.
+type PaymentReceipt struct {
+	PaymentID  int
+	InvoiceID  int
+	BuyerName  string
+	Method     PaymentMethod
+	Amount     int
+	ReceivedAt time.Time
+}
+
+// NewInvoiceJournalEntry books an issued invoice: receivable against sales per tax rate.
+func NewInvoiceJournalEntry(inv *Invoice, accounts *AccountingSettings) JournalEntry {
+	debits := []JournalSide{{Account: accounts.ReceivableAccount, TaxCategory: TaxCategoryNone, Amount: inv.TotalAmount}}
+	var credits []JournalSide
+	for _, b := range inv.TaxBreakdown() {
+		credits = append(credits, JournalSide{
+			Account:     accounts.SalesAccount,
+			TaxCategory: salesTaxCategory(b.Rate),
+			Amount:      b.Amount + b.Tax,
+			TaxAmount:   b.Tax,
+		})
+	}
+
+	entry := JournalEntry{
+		Description: fmt.Sprintf("売上 %s 請求書#%d", inv.BuyerName, inv.ID),
+		Lines:       zipJournalSides(debits, credits),
+	}
+	if inv.IssuedAt != nil {
+		entry.Date = *inv.IssuedAt
+	}
+	return entry
+}
+
+// NewCreditNoteJournalEntry books a credit note (赤伝) as a reversal of sales against the receivable.
+func NewCreditNoteJournalEntry(cn *CreditNote, accounts *AccountingSettings) JournalEntry {
+	return JournalEntry{
+		Date:        cn.CreatedAt,
+		Description: fmt.Sprintf("売上値引 %s 請求書#%d", cn.BuyerName, cn.InvoiceID),
+		Lines: []JournalLine{{
+			Debit: JournalSide{
+				Account:     accounts.SalesAccount,
+				TaxCategory: salesTaxCategory(cn.TaxRate),
+				Amount:      cn.TotalAmount,
+				TaxAmount:   cn.TaxAmount,
+			},
+			Credit: JournalSide{Account: accounts.ReceivableAccount, TaxCategory: TaxCategoryNone, Amount: cn.TotalAmount},
+		}},
+	}
+}
+
+// NewPaymentJournalEntry books money received against the receivable it settled.
+func NewPaymentJournalEntry(r *PaymentReceipt, accounts *AccountingSettings) JournalEntry {
+	return JournalEntry{
+		Date:        r.ReceivedAt,
+		Description: fmt.Sprintf("入金 %s 請求書#%d", r.BuyerName, r.InvoiceID),
+		Lines: []JournalLine{{
+			Debit:  JournalSide{Account: accounts.PaymentAccount(r.Method), TaxCategory: TaxCategoryNone, Amount: r.Amount},
+			Credit: JournalSide{Account: accounts.ReceivableAccount, TaxCategory: TaxCategoryNone, Amount: r.Amount},
+		}},
+	}
+}
+
+// NewSettlementJournalEntry books a settled fisherman statement: the gross sales owed to the
+// fisherman, split into the payable net amount and the commission (with tax) the venue keeps.
+func NewSettlementJournalEntry(s *Settlement, accounts *AccountingSettings) JournalEntry {
+	debits := []JournalSide{{Account: accounts.PurchaseAccount, TaxCategory: TaxCategoryNone, Amount: s.GrossAmount}}
+	credits := []JournalSide{{Account: accounts.PayableAccount, TaxCategory: TaxCategoryNone, Amount: s.NetAmount}}
+	if commission := s.CommissionAmount + s.CommissionTax; commission > 0 {
+		credits = append(credits, JournalSide{
+			Account:     accounts.CommissionAccount,
+			TaxCategory: TaxCategorySalesStandard,
+			Amount:      commission,
+			TaxAmount:   s.CommissionTax,
+		})
+	}
+
+	entry := JournalEntry{
+		Description: fmt.Sprintf("仕切 %s 仕切書#%d", s.FishermanName, s.ID),
+		Lines:       zipJournalSides(debits, credits),
+	}
+	if s.SettledAt != nil {
+		entry.Date = *s.SettledAt
+	}
+	return entry
+}
+
+// BuildJournal converts invoices, credit notes, payment receipts and settlements into vouchers ordered
+// by date and numbered from 1. Documents with nothing to book are skipped.
+func BuildJournal(invoices []Invoice, creditNotes []CreditNote, receipts []PaymentReceipt, settlements []Settlement, accounts *AccountingSettings) []JournalEntry {
+	var entries []JournalEntry
+	for i := range invoices {
+		if invoices[i].TotalAmount > 0 {
+			entries = append(entries, NewInvoiceJournalEntry(&invoices[i], accounts))
+		}
+	}
+	for i := range creditNotes {
+		if creditNotes[i].TotalAmount > 0 {
+			entries = append(entries, NewCreditNoteJournalEntry(&creditNotes[i], accounts))
+		}
+	}
+	for i := range receipts {
+		if receipts[i].Amount > 0 {
+			entries = append(entries, NewPaymentJournalEntry(&receipts[i], accounts))
+		}
+	}
+	for i := range settlements {
+		if settlements[i].GrossAmount > 0 {
+			entries = append(entries, NewSettlementJournalEntry(&settlements[i], accounts))
+		}
+	}
+
+	// 日付単位で並べ、同じ日付の中では 売上 → 赤伝 → 入金 → 仕切 の順を保つ
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].LocalDate().Format(time.DateOnly) < entries[j].LocalDate().Format(time.DateOnly)
+	})
+	for i := range entries {
+		entries[i].VoucherNo = i + 1
+	}
+	return entries
+}
+
+func zipJournalSides(debits, credits []JournalSide) []JournalLine {
+	lines := make([]JournalLine, max(len(debits), len(credits)))
+	for i := range lines {
+		if i < len(debits) {
+			lines[i].Debit = debits[i]
+		}
+		if i < len(credits) {
+			lines[i].Credit = credits[i]
+		}
+	}
+	return lines
+}
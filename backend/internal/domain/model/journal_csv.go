@@ -0,0 +1,162 @@
+package model
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// JournalFormat is the accounting software layout a journal is exported in.
+type JournalFormat string
+
+const (
+	JournalFormatYayoi JournalFormat = "yayoi"
+	JournalFormatFreee JournalFormat = "freee"
+)
+
+// IsValid reports whether the format is supported.
+func (f JournalFormat) IsValid() bool {
+	switch f {
+	case JournalFormatYayoi, JournalFormatFreee:
+		return true
+	}
+	return false
+}
+
+// 弥生会計の識別フラグ。1 行の伝票は 2000、複数行の伝票は 先頭 2110 / 中間 2100 / 最終 2101。
+const (
+	yayoiFlagSingle = "2000"
+	yayoiFlagFirst  = "2110"
+	yayoiFlagMiddle = "2100"
+	yayoiFlagLast   = "2101"
+)
+
+var yayoiTaxLabels = map[TaxCategory]string{
+	TaxCategoryNone:          "対象外",
+	TaxCategorySalesReduced:  "課税売上8%(軽)",
+	TaxCategorySalesStandard: "課税売上10%",
+}
+
+var freeeTaxLabels = map[TaxCategory]string{
+	TaxCategoryNone:          "対象外",
+	TaxCategorySalesReduced:  "課税売上8%（軽）",
+	TaxCategorySalesStandard: "課税売上10%",
+}
+
+var freeeHeader = []string{
+	"伝票番号", "取引日",
+	"借方勘定科目", "借方税区分", "借方金額", "借方税額",
+	"貸方勘定科目", "貸方税区分", "貸方金額", "貸方税額",
+	"摘要",
+}
+
+// EncodeJournal renders the vouchers in the given format.
+func EncodeJournal(format JournalFormat, entries []JournalEntry) ([]byte, error) {
+	switch format {
+	case JournalFormatYayoi:
+		return EncodeYayoiJournal(entries)
+	case JournalFormatFreee:
+		return EncodeFreeeJournal(entries)
+	}
+	return nil, fmt.Errorf("unsupported journal format %q", format)
+}
+
+// EncodeYayoiJournal renders the vouchers in the 弥生会計 仕訳日記帳 import layout
+// (25 columns, no header, Shift_JIS, CRLF).
+func EncodeYayoiJournal(entries []JournalEntry) ([]byte, error) {
+	var rows [][]string
+	for _, e := range entries {
+		date := e.LocalDate().Format("2006/01/02")
+		for i, l := range e.Lines {
+			row := []string{yayoiFlag(i, len(e.Lines)), strconv.Itoa(e.VoucherNo), "", date}
+			row = append(row, yayoiSide(l.Debit)...)
+			row = append(row, yayoiSide(l.Credit)...)
+			row = append(row, toShiftJISSafe(e.Description), "", "", "0", "", "", "0", "0", "no")
+			rows = append(rows, row)
+		}
+	}
+
+	out, err := writeCSV(rows)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := japanese.ShiftJIS.NewEncoder().Bytes(out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode yayoi journal: %w", err)
+	}
+	return encoded, nil
+}
+
+// EncodeFreeeJournal renders the vouchers in freee's 振替伝票 import layout (UTF-8 with a header row).
+// 複合仕訳は伝票番号と取引日を各行に繰り返して 1 伝票にまとめる。
+func EncodeFreeeJournal(entries []JournalEntry) ([]byte, error) {
+	rows := [][]string{freeeHeader}
+	for _, e := range entries {
+		date := e.LocalDate().Format("2006/01/02")
+		for _, l := range e.Lines {
+			row := []string{strconv.Itoa(e.VoucherNo), date}
+			row = append(row, freeeSide(l.Debit)...)
+			row = append(row, freeeSide(l.Credit)...)
+			row = append(row, e.Description)
+			rows = append(rows, row)
+		}
+	}
+	return writeCSV(rows)
+}
+
+func yayoiFlag(i, n int) string {
+	switch {
+	case n == 1:
+		return yayoiFlagSingle
+	case i == 0:
+		return yayoiFlagFirst
+	case i == n-1:
+		return yayoiFlagLast
+	}
+	return yayoiFlagMiddle
+}
+
+// yayoiSide returns 勘定科目, 補助科目, 部門, 税区分, 金額, 税金額.
+func yayoiSide(s JournalSide) []string {
+	if s.Account == "" {
+		return []string{"", "", "", "", "", ""}
+	}
+	return []string{toShiftJISSafe(s.Account), "", "", yayoiTaxLabels[s.TaxCategory], strconv.Itoa(s.Amount), strconv.Itoa(s.TaxAmount)}
+}
+
+// freeeSide returns 勘定科目, 税区分, 金額, 税額.
+func freeeSide(s JournalSide) []string {
+	if s.Account == "" {
+		return []string{"", "", "", ""}
+	}
+	return []string{s.Account, freeeTaxLabels[s.TaxCategory], strconv.Itoa(s.Amount), strconv.Itoa(s.TaxAmount)}
+}
+
+func writeCSV(rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.UseCRLF = true
+	if err := w.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("failed to write journal csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// toShiftJISSafe replaces characters that Shift_JIS cannot represent (e.g. some names) with "?"
+// so that a single unusual name does not make the whole export fail.
+func toShiftJISSafe(s string) string {
+	enc := japanese.ShiftJIS.NewEncoder()
+	var b strings.Builder
+	for _, r := range s {
+		if _, err := enc.String(string(r)); err != nil {
+			b.WriteByte('?')
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
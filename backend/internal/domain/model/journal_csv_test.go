@@ -0,0 +1,58 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func testJournal() []JournalEntry {
+	invoices, receipts, settlements := testJournalSources()
+	return BuildJournal(invoices, nil, receipts, settlements, DefaultAccountingSettings(1))
+}
+
+func TestEncodeYayoiJournal_Golden(t *testing.T) {
+	got, err := EncodeYayoiJournal(testJournal())
+	require.NoError(t, err)
+	assertGolden(t, "yayoi_journal.golden", got)
+
+	decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(got)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(decoded), "\r\n"), "\r\n")
+	require.Len(t, lines, 5)
+
+	flags := make([]string, len(lines))
+	for i, l := range lines {
+		cols := strings.Split(l, ",")
+		assert.Len(t, cols, 25)
+		flags[i] = cols[0]
+	}
+	assert.Equal(t, []string{"2110", "2101", "2110", "2101", "2000"}, flags)
+}
+
+func TestEncodeFreeeJournal_Golden(t *testing.T) {
+	got, err := EncodeFreeeJournal(testJournal())
+	require.NoError(t, err)
+	assertGolden(t, "freee_journal.golden", got)
+
+	lines := strings.Split(strings.TrimSuffix(string(got), "\r\n"), "\r\n")
+	require.Len(t, lines, 6)
+	assert.True(t, strings.HasPrefix(lines[0], "伝票番号,取引日,"))
+}
+
+func TestEncodeJournal(t *testing.T) {
+	_, err := EncodeJournal(JournalFormat("csv"), testJournal())
+	assert.Error(t, err)
+
+	got, err := EncodeJournal(JournalFormatFreee, nil)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Join(freeeHeader, ",")+"\r\n", string(got))
+}
+
+func TestToShiftJISSafe(t *testing.T) {
+	assert.Equal(t, "丸魚商店", toShiftJISSafe("丸魚商店"))
+	assert.Equal(t, "?魚", toShiftJISSafe("🐟魚"))
+}
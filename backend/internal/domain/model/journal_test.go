@@ -0,0 +1,114 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+func testJournalSources() ([]Invoice, []PaymentReceipt, []Settlement) {
+	issuedAt := time.Date(2024, 3, 1, 9, 0, 0, 0, jst)
+	settledAt := time.Date(2024, 3, 1, 17, 0, 0, 0, jst)
+	// 3/2 0:30 JST は UTC では 3/1 だが、仕訳日付は 3/2 になる
+	receivedAt := time.Date(2024, 3, 1, 15, 30, 0, 0, time.UTC)
+
+	inv := Invoice{
+		ID:        10,
+		BuyerName: "丸魚商店",
+		IssuedAt:  &issuedAt,
+		Status:    InvoiceStatusIssued,
+		Lines: []InvoiceLine{
+			{Amount: 10000, TaxRate: TaxRateReduced},
+			{Amount: 500, TaxRate: TaxRateStandard},
+		},
+	}
+	inv.Recalculate()
+
+	empty := Invoice{ID: 11, IssuedAt: &issuedAt}
+
+	st := Settlement{ID: 3, FishermanName: "山田太郎", CommissionRate: 5, SettledAt: &settledAt, Status: SettlementStatusSettled,
+		Lines: []SettlementLine{{Amount: 10000}}}
+	st.Recalculate()
+
+	return []Invoice{inv, empty},
+		[]PaymentReceipt{{PaymentID: 1, InvoiceID: 10, BuyerName: "丸魚商店", Method: PaymentMethodBankTransfer, Amount: 5000, ReceivedAt: receivedAt}},
+		[]Settlement{st}
+}
+
+func TestNewInvoiceJournalEntry(t *testing.T) {
+	invoices, _, _ := testJournalSources()
+	e := NewInvoiceJournalEntry(&invoices[0], DefaultAccountingSettings(1))
+
+	require.Len(t, e.Lines, 2)
+	assert.True(t, e.Balanced())
+	assert.Equal(t, JournalSide{Account: "売掛金", TaxCategory: TaxCategoryNone, Amount: 11350}, e.Lines[0].Debit)
+	assert.Equal(t, JournalSide{Account: "売上高", TaxCategory: TaxCategorySalesReduced, Amount: 10800, TaxAmount: 800}, e.Lines[0].Credit)
+	assert.Empty(t, e.Lines[1].Debit.Account)
+	assert.Equal(t, JournalSide{Account: "売上高", TaxCategory: TaxCategorySalesStandard, Amount: 550, TaxAmount: 50}, e.Lines[1].Credit)
+}
+
+func TestNewSettlementJournalEntry(t *testing.T) {
+	_, _, settlements := testJournalSources()
+	accounts := DefaultAccountingSettings(1)
+
+	e := NewSettlementJournalEntry(&settlements[0], accounts)
+	require.Len(t, e.Lines, 2)
+	assert.True(t, e.Balanced())
+	assert.Equal(t, 10000, e.Lines[0].Debit.Amount)
+	assert.Equal(t, 9450, e.Lines[0].Credit.Amount)
+	assert.Equal(t, JournalSide{Account: "受取手数料", TaxCategory: TaxCategorySalesStandard, Amount: 550, TaxAmount: 50}, e.Lines[1].Credit)
+
+	t.Run("NoCommission", func(t *testing.T) {
+		s := Settlement{Lines: []SettlementLine{{Amount: 1000}}}
+		s.Recalculate()
+		e := NewSettlementJournalEntry(&s, accounts)
+		require.Len(t, e.Lines, 1)
+		assert.True(t, e.Balanced())
+	})
+}
+
+func TestNewCreditNoteJournalEntry(t *testing.T) {
+	accounts := DefaultAccountingSettings(1)
+	cn := CreditNote{ID: 3, InvoiceID: 10, BuyerName: "丸魚商店", Amount: 1000, TaxRate: TaxRateReduced, TaxAmount: 80, TotalAmount: 1080}
+
+	e := NewCreditNoteJournalEntry(&cn, accounts)
+
+	require.Len(t, e.Lines, 1)
+	assert.True(t, e.Balanced())
+	assert.Equal(t, "売上値引 丸魚商店 請求書#10", e.Description)
+	assert.Equal(t, JournalSide{Account: "売上高", TaxCategory: TaxCategorySalesReduced, Amount: 1080, TaxAmount: 80}, e.Lines[0].Debit)
+	assert.Equal(t, "売掛金", e.Lines[0].Credit.Account)
+}
+
+func TestBuildJournal(t *testing.T) {
+	invoices, receipts, settlements := testJournalSources()
+	accounts := DefaultAccountingSettings(1)
+	accounts.DepositAccount = "当座預金"
+
+	entries := BuildJournal(invoices, nil, receipts, settlements, accounts)
+
+	// 金額 0 の請求書は出力しない
+	require.Len(t, entries, 3)
+	assert.Equal(t, "売上 丸魚商店 請求書#10", entries[0].Description)
+	assert.Equal(t, "仕切 山田太郎 仕切書#3", entries[1].Description)
+	assert.Equal(t, "入金 丸魚商店 請求書#10", entries[2].Description)
+	assert.Equal(t, "当座預金", entries[2].Lines[0].Debit.Account)
+	for i, e := range entries {
+		assert.Equal(t, i+1, e.VoucherNo)
+		assert.True(t, e.Balanced())
+	}
+	assert.Equal(t, "2024-03-02", entries[2].LocalDate().Format(time.DateOnly))
+}
+
+func TestJournalDayRange(t *testing.T) {
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	start, end := JournalDayRange(from, to)
+	assert.True(t, start.Equal(time.Date(2024, 2, 29, 15, 0, 0, 0, time.UTC)))
+	assert.True(t, end.Equal(time.Date(2024, 3, 31, 15, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// labelCodePrefix はラベルコードの形式バージョン。形式を変える場合は値を変えて旧コードと区別する。
+const labelCodePrefix = "L1"
+
+// labelSignatureLen は署名（HMAC-SHA256）のうち QR に載せる先頭バイト数。
+// 128 bit あれば偽造は現実的でなく、QR のバージョン（サイズ）も小さく保てる。
+const labelSignatureLen = 16
+
+// LabelCode is the signed item reference printed as a QR code on a lot label.
+// The encoded form is "L1.<item id>.<expires unix>.<signature>".
+type LabelCode struct {
+	ItemID    int
+	ExpiresAt time.Time
+}
+
+// Sign returns the encoded, signed form of the code.
+func (c LabelCode) Sign(key []byte) string {
+	payload := fmt.Sprintf("%s.%d.%d", labelCodePrefix, c.ItemID, c.ExpiresAt.Unix())
+	return payload + "." + labelSignature(key, payload)
+}
+
+// ParseLabelCode verifies the signature of an encoded label code and returns it.
+// The expiry is not checked; see LabelCode.Expired.
+func ParseLabelCode(key []byte, code string) (*LabelCode, error) {
+	invalid := &domainErrors.ValidationError{Field: "code", Message: "label code is invalid"}
+
+	parts := strings.Split(strings.TrimSpace(code), ".")
+	if len(parts) != 4 || parts[0] != labelCodePrefix {
+		return nil, invalid
+	}
+	payload := strings.Join(parts[:3], ".")
+	if !hmac.Equal([]byte(parts[3]), []byte(labelSignature(key, payload))) {
+		return nil, invalid
+	}
+
+	itemID, err := strconv.Atoi(parts[1])
+	if err != nil || itemID <= 0 {
+		return nil, invalid
+	}
+	expires, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, invalid
+	}
+	return &LabelCode{ItemID: itemID, ExpiresAt: time.Unix(expires, 0)}, nil
+}
+
+// Expired reports whether the code is no longer accepted at now.
+func (c LabelCode) Expired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
+
+func labelSignature(key []byte, payload string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:labelSignatureLen])
+}
+
+// Label is the printable content of a single lot label.
+// QRContent is what the QR code encodes: the scan URL carrying the signed code.
+type Label struct {
+	ItemID        int
+	AuctionID     int
+	LotNumber     int
+	FishType      string
+	Quantity      int
+	Unit          string
+	FishermanName string
+	QRContent     string
+}
+
+// LabelScan is the lot a scanned label code resolves to.
+type LabelScan struct {
+	Item        *AuctionItem
+	Auction     *Auction
+	BiddingOpen bool
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelCode_SignAndParse(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	expires := time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)
+	code := LabelCode{ItemID: 42, ExpiresAt: expires}.Sign(key)
+
+	assert.True(t, strings.HasPrefix(code, "L1.42.1704326400."))
+
+	got, err := ParseLabelCode(key, code)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, got.ItemID)
+	assert.True(t, got.ExpiresAt.Equal(expires))
+	assert.False(t, got.Expired(expires.Add(-time.Second)))
+	assert.True(t, got.Expired(expires))
+
+	tampered := strings.Replace(code, "L1.42.", "L1.43.", 1)
+	invalid := []string{
+		"",
+		"not-a-code",
+		tampered,
+		code + "x",
+		"L2" + code[2:],
+	}
+	for _, c := range invalid {
+		_, err := ParseLabelCode(key, c)
+		var vErr *domainErrors.ValidationError
+		assert.ErrorAs(t, err, &vErr, "code %q", c)
+	}
+
+	_, err = ParseLabelCode([]byte("another-key-another-key-another-k"), code)
+	var vErr *domainErrors.ValidationError
+	assert.ErrorAs(t, err, &vErr)
+}
@@ -0,0 +1,68 @@
+package model
+
+import "time"
+
+// PaymentMethod represents how a buyer settled (part of) their balance.
+type PaymentMethod string
+
+const (
+	PaymentMethodCash         PaymentMethod = "cash"
+	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
+)
+
+// IsValid reports whether the payment method is supported.
+func (m PaymentMethod) IsValid() bool {
+	switch m {
+	case PaymentMethodCash, PaymentMethodBankTransfer:
+		return true
+	}
+	return false
+}
+
+// Payment represents money received from a buyer.
+// AllocatedAmount is the part already applied to invoices; the rest is held as credit.
+type Payment struct {
+	ID              int
+	BuyerID         int
+	InvoiceID       *int
+	Method          PaymentMethod
+	Amount          int
+	AllocatedAmount int
+	ReceivedAt      time.Time
+	Note            string
+	RecordedBy      *int
+	CreatedAt       time.Time
+}
+
+// UnallocatedAmount returns the part of the payment not yet applied to any invoice.
+func (p *Payment) UnallocatedAmount() int {
+	return max(p.Amount-p.AllocatedAmount, 0)
+}
+
+// PaymentAllocation records how much of a payment was applied to an invoice.
+type PaymentAllocation struct {
+	ID        int
+	PaymentID int
+	InvoiceID int
+	Amount    int
+	CreatedAt time.Time
+}
+
+// Allocate applies the unallocated part of the payment to the given invoices in order
+// and returns the allocations made. Whatever cannot be applied stays on the payment as credit.
+func (p *Payment) Allocate(invoices []*Invoice, at time.Time) []PaymentAllocation {
+	var allocations []PaymentAllocation
+	for _, inv := range invoices {
+		remaining := p.UnallocatedAmount()
+		if remaining == 0 {
+			break
+		}
+		applied := inv.ApplyPayment(remaining, at)
+		if applied == 0 {
+			continue
+		}
+		p.AllocatedAmount += applied
+		allocations = append(allocations, PaymentAllocation{PaymentID: p.ID, InvoiceID: inv.ID, Amount: applied})
+	}
+	return allocations
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPayment_Allocate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("SpreadsOldestFirstAndKeepsCredit", func(t *testing.T) {
+		inv1 := &Invoice{ID: 1, Status: InvoiceStatusIssued, TotalAmount: 1000}
+		inv2 := &Invoice{ID: 2, Status: InvoiceStatusIssued, TotalAmount: 1000, PaidAmount: 200}
+		p := &Payment{ID: 5, Amount: 2000}
+
+		allocations := p.Allocate([]*Invoice{inv1, inv2}, now)
+
+		assert.Equal(t, []PaymentAllocation{
+			{PaymentID: 5, InvoiceID: 1, Amount: 1000},
+			{PaymentID: 5, InvoiceID: 2, Amount: 800},
+		}, allocations)
+		assert.Equal(t, InvoiceStatusPaid, inv1.Status)
+		assert.Equal(t, InvoiceStatusPaid, inv2.Status)
+		assert.Equal(t, 200, p.UnallocatedAmount())
+	})
+
+	t.Run("PartialPayment", func(t *testing.T) {
+		inv := &Invoice{ID: 1, Status: InvoiceStatusIssued, TotalAmount: 1000}
+		p := &Payment{ID: 5, Amount: 300}
+
+		allocations := p.Allocate([]*Invoice{inv}, now)
+
+		assert.Len(t, allocations, 1)
+		assert.Equal(t, InvoiceStatusIssued, inv.Status)
+		assert.Equal(t, 700, inv.OutstandingAmount())
+		assert.Equal(t, 0, p.UnallocatedAmount())
+	})
+
+	t.Run("SkipsSettledInvoices", func(t *testing.T) {
+		paid := &Invoice{ID: 1, Status: InvoiceStatusPaid, TotalAmount: 1000, PaidAmount: 1000}
+		p := &Payment{ID: 5, Amount: 300}
+
+		assert.Empty(t, p.Allocate([]*Invoice{paid}, now))
+		assert.Equal(t, 300, p.UnallocatedAmount())
+	})
+}
+
+func TestPaymentMethod_IsValid(t *testing.T) {
+	assert.True(t, PaymentMethodCash.IsValid())
+	assert.True(t, PaymentMethodBankTransfer.IsValid())
+	assert.False(t, PaymentMethod("cheque").IsValid())
+}
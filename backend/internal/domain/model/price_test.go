@@ -43,3 +43,9 @@ func TestBidPrice_LessThan(t *testing.T) {
 	assert.False(t, p2.LessThan(p1))
 	assert.False(t, p1.LessThan(p1))
 }
+
+func TestAuctionItem_MinimumNextBid(t *testing.T) {
+	highest := NewBidPrice(1000)
+	assert.Equal(t, 100, (&AuctionItem{}).MinimumNextBid().Value)
+	assert.Equal(t, 1500, (&AuctionItem{HighestBid: &highest}).MinimumNextBid().Value)
+}
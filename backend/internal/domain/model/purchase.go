@@ -11,6 +11,7 @@ type Purchase struct {
 	Unit        string
 	Price       int
 	BuyerID     int
+	FishermanID int
 	AuctionID   int
 	AuctionDate string
 	CreatedAt   time.Time
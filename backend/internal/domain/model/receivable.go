@@ -0,0 +1,175 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// BuyerBalance summarizes what a buyer has been billed, has paid and still owes.
+type BuyerBalance struct {
+	BuyerID           int
+	InvoicedAmount    int
+	PaidAmount        int
+	OutstandingAmount int
+	CreditAmount      int
+	OpenInvoices      []Invoice
+}
+
+// NewBuyerBalance builds a balance summary from the buyer's invoices and payments.
+// Draft invoices are not yet billed and are therefore ignored. Invoiced amounts are net of credit notes,
+// and any payment exceeding a credited invoice is counted as credit.
+func NewBuyerBalance(buyerID int, invoices []Invoice, payments []Payment) *BuyerBalance {
+	b := &BuyerBalance{BuyerID: buyerID, OpenInvoices: []Invoice{}}
+	for _, inv := range invoices {
+		if inv.Status == InvoiceStatusDraft {
+			continue
+		}
+		b.InvoicedAmount += inv.NetAmount()
+		b.OutstandingAmount += inv.OutstandingAmount()
+		b.CreditAmount += inv.OverpaidAmount()
+		if inv.OutstandingAmount() > 0 {
+			b.OpenInvoices = append(b.OpenInvoices, inv)
+		}
+	}
+	for _, p := range payments {
+		b.PaidAmount += p.Amount
+		b.CreditAmount += p.UnallocatedAmount()
+	}
+	return b
+}
+
+// LedgerEntryType distinguishes charges from receipts in a buyer ledger.
+type LedgerEntryType string
+
+const (
+	LedgerEntryTypeInvoice    LedgerEntryType = "invoice"
+	LedgerEntryTypeCreditNote LedgerEntryType = "credit_note"
+	LedgerEntryTypePayment    LedgerEntryType = "payment"
+)
+
+// LedgerEntry is a single line of a buyer's running account (売掛金元帳).
+// Balance is positive while the buyer owes money and negative while they hold credit.
+type LedgerEntry struct {
+	Date        time.Time
+	Type        LedgerEntryType
+	ReferenceID int
+	Description string
+	Debit       int
+	Credit      int
+	Balance     int
+}
+
+// BuildLedger merges issued invoices, credit notes and payments into a chronological ledger with a running balance.
+func BuildLedger(invoices []Invoice, creditNotes []CreditNote, payments []Payment) []LedgerEntry {
+	entries := make([]LedgerEntry, 0, len(invoices)+len(creditNotes)+len(payments))
+	for _, inv := range invoices {
+		if inv.Status == InvoiceStatusDraft || inv.IssuedAt == nil {
+			continue
+		}
+		entries = append(entries, LedgerEntry{
+			Date:        *inv.IssuedAt,
+			Type:        LedgerEntryTypeInvoice,
+			ReferenceID: inv.ID,
+			Description: "せり代金",
+			Debit:       inv.TotalAmount,
+		})
+	}
+	for _, cn := range creditNotes {
+		entries = append(entries, LedgerEntry{
+			Date:        cn.CreatedAt,
+			Type:        LedgerEntryTypeCreditNote,
+			ReferenceID: cn.ID,
+			Description: "赤伝（クレーム）",
+			Credit:      cn.TotalAmount,
+		})
+	}
+	for _, p := range payments {
+		entries = append(entries, LedgerEntry{
+			Date:        p.ReceivedAt,
+			Type:        LedgerEntryTypePayment,
+			ReferenceID: p.ID,
+			Description: string(p.Method),
+			Credit:      p.Amount,
+		})
+	}
+
+	// 同時刻の場合は請求、赤伝、入金の順に並べ、消し込みが後に来るようにする
+	order := map[LedgerEntryType]int{LedgerEntryTypeInvoice: 0, LedgerEntryTypeCreditNote: 1, LedgerEntryTypePayment: 2}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].Date.Equal(entries[j].Date) {
+			return entries[i].Date.Before(entries[j].Date)
+		}
+		if entries[i].Type != entries[j].Type {
+			return order[entries[i].Type] < order[entries[j].Type]
+		}
+		return entries[i].ReferenceID < entries[j].ReferenceID
+	})
+
+	balance := 0
+	for i := range entries {
+		balance += entries[i].Debit - entries[i].Credit
+		entries[i].Balance = balance
+	}
+	return entries
+}
+
+// AgedReceivable is one buyer's outstanding balance split into age buckets (売掛金年齢表).
+type AgedReceivable struct {
+	BuyerID    int
+	BuyerName  string
+	Current    int
+	Days31To60 int
+	Days61To90 int
+	Over90     int
+	Total      int
+}
+
+// Add places amount into the bucket matching ageDays.
+func (a *AgedReceivable) Add(amount, ageDays int) {
+	switch {
+	case ageDays <= 30:
+		a.Current += amount
+	case ageDays <= 60:
+		a.Days31To60 += amount
+	case ageDays <= 90:
+		a.Days61To90 += amount
+	default:
+		a.Over90 += amount
+	}
+	a.Total += amount
+}
+
+// AgeInDays returns the number of JST calendar days between issuedAt and asOf.
+func AgeInDays(issuedAt, asOf time.Time) int {
+	tz := NewTimeZone(LocationJST)
+	from := tz.At(issuedAt)
+	to := tz.At(asOf)
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+// BuildAgedReceivables groups open invoices by buyer and ages them as of asOf.
+func BuildAgedReceivables(invoices []Invoice, asOf time.Time) []AgedReceivable {
+	byBuyer := make(map[int]*AgedReceivable)
+	var order []int
+	for _, inv := range invoices {
+		outstanding := inv.OutstandingAmount()
+		if outstanding == 0 || inv.IssuedAt == nil {
+			continue
+		}
+		ar, ok := byBuyer[inv.BuyerID]
+		if !ok {
+			ar = &AgedReceivable{BuyerID: inv.BuyerID, BuyerName: inv.BuyerName}
+			byBuyer[inv.BuyerID] = ar
+			order = append(order, inv.BuyerID)
+		}
+		ar.Add(outstanding, AgeInDays(*inv.IssuedAt, asOf))
+	}
+
+	result := make([]AgedReceivable, 0, len(order))
+	for _, id := range order {
+		result = append(result, *byBuyer[id])
+	}
+	return result
+}
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildLedger(t *testing.T) {
+	jst := NewTimeZone(LocationJST).Location()
+	day1 := time.Date(2024, 1, 1, 9, 0, 0, 0, jst)
+	day2 := time.Date(2024, 1, 2, 9, 0, 0, 0, jst)
+	day3 := time.Date(2024, 1, 3, 9, 0, 0, 0, jst)
+
+	invoices := []Invoice{
+		{ID: 1, Status: InvoiceStatusPaid, TotalAmount: 1080, IssuedAt: &day1},
+		{ID: 2, Status: InvoiceStatusIssued, TotalAmount: 2160, IssuedAt: &day3},
+		{ID: 3, Status: InvoiceStatusDraft, TotalAmount: 5000},
+	}
+	payments := []Payment{
+		{ID: 10, Method: PaymentMethodCash, Amount: 1500, ReceivedAt: day2},
+	}
+
+	creditNotes := []CreditNote{
+		{ID: 20, TotalAmount: 540, CreatedAt: day3},
+	}
+
+	entries := BuildLedger(invoices, creditNotes, payments)
+
+	assert.Len(t, entries, 4)
+	assert.Equal(t, LedgerEntryTypeInvoice, entries[0].Type)
+	assert.Equal(t, 1080, entries[0].Balance)
+	assert.Equal(t, LedgerEntryTypePayment, entries[1].Type)
+	assert.Equal(t, -420, entries[1].Balance)
+	assert.Equal(t, 2, entries[2].ReferenceID)
+	assert.Equal(t, 1740, entries[2].Balance)
+	assert.Equal(t, LedgerEntryTypeCreditNote, entries[3].Type)
+	assert.Equal(t, 1200, entries[3].Balance)
+}
+
+func TestNewBuyerBalance(t *testing.T) {
+	issued := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	invoices := []Invoice{
+		{ID: 1, Status: InvoiceStatusPaid, TotalAmount: 1000, PaidAmount: 1000, IssuedAt: &issued},
+		{ID: 2, Status: InvoiceStatusIssued, TotalAmount: 2000, PaidAmount: 500, IssuedAt: &issued},
+		{ID: 3, Status: InvoiceStatusDraft, TotalAmount: 9999},
+	}
+	payments := []Payment{
+		{ID: 1, Amount: 1000, AllocatedAmount: 1000},
+		{ID: 2, Amount: 800, AllocatedAmount: 500},
+	}
+
+	b := NewBuyerBalance(1, invoices, payments)
+
+	assert.Equal(t, 3000, b.InvoicedAmount)
+	assert.Equal(t, 1800, b.PaidAmount)
+	assert.Equal(t, 1500, b.OutstandingAmount)
+	assert.Equal(t, 300, b.CreditAmount)
+	assert.Len(t, b.OpenInvoices, 1)
+	assert.Equal(t, 2, b.OpenInvoices[0].ID)
+}
+
+func TestNewBuyerBalance_CreditedAfterPayment(t *testing.T) {
+	issued := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	invoices := []Invoice{
+		{ID: 1, Status: InvoiceStatusPaid, TotalAmount: 1000, CreditedAmount: 300, PaidAmount: 1000, IssuedAt: &issued},
+	}
+	payments := []Payment{
+		{ID: 1, Amount: 1000, AllocatedAmount: 1000},
+	}
+
+	b := NewBuyerBalance(1, invoices, payments)
+
+	assert.Equal(t, 700, b.InvoicedAmount)
+	assert.Equal(t, 0, b.OutstandingAmount)
+	assert.Equal(t, 300, b.CreditAmount)
+	assert.Empty(t, b.OpenInvoices)
+}
+
+func TestAgeInDays(t *testing.T) {
+	jst := NewTimeZone(LocationJST).Location()
+
+	tests := []struct {
+		name   string
+		issued time.Time
+		asOf   time.Time
+		want   int
+	}{
+		{"SameDay", time.Date(2024, 1, 1, 5, 0, 0, 0, jst), time.Date(2024, 1, 1, 23, 0, 0, 0, jst), 0},
+		{"CrossesJSTMidnight", time.Date(2024, 1, 1, 23, 0, 0, 0, jst), time.Date(2024, 1, 2, 1, 0, 0, 0, jst), 1},
+		{"UTCInputUsesJSTDate", time.Date(2023, 12, 31, 16, 0, 0, 0, time.UTC), time.Date(2024, 1, 31, 0, 0, 0, 0, jst), 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AgeInDays(tt.issued, tt.asOf))
+		})
+	}
+}
+
+func TestBuildAgedReceivables(t *testing.T) {
+	asOf := time.Date(2024, 4, 30, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(n int) *time.Time {
+		t := asOf.AddDate(0, 0, -n)
+		return &t
+	}
+
+	invoices := []Invoice{
+		{BuyerID: 1, BuyerName: "A", Status: InvoiceStatusIssued, TotalAmount: 100, IssuedAt: daysAgo(10)},
+		{BuyerID: 1, BuyerName: "A", Status: InvoiceStatusIssued, TotalAmount: 200, IssuedAt: daysAgo(45)},
+		{BuyerID: 2, BuyerName: "B", Status: InvoiceStatusIssued, TotalAmount: 300, PaidAmount: 100, IssuedAt: daysAgo(75)},
+		{BuyerID: 2, BuyerName: "B", Status: InvoiceStatusIssued, TotalAmount: 400, IssuedAt: daysAgo(120)},
+		{BuyerID: 3, BuyerName: "C", Status: InvoiceStatusPaid, TotalAmount: 500, PaidAmount: 500, IssuedAt: daysAgo(5)},
+	}
+
+	got := BuildAgedReceivables(invoices, asOf)
+
+	assert.Equal(t, []AgedReceivable{
+		{BuyerID: 1, BuyerName: "A", Current: 100, Days31To60: 200, Total: 300},
+		{BuyerID: 2, BuyerName: "B", Days61To90: 200, Over90: 400, Total: 600},
+	}, got)
+}
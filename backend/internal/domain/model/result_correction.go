@@ -0,0 +1,149 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// ResultCorrectionStatus represents the review state of a result correction.
+type ResultCorrectionStatus string
+
+const (
+	ResultCorrectionStatusPending  ResultCorrectionStatus = "pending"
+	ResultCorrectionStatusApproved ResultCorrectionStatus = "approved"
+	ResultCorrectionStatusRejected ResultCorrectionStatus = "rejected"
+)
+
+// IsValid reports whether the correction status is supported.
+func (s ResultCorrectionStatus) IsValid() bool {
+	switch s {
+	case ResultCorrectionStatusPending, ResultCorrectionStatusApproved, ResultCorrectionStatusRejected:
+		return true
+	}
+	return false
+}
+
+// ResultCorrection represents a request to change the buyer or price of an awarded lot.
+// The old values are captured when the request is made so reviewers see exactly what changes.
+type ResultCorrection struct {
+	ID          int
+	PurchaseID  int
+	ItemID      int
+	AuctionID   int
+	FishType    string
+	OldBuyerID  int
+	OldPrice    int
+	NewBuyerID  int
+	NewPrice    int
+	Reason      string
+	Status      ResultCorrectionStatus
+	RequestedBy int
+	ReviewedBy  *int
+	ReviewNote  string
+	ReviewedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	CreditNote  *CreditNote
+}
+
+// NewResultCorrection validates and builds a pending correction of an award.
+// 落札者・落札額のどちらも変わらない訂正は受け付けない。
+func NewResultCorrection(award *Purchase, newBuyerID, newPrice int, reason string, adminID int) (*ResultCorrection, error) {
+	if newBuyerID <= 0 {
+		return nil, &domainErrors.ValidationError{Field: "buyer_id", Message: "is required"}
+	}
+	if newPrice <= 0 {
+		return nil, &domainErrors.ValidationError{Field: "price", Message: "must be greater than 0"}
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, &domainErrors.ValidationError{Field: "reason", Message: "is required"}
+	}
+	if newBuyerID == award.BuyerID && newPrice == award.Price {
+		return nil, &domainErrors.ValidationError{Field: "price", Message: "the correction does not change the result"}
+	}
+
+	return &ResultCorrection{
+		PurchaseID:  award.ID,
+		ItemID:      award.ItemID,
+		AuctionID:   award.AuctionID,
+		FishType:    award.FishType,
+		OldBuyerID:  award.BuyerID,
+		OldPrice:    award.Price,
+		NewBuyerID:  newBuyerID,
+		NewPrice:    newPrice,
+		Reason:      reason,
+		Status:      ResultCorrectionStatusPending,
+		RequestedBy: adminID,
+	}, nil
+}
+
+// Approve accepts a pending correction. The approver must differ from the requester.
+func (c *ResultCorrection) Approve(adminID int, note string, at time.Time) error {
+	if c.Status != ResultCorrectionStatusPending {
+		return &domainErrors.ConflictError{Message: "correction has already been reviewed"}
+	}
+	if adminID == c.RequestedBy {
+		return &domainErrors.ForbiddenError{Message: "a correction must be approved by a different admin than the one who requested it"}
+	}
+
+	c.Status = ResultCorrectionStatusApproved
+	c.review(adminID, note, at)
+	return nil
+}
+
+// Reject declines a pending correction. The requester may reject their own request to withdraw it.
+func (c *ResultCorrection) Reject(adminID int, note string, at time.Time) error {
+	if c.Status != ResultCorrectionStatusPending {
+		return &domainErrors.ConflictError{Message: "correction has already been reviewed"}
+	}
+	if strings.TrimSpace(note) == "" {
+		return &domainErrors.ValidationError{Field: "note", Message: "is required"}
+	}
+
+	c.Status = ResultCorrectionStatusRejected
+	c.review(adminID, note, at)
+	return nil
+}
+
+func (c *ResultCorrection) review(adminID int, note string, at time.Time) {
+	c.ReviewNote = strings.TrimSpace(note)
+	c.ReviewedBy = &adminID
+	c.ReviewedAt = &at
+}
+
+// BuyerChanged reports whether the correction moves the lot to another buyer.
+func (c *ResultCorrection) BuyerChanged() bool {
+	return c.NewBuyerID != c.OldBuyerID
+}
+
+// CreditAmount returns the tax-exclusive amount to credit on the original buyer's issued invoice.
+// 落札者が変わる場合は元の落札額の全額、同じ買受人の減額訂正は差額を赤伝にする。増額は赤伝にならない。
+func (c *ResultCorrection) CreditAmount() int {
+	if c.BuyerChanged() {
+		return c.OldPrice
+	}
+	if c.NewPrice < c.OldPrice {
+		return c.OldPrice - c.NewPrice
+	}
+	return 0
+}
+
+// NewCorrectionCreditNote builds the credit note for an approved correction at the given tax rate.
+func NewCorrectionCreditNote(c *ResultCorrection, invoiceID, taxRate int) *CreditNote {
+	amount := c.CreditAmount()
+	tax := CalculateTax(amount, taxRate)
+	correctionID := c.ID
+	return &CreditNote{
+		CorrectionID: &correctionID,
+		InvoiceID:    invoiceID,
+		BuyerID:      c.OldBuyerID,
+		Amount:       amount,
+		TaxRate:      taxRate,
+		TaxAmount:    tax,
+		TotalAmount:  amount + tax,
+		IssuedBy:     c.ReviewedBy,
+	}
+}
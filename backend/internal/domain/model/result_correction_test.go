@@ -0,0 +1,102 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewResultCorrection(t *testing.T) {
+	award := &Purchase{ID: 1, ItemID: 10, BuyerID: 2, AuctionID: 4, FishType: "マグロ", Price: 5000}
+
+	tests := []struct {
+		name      string
+		buyerID   int
+		price     int
+		reason    string
+		wantField string
+	}{
+		{name: "ChangeBuyer", buyerID: 3, price: 5000, reason: "札の読み違い"},
+		{name: "ChangePrice", buyerID: 2, price: 4500, reason: "入力ミス"},
+		{name: "NoChange", buyerID: 2, price: 5000, reason: "入力ミス", wantField: "price"},
+		{name: "ZeroPrice", buyerID: 2, price: 0, reason: "入力ミス", wantField: "price"},
+		{name: "MissingBuyer", price: 4500, reason: "入力ミス", wantField: "buyer_id"},
+		{name: "BlankReason", buyerID: 3, price: 5000, reason: " ", wantField: "reason"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewResultCorrection(award, tt.buyerID, tt.price, tt.reason, 7)
+			if tt.wantField != "" {
+				var vErr *domainErrors.ValidationError
+				if assert.ErrorAs(t, err, &vErr) {
+					assert.Equal(t, tt.wantField, vErr.Field)
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, ResultCorrectionStatusPending, c.Status)
+			assert.Equal(t, 2, c.OldBuyerID)
+			assert.Equal(t, 5000, c.OldPrice)
+			assert.Equal(t, 7, c.RequestedBy)
+		})
+	}
+}
+
+func TestResultCorrection_Approve(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("SecondAdmin", func(t *testing.T) {
+		c := &ResultCorrection{Status: ResultCorrectionStatusPending, RequestedBy: 7}
+		assert.NoError(t, c.Approve(8, " ok ", now))
+		assert.Equal(t, ResultCorrectionStatusApproved, c.Status)
+		assert.Equal(t, 8, *c.ReviewedBy)
+		assert.Equal(t, "ok", c.ReviewNote)
+	})
+
+	t.Run("SameAdmin", func(t *testing.T) {
+		c := &ResultCorrection{Status: ResultCorrectionStatusPending, RequestedBy: 7}
+		var fErr *domainErrors.ForbiddenError
+		assert.ErrorAs(t, c.Approve(7, "", now), &fErr)
+		assert.Equal(t, ResultCorrectionStatusPending, c.Status)
+	})
+
+	t.Run("AlreadyReviewed", func(t *testing.T) {
+		c := &ResultCorrection{Status: ResultCorrectionStatusRejected, RequestedBy: 7}
+		var cErr *domainErrors.ConflictError
+		assert.ErrorAs(t, c.Approve(8, "", now), &cErr)
+	})
+}
+
+func TestResultCorrection_Reject(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	c := &ResultCorrection{Status: ResultCorrectionStatusPending, RequestedBy: 7}
+	var vErr *domainErrors.ValidationError
+	assert.ErrorAs(t, c.Reject(7, "", now), &vErr)
+
+	assert.NoError(t, c.Reject(7, "取り下げ", now))
+	assert.Equal(t, ResultCorrectionStatusRejected, c.Status)
+}
+
+func TestResultCorrection_CreditAmount(t *testing.T) {
+	assert.Equal(t, 5000, (&ResultCorrection{OldBuyerID: 2, OldPrice: 5000, NewBuyerID: 3, NewPrice: 6000}).CreditAmount())
+	assert.Equal(t, 500, (&ResultCorrection{OldBuyerID: 2, OldPrice: 5000, NewBuyerID: 2, NewPrice: 4500}).CreditAmount())
+	assert.Equal(t, 0, (&ResultCorrection{OldBuyerID: 2, OldPrice: 5000, NewBuyerID: 2, NewPrice: 5500}).CreditAmount())
+}
+
+func TestNewCorrectionCreditNote(t *testing.T) {
+	admin := 8
+	c := &ResultCorrection{ID: 3, OldBuyerID: 2, OldPrice: 5000, NewBuyerID: 2, NewPrice: 4000, ReviewedBy: &admin}
+
+	cn := NewCorrectionCreditNote(c, 9, TaxRateReduced)
+
+	assert.Nil(t, cn.ClaimID)
+	assert.Equal(t, 3, *cn.CorrectionID)
+	assert.Equal(t, 2, cn.BuyerID)
+	assert.Equal(t, 1000, cn.Amount)
+	assert.Equal(t, 1080, cn.TotalAmount)
+	assert.Equal(t, &admin, cn.IssuedBy)
+}
@@ -0,0 +1,168 @@
+package model
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// MaxResultEntries is the largest number of rows accepted in one result batch.
+const MaxResultEntries = 1000
+
+// resultCSVColumns are the numeric columns of a result CSV, in any order.
+// buyer_id may be left out when the file has a paddle_number column instead.
+var resultCSVColumns = []string{"lot_number", "buyer_id", "price"}
+
+// ResultEntry is one price tag (値札) typed in after a paper seri.
+// Row is the position in the submitted batch (the line number for CSV uploads) and is used to report errors.
+// The winning buyer is given either by BuyerID or by the PaddleNumber written on the tag.
+type ResultEntry struct {
+	Row          int
+	LotNumber    int
+	BuyerID      int
+	PaddleNumber string
+	Price        int
+}
+
+// Validate returns the problems with the row that can be found without looking anything up.
+// A BuyerID of 0 means the row did not give one, so a row naming no buyer at all is reported as such.
+func (e ResultEntry) Validate() []ResultEntryError {
+	var errs []ResultEntryError
+	if e.LotNumber <= 0 {
+		errs = append(errs, ResultEntryError{Row: e.Row, Field: "lot_number", Message: "must be positive"})
+	}
+	switch {
+	case e.BuyerID < 0:
+		errs = append(errs, ResultEntryError{Row: e.Row, Field: "buyer_id", Message: "must be positive"})
+	case e.BuyerID == 0 && e.PaddleNumber == "":
+		errs = append(errs, errMissingResultBuyer(e.Row))
+	}
+	if e.Price <= 0 {
+		errs = append(errs, ResultEntryError{Row: e.Row, Field: "price", Message: "must be positive"})
+	}
+	return errs
+}
+
+func errMissingResultBuyer(row int) ResultEntryError {
+	return ResultEntryError{Row: row, Field: "buyer", Message: "buyer_id or paddle_number is required"}
+}
+
+// ResultEntryError describes why a row of a result batch was rejected.
+type ResultEntryError struct {
+	Row     int
+	Field   string
+	Message string
+}
+
+// ResultBatchError is returned when any row of a result batch is invalid.
+// The batch is all-or-nothing, so none of its rows have been recorded.
+type ResultBatchError struct {
+	Rows []ResultEntryError
+}
+
+func (e *ResultBatchError) Error() string {
+	return fmt.Sprintf("%d row(s) of the result batch are invalid", len(e.Rows))
+}
+
+// ParseResultCSV reads result rows from a CSV with a lot_number,buyer_id,price header.
+// A paddle_number column may name the buyer instead of buyer_id; a row may fill in either.
+// 表計算ソフトで作られた CSV を想定し、UTF-8 の BOM と空行は読み飛ばす。
+func ParseResultCSV(r io.Reader) ([]ResultEntry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, &ResultBatchError{Rows: []ResultEntryError{{Row: 1, Field: "header", Message: "the file is empty"}}}
+	}
+	if err != nil {
+		return nil, &ResultBatchError{Rows: []ResultEntryError{{Row: 1, Field: "header", Message: err.Error()}}}
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	_, hasPaddle := index["paddle_number"]
+	for _, name := range resultCSVColumns {
+		if _, ok := index[name]; !ok && (name != "buyer_id" || !hasPaddle) {
+			return nil, &ResultBatchError{Rows: []ResultEntryError{{Row: 1, Field: "header", Message: "missing column " + name}}}
+		}
+	}
+
+	var entries []ResultEntry
+	var errs []ResultEntryError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			errs = append(errs, ResultEntryError{Row: parseErr.Line, Field: "row", Message: parseErr.Err.Error()})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		entry := ResultEntry{Row: line}
+		buyerBlank := true
+		if i, ok := index["paddle_number"]; ok && i < len(record) {
+			entry.PaddleNumber = strings.TrimSpace(record[i])
+		}
+		fields := map[string]*int{"lot_number": &entry.LotNumber, "buyer_id": &entry.BuyerID, "price": &entry.Price}
+		for _, name := range resultCSVColumns {
+			i, ok := index[name]
+			if !ok {
+				continue
+			}
+			var cell string
+			if i < len(record) {
+				cell = strings.ReplaceAll(strings.TrimSpace(record[i]), ",", "")
+			}
+			// paddle_number 列があるファイルでは buyer_id を空欄にしてよい（どちらも空欄なら下で弾く）。
+			if name == "buyer_id" && cell == "" && hasPaddle {
+				continue
+			}
+			if i >= len(record) {
+				errs = append(errs, ResultEntryError{Row: line, Field: name, Message: "is missing"})
+				continue
+			}
+			v, convErr := strconv.Atoi(cell)
+			if convErr != nil {
+				errs = append(errs, ResultEntryError{Row: line, Field: name, Message: "must be a whole number"})
+				continue
+			}
+			// 0 を書いた buyer_id は未入力と区別できなくなるため、ここで弾く。
+			if name == "buyer_id" {
+				buyerBlank = false
+				if v <= 0 {
+					errs = append(errs, ResultEntryError{Row: line, Field: name, Message: "must be positive"})
+					continue
+				}
+			}
+			*fields[name] = v
+		}
+		if hasPaddle && buyerBlank && entry.PaddleNumber == "" {
+			errs = append(errs, errMissingResultBuyer(line))
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(errs) > 0 {
+		return nil, &ResultBatchError{Rows: errs}
+	}
+	return entries, nil
+}
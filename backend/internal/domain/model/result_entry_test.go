@@ -0,0 +1,97 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResultCSV(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		input := "\xef\xbb\xbfprice,lot_number,buyer_id\n" +
+			"\"1,500\",1,3\n" +
+			"\n" +
+			"2800, 2, 4\n"
+
+		got, err := ParseResultCSV(strings.NewReader(input))
+		require.NoError(t, err)
+		assert.Equal(t, []ResultEntry{
+			{Row: 2, LotNumber: 1, BuyerID: 3, Price: 1500},
+			{Row: 4, LotNumber: 2, BuyerID: 4, Price: 2800},
+		}, got)
+	})
+
+	t.Run("RowErrors", func(t *testing.T) {
+		input := "lot_number,buyer_id,price\n" +
+			"1,3,abc\n" +
+			"2\n" +
+			"3,x,100\n"
+
+		_, err := ParseResultCSV(strings.NewReader(input))
+		var batchErr *ResultBatchError
+		require.ErrorAs(t, err, &batchErr)
+		assert.Equal(t, []ResultEntryError{
+			{Row: 2, Field: "price", Message: "must be a whole number"},
+			{Row: 3, Field: "buyer_id", Message: "is missing"},
+			{Row: 3, Field: "price", Message: "is missing"},
+			{Row: 4, Field: "buyer_id", Message: "must be a whole number"},
+		}, batchErr.Rows)
+	})
+
+	t.Run("PaddleNumber", func(t *testing.T) {
+		input := "lot_number,paddle_number,price\n" +
+			"1, a-12 ,1500\n" +
+			"2,,800\n"
+
+		_, err := ParseResultCSV(strings.NewReader(input))
+		var batchErr *ResultBatchError
+		require.ErrorAs(t, err, &batchErr)
+		assert.Equal(t, []ResultEntryError{{Row: 3, Field: "buyer", Message: "buyer_id or paddle_number is required"}}, batchErr.Rows)
+
+		input = "lot_number,buyer_id,paddle_number,price\n" +
+			"1,,,1500\n" +
+			"2,0,A-12,800\n"
+		_, err = ParseResultCSV(strings.NewReader(input))
+		require.ErrorAs(t, err, &batchErr)
+		assert.Equal(t, []ResultEntryError{
+			{Row: 2, Field: "buyer", Message: "buyer_id or paddle_number is required"},
+			{Row: 3, Field: "buyer_id", Message: "must be positive"},
+		}, batchErr.Rows)
+
+		got, err := ParseResultCSV(strings.NewReader("lot_number,buyer_id,paddle_number,price\n1,,A-12,1500\n2,4,,800\n"))
+		require.NoError(t, err)
+		assert.Equal(t, []ResultEntry{
+			{Row: 2, LotNumber: 1, PaddleNumber: "A-12", Price: 1500},
+			{Row: 3, LotNumber: 2, BuyerID: 4, Price: 800},
+		}, got)
+	})
+
+	t.Run("MissingColumn", func(t *testing.T) {
+		_, err := ParseResultCSV(strings.NewReader("lot_number,price\n1,100\n"))
+		var batchErr *ResultBatchError
+		require.ErrorAs(t, err, &batchErr)
+		assert.Equal(t, "missing column buyer_id", batchErr.Rows[0].Message)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		_, err := ParseResultCSV(strings.NewReader(""))
+		var batchErr *ResultBatchError
+		require.ErrorAs(t, err, &batchErr)
+	})
+}
+
+func TestResultEntry_Validate(t *testing.T) {
+	assert.Empty(t, ResultEntry{Row: 1, LotNumber: 1, BuyerID: 1, Price: 100}.Validate())
+
+	assert.Empty(t, ResultEntry{Row: 1, LotNumber: 1, PaddleNumber: "A-12", Price: 100}.Validate())
+
+	errs := ResultEntry{Row: 5}.Validate()
+	assert.Len(t, errs, 3)
+	assert.Equal(t, 5, errs[0].Row)
+	assert.Equal(t, ResultEntryError{Row: 5, Field: "buyer", Message: "buyer_id or paddle_number is required"}, errs[1])
+
+	errs = ResultEntry{Row: 6, LotNumber: 1, BuyerID: -1, PaddleNumber: "A-12", Price: 100}.Validate()
+	assert.Equal(t, []ResultEntryError{{Row: 6, Field: "buyer_id", Message: "must be positive"}}, errs)
+}
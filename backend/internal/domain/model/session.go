@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
 
 // SessionRole provides SessionRole related functionality.
 type SessionRole string
@@ -10,12 +14,49 @@ const (
 	SessionRoleAdmin SessionRole = "admin"
 	// SessionRoleBuyer provides SessionRoleBuyer related functionality.
 	SessionRoleBuyer SessionRole = "buyer"
+	// SessionRoleFisherman is the role of a fisherman logged in to the consignor portal.
+	SessionRoleFisherman SessionRole = "fisherman"
 )
 
+// sessionPublicIDLen is the number of hex characters of a session's public ID.
+const sessionPublicIDLen = 16
+
 // Session provides Session related functionality.
 type Session struct {
-	ID        string
-	UserID    int
+	ID     string
+	UserID int
+	// LoginID is the individual login (authentications.id) of a buyer session; it is 0 for other roles.
+	LoginID int
+	// AdminRole is the role of an admin session, fixed at login; it is empty for other roles.
+	AdminRole AdminRole
 	Role      SessionRole
 	CreatedAt time.Time
+	// LastSeenAt is when the session was last used, recorded at most once a minute.
+	LastSeenAt time.Time
+	// IPAddress and UserAgent describe the client of the most recent request.
+	IPAddress string
+	UserAgent string
+}
+
+// PublicID returns an identifier of the session that is safe to show to the user.
+// セッション ID は Cookie の値そのものなので、一覧や取り消しの API ではハッシュから作った別の ID を使う。
+func (s *Session) PublicID() string {
+	sum := sha256.Sum256([]byte(s.ID))
+	return hex.EncodeToString(sum[:])[:sessionPublicIDLen]
+}
+
+// SessionOwner identifies whose sessions a listing or revocation covers.
+type SessionOwner struct {
+	Role   SessionRole
+	UserID int
+	// LoginID narrows a buyer's sessions to one login; 0 covers every login of the user.
+	LoginID int
+}
+
+// Owns reports whether the session belongs to the owner.
+func (o SessionOwner) Owns(s *Session) bool {
+	if s == nil || s.Role != o.Role || s.UserID != o.UserID {
+		return false
+	}
+	return o.LoginID == 0 || s.LoginID == o.LoginID
 }
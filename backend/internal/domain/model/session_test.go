@@ -0,0 +1,38 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSession_PublicID(t *testing.T) {
+	s := &Session{ID: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"}
+
+	assert.Len(t, s.PublicID(), 16)
+	assert.Equal(t, s.PublicID(), (&Session{ID: s.ID}).PublicID())
+	assert.NotContains(t, s.ID, s.PublicID())
+	assert.NotEqual(t, s.PublicID(), (&Session{ID: "another"}).PublicID())
+}
+
+func TestSessionOwner_Owns(t *testing.T) {
+	session := &Session{UserID: 1, LoginID: 10, Role: SessionRoleBuyer}
+
+	tests := []struct {
+		name  string
+		owner SessionOwner
+		want  bool
+	}{
+		{name: "SameLogin", owner: SessionOwner{Role: SessionRoleBuyer, UserID: 1, LoginID: 10}, want: true},
+		{name: "AnyLogin", owner: SessionOwner{Role: SessionRoleBuyer, UserID: 1}, want: true},
+		{name: "OtherLogin", owner: SessionOwner{Role: SessionRoleBuyer, UserID: 1, LoginID: 11}},
+		{name: "OtherUser", owner: SessionOwner{Role: SessionRoleBuyer, UserID: 2}},
+		{name: "OtherRole", owner: SessionOwner{Role: SessionRoleAdmin, UserID: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.owner.Owns(session))
+		})
+	}
+	assert.False(t, SessionOwner{Role: SessionRoleBuyer, UserID: 1}.Owns(nil))
+}
@@ -0,0 +1,100 @@
+package model
+
+import "time"
+
+// SettlementStatus represents the lifecycle state of a fisherman settlement (仕切書).
+type SettlementStatus string
+
+const (
+	SettlementStatusDraft   SettlementStatus = "draft"
+	SettlementStatusSettled SettlementStatus = "settled"
+)
+
+// SettlementLine represents a single sold lot on a settlement.
+type SettlementLine struct {
+	ID           int
+	SettlementID int
+	ItemID       *int
+	Description  string
+	Quantity     int
+	Unit         string
+	Amount       int
+}
+
+// Settlement represents the payout to a fisherman for the lots sold in a single auction.
+// 売上（落札額の合計）から委託手数料とその消費税を差し引いた額が支払額となる。
+type Settlement struct {
+	ID               int
+	FishermanID      int
+	FishermanName    string
+	AuctionID        int
+	Lines            []SettlementLine
+	GrossAmount      int
+	CommissionRate   int
+	CommissionAmount int
+	CommissionTax    int
+	NetAmount        int
+	Status           SettlementStatus
+	SettledAt        *time.Time
+	// ExportedAt is when the statement was last written to a Zengin transfer file.
+	ExportedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Recalculate derives the gross, commission and net amounts from the lines and commission rate.
+func (s *Settlement) Recalculate() {
+	gross := 0
+	for _, l := range s.Lines {
+		gross += l.Amount
+	}
+	s.GrossAmount = gross
+	s.CommissionAmount = gross * s.CommissionRate / 100
+	s.CommissionTax = CalculateTax(s.CommissionAmount, TaxRateStandard)
+	s.NetAmount = gross - s.CommissionAmount - s.CommissionTax
+}
+
+// ApplyAdjustments deducts pending clawbacks as negative lines and returns the IDs of those applied.
+// 控除後の売上が負にならない分だけを取り込み、残りは次回以降の仕切に持ち越す。
+func (s *Settlement) ApplyAdjustments(adjustments []SettlementAdjustment) []int {
+	var applied []int
+	for _, a := range adjustments {
+		if a.FishermanID != s.FishermanID || a.SettlementID != nil {
+			continue
+		}
+		if s.GrossAmount-a.Amount < 0 {
+			continue
+		}
+		s.Lines = append(s.Lines, SettlementLine{Description: a.Description, Quantity: 1, Amount: -a.Amount})
+		s.Recalculate()
+		applied = append(applied, a.ID)
+	}
+	return applied
+}
+
+// Settle finalizes a draft settlement so it can be paid out.
+func (s *Settlement) Settle(at time.Time) bool {
+	if s.Status != SettlementStatusDraft {
+		return false
+	}
+	s.Status = SettlementStatusSettled
+	s.SettledAt = &at
+	return true
+}
+
+// IsExported reports whether the statement has already been written to a transfer file.
+func (s *Settlement) IsExported() bool {
+	return s.ExportedAt != nil
+}
+
+// SettlementAdjustment is an amount clawed back from a fisherman for an approved claim.
+// It stays pending until it is deducted on one of the fisherman's settlements.
+type SettlementAdjustment struct {
+	ID           int
+	FishermanID  int
+	CreditNoteID int
+	Amount       int
+	Description  string
+	SettlementID *int
+	CreatedAt    time.Time
+}
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettlement_Recalculate(t *testing.T) {
+	s := &Settlement{
+		CommissionRate: 5,
+		Lines: []SettlementLine{
+			{Amount: 12345},
+			{Amount: 8000},
+		},
+	}
+
+	s.Recalculate()
+
+	assert.Equal(t, 20345, s.GrossAmount)
+	assert.Equal(t, 1017, s.CommissionAmount)
+	assert.Equal(t, 101, s.CommissionTax)
+	assert.Equal(t, 19227, s.NetAmount)
+}
+
+func TestSettlement_Settle(t *testing.T) {
+	now := time.Now()
+	s := &Settlement{Status: SettlementStatusDraft}
+
+	assert.True(t, s.Settle(now))
+	assert.Equal(t, SettlementStatusSettled, s.Status)
+	assert.Equal(t, &now, s.SettledAt)
+	assert.False(t, s.Settle(now))
+}
+
+func TestSettlement_ApplyAdjustments(t *testing.T) {
+	s := &Settlement{FishermanID: 3, CommissionRate: 5, Lines: []SettlementLine{{Amount: 10000}}}
+	s.Recalculate()
+
+	applied := s.ApplyAdjustments([]SettlementAdjustment{
+		{ID: 1, FishermanID: 3, Amount: 4000, Description: "クレーム控除"},
+		{ID: 2, FishermanID: 3, Amount: 7000},
+		{ID: 3, FishermanID: 9, Amount: 100},
+		{ID: 4, FishermanID: 3, Amount: 6000},
+	})
+
+	assert.Equal(t, []int{1, 4}, applied)
+	assert.Len(t, s.Lines, 3)
+	assert.Equal(t, -4000, s.Lines[1].Amount)
+	assert.Equal(t, 0, s.GrossAmount)
+	assert.Equal(t, 0, s.NetAmount)
+}
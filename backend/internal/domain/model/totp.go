@@ -0,0 +1,166 @@
+package model
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 の既定で、認証アプリが対応するのは SHA-1 のみ
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// TOTPDigits is the number of digits in a code.
+	TOTPDigits = 6
+	// TOTPPeriod is the time step of a code.
+	TOTPPeriod = 30 * time.Second
+	// TOTPIssuer is the service name shown next to the account in authenticator apps.
+	TOTPIssuer = "Fish Auction"
+
+	// totpSecretLen は RFC 4226 が推奨する 160 bit。
+	totpSecretLen = 20
+	// totpSkew は端末との時計のずれとして前後何ステップまで受け付けるか。
+	totpSkew = 1
+	// totpSealedPrefix は暗号化済みシークレットの形式バージョン。
+	totpSealedPrefix = "v1."
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a new random TOTP secret.
+func GenerateTOTPSecret() ([]byte, error) {
+	secret := make([]byte, totpSecretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return secret, nil
+}
+
+// EncodeTOTPSecret returns the base32 form of the secret that users type into an authenticator app.
+func EncodeTOTPSecret(secret []byte) string {
+	return totpEncoding.EncodeToString(secret)
+}
+
+// TOTPProvisioningURI returns the otpauth:// URI encoded into the enrollment QR code.
+func TOTPProvisioningURI(secret []byte, account string) string {
+	q := url.Values{}
+	q.Set("secret", EncodeTOTPSecret(secret))
+	q.Set("issuer", TOTPIssuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", strconv.Itoa(TOTPDigits))
+	q.Set("period", strconv.Itoa(int(TOTPPeriod/time.Second)))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + TOTPIssuer + ":" + account,
+		RawQuery: q.Encode(),
+	}
+	return u.String()
+}
+
+// TOTPStep returns the time step that t falls in.
+func TOTPStep(t time.Time) int64 {
+	return t.Unix() / int64(TOTPPeriod/time.Second)
+}
+
+// TOTPCode returns the code for the time step that t falls in.
+func TOTPCode(secret []byte, t time.Time) string {
+	return hotp(secret, uint64(TOTPStep(t)), TOTPDigits, sha1.New)
+}
+
+// VerifyTOTP checks code against the steps around now and returns the step it matched.
+// Steps at or before lastStep are rejected so that an intercepted code cannot be replayed.
+func VerifyTOTP(secret []byte, code string, now time.Time, lastStep int64) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+	current := TOTPStep(now)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastStep || step < 0 {
+			continue
+		}
+		want := hotp(secret, uint64(step), TOTPDigits, sha1.New)
+		if subtle.ConstantTimeCompare([]byte(code), []byte(want)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// hotp computes an RFC 4226 HOTP value, generalised over the hash as RFC 6238 allows.
+func hotp(secret []byte, counter uint64, digits int, h func() hash.Hash) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(h, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+// SealTOTPSecret encrypts a TOTP secret with AES-256-GCM for storage.
+// The admin ID is bound as additional data so a sealed secret cannot be copied to another admin.
+func SealTOTPSecret(key, secret []byte, adminID int) (string, error) {
+	aead, err := totpCipher(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, secret, totpAdditionalData(adminID))
+	return totpSealedPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// OpenTOTPSecret decrypts a secret sealed by SealTOTPSecret.
+func OpenTOTPSecret(key []byte, sealed string, adminID int) ([]byte, error) {
+	aead, err := totpCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := base64.RawStdEncoding.DecodeString(strings.TrimPrefix(sealed, totpSealedPrefix))
+	if err != nil || !strings.HasPrefix(sealed, totpSealedPrefix) || len(raw) < aead.NonceSize() {
+		return nil, errors.New("malformed TOTP secret")
+	}
+	nonce, ciphertext := raw[:aead.NonceSize()], raw[aead.NonceSize():]
+	secret, err := aead.Open(nil, nonce, ciphertext, totpAdditionalData(adminID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt TOTP secret: %w", err)
+	}
+	return secret, nil
+}
+
+// totpCipher は設定値の長さに依らず AES-256 を使えるよう、鍵を SHA-256 で 32 バイトに揃える。
+func totpCipher(key []byte) (cipher.AEAD, error) {
+	derived := sha256.Sum256(key)
+	block, err := aes.NewCipher(derived[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func totpAdditionalData(adminID int) []byte {
+	return []byte("admin:" + strconv.Itoa(adminID))
+}
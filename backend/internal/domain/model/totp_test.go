@@ -0,0 +1,149 @@
+package model
+
+import (
+	"crypto/sha1" //nolint:gosec // RFC 6238 のテストベクタ
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHOTP_RFC4226Vectors(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	want := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+
+	for counter, code := range want {
+		assert.Equal(t, code, hotp(secret, uint64(counter), 6, sha1.New), "counter %d", counter)
+	}
+}
+
+func TestTOTP_RFC6238Vectors(t *testing.T) {
+	keys := map[string]struct {
+		secret []byte
+		hash   func() hash.Hash
+	}{
+		"SHA1":   {[]byte("12345678901234567890"), sha1.New},
+		"SHA256": {[]byte("12345678901234567890123456789012"), sha256.New},
+		"SHA512": {[]byte("1234567890123456789012345678901234567890123456789012345678901234"), sha512.New},
+	}
+	vectors := []struct {
+		unix int64
+		want map[string]string
+	}{
+		{59, map[string]string{"SHA1": "94287082", "SHA256": "46119246", "SHA512": "90693936"}},
+		{1111111109, map[string]string{"SHA1": "07081804", "SHA256": "68084774", "SHA512": "25091201"}},
+		{1111111111, map[string]string{"SHA1": "14050471", "SHA256": "67062674", "SHA512": "99943326"}},
+		{1234567890, map[string]string{"SHA1": "89005924", "SHA256": "91819424", "SHA512": "93441116"}},
+		{2000000000, map[string]string{"SHA1": "69279037", "SHA256": "90698825", "SHA512": "38618901"}},
+		{20000000000, map[string]string{"SHA1": "65353130", "SHA256": "77737706", "SHA512": "47863826"}},
+	}
+
+	for _, v := range vectors {
+		step := uint64(TOTPStep(time.Unix(v.unix, 0)))
+		for name, key := range keys {
+			t.Run(name+"/"+strconv.FormatInt(v.unix, 10), func(t *testing.T) {
+				assert.Equal(t, v.want[name], hotp(key.secret, step, 8, key.hash))
+			})
+		}
+	}
+
+	// The 6 digit code used by authenticator apps is the low digits of the same value.
+	assert.Equal(t, "287082", TOTPCode(keys["SHA1"].secret, time.Unix(59, 0)))
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	now := time.Unix(1111111111, 0)
+	step := TOTPStep(now)
+
+	t.Run("CurrentStep", func(t *testing.T) {
+		got, ok := VerifyTOTP(secret, TOTPCode(secret, now), now, 0)
+		assert.True(t, ok)
+		assert.Equal(t, step, got)
+	})
+
+	t.Run("ClockSkewWithinOneStep", func(t *testing.T) {
+		got, ok := VerifyTOTP(secret, " "+TOTPCode(secret, now.Add(-TOTPPeriod))+" ", now, 0)
+		assert.True(t, ok)
+		assert.Equal(t, step-1, got)
+
+		_, ok = VerifyTOTP(secret, TOTPCode(secret, now.Add(TOTPPeriod)), now, 0)
+		assert.True(t, ok)
+	})
+
+	t.Run("TooOld", func(t *testing.T) {
+		_, ok := VerifyTOTP(secret, TOTPCode(secret, now.Add(-2*TOTPPeriod)), now, 0)
+		assert.False(t, ok)
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		_, ok := VerifyTOTP(secret, TOTPCode(secret, now), now, step)
+		assert.False(t, ok)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		_, ok := VerifyTOTP(secret, "12345", now, 0)
+		assert.False(t, ok)
+		_, ok = VerifyTOTP(secret, "", now, 0)
+		assert.False(t, ok)
+	})
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	u, err := url.Parse(TOTPProvisioningURI(secret, "admin@example.com"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Fish Auction:admin@example.com", u.Path)
+	assert.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", u.Query().Get("secret"))
+	assert.Equal(t, "Fish Auction", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+	assert.Equal(t, "30", u.Query().Get("period"))
+}
+
+func TestSealTOTPSecret(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	secret, err := GenerateTOTPSecret()
+	assert.NoError(t, err)
+	assert.Len(t, secret, 20)
+
+	sealed, err := SealTOTPSecret(key, secret, 1)
+	assert.NoError(t, err)
+	assert.NotContains(t, sealed, EncodeTOTPSecret(secret))
+
+	got, err := OpenTOTPSecret(key, sealed, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, secret, got)
+
+	_, err = OpenTOTPSecret([]byte("another-key-another-key-another-k"), sealed, 1)
+	assert.Error(t, err, "wrong key")
+	_, err = OpenTOTPSecret(key, sealed, 2)
+	assert.Error(t, err, "secret copied to another admin")
+	_, err = OpenTOTPSecret(key, sealed[:len(sealed)-2], 1)
+	assert.Error(t, err, "truncated")
+	_, err = OpenTOTPSecret(key, "plain", 1)
+	assert.Error(t, err, "not sealed")
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(RecoveryCodeCount)
+	assert.NoError(t, err)
+	assert.Len(t, codes, RecoveryCodeCount)
+
+	seen := map[string]bool{}
+	for _, code := range codes {
+		assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, code)
+		assert.False(t, seen[code], "duplicate code")
+		seen[code] = true
+	}
+
+	assert.Equal(t, HashRecoveryCode("abcde-fghij"), HashRecoveryCode(" ABCDE fghij "))
+	assert.NotEqual(t, HashRecoveryCode("abcde-fghij"), HashRecoveryCode("abcde-fghik"))
+}
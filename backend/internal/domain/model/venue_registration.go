@@ -0,0 +1,85 @@
+package model
+
+import (
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// VenueRegistrationStatus represents whether a buyer is licensed to bid at a venue.
+type VenueRegistrationStatus string
+
+const (
+	VenueRegistrationStatusPending   VenueRegistrationStatus = "pending"
+	VenueRegistrationStatusApproved  VenueRegistrationStatus = "approved"
+	VenueRegistrationStatusSuspended VenueRegistrationStatus = "suspended"
+)
+
+// IsValid reports whether the registration status is supported.
+func (s VenueRegistrationStatus) IsValid() bool {
+	switch s {
+	case VenueRegistrationStatusPending, VenueRegistrationStatusApproved, VenueRegistrationStatusSuspended:
+		return true
+	}
+	return false
+}
+
+// VenueRegistration represents a buyer's licence (買参権) at one venue.
+// ValidFrom and ValidUntil are calendar dates in JST and both ends are inclusive; nil means open-ended.
+type VenueRegistration struct {
+	ID         int
+	BuyerID    int
+	VenueID    int
+	Status     VenueRegistrationStatus
+	ValidFrom  *time.Time
+	ValidUntil *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Validate checks the status and that the validity period is not reversed.
+func (r *VenueRegistration) Validate() error {
+	if r.BuyerID <= 0 {
+		return &domainErrors.ValidationError{Field: "buyer_id", Message: "is required"}
+	}
+	if r.VenueID <= 0 {
+		return &domainErrors.ValidationError{Field: "venue_id", Message: "is required"}
+	}
+	if !r.Status.IsValid() {
+		return &domainErrors.ValidationError{Field: "status", Message: "must be pending, approved or suspended"}
+	}
+	if r.ValidFrom != nil && r.ValidUntil != nil && r.ValidUntil.Before(*r.ValidFrom) {
+		return &domainErrors.ValidationError{Field: "valid_until", Message: "must not be before valid_from"}
+	}
+	return nil
+}
+
+// CheckAccess reports why the registration does not let the buyer take part in the venue's auctions at now.
+// 日付の比較は JST の暦日で行う。
+func (r *VenueRegistration) CheckAccess(now time.Time) error {
+	switch r.Status {
+	case VenueRegistrationStatusApproved:
+	case VenueRegistrationStatusPending:
+		return &domainErrors.ForbiddenError{Message: "Venue registration is pending approval"}
+	default:
+		return &domainErrors.ForbiddenError{Message: "Venue registration is suspended"}
+	}
+
+	today := NewTimeZone(LocationJST).At(now).Format(time.DateOnly)
+	if r.ValidFrom != nil && today < r.ValidFrom.Format(time.DateOnly) {
+		return &domainErrors.ForbiddenError{Message: "Venue registration is not yet valid"}
+	}
+	if r.ValidUntil != nil && today > r.ValidUntil.Format(time.DateOnly) {
+		return &domainErrors.ForbiddenError{Message: "Venue registration has expired"}
+	}
+	return nil
+}
+
+// CheckVenueAccess returns a forbidden error unless the registration lets the buyer take part at now.
+// A nil registration means the buyer is not registered at the venue.
+func CheckVenueAccess(reg *VenueRegistration, now time.Time) error {
+	if reg == nil {
+		return &domainErrors.ForbiddenError{Message: "Buyer is not registered at this venue"}
+	}
+	return reg.CheckAccess(now)
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+func TestVenueRegistration_Validate(t *testing.T) {
+	from := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		reg       VenueRegistration
+		wantField string
+	}{
+		{name: "Valid", reg: VenueRegistration{BuyerID: 1, VenueID: 2, Status: VenueRegistrationStatusApproved, ValidFrom: &from, ValidUntil: &until}},
+		{name: "OpenEnded", reg: VenueRegistration{BuyerID: 1, VenueID: 2, Status: VenueRegistrationStatusPending}},
+		{name: "MissingBuyer", reg: VenueRegistration{VenueID: 2, Status: VenueRegistrationStatusPending}, wantField: "buyer_id"},
+		{name: "MissingVenue", reg: VenueRegistration{BuyerID: 1, Status: VenueRegistrationStatusPending}, wantField: "venue_id"},
+		{name: "UnknownStatus", reg: VenueRegistration{BuyerID: 1, VenueID: 2, Status: "revoked"}, wantField: "status"},
+		{
+			name:      "Reversed",
+			reg:       VenueRegistration{BuyerID: 1, VenueID: 2, Status: VenueRegistrationStatusApproved, ValidFrom: &until, ValidUntil: &from},
+			wantField: "valid_until",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.reg.Validate()
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var valErr *domainErrors.ValidationError
+			require.ErrorAs(t, err, &valErr)
+			assert.Equal(t, tt.wantField, valErr.Field)
+		})
+	}
+}
+
+func TestCheckVenueAccess(t *testing.T) {
+	jst := NewTimeZone(LocationJST).Location()
+	from := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		reg     *VenueRegistration
+		now     time.Time
+		wantErr bool
+	}{
+		{name: "NotRegistered", now: time.Date(2024, 6, 1, 5, 0, 0, 0, jst), wantErr: true},
+		{name: "Approved", reg: &VenueRegistration{Status: VenueRegistrationStatusApproved}, now: time.Date(2024, 6, 1, 5, 0, 0, 0, jst)},
+		{name: "Pending", reg: &VenueRegistration{Status: VenueRegistrationStatusPending}, now: time.Date(2024, 6, 1, 5, 0, 0, 0, jst), wantErr: true},
+		{name: "Suspended", reg: &VenueRegistration{Status: VenueRegistrationStatusSuspended}, now: time.Date(2024, 6, 1, 5, 0, 0, 0, jst), wantErr: true},
+		{
+			// 4/1 早朝の JST は UTC ではまだ 3/31 だが、JST の暦日で有効期間に入っている
+			name: "FirstDayInJST",
+			reg:  &VenueRegistration{Status: VenueRegistrationStatusApproved, ValidFrom: &from, ValidUntil: &until},
+			now:  time.Date(2024, 4, 1, 5, 0, 0, 0, jst),
+		},
+		{
+			name:    "BeforeValidFrom",
+			reg:     &VenueRegistration{Status: VenueRegistrationStatusApproved, ValidFrom: &from},
+			now:     time.Date(2024, 3, 31, 23, 59, 0, 0, jst),
+			wantErr: true,
+		},
+		{
+			name: "LastDay",
+			reg:  &VenueRegistration{Status: VenueRegistrationStatusApproved, ValidUntil: &until},
+			now:  time.Date(2025, 3, 31, 23, 59, 0, 0, jst),
+		},
+		{
+			name:    "Expired",
+			reg:     &VenueRegistration{Status: VenueRegistrationStatusApproved, ValidUntil: &until},
+			now:     time.Date(2025, 4, 1, 0, 0, 0, 0, jst),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckVenueAccess(tt.reg, tt.now)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var forbiddenErr *domainErrors.ForbiddenError
+			assert.ErrorAs(t, err, &forbiddenErr)
+		})
+	}
+}
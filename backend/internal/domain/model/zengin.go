@@ -0,0 +1,354 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/japanese"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+// 全銀協フォーマット（総合振込）のレコード長と区分。
+const (
+	ZenginRecordLength = 120
+
+	zenginRecordHeader  = '1'
+	zenginRecordData    = '2'
+	zenginRecordTrailer = '8'
+	zenginRecordEnd     = '9'
+
+	zenginKindGeneralTransfer = "21" // 種別コード: 総合振込
+	zenginCodeTypeSJIS        = "0"  // コード区分: SJIS
+	zenginTransferTypeTeleEx  = "7"  // 振込指定区分: テレ振込
+
+	zenginMaxAmount = 9_999_999_999
+)
+
+// zenginAllowed is the set of characters the Zengin format accepts in kana fields.
+const zenginAllowed = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ" +
+	"ｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝﾞﾟ" +
+	" ()-./,｢｣"
+
+var zenginKanaTable = buildZenginKanaTable()
+
+func buildZenginKanaTable() map[rune]string {
+	t := make(map[rune]string)
+	add := func(from, to string) {
+		toRunes := []rune(to)
+		for i, r := range []rune(from) {
+			t[r] = string(toRunes[i])
+		}
+	}
+
+	// 清音
+	add("アイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン",
+		"ｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝ")
+	// 小書き文字は全銀協では使えないため大文字に寄せる
+	add("ァィゥェォャュョッヮ", "ｱｲｳｴｵﾔﾕﾖﾂﾜ")
+	add("ｧｨｩｪｫｬｭｮｯ", "ｱｲｳｴｵﾔﾕﾖﾂ")
+	// 旧仮名・長音
+	add("ヲヰヱーｰ", "ｵｲｴ--")
+	// 濁音・半濁音は清音 + (半)濁点の 2 文字になる
+	for _, r := range "カキクケコサシスセソタチツテトハヒフヘホ" {
+		t[r+1] = t[r] + "ﾞ"
+	}
+	for _, r := range "ハヒフヘホ" {
+		t[r+2] = t[r] + "ﾟ"
+	}
+	t['ヴ'] = "ｳﾞ"
+	t['゛'] = "ﾞ"
+	t['゜'] = "ﾟ"
+	// 全角英数字・記号
+	for r := 'Ａ'; r <= 'Ｚ'; r++ {
+		t[r] = string('A' + (r - 'Ａ'))
+	}
+	for r := 'ａ'; r <= 'ｚ'; r++ {
+		t[r] = string('A' + (r - 'ａ'))
+	}
+	for r := '０'; r <= '９'; r++ {
+		t[r] = string('0' + (r - '０'))
+	}
+	add("　（）－‐．／，「」", " ()--./,｢｣")
+	return t
+}
+
+// ToZenginKana converts a name to the half-width character set used by Zengin transfer files.
+// ひらがな・全角カナ・全角英数字を半角に、英小文字を大文字に変換する。
+// 変換後に使用できない文字が残る場合は false を返す。
+func ToZenginKana(s string) (string, bool) {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= 'ぁ' && r <= 'ゖ' {
+			r += 'ァ' - 'ぁ'
+		}
+		if r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		if conv, ok := zenginKanaTable[r]; ok {
+			b.WriteString(conv)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	out := b.String()
+	return out, IsZenginKana(out)
+}
+
+// IsZenginKana reports whether s only contains characters allowed in Zengin kana fields.
+func IsZenginKana(s string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune(zenginAllowed, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// ZenginRemitter is the 依頼人 (the market) written to the header record.
+type ZenginRemitter struct {
+	CompanyCode    string
+	NameKana       string
+	BankCode       string
+	BankNameKana   string
+	BranchCode     string
+	BranchNameKana string
+	AccountType    BankAccountType
+	AccountNumber  string
+}
+
+// ZenginTransfer is a single payee written as a data record.
+type ZenginTransfer struct {
+	Account      BankAccount
+	Amount       int
+	CustomerCode string
+}
+
+// ZenginTransferFile is a 総合振込 file with a single header.
+type ZenginTransferFile struct {
+	Remitter     ZenginRemitter
+	TransferDate time.Time
+	Transfers    []ZenginTransfer
+}
+
+// Records builds the fixed-width header, data, trailer and end records.
+func (f *ZenginTransferFile) Records() ([]string, error) {
+	if len(f.Transfers) == 0 {
+		return nil, &domainErrors.ValidationError{Field: "transfers", Message: "at least one transfer is required"}
+	}
+
+	header, err := f.headerRecord()
+	if err != nil {
+		return nil, err
+	}
+	records := []string{header}
+
+	total := 0
+	for i, tr := range f.Transfers {
+		rec, err := tr.dataRecord()
+		if err != nil {
+			return nil, fmt.Errorf("transfer %d: %w", i+1, err)
+		}
+		records = append(records, rec)
+		total += tr.Amount
+	}
+
+	records = append(records,
+		string(zenginRecordTrailer)+zeroPad(len(f.Transfers), 6)+zeroPad(total, 12)+strings.Repeat(" ", 101),
+		string(zenginRecordEnd)+strings.Repeat(" ", 119),
+	)
+
+	if err := ValidateZenginRecords(records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// Encode renders the file in Shift_JIS with CRLF after each record.
+func (f *ZenginTransferFile) Encode() ([]byte, error) {
+	records, err := f.Records()
+	if err != nil {
+		return nil, err
+	}
+	out, err := japanese.ShiftJIS.NewEncoder().String(strings.Join(records, "\r\n") + "\r\n")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode transfer file: %w", err)
+	}
+	return []byte(out), nil
+}
+
+func (f *ZenginTransferFile) headerRecord() (string, error) {
+	r := f.Remitter
+	if !r.AccountType.IsValid() {
+		return "", &domainErrors.ValidationError{Field: "remitter.account_type", Message: "must be 1, 2 or 4"}
+	}
+	fields := []struct {
+		name  string
+		value string
+		width int
+		kana  bool
+	}{
+		{"company_code", r.CompanyCode, 10, false},
+		{"name_kana", r.NameKana, 40, true},
+		{"transfer_date", f.TransferDate.Format("0102"), 4, false},
+		{"bank_code", r.BankCode, 4, false},
+		{"bank_name_kana", r.BankNameKana, 15, true},
+		{"branch_code", r.BranchCode, 3, false},
+		{"branch_name_kana", r.BranchNameKana, 15, true},
+		{"account_type", strconv.Itoa(int(r.AccountType)), 1, false},
+		{"account_number", leftPad(r.AccountNumber, 7, '0'), 7, false},
+	}
+
+	var b strings.Builder
+	b.WriteRune(zenginRecordHeader)
+	b.WriteString(zenginKindGeneralTransfer)
+	b.WriteString(zenginCodeTypeSJIS)
+	for _, fld := range fields {
+		if strings.TrimSpace(fld.value) == "" {
+			return "", &domainErrors.ValidationError{Field: "remitter." + fld.name, Message: "cannot be empty"}
+		}
+		var (
+			v   string
+			err error
+		)
+		if fld.kana {
+			v, err = kanaField(fld.value, fld.width)
+		} else {
+			v, err = digitField(fld.value, fld.width)
+		}
+		if err != nil {
+			return "", &domainErrors.ValidationError{Field: "remitter." + fld.name, Message: err.Error()}
+		}
+		b.WriteString(v)
+	}
+	b.WriteString(strings.Repeat(" ", 17))
+	return b.String(), nil
+}
+
+func (t *ZenginTransfer) dataRecord() (string, error) {
+	if err := t.Account.Validate(); err != nil {
+		return "", err
+	}
+	if t.Amount <= 0 || t.Amount > zenginMaxAmount {
+		return "", &domainErrors.ValidationError{Field: "amount", Message: "must be between 1 and 9999999999"}
+	}
+	customer, err := digitField(leftPad(t.CustomerCode, 10, '0'), 10)
+	if err != nil {
+		return "", &domainErrors.ValidationError{Field: "customer_code", Message: err.Error()}
+	}
+	holder, err := kanaField(t.Account.HolderKana, 30)
+	if err != nil {
+		return "", &domainErrors.ValidationError{Field: "account_holder_kana", Message: err.Error()}
+	}
+
+	var b strings.Builder
+	b.WriteRune(zenginRecordData)
+	b.WriteString(t.Account.BankCode)
+	b.WriteString(strings.Repeat(" ", 15)) // 被仕向銀行名（任意）
+	b.WriteString(t.Account.BranchCode)
+	b.WriteString(strings.Repeat(" ", 15)) // 被仕向支店名（任意）
+	b.WriteString(strings.Repeat(" ", 4))  // 手形交換所番号
+	b.WriteString(strconv.Itoa(int(t.Account.AccountType)))
+	b.WriteString(leftPad(t.Account.AccountNumber, 7, '0'))
+	b.WriteString(holder)
+	b.WriteString(zeroPad(t.Amount, 10))
+	b.WriteString("0") // 新規コード
+	b.WriteString(customer)
+	b.WriteString(strings.Repeat(" ", 10)) // 顧客コード2
+	b.WriteString(zenginTransferTypeTeleEx)
+	b.WriteString(" ") // 識別表示
+	b.WriteString(strings.Repeat(" ", 7))
+	return b.String(), nil
+}
+
+// ValidateZenginRecords checks record lengths, ordering and that the trailer totals match the data records.
+func ValidateZenginRecords(records []string) error {
+	invalid := func(line int, msg string) error {
+		return &domainErrors.ValidationError{Field: "record " + strconv.Itoa(line), Message: msg}
+	}
+
+	if len(records) < 4 {
+		return &domainErrors.ValidationError{Field: "records", Message: "a transfer file needs header, data, trailer and end records"}
+	}
+	for i, rec := range records {
+		if n := utf8.RuneCountInString(rec); n != ZenginRecordLength {
+			return invalid(i+1, fmt.Sprintf("length %d, want %d", n, ZenginRecordLength))
+		}
+		if !IsZenginKana(rec) {
+			return invalid(i+1, "contains characters not allowed in Zengin files")
+		}
+	}
+
+	last := len(records) - 1
+	if records[0][0] != zenginRecordHeader {
+		return invalid(1, "first record must be a header record")
+	}
+	if records[last][0] != zenginRecordEnd {
+		return invalid(last+1, "last record must be an end record")
+	}
+	if records[last-1][0] != zenginRecordTrailer {
+		return invalid(last, "record before the end record must be a trailer record")
+	}
+
+	count, total := 0, 0
+	for i := 1; i < last-1; i++ {
+		if records[i][0] != zenginRecordData {
+			return invalid(i+1, "expected a data record")
+		}
+		amount, err := strconv.Atoi(dataAmount(records[i]))
+		if err != nil {
+			return invalid(i+1, "amount is not numeric")
+		}
+		count++
+		total += amount
+	}
+
+	trailer := records[last-1]
+	if trailer[1:7] != zeroPad(count, 6) {
+		return invalid(last, fmt.Sprintf("trailer count %s does not match %d data records", trailer[1:7], count))
+	}
+	if trailer[7:19] != zeroPad(total, 12) {
+		return invalid(last, fmt.Sprintf("trailer total %s does not match data total %d", trailer[7:19], total))
+	}
+	return nil
+}
+
+// dataAmount extracts the 振込金額 field (positions 81-90) from a data record.
+func dataAmount(rec string) string {
+	r := []rune(rec)
+	return string(r[80:90])
+}
+
+func digitField(v string, width int) (string, error) {
+	if len(v) != width || !isDigits(v) {
+		return "", fmt.Errorf("must be %d digits", width)
+	}
+	return v, nil
+}
+
+func kanaField(v string, width int) (string, error) {
+	if !IsZenginKana(v) {
+		return "", errors.New("contains characters not allowed in Zengin files")
+	}
+	n := utf8.RuneCountInString(v)
+	if n > width {
+		return "", fmt.Errorf("must be at most %d characters", width)
+	}
+	return v + strings.Repeat(" ", width-n), nil
+}
+
+func zeroPad(n, width int) string {
+	return leftPad(strconv.Itoa(n), width, '0')
+}
+
+func leftPad(s string, width int, pad rune) string {
+	n := utf8.RuneCountInString(s)
+	if n >= width {
+		return s
+	}
+	return strings.Repeat(string(pad), width-n) + s
+}
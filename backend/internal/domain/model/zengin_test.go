@@ -0,0 +1,219 @@
+package model
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+func testRemitter() ZenginRemitter {
+	return ZenginRemitter{
+		CompanyCode:    "1234567890",
+		NameKana:       "ｻｶﾅｲﾁﾊﾞ(ｶ",
+		BankCode:       "0001",
+		BankNameKana:   "ﾐｽﾞﾎ",
+		BranchCode:     "100",
+		BranchNameKana: "ﾄｳｷﾖｳ",
+		AccountType:    BankAccountTypeOrdinary,
+		AccountNumber:  "1234567",
+	}
+}
+
+func testTransferFile() *ZenginTransferFile {
+	return &ZenginTransferFile{
+		Remitter:     testRemitter(),
+		TransferDate: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+		Transfers: []ZenginTransfer{
+			{
+				Account:      BankAccount{BankCode: "0005", BranchCode: "201", AccountType: BankAccountTypeOrdinary, AccountNumber: "7654321", HolderKana: "ﾔﾏﾀﾞ ﾀﾛｳ"},
+				Amount:       123456,
+				CustomerCode: "1",
+			},
+			{
+				Account:      BankAccount{BankCode: "0009", BranchCode: "010", AccountType: BankAccountTypeCurrent, AccountNumber: "42", HolderKana: "ｷﾞﾖｷﾞﾖｳｸﾐｱｲ"},
+				Amount:       98000,
+				CustomerCode: "27",
+			},
+		},
+	}
+}
+
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll("testdata", 0o750))
+		require.NoError(t, os.WriteFile(path, got, 0o600))
+	}
+	want, err := os.ReadFile(path) // #nosec G304 -- test fixture path
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestZenginTransferFile_Encode_Golden(t *testing.T) {
+	got, err := testTransferFile().Encode()
+	require.NoError(t, err)
+	assertGolden(t, "zengin_transfer.golden", got)
+
+	// 各レコードは SJIS で 120 バイト + CRLF
+	lines := strings.Split(strings.TrimSuffix(string(got), "\r\n"), "\r\n")
+	assert.Len(t, lines, 5)
+	for _, l := range lines {
+		assert.Len(t, l, ZenginRecordLength)
+	}
+}
+
+func TestZenginTransferFile_Records_Layout(t *testing.T) {
+	records, err := testTransferFile().Records()
+	require.NoError(t, err)
+
+	field := func(rec string, from, to int) string {
+		return string([]rune(rec)[from-1 : to])
+	}
+
+	header := records[0]
+	assert.Equal(t, "1", field(header, 1, 1))
+	assert.Equal(t, "21", field(header, 2, 3))
+	assert.Equal(t, "0", field(header, 4, 4))
+	assert.Equal(t, "1234567890", field(header, 5, 14))
+	assert.Equal(t, "ｻｶﾅｲﾁﾊﾞ(ｶ", strings.TrimRight(field(header, 15, 54), " "))
+	assert.Equal(t, "0305", field(header, 55, 58))
+	assert.Equal(t, "0001", field(header, 59, 62))
+	assert.Equal(t, "100", field(header, 78, 80))
+	assert.Equal(t, "1", field(header, 96, 96))
+	assert.Equal(t, "1234567", field(header, 97, 103))
+
+	data := records[2]
+	assert.Equal(t, "2", field(data, 1, 1))
+	assert.Equal(t, "0009", field(data, 2, 5))
+	assert.Equal(t, "010", field(data, 21, 23))
+	assert.Equal(t, "2", field(data, 43, 43))
+	assert.Equal(t, "0000042", field(data, 44, 50))
+	assert.Equal(t, "ｷﾞﾖｷﾞﾖｳｸﾐｱｲ", strings.TrimRight(field(data, 51, 80), " "))
+	assert.Equal(t, "0000098000", field(data, 81, 90))
+	assert.Equal(t, "0000000027", field(data, 92, 101))
+
+	trailer := records[3]
+	assert.Equal(t, "8", field(trailer, 1, 1))
+	assert.Equal(t, "000002", field(trailer, 2, 7))
+	assert.Equal(t, "000000221456", field(trailer, 8, 19))
+
+	assert.Equal(t, "9"+strings.Repeat(" ", 119), records[4])
+}
+
+func TestZenginTransferFile_Records_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(f *ZenginTransferFile)
+		wantField string
+	}{
+		{"NoTransfers", func(f *ZenginTransferFile) { f.Transfers = nil }, "transfers"},
+		{"CompanyCodeTooShort", func(f *ZenginTransferFile) { f.Remitter.CompanyCode = "123" }, "remitter.company_code"},
+		{"RemitterNameNotKana", func(f *ZenginTransferFile) { f.Remitter.NameKana = "魚市場" }, "remitter.name_kana"},
+		{"RemitterNameEmpty", func(f *ZenginTransferFile) { f.Remitter.NameKana = "" }, "remitter.name_kana"},
+		{"RemitterAccountType", func(f *ZenginTransferFile) { f.Remitter.AccountType = 3 }, "remitter.account_type"},
+		{"ZeroAmount", func(f *ZenginTransferFile) { f.Transfers[0].Amount = 0 }, "amount"},
+		{"InvalidBankCode", func(f *ZenginTransferFile) { f.Transfers[1].Account.BankCode = "12" }, "bank_code"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := testTransferFile()
+			tt.mutate(f)
+
+			_, err := f.Records()
+
+			var vErr *domainErrors.ValidationError
+			require.ErrorAs(t, err, &vErr)
+			assert.Equal(t, tt.wantField, vErr.Field)
+		})
+	}
+}
+
+func TestValidateZenginRecords(t *testing.T) {
+	valid, err := testTransferFile().Records()
+	require.NoError(t, err)
+
+	clone := func() []string { return append([]string(nil), valid...) }
+
+	tests := []struct {
+		name    string
+		records func() []string
+		wantErr string
+	}{
+		{"Valid", clone, ""},
+		{"MissingEnd", func() []string { r := clone(); return r[:len(r)-1] }, "end record"},
+		{"ShortRecord", func() []string { r := clone(); r[1] = r[1][:119]; return r }, "length"},
+		{"WrongHeader", func() []string { r := clone(); r[0] = "2" + r[0][1:]; return r }, "header"},
+		{"TrailerCountMismatch", func() []string {
+			r := clone()
+			r[3] = "8000003" + r[3][7:]
+			return r
+		}, "trailer count"},
+		{"TrailerTotalMismatch", func() []string {
+			r := clone()
+			r[3] = "8000002000000000001" + r[3][19:]
+			return r
+		}, "trailer total"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateZenginRecords(tt.records())
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestZenginTransferFile_Encode_ShiftJIS(t *testing.T) {
+	got, err := testTransferFile().Encode()
+	require.NoError(t, err)
+
+	decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(got)
+	require.NoError(t, err)
+	assert.Contains(t, string(decoded), "ﾔﾏﾀﾞ ﾀﾛｳ")
+	// 半角カナは SJIS で 1 バイト (0xA1-0xDF)
+	assert.Contains(t, string(got), "\xd4\xcf\xc0\xde \xc0\xdb\xb3")
+}
+
+func TestToZenginKana(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   string
+		wantOK bool
+	}{
+		{"ヤマダ タロウ", "ﾔﾏﾀﾞ ﾀﾛｳ", true},
+		{"やまだ　たろう", "ﾔﾏﾀﾞ ﾀﾛｳ", true},
+		{"キョウドウ ギョギョウ", "ｷﾖｳﾄﾞｳ ｷﾞﾖｷﾞﾖｳ", true},
+		{"ポンプ", "ﾎﾟﾝﾌﾟ", true},
+		{"ｶ)ｻｶﾅ", "ｶ)ｻｶﾅ", true},
+		{"ﾏﾙｻﾝ ｼｮｳﾃﾝ", "ﾏﾙｻﾝ ｼﾖｳﾃﾝ", true},
+		{"ＡＢＣ水産", "ABC水産", false},
+		{"abc-123", "ABC-123", true},
+		{"ヴィーナス", "ｳﾞｲ-ﾅｽ", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := ToZenginKana(tt.in)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// AccountingSettingsRepository defines the interface for per-venue accounting settings data access.
+type AccountingSettingsRepository interface {
+	FindByVenueID(ctx context.Context, venueID int) (*model.AccountingSettings, error)
+	Upsert(ctx context.Context, settings *model.AccountingSettings) (*model.AccountingSettings, error)
+}
@@ -11,10 +11,19 @@ import (
 type AdminRepository interface {
 	FindOneByEmail(ctx context.Context, email string) (*model.Admin, error)
 	FindByID(ctx context.Context, id int) (*model.Admin, error)
+	// List returns all admins ordered by ID.
+	List(ctx context.Context) ([]model.Admin, error)
 	Create(ctx context.Context, admin *model.Admin) error
+	UpdateRole(ctx context.Context, id int, role model.AdminRole) error
 	Count(ctx context.Context) (int, error)
 	UpdatePassword(ctx context.Context, id int, passwordHash string) error
 	IncrementFailedAttempts(ctx context.Context, id int) (int, error)
 	LockAccount(ctx context.Context, id int, until time.Time) error
 	UpdateLoginSuccess(ctx context.Context, id int) error
+	// UpdateDisabledAt disables the admin at disabledAt, or enables the admin when it is nil.
+	UpdateDisabledAt(ctx context.Context, id int, disabledAt *time.Time) error
+	// Unlock clears a lockout caused by failed login attempts.
+	Unlock(ctx context.Context, id int) error
+	// RequirePasswordReset blocks login until the admin's password is changed by UpdatePassword.
+	RequirePasswordReset(ctx context.Context, id int) error
 }
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// AdminTwoFactorRepository defines the interface for admin TOTP enrollment and recovery code persistence.
+type AdminTwoFactorRepository interface {
+	// FindByAdminID returns nil when the admin has not started enrollment.
+	FindByAdminID(ctx context.Context, adminID int) (*model.AdminTwoFactor, error)
+	// SavePending stores a new pending enrollment, replacing any earlier pending one.
+	SavePending(ctx context.Context, adminID int, secretEncrypted string) error
+	// Enable confirms a pending enrollment and records the step of the code used to confirm it.
+	Enable(ctx context.Context, adminID int, enabledAt time.Time, step int64) error
+	// AdvanceLastUsedStep records an accepted code. It returns false when the step is not newer
+	// than the last accepted one, so that a code cannot be used twice even by concurrent logins.
+	AdvanceLastUsedStep(ctx context.Context, adminID int, step int64) (bool, error)
+	// Delete removes the enrollment and its recovery codes.
+	Delete(ctx context.Context, adminID int) error
+	// ReplaceRecoveryCodes discards the admin's recovery codes and stores the given hashes.
+	ReplaceRecoveryCodes(ctx context.Context, adminID int, codeHashes []string) error
+	// UseRecoveryCode marks an unused recovery code as used. It returns false when none matched.
+	UseRecoveryCode(ctx context.Context, adminID int, codeHash string, usedAt time.Time) (bool, error)
+	// CountUnusedRecoveryCodes returns how many recovery codes the admin has left.
+	CountUnusedRecoveryCodes(ctx context.Context, adminID int) (int, error)
+}
@@ -8,13 +8,19 @@ import (
 )
 
 // AuthenticationRepository provides AuthenticationRepository related functionality.
+// Each buyer organization has one owner login and any number of staff logins.
 type AuthenticationRepository interface {
 	Create(ctx context.Context, auth *model.Authentication) (*model.Authentication, error)
+	FindByID(ctx context.Context, id int) (*model.Authentication, error)
 	FindByEmail(ctx context.Context, email string) (*model.Authentication, error)
+	// FindByBuyerID returns the owner login of the buyer organization.
 	FindByBuyerID(ctx context.Context, buyerID int) (*model.Authentication, error)
+	ListByBuyerID(ctx context.Context, buyerID int) ([]model.Authentication, error)
+	UpdateLogin(ctx context.Context, auth *model.Authentication) error
 	UpdateLoginSuccess(ctx context.Context, id int, loginAt time.Time) error
 	IncrementFailedAttempts(ctx context.Context, id int) (int, error)
 	ResetFailedAttempts(ctx context.Context, id int) error
 	LockAccount(ctx context.Context, id int, until time.Time) error
-	UpdatePassword(ctx context.Context, buyerID int, passwordHash string) error
+	UpdatePassword(ctx context.Context, id int, passwordHash string) error
+	UpdateEmail(ctx context.Context, id int, email string) error
 }
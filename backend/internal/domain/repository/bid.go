@@ -10,6 +10,13 @@ import (
 type BidRepository interface {
 	Create(ctx context.Context, bid *model.Bid) (*model.Bid, error)
 	ListInvoices(ctx context.Context) ([]model.InvoiceItem, error)
+	FindPurchaseByID(ctx context.Context, id int) (*model.Purchase, error)
 	ListPurchasesByBuyerID(ctx context.Context, buyerID int) ([]model.Purchase, error)
 	ListAuctionsByBuyerID(ctx context.Context, buyerID int) ([]model.Auction, error)
+	ListAwardsByAuctionID(ctx context.Context, auctionID int) ([]model.Purchase, error)
+	UpdateAward(ctx context.Context, id, buyerID, price int) error
+	ListHistoryByItemID(ctx context.Context, itemID, beforeID, limit int, includeVoided bool) ([]model.BidHistoryEntry, error)
+	FindByIDWithLock(ctx context.Context, id int) (*model.Bid, error)
+	Void(ctx context.Context, bid *model.Bid) error
+	SumLeadingByBuyerID(ctx context.Context, buyerID int) (int, error)
 }
@@ -13,5 +13,12 @@ type BuyerRepository interface {
 	FindByID(ctx context.Context, id int) (*model.Buyer, error)
 	FindByName(ctx context.Context, name string) (*model.Buyer, error)
 	FindByEmail(ctx context.Context, email string) (*model.Buyer, error)
+	FindByIDWithLock(ctx context.Context, id int) (*model.Buyer, error)
+	Update(ctx context.Context, buyer *model.Buyer) error
+	UpdatePaddleNumber(ctx context.Context, id int, paddleNumber string) error
+	UpdateCreditTerms(ctx context.Context, id int, terms model.CreditTerms) error
+	UpdateRegistration(ctx context.Context, id int, reg model.BuyerRegistration) error
+	// ListPendingApplications returns the email-verified self-registrations awaiting admin review.
+	ListPendingApplications(ctx context.Context) ([]model.BuyerApplication, error)
 	Delete(ctx context.Context, id int) error
 }
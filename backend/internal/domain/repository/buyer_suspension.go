@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// BuyerSuspensionFilters represents filters for listing buyer suspensions
+type BuyerSuspensionFilters struct {
+	BuyerID *int
+	// ActiveAt limits the list to suspensions in force at the given time.
+	ActiveAt *time.Time
+}
+
+// BuyerSuspensionRepository defines the interface for buyer suspension data access.
+type BuyerSuspensionRepository interface {
+	Create(ctx context.Context, s *model.BuyerSuspension) (*model.BuyerSuspension, error)
+	FindByID(ctx context.Context, id int) (*model.BuyerSuspension, error)
+	List(ctx context.Context, filters *BuyerSuspensionFilters) ([]model.BuyerSuspension, error)
+	Lift(ctx context.Context, s *model.BuyerSuspension) error
+}
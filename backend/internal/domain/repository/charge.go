@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// ChargeItemRepository defines the interface for the per-venue catalog of miscellaneous charges.
+type ChargeItemRepository interface {
+	Create(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error)
+	FindByID(ctx context.Context, id int) (*model.ChargeItem, error)
+	ListByVenueID(ctx context.Context, venueID int) ([]model.ChargeItem, error)
+	Update(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error)
+}
+
+// BuyerChargeRepository defines the interface for charges billed to buyers per auction.
+type BuyerChargeRepository interface {
+	Create(ctx context.Context, charge *model.BuyerCharge) (*model.BuyerCharge, error)
+	FindByID(ctx context.Context, id int) (*model.BuyerCharge, error)
+	ListByAuctionID(ctx context.Context, auctionID int) ([]model.BuyerCharge, error)
+	Delete(ctx context.Context, id int) error
+}
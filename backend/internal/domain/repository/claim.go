@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// ClaimFilters represents filters for listing claims
+type ClaimFilters struct {
+	BuyerID *int
+	Status  *model.ClaimStatus
+}
+
+// ClaimRepository defines the interface for buyer claim data access.
+// Events are append-only and form the claim's audit trail.
+type ClaimRepository interface {
+	Create(ctx context.Context, claim *model.Claim) (*model.Claim, error)
+	FindByID(ctx context.Context, id int) (*model.Claim, error)
+	FindByIDWithLock(ctx context.Context, id int) (*model.Claim, error)
+	List(ctx context.Context, filters *ClaimFilters) ([]model.Claim, error)
+	Update(ctx context.Context, claim *model.Claim) error
+	CreateEvent(ctx context.Context, event *model.ClaimEvent) error
+	ListEvents(ctx context.Context, claimID int) ([]model.ClaimEvent, error)
+}
+
+// CreditNoteRepository defines the interface for credit note (赤伝) data access.
+type CreditNoteRepository interface {
+	Create(ctx context.Context, note *model.CreditNote) (*model.CreditNote, error)
+	FindByClaimID(ctx context.Context, claimID int) (*model.CreditNote, error)
+	ListByBuyerID(ctx context.Context, buyerID int) ([]model.CreditNote, error)
+	ListByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.CreditNote, error)
+}
+
+// SettlementAdjustmentRepository defines the interface for fisherman clawback data access.
+type SettlementAdjustmentRepository interface {
+	Create(ctx context.Context, adjustment *model.SettlementAdjustment) (*model.SettlementAdjustment, error)
+	ListPendingByFishermanIDs(ctx context.Context, fishermanIDs []int) ([]model.SettlementAdjustment, error)
+	AssignToSettlement(ctx context.Context, ids []int, settlementID int) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// EmailChangeRepository defines the interface for pending email change persistence.
+type EmailChangeRepository interface {
+	Create(ctx context.Context, token *model.EmailChangeToken) error
+	// FindByTokenHash returns nil when no pending change matches the hash.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*model.EmailChangeToken, error)
+	DeleteAllByBuyerID(ctx context.Context, buyerID int) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// EmailVerificationRepository defines the interface for sign-up email verification token persistence.
+type EmailVerificationRepository interface {
+	Create(ctx context.Context, token *model.EmailVerificationToken) error
+	// FindByTokenHash returns nil when no token matches the hash.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error)
+	DeleteAllByBuyerID(ctx context.Context, buyerID int) error
+}
@@ -11,5 +11,7 @@ type FishermanRepository interface {
 	Create(ctx context.Context, name string) (*model.Fisherman, error)
 	List(ctx context.Context) ([]model.Fisherman, error)
 	FindByID(ctx context.Context, id int) (*model.Fisherman, error)
+	Update(ctx context.Context, fisherman *model.Fisherman) error
+	UpdateBankAccount(ctx context.Context, id int, account *model.BankAccount) error
 	Delete(ctx context.Context, id int) error
 }
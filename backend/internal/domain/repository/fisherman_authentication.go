@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// FishermanAuthenticationRepository defines the interface for fisherman portal login data access.
+type FishermanAuthenticationRepository interface {
+	Create(ctx context.Context, auth *model.FishermanAuthentication) (*model.FishermanAuthentication, error)
+	FindByEmail(ctx context.Context, email string) (*model.FishermanAuthentication, error)
+	FindByFishermanID(ctx context.Context, fishermanID int) (*model.FishermanAuthentication, error)
+	UpdateLoginSuccess(ctx context.Context, id int, loginAt time.Time) error
+	IncrementFailedAttempts(ctx context.Context, id int) (int, error)
+	LockAccount(ctx context.Context, id int, until time.Time) error
+	UpdatePassword(ctx context.Context, fishermanID int, passwordHash string) error
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// InvoiceRepository defines the interface for persisted invoice data access.
+type InvoiceRepository interface {
+	Create(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error)
+	FindByID(ctx context.Context, id int) (*model.Invoice, error)
+	FindByIDWithLock(ctx context.Context, id int) (*model.Invoice, error)
+	ListByAuctionID(ctx context.Context, auctionID int) ([]model.Invoice, error)
+	ListByBuyerID(ctx context.Context, buyerID int) ([]model.Invoice, error)
+	ListOpenByBuyerIDWithLock(ctx context.Context, buyerID int) ([]model.Invoice, error)
+	ListOpen(ctx context.Context) ([]model.Invoice, error)
+	ListIssuedByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.Invoice, error)
+	Update(ctx context.Context, invoice *model.Invoice) error
+	DeleteDraftsByAuctionID(ctx context.Context, auctionID int) error
+}
@@ -11,6 +11,7 @@ type ItemRepository interface {
 	Create(ctx context.Context, item *model.AuctionItem) (*model.AuctionItem, error)
 	List(ctx context.Context) ([]model.AuctionItem, error)
 	ListByAuction(ctx context.Context, auctionID int) ([]model.AuctionItem, error)
+	ListConsignedByFishermanID(ctx context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error)
 	FindByID(ctx context.Context, id int) (*model.AuctionItem, error)
 	FindByIDWithLock(ctx context.Context, id int) (*model.AuctionItem, error)
 	Update(ctx context.Context, item *model.AuctionItem) (*model.AuctionItem, error)
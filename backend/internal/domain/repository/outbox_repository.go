@@ -9,8 +9,11 @@ import (
 
 // OutboxRepository manages outbox messages for the transactional outbox pattern.
 type OutboxRepository interface {
-	// InsertEmailJob serializes and inserts an email job.
-	InsertEmailJob(ctx context.Context, to string, resetURL string, emailType string) error
+	// InsertEmailJob serializes and inserts an email job. url may be empty for notices without a link.
+	InsertEmailJob(ctx context.Context, to string, url string, emailType string) error
+
+	// InsertRejectionEmailJob serializes and inserts an email job that tells the recipient why their request was turned down.
+	InsertRejectionEmailJob(ctx context.Context, to string, reason string, emailType string) error
 
 	// InsertPushJob serializes and inserts a push notification job.
 	// jobType must be one of JobTypePush* values; title/body/url are delivered as-is to the browser Service Worker.
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// PaymentRepository defines the interface for buyer payment data access.
+type PaymentRepository interface {
+	Create(ctx context.Context, payment *model.Payment) (*model.Payment, error)
+	CreateAllocation(ctx context.Context, allocation *model.PaymentAllocation) error
+	ListAllocationsByInvoiceID(ctx context.Context, invoiceID int) ([]model.PaymentAllocation, error)
+	ListByBuyerID(ctx context.Context, buyerID int) ([]model.Payment, error)
+	ListByBuyerIDWithLock(ctx context.Context, buyerID int) ([]model.Payment, error)
+	ListReceiptsByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.PaymentReceipt, error)
+}
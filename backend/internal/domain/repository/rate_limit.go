@@ -11,4 +11,7 @@ type RateLimitRepository interface {
 	IncrementBuyerLogin(ctx context.Context, ip string, window time.Duration) (int64, error)
 	IncrementAdminReset(ctx context.Context, ip string, window time.Duration) (int64, error)
 	IncrementBuyerReset(ctx context.Context, ip string, window time.Duration) (int64, error)
+	IncrementFishermanLogin(ctx context.Context, ip string, window time.Duration) (int64, error)
+	IncrementFishermanReset(ctx context.Context, ip string, window time.Duration) (int64, error)
+	IncrementBuyerSignup(ctx context.Context, ip string, window time.Duration) (int64, error)
 }
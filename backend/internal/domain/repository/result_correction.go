@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// ResultCorrectionFilters represents filters for listing result corrections
+type ResultCorrectionFilters struct {
+	AuctionID *int
+	Status    *model.ResultCorrectionStatus
+}
+
+// ResultCorrectionRepository defines the interface for award correction data access.
+type ResultCorrectionRepository interface {
+	Create(ctx context.Context, correction *model.ResultCorrection) (*model.ResultCorrection, error)
+	FindByID(ctx context.Context, id int) (*model.ResultCorrection, error)
+	FindByIDWithLock(ctx context.Context, id int) (*model.ResultCorrection, error)
+	List(ctx context.Context, filters *ResultCorrectionFilters) ([]model.ResultCorrection, error)
+	Update(ctx context.Context, correction *model.ResultCorrection) error
+}
@@ -9,7 +9,17 @@ import (
 // SessionRepository provides SessionRepository related functionality.
 type SessionRepository interface {
 	Create(ctx context.Context, userID int, role model.SessionRole) (string, error)
+	// CreateForLogin creates a session for one login of a user with several logins, such as a buyer organization.
+	CreateForLogin(ctx context.Context, userID, loginID int, role model.SessionRole) (string, error)
+	// CreateForAdmin creates an admin session that carries the admin's role for permission checks.
+	CreateForAdmin(ctx context.Context, adminID int, role model.AdminRole) (string, error)
 	FindByID(ctx context.Context, sessionID string) (*model.Session, error)
+	// ListByUserID returns the active sessions of a user across all of the user's logins.
+	ListByUserID(ctx context.Context, userID int, role model.SessionRole) ([]model.Session, error)
+	// Touch records that the session was used from the given client. Implementations may skip frequent updates.
+	Touch(ctx context.Context, sessionID, ipAddress, userAgent string) error
 	Delete(ctx context.Context, sessionID string) error
 	DeleteAllByUserID(ctx context.Context, userID int, role model.SessionRole) error
+	// DeleteAllByLoginID removes the sessions of one login, leaving the user's other logins signed in.
+	DeleteAllByLoginID(ctx context.Context, userID, loginID int, role model.SessionRole) error
 }
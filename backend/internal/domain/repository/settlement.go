@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// SettlementRepository defines the interface for fisherman settlement (仕切書) data access.
+type SettlementRepository interface {
+	Create(ctx context.Context, settlement *model.Settlement) (*model.Settlement, error)
+	FindByID(ctx context.Context, id int) (*model.Settlement, error)
+	FindByIDWithLock(ctx context.Context, id int) (*model.Settlement, error)
+	ListByAuctionID(ctx context.Context, auctionID int) ([]model.Settlement, error)
+	ListByIDs(ctx context.Context, ids []int) ([]model.Settlement, error)
+	// ListByIDsWithLock returns the settlements like ListByIDs and locks their rows until the transaction ends.
+	ListByIDsWithLock(ctx context.Context, ids []int) ([]model.Settlement, error)
+	// MarkExported records that the settlements were written to a transfer file.
+	MarkExported(ctx context.Context, ids []int, exportedAt time.Time) error
+	ListSettledByFishermanID(ctx context.Context, fishermanID int) ([]model.Settlement, error)
+	ListSettledByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.Settlement, error)
+	Update(ctx context.Context, settlement *model.Settlement) error
+	DeleteDraftsByAuctionID(ctx context.Context, auctionID int) error
+}
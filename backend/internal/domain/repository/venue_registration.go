@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// VenueRegistrationFilters represents filters for listing buyer–venue registrations
+type VenueRegistrationFilters struct {
+	BuyerID *int
+	VenueID *int
+	Status  *model.VenueRegistrationStatus
+}
+
+// VenueRegistrationRepository defines the interface for buyer–venue registration data access.
+type VenueRegistrationRepository interface {
+	Create(ctx context.Context, reg *model.VenueRegistration) (*model.VenueRegistration, error)
+	FindByID(ctx context.Context, id int) (*model.VenueRegistration, error)
+	FindByBuyerAndVenue(ctx context.Context, buyerID, venueID int) (*model.VenueRegistration, error)
+	List(ctx context.Context, filters *VenueRegistrationFilters) ([]model.VenueRegistration, error)
+	Update(ctx context.Context, reg *model.VenueRegistration) error
+}
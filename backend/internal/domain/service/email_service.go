@@ -5,9 +5,19 @@ import "context"
 // BuyerEmailService provides BuyerEmailService related functionality.
 type BuyerEmailService interface {
 	SendBuyerPasswordReset(ctx context.Context, to, url string) error
+	SendBuyerEmailChangeVerification(ctx context.Context, to, url string) error
+	SendBuyerEmailChanged(ctx context.Context, to string) error
+	SendBuyerRegistrationVerification(ctx context.Context, to, url string) error
+	SendBuyerRegistrationApproved(ctx context.Context, to, url string) error
+	SendBuyerRegistrationRejected(ctx context.Context, to, reason string) error
 }
 
 // AdminEmailService provides AdminEmailService related functionality.
 type AdminEmailService interface {
 	SendAdminPasswordReset(ctx context.Context, to, url string) error
 }
+
+// FishermanEmailService sends emails to fishermen using the consignor portal.
+type FishermanEmailService interface {
+	SendFishermanPasswordReset(ctx context.Context, to, url string) error
+}
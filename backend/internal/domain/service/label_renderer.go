@@ -0,0 +1,9 @@
+package service
+
+import "github.com/seka/fish-auction/backend/internal/domain/model"
+
+// LabelRenderer renders lot labels as a printable document.
+type LabelRenderer interface {
+	// Render returns the labels laid out on printable sheets, in the given order.
+	Render(labels []model.Label) ([]byte, error)
+}
@@ -4,13 +4,28 @@ package event
 type EmailType string
 
 const (
-	EmailTypeBuyerPasswordReset EmailType = "buyer_password_reset"
-	EmailTypeAdminPasswordReset EmailType = "admin_password_reset"
+	EmailTypeBuyerPasswordReset     EmailType = "buyer_password_reset"
+	EmailTypeAdminPasswordReset     EmailType = "admin_password_reset"
+	EmailTypeFishermanPasswordReset EmailType = "fisherman_password_reset"
+	// EmailTypeBuyerEmailChangeVerification is sent to the new address with the link that confirms the change.
+	EmailTypeBuyerEmailChangeVerification EmailType = "buyer_email_change_verification"
+	// EmailTypeBuyerEmailChanged is sent to the old address once the change has been confirmed.
+	EmailTypeBuyerEmailChanged EmailType = "buyer_email_changed"
+	// EmailTypeBuyerRegistrationVerification is sent to a new applicant with the link that confirms their address.
+	EmailTypeBuyerRegistrationVerification EmailType = "buyer_registration_verification"
+	// EmailTypeBuyerRegistrationApproved tells the applicant they can now bid.
+	EmailTypeBuyerRegistrationApproved EmailType = "buyer_registration_approved"
+	// EmailTypeBuyerRegistrationRejected tells the applicant why their application was turned down.
+	EmailTypeBuyerRegistrationRejected EmailType = "buyer_registration_rejected"
 )
 
 // EmailMessage is the wire format for email job messages.
 type EmailMessage struct {
 	EmailType EmailType `json:"email_type"`
 	To        string    `json:"to"`
-	ResetURL  string    `json:"reset_url,omitempty"`
+	// URL is the link the email points to. Notices without a link leave it empty.
+	// キューに残っている既存ジョブと互換にするため、JSON 名は reset_url のままにしている。
+	URL string `json:"reset_url,omitempty"`
+	// Reason is the explanation shown in notices that turn a request down.
+	Reason string `json:"reason,omitempty"`
 }
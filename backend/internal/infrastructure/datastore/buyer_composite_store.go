@@ -20,6 +20,12 @@ type BuyerStore interface {
 	FindByID(ctx context.Context, id int) (*model.Buyer, error)
 	FindByName(ctx context.Context, name string) (*model.Buyer, error)
 	FindByEmail(ctx context.Context, email string) (*model.Buyer, error)
+	FindByIDWithLock(ctx context.Context, id int) (*model.Buyer, error)
+	Update(ctx context.Context, buyer *model.Buyer) error
+	UpdatePaddleNumber(ctx context.Context, id int, paddleNumber string) error
+	UpdateCreditTerms(ctx context.Context, id int, terms model.CreditTerms) error
+	UpdateRegistration(ctx context.Context, id int, reg model.BuyerRegistration) error
+	ListPendingApplications(ctx context.Context) ([]model.BuyerApplication, error)
 	Delete(ctx context.Context, id int) error
 }
 
@@ -72,6 +78,52 @@ func (s *BuyerCompositeStore) FindByEmail(ctx context.Context, email string) (*m
 	return s.store.FindByEmail(ctx, email)
 }
 
+// FindByIDWithLock returns a buyer by its ID from the persistence layer, bypassing the cache, and locks the row.
+func (s *BuyerCompositeStore) FindByIDWithLock(ctx context.Context, id int) (*model.Buyer, error) {
+	return s.store.FindByIDWithLock(ctx, id)
+}
+
+// Update updates the buyer in the persistence layer and invalidates the cache.
+func (s *BuyerCompositeStore) Update(ctx context.Context, buyer *model.Buyer) error {
+	if err := s.store.Update(ctx, buyer); err != nil {
+		return err
+	}
+	_ = s.cache.Delete(ctx, buyer.ID)
+	return nil
+}
+
+// UpdatePaddleNumber assigns a paddle number in the persistence layer and invalidates the cache.
+func (s *BuyerCompositeStore) UpdatePaddleNumber(ctx context.Context, id int, paddleNumber string) error {
+	if err := s.store.UpdatePaddleNumber(ctx, id, paddleNumber); err != nil {
+		return err
+	}
+	_ = s.cache.Delete(ctx, id)
+	return nil
+}
+
+// UpdateCreditTerms sets the credit limit and deposit in the persistence layer and invalidates the cache.
+func (s *BuyerCompositeStore) UpdateCreditTerms(ctx context.Context, id int, terms model.CreditTerms) error {
+	if err := s.store.UpdateCreditTerms(ctx, id, terms); err != nil {
+		return err
+	}
+	_ = s.cache.Delete(ctx, id)
+	return nil
+}
+
+// UpdateRegistration stores the registration review in the persistence layer and invalidates the cache.
+func (s *BuyerCompositeStore) UpdateRegistration(ctx context.Context, id int, reg model.BuyerRegistration) error {
+	if err := s.store.UpdateRegistration(ctx, id, reg); err != nil {
+		return err
+	}
+	_ = s.cache.Delete(ctx, id)
+	return nil
+}
+
+// ListPendingApplications returns the applications awaiting review directly from the persistence layer.
+func (s *BuyerCompositeStore) ListPendingApplications(ctx context.Context) ([]model.BuyerApplication, error) {
+	return s.store.ListPendingApplications(ctx)
+}
+
 // Delete removes a buyer by its ID from the persistence layer and the cache.
 func (s *BuyerCompositeStore) Delete(ctx context.Context, id int) error {
 	if err := s.store.Delete(ctx, id); err != nil {
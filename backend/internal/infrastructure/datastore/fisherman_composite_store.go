@@ -18,6 +18,8 @@ type FishermanStore interface {
 	Create(ctx context.Context, name string) (*model.Fisherman, error)
 	List(ctx context.Context) ([]model.Fisherman, error)
 	FindByID(ctx context.Context, id int) (*model.Fisherman, error)
+	Update(ctx context.Context, fisherman *model.Fisherman) error
+	UpdateBankAccount(ctx context.Context, id int, account *model.BankAccount) error
 	Delete(ctx context.Context, id int) error
 }
 
@@ -60,6 +62,24 @@ func (s *FishermanCompositeStore) FindByID(ctx context.Context, id int) (*model.
 	return fisherman, nil
 }
 
+// Update updates the fisherman in the persistence layer and invalidates the cache.
+func (s *FishermanCompositeStore) Update(ctx context.Context, fisherman *model.Fisherman) error {
+	if err := s.store.Update(ctx, fisherman); err != nil {
+		return err
+	}
+	_ = s.cache.Delete(ctx, fisherman.ID)
+	return nil
+}
+
+// UpdateBankAccount updates the bank account in the persistence layer and invalidates the cache.
+func (s *FishermanCompositeStore) UpdateBankAccount(ctx context.Context, id int, account *model.BankAccount) error {
+	if err := s.store.UpdateBankAccount(ctx, id, account); err != nil {
+		return err
+	}
+	_ = s.cache.Delete(ctx, id)
+	return nil
+}
+
 // Delete removes a fisherman by its ID from the persistence layer and the cache.
 func (s *FishermanCompositeStore) Delete(ctx context.Context, id int) error {
 	if err := s.store.Delete(ctx, id); err != nil {
@@ -18,6 +18,7 @@ type ItemStore interface {
 	Create(ctx context.Context, item *model.AuctionItem) (*model.AuctionItem, error)
 	List(ctx context.Context) ([]model.AuctionItem, error)
 	ListByAuction(ctx context.Context, auctionID int) ([]model.AuctionItem, error)
+	ListConsignedByFishermanID(ctx context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error)
 	FindByID(ctx context.Context, id int) (*model.AuctionItem, error)
 	FindByIDWithLock(ctx context.Context, id int) (*model.AuctionItem, error)
 	Update(ctx context.Context, item *model.AuctionItem) (*model.AuctionItem, error)
@@ -57,6 +58,12 @@ func (s *ItemCompositeStore) ListByAuction(ctx context.Context, auctionID int) (
 	return s.store.ListByAuction(ctx, auctionID)
 }
 
+// ListConsignedByFishermanID returns the lots consigned by the given fisherman from the persistence layer.
+// 入札中の価格を見せるため、キャッシュは経由しない。
+func (s *ItemCompositeStore) ListConsignedByFishermanID(ctx context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error) {
+	return s.store.ListConsignedByFishermanID(ctx, fishermanID, status)
+}
+
 // FindByID returns an auction item by its ID, checking the cache first.
 func (s *ItemCompositeStore) FindByID(ctx context.Context, id int) (*model.AuctionItem, error) {
 	if i, err := s.cache.Get(ctx, id); err == nil && i != nil {
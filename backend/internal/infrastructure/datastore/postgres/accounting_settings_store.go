@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.AccountingSettingsRepository = (*AccountingSettingsStore)(nil)
+
+const accountingSettingsColumns = `
+	venue_id, sales_account, receivable_account, cash_account, deposit_account,
+	purchase_account, payable_account, commission_account, updated_at`
+
+// AccountingSettingsStore implements repository.AccountingSettingsRepository using PostgreSQL.
+type AccountingSettingsStore struct {
+	db datastore.Database
+}
+
+// NewAccountingSettingsStore creates a new instance of AccountingSettingsRepository
+func NewAccountingSettingsStore(db datastore.Database) *AccountingSettingsStore {
+	return &AccountingSettingsStore{db: db}
+}
+
+// FindByVenueID returns the accounting settings of a venue.
+func (r *AccountingSettingsStore) FindByVenueID(ctx context.Context, venueID int) (*model.AccountingSettings, error) {
+	query := `SELECT ` + accountingSettingsColumns + ` FROM venue_accounting_settings WHERE venue_id = $1`
+
+	s, err := scanAccountingSettings(r.db.QueryRow(ctx, query, venueID))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "AccountingSettings", venueID, "FindByVenueID")
+	}
+	return s, nil
+}
+
+// Upsert creates or replaces the accounting settings of a venue.
+func (r *AccountingSettingsStore) Upsert(ctx context.Context, settings *model.AccountingSettings) (*model.AccountingSettings, error) {
+	query := `
+		INSERT INTO venue_accounting_settings (
+			venue_id, sales_account, receivable_account, cash_account, deposit_account,
+			purchase_account, payable_account, commission_account
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (venue_id) DO UPDATE SET
+			sales_account = EXCLUDED.sales_account,
+			receivable_account = EXCLUDED.receivable_account,
+			cash_account = EXCLUDED.cash_account,
+			deposit_account = EXCLUDED.deposit_account,
+			purchase_account = EXCLUDED.purchase_account,
+			payable_account = EXCLUDED.payable_account,
+			commission_account = EXCLUDED.commission_account,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING ` + accountingSettingsColumns
+
+	s, err := scanAccountingSettings(r.db.QueryRow(ctx, query,
+		settings.VenueID, settings.SalesAccount, settings.ReceivableAccount, settings.CashAccount, settings.DepositAccount,
+		settings.PurchaseAccount, settings.PayableAccount, settings.CommissionAccount,
+	))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "AccountingSettings", settings.VenueID, "Upsert")
+	}
+	return s, nil
+}
+
+func scanAccountingSettings(row datastore.Row) (*model.AccountingSettings, error) {
+	var s model.AccountingSettings
+	if err := row.Scan(
+		&s.VenueID, &s.SalesAccount, &s.ReceivableAccount, &s.CashAccount, &s.DepositAccount,
+		&s.PurchaseAccount, &s.PayableAccount, &s.CommissionAccount, &s.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
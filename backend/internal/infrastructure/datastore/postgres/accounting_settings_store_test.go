@@ -0,0 +1,78 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+var accountingSettingsRowColumns = []string{
+	"venue_id", "sales_account", "receivable_account", "cash_account", "deposit_account",
+	"purchase_account", "payable_account", "commission_account", "updated_at",
+}
+
+func TestAccountingSettingsStore_FindByVenueID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAccountingSettingsStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM venue_accounting_settings WHERE venue_id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(accountingSettingsRowColumns).
+			AddRow(1, "売上高", "売掛金", "現金", "普通預金", "仕入高", "買掛金", "受取手数料", time.Now()))
+
+	s, err := repo.FindByVenueID(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "受取手数料", s.CommissionAccount)
+}
+
+func TestAccountingSettingsStore_FindByVenueID_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAccountingSettingsStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM venue_accounting_settings").
+		WithArgs(2).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.FindByVenueID(context.Background(), 2)
+	var nfErr *apperrors.NotFoundError
+	assert.ErrorAs(t, err, &nfErr)
+}
+
+func TestAccountingSettingsStore_Upsert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAccountingSettingsStore(postgres.NewClient(db))
+	s := model.DefaultAccountingSettings(1)
+
+	mock.ExpectQuery("INSERT INTO venue_accounting_settings .* ON CONFLICT \\(venue_id\\) DO UPDATE SET").
+		WithArgs(1, "売上高", "売掛金", "現金", "普通預金", "仕入高", "買掛金", "受取手数料").
+		WillReturnRows(sqlmock.NewRows(accountingSettingsRowColumns).
+			AddRow(1, "売上高", "売掛金", "現金", "普通預金", "仕入高", "買掛金", "受取手数料", time.Now()))
+
+	got, err := repo.Upsert(context.Background(), s)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got.VenueID)
+	assert.False(t, got.UpdatedAt.IsZero())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
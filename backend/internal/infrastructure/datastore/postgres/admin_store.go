@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
@@ -22,13 +23,13 @@ func NewAdminStore(db datastore.Database) *AdminStore {
 	return &AdminStore{db: db}
 }
 
+// adminColumns is the column list scanned by scanAdmin.
+const adminColumns = `id, email, password_hash, role, failed_attempts, locked_until, disabled_at, password_reset_required, created_at`
+
 // FindOneByEmail returns an admin by its email.
 func (r *AdminStore) FindOneByEmail(ctx context.Context, email string) (*model.Admin, error) {
-	query := `SELECT id, email, password_hash, failed_attempts, locked_until, created_at FROM admins WHERE email = $1`
-	row := r.db.QueryRow(ctx, query, email)
-
-	admin := &model.Admin{}
-	err := row.Scan(&admin.ID, &admin.Email, &admin.PasswordHash, &admin.FailedAttempts, &admin.LockedUntil, &admin.CreatedAt)
+	query := `SELECT ` + adminColumns + ` FROM admins WHERE email = $1`
+	admin, err := scanAdmin(r.db.QueryRow(ctx, query, email))
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Admin", 0, "FindOneByEmail")
 	}
@@ -37,27 +38,58 @@ func (r *AdminStore) FindOneByEmail(ctx context.Context, email string) (*model.A
 
 // FindByID returns an admin by its ID.
 func (r *AdminStore) FindByID(ctx context.Context, id int) (*model.Admin, error) {
-	query := `SELECT id, email, password_hash, failed_attempts, locked_until, created_at FROM admins WHERE id = $1`
-	row := r.db.QueryRow(ctx, query, id)
-
-	admin := &model.Admin{}
-	err := row.Scan(&admin.ID, &admin.Email, &admin.PasswordHash, &admin.FailedAttempts, &admin.LockedUntil, &admin.CreatedAt)
+	query := `SELECT ` + adminColumns + ` FROM admins WHERE id = $1`
+	admin, err := scanAdmin(r.db.QueryRow(ctx, query, id))
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Admin", id, "FindByID")
 	}
 	return admin, nil
 }
 
-// Create stores a new admin.
+// List returns all admins ordered by ID.
+func (r *AdminStore) List(ctx context.Context) ([]model.Admin, error) {
+	rows, err := r.db.Query(ctx, `SELECT `+adminColumns+` FROM admins ORDER BY id`)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Admin", 0, "List")
+	}
+	defer func() { _ = rows.Close() }()
+
+	admins := []model.Admin{}
+	for rows.Next() {
+		admin, err := scanAdmin(rows)
+		if err != nil {
+			return nil, dserrors.HandleError(err, "Admin", 0, "List")
+		}
+		admins = append(admins, *admin)
+	}
+	return admins, dserrors.HandleError(rows.Err(), "Admin", 0, "List")
+}
+
+// Create stores a new admin. An admin without a role can only view.
 func (r *AdminStore) Create(ctx context.Context, admin *model.Admin) error {
-	query := `INSERT INTO admins (email, password_hash) VALUES ($1, $2) RETURNING id, created_at`
-	err := r.db.QueryRow(ctx, query, admin.Email, admin.PasswordHash).Scan(&admin.ID, &admin.CreatedAt)
+	if admin.Role == "" {
+		admin.Role = model.AdminRoleReadOnly
+	}
+	query := `INSERT INTO admins (email, password_hash, role) VALUES ($1, $2, $3) RETURNING id, created_at`
+	err := r.db.QueryRow(ctx, query, admin.Email, admin.PasswordHash, string(admin.Role)).Scan(&admin.ID, &admin.CreatedAt)
 	if err != nil {
 		return dserrors.HandleError(err, "Admin", 0, "Create")
 	}
 	return nil
 }
 
+// UpdateRole changes the role of an admin.
+func (r *AdminStore) UpdateRole(ctx context.Context, id int, role model.AdminRole) error {
+	rowsAffected, err := r.db.Execute(ctx, `UPDATE admins SET role = $1 WHERE id = $2`, string(role), id)
+	if err != nil {
+		return dserrors.HandleError(err, "Admin", id, "UpdateRole")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Admin", ID: id}
+	}
+	return nil
+}
+
 // Count returns the total number of admins.
 func (r *AdminStore) Count(ctx context.Context) (int, error) {
 	var count int
@@ -69,9 +101,9 @@ func (r *AdminStore) Count(ctx context.Context) (int, error) {
 	return count, nil
 }
 
-// UpdatePassword updates the password hash of an admin.
+// UpdatePassword updates the password hash of an admin and lifts a forced password reset.
 func (r *AdminStore) UpdatePassword(ctx context.Context, id int, passwordHash string) error {
-	query := `UPDATE admins SET password_hash = $1 WHERE id = $2`
+	query := `UPDATE admins SET password_hash = $1, password_reset_required = FALSE WHERE id = $2`
 	_, err := r.db.Execute(ctx, query, passwordHash, id)
 	if err != nil {
 		return dserrors.HandleError(err, "Admin", id, "UpdatePassword")
@@ -109,3 +141,53 @@ func (r *AdminStore) UpdateLoginSuccess(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// UpdateDisabledAt disables or enables an admin.
+func (r *AdminStore) UpdateDisabledAt(ctx context.Context, id int, disabledAt *time.Time) error {
+	rowsAffected, err := r.db.Execute(ctx, `UPDATE admins SET disabled_at = $1 WHERE id = $2`, disabledAt, id)
+	if err != nil {
+		return dserrors.HandleError(err, "Admin", id, "UpdateDisabledAt")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Admin", ID: id}
+	}
+	return nil
+}
+
+// Unlock resets failed attempts and clears the lock of an admin.
+func (r *AdminStore) Unlock(ctx context.Context, id int) error {
+	rowsAffected, err := r.db.Execute(ctx,
+		`UPDATE admins SET failed_attempts = 0, locked_until = NULL WHERE id = $1`, id)
+	if err != nil {
+		return dserrors.HandleError(err, "Admin", id, "Unlock")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Admin", ID: id}
+	}
+	return nil
+}
+
+// RequirePasswordReset blocks login of an admin until the password is changed.
+func (r *AdminStore) RequirePasswordReset(ctx context.Context, id int) error {
+	rowsAffected, err := r.db.Execute(ctx, `UPDATE admins SET password_reset_required = TRUE WHERE id = $1`, id)
+	if err != nil {
+		return dserrors.HandleError(err, "Admin", id, "RequirePasswordReset")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Admin", ID: id}
+	}
+	return nil
+}
+
+// scanAdmin scans the adminColumns of a row.
+func scanAdmin(row datastore.Row) (*model.Admin, error) {
+	var admin model.Admin
+	var role string
+	err := row.Scan(&admin.ID, &admin.Email, &admin.PasswordHash, &role, &admin.FailedAttempts, &admin.LockedUntil,
+		&admin.DisabledAt, &admin.PasswordResetRequired, &admin.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	admin.Role = model.AdminRole(role)
+	return &admin, nil
+}
@@ -7,11 +7,16 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
 	"github.com/stretchr/testify/assert"
 )
 
+var adminTestColumns = []string{
+	"id", "email", "password_hash", "role", "failed_attempts", "locked_until", "disabled_at", "password_reset_required", "created_at",
+}
+
 func TestAdminStore_FindOneByEmail(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -23,20 +28,21 @@ func TestAdminStore_FindOneByEmail(t *testing.T) {
 	email := "admin@example.com"
 
 	t.Run("Success", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "email", "password_hash", "failed_attempts", "locked_until", "created_at"}).
-			AddRow(1, email, "hash", 0, nil, time.Now())
+		rows := sqlmock.NewRows(adminTestColumns).
+			AddRow(1, email, "hash", "clerk", 0, nil, nil, false, time.Now())
 
-		mock.ExpectQuery("SELECT id, email, password_hash, failed_attempts, locked_until, created_at FROM admins WHERE email = \\$1").
+		mock.ExpectQuery("SELECT id, email, password_hash, role, failed_attempts, locked_until, disabled_at, password_reset_required, created_at FROM admins WHERE email = \\$1").
 			WithArgs(email).
 			WillReturnRows(rows)
 
 		got, err := repo.FindOneByEmail(context.Background(), email)
 		assert.NoError(t, err)
 		assert.Equal(t, email, got.Email)
+		assert.Equal(t, model.AdminRoleClerk, got.Role)
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
-		mock.ExpectQuery("SELECT id, email, password_hash, failed_attempts, locked_until, created_at FROM admins WHERE email = \\$1").
+		mock.ExpectQuery("SELECT id, email, password_hash, role, failed_attempts, locked_until, disabled_at, password_reset_required, created_at FROM admins WHERE email = \\$1").
 			WithArgs(email).
 			WillReturnError(sql.ErrNoRows)
 
@@ -57,16 +63,17 @@ func TestAdminStore_FindByID(t *testing.T) {
 	id := 1
 
 	t.Run("Success", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "email", "password_hash", "failed_attempts", "locked_until", "created_at"}).
-			AddRow(id, "admin@example.com", "hash", 0, nil, time.Now())
+		rows := sqlmock.NewRows(adminTestColumns).
+			AddRow(id, "admin@example.com", "hash", "super_admin", 0, nil, nil, true, time.Now())
 
-		mock.ExpectQuery("SELECT id, email, password_hash, failed_attempts, locked_until, created_at FROM admins WHERE id = \\$1").
+		mock.ExpectQuery("SELECT id, email, password_hash, role, failed_attempts, locked_until, disabled_at, password_reset_required, created_at FROM admins WHERE id = \\$1").
 			WithArgs(id).
 			WillReturnRows(rows)
 
 		got, err := repo.FindByID(context.Background(), id)
 		assert.NoError(t, err)
 		assert.Equal(t, id, got.ID)
+		assert.True(t, got.PasswordResetRequired)
 	})
 }
 
@@ -84,12 +91,71 @@ func TestAdminStore_Create(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, time.Now())
 
 		mock.ExpectQuery("INSERT INTO admins").
-			WithArgs(admin.Email, admin.PasswordHash).
+			WithArgs(admin.Email, admin.PasswordHash, "read_only").
 			WillReturnRows(rows)
 
 		err := repo.Create(context.Background(), admin)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, admin.ID)
+		assert.Equal(t, model.AdminRoleReadOnly, admin.Role)
+	})
+
+	t.Run("WithRole", func(t *testing.T) {
+		accountant := &model.Admin{Email: "accounts@example.com", PasswordHash: "hashed", Role: model.AdminRoleAccountant}
+		mock.ExpectQuery("INSERT INTO admins").
+			WithArgs(accountant.Email, accountant.PasswordHash, "accountant").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(2, time.Now()))
+
+		assert.NoError(t, repo.Create(context.Background(), accountant))
+	})
+}
+
+func TestAdminStore_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAdminStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT id, email, password_hash, role, failed_attempts, locked_until, disabled_at, password_reset_required, created_at FROM admins ORDER BY id").
+		WillReturnRows(sqlmock.NewRows(adminTestColumns).
+			AddRow(1, "admin@example.com", "hash", "super_admin", 0, nil, nil, false, time.Now()).
+			AddRow(2, "clerk@example.com", "hash", "clerk", 0, nil, time.Now(), false, time.Now()))
+
+	admins, err := repo.List(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, admins, 2)
+	assert.Equal(t, model.AdminRoleClerk, admins[1].Role)
+	assert.NotNil(t, admins[1].DisabledAt)
+}
+
+func TestAdminStore_UpdateRole(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAdminStore(postgres.NewClient(db))
+	query := "UPDATE admins SET role = \\$1 WHERE id = \\$2"
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(query).
+			WithArgs("accountant", 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.UpdateRole(context.Background(), 2, model.AdminRoleAccountant))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(query).
+			WithArgs("clerk", 9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		var notFoundErr *apperrors.NotFoundError
+		assert.ErrorAs(t, repo.UpdateRole(context.Background(), 9, model.AdminRoleClerk), &notFoundErr)
 	})
 }
 
@@ -105,7 +171,7 @@ func TestAdminStore_UpdatePassword(t *testing.T) {
 	newHash := "newHash"
 
 	t.Run("Success", func(t *testing.T) {
-		mock.ExpectExec("UPDATE admins SET password_hash = \\$1 WHERE id = \\$2").
+		mock.ExpectExec("UPDATE admins SET password_hash = \\$1, password_reset_required = FALSE WHERE id = \\$2").
 			WithArgs(newHash, id).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -113,3 +179,48 @@ func TestAdminStore_UpdatePassword(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestAdminStore_AccountStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAdminStore(postgres.NewClient(db))
+	var notFoundErr *apperrors.NotFoundError
+
+	t.Run("UpdateDisabledAt", func(t *testing.T) {
+		disabledAt := time.Now()
+		mock.ExpectExec("UPDATE admins SET disabled_at = \\$1 WHERE id = \\$2").
+			WithArgs(&disabledAt, 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		assert.NoError(t, repo.UpdateDisabledAt(context.Background(), 2, &disabledAt))
+
+		mock.ExpectExec("UPDATE admins SET disabled_at = \\$1 WHERE id = \\$2").
+			WithArgs(nil, 9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		assert.ErrorAs(t, repo.UpdateDisabledAt(context.Background(), 9, nil), &notFoundErr)
+	})
+
+	t.Run("Unlock", func(t *testing.T) {
+		mock.ExpectExec("UPDATE admins SET failed_attempts = 0, locked_until = NULL WHERE id = \\$1").
+			WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		assert.NoError(t, repo.Unlock(context.Background(), 2))
+
+		mock.ExpectExec("UPDATE admins SET failed_attempts = 0, locked_until = NULL WHERE id = \\$1").
+			WithArgs(9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		assert.ErrorAs(t, repo.Unlock(context.Background(), 9), &notFoundErr)
+	})
+
+	t.Run("RequirePasswordReset", func(t *testing.T) {
+		mock.ExpectExec("UPDATE admins SET password_reset_required = TRUE WHERE id = \\$1").
+			WithArgs(2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		assert.NoError(t, repo.RequirePasswordReset(context.Background(), 2))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.AdminTwoFactorRepository = (*AdminTwoFactorStore)(nil)
+
+// AdminTwoFactorStore implements repository.AdminTwoFactorRepository using PostgreSQL.
+type AdminTwoFactorStore struct {
+	db datastore.Database
+}
+
+// NewAdminTwoFactorStore creates a new instance of AdminTwoFactorRepository
+func NewAdminTwoFactorStore(db datastore.Database) *AdminTwoFactorStore {
+	return &AdminTwoFactorStore{db: db}
+}
+
+// FindByAdminID returns the enrollment of an admin, or nil when there is none.
+func (r *AdminTwoFactorStore) FindByAdminID(ctx context.Context, adminID int) (*model.AdminTwoFactor, error) {
+	query := `SELECT admin_id, secret_encrypted, enabled_at, last_used_step, created_at FROM admin_two_factors WHERE admin_id = $1`
+	var res model.AdminTwoFactor
+	err := r.db.QueryRow(ctx, query, adminID).Scan(&res.AdminID, &res.SecretEncrypted, &res.EnabledAt, &res.LastUsedStep, &res.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, dserrors.HandleError(err, "AdminTwoFactor", adminID, "FindByAdminID")
+	}
+	return &res, nil
+}
+
+// SavePending stores a pending enrollment. An enabled enrollment is never overwritten.
+func (r *AdminTwoFactorStore) SavePending(ctx context.Context, adminID int, secretEncrypted string) error {
+	query := `
+		INSERT INTO admin_two_factors (admin_id, secret_encrypted) VALUES ($1, $2)
+		ON CONFLICT (admin_id) DO UPDATE SET
+			secret_encrypted = EXCLUDED.secret_encrypted,
+			last_used_step = 0,
+			created_at = CURRENT_TIMESTAMP
+		WHERE admin_two_factors.enabled_at IS NULL`
+	rowsAffected, err := r.db.Execute(ctx, query, adminID, secretEncrypted)
+	if err != nil {
+		return dserrors.HandleError(err, "AdminTwoFactor", adminID, "SavePending")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.ConflictError{Message: "Two-factor authentication is already enabled"}
+	}
+	return nil
+}
+
+// Enable confirms a pending enrollment.
+func (r *AdminTwoFactorStore) Enable(ctx context.Context, adminID int, enabledAt time.Time, step int64) error {
+	query := `UPDATE admin_two_factors SET enabled_at = $1, last_used_step = $2 WHERE admin_id = $3 AND enabled_at IS NULL`
+	rowsAffected, err := r.db.Execute(ctx, query, enabledAt, step, adminID)
+	if err != nil {
+		return dserrors.HandleError(err, "AdminTwoFactor", adminID, "Enable")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "AdminTwoFactor", ID: adminID}
+	}
+	return nil
+}
+
+// AdvanceLastUsedStep records the step of an accepted code if it is newer than the last one.
+func (r *AdminTwoFactorStore) AdvanceLastUsedStep(ctx context.Context, adminID int, step int64) (bool, error) {
+	query := `UPDATE admin_two_factors SET last_used_step = $1 WHERE admin_id = $2 AND last_used_step < $1`
+	rowsAffected, err := r.db.Execute(ctx, query, step, adminID)
+	if err != nil {
+		return false, dserrors.HandleError(err, "AdminTwoFactor", adminID, "AdvanceLastUsedStep")
+	}
+	return rowsAffected > 0, nil
+}
+
+// Delete removes the enrollment. Recovery codes are removed by ON DELETE CASCADE.
+func (r *AdminTwoFactorStore) Delete(ctx context.Context, adminID int) error {
+	_, err := r.db.Execute(ctx, `DELETE FROM admin_two_factors WHERE admin_id = $1`, adminID)
+	if err != nil {
+		return dserrors.HandleError(err, "AdminTwoFactor", adminID, "Delete")
+	}
+	return nil
+}
+
+// ReplaceRecoveryCodes discards the admin's recovery codes and stores the given hashes.
+// Callers should run it in a transaction so that a failure does not leave the admin without codes.
+func (r *AdminTwoFactorStore) ReplaceRecoveryCodes(ctx context.Context, adminID int, codeHashes []string) error {
+	if _, err := r.db.Execute(ctx, `DELETE FROM admin_recovery_codes WHERE admin_id = $1`, adminID); err != nil {
+		return dserrors.HandleError(err, "AdminRecoveryCode", adminID, "ReplaceRecoveryCodes")
+	}
+	for _, h := range codeHashes {
+		_, err := r.db.Execute(ctx, `INSERT INTO admin_recovery_codes (admin_id, code_hash) VALUES ($1, $2)`, adminID, h)
+		if err != nil {
+			return dserrors.HandleError(err, "AdminRecoveryCode", adminID, "ReplaceRecoveryCodes")
+		}
+	}
+	return nil
+}
+
+// UseRecoveryCode marks an unused recovery code as used.
+func (r *AdminTwoFactorStore) UseRecoveryCode(ctx context.Context, adminID int, codeHash string, usedAt time.Time) (bool, error) {
+	query := `UPDATE admin_recovery_codes SET used_at = $1 WHERE admin_id = $2 AND code_hash = $3 AND used_at IS NULL`
+	rowsAffected, err := r.db.Execute(ctx, query, usedAt, adminID, codeHash)
+	if err != nil {
+		return false, dserrors.HandleError(err, "AdminRecoveryCode", adminID, "UseRecoveryCode")
+	}
+	return rowsAffected > 0, nil
+}
+
+// CountUnusedRecoveryCodes returns how many recovery codes the admin has left.
+func (r *AdminTwoFactorStore) CountUnusedRecoveryCodes(ctx context.Context, adminID int) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM admin_recovery_codes WHERE admin_id = $1 AND used_at IS NULL`
+	if err := r.db.QueryRow(ctx, query, adminID).Scan(&count); err != nil {
+		return 0, dserrors.HandleError(err, "AdminRecoveryCode", adminID, "CountUnusedRecoveryCodes")
+	}
+	return count, nil
+}
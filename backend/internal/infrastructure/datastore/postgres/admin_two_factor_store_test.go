@@ -0,0 +1,176 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminTwoFactorStore_FindByAdminID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAdminTwoFactorStore(postgres.NewClient(db))
+	query := "SELECT admin_id, secret_encrypted, enabled_at, last_used_step, created_at FROM admin_two_factors WHERE admin_id = \\$1"
+
+	t.Run("Enabled", func(t *testing.T) {
+		enabledAt := time.Now()
+		mock.ExpectQuery(query).
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"admin_id", "secret_encrypted", "enabled_at", "last_used_step", "created_at"}).
+				AddRow(1, "v1.sealed", enabledAt, 42, time.Now()))
+
+		got, err := repo.FindByAdminID(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.True(t, got.IsEnabled())
+		assert.Equal(t, int64(42), got.LastUsedStep)
+	})
+
+	t.Run("NotEnrolled", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(2).
+			WillReturnError(sql.ErrNoRows)
+
+		got, err := repo.FindByAdminID(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdminTwoFactorStore_SavePending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAdminTwoFactorStore(postgres.NewClient(db))
+	query := "INSERT INTO admin_two_factors .* ON CONFLICT \\(admin_id\\) DO UPDATE SET .* WHERE admin_two_factors.enabled_at IS NULL"
+
+	mock.ExpectExec(query).
+		WithArgs(1, "v1.sealed").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.SavePending(context.Background(), 1, "v1.sealed"))
+
+	mock.ExpectExec(query).
+		WithArgs(1, "v1.other").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	var conflictErr *apperrors.ConflictError
+	assert.ErrorAs(t, repo.SavePending(context.Background(), 1, "v1.other"), &conflictErr)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdminTwoFactorStore_Steps(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAdminTwoFactorStore(postgres.NewClient(db))
+
+	t.Run("Enable", func(t *testing.T) {
+		enabledAt := time.Now()
+		mock.ExpectExec("UPDATE admin_two_factors SET enabled_at = \\$1, last_used_step = \\$2 WHERE admin_id = \\$3 AND enabled_at IS NULL").
+			WithArgs(enabledAt, int64(100), 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		assert.NoError(t, repo.Enable(context.Background(), 1, enabledAt, 100))
+
+		mock.ExpectExec("UPDATE admin_two_factors SET enabled_at").
+			WithArgs(enabledAt, int64(100), 9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		var notFoundErr *apperrors.NotFoundError
+		assert.ErrorAs(t, repo.Enable(context.Background(), 9, enabledAt, 100), &notFoundErr)
+	})
+
+	t.Run("AdvanceLastUsedStep", func(t *testing.T) {
+		query := "UPDATE admin_two_factors SET last_used_step = \\$1 WHERE admin_id = \\$2 AND last_used_step < \\$1"
+		mock.ExpectExec(query).
+			WithArgs(int64(101), 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		ok, err := repo.AdvanceLastUsedStep(context.Background(), 1, 101)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		mock.ExpectExec(query).
+			WithArgs(int64(101), 1).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		ok, err = repo.AdvanceLastUsedStep(context.Background(), 1, 101)
+		assert.NoError(t, err)
+		assert.False(t, ok, "replayed step")
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdminTwoFactorStore_RecoveryCodes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAdminTwoFactorStore(postgres.NewClient(db))
+
+	t.Run("Replace", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM admin_recovery_codes WHERE admin_id = \\$1").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 10))
+		mock.ExpectExec("INSERT INTO admin_recovery_codes \\(admin_id, code_hash\\) VALUES \\(\\$1, \\$2\\)").
+			WithArgs(1, "hash-a").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO admin_recovery_codes \\(admin_id, code_hash\\) VALUES \\(\\$1, \\$2\\)").
+			WithArgs(1, "hash-b").
+			WillReturnResult(sqlmock.NewResult(2, 1))
+
+		assert.NoError(t, repo.ReplaceRecoveryCodes(context.Background(), 1, []string{"hash-a", "hash-b"}))
+	})
+
+	t.Run("Use", func(t *testing.T) {
+		usedAt := time.Now()
+		query := "UPDATE admin_recovery_codes SET used_at = \\$1 WHERE admin_id = \\$2 AND code_hash = \\$3 AND used_at IS NULL"
+		mock.ExpectExec(query).
+			WithArgs(usedAt, 1, "hash-a").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		ok, err := repo.UseRecoveryCode(context.Background(), 1, "hash-a", usedAt)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		mock.ExpectExec(query).
+			WithArgs(usedAt, 1, "hash-a").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		ok, err = repo.UseRecoveryCode(context.Background(), 1, "hash-a", usedAt)
+		assert.NoError(t, err)
+		assert.False(t, ok, "code already used")
+	})
+
+	t.Run("CountUnused", func(t *testing.T) {
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM admin_recovery_codes WHERE admin_id = \\$1 AND used_at IS NULL").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(9))
+		count, err := repo.CountUnusedRecoveryCodes(context.Background(), 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 9, count)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM admin_two_factors WHERE admin_id = \\$1").
+			WithArgs(1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		assert.NoError(t, repo.Delete(context.Background(), 1))
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 	"time"
 
 	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
@@ -29,41 +30,64 @@ func NewAuthenticationStore(db datastore.Database) *AuthenticationStore {
 	}
 }
 
-// Create stores a new authentication record.
+// authenticationColumns is the column list scanned by scanAuthentication.
+const authenticationColumns = `id, buyer_id, email, password_hash, auth_type, name, role, bid_limit, disabled_at,
+	failed_attempts, locked_until, last_login_at, created_at, updated_at`
+
+// Create stores a new authentication record. A login without a role is the organization's owner.
 func (r *AuthenticationStore) Create(ctx context.Context, auth *model.Authentication) (*model.Authentication, error) {
 	e := entity.Authentication{
 		BuyerID:      auth.BuyerID,
 		Email:        auth.Email,
 		PasswordHash: auth.PasswordHash,
 		AuthType:     auth.AuthType,
+		Name:         strings.TrimSpace(auth.Name),
+		Role:         string(auth.Role),
+		BidLimit:     auth.BidLimit,
 	}
 	if e.AuthType == "" {
 		e.AuthType = "password"
 	}
+	if e.Role == "" {
+		e.Role = string(model.BuyerLoginOwner)
+	}
 
 	if err := e.Validate(); err != nil {
 		return nil, err
 	}
 
 	err := r.db.QueryRow(ctx,
-		`INSERT INTO authentications (buyer_id, email, password_hash, auth_type, created_at, updated_at)
-		 VALUES ($1, $2, $3, $4, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		`INSERT INTO authentications (buyer_id, email, password_hash, auth_type, name, role, bid_limit, created_at, updated_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
 		 RETURNING id, created_at, updated_at`,
-		e.BuyerID, e.Email, e.PasswordHash, e.AuthType).Scan(&e.ID, &e.CreatedAt, &e.UpdatedAt)
+		e.BuyerID, e.Email, e.PasswordHash, e.AuthType, e.Name, e.Role, e.BidLimit).Scan(&e.ID, &e.CreatedAt, &e.UpdatedAt)
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Authentication", 0, "Create")
 	}
 	return e.ToModel(), nil
 }
 
+// FindByID returns an authentication record by its ID.
+func (r *AuthenticationStore) FindByID(ctx context.Context, id int) (*model.Authentication, error) {
+	e, err := scanAuthentication(r.db.QueryRow(ctx,
+		`SELECT `+authenticationColumns+` FROM authentications WHERE id = $1`,
+		id,
+	))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &apperrors.NotFoundError{Resource: "Authentication", ID: id}
+		}
+		return nil, dserrors.HandleError(err, "Authentication", id, "FindByID")
+	}
+	return e.ToModel(), nil
+}
+
 // FindByEmail returns an authentication record by its email.
 func (r *AuthenticationStore) FindByEmail(ctx context.Context, email string) (*model.Authentication, error) {
-	var e entity.Authentication
-	err := r.db.QueryRow(ctx,
-		`SELECT id, buyer_id, email, password_hash, auth_type, failed_attempts, locked_until, last_login_at, created_at, updated_at
-		 FROM authentications WHERE email = $1`,
+	e, err := scanAuthentication(r.db.QueryRow(ctx,
+		`SELECT `+authenticationColumns+` FROM authentications WHERE email = $1`,
 		email,
-	).Scan(&e.ID, &e.BuyerID, &e.Email, &e.PasswordHash, &e.AuthType, &e.FailedAttempts, &e.LockedUntil, &e.LastLoginAt, &e.CreatedAt, &e.UpdatedAt)
+	))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, &apperrors.NotFoundError{Resource: "Authentication", ID: 0}
@@ -73,14 +97,13 @@ func (r *AuthenticationStore) FindByEmail(ctx context.Context, email string) (*m
 	return e.ToModel(), nil
 }
 
-// FindByBuyerID returns an authentication record by its buyer ID.
+// FindByBuyerID returns the owner login of a buyer organization.
+// 審査結果の通知など組織宛ての連絡は代表者のアドレスに送る。
 func (r *AuthenticationStore) FindByBuyerID(ctx context.Context, buyerID int) (*model.Authentication, error) {
-	var e entity.Authentication
-	err := r.db.QueryRow(ctx,
-		`SELECT id, buyer_id, email, password_hash, auth_type, failed_attempts, locked_until, last_login_at, created_at, updated_at
-		 FROM authentications WHERE buyer_id = $1`,
-		buyerID,
-	).Scan(&e.ID, &e.BuyerID, &e.Email, &e.PasswordHash, &e.AuthType, &e.FailedAttempts, &e.LockedUntil, &e.LastLoginAt, &e.CreatedAt, &e.UpdatedAt)
+	e, err := scanAuthentication(r.db.QueryRow(ctx,
+		`SELECT `+authenticationColumns+` FROM authentications WHERE buyer_id = $1 AND role = $2`,
+		buyerID, string(model.BuyerLoginOwner),
+	))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, &apperrors.NotFoundError{Resource: "Authentication", ID: buyerID}
@@ -90,6 +113,48 @@ func (r *AuthenticationStore) FindByBuyerID(ctx context.Context, buyerID int) (*
 	return e.ToModel(), nil
 }
 
+// ListByBuyerID returns all logins of a buyer organization, the owner first.
+func (r *AuthenticationStore) ListByBuyerID(ctx context.Context, buyerID int) ([]model.Authentication, error) {
+	rows, err := r.db.Query(ctx,
+		`SELECT `+authenticationColumns+` FROM authentications WHERE buyer_id = $1
+		 ORDER BY role = $2 DESC, id`,
+		buyerID, string(model.BuyerLoginOwner),
+	)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Authentication", buyerID, "ListByBuyerID")
+	}
+	defer func() { _ = rows.Close() }()
+
+	auths := []model.Authentication{}
+	for rows.Next() {
+		e, err := scanAuthentication(rows)
+		if err != nil {
+			return nil, dserrors.HandleError(err, "Authentication", buyerID, "ListByBuyerID")
+		}
+		auths = append(auths, *e.ToModel())
+	}
+	return auths, dserrors.HandleError(rows.Err(), "Authentication", buyerID, "ListByBuyerID")
+}
+
+// UpdateLogin stores the name, bid limit and disabled state of a login.
+func (r *AuthenticationStore) UpdateLogin(ctx context.Context, auth *model.Authentication) error {
+	if err := model.ValidateBidLimit(auth.BidLimit); err != nil {
+		return err
+	}
+	rowsAffected, err := r.db.Execute(ctx,
+		`UPDATE authentications
+		 SET name = $1, bid_limit = $2, disabled_at = $3, updated_at = CURRENT_TIMESTAMP
+		 WHERE id = $4`,
+		strings.TrimSpace(auth.Name), auth.BidLimit, auth.DisabledAt, auth.ID)
+	if err != nil {
+		return dserrors.HandleError(err, "Authentication", auth.ID, "UpdateLogin")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Authentication", ID: auth.ID}
+	}
+	return nil
+}
+
 // UpdateLoginSuccess updates the record upon a successful login.
 func (r *AuthenticationStore) UpdateLoginSuccess(ctx context.Context, id int, loginAt time.Time) error {
 	_, err := r.db.Execute(ctx,
@@ -143,15 +208,45 @@ func (r *AuthenticationStore) LockAccount(ctx context.Context, id int, until tim
 	return nil
 }
 
-// UpdatePassword updates the password hash for a buyer.
-func (r *AuthenticationStore) UpdatePassword(ctx context.Context, buyerID int, passwordHash string) error {
+// UpdatePassword updates the password hash of a login.
+func (r *AuthenticationStore) UpdatePassword(ctx context.Context, id int, passwordHash string) error {
 	_, err := r.db.Execute(ctx,
 		`UPDATE authentications
 		 SET password_hash = $1, updated_at = CURRENT_TIMESTAMP
-		 WHERE buyer_id = $2`,
-		passwordHash, buyerID)
+		 WHERE id = $2`,
+		passwordHash, id)
 	if err != nil {
-		return dserrors.HandleError(err, "Authentication", buyerID, "UpdatePassword")
+		return dserrors.HandleError(err, "Authentication", id, "UpdatePassword")
 	}
 	return nil
 }
+
+// UpdateEmail replaces the email of a login.
+// 他のログインが使用中のアドレスは一意制約により ConflictError になる。
+func (r *AuthenticationStore) UpdateEmail(ctx context.Context, id int, email string) error {
+	rowsAffected, err := r.db.Execute(ctx,
+		`UPDATE authentications
+		 SET email = $1, updated_at = CURRENT_TIMESTAMP
+		 WHERE id = $2`,
+		email, id)
+	if err != nil {
+		return dserrors.HandleError(err, "Authentication", id, "UpdateEmail")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Authentication", ID: id}
+	}
+	return nil
+}
+
+// scanAuthentication scans the authenticationColumns of a row.
+func scanAuthentication(row datastore.Row) (*entity.Authentication, error) {
+	var e entity.Authentication
+	err := row.Scan(
+		&e.ID, &e.BuyerID, &e.Email, &e.PasswordHash, &e.AuthType, &e.Name, &e.Role, &e.BidLimit, &e.DisabledAt,
+		&e.FailedAttempts, &e.LockedUntil, &e.LastLoginAt, &e.CreatedAt, &e.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
 	"github.com/stretchr/testify/assert"
@@ -27,13 +29,54 @@ func TestAuthenticationStore_Create(t *testing.T) {
 	}
 
 	mock.ExpectQuery("INSERT INTO authentications").
-		WithArgs(auth.BuyerID, auth.Email, auth.PasswordHash, auth.AuthType).
+		WithArgs(auth.BuyerID, auth.Email, auth.PasswordHash, auth.AuthType, "", "owner", nil).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
 			AddRow(1, time.Now(), time.Now()))
 
 	created, err := repo.Create(context.Background(), auth)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, created.ID)
+	assert.Equal(t, model.BuyerLoginOwner, created.Role)
+}
+
+func TestAuthenticationStore_CreateStaff(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAuthenticationStore(postgres.NewClient(db))
+	limit := 30000
+	auth := &model.Authentication{
+		BuyerID:      1,
+		Email:        "staff@example.com",
+		PasswordHash: "hash",
+		Name:         " 佐藤 ",
+		Role:         model.BuyerLoginStaff,
+		BidLimit:     &limit,
+	}
+
+	mock.ExpectQuery("INSERT INTO authentications").
+		WithArgs(1, "staff@example.com", "hash", "password", "佐藤", "staff", &limit).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+			AddRow(2, time.Now(), time.Now()))
+
+	created, err := repo.Create(context.Background(), auth)
+	assert.NoError(t, err)
+	assert.Equal(t, "佐藤", created.Name)
+	assert.Equal(t, limit, *created.BidLimit)
+
+	zero := 0
+	auth.BidLimit = &zero
+	_, err = repo.Create(context.Background(), auth)
+	var validationErr *apperrors.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+}
+
+var authenticationTestColumns = []string{
+	"id", "buyer_id", "email", "password_hash", "auth_type", "name", "role", "bid_limit", "disabled_at",
+	"failed_attempts", "locked_until", "last_login_at", "created_at", "updated_at",
 }
 
 func TestAuthenticationStore_FindByEmail(t *testing.T) {
@@ -48,12 +91,98 @@ func TestAuthenticationStore_FindByEmail(t *testing.T) {
 
 	mock.ExpectQuery("SELECT .* FROM authentications WHERE email = \\$1").
 		WithArgs(email).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "buyer_id", "email", "password_hash", "auth_type", "failed_attempts", "locked_until", "last_login_at", "created_at", "updated_at"}).
-			AddRow(1, 1, email, "hash", "password", 0, nil, nil, time.Now(), time.Now()))
+		WillReturnRows(sqlmock.NewRows(authenticationTestColumns).
+			AddRow(1, 1, email, "hash", "password", "山田", "owner", nil, nil, 0, nil, nil, time.Now(), time.Now()))
 
 	found, err := repo.FindByEmail(context.Background(), email)
 	assert.NoError(t, err)
 	assert.Equal(t, email, found.Email)
+	assert.True(t, found.IsOwner())
+}
+
+func TestAuthenticationStore_FindByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAuthenticationStore(postgres.NewClient(db))
+	query := "SELECT .* FROM authentications WHERE id = \\$1"
+
+	t.Run("Success", func(t *testing.T) {
+		disabledAt := time.Now()
+		mock.ExpectQuery(query).
+			WithArgs(2).
+			WillReturnRows(sqlmock.NewRows(authenticationTestColumns).
+				AddRow(2, 1, "staff@example.com", "hash", "password", "佐藤", "staff", 30000, disabledAt, 0, nil, nil, time.Now(), time.Now()))
+
+		found, err := repo.FindByID(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, model.BuyerLoginStaff, found.Role)
+		assert.Equal(t, 30000, *found.BidLimit)
+		assert.NotNil(t, found.DisabledAt)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs(9).
+			WillReturnRows(sqlmock.NewRows(authenticationTestColumns))
+
+		_, err := repo.FindByID(context.Background(), 9)
+		var notFoundErr *apperrors.NotFoundError
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestAuthenticationStore_ListByBuyerID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAuthenticationStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM authentications WHERE buyer_id = \\$1 ORDER BY role = \\$2 DESC, id").
+		WithArgs(1, "owner").
+		WillReturnRows(sqlmock.NewRows(authenticationTestColumns).
+			AddRow(1, 1, "owner@example.com", "hash", "password", "山田", "owner", nil, nil, 0, nil, nil, time.Now(), time.Now()).
+			AddRow(2, 1, "staff@example.com", "hash", "password", "佐藤", "staff", 30000, nil, 0, nil, nil, time.Now(), time.Now()))
+
+	logins, err := repo.ListByBuyerID(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Len(t, logins, 2)
+	assert.Equal(t, "佐藤", logins[1].Name)
+}
+
+func TestAuthenticationStore_UpdateLogin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAuthenticationStore(postgres.NewClient(db))
+	query := "UPDATE authentications SET name = \\$1, bid_limit = \\$2, disabled_at = \\$3, updated_at = CURRENT_TIMESTAMP WHERE id = \\$4"
+	disabledAt := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(query).
+			WithArgs("佐藤", nil, &disabledAt, 2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.UpdateLogin(context.Background(), &model.Authentication{ID: 2, Name: " 佐藤 ", DisabledAt: &disabledAt}))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(query).
+			WithArgs("佐藤", nil, nil, 9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		var notFoundErr *apperrors.NotFoundError
+		assert.ErrorAs(t, repo.UpdateLogin(context.Background(), &model.Authentication{ID: 9, Name: "佐藤"}), &notFoundErr)
+	})
 }
 
 func TestAuthenticationStore_UpdatePassword(t *testing.T) {
@@ -64,13 +193,50 @@ func TestAuthenticationStore_UpdatePassword(t *testing.T) {
 	defer func() { _ = db.Close() }()
 
 	repo := postgres.NewAuthenticationStore(postgres.NewClient(db))
-	buyerID := 1
+	loginID := 2
 	newHash := "newHash"
 
-	mock.ExpectExec("UPDATE authentications SET password_hash = \\$1, updated_at = CURRENT_TIMESTAMP WHERE buyer_id = \\$2").
-		WithArgs(newHash, buyerID).
+	mock.ExpectExec("UPDATE authentications SET password_hash = \\$1, updated_at = CURRENT_TIMESTAMP WHERE id = \\$2").
+		WithArgs(newHash, loginID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err = repo.UpdatePassword(context.Background(), buyerID, newHash)
+	err = repo.UpdatePassword(context.Background(), loginID, newHash)
 	assert.NoError(t, err)
 }
+
+func TestAuthenticationStore_UpdateEmail(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewAuthenticationStore(postgres.NewClient(db))
+	query := "UPDATE authentications SET email = \\$1, updated_at = CURRENT_TIMESTAMP WHERE id = \\$2"
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(query).
+			WithArgs("new@example.com", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.UpdateEmail(context.Background(), 1, "new@example.com"))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(query).
+			WithArgs("new@example.com", 9).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		var notFoundErr *apperrors.NotFoundError
+		assert.ErrorAs(t, repo.UpdateEmail(context.Background(), 9, "new@example.com"), &notFoundErr)
+	})
+
+	t.Run("EmailTaken", func(t *testing.T) {
+		mock.ExpectExec(query).
+			WithArgs("taken@example.com", 1).
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		var conflictErr *apperrors.ConflictError
+		assert.ErrorAs(t, repo.UpdateEmail(context.Background(), 1, "taken@example.com"), &conflictErr)
+	})
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
@@ -27,18 +28,22 @@ func NewBidStore(db datastore.Database) *BidStore {
 func (r *BidStore) Create(ctx context.Context, bid *model.Bid) (*model.Bid, error) {
 
 	e := entity.Bid{
-		ItemID:  bid.ItemID,
-		BuyerID: bid.BuyerID,
-		Price:   bid.Price.Amount(),
+		ItemID:    bid.ItemID,
+		BuyerID:   bid.BuyerID,
+		LoginID:   bid.LoginID,
+		Price:     bid.Price.Amount(),
+		Channel:   string(bid.Channel),
+		EnteredBy: bid.EnteredBy,
 	}
 	if err := e.Validate(); err != nil {
 		return nil, err
 	}
 
 	err := r.db.QueryRow(ctx,
-		"INSERT INTO transactions (item_id, buyer_id, price) VALUES ($1, $2, $3) RETURNING id, item_id, buyer_id, price, created_at",
-		bid.ItemID, bid.BuyerID, bid.Price.Amount(),
-	).Scan(&e.ID, &e.ItemID, &e.BuyerID, &e.Price, &e.CreatedAt)
+		`INSERT INTO transactions (item_id, buyer_id, login_id, price, channel, entered_by) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, item_id, buyer_id, login_id, price, channel, entered_by, created_at`,
+		e.ItemID, e.BuyerID, e.LoginID, e.Price, e.Channel, e.EnteredBy,
+	).Scan(&e.ID, &e.ItemID, &e.BuyerID, &e.LoginID, &e.Price, &e.Channel, &e.EnteredBy, &e.CreatedAt)
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Bid", 0, "Create")
 	}
@@ -51,6 +56,7 @@ func (r *BidStore) ListInvoices(ctx context.Context) ([]model.InvoiceItem, error
 		SELECT b.id, b.name, SUM(t.price) as total_price
 		FROM transactions t
 		JOIN buyers b ON t.buyer_id = b.id
+		WHERE t.voided_at IS NULL
 		GROUP BY b.id, b.name
 	`)
 	if err != nil {
@@ -79,6 +85,45 @@ func (r *BidStore) ListInvoices(ctx context.Context) ([]model.InvoiceItem, error
 	return invoices, dserrors.HandleError(rows.Err(), "Invoice", 0, "ListInvoices")
 }
 
+// FindPurchaseByID returns a single bidding transaction with its item details.
+func (r *BidStore) FindPurchaseByID(ctx context.Context, id int) (*model.Purchase, error) {
+	var p model.Purchase
+	err := r.db.QueryRow(ctx, `
+		SELECT
+			t.id,
+			t.item_id,
+			ai.fish_type,
+			ai.quantity,
+			ai.unit,
+			t.price,
+			t.buyer_id,
+			ai.fisherman_id,
+			ai.auction_id,
+			TO_CHAR(a.start_at AT TIME ZONE 'Asia/Tokyo', 'YYYY-MM-DD'),
+			t.created_at
+		FROM transactions t
+		JOIN auction_items ai ON t.item_id = ai.id
+		JOIN auctions a ON ai.auction_id = a.id
+		WHERE t.id = $1 AND t.voided_at IS NULL
+	`, id).Scan(
+		&p.ID,
+		&p.ItemID,
+		&p.FishType,
+		&p.Quantity,
+		&p.Unit,
+		&p.Price,
+		&p.BuyerID,
+		&p.FishermanID,
+		&p.AuctionID,
+		&p.AuctionDate,
+		&p.CreatedAt,
+	)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Purchase", id, "FindPurchaseByID")
+	}
+	return &p, nil
+}
+
 // ListPurchasesByBuyerID returns all purchases for a specific buyer.
 func (r *BidStore) ListPurchasesByBuyerID(ctx context.Context, buyerID int) ([]model.Purchase, error) {
 	rows, err := r.db.Query(ctx, `
@@ -96,7 +141,7 @@ func (r *BidStore) ListPurchasesByBuyerID(ctx context.Context, buyerID int) ([]m
 		FROM transactions t
 		JOIN auction_items ai ON t.item_id = ai.id
 		JOIN auctions a ON ai.auction_id = a.id
-		WHERE t.buyer_id = $1
+		WHERE t.buyer_id = $1 AND t.voided_at IS NULL
 		ORDER BY t.created_at DESC
 	`, buyerID)
 	if err != nil {
@@ -140,7 +185,7 @@ func (r *BidStore) ListAuctionsByBuyerID(ctx context.Context, buyerID int) ([]mo
 		FROM auctions a
 		JOIN auction_items ai ON a.id = ai.auction_id
 		JOIN transactions t ON ai.id = t.item_id
-		WHERE t.buyer_id = $1
+		WHERE t.buyer_id = $1 AND t.voided_at IS NULL
 		ORDER BY a.start_at DESC, a.created_at DESC
 	`, buyerID)
 	if err != nil {
@@ -167,3 +212,174 @@ func (r *BidStore) ListAuctionsByBuyerID(ctx context.Context, buyerID int) ([]mo
 	}
 	return auctions, dserrors.HandleError(rows.Err(), "Auction", buyerID, "ListAuctionsByBuyerID")
 }
+
+// ListAwardsByAuctionID returns the winning bid (落札) of each item in an auction.
+func (r *BidStore) ListAwardsByAuctionID(ctx context.Context, auctionID int) ([]model.Purchase, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT DISTINCT ON (t.item_id)
+			t.id,
+			t.item_id,
+			ai.fish_type,
+			ai.quantity,
+			ai.unit,
+			t.price,
+			t.buyer_id,
+			ai.fisherman_id,
+			ai.auction_id,
+			TO_CHAR(a.start_at AT TIME ZONE 'Asia/Tokyo', 'YYYY-MM-DD'),
+			t.created_at
+		FROM transactions t
+		JOIN auction_items ai ON t.item_id = ai.id
+		JOIN auctions a ON ai.auction_id = a.id
+		WHERE ai.auction_id = $1 AND ai.deleted_at IS NULL AND t.voided_at IS NULL
+		ORDER BY t.item_id, t.price DESC, t.created_at ASC
+	`, auctionID)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Purchase", auctionID, "ListAwardsByAuctionID")
+	}
+	defer func() { _ = rows.Close() }()
+
+	var awards []model.Purchase
+	for rows.Next() {
+		var p model.Purchase
+		if err := rows.Scan(
+			&p.ID,
+			&p.ItemID,
+			&p.FishType,
+			&p.Quantity,
+			&p.Unit,
+			&p.Price,
+			&p.BuyerID,
+			&p.FishermanID,
+			&p.AuctionID,
+			&p.AuctionDate,
+			&p.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		awards = append(awards, p)
+	}
+	return awards, dserrors.HandleError(rows.Err(), "Purchase", auctionID, "ListAwardsByAuctionID")
+}
+
+// UpdateAward rewrites the buyer and price of a winning transaction when a result correction is approved.
+func (r *BidStore) UpdateAward(ctx context.Context, id, buyerID, price int) error {
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE transactions
+		SET buyer_id = $1, price = $2
+		WHERE id = $3 AND voided_at IS NULL`,
+		buyerID, price, id,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Purchase", id, "UpdateAward")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Purchase", ID: id}
+	}
+	return nil
+}
+
+// ListHistoryByItemID returns up to limit bids on an item, newest first, with the bidder's name and paddle number
+// and the name of the login that placed the bid.
+// beforeID pages through the history: only bids with a smaller ID are returned, and 0 starts from the newest bid.
+// Voided bids are part of the audit history and are returned only when includeVoided is set.
+func (r *BidStore) ListHistoryByItemID(ctx context.Context, itemID, beforeID, limit int, includeVoided bool) ([]model.BidHistoryEntry, error) {
+	query := `
+		SELECT t.id, t.item_id, t.buyer_id, b.name, b.paddle_number, t.login_id, a.name, t.price, t.channel, t.entered_by, t.created_at,
+			t.voided_at, t.voided_by, t.void_reason
+		FROM transactions t
+		JOIN buyers b ON t.buyer_id = b.id
+		LEFT JOIN authentications a ON t.login_id = a.id
+		WHERE t.item_id = $1`
+	if !includeVoided {
+		query += " AND t.voided_at IS NULL"
+	}
+	args := []any{itemID}
+	if beforeID > 0 {
+		query += " AND t.id < $3"
+		args = append(args, limit, beforeID)
+	} else {
+		args = append(args, limit)
+	}
+	query += " ORDER BY t.id DESC LIMIT $2"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Bid", itemID, "ListHistoryByItemID")
+	}
+	defer func() { _ = rows.Close() }()
+
+	bids := []model.BidHistoryEntry{}
+	for rows.Next() {
+		var e model.BidHistoryEntry
+		if err := rows.Scan(
+			&e.ID, &e.ItemID, &e.BuyerID, &e.BuyerName, &e.BuyerPaddle, &e.LoginID, &e.LoginName,
+			&e.Price, &e.Channel, &e.EnteredBy, &e.CreatedAt,
+			&e.VoidedAt, &e.VoidedBy, &e.VoidReason,
+		); err != nil {
+			return nil, err
+		}
+		bids = append(bids, e)
+	}
+	return bids, dserrors.HandleError(rows.Err(), "Bid", itemID, "ListHistoryByItemID")
+}
+
+// FindByIDWithLock returns a bid, voided or not, and locks its row.
+func (r *BidStore) FindByIDWithLock(ctx context.Context, id int) (*model.Bid, error) {
+	var e entity.Bid
+	err := r.db.QueryRow(ctx, `
+		SELECT id, item_id, buyer_id, login_id, price, channel, entered_by, created_at, voided_at, voided_by, void_reason
+		FROM transactions
+		WHERE id = $1
+		FOR UPDATE`, id,
+	).Scan(&e.ID, &e.ItemID, &e.BuyerID, &e.LoginID, &e.Price, &e.Channel, &e.EnteredBy, &e.CreatedAt, &e.VoidedAt, &e.VoidedBy, &e.VoidReason)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Bid", id, "FindByIDWithLock")
+	}
+	return e.ToModel(), nil
+}
+
+// Void records that a bid was voided. The row is kept so the bid stays in the audit history.
+func (r *BidStore) Void(ctx context.Context, bid *model.Bid) error {
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE transactions
+		SET voided_at = $1, voided_by = $2, void_reason = $3
+		WHERE id = $4 AND voided_at IS NULL`,
+		bid.VoidedAt, bid.VoidedBy, bid.VoidReason, bid.ID,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Bid", bid.ID, "Void")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Bid", ID: bid.ID}
+	}
+	return nil
+}
+
+// SumLeadingByBuyerID returns the total of the bids a buyer currently leads on that have not yet been invoiced.
+// これには進行中のせりの最高値と、終了後まだ請求書を発行していない落札が含まれる。
+// 発行済みの請求書に載った落札は、請求残高として別に数える。
+func (r *BidStore) SumLeadingByBuyerID(ctx context.Context, buyerID int) (int, error) {
+	var total int
+	err := r.db.QueryRow(ctx, `
+		SELECT COALESCE(SUM(lead.price), 0)
+		FROM (
+			SELECT DISTINCT ON (t.item_id) t.buyer_id, t.price, ai.auction_id
+			FROM transactions t
+			JOIN auction_items ai ON t.item_id = ai.id
+			JOIN auctions a ON ai.auction_id = a.id
+			WHERE ai.deleted_at IS NULL AND t.voided_at IS NULL AND a.status <> 'cancelled'
+			ORDER BY t.item_id, t.price DESC, t.created_at ASC
+		) lead
+		WHERE lead.buyer_id = $1
+			AND NOT EXISTS (
+				SELECT 1 FROM invoices i
+				WHERE i.buyer_id = lead.buyer_id AND i.auction_id = lead.auction_id AND i.status IN ('issued', 'paid')
+			)`,
+		buyerID,
+	).Scan(&total)
+	if err != nil {
+		return 0, dserrors.HandleError(err, "Bid", buyerID, "SumLeadingByBuyerID")
+	}
+	return total, nil
+}
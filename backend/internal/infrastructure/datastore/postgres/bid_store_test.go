@@ -2,10 +2,12 @@ package postgres_test
 
 import (
 	"context"
+	"database/sql/driver"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
 	"github.com/stretchr/testify/assert"
@@ -23,16 +25,49 @@ func TestBidStore_Create(t *testing.T) {
 		ItemID:  101,
 		BuyerID: 1,
 		Price:   model.NewBidPrice(1500),
+		Channel: model.BidChannelFloor,
 	}
+	clerkID := 2
+	bid.EnteredBy = &clerkID
 
 	mock.ExpectQuery("INSERT INTO transactions").
-		WithArgs(bid.ItemID, bid.BuyerID, bid.Price.Amount()).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "buyer_id", "price", "created_at"}).
-			AddRow(1, bid.ItemID, bid.BuyerID, bid.Price.Amount(), time.Now()))
+		WithArgs(bid.ItemID, bid.BuyerID, nil, bid.Price.Amount(), "floor", &clerkID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "buyer_id", "login_id", "price", "channel", "entered_by", "created_at"}).
+			AddRow(1, bid.ItemID, bid.BuyerID, nil, bid.Price.Amount(), "floor", clerkID, time.Now()))
 
 	created, err := repo.Create(context.Background(), bid)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, created.ID)
+	assert.Equal(t, model.BidChannelFloor, created.Channel)
+	assert.Equal(t, clerkID, *created.EnteredBy)
+	assert.Nil(t, created.LoginID)
+}
+
+func TestBidStore_CreateWithLogin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewBidStore(postgres.NewClient(db))
+	loginID := 12
+	bid := &model.Bid{
+		ItemID:  101,
+		BuyerID: 1,
+		LoginID: &loginID,
+		Price:   model.NewBidPrice(1500),
+		Channel: model.BidChannelOnline,
+	}
+
+	mock.ExpectQuery("INSERT INTO transactions").
+		WithArgs(bid.ItemID, bid.BuyerID, &loginID, bid.Price.Amount(), "online", nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "buyer_id", "login_id", "price", "channel", "entered_by", "created_at"}).
+			AddRow(2, bid.ItemID, bid.BuyerID, loginID, bid.Price.Amount(), "online", nil, time.Now()))
+
+	created, err := repo.Create(context.Background(), bid)
+	assert.NoError(t, err)
+	assert.Equal(t, loginID, *created.LoginID)
 }
 
 func TestBidStore_ListPurchasesByBuyerID(t *testing.T) {
@@ -54,3 +89,180 @@ func TestBidStore_ListPurchasesByBuyerID(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, list, 1)
 }
+
+func TestBidStore_ListAwardsByAuctionID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewBidStore(postgres.NewClient(db))
+	auctionID := 7
+
+	mock.ExpectQuery("SELECT DISTINCT ON \\(t.item_id\\) .* FROM transactions t .* WHERE ai.auction_id = \\$1 .* AND t.voided_at IS NULL ORDER BY t.item_id, t.price DESC, t.created_at ASC").
+		WithArgs(auctionID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "fish_type", "quantity", "unit", "price", "buyer_id", "fisherman_id", "auction_id", "start_at", "created_at"}).
+			AddRow(1, 101, "Tuna", 1, "kg", 1500, 2, 9, auctionID, "2023-01-01", time.Now()).
+			AddRow(5, 102, "Mackerel", 3, "box", 800, 3, 9, auctionID, "2023-01-01", time.Now()))
+
+	list, err := repo.ListAwardsByAuctionID(context.Background(), auctionID)
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, 2, list[0].BuyerID)
+	assert.Equal(t, 9, list[0].FishermanID)
+}
+
+func TestBidStore_FindPurchaseByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewBidStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM transactions t .* WHERE t.id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "item_id", "fish_type", "quantity", "unit", "price", "buyer_id", "fisherman_id", "auction_id", "start_at", "created_at"}).
+			AddRow(1, 101, "Tuna", 1, "kg", 1500, 2, 9, 7, "2023-01-01", time.Now()))
+
+	p, err := repo.FindPurchaseByID(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 101, p.ItemID)
+	assert.Equal(t, 9, p.FishermanID)
+	assert.Equal(t, 7, p.AuctionID)
+}
+
+func TestBidStore_UpdateAward(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewBidStore(postgres.NewClient(db))
+
+	mock.ExpectExec("UPDATE transactions SET buyer_id = \\$1, price = \\$2 WHERE id = \\$3").
+		WithArgs(3, 4500, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.UpdateAward(context.Background(), 1, 3, 4500))
+}
+
+func TestBidStore_ListHistoryByItemID(t *testing.T) {
+	columns := []string{
+		"id", "item_id", "buyer_id", "name", "paddle_number", "login_id", "login_name", "price", "channel", "entered_by", "created_at",
+		"voided_at", "voided_by", "void_reason",
+	}
+
+	tests := []struct {
+		name          string
+		beforeID      int
+		includeVoided bool
+		query         string
+		args          []driver.Value
+	}{
+		{
+			name:  "FirstPage",
+			query: "WHERE t.item_id = \\$1 AND t.voided_at IS NULL ORDER BY t.id DESC LIMIT \\$2",
+			args:  []driver.Value{10, 3},
+		},
+		{
+			name:     "NextPage",
+			beforeID: 8,
+			query:    "WHERE t.item_id = \\$1 AND t.voided_at IS NULL AND t.id < \\$3 ORDER BY t.id DESC LIMIT \\$2",
+			args:     []driver.Value{10, 3, 8},
+		},
+		{
+			name:          "AuditHistory",
+			includeVoided: true,
+			query:         "WHERE t.item_id = \\$1 ORDER BY t.id DESC LIMIT \\$2",
+			args:          []driver.Value{10, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() { _ = db.Close() }()
+
+			repo := postgres.NewBidStore(postgres.NewClient(db))
+
+			clerkID := 4
+			mock.ExpectQuery("SELECT .* FROM transactions t JOIN buyers b ON t.buyer_id = b.id LEFT JOIN authentications a ON t.login_id = a.id " + tt.query).
+				WithArgs(tt.args...).
+				WillReturnRows(sqlmock.NewRows(columns).
+					AddRow(6, 10, 2, "Buyer2", "128", nil, nil, 3000, "floor", clerkID, time.Now(), nil, nil, nil).
+					AddRow(5, 10, 3, "Buyer3", nil, 12, "佐藤", 2500, "online", nil, time.Now(), nil, nil, nil))
+
+			bids, err := repo.ListHistoryByItemID(context.Background(), 10, tt.beforeID, 3, tt.includeVoided)
+			assert.NoError(t, err)
+			assert.Len(t, bids, 2)
+			assert.Equal(t, "128", *bids[0].BuyerPaddle)
+			assert.Equal(t, model.BidChannelFloor, bids[0].Channel)
+			assert.Equal(t, clerkID, *bids[0].EnteredBy)
+			assert.Nil(t, bids[1].BuyerPaddle)
+			assert.Nil(t, bids[0].LoginID)
+			assert.Equal(t, "佐藤", *bids[1].LoginName)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBidStore_Void(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewBidStore(postgres.NewClient(db))
+	at := time.Now()
+
+	mock.ExpectQuery("SELECT .* FROM transactions WHERE id = \\$1 FOR UPDATE").
+		WithArgs(6).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "item_id", "buyer_id", "login_id", "price", "channel", "entered_by", "created_at", "voided_at", "voided_by", "void_reason",
+		}).AddRow(6, 10, 2, 12, 30000, "online", nil, at, nil, nil, nil))
+
+	bid, err := repo.FindByIDWithLock(context.Background(), 6)
+	assert.NoError(t, err)
+	assert.Nil(t, bid.VoidedAt)
+	assert.NoError(t, bid.Void(4, "桁違い", at))
+
+	mock.ExpectExec("UPDATE transactions SET voided_at = \\$1, voided_by = \\$2, void_reason = \\$3 WHERE id = \\$4 AND voided_at IS NULL").
+		WithArgs(at, 4, "桁違い", 6).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Void(context.Background(), bid))
+
+	// 既に取り消し済みなら更新されない。
+	mock.ExpectExec("UPDATE transactions SET voided_at").
+		WithArgs(at, 4, "桁違い", 6).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	var notFound *apperrors.NotFoundError
+	assert.ErrorAs(t, repo.Void(context.Background(), bid), &notFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBidStore_SumLeadingByBuyerID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewBidStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT COALESCE\\(SUM\\(lead.price\\), 0\\).*WHERE lead.buyer_id = \\$1.*NOT EXISTS").
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(45000))
+
+	total, err := repo.SumLeadingByBuyerID(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 45000, total)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
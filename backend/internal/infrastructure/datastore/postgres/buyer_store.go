@@ -2,7 +2,9 @@ package postgres
 
 import (
 	"context"
+	"strings"
 
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
@@ -10,6 +12,11 @@ import (
 	"github.com/seka/fish-auction/backend/internal/infrastructure/entity"
 )
 
+const buyerColumns = "id, name, organization, contact_info, paddle_number, " +
+	"business_name, invoice_registration_number, address, phone, license_number, license_expires_on, " +
+	"credit_limit, deposit, " +
+	"registration_status, email_verified_at, rejection_reason, reviewed_by, reviewed_at"
+
 // BuyerStore implements repository.BuyerRepository using PostgreSQL.
 type BuyerStore struct {
 	db datastore.Database
@@ -22,30 +29,45 @@ func NewBuyerStore(db datastore.Database) *BuyerStore {
 	return &BuyerStore{db: db}
 }
 
-// Create stores a new buyer.
+// Create stores a new buyer with its profile.
+// A buyer without a registration status is created approved, as buyers registered by an admin need no review.
 func (r *BuyerStore) Create(ctx context.Context, buyer *model.Buyer) (*model.Buyer, error) {
 	e := entity.Buyer{
-		Name:         buyer.Name,
-		Organization: buyer.Organization,
-		ContactInfo:  buyer.ContactInfo,
+		Name:               buyer.Name,
+		Organization:       buyer.Organization,
+		ContactInfo:        buyer.ContactInfo,
+		RegistrationStatus: string(buyer.Registration.Status),
 	}
+	e.SetProfile(buyer.BuyerProfile)
 	if err := e.Validate(); err != nil {
 		return nil, err
 	}
+	e.SetPaddleNumber(buyer.PaddleNumber)
+	if e.RegistrationStatus == "" {
+		e.RegistrationStatus = string(model.BuyerRegistrationApproved)
+	}
 
-	err := r.db.QueryRow(ctx,
-		"INSERT INTO buyers (name, organization, contact_info) VALUES ($1, $2, $3) RETURNING id",
-		e.Name, e.Organization, e.ContactInfo).Scan(&e.ID)
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO buyers (
+			name, organization, contact_info, paddle_number,
+			business_name, invoice_registration_number, address, phone, license_number, license_expires_on,
+			registration_status
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id`,
+		e.Name, e.Organization, e.ContactInfo, e.PaddleNumber,
+		e.BusinessName, e.InvoiceRegistrationNumber, e.Address, e.Phone, e.LicenseNumber, e.LicenseExpiresOn,
+		e.RegistrationStatus,
+	).Scan(&e.ID)
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Buyer", 0, "Create")
 	}
 	buyer.ID = e.ID
+	buyer.Registration.Status = model.BuyerRegistrationStatus(e.RegistrationStatus)
 	return buyer, nil
 }
 
 // List returns all active buyers.
 func (r *BuyerStore) List(ctx context.Context) ([]model.Buyer, error) {
-	rows, err := r.db.Query(ctx, "SELECT id, name, organization, contact_info FROM buyers WHERE deleted_at IS NULL")
+	rows, err := r.db.Query(ctx, "SELECT "+buyerColumns+" FROM buyers WHERE deleted_at IS NULL")
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Buyer", 0, "List")
 	}
@@ -53,8 +75,8 @@ func (r *BuyerStore) List(ctx context.Context) ([]model.Buyer, error) {
 
 	var buyers []model.Buyer
 	for rows.Next() {
-		var e entity.Buyer
-		if err := rows.Scan(&e.ID, &e.Name, &e.Organization, &e.ContactInfo); err != nil {
+		e, err := scanBuyer(rows)
+		if err != nil {
 			return nil, err
 		}
 		buyers = append(buyers, *e.ToModel())
@@ -64,11 +86,10 @@ func (r *BuyerStore) List(ctx context.Context) ([]model.Buyer, error) {
 
 // FindByID returns a buyer by its ID.
 func (r *BuyerStore) FindByID(ctx context.Context, id int) (*model.Buyer, error) {
-	var e entity.Buyer
-	err := r.db.QueryRow(ctx,
-		"SELECT id, name, organization, contact_info FROM buyers WHERE id = $1",
+	e, err := scanBuyer(r.db.QueryRow(ctx,
+		"SELECT "+buyerColumns+" FROM buyers WHERE id = $1",
 		id,
-	).Scan(&e.ID, &e.Name, &e.Organization, &e.ContactInfo)
+	))
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Buyer", id, "FindByID")
 	}
@@ -78,11 +99,10 @@ func (r *BuyerStore) FindByID(ctx context.Context, id int) (*model.Buyer, error)
 
 // FindByName returns a buyer by its name.
 func (r *BuyerStore) FindByName(ctx context.Context, name string) (*model.Buyer, error) {
-	var e entity.Buyer
-	err := r.db.QueryRow(ctx,
-		"SELECT id, name, organization, contact_info FROM buyers WHERE name = $1 AND deleted_at IS NULL",
+	e, err := scanBuyer(r.db.QueryRow(ctx,
+		"SELECT "+buyerColumns+" FROM buyers WHERE name = $1 AND deleted_at IS NULL",
 		name,
-	).Scan(&e.ID, &e.Name, &e.Organization, &e.ContactInfo)
+	))
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Buyer", 0, "FindByName")
 	}
@@ -91,20 +111,139 @@ func (r *BuyerStore) FindByName(ctx context.Context, name string) (*model.Buyer,
 
 // FindByEmail returns a buyer by its authentication email.
 func (r *BuyerStore) FindByEmail(ctx context.Context, email string) (*model.Buyer, error) {
-	var e entity.Buyer
 	query := `
-		SELECT b.id, b.name, b.organization, b.contact_info
-		FROM buyers b
-		JOIN authentications a ON b.id = a.buyer_id
-		WHERE a.email = $1 AND b.deleted_at IS NULL
+		SELECT ` + buyerColumns + `
+		FROM buyers
+		WHERE id = (SELECT buyer_id FROM authentications WHERE email = $1) AND deleted_at IS NULL
 	`
-	err := r.db.QueryRow(ctx, query, email).Scan(&e.ID, &e.Name, &e.Organization, &e.ContactInfo)
+	e, err := scanBuyer(r.db.QueryRow(ctx, query, email))
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Buyer", 0, "FindByEmail")
 	}
 	return e.ToModel(), nil
 }
 
+// Update stores a buyer's details and profile. The paddle number and credit terms are left as is.
+func (r *BuyerStore) Update(ctx context.Context, buyer *model.Buyer) error {
+	e := entity.Buyer{
+		Name:         strings.TrimSpace(buyer.Name),
+		Organization: strings.TrimSpace(buyer.Organization),
+		ContactInfo:  strings.TrimSpace(buyer.ContactInfo),
+	}
+	e.SetProfile(buyer.BuyerProfile)
+	if err := e.Validate(); err != nil {
+		return err
+	}
+
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE buyers
+		SET name = $1, organization = $2, contact_info = $3,
+			business_name = $4, invoice_registration_number = $5, address = $6, phone = $7,
+			license_number = $8, license_expires_on = $9
+		WHERE id = $10 AND deleted_at IS NULL`,
+		e.Name, e.Organization, e.ContactInfo,
+		e.BusinessName, e.InvoiceRegistrationNumber, e.Address, e.Phone,
+		e.LicenseNumber, e.LicenseExpiresOn, buyer.ID,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Buyer", buyer.ID, "Update")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Buyer", ID: buyer.ID}
+	}
+	return nil
+}
+
+// UpdatePaddleNumber assigns a paddle number to a buyer. An empty number clears it.
+func (r *BuyerStore) UpdatePaddleNumber(ctx context.Context, id int, paddleNumber string) error {
+	var e entity.Buyer
+	e.SetPaddleNumber(paddleNumber)
+
+	rowsAffected, err := r.db.Execute(ctx,
+		"UPDATE buyers SET paddle_number = $1 WHERE id = $2 AND deleted_at IS NULL",
+		e.PaddleNumber, id,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Buyer", id, "UpdatePaddleNumber")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Buyer", ID: id}
+	}
+	return nil
+}
+
+// FindByIDWithLock returns a buyer by its ID and locks the row until the transaction ends.
+func (r *BuyerStore) FindByIDWithLock(ctx context.Context, id int) (*model.Buyer, error) {
+	e, err := scanBuyer(r.db.QueryRow(ctx,
+		"SELECT "+buyerColumns+" FROM buyers WHERE id = $1 FOR UPDATE",
+		id,
+	))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Buyer", id, "FindByIDWithLock")
+	}
+	return e.ToModel(), nil
+}
+
+// UpdateCreditTerms sets a buyer's credit limit and deposit.
+func (r *BuyerStore) UpdateCreditTerms(ctx context.Context, id int, terms model.CreditTerms) error {
+	rowsAffected, err := r.db.Execute(ctx,
+		"UPDATE buyers SET credit_limit = $1, deposit = $2 WHERE id = $3 AND deleted_at IS NULL",
+		terms.CreditLimit, terms.Deposit, id,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Buyer", id, "UpdateCreditTerms")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Buyer", ID: id}
+	}
+	return nil
+}
+
+// UpdateRegistration stores the email verification and review of a buyer's registration.
+func (r *BuyerStore) UpdateRegistration(ctx context.Context, id int, reg model.BuyerRegistration) error {
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE buyers
+		SET registration_status = $1, email_verified_at = $2, rejection_reason = $3, reviewed_by = $4, reviewed_at = $5
+		WHERE id = $6 AND deleted_at IS NULL`,
+		string(reg.Status), reg.EmailVerifiedAt, reg.RejectionReason, reg.ReviewedBy, reg.ReviewedAt, id,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Buyer", id, "UpdateRegistration")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Buyer", ID: id}
+	}
+	return nil
+}
+
+// ListPendingApplications returns the self-registrations awaiting review, oldest verification first.
+// The email is the owner login's; staff logins of the same buyer are not listed.
+// メールアドレスを確認していない申請は審査できないため含めない。
+func (r *BuyerStore) ListPendingApplications(ctx context.Context) ([]model.BuyerApplication, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+buyerColumns+`, (SELECT email FROM authentications WHERE buyer_id = buyers.id AND role = $2)
+		FROM buyers
+		WHERE registration_status = $1 AND email_verified_at IS NOT NULL AND deleted_at IS NULL
+		ORDER BY email_verified_at, id`,
+		string(model.BuyerRegistrationPending), string(model.BuyerLoginOwner),
+	)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Buyer", 0, "ListPendingApplications")
+	}
+	defer func() { _ = rows.Close() }()
+
+	var apps []model.BuyerApplication
+	for rows.Next() {
+		var email string
+		e, err := scanBuyer(rows, &email)
+		if err != nil {
+			return nil, dserrors.HandleError(err, "Buyer", 0, "ListPendingApplications")
+		}
+		apps = append(apps, model.BuyerApplication{Buyer: *e.ToModel(), Email: email})
+	}
+	return apps, dserrors.HandleError(rows.Err(), "Buyer", 0, "ListPendingApplications")
+}
+
 // Delete marks a buyer as deleted.
 func (r *BuyerStore) Delete(ctx context.Context, id int) error {
 	_, err := r.db.Execute(ctx, "UPDATE buyers SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1", id)
@@ -113,3 +252,18 @@ func (r *BuyerStore) Delete(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// scanBuyer scans the buyerColumns of a row followed by any extra columns.
+func scanBuyer(row datastore.Row, extra ...any) (*entity.Buyer, error) {
+	var e entity.Buyer
+	dest := []any{
+		&e.ID, &e.Name, &e.Organization, &e.ContactInfo, &e.PaddleNumber,
+		&e.BusinessName, &e.InvoiceRegistrationNumber, &e.Address, &e.Phone, &e.LicenseNumber, &e.LicenseExpiresOn,
+		&e.CreditLimit, &e.Deposit,
+		&e.RegistrationStatus, &e.EmailVerifiedAt, &e.RejectionReason, &e.ReviewedBy, &e.ReviewedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
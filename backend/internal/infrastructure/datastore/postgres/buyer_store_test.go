@@ -3,8 +3,10 @@ package postgres_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
 	"github.com/stretchr/testify/assert"
@@ -21,12 +23,13 @@ func TestBuyerStore_Create(t *testing.T) {
 	buyer := &model.Buyer{Name: "Buyer1", Organization: "Org1", ContactInfo: "Contact1"}
 
 	mock.ExpectQuery("INSERT INTO buyers").
-		WithArgs(buyer.Name, buyer.Organization, buyer.ContactInfo).
+		WithArgs(buyer.Name, buyer.Organization, buyer.ContactInfo, nil, "", "", "", "", "", nil, "approved").
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 	created, err := repo.Create(context.Background(), buyer)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, created.ID)
+	assert.Equal(t, model.BuyerRegistrationApproved, created.Registration.Status)
 }
 
 func TestBuyerStore_FindByID(t *testing.T) {
@@ -39,14 +42,159 @@ func TestBuyerStore_FindByID(t *testing.T) {
 	repo := postgres.NewBuyerStore(postgres.NewClient(db))
 	id := 1
 
-	mock.ExpectQuery("SELECT id, name, organization, contact_info FROM buyers WHERE id = \\$1").
+	expires := time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("SELECT id, name, organization, contact_info, paddle_number, business_name, .+ FROM buyers WHERE id = \\$1").
 		WithArgs(id).
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization", "contact_info"}).
-			AddRow(1, "Buyer1", "Org1", "Contact1"))
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "name", "organization", "contact_info", "paddle_number",
+			"business_name", "invoice_registration_number", "address", "phone", "license_number", "license_expires_on",
+			"credit_limit", "deposit",
+			"registration_status", "email_verified_at", "rejection_reason", "reviewed_by", "reviewed_at",
+		}).
+			AddRow(1, "Buyer1", "Org1", "Contact1", "128", "株式会社魚一", "T1234567890123", "石巻市魚町1-1", "0225-00-0000", "第42号", expires, 500000, 100000,
+				"approved", nil, "", nil, nil))
 
 	found, err := repo.FindByID(context.Background(), id)
 	assert.NoError(t, err)
 	assert.Equal(t, id, found.ID)
+	assert.Equal(t, "128", found.PaddleNumber)
+	assert.Equal(t, new(500000), found.CreditLimit)
+	assert.Equal(t, 100000, found.Deposit)
+	assert.Equal(t, "T1234567890123", found.InvoiceRegistrationNumber)
+	assert.Equal(t, &expires, found.LicenseExpiresOn)
+	assert.Equal(t, model.BuyerRegistrationApproved, found.Registration.Status)
+}
+
+func TestBuyerStore_UpdateRegistration(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	admin := 3
+	reg := model.BuyerRegistration{
+		Status:          model.BuyerRegistrationRejected,
+		EmailVerifiedAt: &now,
+		RejectionReason: "買参権が確認できません",
+		ReviewedBy:      &admin,
+		ReviewedAt:      &now,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewBuyerStore(postgres.NewClient(db))
+
+		mock.ExpectExec("UPDATE buyers\\s+SET registration_status = \\$1").
+			WithArgs("rejected", &now, "買参権が確認できません", &admin, &now, 7).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err = repo.UpdateRegistration(context.Background(), 7, reg)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewBuyerStore(postgres.NewClient(db))
+
+		mock.ExpectExec("UPDATE buyers").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = repo.UpdateRegistration(context.Background(), 9, reg)
+		var nfErr *apperrors.NotFoundError
+		assert.ErrorAs(t, err, &nfErr)
+	})
+}
+
+func TestBuyerStore_ListPendingApplications(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+	repo := postgres.NewBuyerStore(postgres.NewClient(db))
+
+	// 担当者ログインを持つ申請でも代表者のアドレス 1 件に絞る（サブクエリが複数行を返さない）
+	verified := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	mock.ExpectQuery("SELECT .+, \\(SELECT email FROM authentications WHERE buyer_id = buyers.id AND role = \\$2\\)\\s+FROM buyers\\s+WHERE registration_status = \\$1 AND email_verified_at IS NOT NULL").
+		WithArgs("pending", "owner").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "name", "organization", "contact_info", "paddle_number",
+			"business_name", "invoice_registration_number", "address", "phone", "license_number", "license_expires_on",
+			"credit_limit", "deposit",
+			"registration_status", "email_verified_at", "rejection_reason", "reviewed_by", "reviewed_at",
+			"email",
+		}).
+			AddRow(7, "魚一", "株式会社魚一", "0225-00-0000", nil, "株式会社魚一", "", "", "", "", nil, nil, 0,
+				"pending", verified, "", nil, nil, "new@example.com"))
+
+	apps, err := repo.ListPendingApplications(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, apps, 1)
+	assert.Equal(t, 7, apps[0].ID)
+	assert.Equal(t, "new@example.com", apps[0].Email)
+	assert.Equal(t, model.BuyerRegistrationPending, apps[0].Registration.Status)
+	assert.Equal(t, &verified, apps[0].Registration.EmailVerifiedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuyerStore_Update(t *testing.T) {
+	profile := model.BuyerProfile{
+		BusinessName:              " 株式会社魚一 ",
+		InvoiceRegistrationNumber: "t1234567890123",
+		Phone:                     "0225-00-0000",
+		LicenseNumber:             "第42号",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewBuyerStore(postgres.NewClient(db))
+
+		mock.ExpectExec("UPDATE buyers").
+			WithArgs("Buyer1", "Org1", "Contact1", "株式会社魚一", "T1234567890123", "", "0225-00-0000", "第42号", nil, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err = repo.Update(context.Background(), &model.Buyer{ID: 1, Name: "Buyer1", Organization: "Org1", ContactInfo: "Contact1", BuyerProfile: profile})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewBuyerStore(postgres.NewClient(db))
+
+		mock.ExpectExec("UPDATE buyers").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = repo.Update(context.Background(), &model.Buyer{ID: 9, Name: "Buyer1", Organization: "Org1", ContactInfo: "Contact1"})
+		var nfErr *apperrors.NotFoundError
+		assert.ErrorAs(t, err, &nfErr)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewBuyerStore(postgres.NewClient(db))
+
+		invalid := profile
+		invalid.InvoiceRegistrationNumber = "1234567890123"
+		err = repo.Update(context.Background(), &model.Buyer{ID: 1, Name: "Buyer1", Organization: "Org1", ContactInfo: "Contact1", BuyerProfile: invalid})
+		var vErr *apperrors.ValidationError
+		assert.ErrorAs(t, err, &vErr)
+	})
 }
 
 func TestBuyerStore_Delete(t *testing.T) {
@@ -66,3 +214,81 @@ func TestBuyerStore_Delete(t *testing.T) {
 	err = repo.Delete(context.Background(), id)
 	assert.NoError(t, err)
 }
+
+func TestBuyerStore_UpdatePaddleNumber(t *testing.T) {
+	tests := []struct {
+		name     string
+		paddle   string
+		wantArg  any
+		affected int64
+		wantErr  bool
+	}{
+		{name: "Assign", paddle: "128", wantArg: "128", affected: 1},
+		{name: "Clear", paddle: "", wantArg: nil, affected: 1},
+		{name: "NotFound", paddle: "128", wantArg: "128", affected: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() { _ = db.Close() }()
+
+			repo := postgres.NewBuyerStore(postgres.NewClient(db))
+
+			mock.ExpectExec("UPDATE buyers SET paddle_number = \\$1 WHERE id = \\$2 AND deleted_at IS NULL").
+				WithArgs(tt.wantArg, 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			err = repo.UpdatePaddleNumber(context.Background(), 1, tt.paddle)
+			if tt.wantErr {
+				var notFound *apperrors.NotFoundError
+				assert.ErrorAs(t, err, &notFound)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBuyerStore_UpdateCreditTerms(t *testing.T) {
+	tests := []struct {
+		name     string
+		terms    model.CreditTerms
+		wantArg  any
+		affected int64
+		wantErr  bool
+	}{
+		{name: "SetLimit", terms: model.CreditTerms{CreditLimit: new(500000), Deposit: 100000}, wantArg: 500000, affected: 1},
+		{name: "RemoveLimit", terms: model.CreditTerms{Deposit: 100000}, wantArg: nil, affected: 1},
+		{name: "NotFound", terms: model.CreditTerms{CreditLimit: new(500000), Deposit: 100000}, wantArg: 500000, affected: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() { _ = db.Close() }()
+
+			repo := postgres.NewBuyerStore(postgres.NewClient(db))
+
+			mock.ExpectExec("UPDATE buyers SET credit_limit = \\$1, deposit = \\$2 WHERE id = \\$3 AND deleted_at IS NULL").
+				WithArgs(tt.wantArg, 100000, 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			err = repo.UpdateCreditTerms(context.Background(), 1, tt.terms)
+			if tt.wantErr {
+				var notFound *apperrors.NotFoundError
+				assert.ErrorAs(t, err, &notFound)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.BuyerSuspensionRepository = (*BuyerSuspensionStore)(nil)
+
+const buyerSuspensionColumns = `id, buyer_id, severity, reason, starts_at, ends_at, created_by, lifted_at, lifted_by, created_at`
+
+// BuyerSuspensionStore implements repository.BuyerSuspensionRepository using PostgreSQL.
+type BuyerSuspensionStore struct {
+	db datastore.Database
+}
+
+// NewBuyerSuspensionStore creates a new instance of BuyerSuspensionRepository
+func NewBuyerSuspensionStore(db datastore.Database) *BuyerSuspensionStore {
+	return &BuyerSuspensionStore{db: db}
+}
+
+// Create stores a new suspension.
+func (r *BuyerSuspensionStore) Create(ctx context.Context, s *model.BuyerSuspension) (*model.BuyerSuspension, error) {
+	v := *s
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO buyer_suspensions (buyer_id, severity, reason, starts_at, ends_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at`,
+		v.BuyerID, string(v.Severity), v.Reason, v.StartsAt, v.EndsAt, v.CreatedBy,
+	).Scan(&v.ID, &v.CreatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "BuyerSuspension", 0, "Create")
+	}
+	return &v, nil
+}
+
+// FindByID returns a suspension.
+func (r *BuyerSuspensionStore) FindByID(ctx context.Context, id int) (*model.BuyerSuspension, error) {
+	s, err := scanBuyerSuspension(r.db.QueryRow(ctx,
+		`SELECT `+buyerSuspensionColumns+` FROM buyer_suspensions WHERE id = $1`, id))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "BuyerSuspension", id, "FindByID")
+	}
+	return s, nil
+}
+
+// List returns suspensions matching the filters, newest first.
+// 解除済み・期間満了のものも監査のためにすべて残っている。
+func (r *BuyerSuspensionStore) List(ctx context.Context, filters *repository.BuyerSuspensionFilters) ([]model.BuyerSuspension, error) {
+	query := `SELECT ` + buyerSuspensionColumns + ` FROM buyer_suspensions`
+
+	var conditions []string
+	var args []any
+	argIndex := 1
+
+	if filters != nil {
+		if filters.BuyerID != nil {
+			conditions = append(conditions, fmt.Sprintf("buyer_id = $%d", argIndex))
+			args = append(args, *filters.BuyerID)
+			argIndex++
+		}
+		if filters.ActiveAt != nil {
+			conditions = append(conditions, fmt.Sprintf(
+				"lifted_at IS NULL AND starts_at <= $%d AND (ends_at IS NULL OR ends_at > $%d)", argIndex, argIndex))
+			args = append(args, *filters.ActiveAt)
+		}
+	}
+
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY created_at DESC, id DESC"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "BuyerSuspension", 0, "List")
+	}
+	defer func() { _ = rows.Close() }()
+
+	suspensions := []model.BuyerSuspension{}
+	for rows.Next() {
+		s, err := scanBuyerSuspension(rows)
+		if err != nil {
+			return nil, err
+		}
+		suspensions = append(suspensions, *s)
+	}
+	return suspensions, dserrors.HandleError(rows.Err(), "BuyerSuspension", 0, "List")
+}
+
+// Lift records that an admin ended the suspension early.
+func (r *BuyerSuspensionStore) Lift(ctx context.Context, s *model.BuyerSuspension) error {
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE buyer_suspensions
+		SET lifted_at = $1, lifted_by = $2
+		WHERE id = $3 AND lifted_at IS NULL`,
+		s.LiftedAt, s.LiftedBy, s.ID,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "BuyerSuspension", s.ID, "Lift")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "BuyerSuspension", ID: s.ID}
+	}
+	return nil
+}
+
+func scanBuyerSuspension(row datastore.Row) (*model.BuyerSuspension, error) {
+	var s model.BuyerSuspension
+	if err := row.Scan(
+		&s.ID, &s.BuyerID, &s.Severity, &s.Reason, &s.StartsAt, &s.EndsAt, &s.CreatedBy, &s.LiftedAt, &s.LiftedBy, &s.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
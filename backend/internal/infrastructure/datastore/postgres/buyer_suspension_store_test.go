@@ -0,0 +1,128 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+var buyerSuspensionRowColumns = []string{
+	"id", "buyer_id", "severity", "reason", "starts_at", "ends_at", "created_by", "lifted_at", "lifted_by", "created_at",
+}
+
+func TestBuyerSuspensionStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewBuyerSuspensionStore(postgres.NewClient(db))
+	start := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("INSERT INTO buyer_suspensions").
+		WithArgs(1, "login", "未払い", start, nil, 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(3, time.Now()))
+
+	created, err := repo.Create(context.Background(), &model.BuyerSuspension{
+		BuyerID: 1, Severity: model.SuspensionSeverityLogin, Reason: "未払い", StartsAt: start, CreatedBy: 7,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, created.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuyerSuspensionStore_List(t *testing.T) {
+	now := time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)
+	buyerID := 1
+
+	tests := []struct {
+		name      string
+		filters   *repository.BuyerSuspensionFilters
+		wantQuery string
+		wantArgs  []driver.Value
+	}{
+		{
+			name:      "All",
+			wantQuery: "SELECT .* FROM buyer_suspensions ORDER BY created_at DESC, id DESC",
+		},
+		{
+			name:    "ActiveForBuyer",
+			filters: &repository.BuyerSuspensionFilters{BuyerID: &buyerID, ActiveAt: &now},
+			wantQuery: "SELECT .* FROM buyer_suspensions WHERE buyer_id = \\$1 AND lifted_at IS NULL AND starts_at <= \\$2 " +
+				"AND \\(ends_at IS NULL OR ends_at > \\$2\\) ORDER BY created_at DESC, id DESC",
+			wantArgs: []driver.Value{1, now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() { _ = db.Close() }()
+
+			repo := postgres.NewBuyerSuspensionStore(postgres.NewClient(db))
+
+			q := mock.ExpectQuery(tt.wantQuery)
+			if tt.wantArgs != nil {
+				q.WithArgs(tt.wantArgs...)
+			}
+			q.WillReturnRows(sqlmock.NewRows(buyerSuspensionRowColumns).
+				AddRow(3, 1, "bidding", "未払い", now.Add(-time.Hour), nil, 7, nil, nil, now.Add(-time.Hour)))
+
+			suspensions, err := repo.List(context.Background(), tt.filters)
+			assert.NoError(t, err)
+			assert.Len(t, suspensions, 1)
+			assert.Equal(t, model.SuspensionSeverityBidding, suspensions[0].Severity)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestBuyerSuspensionStore_Lift(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		wantErr  bool
+	}{
+		{name: "Success", affected: 1},
+		{name: "AlreadyLifted", affected: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() { _ = db.Close() }()
+
+			repo := postgres.NewBuyerSuspensionStore(postgres.NewClient(db))
+			now := time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC)
+			adminID := 7
+
+			mock.ExpectExec("UPDATE buyer_suspensions SET lifted_at = \\$1, lifted_by = \\$2 WHERE id = \\$3 AND lifted_at IS NULL").
+				WithArgs(&now, &adminID, 3).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			err = repo.Lift(context.Background(), &model.BuyerSuspension{ID: 3, LiftedAt: &now, LiftedBy: &adminID})
+			if tt.wantErr {
+				var notFound *apperrors.NotFoundError
+				assert.ErrorAs(t, err, &notFound)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"context"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var (
+	_ repository.ChargeItemRepository  = (*ChargeItemStore)(nil)
+	_ repository.BuyerChargeRepository = (*BuyerChargeStore)(nil)
+)
+
+const chargeItemColumns = `id, venue_id, name, unit, unit_price, tax_rate, active, created_at, updated_at`
+
+const buyerChargeColumns = `
+	c.id, c.auction_id, c.buyer_id, b.name, c.charge_item_id, c.description, c.unit,
+	c.unit_price, c.tax_rate, c.quantity, c.note, c.recorded_by, c.created_at`
+
+// ChargeItemStore implements repository.ChargeItemRepository using PostgreSQL.
+type ChargeItemStore struct {
+	db datastore.Database
+}
+
+// NewChargeItemStore creates a new instance of ChargeItemRepository
+func NewChargeItemStore(db datastore.Database) *ChargeItemStore {
+	return &ChargeItemStore{db: db}
+}
+
+// Create stores a new catalog entry.
+func (r *ChargeItemStore) Create(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error) {
+	query := `INSERT INTO charge_items (venue_id, name, unit, unit_price, tax_rate, active)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING ` + chargeItemColumns
+
+	c, err := scanChargeItem(r.db.QueryRow(ctx, query,
+		item.VenueID, item.Name, item.Unit, item.UnitPrice, item.TaxRate, item.Active))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "ChargeItem", 0, "Create")
+	}
+	return c, nil
+}
+
+// FindByID returns a catalog entry by its ID.
+func (r *ChargeItemStore) FindByID(ctx context.Context, id int) (*model.ChargeItem, error) {
+	query := `SELECT ` + chargeItemColumns + ` FROM charge_items WHERE id = $1`
+
+	c, err := scanChargeItem(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "ChargeItem", id, "FindByID")
+	}
+	return c, nil
+}
+
+// ListByVenueID returns the catalog of a venue, active entries first.
+func (r *ChargeItemStore) ListByVenueID(ctx context.Context, venueID int) ([]model.ChargeItem, error) {
+	query := `SELECT ` + chargeItemColumns + `
+		FROM charge_items
+		WHERE venue_id = $1
+		ORDER BY active DESC, name ASC`
+
+	rows, err := r.db.Query(ctx, query, venueID)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "ChargeItem", venueID, "ListByVenueID")
+	}
+	defer func() { _ = rows.Close() }()
+
+	items := []model.ChargeItem{}
+	for rows.Next() {
+		c, err := scanChargeItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, *c)
+	}
+	return items, dserrors.HandleError(rows.Err(), "ChargeItem", venueID, "ListByVenueID")
+}
+
+// Update replaces the name, unit, price, tax rate and active flag of a catalog entry.
+// 計上済みの諸掛は計上時点の値を保持しているため影響を受けない。
+func (r *ChargeItemStore) Update(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error) {
+	query := `UPDATE charge_items
+		SET name = $1, unit = $2, unit_price = $3, tax_rate = $4, active = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $6
+		RETURNING ` + chargeItemColumns
+
+	c, err := scanChargeItem(r.db.QueryRow(ctx, query,
+		item.Name, item.Unit, item.UnitPrice, item.TaxRate, item.Active, item.ID))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "ChargeItem", item.ID, "Update")
+	}
+	return c, nil
+}
+
+func scanChargeItem(row datastore.Row) (*model.ChargeItem, error) {
+	var c model.ChargeItem
+	if err := row.Scan(&c.ID, &c.VenueID, &c.Name, &c.Unit, &c.UnitPrice, &c.TaxRate, &c.Active, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// BuyerChargeStore implements repository.BuyerChargeRepository using PostgreSQL.
+type BuyerChargeStore struct {
+	db datastore.Database
+}
+
+// NewBuyerChargeStore creates a new instance of BuyerChargeRepository
+func NewBuyerChargeStore(db datastore.Database) *BuyerChargeStore {
+	return &BuyerChargeStore{db: db}
+}
+
+// Create stores a new buyer charge.
+func (r *BuyerChargeStore) Create(ctx context.Context, charge *model.BuyerCharge) (*model.BuyerCharge, error) {
+	c := *charge
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO buyer_charges (auction_id, buyer_id, charge_item_id, description, unit, unit_price, tax_rate, quantity, note, recorded_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at`,
+		c.AuctionID, c.BuyerID, c.ChargeItemID, c.Description, c.Unit, c.UnitPrice, c.TaxRate, c.Quantity, c.Note, c.RecordedBy,
+	).Scan(&c.ID, &c.CreatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "BuyerCharge", 0, "Create")
+	}
+	return &c, nil
+}
+
+// FindByID returns a buyer charge by its ID.
+func (r *BuyerChargeStore) FindByID(ctx context.Context, id int) (*model.BuyerCharge, error) {
+	query := `SELECT ` + buyerChargeColumns + `
+		FROM buyer_charges c
+		JOIN buyers b ON c.buyer_id = b.id
+		WHERE c.id = $1`
+
+	c, err := scanBuyerCharge(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "BuyerCharge", id, "FindByID")
+	}
+	return c, nil
+}
+
+// ListByAuctionID returns the charges billed for an auction ordered by buyer.
+func (r *BuyerChargeStore) ListByAuctionID(ctx context.Context, auctionID int) ([]model.BuyerCharge, error) {
+	query := `SELECT ` + buyerChargeColumns + `
+		FROM buyer_charges c
+		JOIN buyers b ON c.buyer_id = b.id
+		WHERE c.auction_id = $1
+		ORDER BY c.buyer_id ASC, c.id ASC`
+
+	rows, err := r.db.Query(ctx, query, auctionID)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "BuyerCharge", auctionID, "ListByAuctionID")
+	}
+	defer func() { _ = rows.Close() }()
+
+	charges := []model.BuyerCharge{}
+	for rows.Next() {
+		c, err := scanBuyerCharge(rows)
+		if err != nil {
+			return nil, err
+		}
+		charges = append(charges, *c)
+	}
+	return charges, dserrors.HandleError(rows.Err(), "BuyerCharge", auctionID, "ListByAuctionID")
+}
+
+// Delete removes a buyer charge.
+func (r *BuyerChargeStore) Delete(ctx context.Context, id int) error {
+	rowsAffected, err := r.db.Execute(ctx, `DELETE FROM buyer_charges WHERE id = $1`, id)
+	if err != nil {
+		return dserrors.HandleError(err, "BuyerCharge", id, "Delete")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "BuyerCharge", ID: id}
+	}
+	return nil
+}
+
+func scanBuyerCharge(row datastore.Row) (*model.BuyerCharge, error) {
+	var c model.BuyerCharge
+	if err := row.Scan(
+		&c.ID, &c.AuctionID, &c.BuyerID, &c.BuyerName, &c.ChargeItemID, &c.Description, &c.Unit,
+		&c.UnitPrice, &c.TaxRate, &c.Quantity, &c.Note, &c.RecordedBy, &c.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
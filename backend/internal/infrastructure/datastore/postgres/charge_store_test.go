@@ -0,0 +1,127 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+var chargeItemRowColumns = []string{"id", "venue_id", "name", "unit", "unit_price", "tax_rate", "active", "created_at", "updated_at"}
+
+var buyerChargeRowColumns = []string{
+	"id", "auction_id", "buyer_id", "name", "charge_item_id", "description", "unit",
+	"unit_price", "tax_rate", "quantity", "note", "recorded_by", "created_at",
+}
+
+func TestChargeItemStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewChargeItemStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("INSERT INTO charge_items").
+		WithArgs(1, "氷", "袋", 300, 10, true).
+		WillReturnRows(sqlmock.NewRows(chargeItemRowColumns).AddRow(5, 1, "氷", "袋", 300, 10, true, time.Now(), time.Now()))
+
+	item, err := repo.Create(context.Background(), &model.ChargeItem{
+		VenueID: 1, Name: "氷", Unit: "袋", UnitPrice: 300, TaxRate: model.TaxRateStandard, Active: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, item.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChargeItemStore_Update_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewChargeItemStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("UPDATE charge_items").
+		WithArgs("氷", "袋", 350, 10, false, 99).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.Update(context.Background(), &model.ChargeItem{
+		ID: 99, Name: "氷", Unit: "袋", UnitPrice: 350, TaxRate: model.TaxRateStandard,
+	})
+	var nfErr *apperrors.NotFoundError
+	assert.ErrorAs(t, err, &nfErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuyerChargeStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewBuyerChargeStore(postgres.NewClient(db))
+	adminID := 9
+
+	mock.ExpectQuery("INSERT INTO buyer_charges").
+		WithArgs(1, 2, 5, "氷", "袋", 300, 10, 3, "", &adminID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(12, time.Now()))
+
+	c, err := repo.Create(context.Background(), &model.BuyerCharge{
+		AuctionID: 1, BuyerID: 2, ChargeItemID: 5, Description: "氷", Unit: "袋",
+		UnitPrice: 300, TaxRate: model.TaxRateStandard, Quantity: 3, RecordedBy: &adminID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 12, c.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuyerChargeStore_ListByAuctionID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewBuyerChargeStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM buyer_charges c .* WHERE c.auction_id = \\$1").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(buyerChargeRowColumns).
+			AddRow(12, 1, 2, "Buyer", 5, "氷", "袋", 300, 10, 3, "", 9, time.Now()).
+			AddRow(13, 1, 2, "Buyer", 6, "配送料", "", 1500, 0, 1, "", nil, time.Now()))
+
+	charges, err := repo.ListByAuctionID(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Len(t, charges, 2)
+	assert.Equal(t, 900, charges[0].Amount())
+	assert.Nil(t, charges[1].RecordedBy)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuyerChargeStore_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewBuyerChargeStore(postgres.NewClient(db))
+
+	mock.ExpectExec("DELETE FROM buyer_charges").WithArgs(12).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM buyer_charges").WithArgs(99).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.NoError(t, repo.Delete(context.Background(), 12))
+	var nfErr *apperrors.NotFoundError
+	assert.ErrorAs(t, repo.Delete(context.Background(), 99), &nfErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.ClaimRepository = (*ClaimStore)(nil)
+
+// 品目・漁業者・せりは落札（transactions）から都度参照する。
+const claimColumns = `
+	c.id, c.buyer_id, b.name, c.purchase_id, t.item_id, ai.auction_id, ai.fisherman_id, ai.fish_type, t.price,
+	c.claim_type, c.reason, c.requested_amount, c.approved_amount, c.clawback,
+	c.status, c.resolution_note, c.resolved_by, c.resolved_at, c.created_at, c.updated_at`
+
+const claimFrom = `
+	FROM claims c
+	JOIN buyers b ON c.buyer_id = b.id
+	JOIN transactions t ON c.purchase_id = t.id
+	JOIN auction_items ai ON t.item_id = ai.id`
+
+// ClaimStore implements repository.ClaimRepository using PostgreSQL.
+type ClaimStore struct {
+	db datastore.Database
+}
+
+// NewClaimStore creates a new instance of ClaimRepository
+func NewClaimStore(db datastore.Database) *ClaimStore {
+	return &ClaimStore{db: db}
+}
+
+// Create stores a new claim.
+func (r *ClaimStore) Create(ctx context.Context, claim *model.Claim) (*model.Claim, error) {
+	c := *claim
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO claims (buyer_id, purchase_id, claim_type, reason, requested_amount, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at`,
+		c.BuyerID, c.PurchaseID, string(c.Type), c.Reason, c.RequestedAmount, string(c.Status),
+	).Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Claim", 0, "Create")
+	}
+	return &c, nil
+}
+
+// FindByID returns a claim (without events).
+func (r *ClaimStore) FindByID(ctx context.Context, id int) (*model.Claim, error) {
+	return r.findByID(ctx, id, "")
+}
+
+// FindByIDWithLock returns a claim (without events) and locks the claim row.
+func (r *ClaimStore) FindByIDWithLock(ctx context.Context, id int) (*model.Claim, error) {
+	return r.findByID(ctx, id, " FOR UPDATE OF c")
+}
+
+func (r *ClaimStore) findByID(ctx context.Context, id int, lockClause string) (*model.Claim, error) {
+	query := `SELECT ` + claimColumns + claimFrom + `
+		WHERE c.id = $1` + lockClause
+
+	c, err := scanClaim(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Claim", id, "FindByID")
+	}
+	return c, nil
+}
+
+// List returns claims matching the filters, newest first.
+func (r *ClaimStore) List(ctx context.Context, filters *repository.ClaimFilters) ([]model.Claim, error) {
+	query := `SELECT ` + claimColumns + claimFrom
+
+	var conditions []string
+	var args []any
+	argIndex := 1
+
+	if filters != nil {
+		if filters.BuyerID != nil {
+			conditions = append(conditions, fmt.Sprintf("c.buyer_id = $%d", argIndex))
+			args = append(args, *filters.BuyerID)
+			argIndex++
+		}
+		if filters.Status != nil {
+			conditions = append(conditions, fmt.Sprintf("c.status = $%d", argIndex))
+			args = append(args, string(*filters.Status))
+		}
+	}
+
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY c.created_at DESC, c.id DESC"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Claim", 0, "List")
+	}
+	defer func() { _ = rows.Close() }()
+
+	claims := []model.Claim{}
+	for rows.Next() {
+		c, err := scanClaim(rows)
+		if err != nil {
+			return nil, err
+		}
+		claims = append(claims, *c)
+	}
+	return claims, dserrors.HandleError(rows.Err(), "Claim", 0, "List")
+}
+
+// Update persists the resolution of a claim.
+func (r *ClaimStore) Update(ctx context.Context, claim *model.Claim) error {
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE claims
+		SET status = $1, approved_amount = $2, clawback = $3, resolution_note = $4,
+			resolved_by = $5, resolved_at = $6, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $7`,
+		string(claim.Status), claim.ApprovedAmount, claim.Clawback, claim.ResolutionNote,
+		claim.ResolvedBy, claim.ResolvedAt, claim.ID,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Claim", claim.ID, "Update")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Claim", ID: claim.ID}
+	}
+	return nil
+}
+
+// CreateEvent appends an entry to a claim's audit trail.
+func (r *ClaimStore) CreateEvent(ctx context.Context, event *model.ClaimEvent) error {
+	_, err := r.db.Execute(ctx, `
+		INSERT INTO claim_events (claim_id, action, actor_type, actor_id, amount, note)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		event.ClaimID, string(event.Action), string(event.ActorType), event.ActorID, event.Amount, event.Note,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "ClaimEvent", event.ClaimID, "CreateEvent")
+	}
+	return nil
+}
+
+// ListEvents returns the audit trail of a claim oldest first.
+func (r *ClaimStore) ListEvents(ctx context.Context, claimID int) ([]model.ClaimEvent, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, claim_id, action, actor_type, actor_id, amount, note, created_at
+		FROM claim_events
+		WHERE claim_id = $1
+		ORDER BY created_at ASC, id ASC`, claimID)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "ClaimEvent", claimID, "ListEvents")
+	}
+	defer func() { _ = rows.Close() }()
+
+	events := []model.ClaimEvent{}
+	for rows.Next() {
+		var e model.ClaimEvent
+		if err := rows.Scan(&e.ID, &e.ClaimID, &e.Action, &e.ActorType, &e.ActorID, &e.Amount, &e.Note, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, dserrors.HandleError(rows.Err(), "ClaimEvent", claimID, "ListEvents")
+}
+
+func scanClaim(row datastore.Row) (*model.Claim, error) {
+	var c model.Claim
+	if err := row.Scan(
+		&c.ID, &c.BuyerID, &c.BuyerName, &c.PurchaseID, &c.ItemID, &c.AuctionID, &c.FishermanID, &c.FishType, &c.PurchasePrice,
+		&c.Type, &c.Reason, &c.RequestedAmount, &c.ApprovedAmount, &c.Clawback,
+		&c.Status, &c.ResolutionNote, &c.ResolvedBy, &c.ResolvedAt, &c.CreatedAt, &c.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,151 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+var claimRowColumns = []string{
+	"id", "buyer_id", "name", "purchase_id", "item_id", "auction_id", "fisherman_id", "fish_type", "price",
+	"claim_type", "reason", "requested_amount", "approved_amount", "clawback",
+	"status", "resolution_note", "resolved_by", "resolved_at", "created_at", "updated_at",
+}
+
+func TestClaimStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewClaimStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("INSERT INTO claims").
+		WithArgs(2, 1, "return", "鮮度不良", 5000, "pending").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(10, time.Now(), time.Now()))
+
+	created, err := repo.Create(context.Background(), &model.Claim{
+		BuyerID: 2, PurchaseID: 1, Type: model.ClaimTypeReturn, Reason: "鮮度不良",
+		RequestedAmount: 5000, Status: model.ClaimStatusPending,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 10, created.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimStore_FindByIDWithLock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewClaimStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM claims c .* WHERE c.id = \\$1 FOR UPDATE OF c").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows(claimRowColumns).
+			AddRow(10, 2, "Buyer", 1, 101, 7, 3, "Tuna", 5000, "discount", "身割れ", 1200, nil, false,
+				"pending", "", nil, nil, time.Now(), time.Now()))
+
+	c, err := repo.FindByIDWithLock(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, model.ClaimTypeDiscount, c.Type)
+	assert.Equal(t, 3, c.FishermanID)
+	assert.Nil(t, c.ApprovedAmount)
+}
+
+func TestClaimStore_FindByID_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewClaimStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM claims c").WithArgs(99).WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.FindByID(context.Background(), 99)
+	var nfErr *apperrors.NotFoundError
+	assert.ErrorAs(t, err, &nfErr)
+}
+
+func TestClaimStore_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewClaimStore(postgres.NewClient(db))
+	buyerID := 2
+	status := model.ClaimStatusApproved
+
+	mock.ExpectQuery("SELECT .* FROM claims c .* WHERE c.buyer_id = \\$1 AND c.status = \\$2 ORDER BY c.created_at DESC").
+		WithArgs(2, "approved").
+		WillReturnRows(sqlmock.NewRows(claimRowColumns).
+			AddRow(10, 2, "Buyer", 1, 101, 7, 3, "Tuna", 5000, "return", "鮮度不良", 5000, 5000, true,
+				"approved", "ok", 1, time.Now(), time.Now(), time.Now()))
+
+	list, err := repo.List(context.Background(), &repository.ClaimFilters{BuyerID: &buyerID, Status: &status})
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, 5000, *list[0].ApprovedAmount)
+}
+
+func TestClaimStore_Update_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewClaimStore(postgres.NewClient(db))
+
+	mock.ExpectExec("UPDATE claims").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Update(context.Background(), &model.Claim{ID: 9, Status: model.ClaimStatusRejected})
+	var nfErr *apperrors.NotFoundError
+	assert.ErrorAs(t, err, &nfErr)
+}
+
+func TestClaimStore_Events(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewClaimStore(postgres.NewClient(db))
+	amount := 5000
+
+	mock.ExpectExec("INSERT INTO claim_events").
+		WithArgs(10, "approved", "admin", 1, &amount, "ok").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT .* FROM claim_events WHERE claim_id = \\$1").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "claim_id", "action", "actor_type", "actor_id", "amount", "note", "created_at"}).
+			AddRow(1, 10, "filed", "buyer", 2, 5000, "", time.Now()).
+			AddRow(2, 10, "approved", "admin", 1, 5000, "ok", time.Now()))
+
+	err = repo.CreateEvent(context.Background(), &model.ClaimEvent{
+		ClaimID: 10, Action: model.ClaimActionApproved, ActorType: model.ClaimActorAdmin, ActorID: 1, Amount: &amount, Note: "ok",
+	})
+	assert.NoError(t, err)
+
+	events, err := repo.ListEvents(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, model.ClaimActorBuyer, events[0].ActorType)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.CreditNoteRepository = (*CreditNoteStore)(nil)
+
+const creditNoteColumns = `
+	cn.id, cn.claim_id, cn.correction_id, cn.invoice_id, cn.buyer_id, b.name,
+	cn.amount, cn.tax_rate, cn.tax_amount, cn.total_amount, cn.issued_by, cn.created_at`
+
+// CreditNoteStore implements repository.CreditNoteRepository using PostgreSQL.
+type CreditNoteStore struct {
+	db datastore.Database
+}
+
+// NewCreditNoteStore creates a new instance of CreditNoteRepository
+func NewCreditNoteStore(db datastore.Database) *CreditNoteStore {
+	return &CreditNoteStore{db: db}
+}
+
+// Create stores a new credit note.
+func (r *CreditNoteStore) Create(ctx context.Context, note *model.CreditNote) (*model.CreditNote, error) {
+	cn := *note
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO credit_notes (claim_id, correction_id, invoice_id, buyer_id, amount, tax_rate, tax_amount, total_amount, issued_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at`,
+		cn.ClaimID, cn.CorrectionID, cn.InvoiceID, cn.BuyerID, cn.Amount, cn.TaxRate, cn.TaxAmount, cn.TotalAmount, cn.IssuedBy,
+	).Scan(&cn.ID, &cn.CreatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "CreditNote", 0, "Create")
+	}
+	return &cn, nil
+}
+
+// FindByClaimID returns the credit note issued for a claim.
+func (r *CreditNoteStore) FindByClaimID(ctx context.Context, claimID int) (*model.CreditNote, error) {
+	query := `SELECT ` + creditNoteColumns + `
+		FROM credit_notes cn
+		JOIN buyers b ON cn.buyer_id = b.id
+		WHERE cn.claim_id = $1`
+
+	cn, err := scanCreditNote(r.db.QueryRow(ctx, query, claimID))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "CreditNote", claimID, "FindByClaimID")
+	}
+	return cn, nil
+}
+
+// ListByBuyerID returns all credit notes of a buyer oldest first.
+func (r *CreditNoteStore) ListByBuyerID(ctx context.Context, buyerID int) ([]model.CreditNote, error) {
+	query := `SELECT ` + creditNoteColumns + `
+		FROM credit_notes cn
+		JOIN buyers b ON cn.buyer_id = b.id
+		WHERE cn.buyer_id = $1
+		ORDER BY cn.created_at ASC, cn.id ASC`
+	return r.list(ctx, "ListByBuyerID", buyerID, query, buyerID)
+}
+
+// ListByVenueBetween returns the credit notes against invoices of a venue's auctions issued in [start, end).
+func (r *CreditNoteStore) ListByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.CreditNote, error) {
+	query := `SELECT ` + creditNoteColumns + `
+		FROM credit_notes cn
+		JOIN buyers b ON cn.buyer_id = b.id
+		JOIN invoices i ON cn.invoice_id = i.id
+		JOIN auctions a ON i.auction_id = a.id
+		WHERE a.venue_id = $1 AND cn.created_at >= $2 AND cn.created_at < $3
+		ORDER BY cn.created_at ASC, cn.id ASC`
+	return r.list(ctx, "ListByVenueBetween", venueID, query, venueID, start, end)
+}
+
+func (r *CreditNoteStore) list(ctx context.Context, op string, id int, query string, args ...any) ([]model.CreditNote, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "CreditNote", id, op)
+	}
+	defer func() { _ = rows.Close() }()
+
+	notes := []model.CreditNote{}
+	for rows.Next() {
+		cn, err := scanCreditNote(rows)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, *cn)
+	}
+	return notes, dserrors.HandleError(rows.Err(), "CreditNote", id, op)
+}
+
+func scanCreditNote(row datastore.Row) (*model.CreditNote, error) {
+	var cn model.CreditNote
+	if err := row.Scan(
+		&cn.ID, &cn.ClaimID, &cn.CorrectionID, &cn.InvoiceID, &cn.BuyerID, &cn.BuyerName,
+		&cn.Amount, &cn.TaxRate, &cn.TaxAmount, &cn.TotalAmount, &cn.IssuedBy, &cn.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &cn, nil
+}
@@ -0,0 +1,63 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+var creditNoteRowColumns = []string{
+	"id", "claim_id", "correction_id", "invoice_id", "buyer_id", "name",
+	"amount", "tax_rate", "tax_amount", "total_amount", "issued_by", "created_at",
+}
+
+func TestCreditNoteStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewCreditNoteStore(postgres.NewClient(db))
+	adminID := 1
+	claimID := 10
+
+	mock.ExpectQuery("INSERT INTO credit_notes").
+		WithArgs(&claimID, nil, 5, 2, 1000, 8, 80, 1080, &adminID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(3, time.Now()))
+
+	created, err := repo.Create(context.Background(), &model.CreditNote{
+		ClaimID: &claimID, InvoiceID: 5, BuyerID: 2, Amount: 1000, TaxRate: 8, TaxAmount: 80, TotalAmount: 1080, IssuedBy: &adminID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, created.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreditNoteStore_ListByVenueBetween(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewCreditNoteStore(postgres.NewClient(db))
+	start := time.Date(2024, 2, 29, 15, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	mock.ExpectQuery("SELECT .* FROM credit_notes cn .* JOIN auctions a ON i.auction_id = a.id WHERE a.venue_id = \\$1 AND cn.created_at >= \\$2 AND cn.created_at < \\$3").
+		WithArgs(1, start, end).
+		WillReturnRows(sqlmock.NewRows(creditNoteRowColumns).
+			AddRow(3, 10, nil, 5, 2, "Buyer", 1000, 8, 80, 1080, 1, start))
+
+	list, err := repo.ListByVenueBetween(context.Background(), 1, start, end)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, "Buyer", list[0].BuyerName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
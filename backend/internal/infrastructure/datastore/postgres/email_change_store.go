@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.EmailChangeRepository = (*EmailChangeStore)(nil)
+
+// EmailChangeStore implements repository.EmailChangeRepository using PostgreSQL.
+type EmailChangeStore struct {
+	db datastore.Database
+}
+
+// NewEmailChangeStore creates a new instance of EmailChangeRepository
+func NewEmailChangeStore(db datastore.Database) *EmailChangeStore {
+	return &EmailChangeStore{db: db}
+}
+
+// Create stores a new pending email change.
+func (r *EmailChangeStore) Create(ctx context.Context, token *model.EmailChangeToken) error {
+	query := `INSERT INTO email_change_tokens (buyer_id, new_email, token_hash, expires_at) VALUES ($1, $2, $3, $4)`
+	_, err := r.db.Execute(ctx, query, token.BuyerID, token.NewEmail, token.TokenHash, token.ExpiresAt)
+	if err != nil {
+		return dserrors.HandleError(err, "EmailChange", token.BuyerID, "Create")
+	}
+	return nil
+}
+
+// FindByTokenHash returns the pending email change for the token hash.
+func (r *EmailChangeStore) FindByTokenHash(ctx context.Context, tokenHash string) (*model.EmailChangeToken, error) {
+	query := `SELECT buyer_id, new_email, expires_at FROM email_change_tokens WHERE token_hash = $1`
+	var res model.EmailChangeToken
+	err := r.db.QueryRow(ctx, query, tokenHash).Scan(&res.BuyerID, &res.NewEmail, &res.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, dserrors.HandleError(err, "EmailChange", 0, "FindByTokenHash")
+	}
+	res.TokenHash = tokenHash
+	return &res, nil
+}
+
+// DeleteAllByBuyerID removes all pending email changes for a buyer.
+func (r *EmailChangeStore) DeleteAllByBuyerID(ctx context.Context, buyerID int) error {
+	query := `DELETE FROM email_change_tokens WHERE buyer_id = $1`
+	_, err := r.db.Execute(ctx, query, buyerID)
+	if err != nil {
+		return dserrors.HandleError(err, "EmailChange", buyerID, "DeleteAllByBuyerID")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.EmailVerificationRepository = (*EmailVerificationStore)(nil)
+
+// EmailVerificationStore implements repository.EmailVerificationRepository using PostgreSQL.
+type EmailVerificationStore struct {
+	db datastore.Database
+}
+
+// NewEmailVerificationStore creates a new instance of EmailVerificationRepository
+func NewEmailVerificationStore(db datastore.Database) *EmailVerificationStore {
+	return &EmailVerificationStore{db: db}
+}
+
+// Create stores a new verification token.
+func (r *EmailVerificationStore) Create(ctx context.Context, token *model.EmailVerificationToken) error {
+	query := `INSERT INTO email_verification_tokens (buyer_id, token_hash, expires_at) VALUES ($1, $2, $3)`
+	_, err := r.db.Execute(ctx, query, token.BuyerID, token.TokenHash, token.ExpiresAt)
+	if err != nil {
+		return dserrors.HandleError(err, "EmailVerification", token.BuyerID, "Create")
+	}
+	return nil
+}
+
+// FindByTokenHash returns the verification token for the hash.
+func (r *EmailVerificationStore) FindByTokenHash(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error) {
+	query := `SELECT buyer_id, expires_at FROM email_verification_tokens WHERE token_hash = $1`
+	var res model.EmailVerificationToken
+	err := r.db.QueryRow(ctx, query, tokenHash).Scan(&res.BuyerID, &res.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, dserrors.HandleError(err, "EmailVerification", 0, "FindByTokenHash")
+	}
+	res.TokenHash = tokenHash
+	return &res, nil
+}
+
+// DeleteAllByBuyerID removes all verification tokens for a buyer.
+func (r *EmailVerificationStore) DeleteAllByBuyerID(ctx context.Context, buyerID int) error {
+	query := `DELETE FROM email_verification_tokens WHERE buyer_id = $1`
+	_, err := r.db.Execute(ctx, query, buyerID)
+	if err != nil {
+		return dserrors.HandleError(err, "EmailVerification", buyerID, "DeleteAllByBuyerID")
+	}
+	return nil
+}
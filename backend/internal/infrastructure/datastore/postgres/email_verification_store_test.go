@@ -0,0 +1,101 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailVerificationStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewEmailVerificationStore(postgres.NewClient(db))
+	token := &model.EmailVerificationToken{BuyerID: 1, TokenHash: "hash", ExpiresAt: time.Now()}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO email_verification_tokens (buyer_id, token_hash, expires_at) VALUES ($1, $2, $3)")).
+			WithArgs(token.BuyerID, token.TokenHash, token.ExpiresAt).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		assert.NoError(t, repo.Create(context.Background(), token))
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO email_verification_tokens")).
+			WillReturnError(sql.ErrConnDone)
+
+		assert.Error(t, repo.Create(context.Background(), token))
+	})
+}
+
+func TestEmailVerificationStore_FindByTokenHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewEmailVerificationStore(postgres.NewClient(db))
+	expiresAt := time.Now()
+	query := regexp.QuoteMeta("SELECT buyer_id, expires_at FROM email_verification_tokens WHERE token_hash = $1")
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs("hash").
+			WillReturnRows(sqlmock.NewRows([]string{"buyer_id", "expires_at"}).AddRow(1, expiresAt))
+
+		got, err := repo.FindByTokenHash(context.Background(), "hash")
+		assert.NoError(t, err)
+		if assert.NotNil(t, got) {
+			assert.Equal(t, 1, got.BuyerID)
+			assert.Equal(t, "hash", got.TokenHash)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs("hash").
+			WillReturnError(sql.ErrNoRows)
+
+		got, err := repo.FindByTokenHash(context.Background(), "hash")
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mock.ExpectQuery(query).
+			WithArgs("hash").
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.FindByTokenHash(context.Background(), "hash")
+		assert.Error(t, err)
+	})
+}
+
+func TestEmailVerificationStore_DeleteAllByBuyerID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewEmailVerificationStore(postgres.NewClient(db))
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM email_verification_tokens WHERE buyer_id = $1")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	assert.NoError(t, repo.DeleteAllByBuyerID(context.Background(), 1))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.FishermanAuthenticationRepository = (*FishermanAuthenticationStore)(nil)
+
+const fishermanAuthenticationColumns = `id, fisherman_id, email, password_hash, failed_attempts, locked_until, last_login_at, created_at, updated_at`
+
+// FishermanAuthenticationStore implements repository.FishermanAuthenticationRepository using PostgreSQL.
+type FishermanAuthenticationStore struct {
+	db datastore.Database
+}
+
+// NewFishermanAuthenticationStore creates a new instance of FishermanAuthenticationRepository
+func NewFishermanAuthenticationStore(db datastore.Database) *FishermanAuthenticationStore {
+	return &FishermanAuthenticationStore{db: db}
+}
+
+// Create stores a new fisherman login.
+func (r *FishermanAuthenticationStore) Create(ctx context.Context, auth *model.FishermanAuthentication) (*model.FishermanAuthentication, error) {
+	v := *auth
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO fisherman_authentications (fisherman_id, email, password_hash)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at, updated_at`,
+		v.FishermanID, v.Email, v.PasswordHash,
+	).Scan(&v.ID, &v.CreatedAt, &v.UpdatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "FishermanAuthentication", 0, "Create")
+	}
+	return &v, nil
+}
+
+// FindByEmail returns a fisherman login by its email.
+// 削除済みの漁業者のログインは見つからないものとして扱い、ログインもパスワードリセットもできなくする。
+func (r *FishermanAuthenticationStore) FindByEmail(ctx context.Context, email string) (*model.FishermanAuthentication, error) {
+	a, err := scanFishermanAuthentication(r.db.QueryRow(ctx, `
+		SELECT fa.id, fa.fisherman_id, fa.email, fa.password_hash, fa.failed_attempts, fa.locked_until, fa.last_login_at, fa.created_at, fa.updated_at
+		FROM fisherman_authentications fa
+		JOIN fishermen f ON fa.fisherman_id = f.id
+		WHERE fa.email = $1 AND f.deleted_at IS NULL`, email))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "FishermanAuthentication", 0, "FindByEmail")
+	}
+	return a, nil
+}
+
+// FindByFishermanID returns the login of a fisherman.
+func (r *FishermanAuthenticationStore) FindByFishermanID(ctx context.Context, fishermanID int) (*model.FishermanAuthentication, error) {
+	a, err := scanFishermanAuthentication(r.db.QueryRow(ctx,
+		`SELECT `+fishermanAuthenticationColumns+` FROM fisherman_authentications WHERE fisherman_id = $1`, fishermanID))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "FishermanAuthentication", fishermanID, "FindByFishermanID")
+	}
+	return a, nil
+}
+
+// UpdateLoginSuccess records a successful login and clears the failure counter and lock.
+func (r *FishermanAuthenticationStore) UpdateLoginSuccess(ctx context.Context, id int, loginAt time.Time) error {
+	_, err := r.db.Execute(ctx, `
+		UPDATE fisherman_authentications
+		SET last_login_at = $1, failed_attempts = 0, locked_until = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2`,
+		loginAt, id)
+	if err != nil {
+		return dserrors.HandleError(err, "FishermanAuthentication", id, "UpdateLoginSuccess")
+	}
+	return nil
+}
+
+// IncrementFailedAttempts increments the count of failed login attempts and returns the new count.
+func (r *FishermanAuthenticationStore) IncrementFailedAttempts(ctx context.Context, id int) (int, error) {
+	var newCount int
+	err := r.db.QueryRow(ctx, `
+		UPDATE fisherman_authentications
+		SET failed_attempts = failed_attempts + 1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 RETURNING failed_attempts`,
+		id).Scan(&newCount)
+	if err != nil {
+		return 0, dserrors.HandleError(err, "FishermanAuthentication", id, "IncrementFailedAttempts")
+	}
+	return newCount, nil
+}
+
+// LockAccount locks a login until the specified time.
+func (r *FishermanAuthenticationStore) LockAccount(ctx context.Context, id int, until time.Time) error {
+	_, err := r.db.Execute(ctx, `
+		UPDATE fisherman_authentications
+		SET locked_until = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $2`,
+		until, id)
+	if err != nil {
+		return dserrors.HandleError(err, "FishermanAuthentication", id, "LockAccount")
+	}
+	return nil
+}
+
+// UpdatePassword replaces the password hash of a fisherman.
+func (r *FishermanAuthenticationStore) UpdatePassword(ctx context.Context, fishermanID int, passwordHash string) error {
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE fisherman_authentications
+		SET password_hash = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE fisherman_id = $2`,
+		passwordHash, fishermanID)
+	if err != nil {
+		return dserrors.HandleError(err, "FishermanAuthentication", fishermanID, "UpdatePassword")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "FishermanAuthentication", ID: fishermanID}
+	}
+	return nil
+}
+
+func scanFishermanAuthentication(row datastore.Row) (*model.FishermanAuthentication, error) {
+	var a model.FishermanAuthentication
+	if err := row.Scan(
+		&a.ID, &a.FishermanID, &a.Email, &a.PasswordHash, &a.FailedAttempts,
+		&a.LockedUntil, &a.LastLoginAt, &a.CreatedAt, &a.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
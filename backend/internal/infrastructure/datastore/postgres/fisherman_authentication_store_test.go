@@ -0,0 +1,96 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+var fishermanAuthenticationRowColumns = []string{
+	"id", "fisherman_id", "email", "password_hash", "failed_attempts", "locked_until", "last_login_at", "created_at", "updated_at",
+}
+
+func TestFishermanAuthenticationStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewFishermanAuthenticationStore(postgres.NewClient(db))
+	auth := &model.FishermanAuthentication{FishermanID: 3, Email: "fisher@example.com", PasswordHash: "hash"}
+
+	mock.ExpectQuery("INSERT INTO fisherman_authentications").
+		WithArgs(3, "fisher@example.com", "hash").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(1, time.Now(), time.Now()))
+
+	created, err := repo.Create(context.Background(), auth)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, created.ID)
+	assert.Equal(t, 0, auth.ID)
+
+	mock.ExpectQuery("INSERT INTO fisherman_authentications").
+		WithArgs(3, "fisher@example.com", "hash").
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	_, err = repo.Create(context.Background(), auth)
+	var conflictErr *apperrors.ConflictError
+	assert.ErrorAs(t, err, &conflictErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFishermanAuthenticationStore_FindByEmail(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewFishermanAuthenticationStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM fisherman_authentications fa JOIN fishermen f ON fa.fisherman_id = f.id WHERE fa.email = \\$1 AND f.deleted_at IS NULL").
+		WithArgs("fisher@example.com").
+		WillReturnRows(sqlmock.NewRows(fishermanAuthenticationRowColumns).
+			AddRow(1, 3, "fisher@example.com", "hash", 2, nil, nil, time.Now(), time.Now()))
+
+	found, err := repo.FindByEmail(context.Background(), "fisher@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, found.FishermanID)
+	assert.Equal(t, 2, found.FailedAttempts)
+
+	mock.ExpectQuery("SELECT .* FROM fisherman_authentications fa JOIN fishermen f ON fa.fisherman_id = f.id WHERE fa.email = \\$1 AND f.deleted_at IS NULL").
+		WithArgs("nobody@example.com").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.FindByEmail(context.Background(), "nobody@example.com")
+	var notFoundErr *apperrors.NotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFishermanAuthenticationStore_UpdatePassword_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewFishermanAuthenticationStore(postgres.NewClient(db))
+
+	mock.ExpectExec("UPDATE fisherman_authentications SET password_hash = \\$1, updated_at = CURRENT_TIMESTAMP WHERE fisherman_id = \\$2").
+		WithArgs("newHash", 9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UpdatePassword(context.Background(), 9, "newHash")
+	var notFoundErr *apperrors.NotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
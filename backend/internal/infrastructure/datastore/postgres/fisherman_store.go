@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
@@ -10,6 +11,9 @@ import (
 	"github.com/seka/fish-auction/backend/internal/infrastructure/entity"
 )
 
+const fishermanColumns = "id, name, cooperative_member_number, vessel_name, vessel_registration_number, phone, address, " +
+	"bank_code, branch_code, account_type, account_number, account_holder_kana"
+
 // FishermanStore implements repository.FishermanRepository using PostgreSQL.
 type FishermanStore struct {
 	db datastore.Database
@@ -38,7 +42,7 @@ func (r *FishermanStore) Create(ctx context.Context, name string) (*model.Fisher
 
 // List returns all active fishermen.
 func (r *FishermanStore) List(ctx context.Context) ([]model.Fisherman, error) {
-	rows, err := r.db.Query(ctx, "SELECT id, name FROM fishermen WHERE deleted_at IS NULL")
+	rows, err := r.db.Query(ctx, "SELECT "+fishermanColumns+" FROM fishermen WHERE deleted_at IS NULL")
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Fisherman", 0, "List")
 	}
@@ -46,8 +50,8 @@ func (r *FishermanStore) List(ctx context.Context) ([]model.Fisherman, error) {
 
 	var fishermen []model.Fisherman
 	for rows.Next() {
-		var e entity.Fisherman
-		if err := rows.Scan(&e.ID, &e.Name); err != nil {
+		e, err := scanFisherman(rows)
+		if err != nil {
 			return nil, err
 		}
 		fishermen = append(fishermen, *e.ToModel())
@@ -57,11 +61,10 @@ func (r *FishermanStore) List(ctx context.Context) ([]model.Fisherman, error) {
 
 // FindByID returns a fisherman by its ID.
 func (r *FishermanStore) FindByID(ctx context.Context, id int) (*model.Fisherman, error) {
-	var e entity.Fisherman
-	err := r.db.QueryRow(ctx,
-		"SELECT id, name FROM fishermen WHERE id = $1",
+	e, err := scanFisherman(r.db.QueryRow(ctx,
+		"SELECT "+fishermanColumns+" FROM fishermen WHERE id = $1",
 		id,
-	).Scan(&e.ID, &e.Name)
+	))
 	if err != nil {
 		return nil, dserrors.HandleError(err, "Fisherman", id, "FindByID")
 	}
@@ -69,6 +72,54 @@ func (r *FishermanStore) FindByID(ctx context.Context, id int) (*model.Fisherman
 	return e.ToModel(), nil
 }
 
+// Update stores a fisherman's name and profile. The bank account is left as is.
+func (r *FishermanStore) Update(ctx context.Context, fisherman *model.Fisherman) error {
+	e := entity.Fisherman{Name: fisherman.Name}
+	e.SetProfile(fisherman.FishermanProfile)
+	if err := e.Validate(); err != nil {
+		return err
+	}
+
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE fishermen
+		SET name = $1, cooperative_member_number = $2, vessel_name = $3, vessel_registration_number = $4, phone = $5, address = $6
+		WHERE id = $7 AND deleted_at IS NULL`,
+		e.Name, e.CooperativeMemberNumber, e.VesselName, e.VesselRegistrationNumber, e.Phone, e.Address, fisherman.ID,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Fisherman", fisherman.ID, "Update")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Fisherman", ID: fisherman.ID}
+	}
+	return nil
+}
+
+// UpdateBankAccount stores the payout bank account of a fisherman.
+func (r *FishermanStore) UpdateBankAccount(ctx context.Context, id int, account *model.BankAccount) error {
+	if account != nil {
+		if err := account.Validate(); err != nil {
+			return err
+		}
+	}
+	var e entity.Fisherman
+	e.SetBankAccount(account)
+
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE fishermen
+		SET bank_code = $1, branch_code = $2, account_type = $3, account_number = $4, account_holder_kana = $5
+		WHERE id = $6 AND deleted_at IS NULL`,
+		e.BankCode, e.BranchCode, e.AccountType, e.AccountNumber, e.AccountHolderKana, id,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Fisherman", id, "UpdateBankAccount")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Fisherman", ID: id}
+	}
+	return nil
+}
+
 // Delete marks a fisherman as deleted.
 func (r *FishermanStore) Delete(ctx context.Context, id int) error {
 	_, err := r.db.Execute(ctx, "UPDATE fishermen SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1", id)
@@ -77,3 +128,15 @@ func (r *FishermanStore) Delete(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+func scanFisherman(row datastore.Row) (*entity.Fisherman, error) {
+	var e entity.Fisherman
+	if err := row.Scan(
+		&e.ID, &e.Name,
+		&e.CooperativeMemberNumber, &e.VesselName, &e.VesselRegistrationNumber, &e.Phone, &e.Address,
+		&e.BankCode, &e.BranchCode, &e.AccountType, &e.AccountNumber, &e.AccountHolderKana,
+	); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
 	"github.com/stretchr/testify/assert"
 )
@@ -38,14 +40,126 @@ func TestFishermanStore_List(t *testing.T) {
 
 	repo := postgres.NewFishermanStore(postgres.NewClient(db))
 
-	mock.ExpectQuery("SELECT id, name FROM fishermen").
-		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
-			AddRow(1, "Fisherman A").
-			AddRow(2, "Fisherman B"))
+	mock.ExpectQuery("SELECT id, name, cooperative_member_number, .+, bank_code, branch_code, account_type, account_number, account_holder_kana FROM fishermen").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "name", "cooperative_member_number", "vessel_name", "vessel_registration_number", "phone", "address",
+			"bank_code", "branch_code", "account_type", "account_number", "account_holder_kana",
+		}).
+			AddRow(1, "Fisherman A", "1024", "第一漁丸", "MG2-1234", "090-0000-0000", "石巻市", "0001", "100", 1, "1234567", "ｷﾞﾖｼﾔA").
+			AddRow(2, "Fisherman B", "", "", "", "", "", nil, nil, nil, nil, nil))
 
 	list, err := repo.List(context.Background())
 	assert.NoError(t, err)
 	assert.Len(t, list, 2)
+	assert.NotNil(t, list[0].BankAccount)
+	assert.Equal(t, model.BankAccountTypeOrdinary, list[0].BankAccount.AccountType)
+	assert.Nil(t, list[1].BankAccount)
+	assert.Equal(t, "第一漁丸", list[0].VesselName)
+	assert.Equal(t, "MG2-1234", list[0].VesselRegistrationNumber)
+}
+
+func TestFishermanStore_Update(t *testing.T) {
+	profile := model.FishermanProfile{
+		CooperativeMemberNumber:  "1024",
+		VesselName:               "第一漁丸",
+		VesselRegistrationNumber: "mg2-1234",
+		Phone:                    "090-0000-0000",
+		Address:                  "石巻市",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewFishermanStore(postgres.NewClient(db))
+
+		mock.ExpectExec("UPDATE fishermen").
+			WithArgs("Fisherman A", "1024", "第一漁丸", "MG2-1234", "090-0000-0000", "石巻市", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.Update(context.Background(), &model.Fisherman{ID: 1, Name: "Fisherman A", FishermanProfile: profile}))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewFishermanStore(postgres.NewClient(db))
+
+		mock.ExpectExec("UPDATE fishermen").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = repo.Update(context.Background(), &model.Fisherman{ID: 9, Name: "Fisherman A"})
+		var nfErr *apperrors.NotFoundError
+		assert.ErrorAs(t, err, &nfErr)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewFishermanStore(postgres.NewClient(db))
+
+		invalid := profile
+		invalid.Phone = "0120"
+		var vErr *apperrors.ValidationError
+		assert.ErrorAs(t, repo.Update(context.Background(), &model.Fisherman{ID: 1, Name: "Fisherman A", FishermanProfile: invalid}), &vErr)
+	})
+}
+
+func TestFishermanStore_UpdateBankAccount(t *testing.T) {
+	account := &model.BankAccount{BankCode: "0001", BranchCode: "100", AccountType: model.BankAccountTypeOrdinary, AccountNumber: "1234567", HolderKana: "ﾔﾏﾀﾞ"}
+
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewFishermanStore(postgres.NewClient(db))
+
+		mock.ExpectExec("UPDATE fishermen").
+			WithArgs("0001", "100", 1, "1234567", "ﾔﾏﾀﾞ", 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.UpdateBankAccount(context.Background(), 1, account))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewFishermanStore(postgres.NewClient(db))
+
+		mock.ExpectExec("UPDATE fishermen").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = repo.UpdateBankAccount(context.Background(), 9, account)
+		var nfErr *apperrors.NotFoundError
+		assert.ErrorAs(t, err, &nfErr)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+		}
+		defer func() { _ = db.Close() }()
+		repo := postgres.NewFishermanStore(postgres.NewClient(db))
+
+		invalid := *account
+		invalid.BankCode = "1"
+		var vErr *apperrors.ValidationError
+		assert.ErrorAs(t, repo.UpdateBankAccount(context.Background(), 1, &invalid), &vErr)
+	})
 }
 
 func TestFishermanStore_Delete(t *testing.T) {
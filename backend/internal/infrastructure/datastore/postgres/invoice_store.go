@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.InvoiceRepository = (*InvoiceStore)(nil)
+
+const invoiceColumns = `
+	i.id, i.buyer_id, b.name, i.auction_id,
+	i.subtotal, i.tax_amount, i.total_amount, i.credited_amount, i.paid_amount,
+	i.status, i.issued_at, i.paid_at, i.created_at, i.updated_at`
+
+// InvoiceStore implements repository.InvoiceRepository using PostgreSQL.
+type InvoiceStore struct {
+	db datastore.Database
+}
+
+// NewInvoiceStore creates a new instance of InvoiceRepository
+func NewInvoiceStore(db datastore.Database) *InvoiceStore {
+	return &InvoiceStore{db: db}
+}
+
+// Create stores a new invoice together with its lines.
+// Callers are expected to run this inside a transaction.
+func (r *InvoiceStore) Create(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error) {
+	inv := *invoice
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO invoices (buyer_id, auction_id, subtotal, tax_amount, total_amount, paid_amount, status, issued_at, paid_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, updated_at`,
+		inv.BuyerID, inv.AuctionID, inv.Subtotal, inv.TaxAmount, inv.TotalAmount, inv.PaidAmount,
+		string(inv.Status), inv.IssuedAt, inv.PaidAt,
+	).Scan(&inv.ID, &inv.CreatedAt, &inv.UpdatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Invoice", 0, "Create")
+	}
+
+	inv.Lines = make([]model.InvoiceLine, len(invoice.Lines))
+	for i, l := range invoice.Lines {
+		l.InvoiceID = inv.ID
+		err := r.db.QueryRow(ctx, `
+			INSERT INTO invoice_lines (invoice_id, item_id, charge_id, description, quantity, unit, amount, tax_rate)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id`,
+			l.InvoiceID, l.ItemID, l.ChargeID, l.Description, l.Quantity, l.Unit, l.Amount, l.TaxRate,
+		).Scan(&l.ID)
+		if err != nil {
+			return nil, dserrors.HandleError(err, "InvoiceLine", 0, "Create")
+		}
+		inv.Lines[i] = l
+	}
+	return &inv, nil
+}
+
+// FindByID returns an invoice with its lines.
+func (r *InvoiceStore) FindByID(ctx context.Context, id int) (*model.Invoice, error) {
+	return r.findByID(ctx, id, "")
+}
+
+// FindByIDWithLock returns an invoice with its lines and locks the invoice row.
+func (r *InvoiceStore) FindByIDWithLock(ctx context.Context, id int) (*model.Invoice, error) {
+	return r.findByID(ctx, id, " FOR UPDATE OF i")
+}
+
+func (r *InvoiceStore) findByID(ctx context.Context, id int, lockClause string) (*model.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + `
+		FROM invoices i
+		JOIN buyers b ON i.buyer_id = b.id
+		WHERE i.id = $1` + lockClause
+
+	inv, err := scanInvoice(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Invoice", id, "FindByID")
+	}
+
+	lines, err := r.listLines(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	inv.Lines = lines
+	return inv, nil
+}
+
+func (r *InvoiceStore) listLines(ctx context.Context, invoiceID int) ([]model.InvoiceLine, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, invoice_id, item_id, charge_id, description, quantity, unit, amount, tax_rate
+		FROM invoice_lines
+		WHERE invoice_id = $1
+		ORDER BY id ASC`, invoiceID)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "InvoiceLine", invoiceID, "listLines")
+	}
+	defer func() { _ = rows.Close() }()
+
+	lines := []model.InvoiceLine{}
+	for rows.Next() {
+		var l model.InvoiceLine
+		if err := rows.Scan(&l.ID, &l.InvoiceID, &l.ItemID, &l.ChargeID, &l.Description, &l.Quantity, &l.Unit, &l.Amount, &l.TaxRate); err != nil {
+			return nil, err
+		}
+		lines = append(lines, l)
+	}
+	return lines, dserrors.HandleError(rows.Err(), "InvoiceLine", invoiceID, "listLines")
+}
+
+// ListByAuctionID returns the invoices generated for an auction (without lines).
+func (r *InvoiceStore) ListByAuctionID(ctx context.Context, auctionID int) ([]model.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + `
+		FROM invoices i
+		JOIN buyers b ON i.buyer_id = b.id
+		WHERE i.auction_id = $1
+		ORDER BY i.buyer_id ASC`
+	return r.list(ctx, "ListByAuctionID", auctionID, query, auctionID)
+}
+
+// ListByBuyerID returns all invoices of a buyer (without lines).
+func (r *InvoiceStore) ListByBuyerID(ctx context.Context, buyerID int) ([]model.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + `
+		FROM invoices i
+		JOIN buyers b ON i.buyer_id = b.id
+		WHERE i.buyer_id = $1
+		ORDER BY i.created_at ASC, i.id ASC`
+	return r.list(ctx, "ListByBuyerID", buyerID, query, buyerID)
+}
+
+// ListOpenByBuyerIDWithLock returns a buyer's issued, not yet paid invoices oldest first and locks them.
+func (r *InvoiceStore) ListOpenByBuyerIDWithLock(ctx context.Context, buyerID int) ([]model.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + `
+		FROM invoices i
+		JOIN buyers b ON i.buyer_id = b.id
+		WHERE i.buyer_id = $1 AND i.status = 'issued'
+		ORDER BY i.issued_at ASC, i.id ASC
+		FOR UPDATE OF i`
+	return r.list(ctx, "ListOpenByBuyerIDWithLock", buyerID, query, buyerID)
+}
+
+// ListOpen returns every issued, not yet paid invoice across all buyers.
+func (r *InvoiceStore) ListOpen(ctx context.Context) ([]model.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + `
+		FROM invoices i
+		JOIN buyers b ON i.buyer_id = b.id
+		WHERE i.status = 'issued'
+		ORDER BY b.name ASC, i.buyer_id ASC, i.issued_at ASC`
+	return r.list(ctx, "ListOpen", 0, query)
+}
+
+// ListIssuedByVenueBetween returns the invoices of a venue's auctions issued in [start, end), with their lines.
+func (r *InvoiceStore) ListIssuedByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.Invoice, error) {
+	query := `SELECT ` + invoiceColumns + `
+		FROM invoices i
+		JOIN buyers b ON i.buyer_id = b.id
+		JOIN auctions a ON i.auction_id = a.id
+		WHERE a.venue_id = $1 AND i.status IN ('issued', 'paid') AND i.issued_at >= $2 AND i.issued_at < $3
+		ORDER BY i.issued_at ASC, i.id ASC`
+	invoices, err := r.list(ctx, "ListIssuedByVenueBetween", venueID, query, venueID, start, end)
+	if err != nil || len(invoices) == 0 {
+		return invoices, err
+	}
+
+	ids := make([]int, len(invoices))
+	for i, inv := range invoices {
+		ids[i] = inv.ID
+	}
+	lines, err := r.listLinesByInvoiceIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range invoices {
+		invoices[i].Lines = lines[invoices[i].ID]
+	}
+	return invoices, nil
+}
+
+func (r *InvoiceStore) listLinesByInvoiceIDs(ctx context.Context, invoiceIDs []int) (map[int][]model.InvoiceLine, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, invoice_id, item_id, charge_id, description, quantity, unit, amount, tax_rate
+		FROM invoice_lines
+		WHERE invoice_id = ANY($1)
+		ORDER BY invoice_id ASC, id ASC`, pq.Array(invoiceIDs))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "InvoiceLine", 0, "listLinesByInvoiceIDs")
+	}
+	defer func() { _ = rows.Close() }()
+
+	lines := make(map[int][]model.InvoiceLine, len(invoiceIDs))
+	for rows.Next() {
+		var l model.InvoiceLine
+		if err := rows.Scan(&l.ID, &l.InvoiceID, &l.ItemID, &l.ChargeID, &l.Description, &l.Quantity, &l.Unit, &l.Amount, &l.TaxRate); err != nil {
+			return nil, err
+		}
+		lines[l.InvoiceID] = append(lines[l.InvoiceID], l)
+	}
+	return lines, dserrors.HandleError(rows.Err(), "InvoiceLine", 0, "listLinesByInvoiceIDs")
+}
+
+func (r *InvoiceStore) list(ctx context.Context, op string, id int, query string, args ...any) ([]model.Invoice, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Invoice", id, op)
+	}
+	defer func() { _ = rows.Close() }()
+
+	invoices := []model.Invoice{}
+	for rows.Next() {
+		inv, err := scanInvoice(rows)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, *inv)
+	}
+	return invoices, dserrors.HandleError(rows.Err(), "Invoice", id, op)
+}
+
+// Update persists the mutable state (status, paid and credited amounts and timestamps) of an invoice.
+func (r *InvoiceStore) Update(ctx context.Context, invoice *model.Invoice) error {
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE invoices
+		SET status = $1, paid_amount = $2, credited_amount = $3, issued_at = $4, paid_at = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $6`,
+		string(invoice.Status), invoice.PaidAmount, invoice.CreditedAmount, invoice.IssuedAt, invoice.PaidAt, invoice.ID,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Invoice", invoice.ID, "Update")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Invoice", ID: invoice.ID}
+	}
+	return nil
+}
+
+// DeleteDraftsByAuctionID removes draft invoices of an auction so they can be regenerated.
+func (r *InvoiceStore) DeleteDraftsByAuctionID(ctx context.Context, auctionID int) error {
+	_, err := r.db.Execute(ctx, `DELETE FROM invoices WHERE auction_id = $1 AND status = 'draft'`, auctionID)
+	if err != nil {
+		return dserrors.HandleError(err, "Invoice", auctionID, "DeleteDraftsByAuctionID")
+	}
+	return nil
+}
+
+func scanInvoice(row datastore.Row) (*model.Invoice, error) {
+	var inv model.Invoice
+	if err := row.Scan(
+		&inv.ID, &inv.BuyerID, &inv.BuyerName, &inv.AuctionID,
+		&inv.Subtotal, &inv.TaxAmount, &inv.TotalAmount, &inv.CreditedAmount, &inv.PaidAmount,
+		&inv.Status, &inv.IssuedAt, &inv.PaidAt, &inv.CreatedAt, &inv.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &inv, nil
+}
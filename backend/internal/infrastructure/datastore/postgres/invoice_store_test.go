@@ -0,0 +1,181 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+var invoiceRowColumns = []string{
+	"id", "buyer_id", "name", "auction_id",
+	"subtotal", "tax_amount", "total_amount", "credited_amount", "paid_amount",
+	"status", "issued_at", "paid_at", "created_at", "updated_at",
+}
+
+func TestInvoiceStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewInvoiceStore(postgres.NewClient(db))
+	itemID := 101
+	inv := &model.Invoice{
+		BuyerID:   1,
+		AuctionID: 2,
+		Status:    model.InvoiceStatusDraft,
+		Lines: []model.InvoiceLine{
+			{ItemID: &itemID, Description: "Tuna", Quantity: 1, Unit: "kg", Amount: 1000, TaxRate: model.TaxRateReduced},
+		},
+	}
+	inv.Recalculate()
+
+	mock.ExpectQuery("INSERT INTO invoices").
+		WithArgs(1, 2, 1000, 80, 1080, 0, "draft", nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(10, time.Now(), time.Now()))
+	mock.ExpectQuery("INSERT INTO invoice_lines").
+		WithArgs(10, &itemID, nil, "Tuna", 1, "kg", 1000, model.TaxRateReduced).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+
+	created, err := repo.Create(context.Background(), inv)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, created.ID)
+	assert.Equal(t, 20, created.Lines[0].ID)
+	assert.Equal(t, 10, created.Lines[0].InvoiceID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInvoiceStore_FindByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewInvoiceStore(postgres.NewClient(db))
+	issuedAt := time.Now()
+
+	mock.ExpectQuery("SELECT .* FROM invoices i JOIN buyers b ON i.buyer_id = b.id WHERE i.id = \\$1").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows(invoiceRowColumns).
+			AddRow(10, 1, "Buyer", 2, 1000, 80, 1080, 0, 0, "issued", issuedAt, nil, time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT id, invoice_id, item_id, charge_id, description, quantity, unit, amount, tax_rate FROM invoice_lines").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "invoice_id", "item_id", "charge_id", "description", "quantity", "unit", "amount", "tax_rate"}).
+			AddRow(20, 10, 101, nil, "Tuna", 1, "kg", 1000, 8))
+
+	inv, err := repo.FindByID(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, model.InvoiceStatusIssued, inv.Status)
+	assert.NotNil(t, inv.IssuedAt)
+	assert.Nil(t, inv.PaidAt)
+	assert.Len(t, inv.Lines, 1)
+}
+
+func TestInvoiceStore_FindByID_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewInvoiceStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM invoices i").
+		WithArgs(99).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.FindByID(context.Background(), 99)
+	var nfErr *apperrors.NotFoundError
+	assert.ErrorAs(t, err, &nfErr)
+}
+
+func TestInvoiceStore_ListOpenByBuyerIDWithLock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewInvoiceStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM invoices i .* WHERE i.buyer_id = \\$1 AND i.status = 'issued' ORDER BY i.issued_at ASC, i.id ASC FOR UPDATE OF i").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(invoiceRowColumns).
+			AddRow(10, 1, "Buyer", 2, 1000, 80, 1080, 0, 500, "issued", time.Now(), nil, time.Now(), time.Now()))
+
+	list, err := repo.ListOpenByBuyerIDWithLock(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, 580, list[0].OutstandingAmount())
+}
+
+func TestInvoiceStore_Update_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewInvoiceStore(postgres.NewClient(db))
+
+	mock.ExpectExec("UPDATE invoices SET status = \\$1, paid_amount = \\$2").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Update(context.Background(), &model.Invoice{ID: 99, Status: model.InvoiceStatusPaid})
+	var nfErr *apperrors.NotFoundError
+	assert.ErrorAs(t, err, &nfErr)
+}
+
+func TestInvoiceStore_DeleteDraftsByAuctionID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewInvoiceStore(postgres.NewClient(db))
+
+	mock.ExpectExec("DELETE FROM invoices WHERE auction_id = \\$1 AND status = 'draft'").
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	assert.NoError(t, repo.DeleteDraftsByAuctionID(context.Background(), 2))
+}
+
+func TestInvoiceStore_ListIssuedByVenueBetween(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewInvoiceStore(postgres.NewClient(db))
+	start := time.Date(2024, 2, 29, 15, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	mock.ExpectQuery("SELECT .* FROM invoices i .* JOIN auctions a ON i.auction_id = a.id WHERE a.venue_id = \\$1 AND i.status IN \\('issued', 'paid'\\) AND i.issued_at >= \\$2 AND i.issued_at < \\$3").
+		WithArgs(1, start, end).
+		WillReturnRows(sqlmock.NewRows(invoiceRowColumns).
+			AddRow(10, 1, "Buyer", 2, 1000, 80, 1080, 0, 0, "issued", time.Now(), nil, time.Now(), time.Now()).
+			AddRow(11, 2, "Other", 2, 500, 50, 550, 0, 550, "paid", time.Now(), time.Now(), time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT id, invoice_id, item_id, charge_id, description, quantity, unit, amount, tax_rate FROM invoice_lines WHERE invoice_id = ANY\\(\\$1\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "invoice_id", "item_id", "charge_id", "description", "quantity", "unit", "amount", "tax_rate"}).
+			AddRow(20, 10, 101, nil, "Tuna", 1, "kg", 1000, 8).
+			AddRow(21, 11, nil, 3, "Ice", 1, "", 500, 10))
+
+	list, err := repo.ListIssuedByVenueBetween(context.Background(), 1, start, end)
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Len(t, list[0].Lines, 1)
+	assert.Equal(t, 21, list[1].Lines[0].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
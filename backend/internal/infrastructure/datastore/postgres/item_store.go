@@ -78,17 +78,18 @@ func (r *ItemStore) ListByAuction(ctx context.Context, auctionID int) ([]model.A
 			ai.quantity, ai.unit, ai.created_at, ai.sort_order,
 			t_max.max_price as highest_bid,
 			t_max.buyer_id as highest_bidder_id,
-			b.name as highest_bidder_name
+			b.paddle_number as highest_bidder_paddle
 		FROM auction_items ai
 		LEFT JOIN (
 			SELECT
 				t1.item_id,
 				MAX(t1.price) as max_price,
 				(SELECT t2.buyer_id FROM transactions t2
-				 WHERE t2.item_id = t1.item_id
+				 WHERE t2.item_id = t1.item_id AND t2.voided_at IS NULL
 				 ORDER BY t2.price DESC, t2.created_at ASC
 				 LIMIT 1) as buyer_id
 			FROM transactions t1
+			WHERE t1.voided_at IS NULL
 			GROUP BY t1.item_id
 		) t_max ON ai.id = t_max.item_id
 		LEFT JOIN buyers b ON t_max.buyer_id = b.id
@@ -107,13 +108,13 @@ func (r *ItemStore) ListByAuction(ctx context.Context, auctionID int) ([]model.A
 		var e entity.AuctionItem
 		var highestBid sql.NullInt64
 		var highestBidderID sql.NullInt64
-		var highestBidderName sql.NullString
+		var highestBidderPaddle sql.NullString
 
 		if err := rows.Scan(
 			&e.ID, &e.AuctionID, &e.FishermanID, &e.FishType,
 			&e.Quantity, &e.Unit, &e.CreatedAt,
 			&e.SortOrder,
-			&highestBid, &highestBidderID, &highestBidderName,
+			&highestBid, &highestBidderID, &highestBidderPaddle,
 		); err != nil {
 			return nil, dserrors.HandleError(err, "Item", nil, "failed to scan item row")
 		}
@@ -126,8 +127,8 @@ func (r *ItemStore) ListByAuction(ctx context.Context, auctionID int) ([]model.A
 			bidderID := int(highestBidderID.Int64)
 			e.HighestBidderID = &bidderID
 		}
-		if highestBidderName.Valid {
-			e.HighestBidderName = &highestBidderName.String
+		if highestBidderPaddle.Valid {
+			e.HighestBidderPaddle = &highestBidderPaddle.String
 		}
 
 		items = append(items, *e.ToModel())
@@ -138,31 +139,85 @@ func (r *ItemStore) ListByAuction(ctx context.Context, auctionID int) ([]model.A
 	return items, nil
 }
 
+// ListConsignedByFishermanID returns the lots the fisherman consigned, newest auction first,
+// with the highest valid bid and the number of valid bids on each lot.
+func (r *ItemStore) ListConsignedByFishermanID(ctx context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error) {
+	query := `
+		SELECT
+			ai.id, ai.auction_id,
+			TO_CHAR(a.start_at AT TIME ZONE 'Asia/Tokyo', 'YYYY-MM-DD'),
+			a.status, ai.fish_type, ai.quantity, ai.unit,
+			MAX(t.price) AS highest_bid,
+			COUNT(t.id) AS bid_count
+		FROM auction_items ai
+		JOIN auctions a ON ai.auction_id = a.id
+		LEFT JOIN transactions t ON t.item_id = ai.id AND t.voided_at IS NULL
+		WHERE ai.fisherman_id = $1 AND ai.deleted_at IS NULL`
+	args := []any{fishermanID}
+	if status != nil {
+		query += " AND a.status = $2"
+		args = append(args, string(*status))
+	}
+	query += `
+		GROUP BY ai.id, a.id
+		ORDER BY a.start_at DESC NULLS LAST, ai.auction_id DESC, ai.sort_order ASC, ai.id ASC`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Item", fishermanID, "failed to list consigned items")
+	}
+	defer func() { _ = rows.Close() }()
+
+	lots := []model.ConsignedLot{}
+	for rows.Next() {
+		var l model.ConsignedLot
+		var auctionDate sql.NullString
+		var highestBid sql.NullInt64
+		if err := rows.Scan(
+			&l.ItemID, &l.AuctionID, &auctionDate, &l.AuctionStatus,
+			&l.FishType, &l.Quantity, &l.Unit,
+			&highestBid, &l.BidCount,
+		); err != nil {
+			return nil, dserrors.HandleError(err, "Item", fishermanID, "failed to scan consigned item row")
+		}
+		l.AuctionDate = auctionDate.String
+		if highestBid.Valid {
+			price := int(highestBid.Int64)
+			l.Price = &price
+		}
+		lots = append(lots, l)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, dserrors.HandleError(err, "Item", fishermanID, "failed to iterate consigned item rows")
+	}
+	return lots, nil
+}
+
 // FindByID returns an auction item by its ID.
 func (r *ItemStore) FindByID(ctx context.Context, id int) (*model.AuctionItem, error) {
 	var e entity.AuctionItem
 	var highestBid sql.NullInt64
 	var highestBidderID sql.NullInt64
-	var highestBidderName sql.NullString
+	var highestBidderPaddle sql.NullString
 
 	query := `
 		SELECT
 			ai.id, ai.auction_id, ai.fisherman_id, ai.fish_type,
-			ai.quantity, ai.unit, ai.created_at, ai.sort_order,
+			ai.quantity, ai.unit, ai.created_at, ai.sort_order, ai.deleted_at,
 			t_max.max_price as highest_bid,
 			t_max.buyer_id as highest_bidder_id,
-			b.name as highest_bidder_name
+			b.paddle_number as highest_bidder_paddle
 		FROM auction_items ai
 		LEFT JOIN (
 			SELECT
 				t1.item_id,
 				MAX(t1.price) as max_price,
 				(SELECT t2.buyer_id FROM transactions t2
-				 WHERE t2.item_id = t1.item_id
+				 WHERE t2.item_id = t1.item_id AND t2.voided_at IS NULL
 				 ORDER BY t2.price DESC, t2.created_at ASC
 				 LIMIT 1) as buyer_id
 			FROM transactions t1
-			WHERE t1.item_id = $1
+			WHERE t1.item_id = $1 AND t1.voided_at IS NULL
 			GROUP BY t1.item_id
 		) t_max ON ai.id = t_max.item_id
 		LEFT JOIN buyers b ON t_max.buyer_id = b.id
@@ -172,8 +227,8 @@ func (r *ItemStore) FindByID(ctx context.Context, id int) (*model.AuctionItem, e
 	err := r.db.QueryRow(ctx, query, id).Scan(
 		&e.ID, &e.AuctionID, &e.FishermanID, &e.FishType,
 		&e.Quantity, &e.Unit, &e.CreatedAt,
-		&e.SortOrder,
-		&highestBid, &highestBidderID, &highestBidderName,
+		&e.SortOrder, &e.DeletedAt,
+		&highestBid, &highestBidderID, &highestBidderPaddle,
 	)
 
 	if err != nil {
@@ -188,8 +243,8 @@ func (r *ItemStore) FindByID(ctx context.Context, id int) (*model.AuctionItem, e
 		bidderID := int(highestBidderID.Int64)
 		e.HighestBidderID = &bidderID
 	}
-	if highestBidderName.Valid {
-		e.HighestBidderName = &highestBidderName.String
+	if highestBidderPaddle.Valid {
+		e.HighestBidderPaddle = &highestBidderPaddle.String
 	}
 
 	return e.ToModel(), nil
@@ -200,7 +255,7 @@ func (r *ItemStore) FindByIDWithLock(ctx context.Context, id int) (*model.Auctio
 	var e entity.AuctionItem
 	var highestBid sql.NullInt64
 	var highestBidderID sql.NullInt64
-	var highestBidderName sql.NullString
+	var highestBidderPaddle sql.NullString
 
 	query := `
 		SELECT
@@ -208,18 +263,18 @@ func (r *ItemStore) FindByIDWithLock(ctx context.Context, id int) (*model.Auctio
 			ai.quantity, ai.unit, ai.created_at, ai.sort_order,
 			t_max.max_price as highest_bid,
 			t_max.buyer_id as highest_bidder_id,
-			b.name as highest_bidder_name
+			b.paddle_number as highest_bidder_paddle
 		FROM auction_items ai
 		LEFT JOIN (
 			SELECT
 				t1.item_id,
 				MAX(t1.price) as max_price,
 				(SELECT t2.buyer_id FROM transactions t2
-				 WHERE t2.item_id = t1.item_id
+				 WHERE t2.item_id = t1.item_id AND t2.voided_at IS NULL
 				 ORDER BY t2.price DESC, t2.created_at ASC
 				 LIMIT 1) as buyer_id
 			FROM transactions t1
-			WHERE t1.item_id = $1
+			WHERE t1.item_id = $1 AND t1.voided_at IS NULL
 			GROUP BY t1.item_id
 		) t_max ON ai.id = t_max.item_id
 		LEFT JOIN buyers b ON t_max.buyer_id = b.id
@@ -231,7 +286,7 @@ func (r *ItemStore) FindByIDWithLock(ctx context.Context, id int) (*model.Auctio
 		&e.ID, &e.AuctionID, &e.FishermanID, &e.FishType,
 		&e.Quantity, &e.Unit, &e.CreatedAt,
 		&e.SortOrder,
-		&highestBid, &highestBidderID, &highestBidderName,
+		&highestBid, &highestBidderID, &highestBidderPaddle,
 	)
 
 	if err != nil {
@@ -246,8 +301,8 @@ func (r *ItemStore) FindByIDWithLock(ctx context.Context, id int) (*model.Auctio
 		bidderID := int(highestBidderID.Int64)
 		e.HighestBidderID = &bidderID
 	}
-	if highestBidderName.Valid {
-		e.HighestBidderName = &highestBidderName.String
+	if highestBidderPaddle.Valid {
+		e.HighestBidderPaddle = &highestBidderPaddle.String
 	}
 
 	return e.ToModel(), nil
@@ -22,16 +22,18 @@ func TestItemStore_FindByID(t *testing.T) {
 	repo := postgres.NewItemStore(postgres.NewClient(db))
 	id := 1
 
-	mock.ExpectQuery("(?s)SELECT .* FROM auction_items ai .*").
+	mock.ExpectQuery("(?s)SELECT .*b.paddle_number as highest_bidder_paddle.* FROM auction_items ai .*").
 		WithArgs(id).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "auction_id", "fisherman_id", "fish_type", "quantity", "unit", "created_at", "sort_order",
-			"highest_bid", "highest_bidder_id", "highest_bidder_name",
-		}).AddRow(id, 1, 1, "DB Tuna", 10, "kg", time.Now(), 1, nil, nil, nil))
+			"id", "auction_id", "fisherman_id", "fish_type", "quantity", "unit", "created_at", "sort_order", "deleted_at",
+			"highest_bid", "highest_bidder_id", "highest_bidder_paddle",
+		}).AddRow(id, 1, 1, "DB Tuna", 10, "kg", time.Now(), 1, nil, 5000, 7, "128"))
 
 	item, err := repo.FindByID(context.Background(), id)
 	require.NoError(t, err)
 	assert.Equal(t, "DB Tuna", item.FishType)
+	require.NotNil(t, item.HighestBidderPaddle)
+	assert.Equal(t, "128", *item.HighestBidderPaddle)
 }
 
 func TestItemStore_Create(t *testing.T) {
@@ -61,3 +63,30 @@ func TestItemStore_Create(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "Tuna", created.FishType)
 }
+
+func TestItemStore_ListConsignedByFishermanID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewItemStore(postgres.NewClient(db))
+	status := model.AuctionStatusInProgress
+	columns := []string{"id", "auction_id", "auction_date", "status", "fish_type", "quantity", "unit", "highest_bid", "bid_count"}
+
+	mock.ExpectQuery("(?s)SELECT .* FROM auction_items ai .* WHERE ai.fisherman_id = \\$1 AND ai.deleted_at IS NULL AND a.status = \\$2 GROUP BY .*").
+		WithArgs(3, "in_progress").
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(10, 2, "2026-05-01", "in_progress", "マグロ", 1, "本", 120000, 4).
+			AddRow(11, 2, "2026-05-01", "in_progress", "ブリ", 5, "kg", nil, 0))
+
+	lots, err := repo.ListConsignedByFishermanID(context.Background(), 3, &status)
+	require.NoError(t, err)
+	require.Len(t, lots, 2)
+	require.NotNil(t, lots[0].Price)
+	assert.Equal(t, 120000, *lots[0].Price)
+	assert.Equal(t, 4, lots[0].BidCount)
+	assert.Nil(t, lots[1].Price)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -37,11 +37,25 @@ func (s *OutboxStore) insert(ctx context.Context, jobType model.JobType, schemaV
 }
 
 // InsertEmailJob serializes and inserts an email job.
-func (s *OutboxStore) InsertEmailJob(ctx context.Context, to, resetURL, emailType string) error {
+func (s *OutboxStore) InsertEmailJob(ctx context.Context, to, url, emailType string) error {
 	msg := event.EmailMessage{
 		EmailType: event.EmailType(emailType),
 		To:        to,
-		ResetURL:  resetURL,
+		URL:       url,
+	}
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal email job: %w", err)
+	}
+	return s.insert(ctx, model.JobTypeEmail, 1, payload)
+}
+
+// InsertRejectionEmailJob serializes and inserts an email job carrying the reason for a rejection.
+func (s *OutboxStore) InsertRejectionEmailJob(ctx context.Context, to, reason, emailType string) error {
+	msg := event.EmailMessage{
+		EmailType: event.EmailType(emailType),
+		To:        to,
+		Reason:    reason,
 	}
 	payload, err := json.Marshal(msg)
 	if err != nil {
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.PaymentRepository = (*PaymentStore)(nil)
+
+// 充当済み金額は payment_allocations から都度集計する（payments 側に冗長カラムを持たない）。
+const paymentColumns = `
+	p.id, p.buyer_id, p.invoice_id, p.method, p.amount,
+	COALESCE((SELECT SUM(pa.amount) FROM payment_allocations pa WHERE pa.payment_id = p.id), 0),
+	p.received_at, p.note, p.recorded_by, p.created_at`
+
+// PaymentStore implements repository.PaymentRepository using PostgreSQL.
+type PaymentStore struct {
+	db datastore.Database
+}
+
+// NewPaymentStore creates a new instance of PaymentRepository
+func NewPaymentStore(db datastore.Database) *PaymentStore {
+	return &PaymentStore{db: db}
+}
+
+// Create stores a new payment.
+func (r *PaymentStore) Create(ctx context.Context, payment *model.Payment) (*model.Payment, error) {
+	p := *payment
+	p.AllocatedAmount = 0
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO payments (buyer_id, invoice_id, method, amount, received_at, note, recorded_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at`,
+		p.BuyerID, p.InvoiceID, string(p.Method), p.Amount, p.ReceivedAt, p.Note, p.RecordedBy,
+	).Scan(&p.ID, &p.CreatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Payment", 0, "Create")
+	}
+	return &p, nil
+}
+
+// CreateAllocation records the application of a payment to an invoice.
+func (r *PaymentStore) CreateAllocation(ctx context.Context, allocation *model.PaymentAllocation) error {
+	_, err := r.db.Execute(ctx,
+		`INSERT INTO payment_allocations (payment_id, invoice_id, amount) VALUES ($1, $2, $3)`,
+		allocation.PaymentID, allocation.InvoiceID, allocation.Amount,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "PaymentAllocation", allocation.PaymentID, "CreateAllocation")
+	}
+	return nil
+}
+
+// ListAllocationsByInvoiceID returns the net amount each payment has applied to an invoice,
+// in the order the payments were first applied.
+// 過入金の戻し（負の充当）を差し引き、残額のある入金だけを返す。
+func (r *PaymentStore) ListAllocationsByInvoiceID(ctx context.Context, invoiceID int) ([]model.PaymentAllocation, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT pa.payment_id, pa.invoice_id, SUM(pa.amount), MIN(pa.created_at)
+		FROM payment_allocations pa
+		WHERE pa.invoice_id = $1
+		GROUP BY pa.payment_id, pa.invoice_id
+		HAVING SUM(pa.amount) > 0
+		ORDER BY MIN(pa.id) ASC`, invoiceID)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "PaymentAllocation", invoiceID, "ListAllocationsByInvoiceID")
+	}
+	defer func() { _ = rows.Close() }()
+
+	allocations := []model.PaymentAllocation{}
+	for rows.Next() {
+		var a model.PaymentAllocation
+		if err := rows.Scan(&a.PaymentID, &a.InvoiceID, &a.Amount, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, a)
+	}
+	return allocations, dserrors.HandleError(rows.Err(), "PaymentAllocation", invoiceID, "ListAllocationsByInvoiceID")
+}
+
+// ListByBuyerID returns all payments of a buyer oldest first.
+func (r *PaymentStore) ListByBuyerID(ctx context.Context, buyerID int) ([]model.Payment, error) {
+	query := `SELECT ` + paymentColumns + `
+		FROM payments p
+		WHERE p.buyer_id = $1
+		ORDER BY p.received_at ASC, p.id ASC`
+	return r.list(ctx, "ListByBuyerID", buyerID, query)
+}
+
+// ListByBuyerIDWithLock returns all payments of a buyer oldest first and locks them.
+func (r *PaymentStore) ListByBuyerIDWithLock(ctx context.Context, buyerID int) ([]model.Payment, error) {
+	query := `SELECT ` + paymentColumns + `
+		FROM payments p
+		WHERE p.buyer_id = $1
+		ORDER BY p.received_at ASC, p.id ASC
+		FOR UPDATE OF p`
+	return r.list(ctx, "ListByBuyerIDWithLock", buyerID, query)
+}
+
+func (r *PaymentStore) list(ctx context.Context, op string, buyerID int, query string) ([]model.Payment, error) {
+	rows, err := r.db.Query(ctx, query, buyerID)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Payment", buyerID, op)
+	}
+	defer func() { _ = rows.Close() }()
+
+	payments := []model.Payment{}
+	for rows.Next() {
+		var p model.Payment
+		if err := rows.Scan(
+			&p.ID, &p.BuyerID, &p.InvoiceID, &p.Method, &p.Amount, &p.AllocatedAmount,
+			&p.ReceivedAt, &p.Note, &p.RecordedBy, &p.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
+	return payments, dserrors.HandleError(rows.Err(), "Payment", buyerID, op)
+}
+
+// ListReceiptsByVenueBetween returns the payment amounts applied to invoices of a venue's auctions,
+// one row per allocation, for payments received in [start, end).
+// 未充当の残額（前受金）は会場に紐づかないため含まない。赤伝で戻した過入金は負の行として現れる。
+func (r *PaymentStore) ListReceiptsByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.PaymentReceipt, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT p.id, pa.invoice_id, b.name, p.method, pa.amount, p.received_at
+		FROM payment_allocations pa
+		JOIN payments p ON pa.payment_id = p.id
+		JOIN buyers b ON p.buyer_id = b.id
+		JOIN invoices i ON pa.invoice_id = i.id
+		JOIN auctions a ON i.auction_id = a.id
+		WHERE a.venue_id = $1 AND p.received_at >= $2 AND p.received_at < $3
+		ORDER BY p.received_at ASC, pa.id ASC`, venueID, start, end)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Payment", venueID, "ListReceiptsByVenueBetween")
+	}
+	defer func() { _ = rows.Close() }()
+
+	receipts := []model.PaymentReceipt{}
+	for rows.Next() {
+		var pr model.PaymentReceipt
+		if err := rows.Scan(&pr.PaymentID, &pr.InvoiceID, &pr.BuyerName, &pr.Method, &pr.Amount, &pr.ReceivedAt); err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, pr)
+	}
+	return receipts, dserrors.HandleError(rows.Err(), "Payment", venueID, "ListReceiptsByVenueBetween")
+}
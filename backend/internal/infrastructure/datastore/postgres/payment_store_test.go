@@ -0,0 +1,124 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaymentStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewPaymentStore(postgres.NewClient(db))
+	adminID := 3
+	receivedAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	p := &model.Payment{
+		BuyerID:    1,
+		Method:     model.PaymentMethodCash,
+		Amount:     5000,
+		ReceivedAt: receivedAt,
+		RecordedBy: &adminID,
+	}
+
+	mock.ExpectQuery("INSERT INTO payments").
+		WithArgs(1, nil, "cash", 5000, receivedAt, "", &adminID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(7, time.Now()))
+
+	created, err := repo.Create(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, created.ID)
+}
+
+func TestPaymentStore_CreateAllocation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewPaymentStore(postgres.NewClient(db))
+
+	mock.ExpectExec("INSERT INTO payment_allocations").
+		WithArgs(7, 10, 3000).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.CreateAllocation(context.Background(), &model.PaymentAllocation{PaymentID: 7, InvoiceID: 10, Amount: 3000})
+	assert.NoError(t, err)
+}
+
+func TestPaymentStore_ListAllocationsByInvoiceID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewPaymentStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM payment_allocations pa WHERE pa.invoice_id = \\$1 GROUP BY .* HAVING SUM\\(pa.amount\\) > 0").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"payment_id", "invoice_id", "amount", "created_at"}).
+			AddRow(7, 10, 3000, time.Now()).
+			AddRow(8, 10, 500, time.Now()))
+
+	list, err := repo.ListAllocationsByInvoiceID(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, 7, list[0].PaymentID)
+	assert.Equal(t, 500, list[1].Amount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPaymentStore_ListByBuyerID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewPaymentStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM payments p WHERE p.buyer_id = \\$1 ORDER BY p.received_at ASC, p.id ASC").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "buyer_id", "invoice_id", "method", "amount", "allocated", "received_at", "note", "recorded_by", "created_at"}).
+			AddRow(7, 1, 10, "bank_transfer", 5000, 3000, time.Now(), "", 3, time.Now()).
+			AddRow(8, 1, nil, "cash", 1000, 0, time.Now(), "", nil, time.Now()))
+
+	list, err := repo.ListByBuyerID(context.Background(), 1)
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, 2000, list[0].UnallocatedAmount())
+	assert.Equal(t, model.PaymentMethodBankTransfer, list[0].Method)
+	assert.Nil(t, list[1].InvoiceID)
+}
+
+func TestPaymentStore_ListReceiptsByVenueBetween(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewPaymentStore(postgres.NewClient(db))
+	start := time.Date(2024, 2, 29, 15, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	mock.ExpectQuery("SELECT p.id, pa.invoice_id, b.name, p.method, pa.amount, p.received_at FROM payment_allocations pa .* WHERE a.venue_id = \\$1 AND p.received_at >= \\$2 AND p.received_at < \\$3").
+		WithArgs(1, start, end).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "invoice_id", "name", "method", "amount", "received_at"}).
+			AddRow(5, 10, "Buyer", "cash", 3000, start))
+
+	list, err := repo.ListReceiptsByVenueBetween(context.Background(), 1, start, end)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.PaymentReceipt{{PaymentID: 5, InvoiceID: 10, BuyerName: "Buyer", Method: model.PaymentMethodCash, Amount: 3000, ReceivedAt: start}}, list)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
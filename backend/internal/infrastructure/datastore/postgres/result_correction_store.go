@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.ResultCorrectionRepository = (*ResultCorrectionStore)(nil)
+
+// 承認後は落札（transactions）自体が書き換わるため、訂正前後の値は訂正側に保持する。
+const resultCorrectionColumns = `
+	rc.id, rc.purchase_id, t.item_id, ai.auction_id, ai.fish_type,
+	rc.old_buyer_id, rc.old_price, rc.new_buyer_id, rc.new_price, rc.reason,
+	rc.status, rc.requested_by, rc.reviewed_by, rc.review_note, rc.reviewed_at, rc.created_at, rc.updated_at`
+
+const resultCorrectionFrom = `
+	FROM result_corrections rc
+	JOIN transactions t ON rc.purchase_id = t.id
+	JOIN auction_items ai ON t.item_id = ai.id`
+
+// ResultCorrectionStore implements repository.ResultCorrectionRepository using PostgreSQL.
+type ResultCorrectionStore struct {
+	db datastore.Database
+}
+
+// NewResultCorrectionStore creates a new instance of ResultCorrectionRepository
+func NewResultCorrectionStore(db datastore.Database) *ResultCorrectionStore {
+	return &ResultCorrectionStore{db: db}
+}
+
+// Create stores a new correction request.
+func (r *ResultCorrectionStore) Create(ctx context.Context, correction *model.ResultCorrection) (*model.ResultCorrection, error) {
+	c := *correction
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO result_corrections (purchase_id, old_buyer_id, old_price, new_buyer_id, new_price, reason, status, requested_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, updated_at`,
+		c.PurchaseID, c.OldBuyerID, c.OldPrice, c.NewBuyerID, c.NewPrice, c.Reason, string(c.Status), c.RequestedBy,
+	).Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "ResultCorrection", 0, "Create")
+	}
+	return &c, nil
+}
+
+// FindByID returns a correction.
+func (r *ResultCorrectionStore) FindByID(ctx context.Context, id int) (*model.ResultCorrection, error) {
+	return r.findByID(ctx, id, "")
+}
+
+// FindByIDWithLock returns a correction and locks the correction row.
+func (r *ResultCorrectionStore) FindByIDWithLock(ctx context.Context, id int) (*model.ResultCorrection, error) {
+	return r.findByID(ctx, id, " FOR UPDATE OF rc")
+}
+
+func (r *ResultCorrectionStore) findByID(ctx context.Context, id int, lockClause string) (*model.ResultCorrection, error) {
+	query := `SELECT ` + resultCorrectionColumns + resultCorrectionFrom + `
+		WHERE rc.id = $1` + lockClause
+
+	c, err := scanResultCorrection(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "ResultCorrection", id, "FindByID")
+	}
+	return c, nil
+}
+
+// List returns corrections matching the filters, newest first.
+func (r *ResultCorrectionStore) List(ctx context.Context, filters *repository.ResultCorrectionFilters) ([]model.ResultCorrection, error) {
+	query := `SELECT ` + resultCorrectionColumns + resultCorrectionFrom
+
+	var conditions []string
+	var args []any
+	argIndex := 1
+
+	if filters != nil {
+		if filters.AuctionID != nil {
+			conditions = append(conditions, fmt.Sprintf("ai.auction_id = $%d", argIndex))
+			args = append(args, *filters.AuctionID)
+			argIndex++
+		}
+		if filters.Status != nil {
+			conditions = append(conditions, fmt.Sprintf("rc.status = $%d", argIndex))
+			args = append(args, string(*filters.Status))
+		}
+	}
+
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY rc.created_at DESC, rc.id DESC"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "ResultCorrection", 0, "List")
+	}
+	defer func() { _ = rows.Close() }()
+
+	corrections := []model.ResultCorrection{}
+	for rows.Next() {
+		c, err := scanResultCorrection(rows)
+		if err != nil {
+			return nil, err
+		}
+		corrections = append(corrections, *c)
+	}
+	return corrections, dserrors.HandleError(rows.Err(), "ResultCorrection", 0, "List")
+}
+
+// Update persists the review of a correction.
+func (r *ResultCorrectionStore) Update(ctx context.Context, correction *model.ResultCorrection) error {
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE result_corrections
+		SET status = $1, reviewed_by = $2, review_note = $3, reviewed_at = $4, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $5`,
+		string(correction.Status), correction.ReviewedBy, correction.ReviewNote, correction.ReviewedAt, correction.ID,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "ResultCorrection", correction.ID, "Update")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "ResultCorrection", ID: correction.ID}
+	}
+	return nil
+}
+
+func scanResultCorrection(row datastore.Row) (*model.ResultCorrection, error) {
+	var c model.ResultCorrection
+	if err := row.Scan(
+		&c.ID, &c.PurchaseID, &c.ItemID, &c.AuctionID, &c.FishType,
+		&c.OldBuyerID, &c.OldPrice, &c.NewBuyerID, &c.NewPrice, &c.Reason,
+		&c.Status, &c.RequestedBy, &c.ReviewedBy, &c.ReviewNote, &c.ReviewedAt, &c.CreatedAt, &c.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
@@ -0,0 +1,104 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+var resultCorrectionRowColumns = []string{
+	"id", "purchase_id", "item_id", "auction_id", "fish_type",
+	"old_buyer_id", "old_price", "new_buyer_id", "new_price", "reason",
+	"status", "requested_by", "reviewed_by", "review_note", "reviewed_at", "created_at", "updated_at",
+}
+
+func TestResultCorrectionStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewResultCorrectionStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("INSERT INTO result_corrections").
+		WithArgs(1, 2, 5000, 3, 5000, "札の読み違い", "pending", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(4, time.Now(), time.Now()))
+
+	created, err := repo.Create(context.Background(), &model.ResultCorrection{
+		PurchaseID: 1, OldBuyerID: 2, OldPrice: 5000, NewBuyerID: 3, NewPrice: 5000,
+		Reason: "札の読み違い", Status: model.ResultCorrectionStatusPending, RequestedBy: 7,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, created.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResultCorrectionStore_FindByIDWithLock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewResultCorrectionStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM result_corrections rc .* WHERE rc.id = \\$1 FOR UPDATE OF rc").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows(resultCorrectionRowColumns).
+			AddRow(4, 1, 101, 7, "Tuna", 2, 5000, 3, 5000, "札の読み違い", "pending", 7, nil, "", nil, time.Now(), time.Now()))
+
+	c, err := repo.FindByIDWithLock(context.Background(), 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 101, c.ItemID)
+	assert.Equal(t, 7, c.AuctionID)
+	assert.Nil(t, c.ReviewedBy)
+}
+
+func TestResultCorrectionStore_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewResultCorrectionStore(postgres.NewClient(db))
+	auctionID := 7
+	status := model.ResultCorrectionStatusPending
+
+	mock.ExpectQuery("SELECT .* FROM result_corrections rc .* WHERE ai.auction_id = \\$1 AND rc.status = \\$2 ORDER BY rc.created_at DESC").
+		WithArgs(auctionID, "pending").
+		WillReturnRows(sqlmock.NewRows(resultCorrectionRowColumns).
+			AddRow(4, 1, 101, 7, "Tuna", 2, 5000, 3, 5000, "札の読み違い", "pending", 7, nil, "", nil, time.Now(), time.Now()))
+
+	list, err := repo.List(context.Background(), &repository.ResultCorrectionFilters{AuctionID: &auctionID, Status: &status})
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+}
+
+func TestResultCorrectionStore_Update(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewResultCorrectionStore(postgres.NewClient(db))
+	reviewer := 8
+	at := time.Now()
+
+	mock.ExpectExec("UPDATE result_corrections").
+		WithArgs("approved", &reviewer, "ok", &at, 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Update(context.Background(), &model.ResultCorrection{
+		ID: 4, Status: model.ResultCorrectionStatusApproved, ReviewedBy: &reviewer, ReviewNote: "ok", ReviewedAt: &at,
+	})
+	assert.NoError(t, err)
+}
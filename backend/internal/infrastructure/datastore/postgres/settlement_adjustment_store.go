@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.SettlementAdjustmentRepository = (*SettlementAdjustmentStore)(nil)
+
+// SettlementAdjustmentStore implements repository.SettlementAdjustmentRepository using PostgreSQL.
+type SettlementAdjustmentStore struct {
+	db datastore.Database
+}
+
+// NewSettlementAdjustmentStore creates a new instance of SettlementAdjustmentRepository
+func NewSettlementAdjustmentStore(db datastore.Database) *SettlementAdjustmentStore {
+	return &SettlementAdjustmentStore{db: db}
+}
+
+// Create stores a new pending adjustment.
+func (r *SettlementAdjustmentStore) Create(ctx context.Context, adjustment *model.SettlementAdjustment) (*model.SettlementAdjustment, error) {
+	a := *adjustment
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO settlement_adjustments (fisherman_id, credit_note_id, amount, description)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at`,
+		a.FishermanID, a.CreditNoteID, a.Amount, a.Description,
+	).Scan(&a.ID, &a.CreatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "SettlementAdjustment", 0, "Create")
+	}
+	return &a, nil
+}
+
+// ListPendingByFishermanIDs returns the adjustments of the given fishermen not yet deducted on a settlement, oldest first.
+func (r *SettlementAdjustmentStore) ListPendingByFishermanIDs(ctx context.Context, fishermanIDs []int) ([]model.SettlementAdjustment, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, fisherman_id, credit_note_id, amount, description, settlement_id, created_at
+		FROM settlement_adjustments
+		WHERE fisherman_id = ANY($1) AND settlement_id IS NULL
+		ORDER BY created_at ASC, id ASC
+		FOR UPDATE`, pq.Array(fishermanIDs))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "SettlementAdjustment", 0, "ListPendingByFishermanIDs")
+	}
+	defer func() { _ = rows.Close() }()
+
+	adjustments := []model.SettlementAdjustment{}
+	for rows.Next() {
+		var a model.SettlementAdjustment
+		if err := rows.Scan(&a.ID, &a.FishermanID, &a.CreditNoteID, &a.Amount, &a.Description, &a.SettlementID, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		adjustments = append(adjustments, a)
+	}
+	return adjustments, dserrors.HandleError(rows.Err(), "SettlementAdjustment", 0, "ListPendingByFishermanIDs")
+}
+
+// AssignToSettlement marks the adjustments as deducted on the given settlement.
+func (r *SettlementAdjustmentStore) AssignToSettlement(ctx context.Context, ids []int, settlementID int) error {
+	_, err := r.db.Execute(ctx,
+		`UPDATE settlement_adjustments SET settlement_id = $1 WHERE id = ANY($2)`,
+		settlementID, pq.Array(ids),
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "SettlementAdjustment", settlementID, "AssignToSettlement")
+	}
+	return nil
+}
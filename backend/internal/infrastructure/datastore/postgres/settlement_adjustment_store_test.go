@@ -0,0 +1,35 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSettlementAdjustmentStore_ListPendingAndAssign(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewSettlementAdjustmentStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM settlement_adjustments WHERE fisherman_id = ANY\\(\\$1\\) AND settlement_id IS NULL").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "fisherman_id", "credit_note_id", "amount", "description", "settlement_id", "created_at"}).
+			AddRow(1, 3, 10, 1000, "クレーム控除", nil, time.Now()))
+	mock.ExpectExec("UPDATE settlement_adjustments SET settlement_id = \\$1 WHERE id = ANY\\(\\$2\\)").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	list, err := repo.ListPendingByFishermanIDs(context.Background(), []int{3})
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Nil(t, list[0].SettlementID)
+
+	assert.NoError(t, repo.AssignToSettlement(context.Background(), []int{1}, 20))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.SettlementRepository = (*SettlementStore)(nil)
+
+const settlementColumns = `
+	s.id, s.fisherman_id, f.name, s.auction_id,
+	s.gross_amount, s.commission_rate, s.commission_amount, s.commission_tax, s.net_amount,
+	s.status, s.settled_at, s.exported_at, s.created_at, s.updated_at`
+
+// SettlementStore implements repository.SettlementRepository using PostgreSQL.
+type SettlementStore struct {
+	db datastore.Database
+}
+
+// NewSettlementStore creates a new instance of SettlementRepository
+func NewSettlementStore(db datastore.Database) *SettlementStore {
+	return &SettlementStore{db: db}
+}
+
+// Create stores a new settlement together with its lines.
+// Callers are expected to run this inside a transaction.
+func (r *SettlementStore) Create(ctx context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+	s := *settlement
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO settlements (fisherman_id, auction_id, gross_amount, commission_rate, commission_amount, commission_tax, net_amount, status, settled_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, updated_at`,
+		s.FishermanID, s.AuctionID, s.GrossAmount, s.CommissionRate, s.CommissionAmount, s.CommissionTax, s.NetAmount,
+		string(s.Status), s.SettledAt,
+	).Scan(&s.ID, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Settlement", 0, "Create")
+	}
+
+	s.Lines = make([]model.SettlementLine, len(settlement.Lines))
+	for i, l := range settlement.Lines {
+		l.SettlementID = s.ID
+		err := r.db.QueryRow(ctx, `
+			INSERT INTO settlement_lines (settlement_id, item_id, description, quantity, unit, amount)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id`,
+			l.SettlementID, l.ItemID, l.Description, l.Quantity, l.Unit, l.Amount,
+		).Scan(&l.ID)
+		if err != nil {
+			return nil, dserrors.HandleError(err, "SettlementLine", 0, "Create")
+		}
+		s.Lines[i] = l
+	}
+	return &s, nil
+}
+
+// FindByID returns a settlement with its lines.
+func (r *SettlementStore) FindByID(ctx context.Context, id int) (*model.Settlement, error) {
+	return r.findByID(ctx, id, "")
+}
+
+// FindByIDWithLock returns a settlement with its lines and locks the settlement row.
+func (r *SettlementStore) FindByIDWithLock(ctx context.Context, id int) (*model.Settlement, error) {
+	return r.findByID(ctx, id, " FOR UPDATE OF s")
+}
+
+func (r *SettlementStore) findByID(ctx context.Context, id int, lockClause string) (*model.Settlement, error) {
+	query := `SELECT ` + settlementColumns + `
+		FROM settlements s
+		JOIN fishermen f ON s.fisherman_id = f.id
+		WHERE s.id = $1` + lockClause
+
+	s, err := scanSettlement(r.db.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Settlement", id, "FindByID")
+	}
+
+	lines, err := r.listLines(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.Lines = lines
+	return s, nil
+}
+
+func (r *SettlementStore) listLines(ctx context.Context, settlementID int) ([]model.SettlementLine, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT id, settlement_id, item_id, description, quantity, unit, amount
+		FROM settlement_lines
+		WHERE settlement_id = $1
+		ORDER BY id ASC`, settlementID)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "SettlementLine", settlementID, "listLines")
+	}
+	defer func() { _ = rows.Close() }()
+
+	lines := []model.SettlementLine{}
+	for rows.Next() {
+		var l model.SettlementLine
+		if err := rows.Scan(&l.ID, &l.SettlementID, &l.ItemID, &l.Description, &l.Quantity, &l.Unit, &l.Amount); err != nil {
+			return nil, err
+		}
+		lines = append(lines, l)
+	}
+	return lines, dserrors.HandleError(rows.Err(), "SettlementLine", settlementID, "listLines")
+}
+
+// ListByAuctionID returns the settlements generated for an auction (without lines).
+func (r *SettlementStore) ListByAuctionID(ctx context.Context, auctionID int) ([]model.Settlement, error) {
+	query := `SELECT ` + settlementColumns + `
+		FROM settlements s
+		JOIN fishermen f ON s.fisherman_id = f.id
+		WHERE s.auction_id = $1
+		ORDER BY s.fisherman_id ASC`
+	return r.list(ctx, "ListByAuctionID", auctionID, query, auctionID)
+}
+
+// ListByIDs returns the given settlements (without lines) ordered by ID.
+func (r *SettlementStore) ListByIDs(ctx context.Context, ids []int) ([]model.Settlement, error) {
+	query := `SELECT ` + settlementColumns + `
+		FROM settlements s
+		JOIN fishermen f ON s.fisherman_id = f.id
+		WHERE s.id = ANY($1)
+		ORDER BY s.id ASC`
+	return r.list(ctx, "ListByIDs", 0, query, pq.Array(ids))
+}
+
+// ListByIDsWithLock returns the given settlements (without lines) ordered by ID and locks their rows.
+// ID 順にロックすることで、同時に書き出す場合のデッドロックを避ける。
+func (r *SettlementStore) ListByIDsWithLock(ctx context.Context, ids []int) ([]model.Settlement, error) {
+	query := `SELECT ` + settlementColumns + `
+		FROM settlements s
+		JOIN fishermen f ON s.fisherman_id = f.id
+		WHERE s.id = ANY($1)
+		ORDER BY s.id ASC
+		FOR UPDATE OF s`
+	return r.list(ctx, "ListByIDsWithLock", 0, query, pq.Array(ids))
+}
+
+// MarkExported sets the export time of the given settlements.
+func (r *SettlementStore) MarkExported(ctx context.Context, ids []int, exportedAt time.Time) error {
+	_, err := r.db.Execute(ctx, `
+		UPDATE settlements
+		SET exported_at = $1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = ANY($2)`,
+		exportedAt, pq.Array(ids),
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Settlement", 0, "MarkExported")
+	}
+	return nil
+}
+
+// ListSettledByFishermanID returns the settled statements of a fisherman (without lines), newest first.
+func (r *SettlementStore) ListSettledByFishermanID(ctx context.Context, fishermanID int) ([]model.Settlement, error) {
+	query := `SELECT ` + settlementColumns + `
+		FROM settlements s
+		JOIN fishermen f ON s.fisherman_id = f.id
+		WHERE s.fisherman_id = $1 AND s.status = 'settled'
+		ORDER BY s.settled_at DESC, s.id DESC`
+	return r.list(ctx, "ListSettledByFishermanID", fishermanID, query, fishermanID)
+}
+
+// ListSettledByVenueBetween returns the settled statements of a venue's auctions settled in [start, end).
+func (r *SettlementStore) ListSettledByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.Settlement, error) {
+	query := `SELECT ` + settlementColumns + `
+		FROM settlements s
+		JOIN fishermen f ON s.fisherman_id = f.id
+		JOIN auctions a ON s.auction_id = a.id
+		WHERE a.venue_id = $1 AND s.status = 'settled' AND s.settled_at >= $2 AND s.settled_at < $3
+		ORDER BY s.settled_at ASC, s.id ASC`
+	return r.list(ctx, "ListSettledByVenueBetween", venueID, query, venueID, start, end)
+}
+
+func (r *SettlementStore) list(ctx context.Context, op string, id int, query string, args ...any) ([]model.Settlement, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "Settlement", id, op)
+	}
+	defer func() { _ = rows.Close() }()
+
+	settlements := []model.Settlement{}
+	for rows.Next() {
+		s, err := scanSettlement(rows)
+		if err != nil {
+			return nil, err
+		}
+		settlements = append(settlements, *s)
+	}
+	return settlements, dserrors.HandleError(rows.Err(), "Settlement", id, op)
+}
+
+// Update persists the mutable state (status and timestamps) of a settlement.
+func (r *SettlementStore) Update(ctx context.Context, settlement *model.Settlement) error {
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE settlements
+		SET status = $1, settled_at = $2, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $3`,
+		string(settlement.Status), settlement.SettledAt, settlement.ID,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "Settlement", settlement.ID, "Update")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "Settlement", ID: settlement.ID}
+	}
+	return nil
+}
+
+// DeleteDraftsByAuctionID removes draft settlements of an auction so they can be regenerated.
+func (r *SettlementStore) DeleteDraftsByAuctionID(ctx context.Context, auctionID int) error {
+	_, err := r.db.Execute(ctx, `DELETE FROM settlements WHERE auction_id = $1 AND status = 'draft'`, auctionID)
+	if err != nil {
+		return dserrors.HandleError(err, "Settlement", auctionID, "DeleteDraftsByAuctionID")
+	}
+	return nil
+}
+
+func scanSettlement(row datastore.Row) (*model.Settlement, error) {
+	var s model.Settlement
+	if err := row.Scan(
+		&s.ID, &s.FishermanID, &s.FishermanName, &s.AuctionID,
+		&s.GrossAmount, &s.CommissionRate, &s.CommissionAmount, &s.CommissionTax, &s.NetAmount,
+		&s.Status, &s.SettledAt, &s.ExportedAt, &s.CreatedAt, &s.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
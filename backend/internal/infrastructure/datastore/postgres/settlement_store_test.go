@@ -0,0 +1,182 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+var settlementRowColumns = []string{
+	"id", "fisherman_id", "name", "auction_id",
+	"gross_amount", "commission_rate", "commission_amount", "commission_tax", "net_amount",
+	"status", "settled_at", "exported_at", "created_at", "updated_at",
+}
+
+func TestSettlementStore_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewSettlementStore(postgres.NewClient(db))
+	itemID := 101
+	s := &model.Settlement{
+		FishermanID:    3,
+		AuctionID:      2,
+		CommissionRate: 5,
+		Status:         model.SettlementStatusDraft,
+		Lines: []model.SettlementLine{
+			{ItemID: &itemID, Description: "Tuna", Quantity: 1, Unit: "kg", Amount: 10000},
+		},
+	}
+	s.Recalculate()
+
+	mock.ExpectQuery("INSERT INTO settlements").
+		WithArgs(3, 2, 10000, 5, 500, 50, 9450, "draft", nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(10, time.Now(), time.Now()))
+	mock.ExpectQuery("INSERT INTO settlement_lines").
+		WithArgs(10, &itemID, "Tuna", 1, "kg", 10000).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+
+	created, err := repo.Create(context.Background(), s)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, created.ID)
+	assert.Equal(t, 10, created.Lines[0].SettlementID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSettlementStore_FindByID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewSettlementStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM settlements s JOIN fishermen f ON s.fisherman_id = f.id WHERE s.id = \\$1").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows(settlementRowColumns).
+			AddRow(10, 3, "Fisher", 2, 10000, 5, 500, 50, 9450, "settled", time.Now(), nil, time.Now(), time.Now()))
+	mock.ExpectQuery("SELECT id, settlement_id, item_id, description, quantity, unit, amount FROM settlement_lines").
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "settlement_id", "item_id", "description", "quantity", "unit", "amount"}).
+			AddRow(20, 10, 101, "Tuna", 1, "kg", 10000))
+
+	s, err := repo.FindByID(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, model.SettlementStatusSettled, s.Status)
+	assert.Equal(t, "Fisher", s.FishermanName)
+	assert.Len(t, s.Lines, 1)
+}
+
+func TestSettlementStore_ListByIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewSettlementStore(postgres.NewClient(db))
+
+	mock.ExpectQuery("SELECT .* FROM settlements s .* WHERE s.id = ANY\\(\\$1\\)").
+		WillReturnRows(sqlmock.NewRows(settlementRowColumns).
+			AddRow(1, 3, "A", 2, 100, 5, 5, 0, 95, "settled", time.Now(), nil, time.Now(), time.Now()).
+			AddRow(2, 4, "B", 2, 200, 5, 10, 1, 189, "draft", nil, nil, time.Now(), time.Now()))
+
+	list, err := repo.ListByIDs(context.Background(), []int{1, 2})
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Nil(t, list[1].SettledAt)
+}
+
+func TestSettlementStore_Export(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewSettlementStore(postgres.NewClient(db))
+	exportedAt := time.Now()
+
+	mock.ExpectQuery("SELECT .* FROM settlements s .* WHERE s.id = ANY\\(\\$1\\) ORDER BY s.id ASC FOR UPDATE OF s").
+		WillReturnRows(sqlmock.NewRows(settlementRowColumns).
+			AddRow(1, 3, "A", 2, 100, 5, 5, 0, 95, "settled", time.Now(), exportedAt, time.Now(), time.Now()))
+	list, err := repo.ListByIDsWithLock(context.Background(), []int{1})
+	assert.NoError(t, err)
+	assert.True(t, list[0].IsExported())
+
+	mock.ExpectExec("UPDATE settlements SET exported_at = \\$1, updated_at = CURRENT_TIMESTAMP WHERE id = ANY\\(\\$2\\)").
+		WithArgs(exportedAt, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	assert.NoError(t, repo.MarkExported(context.Background(), []int{1, 2}, exportedAt))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSettlementStore_Update_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewSettlementStore(postgres.NewClient(db))
+
+	mock.ExpectExec("UPDATE settlements").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Update(context.Background(), &model.Settlement{ID: 9, Status: model.SettlementStatusSettled})
+	var nfErr *apperrors.NotFoundError
+	assert.ErrorAs(t, err, &nfErr)
+}
+
+func TestSettlementStore_ListSettledByVenueBetween(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewSettlementStore(postgres.NewClient(db))
+	start := time.Date(2024, 2, 29, 15, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	mock.ExpectQuery("SELECT .* FROM settlements s .* JOIN auctions a ON s.auction_id = a.id WHERE a.venue_id = \\$1 AND s.status = 'settled' AND s.settled_at >= \\$2 AND s.settled_at < \\$3").
+		WithArgs(1, start, end).
+		WillReturnRows(sqlmock.NewRows(settlementRowColumns).
+			AddRow(1, 3, "A", 2, 100, 5, 5, 0, 95, "settled", start, nil, time.Now(), time.Now()))
+
+	list, err := repo.ListSettledByVenueBetween(context.Background(), 1, start, end)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSettlementStore_ListSettledByFishermanID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewSettlementStore(postgres.NewClient(db))
+	settledAt := time.Date(2024, 3, 1, 3, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("SELECT .* FROM settlements s .* WHERE s.fisherman_id = \\$1 AND s.status = 'settled' ORDER BY s.settled_at DESC").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(settlementRowColumns).
+			AddRow(1, 3, "A", 2, 100, 5, 5, 0, 95, "settled", settledAt, nil, time.Now(), time.Now()))
+
+	list, err := repo.ListSettledByFishermanID(context.Background(), 3)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, 3, list[0].FishermanID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore"
+	dserrors "github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres/errors"
+)
+
+var _ repository.VenueRegistrationRepository = (*VenueRegistrationStore)(nil)
+
+const venueRegistrationColumns = `id, buyer_id, venue_id, status, valid_from, valid_until, created_at, updated_at`
+
+// VenueRegistrationStore implements repository.VenueRegistrationRepository using PostgreSQL.
+type VenueRegistrationStore struct {
+	db datastore.Database
+}
+
+// NewVenueRegistrationStore creates a new instance of VenueRegistrationRepository
+func NewVenueRegistrationStore(db datastore.Database) *VenueRegistrationStore {
+	return &VenueRegistrationStore{db: db}
+}
+
+// Create stores a new registration. A buyer has at most one registration per venue.
+func (r *VenueRegistrationStore) Create(ctx context.Context, reg *model.VenueRegistration) (*model.VenueRegistration, error) {
+	v := *reg
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO buyer_venue_registrations (buyer_id, venue_id, status, valid_from, valid_until)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at`,
+		v.BuyerID, v.VenueID, string(v.Status), v.ValidFrom, v.ValidUntil,
+	).Scan(&v.ID, &v.CreatedAt, &v.UpdatedAt)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "VenueRegistration", 0, "Create")
+	}
+	return &v, nil
+}
+
+// FindByID returns a registration.
+func (r *VenueRegistrationStore) FindByID(ctx context.Context, id int) (*model.VenueRegistration, error) {
+	reg, err := scanVenueRegistration(r.db.QueryRow(ctx,
+		`SELECT `+venueRegistrationColumns+` FROM buyer_venue_registrations WHERE id = $1`, id))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "VenueRegistration", id, "FindByID")
+	}
+	return reg, nil
+}
+
+// FindByBuyerAndVenue returns a buyer's registration at a venue.
+func (r *VenueRegistrationStore) FindByBuyerAndVenue(ctx context.Context, buyerID, venueID int) (*model.VenueRegistration, error) {
+	reg, err := scanVenueRegistration(r.db.QueryRow(ctx,
+		`SELECT `+venueRegistrationColumns+` FROM buyer_venue_registrations WHERE buyer_id = $1 AND venue_id = $2`,
+		buyerID, venueID))
+	if err != nil {
+		return nil, dserrors.HandleError(err, "VenueRegistration", 0, "FindByBuyerAndVenue")
+	}
+	return reg, nil
+}
+
+// List returns registrations matching the filters, ordered by buyer and venue.
+func (r *VenueRegistrationStore) List(ctx context.Context, filters *repository.VenueRegistrationFilters) ([]model.VenueRegistration, error) {
+	query := `SELECT ` + venueRegistrationColumns + ` FROM buyer_venue_registrations`
+
+	var conditions []string
+	var args []any
+	argIndex := 1
+
+	if filters != nil {
+		if filters.BuyerID != nil {
+			conditions = append(conditions, fmt.Sprintf("buyer_id = $%d", argIndex))
+			args = append(args, *filters.BuyerID)
+			argIndex++
+		}
+		if filters.VenueID != nil {
+			conditions = append(conditions, fmt.Sprintf("venue_id = $%d", argIndex))
+			args = append(args, *filters.VenueID)
+			argIndex++
+		}
+		if filters.Status != nil {
+			conditions = append(conditions, fmt.Sprintf("status = $%d", argIndex))
+			args = append(args, string(*filters.Status))
+		}
+	}
+
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY buyer_id ASC, venue_id ASC"
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, dserrors.HandleError(err, "VenueRegistration", 0, "List")
+	}
+	defer func() { _ = rows.Close() }()
+
+	regs := []model.VenueRegistration{}
+	for rows.Next() {
+		reg, err := scanVenueRegistration(rows)
+		if err != nil {
+			return nil, err
+		}
+		regs = append(regs, *reg)
+	}
+	return regs, dserrors.HandleError(rows.Err(), "VenueRegistration", 0, "List")
+}
+
+// Update persists the status and validity period of a registration.
+func (r *VenueRegistrationStore) Update(ctx context.Context, reg *model.VenueRegistration) error {
+	rowsAffected, err := r.db.Execute(ctx, `
+		UPDATE buyer_venue_registrations
+		SET status = $1, valid_from = $2, valid_until = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $4`,
+		string(reg.Status), reg.ValidFrom, reg.ValidUntil, reg.ID,
+	)
+	if err != nil {
+		return dserrors.HandleError(err, "VenueRegistration", reg.ID, "Update")
+	}
+	if rowsAffected == 0 {
+		return &apperrors.NotFoundError{Resource: "VenueRegistration", ID: reg.ID}
+	}
+	return nil
+}
+
+func scanVenueRegistration(row datastore.Row) (*model.VenueRegistration, error) {
+	var reg model.VenueRegistration
+	if err := row.Scan(
+		&reg.ID, &reg.BuyerID, &reg.VenueID, &reg.Status, &reg.ValidFrom, &reg.ValidUntil, &reg.CreatedAt, &reg.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &reg, nil
+}
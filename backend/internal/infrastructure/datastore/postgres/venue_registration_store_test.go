@@ -0,0 +1,169 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/postgres"
+	"github.com/stretchr/testify/assert"
+)
+
+var venueRegistrationRowColumns = []string{
+	"id", "buyer_id", "venue_id", "status", "valid_from", "valid_until", "created_at", "updated_at",
+}
+
+func TestVenueRegistrationStore_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		insertErr error
+		wantErr   bool
+	}{
+		{name: "Success"},
+		{name: "Duplicate", insertErr: &pq.Error{Code: "23505"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() { _ = db.Close() }()
+
+			repo := postgres.NewVenueRegistrationStore(postgres.NewClient(db))
+			until := time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC)
+
+			q := mock.ExpectQuery("INSERT INTO buyer_venue_registrations").
+				WithArgs(1, 2, "approved", nil, &until)
+			if tt.insertErr != nil {
+				q.WillReturnError(tt.insertErr)
+			} else {
+				q.WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(5, time.Now(), time.Now()))
+			}
+
+			created, err := repo.Create(context.Background(), &model.VenueRegistration{
+				BuyerID: 1, VenueID: 2, Status: model.VenueRegistrationStatusApproved, ValidUntil: &until,
+			})
+			if tt.wantErr {
+				var conflictErr *apperrors.ConflictError
+				assert.ErrorAs(t, err, &conflictErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 5, created.ID)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestVenueRegistrationStore_FindByBuyerAndVenue(t *testing.T) {
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		wantErr bool
+	}{
+		{
+			name: "Found",
+			rows: sqlmock.NewRows(venueRegistrationRowColumns).
+				AddRow(5, 1, 2, "suspended", nil, nil, time.Now(), time.Now()),
+		},
+		{name: "NotRegistered", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() { _ = db.Close() }()
+
+			repo := postgres.NewVenueRegistrationStore(postgres.NewClient(db))
+
+			q := mock.ExpectQuery("SELECT .* FROM buyer_venue_registrations WHERE buyer_id = \\$1 AND venue_id = \\$2").
+				WithArgs(1, 2)
+			if tt.rows != nil {
+				q.WillReturnRows(tt.rows)
+			} else {
+				q.WillReturnError(sql.ErrNoRows)
+			}
+
+			reg, err := repo.FindByBuyerAndVenue(context.Background(), 1, 2)
+			if tt.wantErr {
+				var notFound *apperrors.NotFoundError
+				assert.ErrorAs(t, err, &notFound)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, model.VenueRegistrationStatusSuspended, reg.Status)
+				assert.Nil(t, reg.ValidFrom)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestVenueRegistrationStore_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	repo := postgres.NewVenueRegistrationStore(postgres.NewClient(db))
+	venueID := 2
+	status := model.VenueRegistrationStatusPending
+
+	mock.ExpectQuery("SELECT .* FROM buyer_venue_registrations WHERE venue_id = \\$1 AND status = \\$2 ORDER BY buyer_id ASC, venue_id ASC").
+		WithArgs(2, "pending").
+		WillReturnRows(sqlmock.NewRows(venueRegistrationRowColumns).
+			AddRow(5, 1, 2, "pending", nil, nil, time.Now(), time.Now()).
+			AddRow(6, 3, 2, "pending", nil, nil, time.Now(), time.Now()))
+
+	regs, err := repo.List(context.Background(), &repository.VenueRegistrationFilters{VenueID: &venueID, Status: &status})
+	assert.NoError(t, err)
+	assert.Len(t, regs, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVenueRegistrationStore_Update(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		wantErr  bool
+	}{
+		{name: "Success", affected: 1},
+		{name: "NotFound", affected: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+			}
+			defer func() { _ = db.Close() }()
+
+			repo := postgres.NewVenueRegistrationStore(postgres.NewClient(db))
+
+			mock.ExpectExec("UPDATE buyer_venue_registrations").
+				WithArgs("suspended", nil, nil, 5).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			err = repo.Update(context.Background(), &model.VenueRegistration{ID: 5, Status: model.VenueRegistrationStatusSuspended})
+			if tt.wantErr {
+				var notFound *apperrors.NotFoundError
+				assert.ErrorAs(t, err, &notFound)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
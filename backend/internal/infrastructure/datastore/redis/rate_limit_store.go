@@ -16,6 +16,11 @@ const (
 	keyBuyerLogin = "rate:login_buyer"
 	keyAdminReset = "rate:reset_admin"
 	keyBuyerReset = "rate:reset_buyer"
+
+	keyFishermanLogin = "rate:login_fisherman"
+	keyFishermanReset = "rate:reset_fisherman"
+
+	keyBuyerSignup = "rate:signup_buyer"
 )
 
 // RateLimitStore implements repository.RateLimitRepository using Redis INCR.
@@ -44,6 +49,18 @@ func (s *RateLimitStore) IncrementBuyerReset(ctx context.Context, ip string, win
 	return s.increment(ctx, keyBuyerReset, ip, window)
 }
 
+func (s *RateLimitStore) IncrementFishermanLogin(ctx context.Context, ip string, window time.Duration) (int64, error) {
+	return s.increment(ctx, keyFishermanLogin, ip, window)
+}
+
+func (s *RateLimitStore) IncrementFishermanReset(ctx context.Context, ip string, window time.Duration) (int64, error) {
+	return s.increment(ctx, keyFishermanReset, ip, window)
+}
+
+func (s *RateLimitStore) IncrementBuyerSignup(ctx context.Context, ip string, window time.Duration) (int64, error) {
+	return s.increment(ctx, keyBuyerSignup, ip, window)
+}
+
 // increment is the shared implementation for all Increment* methods.
 func (s *RateLimitStore) increment(ctx context.Context, keyPrefix, ip string, window time.Duration) (int64, error) {
 	if s.client == nil {
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -17,6 +18,13 @@ import (
 
 const sessionKeyPrefix = "session:"
 
+const (
+	// sessionTouchInterval is how often the last-seen time of a session is written back.
+	sessionTouchInterval = time.Minute
+	// maxUserAgentLen caps the stored user agent; the header is client controlled.
+	maxUserAgentLen = 256
+)
+
 // SessionStore provides SessionStore related functionality.
 type SessionStore struct {
 	cache datastore.Cache
@@ -26,8 +34,14 @@ type SessionStore struct {
 type sessionJSON struct {
 	ID        string            `json:"id"`
 	UserID    int               `json:"user_id"`
+	LoginID   int               `json:"login_id,omitempty"`
 	Role      model.SessionRole `json:"role"`
+	AdminRole model.AdminRole   `json:"admin_role,omitempty"`
 	CreatedAt time.Time         `json:"created_at"`
+	// LastSeenAt, IPAddress and UserAgent are absent from sessions created before they were recorded.
+	LastSeenAt time.Time `json:"last_seen_at,omitzero"`
+	IPAddress  string    `json:"ip_address,omitempty"`
+	UserAgent  string    `json:"user_agent,omitempty"`
 }
 
 var _ repository.SessionRepository = (*SessionStore)(nil)
@@ -42,17 +56,26 @@ func NewSessionStore(cache datastore.Cache, ttl time.Duration) *SessionStore {
 
 // Create creates a new record.
 func (s *SessionStore) Create(ctx context.Context, userID int, role model.SessionRole) (string, error) {
+	return s.CreateForLogin(ctx, userID, 0, role)
+}
+
+// CreateForLogin creates a new session for one login of the user.
+func (s *SessionStore) CreateForLogin(ctx context.Context, userID, loginID int, role model.SessionRole) (string, error) {
+	return s.create(ctx, sessionJSON{UserID: userID, LoginID: loginID, Role: role})
+}
+
+// CreateForAdmin creates a new admin session carrying the admin's role.
+func (s *SessionStore) CreateForAdmin(ctx context.Context, adminID int, role model.AdminRole) (string, error) {
+	return s.create(ctx, sessionJSON{UserID: adminID, Role: model.SessionRoleAdmin, AdminRole: role})
+}
+
+func (s *SessionStore) create(ctx context.Context, sJSON sessionJSON) (string, error) {
 	sessionID, err := newSessionID()
 	if err != nil {
 		return "", err
 	}
-
-	sJSON := sessionJSON{
-		ID:        sessionID,
-		UserID:    userID,
-		Role:      role,
-		CreatedAt: time.Now().UTC(),
-	}
+	sJSON.ID = sessionID
+	sJSON.CreatedAt = time.Now().UTC()
 
 	payload, err := json.Marshal(sJSON)
 	if err != nil {
@@ -65,7 +88,7 @@ func (s *SessionStore) Create(ctx context.Context, userID int, role model.Sessio
 
 	// Add to user sessions set
 	if rc := s.getRedisClient(); rc != nil {
-		setKey := userSessionsKey(role, userID)
+		setKey := userSessionsKey(sJSON.Role, sJSON.UserID)
 		if err := rc.SAdd(ctx, setKey, sessionID).Err(); err != nil {
 			return "", fmt.Errorf("add to session set: %w", err)
 		}
@@ -79,6 +102,81 @@ func (s *SessionStore) Create(ctx context.Context, userID int, role model.Sessio
 
 // FindByID retrieves a record based on criteria.
 func (s *SessionStore) FindByID(ctx context.Context, sessionID string) (*model.Session, error) {
+	sJSON, err := s.get(ctx, sessionID)
+	if err != nil || sJSON == nil {
+		return nil, err
+	}
+	return sJSON.toModel(), nil
+}
+
+// ListByUserID returns the active sessions of a user, dropping expired ones from the user's set.
+func (s *SessionStore) ListByUserID(ctx context.Context, userID int, role model.SessionRole) ([]model.Session, error) {
+	rc := s.getRedisClient()
+	if rc == nil {
+		return nil, fmt.Errorf("redis client not available")
+	}
+
+	setKey := userSessionsKey(role, userID)
+	sessionIDs, err := rc.SMembers(ctx, setKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("get user sessions: %w", err)
+	}
+
+	sessions := make([]model.Session, 0, len(sessionIDs))
+	for _, id := range sessionIDs {
+		session, err := s.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			_ = rc.SRem(ctx, setKey, id).Err()
+			continue
+		}
+		sessions = append(sessions, *session)
+	}
+	return sessions, nil
+}
+
+// Touch records the time and client of a request made with the session.
+// リクエストのたびに書き込まないよう、クライアントが変わらない間は一定間隔でのみ更新する。
+func (s *SessionStore) Touch(ctx context.Context, sessionID, ipAddress, userAgent string) error {
+	sJSON, err := s.get(ctx, sessionID)
+	if err != nil || sJSON == nil {
+		return err
+	}
+
+	if len(userAgent) > maxUserAgentLen {
+		userAgent = userAgent[:maxUserAgentLen]
+	}
+	now := time.Now().UTC()
+	if sJSON.IPAddress == ipAddress && sJSON.UserAgent == userAgent && now.Sub(sJSON.LastSeenAt) < sessionTouchInterval {
+		return nil
+	}
+	sJSON.LastSeenAt = now
+	sJSON.IPAddress = ipAddress
+	sJSON.UserAgent = userAgent
+
+	payload, err := json.Marshal(sJSON)
+	if err != nil {
+		return fmt.Errorf("marshal session: %w", err)
+	}
+
+	// 有効期限はログイン時に決まるため、更新で延長しない。期限切れ直後に書き戻して復活させないよう XX を付ける。
+	if rc := s.getRedisClient(); rc != nil {
+		err := rc.SetArgs(ctx, sessionKey(sessionID), payload, goredis.SetArgs{Mode: "XX", KeepTTL: true}).Err()
+		if errors.Is(err, goredis.Nil) {
+			return nil
+		}
+		return err
+	}
+	remaining := sJSON.CreatedAt.Add(s.ttl).Sub(now)
+	if remaining <= 0 {
+		return nil
+	}
+	return s.cache.Set(ctx, sessionKey(sessionID), payload, remaining)
+}
+
+func (s *SessionStore) get(ctx context.Context, sessionID string) (*sessionJSON, error) {
 	payload, err := s.cache.Get(ctx, sessionKey(sessionID))
 	if err != nil {
 		return nil, err
@@ -91,13 +189,25 @@ func (s *SessionStore) FindByID(ctx context.Context, sessionID string) (*model.S
 	if err := json.Unmarshal(payload, &sJSON); err != nil {
 		return nil, fmt.Errorf("unmarshal session: %w", err)
 	}
+	return &sJSON, nil
+}
 
+func (j *sessionJSON) toModel() *model.Session {
+	lastSeenAt := j.LastSeenAt
+	if lastSeenAt.IsZero() {
+		lastSeenAt = j.CreatedAt
+	}
 	return &model.Session{
-		ID:        sJSON.ID,
-		UserID:    sJSON.UserID,
-		Role:      sJSON.Role,
-		CreatedAt: sJSON.CreatedAt,
-	}, nil
+		ID:         j.ID,
+		UserID:     j.UserID,
+		LoginID:    j.LoginID,
+		Role:       j.Role,
+		AdminRole:  j.AdminRole,
+		CreatedAt:  j.CreatedAt,
+		LastSeenAt: lastSeenAt,
+		IPAddress:  j.IPAddress,
+		UserAgent:  j.UserAgent,
+	}
 }
 
 // Delete removes a record by ID.
@@ -145,6 +255,41 @@ func (s *SessionStore) DeleteAllByUserID(ctx context.Context, userID int, role m
 	return rc.Del(ctx, setKey).Err()
 }
 
+// DeleteAllByLoginID removes the sessions of one login of the user.
+// セッションはユーザー単位の集合で管理しているため、集合内の各セッションを読んでログインを照合する。
+func (s *SessionStore) DeleteAllByLoginID(ctx context.Context, userID, loginID int, role model.SessionRole) error {
+	rc := s.getRedisClient()
+	if rc == nil {
+		return fmt.Errorf("redis client not available")
+	}
+
+	setKey := userSessionsKey(role, userID)
+	sessionIDs, err := rc.SMembers(ctx, setKey).Result()
+	if err != nil {
+		return fmt.Errorf("get user sessions: %w", err)
+	}
+
+	for _, id := range sessionIDs {
+		session, err := s.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if session == nil {
+			// 期限切れのセッションは集合からも取り除く
+			_ = rc.SRem(ctx, setKey, id).Err()
+			continue
+		}
+		if session.LoginID != loginID {
+			continue
+		}
+		if err := s.cache.Delete(ctx, sessionKey(id)); err != nil {
+			return err
+		}
+		_ = rc.SRem(ctx, setKey, id).Err()
+	}
+	return nil
+}
+
 func (s *SessionStore) getRedisClient() *goredis.Client {
 	if c, ok := s.cache.(*Client); ok {
 		return c.client
@@ -0,0 +1,116 @@
+package redis_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redismock/v9"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/datastore/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sessionPayload(t *testing.T, fields map[string]any) string {
+	t.Helper()
+	data, err := json.Marshal(fields)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestSessionStore_FindByID(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	s := redis.NewSessionStore(redis.NewClient(db), time.Hour)
+	createdAt := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	// 記録を始める前のセッションは作成時刻を最終利用時刻として扱う。
+	mock.ExpectGet("session:abc").SetVal(sessionPayload(t, map[string]any{
+		"id": "abc", "user_id": 1, "role": "admin", "admin_role": "clerk", "created_at": createdAt,
+	}))
+
+	got, err := s.FindByID(context.Background(), "abc")
+	require.NoError(t, err)
+	assert.Equal(t, model.AdminRoleClerk, got.AdminRole)
+	assert.True(t, got.LastSeenAt.Equal(createdAt))
+	assert.Empty(t, got.IPAddress)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionStore_ListByUserID(t *testing.T) {
+	db, mock := redismock.NewClientMock()
+	s := redis.NewSessionStore(redis.NewClient(db), time.Hour)
+	lastSeenAt := time.Date(2026, 5, 1, 9, 30, 0, 0, time.UTC)
+
+	mock.ExpectSMembers("user_sessions:buyer:1").SetVal([]string{"live", "expired"})
+	mock.ExpectGet("session:live").SetVal(sessionPayload(t, map[string]any{
+		"id": "live", "user_id": 1, "login_id": 10, "role": "buyer", "created_at": lastSeenAt.Add(-time.Hour),
+		"last_seen_at": lastSeenAt, "ip_address": "203.0.113.5", "user_agent": "Mozilla/5.0",
+	}))
+	mock.ExpectGet("session:expired").RedisNil()
+	mock.ExpectSRem("user_sessions:buyer:1", "expired").SetVal(1)
+
+	got, err := s.ListByUserID(context.Background(), 1, model.SessionRoleBuyer)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "live", got[0].ID)
+	assert.Equal(t, 10, got[0].LoginID)
+	assert.True(t, got[0].LastSeenAt.Equal(lastSeenAt))
+	assert.Equal(t, "203.0.113.5", got[0].IPAddress)
+	assert.Equal(t, "Mozilla/5.0", got[0].UserAgent)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSessionStore_Touch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("SameClientWithinInterval", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		s := redis.NewSessionStore(redis.NewClient(db), time.Hour)
+
+		mock.ExpectGet("session:abc").SetVal(sessionPayload(t, map[string]any{
+			"id": "abc", "user_id": 1, "role": "buyer", "created_at": time.Now().Add(-time.Hour),
+			"last_seen_at": time.Now().Add(-10 * time.Second), "ip_address": "203.0.113.5", "user_agent": "Mozilla/5.0",
+		}))
+
+		require.NoError(t, s.Touch(ctx, "abc", "203.0.113.5", "Mozilla/5.0"))
+		assert.NoError(t, mock.ExpectationsWereMet(), "expected no write")
+	})
+
+	t.Run("NewClient", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		s := redis.NewSessionStore(redis.NewClient(db), time.Hour)
+
+		mock.ExpectGet("session:abc").SetVal(sessionPayload(t, map[string]any{
+			"id": "abc", "user_id": 1, "role": "buyer", "created_at": time.Now().Add(-time.Hour),
+			"last_seen_at": time.Now().Add(-10 * time.Second), "ip_address": "203.0.113.5", "user_agent": "Mozilla/5.0",
+		}))
+		// The payload carries the current time, so the command is checked field by field.
+		var written map[string]any
+		mock.CustomMatch(func(expected, actual []any) error {
+			if !slices.Equal(actual[3:], expected[3:]) {
+				return fmt.Errorf("unexpected options %v", actual[3:])
+			}
+			return json.Unmarshal(actual[2].([]byte), &written)
+		}).ExpectSetArgs("session:abc", nil, goredis.SetArgs{Mode: "XX", KeepTTL: true}).SetVal("OK")
+
+		require.NoError(t, s.Touch(ctx, "abc", "198.51.100.7", "Mozilla/5.0"))
+		require.NoError(t, mock.ExpectationsWereMet())
+		assert.Equal(t, "198.51.100.7", written["ip_address"])
+		assert.Equal(t, "buyer", written["role"], "expected the rest of the session to be kept")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		db, mock := redismock.NewClientMock()
+		s := redis.NewSessionStore(redis.NewClient(db), time.Hour)
+
+		mock.ExpectGet("session:abc").RedisNil()
+
+		require.NoError(t, s.Touch(ctx, "abc", "203.0.113.5", "Mozilla/5.0"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -43,18 +43,81 @@ func (s *BuyerEmailService) send(to, subject, body string) error {
 	return buyerSendMailFunc(s.cfg.SMTPAddress(), nil, s.cfg.GetSMTPFrom(), []string{to}, msg)
 }
 
-// SendBuyerPasswordReset provides SendBuyerPasswordReset related functionality.
-func (s *BuyerEmailService) SendBuyerPasswordReset(_ context.Context, to, url string) error {
-	tmpl := s.templateLoader.Get("buyer_password_reset.txt")
+func (s *BuyerEmailService) render(name string, data map[string]string) (string, error) {
+	tmpl := s.templateLoader.Get(name)
 	if tmpl == nil {
-		return fmt.Errorf("template buyer_password_reset.txt not found")
+		return "", fmt.Errorf("template %s not found", name)
 	}
 
 	var body bytes.Buffer
-	if err := tmpl.Execute(&body, map[string]string{"ResetURL": url}); err != nil {
-		return fmt.Errorf("failed to execute template: %w", err)
+	if err := tmpl.Execute(&body, data); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+	return body.String(), nil
+}
+
+// SendBuyerPasswordReset provides SendBuyerPasswordReset related functionality.
+func (s *BuyerEmailService) SendBuyerPasswordReset(_ context.Context, to, url string) error {
+	body, err := s.render("buyer_password_reset.txt", map[string]string{"ResetURL": url})
+	if err != nil {
+		return err
 	}
 
 	subject := "【Fish Auction】パスワード再設定のご案内"
-	return s.send(to, subject, body.String())
+	return s.send(to, subject, body)
+}
+
+// SendBuyerEmailChangeVerification sends the link that confirms a new login email to that address.
+func (s *BuyerEmailService) SendBuyerEmailChangeVerification(_ context.Context, to, url string) error {
+	body, err := s.render("buyer_email_change_verification.txt", map[string]string{"VerifyURL": url})
+	if err != nil {
+		return err
+	}
+
+	subject := "【Fish Auction】メールアドレス変更の確認"
+	return s.send(to, subject, body)
+}
+
+// SendBuyerEmailChanged tells the previous address that the login email has been changed.
+func (s *BuyerEmailService) SendBuyerEmailChanged(_ context.Context, to string) error {
+	body, err := s.render("buyer_email_changed.txt", nil)
+	if err != nil {
+		return err
+	}
+
+	subject := "【Fish Auction】メールアドレス変更のお知らせ"
+	return s.send(to, subject, body)
+}
+
+// SendBuyerRegistrationVerification sends a new applicant the link that confirms their email address.
+func (s *BuyerEmailService) SendBuyerRegistrationVerification(_ context.Context, to, url string) error {
+	body, err := s.render("buyer_registration_verification.txt", map[string]string{"VerifyURL": url})
+	if err != nil {
+		return err
+	}
+
+	subject := "【Fish Auction】メールアドレスの確認"
+	return s.send(to, subject, body)
+}
+
+// SendBuyerRegistrationApproved tells the applicant that their registration was approved.
+func (s *BuyerEmailService) SendBuyerRegistrationApproved(_ context.Context, to, url string) error {
+	body, err := s.render("buyer_registration_approved.txt", map[string]string{"LoginURL": url})
+	if err != nil {
+		return err
+	}
+
+	subject := "【Fish Auction】利用登録承認のお知らせ"
+	return s.send(to, subject, body)
+}
+
+// SendBuyerRegistrationRejected tells the applicant that their registration was not approved and why.
+func (s *BuyerEmailService) SendBuyerRegistrationRejected(_ context.Context, to, reason string) error {
+	body, err := s.render("buyer_registration_rejected.txt", map[string]string{"Reason": reason})
+	if err != nil {
+		return err
+	}
+
+	subject := "【Fish Auction】利用登録審査結果のお知らせ"
+	return s.send(to, subject, body)
 }
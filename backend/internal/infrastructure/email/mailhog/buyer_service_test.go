@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/smtp"
+	"strings"
 	"testing"
 
 	"github.com/seka/fish-auction/backend/config"
@@ -74,4 +75,58 @@ func TestBuyerEmailService(t *testing.T) {
 			})
 		}
 	})
+	t.Run("SendBuyerEmailChange", func(t *testing.T) {
+		var sent []byte
+		restore := setSendMailFunc(func(_ string, _ smtp.Auth, _ string, _ []string, msg []byte) error {
+			sent = msg
+			return nil
+		})
+		defer restore()
+
+		svc := NewBuyerEmailService(cfg, &mockTemplateLoader{realLoader: realLoader})
+		if err := svc.SendBuyerEmailChangeVerification(context.Background(), "new@example.com", "http://example.com/verify?token=abc"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(string(sent), "http://example.com/verify?token=abc") {
+			t.Errorf("verification link missing from message: %s", sent)
+		}
+		if err := svc.SendBuyerEmailChanged(context.Background(), "old@example.com"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		failing := NewBuyerEmailService(cfg, &mockTemplateLoader{realLoader: realLoader, mockErr: true})
+		if err := failing.SendBuyerEmailChanged(context.Background(), "old@example.com"); err == nil {
+			t.Error("expected error for missing template")
+		}
+	})
+	t.Run("SendBuyerRegistration", func(t *testing.T) {
+		var sent []byte
+		restore := setSendMailFunc(func(_ string, _ smtp.Auth, _ string, _ []string, msg []byte) error {
+			sent = msg
+			return nil
+		})
+		defer restore()
+
+		svc := NewBuyerEmailService(cfg, &mockTemplateLoader{realLoader: realLoader})
+		if err := svc.SendBuyerRegistrationVerification(context.Background(), "new@example.com", "http://example.com/signup/verify?token=abc"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(string(sent), "http://example.com/signup/verify?token=abc") {
+			t.Errorf("verification link missing from message: %s", sent)
+		}
+		if err := svc.SendBuyerRegistrationApproved(context.Background(), "new@example.com", "http://example.com/login"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := svc.SendBuyerRegistrationRejected(context.Background(), "new@example.com", "買参権が確認できません"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strings.Contains(string(sent), "買参権が確認できません") {
+			t.Errorf("rejection reason missing from message: %s", sent)
+		}
+
+		failing := NewBuyerEmailService(cfg, &mockTemplateLoader{realLoader: realLoader, mockErr: true})
+		if err := failing.SendBuyerRegistrationRejected(context.Background(), "new@example.com", "重複"); err == nil {
+			t.Error("expected error for missing template")
+		}
+	})
 }
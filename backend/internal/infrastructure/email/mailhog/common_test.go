@@ -24,10 +24,13 @@ func (m *mockTemplateLoader) Get(name string) *template.Template {
 func setSendMailFunc(f func(addr string, a smtp.Auth, from string, to []string, msg []byte) error) func() {
 	origAdmin := adminSendMailFunc
 	origBuyer := buyerSendMailFunc
+	origFisherman := fishermanSendMailFunc
 	adminSendMailFunc = f
 	buyerSendMailFunc = f
+	fishermanSendMailFunc = f
 	return func() {
 		adminSendMailFunc = origAdmin
 		buyerSendMailFunc = origBuyer
+		fishermanSendMailFunc = origFisherman
 	}
 }
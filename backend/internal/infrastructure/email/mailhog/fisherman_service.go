@@ -0,0 +1,60 @@
+package mailhog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/smtp"
+
+	"github.com/seka/fish-auction/backend/config"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/email/templates"
+)
+
+var fishermanSendMailFunc = smtp.SendMail
+
+// FishermanEmailService provides FishermanEmailService related functionality.
+type FishermanEmailService struct {
+	cfg            config.EmailConfig
+	templateLoader templates.TemplateProvider
+}
+
+var _ service.FishermanEmailService = (*FishermanEmailService)(nil)
+
+// NewFishermanEmailService creates a new FishermanEmailService instance.
+func NewFishermanEmailService(cfg config.EmailConfig, loader templates.TemplateProvider) service.FishermanEmailService {
+	if cfg == config.NoEmailConfig {
+		return &noopFishermanEmailService{}
+	}
+	return &FishermanEmailService{
+		cfg:            cfg,
+		templateLoader: loader,
+	}
+}
+
+func (s *FishermanEmailService) send(to, subject, body string) error {
+	msg := fmt.Appendf(nil, "To: %s\r\n"+
+		"Subject: %s\r\n"+
+		"Content-Type: text/plain; charset=\"UTF-8\"\r\n"+
+		"\r\n"+
+		"%s", to, subject, body)
+
+	// MailHog doesn't require auth
+	return fishermanSendMailFunc(s.cfg.SMTPAddress(), nil, s.cfg.GetSMTPFrom(), []string{to}, msg)
+}
+
+// SendFishermanPasswordReset provides SendFishermanPasswordReset related functionality.
+func (s *FishermanEmailService) SendFishermanPasswordReset(_ context.Context, to, url string) error {
+	tmpl := s.templateLoader.Get("fisherman_password_reset.txt")
+	if tmpl == nil {
+		return fmt.Errorf("template fisherman_password_reset.txt not found")
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, map[string]string{"ResetURL": url}); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	subject := "【Fish Auction】パスワード再設定のご案内"
+	return s.send(to, subject, body.String())
+}
@@ -13,3 +13,29 @@ type noopBuyerEmailService struct{}
 func (n *noopBuyerEmailService) SendBuyerPasswordReset(_ context.Context, _, _ string) error {
 	return nil
 }
+
+func (n *noopBuyerEmailService) SendBuyerEmailChangeVerification(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (n *noopBuyerEmailService) SendBuyerEmailChanged(_ context.Context, _ string) error {
+	return nil
+}
+
+func (n *noopBuyerEmailService) SendBuyerRegistrationVerification(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (n *noopBuyerEmailService) SendBuyerRegistrationApproved(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (n *noopBuyerEmailService) SendBuyerRegistrationRejected(_ context.Context, _, _ string) error {
+	return nil
+}
+
+type noopFishermanEmailService struct{}
+
+func (n *noopFishermanEmailService) SendFishermanPasswordReset(_ context.Context, _, _ string) error {
+	return nil
+}
@@ -24,6 +24,23 @@ func TestTemplateLoader_Get(t *testing.T) {
 		assert.Equal(t, "admin_password_reset.txt", tmpl.Name())
 	})
 
+	t.Run("GetFishermanPasswordReset", func(t *testing.T) {
+		tmpl := loader.Get("fisherman_password_reset.txt")
+		assert.NotNil(t, tmpl)
+		assert.Equal(t, "fisherman_password_reset.txt", tmpl.Name())
+	})
+
+	t.Run("GetBuyerEmailChange", func(t *testing.T) {
+		assert.NotNil(t, loader.Get("buyer_email_change_verification.txt"))
+		assert.NotNil(t, loader.Get("buyer_email_changed.txt"))
+	})
+
+	t.Run("GetBuyerRegistration", func(t *testing.T) {
+		assert.NotNil(t, loader.Get("buyer_registration_verification.txt"))
+		assert.NotNil(t, loader.Get("buyer_registration_approved.txt"))
+		assert.NotNil(t, loader.Get("buyer_registration_rejected.txt"))
+	})
+
 	t.Run("GetUnknown", func(t *testing.T) {
 		tmpl := loader.Get("unknown.txt")
 		assert.Nil(t, tmpl)
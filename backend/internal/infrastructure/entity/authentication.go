@@ -16,6 +16,10 @@ type Authentication struct {
 	Email          string     `db:"email"`
 	PasswordHash   string     `db:"password_hash"`
 	AuthType       string     `db:"auth_type"`
+	Name           string     `db:"name"`
+	Role           string     `db:"role"`
+	BidLimit       *int       `db:"bid_limit"`
+	DisabledAt     *time.Time `db:"disabled_at"`
 	FailedAttempts int        `db:"failed_attempts"`
 	LockedUntil    *time.Time `db:"locked_until"`
 	LastLoginAt    *time.Time `db:"last_login_at"`
@@ -34,7 +38,10 @@ func (a *Authentication) Validate() error {
 	if a.BuyerID <= 0 {
 		return &apperrors.ValidationError{Field: "buyer_id", Message: "Buyer ID must be positive"}
 	}
-	return nil
+	if a.Role != string(model.BuyerLoginOwner) && a.Role != string(model.BuyerLoginStaff) {
+		return &apperrors.ValidationError{Field: "role", Message: "must be owner or staff"}
+	}
+	return model.ValidateBidLimit(a.BidLimit)
 }
 
 // IsLocked provides IsLocked related functionality.
@@ -53,6 +60,10 @@ func (a *Authentication) ToModel() *model.Authentication {
 		Email:          a.Email,
 		PasswordHash:   a.PasswordHash,
 		AuthType:       a.AuthType,
+		Name:           a.Name,
+		Role:           model.BuyerLoginRole(a.Role),
+		BidLimit:       a.BidLimit,
+		DisabledAt:     a.DisabledAt,
 		FailedAttempts: a.FailedAttempts,
 		LockedUntil:    a.LockedUntil,
 		LastLoginAt:    a.LastLoginAt,
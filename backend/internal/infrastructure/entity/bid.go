@@ -9,11 +9,17 @@ import (
 
 // Bid provides Bid related functionality.
 type Bid struct {
-	ID        int       `db:"id"`
-	ItemID    int       `db:"item_id"`
-	BuyerID   int       `db:"buyer_id"`
-	Price     int       `db:"price"`
-	CreatedAt time.Time `db:"created_at"`
+	ID         int        `db:"id"`
+	ItemID     int        `db:"item_id"`
+	BuyerID    int        `db:"buyer_id"`
+	LoginID    *int       `db:"login_id"`
+	Price      int        `db:"price"`
+	Channel    string     `db:"channel"`
+	EnteredBy  *int       `db:"entered_by"`
+	CreatedAt  time.Time  `db:"created_at"`
+	VoidedAt   *time.Time `db:"voided_at"`
+	VoidedBy   *int       `db:"voided_by"`
+	VoidReason *string    `db:"void_reason"`
 }
 
 // Validate provides Validate related functionality.
@@ -41,11 +47,21 @@ func (e *Bid) Validate() error {
 
 // ToModel provides ToModel related functionality.
 func (e *Bid) ToModel() *model.Bid {
+	var voidReason string
+	if e.VoidReason != nil {
+		voidReason = *e.VoidReason
+	}
 	return &model.Bid{
-		ID:        e.ID,
-		ItemID:    e.ItemID,
-		BuyerID:   e.BuyerID,
-		Price:     model.NewBidPrice(e.Price),
-		CreatedAt: e.CreatedAt,
+		ID:         e.ID,
+		ItemID:     e.ItemID,
+		BuyerID:    e.BuyerID,
+		LoginID:    e.LoginID,
+		Price:      model.NewBidPrice(e.Price),
+		Channel:    model.BidChannel(e.Channel),
+		EnteredBy:  e.EnteredBy,
+		CreatedAt:  e.CreatedAt,
+		VoidedAt:   e.VoidedAt,
+		VoidedBy:   e.VoidedBy,
+		VoidReason: voidReason,
 	}
 }
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"regexp"
 	"strings"
 	"time"
 
@@ -8,13 +9,35 @@ import (
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 )
 
+const (
+	businessNameMaxLength  = 255
+	licenseNumberMaxLength = 30
+)
+
+// invoiceRegistrationNumberPattern matches a qualified invoice issuer number: "T" followed by 13 digits.
+var invoiceRegistrationNumberPattern = regexp.MustCompile(`^T[0-9]{13}$`)
+
 // Buyer provides Buyer related functionality.
 type Buyer struct {
-	ID           int        `db:"id"`
-	Name         string     `db:"name"`
-	Organization string     `db:"organization"`
-	ContactInfo  string     `db:"contact_info"`
-	DeletedAt    *time.Time `db:"deleted_at"`
+	ID                        int        `db:"id"`
+	Name                      string     `db:"name"`
+	Organization              string     `db:"organization"`
+	ContactInfo               string     `db:"contact_info"`
+	PaddleNumber              *string    `db:"paddle_number"`
+	BusinessName              string     `db:"business_name"`
+	InvoiceRegistrationNumber string     `db:"invoice_registration_number"`
+	Address                   string     `db:"address"`
+	Phone                     string     `db:"phone"`
+	LicenseNumber             string     `db:"license_number"`
+	LicenseExpiresOn          *time.Time `db:"license_expires_on"`
+	CreditLimit               *int       `db:"credit_limit"`
+	Deposit                   int        `db:"deposit"`
+	RegistrationStatus        string     `db:"registration_status"`
+	EmailVerifiedAt           *time.Time `db:"email_verified_at"`
+	RejectionReason           string     `db:"rejection_reason"`
+	ReviewedBy                *int       `db:"reviewed_by"`
+	ReviewedAt                *time.Time `db:"reviewed_at"`
+	DeletedAt                 *time.Time `db:"deleted_at"`
 }
 
 // Validate provides Validate related functionality.
@@ -28,9 +51,41 @@ func (b *Buyer) Validate() error {
 	if strings.TrimSpace(b.ContactInfo) == "" {
 		return &apperrors.ValidationError{Field: "contact_info", Message: "Contact info is required"}
 	}
+	return b.validateProfile()
+}
+
+func (b *Buyer) validateProfile() error {
+	if err := validateMaxLength("business_name", b.BusinessName, businessNameMaxLength); err != nil {
+		return err
+	}
+	if b.InvoiceRegistrationNumber != "" && !invoiceRegistrationNumberPattern.MatchString(b.InvoiceRegistrationNumber) {
+		return &apperrors.ValidationError{Field: "invoice_registration_number", Message: "must be T followed by 13 digits"}
+	}
+	if err := validateMaxLength("address", b.Address, addressMaxLength); err != nil {
+		return err
+	}
+	if err := validatePhone("phone", b.Phone); err != nil {
+		return err
+	}
+	if err := validateMaxLength("license_number", b.LicenseNumber, licenseNumberMaxLength); err != nil {
+		return err
+	}
+	if b.LicenseExpiresOn != nil && b.LicenseNumber == "" {
+		return &apperrors.ValidationError{Field: "license_number", Message: "is required when license_expires_on is set"}
+	}
 	return nil
 }
 
+// SetProfile copies the profile onto the entity columns, trimming the values and upper-casing the invoice registration number.
+func (b *Buyer) SetProfile(p model.BuyerProfile) {
+	b.BusinessName = strings.TrimSpace(p.BusinessName)
+	b.InvoiceRegistrationNumber = normalizeIdentifier(p.InvoiceRegistrationNumber)
+	b.Address = strings.TrimSpace(p.Address)
+	b.Phone = strings.TrimSpace(p.Phone)
+	b.LicenseNumber = strings.TrimSpace(p.LicenseNumber)
+	b.LicenseExpiresOn = p.LicenseExpiresOn
+}
+
 // ToModel provides ToModel related functionality.
 func (b *Buyer) ToModel() *model.Buyer {
 	return &model.Buyer{
@@ -38,5 +93,38 @@ func (b *Buyer) ToModel() *model.Buyer {
 		Name:         b.Name,
 		Organization: b.Organization,
 		ContactInfo:  b.ContactInfo,
+		PaddleNumber: b.paddleNumber(),
+		BuyerProfile: model.BuyerProfile{
+			BusinessName:              b.BusinessName,
+			InvoiceRegistrationNumber: b.InvoiceRegistrationNumber,
+			Address:                   b.Address,
+			Phone:                     b.Phone,
+			LicenseNumber:             b.LicenseNumber,
+			LicenseExpiresOn:          b.LicenseExpiresOn,
+		},
+		CreditTerms: model.CreditTerms{CreditLimit: b.CreditLimit, Deposit: b.Deposit},
+		Registration: model.BuyerRegistration{
+			Status:          model.BuyerRegistrationStatus(b.RegistrationStatus),
+			EmailVerifiedAt: b.EmailVerifiedAt,
+			RejectionReason: b.RejectionReason,
+			ReviewedBy:      b.ReviewedBy,
+			ReviewedAt:      b.ReviewedAt,
+		},
+	}
+}
+
+// SetPaddleNumber stores an empty paddle number as NULL so that unassigned buyers do not collide on the unique index.
+func (b *Buyer) SetPaddleNumber(n string) {
+	if n == "" {
+		b.PaddleNumber = nil
+		return
+	}
+	b.PaddleNumber = &n
+}
+
+func (b *Buyer) paddleNumber() string {
+	if b.PaddleNumber == nil {
+		return ""
 	}
+	return *b.PaddleNumber
 }
@@ -2,12 +2,16 @@ package entity_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/entity"
 )
 
 func TestBuyer_Validate(t *testing.T) {
+	licenseExpiry := time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name      string
 		buyer     *entity.Buyer
@@ -38,6 +42,47 @@ func TestBuyer_Validate(t *testing.T) {
 			wantErr:   true,
 			wantField: "name",
 		},
+		{
+			name: "Valid_WithProfile",
+			buyer: withBuyerProfile(model.BuyerProfile{
+				BusinessName:              "株式会社魚一",
+				InvoiceRegistrationNumber: "t1234567890123",
+				Address:                   "宮城県石巻市魚町1-1",
+				Phone:                     "+81 225-00-0000",
+				LicenseNumber:             "第42号",
+				LicenseExpiresOn:          &licenseExpiry,
+			}),
+		},
+		{
+			name:      "Invalid_InvoiceRegistrationNumber_MissingPrefix",
+			buyer:     withBuyerProfile(model.BuyerProfile{InvoiceRegistrationNumber: "1234567890123"}),
+			wantErr:   true,
+			wantField: "invoice_registration_number",
+		},
+		{
+			name:      "Invalid_InvoiceRegistrationNumber_Short",
+			buyer:     withBuyerProfile(model.BuyerProfile{InvoiceRegistrationNumber: "T123456789012"}),
+			wantErr:   true,
+			wantField: "invoice_registration_number",
+		},
+		{
+			name:      "Invalid_Phone_Letters",
+			buyer:     withBuyerProfile(model.BuyerProfile{Phone: "0225-XX-0000"}),
+			wantErr:   true,
+			wantField: "phone",
+		},
+		{
+			name:      "Invalid_Phone_TooFewDigits",
+			buyer:     withBuyerProfile(model.BuyerProfile{Phone: "0225-00"}),
+			wantErr:   true,
+			wantField: "phone",
+		},
+		{
+			name:      "Invalid_LicenseExpiry_WithoutNumber",
+			buyer:     withBuyerProfile(model.BuyerProfile{LicenseExpiresOn: &licenseExpiry}),
+			wantErr:   true,
+			wantField: "license_number",
+		},
 	}
 
 	for _, tt := range tests {
@@ -65,6 +110,12 @@ func TestBuyer_Validate(t *testing.T) {
 	}
 }
 
+func withBuyerProfile(p model.BuyerProfile) *entity.Buyer {
+	b := &entity.Buyer{Name: "John Doe", Organization: "Fish Corp", ContactInfo: "john@example.com"}
+	b.SetProfile(p)
+	return b
+}
+
 func TestBuyer_ToModel(t *testing.T) {
 	buyer := &entity.Buyer{
 		ID:   1,
@@ -8,11 +8,23 @@ import (
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 )
 
+const vesselNameMaxLength = 100
+
 // Fisherman provides Fisherman related functionality.
 type Fisherman struct {
-	ID        int        `db:"id"`
-	Name      string     `db:"name"`
-	DeletedAt *time.Time `db:"deleted_at"`
+	ID                       int        `db:"id"`
+	Name                     string     `db:"name"`
+	CooperativeMemberNumber  string     `db:"cooperative_member_number"`
+	VesselName               string     `db:"vessel_name"`
+	VesselRegistrationNumber string     `db:"vessel_registration_number"`
+	Phone                    string     `db:"phone"`
+	Address                  string     `db:"address"`
+	BankCode                 *string    `db:"bank_code"`
+	BranchCode               *string    `db:"branch_code"`
+	AccountType              *int       `db:"account_type"`
+	AccountNumber            *string    `db:"account_number"`
+	AccountHolderKana        *string    `db:"account_holder_kana"`
+	DeletedAt                *time.Time `db:"deleted_at"`
 }
 
 // Validate provides Validate related functionality.
@@ -23,13 +35,86 @@ func (e *Fisherman) Validate() error {
 			Message: "cannot be empty",
 		}
 	}
+	if err := e.validateProfile(); err != nil {
+		return err
+	}
+	if account := e.bankAccount(); account != nil {
+		return account.Validate()
+	}
+	if e.BankCode != nil || e.BranchCode != nil || e.AccountType != nil || e.AccountNumber != nil || e.AccountHolderKana != nil {
+		return &errors.ValidationError{
+			Field:   "bank_account",
+			Message: "all bank account fields must be set together",
+		}
+	}
 	return nil
 }
 
+func (e *Fisherman) validateProfile() error {
+	if err := validateIdentifier("cooperative_member_number", e.CooperativeMemberNumber, identifierMaxLength); err != nil {
+		return err
+	}
+	if err := validateMaxLength("vessel_name", e.VesselName, vesselNameMaxLength); err != nil {
+		return err
+	}
+	// 漁船登録番号は「HK1-1234」のような都道府県略号＋番号の形式。
+	if err := validateIdentifier("vessel_registration_number", e.VesselRegistrationNumber, identifierMaxLength); err != nil {
+		return err
+	}
+	if err := validatePhone("phone", e.Phone); err != nil {
+		return err
+	}
+	return validateMaxLength("address", e.Address, addressMaxLength)
+}
+
+// SetProfile copies the profile onto the entity columns, trimming the values and upper-casing the numbers.
+func (e *Fisherman) SetProfile(p model.FishermanProfile) {
+	e.CooperativeMemberNumber = normalizeIdentifier(p.CooperativeMemberNumber)
+	e.VesselName = strings.TrimSpace(p.VesselName)
+	e.VesselRegistrationNumber = normalizeIdentifier(p.VesselRegistrationNumber)
+	e.Phone = strings.TrimSpace(p.Phone)
+	e.Address = strings.TrimSpace(p.Address)
+}
+
+// SetBankAccount copies the bank account details onto the entity columns.
+func (e *Fisherman) SetBankAccount(a *model.BankAccount) {
+	if a == nil {
+		e.BankCode, e.BranchCode, e.AccountType, e.AccountNumber, e.AccountHolderKana = nil, nil, nil, nil, nil
+		return
+	}
+	accountType := int(a.AccountType)
+	e.BankCode = &a.BankCode
+	e.BranchCode = &a.BranchCode
+	e.AccountType = &accountType
+	e.AccountNumber = &a.AccountNumber
+	e.AccountHolderKana = &a.HolderKana
+}
+
+func (e *Fisherman) bankAccount() *model.BankAccount {
+	if e.BankCode == nil || e.BranchCode == nil || e.AccountType == nil || e.AccountNumber == nil || e.AccountHolderKana == nil {
+		return nil
+	}
+	return &model.BankAccount{
+		BankCode:      *e.BankCode,
+		BranchCode:    *e.BranchCode,
+		AccountType:   model.BankAccountType(*e.AccountType),
+		AccountNumber: *e.AccountNumber,
+		HolderKana:    *e.AccountHolderKana,
+	}
+}
+
 // ToModel provides ToModel related functionality.
 func (e *Fisherman) ToModel() *model.Fisherman {
 	return &model.Fisherman{
 		ID:   e.ID,
 		Name: e.Name,
+		FishermanProfile: model.FishermanProfile{
+			CooperativeMemberNumber:  e.CooperativeMemberNumber,
+			VesselName:               e.VesselName,
+			VesselRegistrationNumber: e.VesselRegistrationNumber,
+			Phone:                    e.Phone,
+			Address:                  e.Address,
+		},
+		BankAccount: e.bankAccount(),
 	}
 }
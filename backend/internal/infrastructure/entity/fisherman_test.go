@@ -4,10 +4,13 @@ import (
 	"testing"
 
 	"github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/entity"
 )
 
 func TestFisherman_Validate(t *testing.T) {
+	bankCode := "0001"
+
 	tests := []struct {
 		name      string
 		fisherman *entity.Fisherman
@@ -28,6 +31,29 @@ func TestFisherman_Validate(t *testing.T) {
 			wantErr:   true,
 			wantField: "name",
 		},
+		{
+			name: "Valid_WithBankAccount",
+			fisherman: withBankAccount(&entity.Fisherman{Name: "Captain Jack"}, &model.BankAccount{
+				BankCode: "0001", BranchCode: "100", AccountType: model.BankAccountTypeOrdinary, AccountNumber: "1234567", HolderKana: "ｼﾞﾔﾂｸ",
+			}),
+		},
+		{
+			name: "Invalid_BankAccount_Partial",
+			fisherman: &entity.Fisherman{
+				Name:     "Captain Jack",
+				BankCode: &bankCode,
+			},
+			wantErr:   true,
+			wantField: "bank_account",
+		},
+		{
+			name: "Invalid_BankAccount_BranchCode",
+			fisherman: withBankAccount(&entity.Fisherman{Name: "Captain Jack"}, &model.BankAccount{
+				BankCode: "0001", BranchCode: "1", AccountType: model.BankAccountTypeOrdinary, AccountNumber: "1234567", HolderKana: "ｼﾞﾔﾂｸ",
+			}),
+			wantErr:   true,
+			wantField: "branch_code",
+		},
 		{
 			name: "Invalid_Name_Whitespace",
 			fisherman: &entity.Fisherman{
@@ -36,6 +62,34 @@ func TestFisherman_Validate(t *testing.T) {
 			wantErr:   true,
 			wantField: "name",
 		},
+		{
+			name: "Valid_WithProfile",
+			fisherman: withProfile(model.FishermanProfile{
+				CooperativeMemberNumber:  "1024",
+				VesselName:               "第一漁丸",
+				VesselRegistrationNumber: "mg2-1234",
+				Phone:                    "090-0000-0000",
+				Address:                  "宮城県石巻市",
+			}),
+		},
+		{
+			name:      "Invalid_CooperativeMemberNumber",
+			fisherman: withProfile(model.FishermanProfile{CooperativeMemberNumber: "No.1024"}),
+			wantErr:   true,
+			wantField: "cooperative_member_number",
+		},
+		{
+			name:      "Invalid_VesselRegistrationNumber_TooLong",
+			fisherman: withProfile(model.FishermanProfile{VesselRegistrationNumber: "MG2-12345678901234567"}),
+			wantErr:   true,
+			wantField: "vessel_registration_number",
+		},
+		{
+			name:      "Invalid_Phone",
+			fisherman: withProfile(model.FishermanProfile{Phone: "090/0000/0000"}),
+			wantErr:   true,
+			wantField: "phone",
+		},
 	}
 
 	for _, tt := range tests {
@@ -63,6 +117,17 @@ func TestFisherman_Validate(t *testing.T) {
 	}
 }
 
+func withProfile(p model.FishermanProfile) *entity.Fisherman {
+	f := &entity.Fisherman{Name: "Captain Jack"}
+	f.SetProfile(p)
+	return f
+}
+
+func withBankAccount(f *entity.Fisherman, a *model.BankAccount) *entity.Fisherman {
+	f.SetBankAccount(a)
+	return f
+}
+
 func TestFisherman_ToModel(t *testing.T) {
 	fisherman := &entity.Fisherman{
 		ID:   1,
@@ -78,3 +143,19 @@ func TestFisherman_ToModel(t *testing.T) {
 		t.Errorf("expected Name %s, got %s", fisherman.Name, modelFisherman.Name)
 	}
 }
+
+func TestFisherman_ToModel_BankAccount(t *testing.T) {
+	account := &model.BankAccount{
+		BankCode: "0005", BranchCode: "201", AccountType: model.BankAccountTypeSavings, AccountNumber: "42", HolderKana: "ﾔﾏﾀﾞ",
+	}
+	fisherman := withBankAccount(&entity.Fisherman{ID: 1, Name: "Captain Jack"}, account)
+
+	got := fisherman.ToModel()
+
+	if got.BankAccount == nil || *got.BankAccount != *account {
+		t.Errorf("expected bank account %+v, got %+v", account, got.BankAccount)
+	}
+	if (&entity.Fisherman{ID: 2, Name: "No Account"}).ToModel().BankAccount != nil {
+		t.Error("expected nil bank account when no details are stored")
+	}
+}
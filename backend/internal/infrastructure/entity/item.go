@@ -10,18 +10,18 @@ import (
 
 // AuctionItem provides AuctionItem related functionality.
 type AuctionItem struct {
-	ID                int        `db:"id"`
-	AuctionID         int        `db:"auction_id"`
-	FishermanID       int        `db:"fisherman_id"`
-	FishType          string     `db:"fish_type"`
-	Quantity          int        `db:"quantity"`
-	Unit              string     `db:"unit"`
-	HighestBid        *int       `db:"highest_bid"`
-	HighestBidderID   *int       `db:"highest_bidder_id"`
-	HighestBidderName *string    `db:"highest_bidder_name"`
-	SortOrder         int        `db:"sort_order"`
-	CreatedAt         time.Time  `db:"created_at"`
-	DeletedAt         *time.Time `db:"deleted_at"`
+	ID                  int        `db:"id"`
+	AuctionID           int        `db:"auction_id"`
+	FishermanID         int        `db:"fisherman_id"`
+	FishType            string     `db:"fish_type"`
+	Quantity            int        `db:"quantity"`
+	Unit                string     `db:"unit"`
+	HighestBid          *int       `db:"highest_bid"`
+	HighestBidderID     *int       `db:"highest_bidder_id"`
+	HighestBidderPaddle *string    `db:"highest_bidder_paddle"`
+	SortOrder           int        `db:"sort_order"`
+	CreatedAt           time.Time  `db:"created_at"`
+	DeletedAt           *time.Time `db:"deleted_at"`
 }
 
 // Validate provides Validate related functionality.
@@ -61,17 +61,17 @@ func (e *AuctionItem) ToModel() *model.AuctionItem {
 		highestBid = &bp
 	}
 	return &model.AuctionItem{
-		ID:                e.ID,
-		AuctionID:         e.AuctionID,
-		FishermanID:       e.FishermanID,
-		FishType:          e.FishType,
-		Quantity:          e.Quantity,
-		Unit:              e.Unit,
-		HighestBid:        highestBid,
-		HighestBidderID:   e.HighestBidderID,
-		HighestBidderName: e.HighestBidderName,
-		SortOrder:         e.SortOrder,
-		CreatedAt:         e.CreatedAt,
-		DeletedAt:         e.DeletedAt,
+		ID:                  e.ID,
+		AuctionID:           e.AuctionID,
+		FishermanID:         e.FishermanID,
+		FishType:            e.FishType,
+		Quantity:            e.Quantity,
+		Unit:                e.Unit,
+		HighestBid:          highestBid,
+		HighestBidderID:     e.HighestBidderID,
+		HighestBidderPaddle: e.HighestBidderPaddle,
+		SortOrder:           e.SortOrder,
+		CreatedAt:           e.CreatedAt,
+		DeletedAt:           e.DeletedAt,
 	}
 }
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/seka/fish-auction/backend/internal/domain/errors"
+)
+
+const (
+	phoneMaxLength      = 20
+	phoneMinDigits      = 10
+	phoneMaxDigits      = 15
+	addressMaxLength    = 255
+	identifierMaxLength = 20
+)
+
+// validatePhone accepts an empty number or digits separated by hyphens or spaces, with an optional leading "+".
+// 国内の固定電話・携帯電話（10〜11 桁）と国際表記の両方を受け付ける。
+func validatePhone(field, phone string) error {
+	if phone == "" {
+		return nil
+	}
+	if len(phone) > phoneMaxLength {
+		return &errors.ValidationError{Field: field, Message: fmt.Sprintf("must be at most %d characters", phoneMaxLength)}
+	}
+	digits := 0
+	for i, r := range phone {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '-' || r == ' ':
+		case r == '+' && i == 0:
+		default:
+			return &errors.ValidationError{Field: field, Message: "must contain only digits, hyphens and spaces"}
+		}
+	}
+	if digits < phoneMinDigits || digits > phoneMaxDigits {
+		return &errors.ValidationError{Field: field, Message: fmt.Sprintf("must have %d to %d digits", phoneMinDigits, phoneMaxDigits)}
+	}
+	return nil
+}
+
+// validateMaxLength checks the length of free text such as names and addresses in characters, not bytes.
+func validateMaxLength(field, s string, maxLength int) error {
+	if len([]rune(s)) > maxLength {
+		return &errors.ValidationError{Field: field, Message: fmt.Sprintf("must be at most %d characters", maxLength)}
+	}
+	return nil
+}
+
+// validateIdentifier accepts an empty value or upper-case letters, digits and hyphens such as member and registration numbers.
+func validateIdentifier(field, s string, maxLength int) error {
+	if len(s) > maxLength {
+		return &errors.ValidationError{Field: field, Message: fmt.Sprintf("must be at most %d characters", maxLength)}
+	}
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'A' || r > 'Z') && r != '-' {
+			return &errors.ValidationError{Field: field, Message: "must contain only letters, digits and hyphens"}
+		}
+	}
+	return nil
+}
+
+func normalizeIdentifier(s string) string {
+	return strings.ToUpper(strings.TrimSpace(s))
+}
@@ -0,0 +1,119 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// A4 の用紙サイズ（pt）。
+const (
+	pageWidth  = 595.28
+	pageHeight = 841.89
+)
+
+// document is a minimal PDF 1.4 writer for label sheets: filled rectangles,
+// stroked rectangles and text in a single Japanese font.
+//
+// 日本語は PDF 標準の CID フォント（HeiseiKakuGo-W5, Adobe-Japan1）を埋め込まずに参照する。
+// フォントファイルを同梱せずに済み、閲覧・印刷側のゴシック体で代替表示される。
+type document struct {
+	pages []*bytes.Buffer
+}
+
+func newDocument() *document {
+	return &document{}
+}
+
+// addPage starts a new page; subsequent drawing goes to it.
+func (d *document) addPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+func (d *document) page() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+// fillRect draws a black filled rectangle. Coordinates are from the top-left corner of the page.
+func (d *document) fillRect(x, y, w, h float64) {
+	fmt.Fprintf(d.page(), "%.2f %.2f %.2f %.2f re f\n", x, pageHeight-y-h, w, h)
+}
+
+// strokeRect draws a thin rectangle outline.
+func (d *document) strokeRect(x, y, w, h float64) {
+	fmt.Fprintf(d.page(), "0.5 w %.2f %.2f %.2f %.2f re S\n", x, pageHeight-y-h, w, h)
+}
+
+// text draws a single line whose baseline is at y from the top of the page.
+func (d *document) text(x, y, size float64, s string) {
+	fmt.Fprintf(d.page(), "BT /F1 %.1f Tf %.2f %.2f Td <%s> Tj ET\n", size, x, pageHeight-y, encodeUCS2(s))
+}
+
+// bytes serializes the document.
+func (d *document) bytes() ([]byte, error) {
+	// 1: catalog, 2: pages, 3-5: font, 6.. : page + content pairs
+	const firstPageObj = 6
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"", // pages, filled in below
+		"<< /Type /Font /Subtype /Type0 /BaseFont /HeiseiKakuGo-W5 /Encoding /UniJIS-UCS2-H /DescendantFonts [4 0 R] >>",
+		"<< /Type /Font /Subtype /CIDFontType0 /BaseFont /HeiseiKakuGo-W5" +
+			" /CIDSystemInfo << /Registry (Adobe) /Ordering (Japan1) /Supplement 5 >>" +
+			" /FontDescriptor 5 0 R /DW 1000 /W [1 95 500 327 389 500] >>",
+		"<< /Type /FontDescriptor /FontName /HeiseiKakuGo-W5 /Flags 4 /FontBBox [-92 -250 1010 922]" +
+			" /ItalicAngle 0 /Ascent 752 /Descent -221 /CapHeight 737 /StemV 114 >>",
+	}
+
+	kids := make([]string, len(d.pages))
+	for i, p := range d.pages {
+		pageObj := firstPageObj + i*2
+		kids[i] = fmt.Sprintf("%d 0 R", pageObj)
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		if _, err := zw.Write(p.Bytes()); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+				pageWidth, pageHeight, pageObj+1),
+			fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.String()),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages))
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes(), nil
+}
+
+// encodeUCS2 returns s as hex UCS-2 for the UniJIS-UCS2-H encoding.
+// BMP 外の文字（サロゲートペア）は表示できないため「?」に置き換える。
+func encodeUCS2(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r > 0xFFFF || utf16.IsSurrogate(r) {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	return b.String()
+}
@@ -0,0 +1,100 @@
+package pdf
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/skip2/go-qrcode"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+var _ service.LabelRenderer = (*LabelRenderer)(nil)
+
+// ラベルの割り付け（A4 に 2 列 × 5 行、1 枚 10 ラベル）。
+const (
+	labelColumns = 2
+	labelRows    = 5
+	sheetMargin  = 20.0
+	labelPadding = 12.0
+	qrSize       = 110.0
+	labelWidth   = (pageWidth - sheetMargin*2) / labelColumns
+	labelHeight  = (pageHeight - sheetMargin*2) / labelRows
+	// 魚種・漁業者名がラベルからはみ出さないように切り詰める文字数
+	maxFishTypeRunes  = 10
+	maxFishermanRunes = 12
+)
+
+// LabelRenderer renders lot labels as an A4 PDF.
+type LabelRenderer struct{}
+
+// NewLabelRenderer creates a new LabelRenderer instance.
+func NewLabelRenderer() *LabelRenderer {
+	return &LabelRenderer{}
+}
+
+// Render lays the labels out ten per A4 page with a cut line around each label.
+func (r *LabelRenderer) Render(labels []model.Label) ([]byte, error) {
+	doc := newDocument()
+	if len(labels) == 0 {
+		doc.addPage()
+	}
+
+	perPage := labelColumns * labelRows
+	for i, l := range labels {
+		if i%perPage == 0 {
+			doc.addPage()
+		}
+		slot := i % perPage
+		x := sheetMargin + float64(slot%labelColumns)*labelWidth
+		y := sheetMargin + float64(slot/labelColumns)*labelHeight
+		if err := drawLabel(doc, x, y, &l); err != nil {
+			return nil, fmt.Errorf("failed to render label for item %d: %w", l.ItemID, err)
+		}
+	}
+	return doc.bytes()
+}
+
+func drawLabel(doc *document, x, y float64, l *model.Label) error {
+	doc.strokeRect(x, y, labelWidth, labelHeight)
+
+	tx := x + labelPadding
+	doc.text(tx, y+labelPadding+28, 28, fmt.Sprintf("No.%d", l.LotNumber))
+	doc.text(tx, y+labelPadding+62, 20, truncate(l.FishType, maxFishTypeRunes))
+	doc.text(tx, y+labelPadding+90, 14, fmt.Sprintf("%d %s", l.Quantity, l.Unit))
+	doc.text(tx, y+labelPadding+114, 11, truncate(l.FishermanName, maxFishermanRunes))
+	doc.text(tx, y+labelHeight-labelPadding, 8, fmt.Sprintf("Auction #%d / Item #%d", l.AuctionID, l.ItemID))
+
+	qr, err := qrcode.New(l.QRContent, qrcode.Medium)
+	if err != nil {
+		return err
+	}
+	drawQR(doc, x+labelWidth-labelPadding-qrSize, y+(labelHeight-qrSize)/2, qr.Bitmap())
+	return nil
+}
+
+// drawQR draws the QR modules, merging horizontal runs of dark modules into one rectangle.
+func drawQR(doc *document, x, y float64, bitmap [][]bool) {
+	module := qrSize / float64(len(bitmap))
+	for row, cells := range bitmap {
+		for col := 0; col < len(cells); {
+			if !cells[col] {
+				col++
+				continue
+			}
+			start := col
+			for col < len(cells) && cells[col] {
+				col++
+			}
+			doc.fillRect(x+float64(start)*module, y+float64(row)*module, float64(col-start)*module, module)
+		}
+	}
+}
+
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n-1]) + "…"
+}
@@ -0,0 +1,51 @@
+package pdf_test
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/label/pdf"
+)
+
+func TestLabelRenderer_Render(t *testing.T) {
+	labels := make([]model.Label, 11)
+	for i := range labels {
+		labels[i] = model.Label{
+			ItemID: i + 1, AuctionID: 1, LotNumber: i + 1, FishType: "本マグロ", Quantity: 1, Unit: "本",
+			FishermanName: "漁協 太郎", QRContent: fmt.Sprintf("https://example.com/scan?code=L1.%d.1.sig", i+1),
+		}
+	}
+
+	out, err := pdf.NewLabelRenderer().Render(labels)
+	require.NoError(t, err)
+
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4")))
+	assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), "/Count 2", "11 labels need two A4 sheets")
+	assert.Contains(t, string(out), "/BaseFont /HeiseiKakuGo-W5")
+
+	// every xref entry must point at the start of its object
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	require.NotNil(t, m)
+	xref, _ := strconv.Atoi(string(m[1]))
+	require.True(t, bytes.HasPrefix(out[xref:], []byte("xref\n")))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xref:], -1)
+	require.NotEmpty(t, entries)
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		assert.True(t, bytes.HasPrefix(out[off:], fmt.Appendf(nil, "%d 0 obj", i+1)), "object %d", i+1)
+	}
+}
+
+func TestLabelRenderer_Render_Empty(t *testing.T) {
+	out, err := pdf.NewLabelRenderer().Render(nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "/Count 1")
+}
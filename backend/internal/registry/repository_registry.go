@@ -33,6 +33,22 @@ type Repository interface {
 	NewSessionRepository() repository.SessionRepository
 	NewOutboxRepository() repository.OutboxRepository
 	NewRateLimitRepository() repository.RateLimitRepository
+	NewInvoiceRepository() repository.InvoiceRepository
+	NewPaymentRepository() repository.PaymentRepository
+	NewSettlementRepository() repository.SettlementRepository
+	NewAccountingSettingsRepository() repository.AccountingSettingsRepository
+	NewClaimRepository() repository.ClaimRepository
+	NewCreditNoteRepository() repository.CreditNoteRepository
+	NewResultCorrectionRepository() repository.ResultCorrectionRepository
+	NewSettlementAdjustmentRepository() repository.SettlementAdjustmentRepository
+	NewChargeItemRepository() repository.ChargeItemRepository
+	NewBuyerChargeRepository() repository.BuyerChargeRepository
+	NewVenueRegistrationRepository() repository.VenueRegistrationRepository
+	NewBuyerSuspensionRepository() repository.BuyerSuspensionRepository
+	NewFishermanAuthenticationRepository() repository.FishermanAuthenticationRepository
+	NewEmailChangeRepository() repository.EmailChangeRepository
+	NewEmailVerificationRepository() repository.EmailVerificationRepository
+	NewAdminTwoFactorRepository() repository.AdminTwoFactorRepository
 	// Cleanup closes underlying connections (DB, Redis, etc.) via their interfaces.
 	Cleanup() error
 }
@@ -191,3 +207,67 @@ func (r *repositoryRegistry) NewSessionRepository() repository.SessionRepository
 func (r *repositoryRegistry) NewOutboxRepository() repository.OutboxRepository {
 	return postgres.NewOutboxStore(r.db)
 }
+
+func (r *repositoryRegistry) NewInvoiceRepository() repository.InvoiceRepository {
+	return postgres.NewInvoiceStore(r.db)
+}
+
+func (r *repositoryRegistry) NewPaymentRepository() repository.PaymentRepository {
+	return postgres.NewPaymentStore(r.db)
+}
+
+func (r *repositoryRegistry) NewSettlementRepository() repository.SettlementRepository {
+	return postgres.NewSettlementStore(r.db)
+}
+
+func (r *repositoryRegistry) NewAccountingSettingsRepository() repository.AccountingSettingsRepository {
+	return postgres.NewAccountingSettingsStore(r.db)
+}
+
+func (r *repositoryRegistry) NewClaimRepository() repository.ClaimRepository {
+	return postgres.NewClaimStore(r.db)
+}
+
+func (r *repositoryRegistry) NewCreditNoteRepository() repository.CreditNoteRepository {
+	return postgres.NewCreditNoteStore(r.db)
+}
+
+func (r *repositoryRegistry) NewResultCorrectionRepository() repository.ResultCorrectionRepository {
+	return postgres.NewResultCorrectionStore(r.db)
+}
+
+func (r *repositoryRegistry) NewSettlementAdjustmentRepository() repository.SettlementAdjustmentRepository {
+	return postgres.NewSettlementAdjustmentStore(r.db)
+}
+
+func (r *repositoryRegistry) NewVenueRegistrationRepository() repository.VenueRegistrationRepository {
+	return postgres.NewVenueRegistrationStore(r.db)
+}
+
+func (r *repositoryRegistry) NewBuyerSuspensionRepository() repository.BuyerSuspensionRepository {
+	return postgres.NewBuyerSuspensionStore(r.db)
+}
+
+func (r *repositoryRegistry) NewFishermanAuthenticationRepository() repository.FishermanAuthenticationRepository {
+	return postgres.NewFishermanAuthenticationStore(r.db)
+}
+
+func (r *repositoryRegistry) NewEmailChangeRepository() repository.EmailChangeRepository {
+	return postgres.NewEmailChangeStore(r.db)
+}
+
+func (r *repositoryRegistry) NewEmailVerificationRepository() repository.EmailVerificationRepository {
+	return postgres.NewEmailVerificationStore(r.db)
+}
+
+func (r *repositoryRegistry) NewAdminTwoFactorRepository() repository.AdminTwoFactorRepository {
+	return postgres.NewAdminTwoFactorStore(r.db)
+}
+
+func (r *repositoryRegistry) NewChargeItemRepository() repository.ChargeItemRepository {
+	return postgres.NewChargeItemStore(r.db)
+}
+
+func (r *repositoryRegistry) NewBuyerChargeRepository() repository.BuyerChargeRepository {
+	return postgres.NewBuyerChargeStore(r.db)
+}
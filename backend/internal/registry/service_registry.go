@@ -8,6 +8,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/domain/service"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/email/mailhog"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/email/templates"
+	"github.com/seka/fish-auction/backend/internal/infrastructure/label/pdf"
 	pushNotification "github.com/seka/fish-auction/backend/internal/infrastructure/push_notification"
 	"github.com/seka/fish-auction/backend/internal/infrastructure/queue/sqs"
 )
@@ -17,16 +18,20 @@ type Service interface {
 	NewPushNotificationService() service.PushNotificationService
 	NewAdminEmailService() service.AdminEmailService
 	NewBuyerEmailService() service.BuyerEmailService
+	NewFishermanEmailService() service.FishermanEmailService
 	NewJobQueue() service.JobQueue
 	NewClock() service.Clock
+	NewLabelRenderer() service.LabelRenderer
 }
 
 type serviceRegistry struct {
 	pushNotificationService service.PushNotificationService
 	adminEmailService       service.AdminEmailService
 	buyerEmailService       service.BuyerEmailService
+	fishermanEmailService   service.FishermanEmailService
 	jobQueue                service.JobQueue
 	clock                   service.Clock
+	labelRenderer           service.LabelRenderer
 }
 
 // NewServiceRegistry creates a new Service registry
@@ -49,6 +54,7 @@ func NewServiceRegistry(
 	// Initialize queue clients whenever a job queue is available.
 	var adminEmailService service.AdminEmailService
 	var buyerEmailService service.BuyerEmailService
+	var fishermanEmailService service.FishermanEmailService
 	var pushNotificationService service.PushNotificationService
 
 	if isWorker {
@@ -61,6 +67,7 @@ func NewServiceRegistry(
 		}
 		adminEmailService = mailhog.NewAdminEmailService(emailCfg, loader)
 		buyerEmailService = mailhog.NewBuyerEmailService(emailCfg, loader)
+		fishermanEmailService = mailhog.NewFishermanEmailService(emailCfg, loader)
 		pushNotificationService = pushNotification.NewWebpushService(webpushCfg)
 	}
 
@@ -68,8 +75,10 @@ func NewServiceRegistry(
 		pushNotificationService: pushNotificationService,
 		adminEmailService:       adminEmailService,
 		buyerEmailService:       buyerEmailService,
+		fishermanEmailService:   fishermanEmailService,
 		jobQueue:                jobQueue,
 		clock:                   service.NewRealClock(),
+		labelRenderer:           pdf.NewLabelRenderer(),
 	}, nil
 }
 
@@ -85,6 +94,10 @@ func (s *serviceRegistry) NewBuyerEmailService() service.BuyerEmailService {
 	return s.buyerEmailService
 }
 
+func (s *serviceRegistry) NewFishermanEmailService() service.FishermanEmailService {
+	return s.fishermanEmailService
+}
+
 func (s *serviceRegistry) NewJobQueue() service.JobQueue {
 	return s.jobQueue
 }
@@ -92,3 +105,7 @@ func (s *serviceRegistry) NewJobQueue() service.JobQueue {
 func (s *serviceRegistry) NewClock() service.Clock {
 	return s.clock
 }
+
+func (s *serviceRegistry) NewLabelRenderer() service.LabelRenderer {
+	return s.labelRenderer
+}
@@ -2,15 +2,24 @@ package registry
 
 import (
 	"github.com/seka/fish-auction/backend/config"
+	"github.com/seka/fish-auction/backend/internal/usecase/accounting"
 	"github.com/seka/fish-auction/backend/internal/usecase/admin"
 	"github.com/seka/fish-auction/backend/internal/usecase/auction"
 	"github.com/seka/fish-auction/backend/internal/usecase/auth"
 	"github.com/seka/fish-auction/backend/internal/usecase/bid"
 	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	"github.com/seka/fish-auction/backend/internal/usecase/charge"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
 	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
 	"github.com/seka/fish-auction/backend/internal/usecase/invoice"
 	"github.com/seka/fish-auction/backend/internal/usecase/item"
+	"github.com/seka/fish-auction/backend/internal/usecase/label"
 	"github.com/seka/fish-auction/backend/internal/usecase/notification"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
+	"github.com/seka/fish-auction/backend/internal/usecase/result"
+	"github.com/seka/fish-auction/backend/internal/usecase/session"
+	"github.com/seka/fish-auction/backend/internal/usecase/settlement"
 	"github.com/seka/fish-auction/backend/internal/usecase/venue"
 )
 
@@ -23,6 +32,9 @@ type UseCase interface {
 	NewUpdateItemSortOrderUseCase() item.UpdateItemSortOrderUseCase
 	NewReorderItemsUseCase() item.ReorderItemsUseCase
 	NewCreateBidUseCase() bid.CreateBidUseCase
+	NewListItemBidsUseCase() bid.ListItemBidsUseCase
+	NewListVisibleItemBidsUseCase() bid.ListItemBidsUseCase
+	NewVoidBidUseCase() bid.VoidBidUseCase
 	NewCreateBuyerUseCase() buyer.CreateBuyerUseCase
 	NewListBuyersUseCase() buyer.ListBuyersUseCase
 	NewLoginBuyerUseCase() buyer.LoginBuyerUseCase
@@ -32,8 +44,64 @@ type UseCase interface {
 	NewCreateFishermanUseCase() fisherman.CreateFishermanUseCase
 	NewListFishermenUseCase() fisherman.ListFishermenUseCase
 	NewDeleteFishermanUseCase() fisherman.DeleteFishermanUseCase
+	NewUpdateFishermanUseCase() fisherman.UpdateFishermanUseCase
+	NewUpdateFishermanBankAccountUseCase() fisherman.UpdateBankAccountUseCase
+	NewCreateFishermanLoginUseCase() fisherman.CreateLoginUseCase
+	NewLoginFishermanUseCase() fisherman.LoginFishermanUseCase
+	NewGetFishermanUseCase() fisherman.GetFishermanUseCase
+	NewListConsignedLotsUseCase() fisherman.ListConsignedLotsUseCase
+	NewListSaleResultsUseCase() fisherman.ListSaleResultsUseCase
+	NewListFishermanStatementsUseCase() fisherman.ListStatementsUseCase
+	NewGetFishermanStatementUseCase() fisherman.GetStatementUseCase
+	NewRequestFishermanPasswordResetUseCase() fisherman.RequestPasswordResetUseCase
+	NewVerifyFishermanResetTokenUseCase() fisherman.VerifyResetTokenUseCase
+	NewResetFishermanPasswordUseCase() fisherman.ResetPasswordUseCase
 	NewDeleteBuyerUseCase() buyer.DeleteBuyerUseCase
+	NewUpdateBuyerUseCase() buyer.UpdateBuyerUseCase
+	NewUpdateBuyerPaddleNumberUseCase() buyer.UpdatePaddleNumberUseCase
+	NewGetBuyerCreditUseCase() buyer.GetCreditUtilizationUseCase
+	NewUpdateBuyerCreditUseCase() buyer.UpdateCreditTermsUseCase
+	NewSuspendBuyerUseCase() buyer.SuspendBuyerUseCase
+	NewLiftBuyerSuspensionUseCase() buyer.LiftSuspensionUseCase
+	NewListBuyerSuspensionsUseCase() buyer.ListSuspensionsUseCase
 	NewListInvoicesUseCase() invoice.ListInvoicesUseCase
+	NewGenerateInvoicesUseCase() invoice.GenerateInvoicesUseCase
+	NewIssueInvoiceUseCase() invoice.IssueInvoiceUseCase
+	NewGetInvoiceUseCase() invoice.GetInvoiceUseCase
+	NewRecordPaymentUseCase() payment.RecordPaymentUseCase
+	NewGetBuyerBalanceUseCase() payment.GetBuyerBalanceUseCase
+	NewGetBuyerLedgerUseCase() payment.GetBuyerLedgerUseCase
+	NewListAgedReceivablesUseCase() payment.ListAgedReceivablesUseCase
+	NewGenerateSettlementsUseCase() settlement.GenerateSettlementsUseCase
+	NewSettleStatementUseCase() settlement.SettleStatementUseCase
+	NewListSettlementsUseCase() settlement.ListSettlementsUseCase
+	NewExportTransferFileUseCase() settlement.ExportTransferFileUseCase
+	NewGetAccountingSettingsUseCase() accounting.GetAccountingSettingsUseCase
+	NewUpdateAccountingSettingsUseCase() accounting.UpdateAccountingSettingsUseCase
+	NewExportJournalUseCase() accounting.ExportJournalUseCase
+	NewFileClaimUseCase() claim.FileClaimUseCase
+	NewListClaimsUseCase() claim.ListClaimsUseCase
+	NewGetClaimUseCase() claim.GetClaimUseCase
+	NewApproveClaimUseCase() claim.ApproveClaimUseCase
+	NewRejectClaimUseCase() claim.RejectClaimUseCase
+	NewCreateChargeItemUseCase() charge.CreateChargeItemUseCase
+	NewUpdateChargeItemUseCase() charge.UpdateChargeItemUseCase
+	NewListChargeItemsUseCase() charge.ListChargeItemsUseCase
+	NewAddBuyerChargeUseCase() charge.AddBuyerChargeUseCase
+	NewListBuyerChargesUseCase() charge.ListBuyerChargesUseCase
+	NewDeleteBuyerChargeUseCase() charge.DeleteBuyerChargeUseCase
+	NewPrintAuctionLabelsUseCase() label.PrintAuctionLabelsUseCase
+	NewPrintItemLabelUseCase() label.PrintItemLabelUseCase
+	NewScanLabelUseCase() label.ScanLabelUseCase
+	NewEnterResultsUseCase() result.EnterResultsUseCase
+	NewRequestCorrectionUseCase() result.RequestCorrectionUseCase
+	NewApproveCorrectionUseCase() result.ApproveCorrectionUseCase
+	NewRejectCorrectionUseCase() result.RejectCorrectionUseCase
+	NewListCorrectionsUseCase() result.ListCorrectionsUseCase
+	NewCreateVenueRegistrationUseCase() registration.CreateRegistrationUseCase
+	NewUpdateVenueRegistrationUseCase() registration.UpdateRegistrationUseCase
+	NewListVenueRegistrationsUseCase() registration.ListRegistrationsUseCase
+	NewAuthorizeAuctionViewUseCase() registration.AuthorizeAuctionViewUseCase
 	NewLoginUseCase() auth.LoginUseCase
 	NewCreateVenueUseCase() venue.CreateVenueUseCase
 	NewListVenuesUseCase() venue.ListVenuesUseCase
@@ -49,6 +117,18 @@ type UseCase interface {
 	NewDeleteAuctionUseCase() auction.DeleteAuctionUseCase
 	NewAdminUpdatePasswordUseCase() admin.UpdatePasswordUseCase
 	NewBuyerUpdatePasswordUseCase() buyer.UpdatePasswordUseCase
+	NewUpdateBuyerProfileUseCase() buyer.UpdateProfileUseCase
+	NewRequestBuyerEmailChangeUseCase() buyer.RequestEmailChangeUseCase
+	NewConfirmBuyerEmailChangeUseCase() buyer.ConfirmEmailChangeUseCase
+	NewListBuyerLoginsUseCase() buyer.ListLoginsUseCase
+	NewCreateBuyerLoginUseCase() buyer.CreateLoginUseCase
+	NewUpdateBuyerLoginUseCase() buyer.UpdateLoginUseCase
+	NewRegisterBuyerUseCase() buyer.RegisterBuyerUseCase
+	NewVerifyBuyerRegistrationEmailUseCase() buyer.VerifyRegistrationEmailUseCase
+	NewResendBuyerRegistrationVerificationUseCase() buyer.ResendRegistrationVerificationUseCase
+	NewListBuyerApplicationsUseCase() buyer.ListApplicationsUseCase
+	NewApproveBuyerApplicationUseCase() buyer.ApproveApplicationUseCase
+	NewRejectBuyerApplicationUseCase() buyer.RejectApplicationUseCase
 	NewRequestPasswordResetUseCase() auth.RequestPasswordResetUseCase
 	NewResetPasswordUseCase() auth.ResetPasswordUseCase
 	NewVerifyResetTokenUseCase() auth.VerifyResetTokenUseCase
@@ -57,16 +137,29 @@ type UseCase interface {
 	NewResetAdminPasswordUseCase() admin.ResetPasswordUseCase
 	NewSubscribeNotificationUseCase() notification.SubscribeNotificationUseCase
 	NewCreateAdminUseCase() admin.CreateAdminUseCase
+	NewListAdminsUseCase() admin.ListAdminsUseCase
+	NewUpdateAdminRoleUseCase() admin.UpdateRoleUseCase
+	NewSetAdminDisabledUseCase() admin.SetDisabledUseCase
+	NewUnlockAdminUseCase() admin.UnlockUseCase
+	NewForceAdminPasswordResetUseCase() admin.ForcePasswordResetUseCase
+	NewGetAdminTwoFactorStatusUseCase() admin.GetTwoFactorStatusUseCase
+	NewSetupAdminTwoFactorUseCase() admin.SetupTwoFactorUseCase
+	NewEnableAdminTwoFactorUseCase() admin.EnableTwoFactorUseCase
+	NewDisableAdminTwoFactorUseCase() admin.DisableTwoFactorUseCase
+	NewResetAdminTwoFactorUseCase() admin.ResetTwoFactorUseCase
+	NewListSessionsUseCase() session.ListSessionsUseCase
+	NewRevokeSessionUseCase() session.RevokeSessionUseCase
+	NewRevokeSessionsUseCase() session.RevokeSessionsUseCase
 }
 
 type useCaseRegistry struct {
 	repo    Repository
 	service Service
-	cfg     config.FrontendConfig
+	cfg     config.UseCaseConfig
 }
 
 // NewUseCaseRegistry creates a new UseCase registry
-func NewUseCaseRegistry(repo Repository, service Service, cfg config.FrontendConfig) UseCase {
+func NewUseCaseRegistry(repo Repository, service Service, cfg config.UseCaseConfig) UseCase {
 	return &useCaseRegistry{
 		repo:    repo,
 		service: service,
@@ -104,8 +197,12 @@ func (u *useCaseRegistry) NewCreateBidUseCase() bid.CreateBidUseCase {
 	return bid.NewCreateBidUseCase(
 		u.repo.NewItemRepository(),
 		u.repo.NewBuyerRepository(),
+		u.repo.NewAuthenticationRepository(),
 		u.repo.NewBidRepository(),
 		u.repo.NewAuctionRepository(),
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewVenueRegistrationRepository(),
+		u.repo.NewBuyerSuspensionRepository(),
 		u.repo.NewOutboxRepository(),
 		u.repo.NewTransactionManager(),
 		u.repo.NewItemCacheInvalidator(),
@@ -113,6 +210,26 @@ func (u *useCaseRegistry) NewCreateBidUseCase() bid.CreateBidUseCase {
 	)
 }
 
+func (u *useCaseRegistry) NewListItemBidsUseCase() bid.ListItemBidsUseCase {
+	return bid.NewListItemBidsUseCase(u.repo.NewItemRepository(), u.repo.NewAuctionRepository(), u.repo.NewBidRepository())
+}
+
+func (u *useCaseRegistry) NewListVisibleItemBidsUseCase() bid.ListItemBidsUseCase {
+	return bid.NewListVisibleItemBidsUseCase(u.repo.NewItemRepository(), u.repo.NewAuctionRepository(), u.repo.NewBidRepository())
+}
+
+func (u *useCaseRegistry) NewVoidBidUseCase() bid.VoidBidUseCase {
+	return bid.NewVoidBidUseCase(
+		u.repo.NewBidRepository(),
+		u.repo.NewItemRepository(),
+		u.repo.NewAuctionRepository(),
+		u.repo.NewOutboxRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+		u.repo.NewItemCacheInvalidator(),
+	)
+}
+
 func (u *useCaseRegistry) NewCreateBuyerUseCase() buyer.CreateBuyerUseCase {
 	return buyer.NewCreateBuyerUseCase(u.repo.NewBuyerRepository(), u.repo.NewAuthenticationRepository(), u.repo.NewTransactionManager())
 }
@@ -122,7 +239,12 @@ func (u *useCaseRegistry) NewListBuyersUseCase() buyer.ListBuyersUseCase {
 }
 
 func (u *useCaseRegistry) NewLoginBuyerUseCase() buyer.LoginBuyerUseCase {
-	return buyer.NewLoginBuyerUseCase(u.repo.NewBuyerRepository(), u.repo.NewAuthenticationRepository(), u.service.NewClock())
+	return buyer.NewLoginBuyerUseCase(
+		u.repo.NewBuyerRepository(),
+		u.repo.NewAuthenticationRepository(),
+		u.repo.NewBuyerSuspensionRepository(),
+		u.service.NewClock(),
+	)
 }
 
 func (u *useCaseRegistry) NewGetBuyerPurchasesUseCase() buyer.GetBuyerPurchasesUseCase {
@@ -149,16 +271,389 @@ func (u *useCaseRegistry) NewDeleteFishermanUseCase() fisherman.DeleteFishermanU
 	return fisherman.NewDeleteFishermanUseCase(u.repo.NewFishermanRepository())
 }
 
+func (u *useCaseRegistry) NewUpdateFishermanUseCase() fisherman.UpdateFishermanUseCase {
+	return fisherman.NewUpdateFishermanUseCase(u.repo.NewFishermanRepository())
+}
+
+func (u *useCaseRegistry) NewUpdateFishermanBankAccountUseCase() fisherman.UpdateBankAccountUseCase {
+	return fisherman.NewUpdateBankAccountUseCase(u.repo.NewFishermanRepository())
+}
+
+func (u *useCaseRegistry) NewCreateFishermanLoginUseCase() fisherman.CreateLoginUseCase {
+	return fisherman.NewCreateLoginUseCase(u.repo.NewFishermanRepository(), u.repo.NewFishermanAuthenticationRepository())
+}
+
+func (u *useCaseRegistry) NewLoginFishermanUseCase() fisherman.LoginFishermanUseCase {
+	return fisherman.NewLoginFishermanUseCase(
+		u.repo.NewFishermanRepository(),
+		u.repo.NewFishermanAuthenticationRepository(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewGetFishermanUseCase() fisherman.GetFishermanUseCase {
+	return fisherman.NewGetFishermanUseCase(u.repo.NewFishermanRepository())
+}
+
+func (u *useCaseRegistry) NewListConsignedLotsUseCase() fisherman.ListConsignedLotsUseCase {
+	return fisherman.NewListConsignedLotsUseCase(u.repo.NewItemRepository())
+}
+
+func (u *useCaseRegistry) NewListSaleResultsUseCase() fisherman.ListSaleResultsUseCase {
+	return fisherman.NewListSaleResultsUseCase(u.repo.NewItemRepository())
+}
+
+func (u *useCaseRegistry) NewListFishermanStatementsUseCase() fisherman.ListStatementsUseCase {
+	return fisherman.NewListStatementsUseCase(u.repo.NewSettlementRepository())
+}
+
+func (u *useCaseRegistry) NewGetFishermanStatementUseCase() fisherman.GetStatementUseCase {
+	return fisherman.NewGetStatementUseCase(u.repo.NewSettlementRepository())
+}
+
+func (u *useCaseRegistry) NewRequestFishermanPasswordResetUseCase() fisherman.RequestPasswordResetUseCase {
+	return fisherman.NewRequestPasswordResetUseCase(
+		u.repo.NewFishermanAuthenticationRepository(),
+		u.repo.PasswordReset(),
+		u.repo.NewOutboxRepository(),
+		u.cfg.GetFrontendURL(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewVerifyFishermanResetTokenUseCase() fisherman.VerifyResetTokenUseCase {
+	return fisherman.NewVerifyResetTokenUseCase(u.repo.PasswordReset(), u.service.NewClock())
+}
+
+func (u *useCaseRegistry) NewResetFishermanPasswordUseCase() fisherman.ResetPasswordUseCase {
+	return fisherman.NewResetPasswordUseCase(
+		u.repo.PasswordReset(),
+		u.repo.NewFishermanAuthenticationRepository(),
+		u.repo.NewSessionRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
 func (u *useCaseRegistry) NewDeleteBuyerUseCase() buyer.DeleteBuyerUseCase {
 	return buyer.NewDeleteBuyerUseCase(u.repo.NewBuyerRepository())
 }
 
+func (u *useCaseRegistry) NewUpdateBuyerUseCase() buyer.UpdateBuyerUseCase {
+	return buyer.NewUpdateBuyerUseCase(u.repo.NewBuyerRepository())
+}
+
+func (u *useCaseRegistry) NewUpdateBuyerPaddleNumberUseCase() buyer.UpdatePaddleNumberUseCase {
+	return buyer.NewUpdatePaddleNumberUseCase(u.repo.NewBuyerRepository())
+}
+
+func (u *useCaseRegistry) NewGetBuyerCreditUseCase() buyer.GetCreditUtilizationUseCase {
+	return buyer.NewGetCreditUtilizationUseCase(u.repo.NewBuyerRepository(), u.repo.NewBidRepository(), u.repo.NewInvoiceRepository())
+}
+
+func (u *useCaseRegistry) NewUpdateBuyerCreditUseCase() buyer.UpdateCreditTermsUseCase {
+	return buyer.NewUpdateCreditTermsUseCase(u.repo.NewBuyerRepository(), u.repo.NewBidRepository(), u.repo.NewInvoiceRepository())
+}
+
+func (u *useCaseRegistry) NewSuspendBuyerUseCase() buyer.SuspendBuyerUseCase {
+	return buyer.NewSuspendBuyerUseCase(
+		u.repo.NewBuyerRepository(),
+		u.repo.NewBuyerSuspensionRepository(),
+		u.repo.NewSessionRepository(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewLiftBuyerSuspensionUseCase() buyer.LiftSuspensionUseCase {
+	return buyer.NewLiftSuspensionUseCase(u.repo.NewBuyerSuspensionRepository(), u.service.NewClock())
+}
+
+func (u *useCaseRegistry) NewListBuyerSuspensionsUseCase() buyer.ListSuspensionsUseCase {
+	return buyer.NewListSuspensionsUseCase(u.repo.NewBuyerSuspensionRepository(), u.service.NewClock())
+}
+
 func (u *useCaseRegistry) NewListInvoicesUseCase() invoice.ListInvoicesUseCase {
 	return invoice.NewListInvoicesUseCase(u.repo.NewBidRepository())
 }
 
+func (u *useCaseRegistry) NewGenerateInvoicesUseCase() invoice.GenerateInvoicesUseCase {
+	return invoice.NewGenerateInvoicesUseCase(
+		u.repo.NewAuctionRepository(),
+		u.repo.NewBidRepository(),
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewBuyerChargeRepository(),
+		u.repo.NewTransactionManager(),
+	)
+}
+
+func (u *useCaseRegistry) NewIssueInvoiceUseCase() invoice.IssueInvoiceUseCase {
+	return invoice.NewIssueInvoiceUseCase(
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewPaymentRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewGetInvoiceUseCase() invoice.GetInvoiceUseCase {
+	return invoice.NewGetInvoiceUseCase(u.repo.NewInvoiceRepository())
+}
+
+func (u *useCaseRegistry) NewRecordPaymentUseCase() payment.RecordPaymentUseCase {
+	return payment.NewRecordPaymentUseCase(
+		u.repo.NewBuyerRepository(),
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewPaymentRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewGetBuyerBalanceUseCase() payment.GetBuyerBalanceUseCase {
+	return payment.NewGetBuyerBalanceUseCase(u.repo.NewInvoiceRepository(), u.repo.NewPaymentRepository())
+}
+
+func (u *useCaseRegistry) NewGetBuyerLedgerUseCase() payment.GetBuyerLedgerUseCase {
+	return payment.NewGetBuyerLedgerUseCase(
+		u.repo.NewBuyerRepository(),
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewCreditNoteRepository(),
+		u.repo.NewPaymentRepository(),
+	)
+}
+
+func (u *useCaseRegistry) NewListAgedReceivablesUseCase() payment.ListAgedReceivablesUseCase {
+	return payment.NewListAgedReceivablesUseCase(u.repo.NewInvoiceRepository(), u.service.NewClock())
+}
+
+func (u *useCaseRegistry) NewGenerateSettlementsUseCase() settlement.GenerateSettlementsUseCase {
+	return settlement.NewGenerateSettlementsUseCase(
+		u.repo.NewAuctionRepository(),
+		u.repo.NewBidRepository(),
+		u.repo.NewSettlementRepository(),
+		u.repo.NewSettlementAdjustmentRepository(),
+		u.repo.NewTransactionManager(),
+	)
+}
+
+func (u *useCaseRegistry) NewSettleStatementUseCase() settlement.SettleStatementUseCase {
+	return settlement.NewSettleStatementUseCase(
+		u.repo.NewSettlementRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewListSettlementsUseCase() settlement.ListSettlementsUseCase {
+	return settlement.NewListSettlementsUseCase(u.repo.NewSettlementRepository())
+}
+
+func (u *useCaseRegistry) NewExportTransferFileUseCase() settlement.ExportTransferFileUseCase {
+	return settlement.NewExportTransferFileUseCase(
+		u.repo.NewSettlementRepository(),
+		u.repo.NewFishermanRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewGetAccountingSettingsUseCase() accounting.GetAccountingSettingsUseCase {
+	return accounting.NewGetAccountingSettingsUseCase(u.repo.NewVenueRepository(), u.repo.NewAccountingSettingsRepository())
+}
+
+func (u *useCaseRegistry) NewUpdateAccountingSettingsUseCase() accounting.UpdateAccountingSettingsUseCase {
+	return accounting.NewUpdateAccountingSettingsUseCase(u.repo.NewVenueRepository(), u.repo.NewAccountingSettingsRepository())
+}
+
+func (u *useCaseRegistry) NewExportJournalUseCase() accounting.ExportJournalUseCase {
+	return accounting.NewExportJournalUseCase(
+		u.repo.NewVenueRepository(),
+		u.repo.NewAccountingSettingsRepository(),
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewCreditNoteRepository(),
+		u.repo.NewPaymentRepository(),
+		u.repo.NewSettlementRepository(),
+	)
+}
+
+func (u *useCaseRegistry) NewFileClaimUseCase() claim.FileClaimUseCase {
+	return claim.NewFileClaimUseCase(u.repo.NewBidRepository(), u.repo.NewClaimRepository(), u.repo.NewTransactionManager())
+}
+
+func (u *useCaseRegistry) NewListClaimsUseCase() claim.ListClaimsUseCase {
+	return claim.NewListClaimsUseCase(u.repo.NewClaimRepository())
+}
+
+func (u *useCaseRegistry) NewGetClaimUseCase() claim.GetClaimUseCase {
+	return claim.NewGetClaimUseCase(u.repo.NewClaimRepository(), u.repo.NewCreditNoteRepository())
+}
+
+func (u *useCaseRegistry) NewApproveClaimUseCase() claim.ApproveClaimUseCase {
+	return claim.NewApproveClaimUseCase(
+		u.repo.NewClaimRepository(),
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewCreditNoteRepository(),
+		u.repo.NewPaymentRepository(),
+		u.repo.NewSettlementAdjustmentRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewRejectClaimUseCase() claim.RejectClaimUseCase {
+	return claim.NewRejectClaimUseCase(u.repo.NewClaimRepository(), u.repo.NewTransactionManager(), u.service.NewClock())
+}
+
+func (u *useCaseRegistry) NewCreateChargeItemUseCase() charge.CreateChargeItemUseCase {
+	return charge.NewCreateChargeItemUseCase(u.repo.NewVenueRepository(), u.repo.NewChargeItemRepository())
+}
+
+func (u *useCaseRegistry) NewUpdateChargeItemUseCase() charge.UpdateChargeItemUseCase {
+	return charge.NewUpdateChargeItemUseCase(u.repo.NewChargeItemRepository())
+}
+
+func (u *useCaseRegistry) NewListChargeItemsUseCase() charge.ListChargeItemsUseCase {
+	return charge.NewListChargeItemsUseCase(u.repo.NewChargeItemRepository())
+}
+
+func (u *useCaseRegistry) NewAddBuyerChargeUseCase() charge.AddBuyerChargeUseCase {
+	return charge.NewAddBuyerChargeUseCase(
+		u.repo.NewAuctionRepository(),
+		u.repo.NewBuyerRepository(),
+		u.repo.NewChargeItemRepository(),
+		u.repo.NewBuyerChargeRepository(),
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewTransactionManager(),
+	)
+}
+
+func (u *useCaseRegistry) NewListBuyerChargesUseCase() charge.ListBuyerChargesUseCase {
+	return charge.NewListBuyerChargesUseCase(u.repo.NewBuyerChargeRepository())
+}
+
+func (u *useCaseRegistry) NewDeleteBuyerChargeUseCase() charge.DeleteBuyerChargeUseCase {
+	return charge.NewDeleteBuyerChargeUseCase(
+		u.repo.NewBuyerChargeRepository(),
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewTransactionManager(),
+	)
+}
+
+func (u *useCaseRegistry) NewPrintAuctionLabelsUseCase() label.PrintAuctionLabelsUseCase {
+	return label.NewPrintAuctionLabelsUseCase(
+		u.repo.NewAuctionRepository(),
+		u.repo.NewItemRepository(),
+		u.repo.NewFishermanRepository(),
+		u.service.NewLabelRenderer(),
+		u.service.NewClock(),
+		u.cfg.GetFrontendURL(),
+		u.cfg.GetLabelSigningKey(),
+		u.cfg.GetLabelCodeTTL(),
+	)
+}
+
+func (u *useCaseRegistry) NewPrintItemLabelUseCase() label.PrintItemLabelUseCase {
+	return label.NewPrintItemLabelUseCase(
+		u.repo.NewItemRepository(),
+		u.repo.NewFishermanRepository(),
+		u.service.NewLabelRenderer(),
+		u.service.NewClock(),
+		u.cfg.GetFrontendURL(),
+		u.cfg.GetLabelSigningKey(),
+		u.cfg.GetLabelCodeTTL(),
+	)
+}
+
+func (u *useCaseRegistry) NewScanLabelUseCase() label.ScanLabelUseCase {
+	return label.NewScanLabelUseCase(
+		u.repo.NewItemRepository(),
+		u.repo.NewAuctionRepository(),
+		u.service.NewClock(),
+		u.cfg.GetLabelSigningKey(),
+	)
+}
+
+func (u *useCaseRegistry) NewEnterResultsUseCase() result.EnterResultsUseCase {
+	return result.NewEnterResultsUseCase(
+		u.repo.NewAuctionRepository(),
+		u.repo.NewItemRepository(),
+		u.repo.NewBuyerRepository(),
+		u.repo.NewBidRepository(),
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewTransactionManager(),
+		u.repo.NewItemCacheInvalidator(),
+	)
+}
+
+func (u *useCaseRegistry) NewRequestCorrectionUseCase() result.RequestCorrectionUseCase {
+	return result.NewRequestCorrectionUseCase(
+		u.repo.NewItemRepository(),
+		u.repo.NewBuyerRepository(),
+		u.repo.NewBidRepository(),
+		u.repo.NewResultCorrectionRepository(),
+	)
+}
+
+func (u *useCaseRegistry) NewApproveCorrectionUseCase() result.ApproveCorrectionUseCase {
+	return result.NewApproveCorrectionUseCase(
+		u.repo.NewResultCorrectionRepository(),
+		u.repo.NewAuctionRepository(),
+		u.repo.NewBidRepository(),
+		u.repo.NewInvoiceRepository(),
+		u.repo.NewCreditNoteRepository(),
+		u.repo.NewPaymentRepository(),
+		u.repo.NewBuyerChargeRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+		u.repo.NewItemCacheInvalidator(),
+	)
+}
+
+func (u *useCaseRegistry) NewRejectCorrectionUseCase() result.RejectCorrectionUseCase {
+	return result.NewRejectCorrectionUseCase(
+		u.repo.NewResultCorrectionRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewListCorrectionsUseCase() result.ListCorrectionsUseCase {
+	return result.NewListCorrectionsUseCase(u.repo.NewResultCorrectionRepository())
+}
+
+func (u *useCaseRegistry) NewCreateVenueRegistrationUseCase() registration.CreateRegistrationUseCase {
+	return registration.NewCreateRegistrationUseCase(
+		u.repo.NewVenueRegistrationRepository(),
+		u.repo.NewBuyerRepository(),
+		u.repo.NewVenueRepository(),
+	)
+}
+
+func (u *useCaseRegistry) NewUpdateVenueRegistrationUseCase() registration.UpdateRegistrationUseCase {
+	return registration.NewUpdateRegistrationUseCase(u.repo.NewVenueRegistrationRepository())
+}
+
+func (u *useCaseRegistry) NewListVenueRegistrationsUseCase() registration.ListRegistrationsUseCase {
+	return registration.NewListRegistrationsUseCase(u.repo.NewVenueRegistrationRepository())
+}
+
+func (u *useCaseRegistry) NewAuthorizeAuctionViewUseCase() registration.AuthorizeAuctionViewUseCase {
+	return registration.NewAuthorizeAuctionViewUseCase(
+		u.repo.NewAuctionRepository(),
+		u.repo.NewVenueRegistrationRepository(),
+		u.service.NewClock(),
+		u.cfg.GetVenueRegistrationRequiredToView(),
+	)
+}
+
 func (u *useCaseRegistry) NewLoginUseCase() auth.LoginUseCase {
-	return auth.NewLoginUseCase(u.repo.NewAdminRepository(), u.service.NewClock())
+	return auth.NewLoginUseCase(
+		u.repo.NewAdminRepository(),
+		u.repo.NewAdminTwoFactorRepository(),
+		u.service.NewClock(),
+		u.cfg.GetTOTPEncryptionKey(),
+	)
 }
 
 func (u *useCaseRegistry) NewCreateVenueUseCase() venue.CreateVenueUseCase {
@@ -222,9 +717,109 @@ func (u *useCaseRegistry) NewBuyerUpdatePasswordUseCase() buyer.UpdatePasswordUs
 	return buyer.NewUpdatePasswordUseCase(u.repo.NewAuthenticationRepository(), u.repo.NewSessionRepository())
 }
 
+func (u *useCaseRegistry) NewUpdateBuyerProfileUseCase() buyer.UpdateProfileUseCase {
+	return buyer.NewUpdateProfileUseCase(u.repo.NewBuyerRepository(), u.repo.NewAuthenticationRepository())
+}
+
+func (u *useCaseRegistry) NewRequestBuyerEmailChangeUseCase() buyer.RequestEmailChangeUseCase {
+	return buyer.NewRequestEmailChangeUseCase(
+		u.repo.NewAuthenticationRepository(),
+		u.repo.NewEmailChangeRepository(),
+		u.repo.NewOutboxRepository(),
+		u.cfg.GetFrontendURL(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewConfirmBuyerEmailChangeUseCase() buyer.ConfirmEmailChangeUseCase {
+	return buyer.NewConfirmEmailChangeUseCase(
+		u.repo.NewAuthenticationRepository(),
+		u.repo.NewEmailChangeRepository(),
+		u.repo.NewOutboxRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewListBuyerLoginsUseCase() buyer.ListLoginsUseCase {
+	return buyer.NewListLoginsUseCase(u.repo.NewAuthenticationRepository())
+}
+
+func (u *useCaseRegistry) NewCreateBuyerLoginUseCase() buyer.CreateLoginUseCase {
+	return buyer.NewCreateLoginUseCase(u.repo.NewBuyerRepository(), u.repo.NewAuthenticationRepository())
+}
+
+func (u *useCaseRegistry) NewUpdateBuyerLoginUseCase() buyer.UpdateLoginUseCase {
+	return buyer.NewUpdateLoginUseCase(
+		u.repo.NewAuthenticationRepository(),
+		u.repo.NewSessionRepository(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewRegisterBuyerUseCase() buyer.RegisterBuyerUseCase {
+	return buyer.NewRegisterBuyerUseCase(
+		u.repo.NewBuyerRepository(),
+		u.repo.NewAuthenticationRepository(),
+		u.repo.NewEmailVerificationRepository(),
+		u.repo.NewOutboxRepository(),
+		u.cfg.GetFrontendURL(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewVerifyBuyerRegistrationEmailUseCase() buyer.VerifyRegistrationEmailUseCase {
+	return buyer.NewVerifyRegistrationEmailUseCase(
+		u.repo.NewBuyerRepository(),
+		u.repo.NewEmailVerificationRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewResendBuyerRegistrationVerificationUseCase() buyer.ResendRegistrationVerificationUseCase {
+	return buyer.NewResendRegistrationVerificationUseCase(
+		u.repo.NewBuyerRepository(),
+		u.repo.NewAuthenticationRepository(),
+		u.repo.NewEmailVerificationRepository(),
+		u.repo.NewOutboxRepository(),
+		u.cfg.GetFrontendURL(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewListBuyerApplicationsUseCase() buyer.ListApplicationsUseCase {
+	return buyer.NewListApplicationsUseCase(u.repo.NewBuyerRepository())
+}
+
+func (u *useCaseRegistry) NewApproveBuyerApplicationUseCase() buyer.ApproveApplicationUseCase {
+	return buyer.NewApproveApplicationUseCase(
+		u.repo.NewBuyerRepository(),
+		u.repo.NewAuthenticationRepository(),
+		u.repo.NewOutboxRepository(),
+		u.cfg.GetFrontendURL(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewRejectBuyerApplicationUseCase() buyer.RejectApplicationUseCase {
+	return buyer.NewRejectApplicationUseCase(
+		u.repo.NewBuyerRepository(),
+		u.repo.NewAuthenticationRepository(),
+		u.repo.NewOutboxRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
 func (u *useCaseRegistry) NewRequestPasswordResetUseCase() auth.RequestPasswordResetUseCase {
 	return auth.NewRequestPasswordResetUseCase(
 		u.repo.NewBuyerRepository(),
+		u.repo.NewAuthenticationRepository(),
 		u.repo.PasswordReset(),
 		u.repo.NewOutboxRepository(),
 		u.cfg.GetFrontendURL(),
@@ -277,3 +872,85 @@ func (u *useCaseRegistry) NewSubscribeNotificationUseCase() notification.Subscri
 func (u *useCaseRegistry) NewCreateAdminUseCase() admin.CreateAdminUseCase {
 	return admin.NewCreateAdminUseCase(u.repo.NewAdminRepository())
 }
+
+func (u *useCaseRegistry) NewListAdminsUseCase() admin.ListAdminsUseCase {
+	return admin.NewListAdminsUseCase(u.repo.NewAdminRepository())
+}
+
+func (u *useCaseRegistry) NewUpdateAdminRoleUseCase() admin.UpdateRoleUseCase {
+	return admin.NewUpdateRoleUseCase(u.repo.NewAdminRepository(), u.repo.NewSessionRepository())
+}
+
+func (u *useCaseRegistry) NewSetAdminDisabledUseCase() admin.SetDisabledUseCase {
+	return admin.NewSetDisabledUseCase(
+		u.repo.NewAdminRepository(),
+		u.repo.NewSessionRepository(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewUnlockAdminUseCase() admin.UnlockUseCase {
+	return admin.NewUnlockUseCase(u.repo.NewAdminRepository())
+}
+
+func (u *useCaseRegistry) NewForceAdminPasswordResetUseCase() admin.ForcePasswordResetUseCase {
+	return admin.NewForcePasswordResetUseCase(
+		u.repo.NewAdminRepository(),
+		u.repo.NewSessionRepository(),
+		u.repo.PasswordReset(),
+		u.repo.NewOutboxRepository(),
+		u.cfg.GetFrontendURL(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+	)
+}
+
+func (u *useCaseRegistry) NewGetAdminTwoFactorStatusUseCase() admin.GetTwoFactorStatusUseCase {
+	return admin.NewGetTwoFactorStatusUseCase(u.repo.NewAdminTwoFactorRepository())
+}
+
+func (u *useCaseRegistry) NewSetupAdminTwoFactorUseCase() admin.SetupTwoFactorUseCase {
+	return admin.NewSetupTwoFactorUseCase(
+		u.repo.NewAdminRepository(),
+		u.repo.NewAdminTwoFactorRepository(),
+		u.cfg.GetTOTPEncryptionKey(),
+	)
+}
+
+func (u *useCaseRegistry) NewEnableAdminTwoFactorUseCase() admin.EnableTwoFactorUseCase {
+	return admin.NewEnableTwoFactorUseCase(
+		u.repo.NewAdminTwoFactorRepository(),
+		u.repo.NewTransactionManager(),
+		u.service.NewClock(),
+		u.cfg.GetTOTPEncryptionKey(),
+	)
+}
+
+func (u *useCaseRegistry) NewDisableAdminTwoFactorUseCase() admin.DisableTwoFactorUseCase {
+	return admin.NewDisableTwoFactorUseCase(
+		u.repo.NewAdminRepository(),
+		u.repo.NewAdminTwoFactorRepository(),
+		u.service.NewClock(),
+		u.cfg.GetTOTPEncryptionKey(),
+	)
+}
+
+func (u *useCaseRegistry) NewResetAdminTwoFactorUseCase() admin.ResetTwoFactorUseCase {
+	return admin.NewResetTwoFactorUseCase(
+		u.repo.NewAdminRepository(),
+		u.repo.NewAdminTwoFactorRepository(),
+		u.repo.NewSessionRepository(),
+	)
+}
+
+func (u *useCaseRegistry) NewListSessionsUseCase() session.ListSessionsUseCase {
+	return session.NewListSessionsUseCase(u.repo.NewSessionRepository())
+}
+
+func (u *useCaseRegistry) NewRevokeSessionUseCase() session.RevokeSessionUseCase {
+	return session.NewRevokeSessionUseCase(u.repo.NewSessionRepository())
+}
+
+func (u *useCaseRegistry) NewRevokeSessionsUseCase() session.RevokeSessionsUseCase {
+	return session.NewRevokeSessionsUseCase(u.repo.NewSessionRepository())
+}
@@ -0,0 +1,143 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/accounting"
+)
+
+// AccountingHandler handles admin HTTP requests related to accounting software export.
+type AccountingHandler struct {
+	getSettingsUseCase    accounting.GetAccountingSettingsUseCase
+	updateSettingsUseCase accounting.UpdateAccountingSettingsUseCase
+	exportUseCase         accounting.ExportJournalUseCase
+}
+
+// NewAccountingHandler creates a new AccountingHandler instance.
+func NewAccountingHandler(r registry.UseCase) *AccountingHandler {
+	return &AccountingHandler{
+		getSettingsUseCase:    r.NewGetAccountingSettingsUseCase(),
+		updateSettingsUseCase: r.NewUpdateAccountingSettingsUseCase(),
+		exportUseCase:         r.NewExportJournalUseCase(),
+	}
+}
+
+// GetSettings handles the request to get the account titles of a venue.
+func (h *AccountingHandler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	venueID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid venue ID")
+		return
+	}
+
+	settings, err := h.getSettingsUseCase.Execute(r.Context(), venueID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toAccountingSettingsResponse(settings))
+}
+
+// UpdateSettings handles the request to replace the account titles of a venue.
+func (h *AccountingHandler) UpdateSettings(w http.ResponseWriter, r *http.Request) {
+	venueID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid venue ID")
+		return
+	}
+
+	var req request.UpdateAccountingSettings
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	settings, err := h.updateSettingsUseCase.Execute(r.Context(), &model.AccountingSettings{
+		VenueID:           venueID,
+		SalesAccount:      req.SalesAccount,
+		ReceivableAccount: req.ReceivableAccount,
+		CashAccount:       req.CashAccount,
+		DepositAccount:    req.DepositAccount,
+		PurchaseAccount:   req.PurchaseAccount,
+		PayableAccount:    req.PayableAccount,
+		CommissionAccount: req.CommissionAccount,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toAccountingSettingsResponse(settings))
+}
+
+// ExportJournal handles the request to download journal entries as CSV.
+// Query: venue_id, from, to (YYYY-MM-DD, inclusive) and format (yayoi or freee).
+func (h *AccountingHandler) ExportJournal(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	venueID, err := strconv.Atoi(q.Get("venue_id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid venue_id")
+		return
+	}
+	from, err := time.Parse("2006-01-02", q.Get("from"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid from format (YYYY-MM-DD)")
+		return
+	}
+	to, err := time.Parse("2006-01-02", q.Get("to"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid to format (YYYY-MM-DD)")
+		return
+	}
+	format := model.JournalFormat(q.Get("format"))
+
+	data, err := h.exportUseCase.Execute(r.Context(), &accounting.ExportJournalInput{
+		VenueID: venueID,
+		From:    from,
+		To:      to,
+		Format:  format,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	contentType := "text/csv; charset=UTF-8"
+	if format == model.JournalFormatYayoi {
+		contentType = "text/csv; charset=Shift_JIS"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="journal_%s_%d_%s_%s.csv"`,
+		format, venueID, from.Format("20060102"), to.Format("20060102")))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func toAccountingSettingsResponse(s *model.AccountingSettings) response.AccountingSettings {
+	return response.AccountingSettings{
+		VenueID:           s.VenueID,
+		SalesAccount:      s.SalesAccount,
+		ReceivableAccount: s.ReceivableAccount,
+		CashAccount:       s.CashAccount,
+		DepositAccount:    s.DepositAccount,
+		PurchaseAccount:   s.PurchaseAccount,
+		PayableAccount:    s.PayableAccount,
+		CommissionAccount: s.CommissionAccount,
+	}
+}
+
+// RegisterRoutes registers the admin accounting handler routes to the given mux.
+func (h *AccountingHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /venues/{id}/accounting-settings", middleware.RequireAdminPermission(model.AdminPermissionViewAccounting, h.GetSettings))
+	mux.HandleFunc("PUT /venues/{id}/accounting-settings", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.UpdateSettings))
+	mux.HandleFunc("GET /accounting/journal", middleware.RequireAdminPermission(model.AdminPermissionViewAccounting, h.ExportJournal))
+}
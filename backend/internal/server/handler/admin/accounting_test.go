@@ -0,0 +1,148 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/accounting"
+)
+
+func TestAccountingHandler_GetSettings(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "1", wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", wantStatus: http.StatusBadRequest},
+		{name: "VenueNotFound", pathID: "9", execErr: &domainErrors.NotFoundError{Resource: "Venue", ID: 9}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				GetAccountingSettingsUC: &mock.MockGetAccountingSettingsUseCase{
+					ExecuteFunc: func(_ context.Context, venueID int) (*model.AccountingSettings, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return model.DefaultAccountingSettings(venueID), nil
+					},
+				},
+			}
+			h := admin.NewAccountingHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/venues/"+tt.pathID+"/accounting-settings", nil)
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.GetSettings(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestAccountingHandler_UpdateSettings(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", body: `{"sales_account":"水産物売上"}`, wantStatus: http.StatusOK},
+		{name: "InvalidJSON", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "ValidationError", body: `{}`, execErr: &domainErrors.ValidationError{Field: "sales_account", Message: "account is required"}, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *model.AccountingSettings
+			mockReg := &mock.MockRegistry{
+				UpdateAccountingSettingsUC: &mock.MockUpdateAccountingSettingsUseCase{
+					ExecuteFunc: func(_ context.Context, s *model.AccountingSettings) (*model.AccountingSettings, error) {
+						got = s
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return s, nil
+					},
+				},
+			}
+			h := admin.NewAccountingHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/venues/3/accounting-settings", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", "3")
+			w := httptest.NewRecorder()
+
+			h.UpdateSettings(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus == http.StatusOK && (got.VenueID != 3 || got.SalesAccount != "水産物売上") {
+				t.Errorf("unexpected settings: %+v", got)
+			}
+		})
+	}
+}
+
+func TestAccountingHandler_ExportJournal(t *testing.T) {
+	tests := []struct {
+		name            string
+		query           string
+		execErr         error
+		wantStatus      int
+		wantContentType string
+	}{
+		{name: "Yayoi", query: "?venue_id=1&from=2024-03-01&to=2024-03-31&format=yayoi", wantStatus: http.StatusOK, wantContentType: "text/csv; charset=Shift_JIS"},
+		{name: "Freee", query: "?venue_id=1&from=2024-03-01&to=2024-03-31&format=freee", wantStatus: http.StatusOK, wantContentType: "text/csv; charset=UTF-8"},
+		{name: "InvalidVenue", query: "?from=2024-03-01&to=2024-03-31&format=freee", wantStatus: http.StatusBadRequest},
+		{name: "InvalidFrom", query: "?venue_id=1&from=03/01&to=2024-03-31&format=freee", wantStatus: http.StatusBadRequest},
+		{name: "InvalidTo", query: "?venue_id=1&from=2024-03-01&format=freee", wantStatus: http.StatusBadRequest},
+		{
+			name:       "InvalidFormat",
+			query:      "?venue_id=1&from=2024-03-01&to=2024-03-31&format=xlsx",
+			execErr:    &domainErrors.ValidationError{Field: "format", Message: "must be yayoi or freee"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ExportJournalUC: &mock.MockExportJournalUseCase{
+					ExecuteFunc: func(_ context.Context, _ *accounting.ExportJournalInput) ([]byte, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return []byte("csv"), nil
+					},
+				},
+			}
+			h := admin.NewAccountingHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/accounting/journal"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.ExportJournal(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantContentType != "" && w.Header().Get("Content-Type") != tt.wantContentType {
+				t.Errorf("expected content type %q, got %q", tt.wantContentType, w.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
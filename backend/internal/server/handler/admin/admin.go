@@ -3,7 +3,9 @@ package admin
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
+	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
@@ -15,12 +17,34 @@ import (
 // AdminHandler handles HTTP requests related to administration.
 type AdminHandler struct {
 	updatePasswordUseCase admin.UpdatePasswordUseCase
+	listUseCase           admin.ListAdminsUseCase
+	createUseCase         admin.CreateAdminUseCase
+	updateRoleUseCase     admin.UpdateRoleUseCase
+	setDisabledUseCase    admin.SetDisabledUseCase
+	unlockUseCase         admin.UnlockUseCase
+	forceResetUseCase     admin.ForcePasswordResetUseCase
+	twoFactorStatus       admin.GetTwoFactorStatusUseCase
+	setupTwoFactor        admin.SetupTwoFactorUseCase
+	enableTwoFactor       admin.EnableTwoFactorUseCase
+	disableTwoFactor      admin.DisableTwoFactorUseCase
+	resetTwoFactor        admin.ResetTwoFactorUseCase
 }
 
 // NewAdminHandler creates a new AdminHandler instance.
 func NewAdminHandler(r registry.UseCase) *AdminHandler {
 	return &AdminHandler{
 		updatePasswordUseCase: r.NewAdminUpdatePasswordUseCase(),
+		listUseCase:           r.NewListAdminsUseCase(),
+		createUseCase:         r.NewCreateAdminUseCase(),
+		updateRoleUseCase:     r.NewUpdateAdminRoleUseCase(),
+		setDisabledUseCase:    r.NewSetAdminDisabledUseCase(),
+		unlockUseCase:         r.NewUnlockAdminUseCase(),
+		forceResetUseCase:     r.NewForceAdminPasswordResetUseCase(),
+		twoFactorStatus:       r.NewGetAdminTwoFactorStatusUseCase(),
+		setupTwoFactor:        r.NewSetupAdminTwoFactorUseCase(),
+		enableTwoFactor:       r.NewEnableAdminTwoFactorUseCase(),
+		disableTwoFactor:      r.NewDisableAdminTwoFactorUseCase(),
+		resetTwoFactor:        r.NewResetAdminTwoFactorUseCase(),
 	}
 }
 
@@ -47,7 +71,248 @@ func (h *AdminHandler) UpdatePassword(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(response.Message{Message: "Password updated successfully"})
 }
 
+// List handles the request to list the admins and their roles.
+func (h *AdminHandler) List(w http.ResponseWriter, r *http.Request) {
+	admins, err := h.listUseCase.Execute(r.Context())
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.Admin, len(admins))
+	for i := range admins {
+		resp[i] = toAdminResponse(&admins[i])
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// Create handles the request to add another admin with a role.
+func (h *AdminHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req request.CreateAdmin
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	a, err := h.createUseCase.Execute(r.Context(), req.Email, req.Password, model.AdminRole(req.Role))
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusCreated, toAdminResponse(a))
+}
+
+// UpdateRole handles the request to change the role of another admin.
+func (h *AdminHandler) UpdateRole(w http.ResponseWriter, r *http.Request) {
+	actorID, id, ok := actorAndTargetAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	var req request.UpdateAdminRole
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	a, err := h.updateRoleUseCase.Execute(r.Context(), actorID, id, model.AdminRole(req.Role))
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toAdminResponse(a))
+}
+
+// Disable handles the request to disable another admin and sign them out.
+func (h *AdminHandler) Disable(w http.ResponseWriter, r *http.Request) {
+	h.setDisabled(w, r, true)
+}
+
+// Enable handles the request to enable a disabled admin again.
+func (h *AdminHandler) Enable(w http.ResponseWriter, r *http.Request) {
+	h.setDisabled(w, r, false)
+}
+
+func (h *AdminHandler) setDisabled(w http.ResponseWriter, r *http.Request, disabled bool) {
+	actorID, id, ok := actorAndTargetAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	a, err := h.setDisabledUseCase.Execute(r.Context(), actorID, id, disabled)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toAdminResponse(a))
+}
+
+// Unlock handles the request to lift the login lockout of an admin.
+func (h *AdminHandler) Unlock(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid admin ID")
+		return
+	}
+
+	a, err := h.unlockUseCase.Execute(r.Context(), id)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toAdminResponse(a))
+}
+
+// ForcePasswordReset handles the request to make another admin set a new password.
+func (h *AdminHandler) ForcePasswordReset(w http.ResponseWriter, r *http.Request) {
+	actorID, id, ok := actorAndTargetAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.forceResetUseCase.Execute(r.Context(), actorID, id); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, response.Message{Message: "Password reset email sent"})
+}
+
+// ResetTwoFactor handles the request to remove the two-factor authentication of an admin who lost their device.
+func (h *AdminHandler) ResetTwoFactor(w http.ResponseWriter, r *http.Request) {
+	actorID, id, ok := actorAndTargetAdmin(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.resetTwoFactor.Execute(r.Context(), actorID, id); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, response.Message{Message: "Two-factor authentication reset"})
+}
+
+// GetTwoFactor handles the request for the signed-in admin's two-factor authentication status.
+func (h *AdminHandler) GetTwoFactor(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	status, err := h.twoFactorStatus.Execute(r.Context(), adminID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, response.TwoFactorStatus{
+		Enabled:                status.Enabled,
+		EnabledAt:              status.EnabledAt,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+	})
+}
+
+// SetupTwoFactor handles the request to start enrolling an authenticator app.
+func (h *AdminHandler) SetupTwoFactor(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	setup, err := h.setupTwoFactor.Execute(r.Context(), adminID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, response.TwoFactorSetup{
+		Secret:          setup.Secret,
+		ProvisioningURI: setup.ProvisioningURI,
+	})
+}
+
+// EnableTwoFactor handles the request to confirm enrollment with a code from the authenticator app.
+func (h *AdminHandler) EnableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.EnableTwoFactor
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	codes, err := h.enableTwoFactor.Execute(r.Context(), adminID, req.Code)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, response.TwoFactorEnabled{RecoveryCodes: codes})
+}
+
+// DisableTwoFactor handles the request to turn off the signed-in admin's two-factor authentication.
+func (h *AdminHandler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.DisableTwoFactor
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.disableTwoFactor.Execute(r.Context(), adminID, req.Password, req.Code); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, response.Message{Message: "Two-factor authentication disabled"})
+}
+
+// actorAndTargetAdmin returns the acting admin and the admin in the path, writing an error response when either is missing.
+func actorAndTargetAdmin(w http.ResponseWriter, r *http.Request) (actorID, id int, ok bool) {
+	actorID, ok = middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return 0, 0, false
+	}
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid admin ID")
+		return 0, 0, false
+	}
+	return actorID, id, true
+}
+
 // RegisterRoutes registers the admin handler routes to the given mux.
 func (h *AdminHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("PUT /password", h.UpdatePassword)
+	mux.HandleFunc("GET /2fa", h.GetTwoFactor)
+	mux.HandleFunc("POST /2fa/setup", h.SetupTwoFactor)
+	mux.HandleFunc("POST /2fa/enable", h.EnableTwoFactor)
+	mux.HandleFunc("POST /2fa/disable", h.DisableTwoFactor)
+	mux.HandleFunc("GET /admins", middleware.RequireAdminPermission(model.AdminPermissionManageAdmins, h.List))
+	mux.HandleFunc("POST /admins", middleware.RequireAdminPermission(model.AdminPermissionManageAdmins, h.Create))
+	mux.HandleFunc("PUT /admins/{id}/role", middleware.RequireAdminPermission(model.AdminPermissionManageAdmins, h.UpdateRole))
+	mux.HandleFunc("POST /admins/{id}/disable", middleware.RequireAdminPermission(model.AdminPermissionManageAdmins, h.Disable))
+	mux.HandleFunc("POST /admins/{id}/enable", middleware.RequireAdminPermission(model.AdminPermissionManageAdmins, h.Enable))
+	mux.HandleFunc("POST /admins/{id}/unlock", middleware.RequireAdminPermission(model.AdminPermissionManageAdmins, h.Unlock))
+	mux.HandleFunc("POST /admins/{id}/password-reset", middleware.RequireAdminPermission(model.AdminPermissionManageAdmins, h.ForcePasswordReset))
+	mux.HandleFunc("POST /admins/{id}/2fa/reset", middleware.RequireAdminPermission(model.AdminPermissionManageAdmins, h.ResetTwoFactor))
+}
+
+func toAdminResponse(a *model.Admin) response.Admin {
+	return response.Admin{
+		ID:                    a.ID,
+		Email:                 a.Email,
+		Role:                  string(a.Role),
+		LockedUntil:           a.LockedUntil,
+		DisabledAt:            a.DisabledAt,
+		PasswordResetRequired: a.PasswordResetRequired,
+		CreatedAt:             a.CreatedAt,
+	}
 }
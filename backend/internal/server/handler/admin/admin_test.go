@@ -6,12 +6,17 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
 	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	adminUsecase "github.com/seka/fish-auction/backend/internal/usecase/admin"
 )
 
 func TestAdminHandler_UpdatePassword(t *testing.T) {
@@ -79,3 +84,199 @@ func TestAdminHandler_RegisterRoutes(t *testing.T) {
 		}
 	})
 }
+
+func TestAdminHandler_ManageAdmins(t *testing.T) {
+	mockReg := &mock.MockRegistry{
+		ListAdminsUC: &mock.MockListAdminsUseCase{
+			ExecuteFunc: func(_ context.Context) ([]model.Admin, error) {
+				return []model.Admin{{ID: 1, Email: "boss@example.com", Role: model.AdminRoleSuperAdmin}}, nil
+			},
+		},
+		CreateAdminUC: &mock.MockCreateAdminUseCase{
+			ExecuteFunc: func(_ context.Context, email, _ string, role model.AdminRole) (*model.Admin, error) {
+				if !role.IsValid() {
+					return nil, &domainErrors.ValidationError{Field: "role", Message: "must be super_admin, clerk, accountant or read_only"}
+				}
+				return &model.Admin{ID: 2, Email: email, Role: role}, nil
+			},
+		},
+		UpdateAdminRoleUC: &mock.MockUpdateAdminRoleUseCase{
+			ExecuteFunc: func(_ context.Context, actorID, adminID int, role model.AdminRole) (*model.Admin, error) {
+				if actorID != 1 {
+					t.Errorf("expected actor 1, got %d", actorID)
+				}
+				return &model.Admin{ID: adminID, Role: role}, nil
+			},
+		},
+		SetAdminDisabledUC: &mock.MockSetAdminDisabledUseCase{
+			ExecuteFunc: func(_ context.Context, actorID, adminID int, disabled bool) (*model.Admin, error) {
+				if actorID == adminID && disabled {
+					return nil, &domainErrors.ValidationError{Field: "disabled", Message: "you cannot disable your own account"}
+				}
+				a := &model.Admin{ID: adminID}
+				if disabled {
+					a.DisabledAt = new(time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC))
+				}
+				return a, nil
+			},
+		},
+		UnlockAdminUC: &mock.MockUnlockAdminUseCase{
+			ExecuteFunc: func(_ context.Context, adminID int) (*model.Admin, error) {
+				if adminID == 9 {
+					return nil, &domainErrors.NotFoundError{Resource: "Admin", ID: adminID}
+				}
+				return &model.Admin{ID: adminID}, nil
+			},
+		},
+		ForceAdminPasswordResetUC: &mock.MockForceAdminPasswordResetUseCase{
+			ExecuteFunc: func(_ context.Context, actorID, adminID int) error {
+				if actorID != 1 || adminID != 2 {
+					t.Errorf("unexpected forced reset of %d by %d", adminID, actorID)
+				}
+				return nil
+			},
+		},
+		ResetAdminTwoFactorUC: &mock.MockResetAdminTwoFactorUseCase{
+			ExecuteFunc: func(_ context.Context, actorID, adminID int) error {
+				if actorID == adminID {
+					return &domainErrors.ValidationError{Field: "id", Message: "turn off your own two-factor authentication from your account settings"}
+				}
+				return nil
+			},
+		},
+	}
+	h := admin.NewAdminHandler(mockReg)
+	mux := http.NewServeMux()
+	h.RegisterRoutes(mux)
+
+	tests := []struct {
+		name       string
+		role       model.AdminRole
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "List", role: model.AdminRoleSuperAdmin, method: http.MethodGet, path: "/admins", wantStatus: http.StatusOK, wantBody: `"role":"super_admin"`},
+		{name: "Create", role: model.AdminRoleSuperAdmin, method: http.MethodPost, path: "/admins", body: `{"email":"clerk@example.com","password":"Password1!","role":"clerk"}`, wantStatus: http.StatusCreated, wantBody: `"role":"clerk"`},
+		{name: "CreateInvalidRole", role: model.AdminRoleSuperAdmin, method: http.MethodPost, path: "/admins", body: `{"email":"x@example.com","password":"Password1!","role":"owner"}`, wantStatus: http.StatusBadRequest},
+		{name: "UpdateRole", role: model.AdminRoleSuperAdmin, method: http.MethodPut, path: "/admins/2/role", body: `{"role":"accountant"}`, wantStatus: http.StatusOK, wantBody: `"role":"accountant"`},
+		{name: "UpdateRoleInvalidID", role: model.AdminRoleSuperAdmin, method: http.MethodPut, path: "/admins/x/role", body: `{"role":"accountant"}`, wantStatus: http.StatusBadRequest},
+		{name: "Disable", role: model.AdminRoleSuperAdmin, method: http.MethodPost, path: "/admins/2/disable", wantStatus: http.StatusOK, wantBody: `"disabled_at":"2026-05-01T10:00:00Z"`},
+		{name: "DisableSelf", role: model.AdminRoleSuperAdmin, method: http.MethodPost, path: "/admins/1/disable", wantStatus: http.StatusBadRequest},
+		{name: "Enable", role: model.AdminRoleSuperAdmin, method: http.MethodPost, path: "/admins/2/enable", wantStatus: http.StatusOK, wantBody: `"disabled_at":null`},
+		{name: "Unlock", role: model.AdminRoleSuperAdmin, method: http.MethodPost, path: "/admins/2/unlock", wantStatus: http.StatusOK, wantBody: `"locked_until":null`},
+		{name: "UnlockNotFound", role: model.AdminRoleSuperAdmin, method: http.MethodPost, path: "/admins/9/unlock", wantStatus: http.StatusNotFound},
+		{name: "ForcePasswordReset", role: model.AdminRoleSuperAdmin, method: http.MethodPost, path: "/admins/2/password-reset", wantStatus: http.StatusOK},
+		{name: "ResetTwoFactor", role: model.AdminRoleSuperAdmin, method: http.MethodPost, path: "/admins/2/2fa/reset", wantStatus: http.StatusOK},
+		{name: "ResetOwnTwoFactor", role: model.AdminRoleSuperAdmin, method: http.MethodPost, path: "/admins/1/2fa/reset", wantStatus: http.StatusBadRequest},
+		{name: "ClerkCannotResetTwoFactor", role: model.AdminRoleClerk, method: http.MethodPost, path: "/admins/2/2fa/reset", wantStatus: http.StatusForbidden},
+		{name: "ClerkCannotDisable", role: model.AdminRoleClerk, method: http.MethodPost, path: "/admins/2/disable", wantStatus: http.StatusForbidden},
+		{name: "ClerkCannotList", role: model.AdminRoleClerk, method: http.MethodGet, path: "/admins", wantStatus: http.StatusForbidden},
+		{name: "AccountantCannotChangeRoles", role: model.AdminRoleAccountant, method: http.MethodPut, path: "/admins/2/role", body: `{"role":"super_admin"}`, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := middleware.WithAdminRole(middleware.WithAdminID(context.Background(), 1), tt.role)
+			req := httptest.NewRequestWithContext(ctx, tt.method, tt.path, strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantBody != "" && !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("expected body to contain %s, got %s", tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAdminHandler_TwoFactor(t *testing.T) {
+	enabledAt := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	mockReg := &mock.MockRegistry{
+		GetAdminTwoFactorStatusUC: &mock.MockGetAdminTwoFactorStatusUseCase{
+			ExecuteFunc: func(_ context.Context, _ int) (*adminUsecase.TwoFactorStatus, error) {
+				return &adminUsecase.TwoFactorStatus{Enabled: true, EnabledAt: &enabledAt, RecoveryCodesRemaining: 8}, nil
+			},
+		},
+		SetupAdminTwoFactorUC: &mock.MockSetupAdminTwoFactorUseCase{
+			ExecuteFunc: func(_ context.Context, adminID int) (*adminUsecase.TwoFactorSetup, error) {
+				if adminID != 3 {
+					t.Errorf("expected the signed-in admin, got %d", adminID)
+				}
+				return &adminUsecase.TwoFactorSetup{Secret: "ABC", ProvisioningURI: "otpauth://totp/x"}, nil
+			},
+		},
+		EnableAdminTwoFactorUC: &mock.MockEnableAdminTwoFactorUseCase{
+			ExecuteFunc: func(_ context.Context, _ int, code string) ([]string, error) {
+				if code != "123456" {
+					return nil, &domainErrors.ValidationError{Field: "code", Message: "the code is incorrect"}
+				}
+				return []string{"aaaaa-bbbbb", "ccccc-ddddd"}, nil
+			},
+		},
+		DisableAdminTwoFactorUC: &mock.MockDisableAdminTwoFactorUseCase{
+			ExecuteFunc: func(_ context.Context, _ int, password, code string) error {
+				if password != "Password1!" {
+					return &domainErrors.UnauthorizedError{Message: "invalid password"}
+				}
+				if code == "" {
+					return &domainErrors.ValidationError{Field: "code", Message: "is required"}
+				}
+				return nil
+			},
+		},
+	}
+	h := admin.NewAdminHandler(mockReg)
+	mux := http.NewServeMux()
+	h.RegisterRoutes(mux)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "Status", method: http.MethodGet, path: "/2fa", wantStatus: http.StatusOK, wantBody: `"recovery_codes_remaining":8`},
+		{name: "Setup", method: http.MethodPost, path: "/2fa/setup", wantStatus: http.StatusOK, wantBody: `"provisioning_uri":"otpauth://totp/x"`},
+		{name: "Enable", method: http.MethodPost, path: "/2fa/enable", body: `{"code":"123456"}`, wantStatus: http.StatusOK, wantBody: `"recovery_codes":["aaaaa-bbbbb","ccccc-ddddd"]`},
+		{name: "EnableWrongCode", method: http.MethodPost, path: "/2fa/enable", body: `{"code":"000000"}`, wantStatus: http.StatusBadRequest},
+		{name: "EnableInvalidJSON", method: http.MethodPost, path: "/2fa/enable", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "Disable", method: http.MethodPost, path: "/2fa/disable", body: `{"password":"Password1!","code":"123456"}`, wantStatus: http.StatusOK},
+		{name: "DisableWrongPassword", method: http.MethodPost, path: "/2fa/disable", body: `{"password":"wrong","code":"123456"}`, wantStatus: http.StatusUnauthorized},
+		{name: "DisableWithoutCode", method: http.MethodPost, path: "/2fa/disable", body: `{"password":"Password1!"}`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 二要素認証の設定は権限に関係なく自分のアカウントに対して行える
+			ctx := middleware.WithAdminRole(middleware.WithAdminID(context.Background(), 3), model.AdminRoleReadOnly)
+			req := httptest.NewRequestWithContext(ctx, tt.method, tt.path, strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantBody != "" && !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("expected body to contain %s, got %s", tt.wantBody, w.Body.String())
+			}
+		})
+	}
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/2fa/setup", nil)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", w.Code)
+		}
+	})
+}
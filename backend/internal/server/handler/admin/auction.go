@@ -10,6 +10,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 	"github.com/seka/fish-auction/backend/internal/usecase/auction"
 	"github.com/seka/fish-auction/backend/internal/usecase/item"
@@ -226,9 +227,9 @@ func parseTimestamp(s *string) (*time.Time, error) {
 
 // RegisterRoutes registers the admin auction handler routes to the given mux.
 func (h *AuctionHandler) RegisterRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("POST /auctions", h.Create)
-	mux.HandleFunc("PUT /auctions/{id}", h.Update)
-	mux.HandleFunc("PATCH /auctions/{id}/status", h.UpdateStatus)
-	mux.HandleFunc("DELETE /auctions/{id}", h.Delete)
-	mux.HandleFunc("PUT /auctions/{id}/reorder", h.Reorder)
+	mux.HandleFunc("POST /auctions", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Create))
+	mux.HandleFunc("PUT /auctions/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Update))
+	mux.HandleFunc("PATCH /auctions/{id}/status", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.UpdateStatus))
+	mux.HandleFunc("DELETE /auctions/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Delete))
+	mux.HandleFunc("PUT /auctions/{id}/reorder", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Reorder))
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 )
@@ -396,7 +397,7 @@ func TestAuctionHandler_RegisterRoutes(t *testing.T) {
 	h.RegisterRoutes(mux)
 
 	// Create
-	req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/auctions", strings.NewReader(`{}`))
+	req := httptest.NewRequestWithContext(middleware.WithAdminRole(context.Background(), model.AdminRoleClerk), http.MethodPost, "/auctions", strings.NewReader(`{}`))
 	w := httptest.NewRecorder()
 	mux.ServeHTTP(w, req)
 	if w.Code != http.StatusCreated {
@@ -404,7 +405,7 @@ func TestAuctionHandler_RegisterRoutes(t *testing.T) {
 	}
 
 	// Reorder - Success (204)
-	req = httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/auctions/1/reorder", strings.NewReader(`{"ids":[1,2,3]}`))
+	req = httptest.NewRequestWithContext(middleware.WithAdminRole(context.Background(), model.AdminRoleClerk), http.MethodPut, "/auctions/1/reorder", strings.NewReader(`{"ids":[1,2,3]}`))
 	w = httptest.NewRecorder()
 	mux.ServeHTTP(w, req)
 	if w.Code != http.StatusNoContent {
@@ -412,7 +413,7 @@ func TestAuctionHandler_RegisterRoutes(t *testing.T) {
 	}
 
 	// Reorder - Invalid JSON (400)
-	req = httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/auctions/1/reorder", strings.NewReader(`invalid json`))
+	req = httptest.NewRequestWithContext(middleware.WithAdminRole(context.Background(), model.AdminRoleClerk), http.MethodPut, "/auctions/1/reorder", strings.NewReader(`invalid json`))
 	w = httptest.NewRecorder()
 	mux.ServeHTTP(w, req)
 	if w.Code != http.StatusBadRequest {
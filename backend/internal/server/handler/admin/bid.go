@@ -0,0 +1,164 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/bid"
+)
+
+// BidHandler handles admin HTTP requests related to bids entered on behalf of buyers and the bid history of lots.
+type BidHandler struct {
+	createUseCase  bid.CreateBidUseCase
+	historyUseCase bid.ListItemBidsUseCase
+	voidUseCase    bid.VoidBidUseCase
+}
+
+// NewBidHandler creates a new BidHandler instance.
+func NewBidHandler(r registry.UseCase) *BidHandler {
+	return &BidHandler{
+		createUseCase:  r.NewCreateBidUseCase(),
+		historyUseCase: r.NewListItemBidsUseCase(),
+		voidUseCase:    r.NewVoidBidUseCase(),
+	}
+}
+
+// EnterFloorBid handles the request to record a floor bid (or hammer price) for a named buyer.
+// The bid goes through the same validation as an online bid and is tagged with the clerk who entered it.
+func (h *BidHandler) EnterFloorBid(w http.ResponseWriter, r *http.Request) {
+	itemID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid item ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.EnterFloorBid
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	created, err := h.createUseCase.Execute(r.Context(), &model.Bid{
+		ItemID:    itemID,
+		BuyerID:   req.BuyerID,
+		Price:     model.NewBidPrice(req.Price),
+		Channel:   model.BidChannelFloor,
+		EnteredBy: &adminID,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, toBidResponse(created))
+}
+
+// History handles the request to page through every bid on a lot, newest first.
+func (h *BidHandler) History(w http.ResponseWriter, r *http.Request) {
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid auction ID")
+		return
+	}
+	itemID, err := strconv.Atoi(r.PathValue("itemId"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid item ID")
+		return
+	}
+	q, err := util.ParseBidHistoryQuery(r)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	page, err := h.historyUseCase.Execute(r.Context(), auctionID, itemID, q)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := response.BidHistory{Bids: make([]response.BidHistoryEntry, len(page.Bids)), NextCursor: page.NextCursor}
+	for i, b := range page.Bids {
+		resp.Bids[i] = response.BidHistoryEntry{
+			ID:           b.ID,
+			ItemID:       b.ItemID,
+			BuyerID:      b.BuyerID,
+			BuyerName:    b.BuyerName,
+			PaddleNumber: b.BuyerPaddle,
+			LoginID:      b.LoginID,
+			LoginName:    b.LoginName,
+			Price:        b.Price,
+			Channel:      string(b.Channel),
+			EnteredBy:    b.EnteredBy,
+			VoidedAt:     b.VoidedAt,
+			VoidedBy:     b.VoidedBy,
+			VoidReason:   b.VoidReason,
+			CreatedAt:    b.CreatedAt,
+		}
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// Void handles the request to void a mistaken bid, such as a fat-fingered price.
+// The lot's highest bid falls back to the best remaining bid.
+func (h *BidHandler) Void(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid bid ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.VoidBid
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	voided, err := h.voidUseCase.Execute(r.Context(), id, adminID, req.Reason)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toBidResponse(voided))
+}
+
+func toBidResponse(b *model.Bid) response.Bid {
+	return response.Bid{
+		ID:         b.ID,
+		ItemID:     b.ItemID,
+		BuyerID:    b.BuyerID,
+		LoginID:    b.LoginID,
+		Price:      b.Price.Amount(),
+		Channel:    string(b.Channel),
+		EnteredBy:  b.EnteredBy,
+		VoidedAt:   b.VoidedAt,
+		VoidedBy:   b.VoidedBy,
+		VoidReason: b.VoidReason,
+		CreatedAt:  b.CreatedAt,
+	}
+}
+
+// RegisterRoutes registers the admin bid handler routes to the given mux.
+func (h *BidHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /items/{id}/bids", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.EnterFloorBid))
+	mux.HandleFunc("GET /auctions/{id}/items/{itemId}/bids", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.History))
+	mux.HandleFunc("POST /bids/{id}/void", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Void))
+}
@@ -0,0 +1,235 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+)
+
+func TestBidHandler_EnterFloorBid(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		body       string
+		withAdmin  bool
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "10", body: `{"buyer_id":2,"price":3000}`, withAdmin: true, wantStatus: http.StatusCreated},
+		{name: "InvalidID", pathID: "abc", body: `{}`, withAdmin: true, wantStatus: http.StatusBadRequest},
+		{name: "NoAdmin", pathID: "10", body: `{}`, wantStatus: http.StatusUnauthorized},
+		{name: "InvalidJSON", pathID: "10", body: `{`, withAdmin: true, wantStatus: http.StatusBadRequest},
+		{
+			name:       "PriceTooLow",
+			pathID:     "10",
+			body:       `{"buyer_id":2,"price":100}`,
+			withAdmin:  true,
+			execErr:    &domainErrors.ValidationError{Field: "price", Message: "Bid price must be at least 3500"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "AuctionNotInProgress",
+			pathID:     "10",
+			body:       `{"buyer_id":2,"price":3000}`,
+			withAdmin:  true,
+			execErr:    &domainErrors.ConflictError{Message: "Auction is not in progress"},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *model.Bid
+			mockReg := &mock.MockRegistry{
+				CreateBidUC: &mock.MockCreateBidUseCase{
+					ExecuteFunc: func(_ context.Context, b *model.Bid) (*model.Bid, error) {
+						got = b
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						created := *b
+						created.ID = 1
+						return &created, nil
+					},
+				},
+			}
+			h := admin.NewBidHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/items/"+tt.pathID+"/bids", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			if tt.withAdmin {
+				req = req.WithContext(middleware.WithAdminID(req.Context(), 9))
+			}
+			w := httptest.NewRecorder()
+
+			h.EnterFloorBid(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+			if got.ItemID != 10 || got.BuyerID != 2 || got.Channel != model.BidChannelFloor || got.EnteredBy == nil || *got.EnteredBy != 9 {
+				t.Errorf("unexpected bid: %+v", got)
+			}
+			var resp response.Bid
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Channel != "floor" || resp.EnteredBy == nil || *resp.EnteredBy != 9 {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestBidHandler_History(t *testing.T) {
+	clerkID := 4
+	tests := []struct {
+		name       string
+		auctionID  string
+		query      string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", auctionID: "1", query: "?cursor=Nw", wantStatus: http.StatusOK},
+		{name: "InvalidAuctionID", auctionID: "abc", wantStatus: http.StatusBadRequest},
+		{name: "LimitTooLarge", auctionID: "1", query: "?limit=1000", wantStatus: http.StatusBadRequest},
+		{name: "NotFound", auctionID: "1", execErr: &domainErrors.NotFoundError{Resource: "Item", ID: 10}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ListItemBidsUC: &mock.MockListItemBidsUseCase{
+					ExecuteFunc: func(_ context.Context, _, _ int, q *model.BidHistoryQuery) (*model.BidHistoryPage, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						if q.BeforeID != 7 || q.Limit != model.DefaultBidHistoryLimit {
+							t.Errorf("unexpected query: %+v", q)
+						}
+						return &model.BidHistoryPage{Bids: []model.BidHistoryEntry{
+							{ID: 6, ItemID: 10, BuyerID: 3, BuyerName: "丸魚商店", Price: 3000, Channel: model.BidChannelFloor, EnteredBy: &clerkID},
+						}}, nil
+					},
+				},
+			}
+			h := admin.NewBidHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/auctions/"+tt.auctionID+"/items/10/bids"+tt.query, nil)
+			req.SetPathValue("id", tt.auctionID)
+			req.SetPathValue("itemId", "10")
+			w := httptest.NewRecorder()
+
+			h.History(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var resp response.BidHistory
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(resp.Bids) != 1 || resp.Bids[0].BuyerName != "丸魚商店" || resp.Bids[0].Channel != "floor" || resp.NextCursor != "" {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestBidHandler_Void(t *testing.T) {
+	voidedAt := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		pathID     string
+		body       string
+		withAdmin  bool
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "7", body: `{"reason":"桁の打ち間違い"}`, withAdmin: true, wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", body: `{}`, withAdmin: true, wantStatus: http.StatusBadRequest},
+		{name: "NoAdmin", pathID: "7", body: `{}`, wantStatus: http.StatusUnauthorized},
+		{name: "InvalidJSON", pathID: "7", body: `{`, withAdmin: true, wantStatus: http.StatusBadRequest},
+		{
+			name:       "MissingReason",
+			pathID:     "7",
+			body:       `{"reason":""}`,
+			withAdmin:  true,
+			execErr:    &domainErrors.ValidationError{Field: "reason", Message: "reason is required"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "AlreadyVoided",
+			pathID:     "7",
+			body:       `{"reason":"誤入力"}`,
+			withAdmin:  true,
+			execErr:    &domainErrors.ConflictError{Message: "bid is already voided"},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "NotFound",
+			pathID:     "7",
+			body:       `{"reason":"誤入力"}`,
+			withAdmin:  true,
+			execErr:    &domainErrors.NotFoundError{Resource: "Bid", ID: 7},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				VoidBidUC: &mock.MockVoidBidUseCase{
+					ExecuteFunc: func(_ context.Context, id, adminID int, reason string) (*model.Bid, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Bid{ID: id, ItemID: 10, BuyerID: 2, Price: model.NewBidPrice(99000), VoidedAt: &voidedAt, VoidedBy: &adminID, VoidReason: reason}, nil
+					},
+				},
+			}
+			h := admin.NewBidHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/bids/"+tt.pathID+"/void", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			if tt.withAdmin {
+				req = req.WithContext(middleware.WithAdminID(req.Context(), 9))
+			}
+			w := httptest.NewRecorder()
+
+			h.Void(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var resp response.Bid
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.ID != 7 || resp.VoidedAt == nil || resp.VoidedBy == nil || *resp.VoidedBy != 9 || resp.VoidReason != "桁の打ち間違い" {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
@@ -4,27 +4,50 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
 )
 
 // BuyerHandler handles admin HTTP requests related to buyers.
 type BuyerHandler struct {
-	createUseCase buyer.CreateBuyerUseCase
-	listUseCase   buyer.ListBuyersUseCase
-	deleteUseCase buyer.DeleteBuyerUseCase
+	createUseCase          buyer.CreateBuyerUseCase
+	listUseCase            buyer.ListBuyersUseCase
+	deleteUseCase          buyer.DeleteBuyerUseCase
+	updateUseCase          buyer.UpdateBuyerUseCase
+	paddleUseCase          buyer.UpdatePaddleNumberUseCase
+	creditUseCase          buyer.GetCreditUtilizationUseCase
+	termsUseCase           buyer.UpdateCreditTermsUseCase
+	suspendUseCase         buyer.SuspendBuyerUseCase
+	liftSuspensionUseCase  buyer.LiftSuspensionUseCase
+	listSuspensionsUseCase buyer.ListSuspensionsUseCase
+	applicationsUseCase    buyer.ListApplicationsUseCase
+	approveUseCase         buyer.ApproveApplicationUseCase
+	rejectUseCase          buyer.RejectApplicationUseCase
 }
 
 // NewBuyerHandler creates a new BuyerHandler instance.
 func NewBuyerHandler(r registry.UseCase) *BuyerHandler {
 	return &BuyerHandler{
-		createUseCase: r.NewCreateBuyerUseCase(),
-		listUseCase:   r.NewListBuyersUseCase(),
-		deleteUseCase: r.NewDeleteBuyerUseCase(),
+		createUseCase:          r.NewCreateBuyerUseCase(),
+		listUseCase:            r.NewListBuyersUseCase(),
+		deleteUseCase:          r.NewDeleteBuyerUseCase(),
+		updateUseCase:          r.NewUpdateBuyerUseCase(),
+		paddleUseCase:          r.NewUpdateBuyerPaddleNumberUseCase(),
+		creditUseCase:          r.NewGetBuyerCreditUseCase(),
+		termsUseCase:           r.NewUpdateBuyerCreditUseCase(),
+		suspendUseCase:         r.NewSuspendBuyerUseCase(),
+		liftSuspensionUseCase:  r.NewLiftBuyerSuspensionUseCase(),
+		listSuspensionsUseCase: r.NewListBuyerSuspensionsUseCase(),
+		applicationsUseCase:    r.NewListBuyerApplicationsUseCase(),
+		approveUseCase:         r.NewApproveBuyerApplicationUseCase(),
+		rejectUseCase:          r.NewRejectBuyerApplicationUseCase(),
 	}
 }
 
@@ -42,7 +65,7 @@ func (h *BuyerHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	util.WriteJSON(w, http.StatusCreated, response.Buyer{ID: buy.ID, Name: buy.Name})
+	util.WriteJSON(w, http.StatusCreated, toBuyerResponse(buy))
 }
 
 // List handles the request to list buyers.
@@ -54,11 +77,8 @@ func (h *BuyerHandler) List(w http.ResponseWriter, r *http.Request) {
 	}
 
 	resp := make([]response.Buyer, len(buyers))
-	for i, v := range buyers {
-		resp[i] = response.Buyer{
-			ID:   v.ID,
-			Name: v.Name,
-		}
+	for i := range buyers {
+		resp[i] = toBuyerResponse(&buyers[i])
 	}
 
 	util.WriteJSON(w, http.StatusOK, resp)
@@ -81,9 +101,344 @@ func (h *BuyerHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// Update handles the request to edit a buyer's details and business profile.
+func (h *BuyerHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+
+	var req request.UpdateBuyer
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+	expiresOn, err := parseOptionalDate(req.LicenseExpiresOn)
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid license_expires_on format (YYYY-MM-DD)")
+		return
+	}
+
+	buy, err := h.updateUseCase.Execute(r.Context(), id, &buyer.UpdateBuyerInput{
+		Name:                      req.Name,
+		Organization:              req.Organization,
+		ContactInfo:               req.ContactInfo,
+		BusinessName:              req.BusinessName,
+		InvoiceRegistrationNumber: req.InvoiceRegistrationNumber,
+		Address:                   req.Address,
+		Phone:                     req.Phone,
+		LicenseNumber:             req.LicenseNumber,
+		LicenseExpiresOn:          expiresOn,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toBuyerResponse(buy))
+}
+
+// UpdatePaddleNumber handles the request to assign or take back a buyer's paddle number.
+func (h *BuyerHandler) UpdatePaddleNumber(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+
+	var req request.UpdateBuyerPaddleNumber
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	buy, err := h.paddleUseCase.Execute(r.Context(), id, req.PaddleNumber)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toBuyerResponse(buy))
+}
+
+// GetCredit handles the request to show a buyer's credit limit and its utilization.
+func (h *BuyerHandler) GetCredit(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+
+	u, err := h.creditUseCase.Execute(r.Context(), id)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toBuyerCreditResponse(u))
+}
+
+// UpdateCredit handles the request to adjust a buyer's credit limit and deposit.
+func (h *BuyerHandler) UpdateCredit(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+
+	var req request.UpdateBuyerCredit
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	u, err := h.termsUseCase.Execute(r.Context(), id, model.CreditTerms{CreditLimit: req.CreditLimit, Deposit: req.Deposit})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toBuyerCreditResponse(u))
+}
+
+// Suspend handles the request to suspend a buyer from logging in or bidding.
+func (h *BuyerHandler) Suspend(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.SuspendBuyer
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+	startsAt, err := parseTimestamp(req.StartsAt)
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid starts_at format (RFC3339)")
+		return
+	}
+	endsAt, err := parseTimestamp(req.EndsAt)
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid ends_at format (RFC3339)")
+		return
+	}
+
+	s := &model.BuyerSuspension{
+		BuyerID:   id,
+		Severity:  model.SuspensionSeverity(req.Severity),
+		Reason:    req.Reason,
+		EndsAt:    endsAt,
+		CreatedBy: adminID,
+	}
+	if startsAt != nil {
+		s.StartsAt = *startsAt
+	}
+
+	created, err := h.suspendUseCase.Execute(r.Context(), s)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, toBuyerSuspensionResponse(created))
+}
+
+// ListSuspensions handles the request for the suspension audit list, optionally filtered by buyer
+// and limited to suspensions in force with active=true.
+func (h *BuyerHandler) ListSuspensions(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	var buyerID *int
+	if s := q.Get("buyer_id"); s != "" {
+		id, err := strconv.Atoi(s)
+		if err != nil {
+			util.WriteError(w, http.StatusBadRequest, "Invalid buyer_id")
+			return
+		}
+		buyerID = &id
+	}
+	activeOnly := false
+	if s := q.Get("active"); s != "" {
+		var err error
+		if activeOnly, err = strconv.ParseBool(s); err != nil {
+			util.WriteError(w, http.StatusBadRequest, "Invalid active")
+			return
+		}
+	}
+
+	suspensions, err := h.listSuspensionsUseCase.Execute(r.Context(), buyerID, activeOnly)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.BuyerSuspension, len(suspensions))
+	for i := range suspensions {
+		resp[i] = toBuyerSuspensionResponse(&suspensions[i])
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// LiftSuspension handles the request to end a suspension early.
+func (h *BuyerHandler) LiftSuspension(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid suspension ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	s, err := h.liftSuspensionUseCase.Execute(r.Context(), id, adminID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toBuyerSuspensionResponse(s))
+}
+
+// ListApplications handles the request for the self-registrations awaiting review.
+func (h *BuyerHandler) ListApplications(w http.ResponseWriter, r *http.Request) {
+	apps, err := h.applicationsUseCase.Execute(r.Context())
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.BuyerApplication, len(apps))
+	for i := range apps {
+		resp[i] = response.BuyerApplication{
+			Buyer:           toBuyerResponse(&apps[i].Buyer),
+			Email:           apps[i].Email,
+			EmailVerifiedAt: util.FormatTimestamp(apps[i].Registration.EmailVerifiedAt),
+		}
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// ApproveApplication handles the request to approve a self-registration so the buyer can bid.
+func (h *BuyerHandler) ApproveApplication(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	b, err := h.approveUseCase.Execute(r.Context(), id, adminID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toBuyerResponse(b))
+}
+
+// RejectApplication handles the request to turn down a self-registration with a reason for the applicant.
+func (h *BuyerHandler) RejectApplication(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.RejectBuyerApplication
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	b, err := h.rejectUseCase.Execute(r.Context(), id, adminID, req.Reason)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toBuyerResponse(b))
+}
+
 // RegisterRoutes registers the admin buyer handler routes to the given mux.
 func (h *BuyerHandler) RegisterRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("GET /buyers", h.List)
-	mux.HandleFunc("POST /buyers", h.Create)
-	mux.HandleFunc("DELETE /buyers/{id}", h.Delete)
+	mux.HandleFunc("GET /buyers", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.List))
+	mux.HandleFunc("POST /buyers", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Create))
+	mux.HandleFunc("PUT /buyers/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Update))
+	mux.HandleFunc("DELETE /buyers/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Delete))
+	mux.HandleFunc("PUT /buyers/{id}/paddle-number", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.UpdatePaddleNumber))
+	mux.HandleFunc("GET /buyers/{id}/credit", middleware.RequireAdminPermission(model.AdminPermissionViewAccounting, h.GetCredit))
+	mux.HandleFunc("PUT /buyers/{id}/credit", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.UpdateCredit))
+	mux.HandleFunc("POST /buyers/{id}/suspensions", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Suspend))
+	mux.HandleFunc("GET /buyer-suspensions", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.ListSuspensions))
+	mux.HandleFunc("POST /buyer-suspensions/{id}/lift", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.LiftSuspension))
+	mux.HandleFunc("GET /buyer-applications", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.ListApplications))
+	mux.HandleFunc("POST /buyer-applications/{id}/approve", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.ApproveApplication))
+	mux.HandleFunc("POST /buyer-applications/{id}/reject", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.RejectApplication))
+}
+
+func toBuyerResponse(b *model.Buyer) response.Buyer {
+	return response.Buyer{
+		ID:                        b.ID,
+		Name:                      b.Name,
+		PaddleNumber:              b.PaddleNumber,
+		CreditLimit:               b.CreditLimit,
+		Deposit:                   b.Deposit,
+		Organization:              b.Organization,
+		ContactInfo:               b.ContactInfo,
+		BusinessName:              b.BusinessName,
+		InvoiceRegistrationNumber: b.InvoiceRegistrationNumber,
+		Address:                   b.Address,
+		Phone:                     b.Phone,
+		LicenseNumber:             b.LicenseNumber,
+		LicenseExpiresOn:          formatOptionalDate(b.LicenseExpiresOn),
+		RegistrationStatus:        string(b.Registration.Status),
+		RejectionReason:           b.Registration.RejectionReason,
+		ReviewedBy:                b.Registration.ReviewedBy,
+		ReviewedAt:                util.FormatTimestamp(b.Registration.ReviewedAt),
+	}
+}
+
+func toBuyerCreditResponse(u *model.CreditUtilization) response.BuyerCredit {
+	return response.BuyerCredit{
+		BuyerID:        u.BuyerID,
+		CreditLimit:    u.CreditLimit,
+		Deposit:        u.Deposit,
+		LeadingBids:    u.LeadingBids,
+		UnpaidInvoices: u.UnpaidInvoices,
+		Committed:      u.Committed(),
+		Available:      u.Available(),
+	}
+}
+
+func toBuyerSuspensionResponse(s *model.BuyerSuspension) response.BuyerSuspension {
+	return response.BuyerSuspension{
+		ID:        s.ID,
+		BuyerID:   s.BuyerID,
+		Severity:  string(s.Severity),
+		Reason:    s.Reason,
+		StartsAt:  s.StartsAt.Format(time.RFC3339),
+		EndsAt:    util.FormatTimestamp(s.EndsAt),
+		CreatedBy: s.CreatedBy,
+		LiftedAt:  util.FormatTimestamp(s.LiftedAt),
+		LiftedBy:  s.LiftedBy,
+		CreatedAt: s.CreatedAt.Format(time.RFC3339),
+	}
 }
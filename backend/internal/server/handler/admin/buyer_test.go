@@ -7,12 +7,18 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
 )
 
 func TestAdminBuyerHandler_Create(t *testing.T) {
@@ -126,3 +132,575 @@ func TestAdminBuyerHandler_Delete(t *testing.T) {
 		}
 	})
 }
+
+func TestAdminBuyerHandler_Update(t *testing.T) {
+	tests := []struct {
+		name        string
+		pathID      string
+		body        string
+		execErr     error
+		wantStatus  int
+		wantExpires string
+	}{
+		{
+			name:        "Success",
+			pathID:      "1",
+			body:        `{"name":"B1","business_name":"丸魚商店","invoice_registration_number":"T1234567890123","license_number":"仲買-128","license_expires_on":"2027-03-31"}`,
+			wantStatus:  http.StatusOK,
+			wantExpires: "2027-03-31",
+		},
+		{name: "NoLicenseExpiry", pathID: "1", body: `{"name":"B1"}`, wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", pathID: "1", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidExpiry", pathID: "1", body: `{"name":"B1","license_expires_on":"31/03/2027"}`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "ValidationError",
+			pathID:     "1",
+			body:       `{"name":"B1","invoice_registration_number":"123"}`,
+			execErr:    &domainErrors.ValidationError{Field: "invoice_registration_number", Message: "must be T followed by 13 digits"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "NotFound",
+			pathID:     "9",
+			body:       `{"name":"B1"}`,
+			execErr:    &domainErrors.NotFoundError{Resource: "Buyer", ID: 9},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				UpdateBuyerUC: &mock.MockUpdateBuyerUseCase{
+					ExecuteFunc: func(_ context.Context, id int, input *buyer.UpdateBuyerInput) (*model.Buyer, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Buyer{ID: id, Name: input.Name, BuyerProfile: model.BuyerProfile{
+							BusinessName:              input.BusinessName,
+							InvoiceRegistrationNumber: input.InvoiceRegistrationNumber,
+							LicenseNumber:             input.LicenseNumber,
+							LicenseExpiresOn:          input.LicenseExpiresOn,
+						}}, nil
+					},
+				},
+			}
+			h := admin.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/buyers/"+tt.pathID, bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.Update(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var body response.Buyer
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			got := ""
+			if body.LicenseExpiresOn != nil {
+				got = *body.LicenseExpiresOn
+			}
+			if got != tt.wantExpires {
+				t.Errorf("expected license_expires_on %q, got %q", tt.wantExpires, got)
+			}
+		})
+	}
+}
+
+func TestAdminBuyerHandler_UpdatePaddleNumber(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "1", body: `{"paddle_number":"128"}`, wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", pathID: "1", body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "AlreadyAssigned",
+			pathID:     "1",
+			body:       `{"paddle_number":"128"}`,
+			execErr:    &domainErrors.ConflictError{Message: "paddle number is already assigned to another buyer"},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "NotFound",
+			pathID:     "9",
+			body:       `{"paddle_number":"128"}`,
+			execErr:    &domainErrors.NotFoundError{Resource: "Buyer", ID: 9},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				UpdateBuyerPaddleNumberUC: &mock.MockUpdatePaddleNumberUseCase{
+					ExecuteFunc: func(_ context.Context, id int, paddleNumber string) (*model.Buyer, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Buyer{ID: id, Name: "B1", PaddleNumber: paddleNumber}, nil
+					},
+				},
+			}
+			h := admin.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/buyers/"+tt.pathID+"/paddle-number", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.UpdatePaddleNumber(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var body struct {
+				PaddleNumber string `json:"paddle_number"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if body.PaddleNumber != "128" {
+				t.Errorf("expected paddle number 128, got %q", body.PaddleNumber)
+			}
+		})
+	}
+}
+
+func TestAdminBuyerHandler_UpdateCredit(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		body       string
+		execErr    error
+		wantStatus int
+		wantLimit  *int
+	}{
+		{name: "Success", pathID: "1", body: `{"credit_limit":500000,"deposit":100000}`, wantStatus: http.StatusOK, wantLimit: new(500000)},
+		{name: "RemoveLimit", pathID: "1", body: `{"credit_limit":null,"deposit":0}`, wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", pathID: "1", body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "NegativeLimit",
+			pathID:     "1",
+			body:       `{"credit_limit":-1}`,
+			execErr:    &domainErrors.ValidationError{Field: "credit_limit", Message: "must not be negative"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				UpdateBuyerCreditUC: &mock.MockUpdateCreditTermsUseCase{
+					ExecuteFunc: func(_ context.Context, id int, terms model.CreditTerms) (*model.CreditUtilization, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.CreditUtilization{BuyerID: id, CreditTerms: terms, LeadingBids: 120000, UnpaidInvoices: 80000}, nil
+					},
+				},
+			}
+			h := admin.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/buyers/"+tt.pathID+"/credit", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.UpdateCredit(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var body response.BuyerCredit
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if body.Committed != 200000 {
+				t.Errorf("expected committed 200000, got %d", body.Committed)
+			}
+			if tt.wantLimit == nil {
+				if body.CreditLimit != nil || body.Available != nil {
+					t.Errorf("expected no limit, got %+v", body)
+				}
+				return
+			}
+			if body.CreditLimit == nil || *body.CreditLimit != *tt.wantLimit || body.Available == nil || *body.Available != 300000 {
+				t.Errorf("unexpected credit: %+v", body)
+			}
+		})
+	}
+}
+
+func TestAdminBuyerHandler_GetCredit(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "1", wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", wantStatus: http.StatusBadRequest},
+		{name: "NotFound", pathID: "9", execErr: &domainErrors.NotFoundError{Resource: "Buyer", ID: 9}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				GetBuyerCreditUC: &mock.MockGetCreditUtilizationUseCase{
+					ExecuteFunc: func(_ context.Context, id int) (*model.CreditUtilization, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.CreditUtilization{BuyerID: id, CreditTerms: model.CreditTerms{CreditLimit: new(100000)}, LeadingBids: 150000}, nil
+					},
+				},
+			}
+			h := admin.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/buyers/"+tt.pathID+"/credit", nil)
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.GetCredit(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var body response.BuyerCredit
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if body.BuyerID != 1 || body.Available == nil || *body.Available != 0 {
+				t.Errorf("unexpected credit: %+v", body)
+			}
+		})
+	}
+}
+
+func TestAdminBuyerHandler_Suspend(t *testing.T) {
+	tests := []struct {
+		name        string
+		pathID      string
+		body        string
+		withContext bool
+		execErr     error
+		wantStatus  int
+		wantEndsAt  bool
+	}{
+		{
+			name:        "Indefinite",
+			pathID:      "1",
+			body:        `{"severity":"login","reason":"迷惑行為"}`,
+			withContext: true,
+			wantStatus:  http.StatusCreated,
+		},
+		{
+			name:        "Scheduled",
+			pathID:      "1",
+			body:        `{"severity":"bidding","reason":"未払い","starts_at":"2026-05-01T00:00:00+09:00","ends_at":"2026-06-01T00:00:00+09:00"}`,
+			withContext: true,
+			wantStatus:  http.StatusCreated,
+			wantEndsAt:  true,
+		},
+		{name: "InvalidID", pathID: "abc", body: `{}`, withContext: true, wantStatus: http.StatusBadRequest},
+		{name: "NotAuthenticated", pathID: "1", body: `{}`, wantStatus: http.StatusUnauthorized},
+		{name: "InvalidJSON", pathID: "1", body: `{`, withContext: true, wantStatus: http.StatusBadRequest},
+		{name: "InvalidEndsAt", pathID: "1", body: `{"severity":"login","reason":"x","ends_at":"tomorrow"}`, withContext: true, wantStatus: http.StatusBadRequest},
+		{
+			name:        "MissingReason",
+			pathID:      "1",
+			body:        `{"severity":"login"}`,
+			withContext: true,
+			execErr:     &domainErrors.ValidationError{Field: "reason", Message: "is required"},
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				SuspendBuyerUC: &mock.MockSuspendBuyerUseCase{
+					ExecuteFunc: func(_ context.Context, s *model.BuyerSuspension) (*model.BuyerSuspension, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						if s.BuyerID != 1 || s.CreatedBy != 9 {
+							t.Errorf("unexpected suspension: %+v", s)
+						}
+						created := *s
+						created.ID = 3
+						return &created, nil
+					},
+				},
+			}
+			h := admin.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/buyers/"+tt.pathID+"/suspensions", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			if tt.withContext {
+				req = req.WithContext(middleware.WithAdminID(req.Context(), 9))
+			}
+			w := httptest.NewRecorder()
+
+			h.Suspend(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+			var resp response.BuyerSuspension
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.ID != 3 || (resp.EndsAt != nil) != tt.wantEndsAt {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestAdminBuyerHandler_ListSuspensions(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		wantBuyer  *int
+		wantActive bool
+	}{
+		{name: "All", wantStatus: http.StatusOK},
+		{name: "ActiveForBuyer", query: "?buyer_id=1&active=true", wantStatus: http.StatusOK, wantBuyer: new(1), wantActive: true},
+		{name: "InvalidBuyerID", query: "?buyer_id=abc", wantStatus: http.StatusBadRequest},
+		{name: "InvalidActive", query: "?active=maybe", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ListBuyerSuspensionsUC: &mock.MockListSuspensionsUseCase{
+					ExecuteFunc: func(_ context.Context, buyerID *int, activeOnly bool) ([]model.BuyerSuspension, error) {
+						if (buyerID == nil) != (tt.wantBuyer == nil) || (buyerID != nil && *buyerID != *tt.wantBuyer) || activeOnly != tt.wantActive {
+							t.Errorf("unexpected arguments: %v %v", buyerID, activeOnly)
+						}
+						return []model.BuyerSuspension{{ID: 3, BuyerID: 1, Severity: model.SuspensionSeverityLogin, Reason: "未払い"}}, nil
+					},
+				},
+			}
+			h := admin.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/buyer-suspensions"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.ListSuspensions(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var resp []response.BuyerSuspension
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(resp) != 1 || resp[0].Reason != "未払い" {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestAdminBuyerHandler_LiftSuspension(t *testing.T) {
+	tests := []struct {
+		name        string
+		pathID      string
+		withContext bool
+		execErr     error
+		wantStatus  int
+	}{
+		{name: "Success", pathID: "3", withContext: true, wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", withContext: true, wantStatus: http.StatusBadRequest},
+		{name: "NotAuthenticated", pathID: "3", wantStatus: http.StatusUnauthorized},
+		{
+			name:        "AlreadyLifted",
+			pathID:      "3",
+			withContext: true,
+			execErr:     &domainErrors.ConflictError{Message: "Suspension has already been lifted"},
+			wantStatus:  http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				LiftBuyerSuspensionUC: &mock.MockLiftSuspensionUseCase{
+					ExecuteFunc: func(_ context.Context, id, adminID int) (*model.BuyerSuspension, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						now := time.Now()
+						return &model.BuyerSuspension{ID: id, BuyerID: 1, LiftedAt: &now, LiftedBy: &adminID}, nil
+					},
+				},
+			}
+			h := admin.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/buyer-suspensions/"+tt.pathID+"/lift", nil)
+			req.SetPathValue("id", tt.pathID)
+			if tt.withContext {
+				req = req.WithContext(middleware.WithAdminID(req.Context(), 9))
+			}
+			w := httptest.NewRecorder()
+
+			h.LiftSuspension(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestAdminBuyerHandler_ListApplications(t *testing.T) {
+	verified := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	mockReg := &mock.MockRegistry{
+		ListBuyerApplicationsUC: &mock.MockListApplicationsUseCase{
+			ExecuteFunc: func(_ context.Context) ([]model.BuyerApplication, error) {
+				return []model.BuyerApplication{{
+					Buyer: model.Buyer{ID: 7, Name: "魚一", Registration: model.BuyerRegistration{Status: model.BuyerRegistrationPending, EmailVerifiedAt: &verified}},
+					Email: "new@example.com",
+				}}, nil
+			},
+		},
+	}
+	h := admin.NewBuyerHandler(mockReg)
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/buyer-applications", nil)
+	w := httptest.NewRecorder()
+
+	h.ListApplications(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	var resp []response.BuyerApplication
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp) != 1 || resp[0].ID != 7 || resp[0].Email != "new@example.com" || resp[0].RegistrationStatus != "pending" ||
+		resp[0].EmailVerifiedAt == nil || *resp[0].EmailVerifiedAt != "2026-05-01T09:00:00Z" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestAdminBuyerHandler_ReviewApplication(t *testing.T) {
+	tests := []struct {
+		name        string
+		reject      bool
+		pathID      string
+		body        string
+		withContext bool
+		execErr     error
+		wantStatus  int
+		wantStatusV string
+	}{
+		{name: "Approve", pathID: "7", withContext: true, wantStatus: http.StatusOK, wantStatusV: "approved"},
+		{
+			name:        "ApproveUnverified",
+			pathID:      "7",
+			withContext: true,
+			execErr:     &domainErrors.ConflictError{Message: "Applicant has not verified their email address yet"},
+			wantStatus:  http.StatusConflict,
+		},
+		{name: "Reject", reject: true, pathID: "7", body: `{"reason":"買参権が確認できません"}`, withContext: true, wantStatus: http.StatusOK, wantStatusV: "rejected"},
+		{
+			name:        "RejectWithoutReason",
+			reject:      true,
+			pathID:      "7",
+			body:        `{}`,
+			withContext: true,
+			execErr:     &domainErrors.ValidationError{Field: "reason", Message: "is required"},
+			wantStatus:  http.StatusBadRequest,
+		},
+		{name: "RejectInvalidJSON", reject: true, pathID: "7", body: "invalid-json", withContext: true, wantStatus: http.StatusBadRequest},
+		{name: "InvalidID", pathID: "abc", withContext: true, wantStatus: http.StatusBadRequest},
+		{name: "NotAuthenticated", pathID: "7", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ApproveBuyerApplicationUC: &mock.MockApproveApplicationUseCase{
+					ExecuteFunc: func(_ context.Context, buyerID, adminID int) (*model.Buyer, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Buyer{ID: buyerID, Registration: model.BuyerRegistration{Status: model.BuyerRegistrationApproved, ReviewedBy: &adminID}}, nil
+					},
+				},
+				RejectBuyerApplicationUC: &mock.MockRejectApplicationUseCase{
+					ExecuteFunc: func(_ context.Context, buyerID, adminID int, reason string) (*model.Buyer, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Buyer{ID: buyerID, Registration: model.BuyerRegistration{Status: model.BuyerRegistrationRejected, RejectionReason: reason, ReviewedBy: &adminID}}, nil
+					},
+				},
+			}
+			h := admin.NewBuyerHandler(mockReg)
+
+			action := "/approve"
+			if tt.reject {
+				action = "/reject"
+			}
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/buyer-applications/"+tt.pathID+action, strings.NewReader(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			if tt.withContext {
+				req = req.WithContext(middleware.WithAdminID(req.Context(), 9))
+			}
+			w := httptest.NewRecorder()
+
+			if tt.reject {
+				h.RejectApplication(w, req)
+			} else {
+				h.ApproveApplication(w, req)
+			}
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatusV == "" {
+				return
+			}
+			var resp response.Buyer
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.RegistrationStatus != tt.wantStatusV || resp.ReviewedBy == nil || *resp.ReviewedBy != 9 {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/charge"
+)
+
+// ChargeHandler handles admin HTTP requests related to miscellaneous charges (諸掛).
+type ChargeHandler struct {
+	createItemUseCase charge.CreateChargeItemUseCase
+	updateItemUseCase charge.UpdateChargeItemUseCase
+	listItemsUseCase  charge.ListChargeItemsUseCase
+	addUseCase        charge.AddBuyerChargeUseCase
+	listUseCase       charge.ListBuyerChargesUseCase
+	deleteUseCase     charge.DeleteBuyerChargeUseCase
+}
+
+// NewChargeHandler creates a new ChargeHandler instance.
+func NewChargeHandler(r registry.UseCase) *ChargeHandler {
+	return &ChargeHandler{
+		createItemUseCase: r.NewCreateChargeItemUseCase(),
+		updateItemUseCase: r.NewUpdateChargeItemUseCase(),
+		listItemsUseCase:  r.NewListChargeItemsUseCase(),
+		addUseCase:        r.NewAddBuyerChargeUseCase(),
+		listUseCase:       r.NewListBuyerChargesUseCase(),
+		deleteUseCase:     r.NewDeleteBuyerChargeUseCase(),
+	}
+}
+
+// ListItems handles the request to list the charge catalog of a venue.
+func (h *ChargeHandler) ListItems(w http.ResponseWriter, r *http.Request) {
+	venueID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid venue ID")
+		return
+	}
+
+	items, err := h.listItemsUseCase.Execute(r.Context(), venueID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.ChargeItem, len(items))
+	for i := range items {
+		resp[i] = toChargeItemResponse(&items[i])
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// CreateItem handles the request to add an entry to a venue's charge catalog.
+func (h *ChargeHandler) CreateItem(w http.ResponseWriter, r *http.Request) {
+	venueID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid venue ID")
+		return
+	}
+
+	var req request.ChargeItem
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	item, err := h.createItemUseCase.Execute(r.Context(), &model.ChargeItem{
+		VenueID:   venueID,
+		Name:      req.Name,
+		Unit:      req.Unit,
+		UnitPrice: req.UnitPrice,
+		TaxRate:   req.TaxRate,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusCreated, toChargeItemResponse(item))
+}
+
+// UpdateItem handles the request to edit or deactivate a charge catalog entry.
+func (h *ChargeHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid ID")
+		return
+	}
+
+	var req request.ChargeItem
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	item, err := h.updateItemUseCase.Execute(r.Context(), &model.ChargeItem{
+		ID:        id,
+		Name:      req.Name,
+		Unit:      req.Unit,
+		UnitPrice: req.UnitPrice,
+		TaxRate:   req.TaxRate,
+		Active:    req.Active,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toChargeItemResponse(item))
+}
+
+// List handles the request to list the charges billed on an auction.
+func (h *ChargeHandler) List(w http.ResponseWriter, r *http.Request) {
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid auction ID")
+		return
+	}
+
+	charges, err := h.listUseCase.Execute(r.Context(), auctionID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.BuyerCharge, len(charges))
+	for i := range charges {
+		resp[i] = toBuyerChargeResponse(&charges[i])
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// Add handles the request to bill a quantity of a catalog charge to a buyer on an auction.
+func (h *ChargeHandler) Add(w http.ResponseWriter, r *http.Request) {
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid auction ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.AddBuyerCharge
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	c, err := h.addUseCase.Execute(r.Context(), adminID, &charge.AddBuyerChargeInput{
+		AuctionID:    auctionID,
+		BuyerID:      req.BuyerID,
+		ChargeItemID: req.ChargeItemID,
+		Quantity:     req.Quantity,
+		Note:         req.Note,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusCreated, toBuyerChargeResponse(c))
+}
+
+// Delete handles the request to remove a charge that has not been billed on an issued invoice.
+func (h *ChargeHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid ID")
+		return
+	}
+
+	if err := h.deleteUseCase.Execute(r.Context(), id); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toChargeItemResponse(c *model.ChargeItem) response.ChargeItem {
+	return response.ChargeItem{
+		ID:        c.ID,
+		VenueID:   c.VenueID,
+		Name:      c.Name,
+		Unit:      c.Unit,
+		UnitPrice: c.UnitPrice,
+		TaxRate:   c.TaxRate,
+		Active:    c.Active,
+	}
+}
+
+func toBuyerChargeResponse(c *model.BuyerCharge) response.BuyerCharge {
+	return response.BuyerCharge{
+		ID:           c.ID,
+		AuctionID:    c.AuctionID,
+		BuyerID:      c.BuyerID,
+		BuyerName:    c.BuyerName,
+		ChargeItemID: c.ChargeItemID,
+		Description:  c.Description,
+		Unit:         c.Unit,
+		UnitPrice:    c.UnitPrice,
+		Quantity:     c.Quantity,
+		Amount:       c.Amount(),
+		TaxRate:      c.TaxRate,
+		Note:         c.Note,
+		RecordedBy:   c.RecordedBy,
+		CreatedAt:    c.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// RegisterRoutes registers the admin charge handler routes to the given mux.
+func (h *ChargeHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /venues/{id}/charge-items", middleware.RequireAdminPermission(model.AdminPermissionViewAccounting, h.ListItems))
+	mux.HandleFunc("POST /venues/{id}/charge-items", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.CreateItem))
+	mux.HandleFunc("PUT /charge-items/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.UpdateItem))
+	mux.HandleFunc("GET /auctions/{id}/charges", middleware.RequireAdminPermission(model.AdminPermissionViewAccounting, h.List))
+	mux.HandleFunc("POST /auctions/{id}/charges", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.Add))
+	mux.HandleFunc("DELETE /charges/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.Delete))
+}
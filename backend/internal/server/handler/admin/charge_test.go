@@ -0,0 +1,156 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/charge"
+)
+
+func TestChargeHandler_CreateItem(t *testing.T) {
+	tests := []struct {
+		name       string
+		venueID    string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", venueID: "1", body: `{"name":"氷","unit":"袋","unit_price":300,"tax_rate":10}`, wantStatus: http.StatusCreated},
+		{name: "InvalidVenueID", venueID: "x", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", venueID: "1", body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "ValidationError",
+			venueID:    "1",
+			body:       `{"name":"氷","tax_rate":5}`,
+			execErr:    &domainErrors.ValidationError{Field: "tax_rate", Message: "must be 0, 8 or 10"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				CreateChargeItemUC: &mock.MockCreateChargeItemUseCase{
+					ExecuteFunc: func(_ context.Context, item *model.ChargeItem) (*model.ChargeItem, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						item.ID = 5
+						item.Active = true
+						return item, nil
+					},
+				},
+			}
+			h := admin.NewChargeHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/venues/"+tt.venueID+"/charge-items", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.venueID)
+			w := httptest.NewRecorder()
+
+			h.CreateItem(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestChargeHandler_Add(t *testing.T) {
+	tests := []struct {
+		name       string
+		withAdmin  bool
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", withAdmin: true, wantStatus: http.StatusCreated},
+		{name: "NotAuthenticated", wantStatus: http.StatusUnauthorized},
+		{
+			name:       "InvoiceIssued",
+			withAdmin:  true,
+			execErr:    &domainErrors.ConflictError{Message: "the buyer's invoice for this auction has already been issued"},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAdmin int
+			var gotInput *charge.AddBuyerChargeInput
+			mockReg := &mock.MockRegistry{
+				AddBuyerChargeUC: &mock.MockAddBuyerChargeUseCase{
+					ExecuteFunc: func(_ context.Context, adminID int, input *charge.AddBuyerChargeInput) (*model.BuyerCharge, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						gotAdmin, gotInput = adminID, input
+						return &model.BuyerCharge{ID: 12, AuctionID: input.AuctionID, BuyerID: input.BuyerID, UnitPrice: 300, Quantity: input.Quantity}, nil
+					},
+				},
+			}
+			h := admin.NewChargeHandler(mockReg)
+
+			body := `{"buyer_id":2,"charge_item_id":5,"quantity":3}`
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/auctions/1/charges", bytes.NewBufferString(body))
+			req.SetPathValue("id", "1")
+			if tt.withAdmin {
+				req = req.WithContext(middleware.WithAdminID(req.Context(), 9))
+			}
+			w := httptest.NewRecorder()
+
+			h.Add(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+			if gotAdmin != 9 || gotInput.AuctionID != 1 || gotInput.BuyerID != 2 || gotInput.Quantity != 3 {
+				t.Errorf("unexpected call: admin %d, input %+v", gotAdmin, gotInput)
+			}
+			var resp map[string]any
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp["amount"] != float64(900) {
+				t.Errorf("expected amount 900, got %v", resp["amount"])
+			}
+		})
+	}
+}
+
+func TestChargeHandler_Delete(t *testing.T) {
+	mockReg := &mock.MockRegistry{
+		DeleteBuyerChargeUC: &mock.MockDeleteBuyerChargeUseCase{
+			ExecuteFunc: func(_ context.Context, id int) error {
+				if id != 12 {
+					return &domainErrors.NotFoundError{Resource: "BuyerCharge", ID: id}
+				}
+				return nil
+			},
+		},
+	}
+	h := admin.NewChargeHandler(mockReg)
+
+	for id, want := range map[string]int{"12": http.StatusNoContent, "99": http.StatusNotFound, "x": http.StatusBadRequest} {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodDelete, "/charges/"+id, nil)
+		req.SetPathValue("id", id)
+		w := httptest.NewRecorder()
+
+		h.Delete(w, req)
+
+		if w.Code != want {
+			t.Errorf("id %s: expected status %d, got %d", id, want, w.Code)
+		}
+	}
+}
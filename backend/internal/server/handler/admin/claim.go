@@ -0,0 +1,201 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
+)
+
+// ClaimHandler handles admin HTTP requests related to buyer claims and credit notes.
+type ClaimHandler struct {
+	listUseCase    claim.ListClaimsUseCase
+	getUseCase     claim.GetClaimUseCase
+	approveUseCase claim.ApproveClaimUseCase
+	rejectUseCase  claim.RejectClaimUseCase
+}
+
+// NewClaimHandler creates a new ClaimHandler instance.
+func NewClaimHandler(r registry.UseCase) *ClaimHandler {
+	return &ClaimHandler{
+		listUseCase:    r.NewListClaimsUseCase(),
+		getUseCase:     r.NewGetClaimUseCase(),
+		approveUseCase: r.NewApproveClaimUseCase(),
+		rejectUseCase:  r.NewRejectClaimUseCase(),
+	}
+}
+
+// List handles the request to list claims, optionally filtered by status and buyer.
+func (h *ClaimHandler) List(w http.ResponseWriter, r *http.Request) {
+	filters := &repository.ClaimFilters{}
+	if s := r.URL.Query().Get("status"); s != "" {
+		status := model.ClaimStatus(s)
+		if !status.IsValid() {
+			util.WriteError(w, http.StatusBadRequest, "Invalid status")
+			return
+		}
+		filters.Status = &status
+	}
+	if s := r.URL.Query().Get("buyer_id"); s != "" {
+		buyerID, err := strconv.Atoi(s)
+		if err != nil {
+			util.WriteError(w, http.StatusBadRequest, "Invalid buyer_id")
+			return
+		}
+		filters.BuyerID = &buyerID
+	}
+
+	claims, err := h.listUseCase.Execute(r.Context(), filters)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.Claim, len(claims))
+	for i := range claims {
+		resp[i] = toClaimResponse(&claims[i])
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// Get handles the request to get a claim with its audit trail.
+func (h *ClaimHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid ID")
+		return
+	}
+
+	c, err := h.getUseCase.Execute(r.Context(), id)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toClaimResponse(c))
+}
+
+// Approve handles the request to approve a claim and issue its credit note.
+func (h *ClaimHandler) Approve(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.ApproveClaim
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	c, err := h.approveUseCase.Execute(r.Context(), id, adminID, claim.ApproveClaimInput{
+		Amount:   req.Amount,
+		Clawback: req.Clawback,
+		Note:     req.Note,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toClaimResponse(c))
+}
+
+// Reject handles the request to reject a claim.
+func (h *ClaimHandler) Reject(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.RejectClaim
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	c, err := h.rejectUseCase.Execute(r.Context(), id, adminID, req.Note)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toClaimResponse(c))
+}
+
+// RegisterRoutes registers the admin claim handler routes to the given mux.
+func (h *ClaimHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /claims", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.List))
+	mux.HandleFunc("GET /claims/{id}", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.Get))
+	mux.HandleFunc("POST /claims/{id}/approve", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.Approve))
+	mux.HandleFunc("POST /claims/{id}/reject", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.Reject))
+}
+
+func toClaimResponse(c *model.Claim) response.Claim {
+	resp := response.Claim{
+		ID:              c.ID,
+		BuyerID:         c.BuyerID,
+		BuyerName:       c.BuyerName,
+		PurchaseID:      c.PurchaseID,
+		ItemID:          c.ItemID,
+		AuctionID:       c.AuctionID,
+		FishermanID:     c.FishermanID,
+		FishType:        c.FishType,
+		PurchasePrice:   c.PurchasePrice,
+		Type:            string(c.Type),
+		Reason:          c.Reason,
+		RequestedAmount: c.RequestedAmount,
+		ApprovedAmount:  c.ApprovedAmount,
+		Clawback:        c.Clawback,
+		Status:          string(c.Status),
+		ResolutionNote:  c.ResolutionNote,
+		ResolvedBy:      c.ResolvedBy,
+		ResolvedAt:      util.FormatTimestamp(c.ResolvedAt),
+		CreatedAt:       c.CreatedAt.Format(time.RFC3339),
+	}
+	for _, e := range c.Events {
+		resp.Events = append(resp.Events, response.ClaimEvent{
+			Action:    string(e.Action),
+			ActorType: string(e.ActorType),
+			ActorID:   e.ActorID,
+			Amount:    e.Amount,
+			Note:      e.Note,
+			CreatedAt: e.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	if c.CreditNote != nil {
+		resp.CreditNote = toCreditNoteResponse(c.CreditNote)
+	}
+	return resp
+}
+
+func toCreditNoteResponse(cn *model.CreditNote) *response.CreditNote {
+	return &response.CreditNote{
+		ID:          cn.ID,
+		InvoiceID:   cn.InvoiceID,
+		Amount:      cn.Amount,
+		TaxRate:     cn.TaxRate,
+		TaxAmount:   cn.TaxAmount,
+		TotalAmount: cn.TotalAmount,
+		IssuedBy:    cn.IssuedBy,
+		CreatedAt:   cn.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,193 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
+)
+
+func TestClaimHandler_List(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		wantStatus       int
+		wantStatusFilter model.ClaimStatus
+	}{
+		{name: "All", wantStatus: http.StatusOK},
+		{name: "Pending", query: "?status=pending&buyer_id=2", wantStatus: http.StatusOK, wantStatusFilter: model.ClaimStatusPending},
+		{name: "InvalidStatus", query: "?status=closed", wantStatus: http.StatusBadRequest},
+		{name: "InvalidBuyerID", query: "?buyer_id=x", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *repository.ClaimFilters
+			mockReg := &mock.MockRegistry{
+				ListClaimsUC: &mock.MockListClaimsUseCase{
+					ExecuteFunc: func(_ context.Context, filters *repository.ClaimFilters) ([]model.Claim, error) {
+						got = filters
+						return []model.Claim{{ID: 1, Status: model.ClaimStatusPending}}, nil
+					},
+				},
+			}
+			h := admin.NewClaimHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/claims"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.List(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatusFilter != "" && (got.Status == nil || *got.Status != tt.wantStatusFilter || got.BuyerID == nil || *got.BuyerID != 2) {
+				t.Errorf("unexpected filters: %+v", got)
+			}
+		})
+	}
+}
+
+func TestClaimHandler_Get(t *testing.T) {
+	amount := 1000
+	mockReg := &mock.MockRegistry{
+		GetClaimUC: &mock.MockGetClaimUseCase{
+			ExecuteFunc: func(_ context.Context, id int) (*model.Claim, error) {
+				return &model.Claim{
+					ID:             id,
+					Status:         model.ClaimStatusApproved,
+					ApprovedAmount: &amount,
+					Events:         []model.ClaimEvent{{Action: model.ClaimActionFiled}, {Action: model.ClaimActionApproved}},
+					CreditNote:     &model.CreditNote{ID: 3, TotalAmount: 1080},
+				}, nil
+			},
+		},
+	}
+	h := admin.NewClaimHandler(mockReg)
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/claims/5", nil)
+	req.SetPathValue("id", "5")
+	w := httptest.NewRecorder()
+
+	h.Get(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	var body struct {
+		ID     int `json:"id"`
+		Events []struct {
+			Action string `json:"action"`
+		} `json:"events"`
+		CreditNote *struct {
+			TotalAmount int `json:"total_amount"`
+		} `json:"credit_note"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if body.ID != 5 || len(body.Events) != 2 || body.CreditNote == nil || body.CreditNote.TotalAmount != 1080 {
+		t.Errorf("unexpected response: %+v", body)
+	}
+}
+
+func TestClaimHandler_Approve(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		body       string
+		noAdmin    bool
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "1", body: `{"amount":500,"clawback":true,"note":"ok"}`, wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "x", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", pathID: "1", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "NoAdmin", pathID: "1", body: `{}`, noAdmin: true, wantStatus: http.StatusUnauthorized},
+		{name: "AlreadyResolved", pathID: "1", body: `{}`, execErr: &domainErrors.ConflictError{Message: "resolved"}, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAdmin int
+			var gotInput claim.ApproveClaimInput
+			mockReg := &mock.MockRegistry{
+				ApproveClaimUC: &mock.MockApproveClaimUseCase{
+					ExecuteFunc: func(_ context.Context, id, adminID int, input claim.ApproveClaimInput) (*model.Claim, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						gotAdmin, gotInput = adminID, input
+						return &model.Claim{ID: id, Status: model.ClaimStatusApproved}, nil
+					},
+				},
+			}
+			h := admin.NewClaimHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/claims/"+tt.pathID+"/approve", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			if !tt.noAdmin {
+				req = req.WithContext(middleware.WithAdminID(req.Context(), 7))
+			}
+			w := httptest.NewRecorder()
+
+			h.Approve(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.name == "Success" && (gotAdmin != 7 || gotInput.Amount != 500 || !gotInput.Clawback) {
+				t.Errorf("unexpected call: admin %d, input %+v", gotAdmin, gotInput)
+			}
+		})
+	}
+}
+
+func TestClaimHandler_Reject(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", body: `{"note":"写真では確認できず"}`, wantStatus: http.StatusOK},
+		{name: "MissingNote", body: `{}`, execErr: &domainErrors.ValidationError{Field: "note", Message: "is required"}, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				RejectClaimUC: &mock.MockRejectClaimUseCase{
+					ExecuteFunc: func(_ context.Context, id, _ int, note string) (*model.Claim, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Claim{ID: id, Status: model.ClaimStatusRejected, ResolutionNote: note}, nil
+					},
+				},
+			}
+			h := admin.NewClaimHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/claims/1/reject", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", "1")
+			req = req.WithContext(middleware.WithAdminID(req.Context(), 7))
+			w := httptest.NewRecorder()
+
+			h.Reject(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
 )
@@ -17,6 +19,9 @@ type FishermanHandler struct {
 	createUseCase fisherman.CreateFishermanUseCase
 	listUseCase   fisherman.ListFishermenUseCase
 	deleteUseCase fisherman.DeleteFishermanUseCase
+	updateUseCase fisherman.UpdateFishermanUseCase
+	bankUseCase   fisherman.UpdateBankAccountUseCase
+	loginUseCase  fisherman.CreateLoginUseCase
 }
 
 // NewFishermanHandler creates a new FishermanHandler instance.
@@ -25,6 +30,9 @@ func NewFishermanHandler(r registry.UseCase) *FishermanHandler {
 		createUseCase: r.NewCreateFishermanUseCase(),
 		listUseCase:   r.NewListFishermenUseCase(),
 		deleteUseCase: r.NewDeleteFishermanUseCase(),
+		updateUseCase: r.NewUpdateFishermanUseCase(),
+		bankUseCase:   r.NewUpdateFishermanBankAccountUseCase(),
+		loginUseCase:  r.NewCreateFishermanLoginUseCase(),
 	}
 }
 
@@ -42,7 +50,7 @@ func (h *FishermanHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	util.WriteJSON(w, http.StatusCreated, response.Fisherman{ID: fm.ID, Name: fm.Name})
+	util.WriteJSON(w, http.StatusCreated, toFishermanResponse(fm))
 }
 
 // List handles the request to list fishermen.
@@ -54,11 +62,8 @@ func (h *FishermanHandler) List(w http.ResponseWriter, r *http.Request) {
 	}
 
 	resp := make([]response.Fisherman, len(fishermen))
-	for i, f := range fishermen {
-		resp[i] = response.Fisherman{
-			ID:   f.ID,
-			Name: f.Name,
-		}
+	for i := range fishermen {
+		resp[i] = toFishermanResponse(&fishermen[i])
 	}
 
 	util.WriteJSON(w, http.StatusOK, resp)
@@ -81,9 +86,116 @@ func (h *FishermanHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// Update handles the request to edit a fisherman's name and profile.
+func (h *FishermanHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid fisherman ID")
+		return
+	}
+
+	var req request.UpdateFisherman
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	fm, err := h.updateUseCase.Execute(r.Context(), id, &fisherman.UpdateFishermanInput{
+		Name:                     req.Name,
+		CooperativeMemberNumber:  req.CooperativeMemberNumber,
+		VesselName:               req.VesselName,
+		VesselRegistrationNumber: req.VesselRegistrationNumber,
+		Phone:                    req.Phone,
+		Address:                  req.Address,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toFishermanResponse(fm))
+}
+
+// UpdateBankAccount handles the request to register or replace a fisherman's payout bank account.
+func (h *FishermanHandler) UpdateBankAccount(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid fisherman ID")
+		return
+	}
+
+	var req request.UpdateFishermanBankAccount
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	fm, err := h.bankUseCase.Execute(r.Context(), id, &fisherman.UpdateBankAccountInput{
+		BankCode:      req.BankCode,
+		BranchCode:    req.BranchCode,
+		AccountType:   req.AccountType,
+		AccountNumber: req.AccountNumber,
+		HolderName:    req.HolderName,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toFishermanResponse(fm))
+}
+
+// CreateLogin handles the request to issue portal login credentials to a fisherman.
+func (h *FishermanHandler) CreateLogin(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid fisherman ID")
+		return
+	}
+
+	var req request.CreateFishermanLogin
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	auth, err := h.loginUseCase.Execute(r.Context(), id, req.Email, req.Password)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, response.FishermanLogin{FishermanID: auth.FishermanID, Email: auth.Email})
+}
+
+func toFishermanResponse(f *model.Fisherman) response.Fisherman {
+	resp := response.Fisherman{
+		ID:                       f.ID,
+		Name:                     f.Name,
+		CooperativeMemberNumber:  f.CooperativeMemberNumber,
+		VesselName:               f.VesselName,
+		VesselRegistrationNumber: f.VesselRegistrationNumber,
+		Phone:                    f.Phone,
+		Address:                  f.Address,
+	}
+	if f.BankAccount != nil {
+		resp.BankAccount = &response.BankAccount{
+			BankCode:      f.BankAccount.BankCode,
+			BranchCode:    f.BankAccount.BranchCode,
+			AccountType:   int(f.BankAccount.AccountType),
+			AccountNumber: f.BankAccount.AccountNumber,
+			HolderKana:    f.BankAccount.HolderKana,
+		}
+	}
+	return resp
+}
+
 // RegisterRoutes registers the admin fisherman handler routes to the given mux.
 func (h *FishermanHandler) RegisterRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("GET /fishermen", h.List)
-	mux.HandleFunc("POST /fishermen", h.Create)
-	mux.HandleFunc("DELETE /fishermen/{id}", h.Delete)
+	mux.HandleFunc("GET /fishermen", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.List))
+	mux.HandleFunc("POST /fishermen", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Create))
+	mux.HandleFunc("PUT /fishermen/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Update))
+	mux.HandleFunc("DELETE /fishermen/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Delete))
+	mux.HandleFunc("PUT /fishermen/{id}/bank-account", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.UpdateBankAccount))
+	mux.HandleFunc("POST /fishermen/{id}/login", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.CreateLogin))
 }
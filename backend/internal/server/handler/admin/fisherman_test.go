@@ -9,10 +9,13 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
 )
 
 func TestFishermanHandler(t *testing.T) {
@@ -81,7 +84,7 @@ func TestFishermanHandler(t *testing.T) {
 	t.Run("RegisterRoutes", func(t *testing.T) {
 		mux := http.NewServeMux()
 		h.RegisterRoutes(mux)
-		req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/fishermen", nil)
+		req := httptest.NewRequestWithContext(middleware.WithAdminRole(context.Background(), model.AdminRoleClerk), http.MethodGet, "/fishermen", nil)
 		w := httptest.NewRecorder()
 		mux.ServeHTTP(w, req)
 		if w.Code != http.StatusOK {
@@ -89,3 +92,214 @@ func TestFishermanHandler(t *testing.T) {
 		}
 	})
 }
+
+func TestFishermanHandler_Update(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{
+			name:       "Success",
+			pathID:     "1",
+			body:       `{"name":"F1","cooperative_member_number":"1024","vessel_name":"第一漁丸","vessel_registration_number":"MG2-1234","phone":"090-0000-0000","address":"石巻市"}`,
+			wantStatus: http.StatusOK,
+		},
+		{name: "InvalidID", pathID: "abc", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", pathID: "1", body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "ValidationError",
+			pathID:     "1",
+			body:       `{"name":"F1","phone":"abc"}`,
+			execErr:    &domainErrors.ValidationError{Field: "phone", Message: "must be a phone number"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "MemberNumberTaken",
+			pathID:     "1",
+			body:       `{"name":"F1","cooperative_member_number":"1024"}`,
+			execErr:    &domainErrors.ConflictError{Message: "cooperative member number is already registered to another fisherman"},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "NotFound",
+			pathID:     "9",
+			body:       `{"name":"F1"}`,
+			execErr:    &domainErrors.NotFoundError{Resource: "Fisherman", ID: 9},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				UpdateFishermanUC: &mock.MockUpdateFishermanUseCase{
+					ExecuteFunc: func(_ context.Context, id int, input *fisherman.UpdateFishermanInput) (*model.Fisherman, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Fisherman{ID: id, Name: input.Name, FishermanProfile: model.FishermanProfile{
+							CooperativeMemberNumber:  input.CooperativeMemberNumber,
+							VesselName:               input.VesselName,
+							VesselRegistrationNumber: input.VesselRegistrationNumber,
+							Phone:                    input.Phone,
+							Address:                  input.Address,
+						}}, nil
+					},
+				},
+			}
+			h := admin.NewFishermanHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/fishermen/"+tt.pathID, bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.Update(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var body struct {
+				CooperativeMemberNumber string `json:"cooperative_member_number"`
+				VesselName              string `json:"vessel_name"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if body.CooperativeMemberNumber != "1024" || body.VesselName != "第一漁丸" {
+				t.Errorf("unexpected profile: %+v", body)
+			}
+		})
+	}
+}
+
+func TestFishermanHandler_UpdateBankAccount(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{
+			name:       "Success",
+			pathID:     "1",
+			body:       `{"bank_code":"0001","branch_code":"100","account_type":1,"account_number":"1234567","account_holder_kana":"ﾔﾏﾀﾞ ﾀﾛｳ"}`,
+			wantStatus: http.StatusOK,
+		},
+		{name: "InvalidID", pathID: "abc", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", pathID: "1", body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "ValidationError",
+			pathID:     "1",
+			body:       `{"bank_code":"1"}`,
+			execErr:    &domainErrors.ValidationError{Field: "bank_code", Message: "must be 4 digits"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "NotFound",
+			pathID:     "9",
+			body:       `{}`,
+			execErr:    &domainErrors.NotFoundError{Resource: "Fisherman", ID: 9},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				UpdateFishermanBankAccountUC: &mock.MockUpdateBankAccountUseCase{
+					ExecuteFunc: func(_ context.Context, id int, input *fisherman.UpdateBankAccountInput) (*model.Fisherman, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Fisherman{ID: id, Name: "F1", BankAccount: &model.BankAccount{
+							BankCode:      input.BankCode,
+							BranchCode:    input.BranchCode,
+							AccountType:   model.BankAccountType(input.AccountType),
+							AccountNumber: input.AccountNumber,
+							HolderKana:    input.HolderName,
+						}}, nil
+					},
+				},
+			}
+			h := admin.NewFishermanHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/fishermen/"+tt.pathID+"/bank-account", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.UpdateBankAccount(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var body struct {
+				BankAccount *struct {
+					BankCode string `json:"bank_code"`
+				} `json:"bank_account"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if body.BankAccount == nil || body.BankAccount.BankCode != "0001" {
+				t.Errorf("unexpected bank account: %+v", body.BankAccount)
+			}
+		})
+	}
+}
+
+func TestFishermanHandler_CreateLogin(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "1", body: `{"email":"f1@example.com","password":"Password1!"}`, wantStatus: http.StatusCreated},
+		{name: "InvalidID", pathID: "abc", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", pathID: "1", body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "AlreadyIssued",
+			pathID:     "1",
+			body:       `{"email":"f1@example.com","password":"Password1!"}`,
+			execErr:    &domainErrors.ConflictError{Message: "Fisherman login already exists"},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				CreateFishermanLoginUC: &mock.MockCreateFishermanLoginUseCase{
+					ExecuteFunc: func(_ context.Context, fishermanID int, email, _ string) (*model.FishermanAuthentication, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.FishermanAuthentication{ID: 1, FishermanID: fishermanID, Email: email}, nil
+					},
+				},
+			}
+			h := admin.NewFishermanHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/fishermen/"+tt.pathID+"/login", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.CreateLogin(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
@@ -3,22 +3,31 @@ package admin
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
+	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 	"github.com/seka/fish-auction/backend/internal/usecase/invoice"
 )
 
 // InvoiceHandler handles admin HTTP requests related to invoices.
 type InvoiceHandler struct {
-	listUseCase invoice.ListInvoicesUseCase
+	listUseCase     invoice.ListInvoicesUseCase
+	generateUseCase invoice.GenerateInvoicesUseCase
+	issueUseCase    invoice.IssueInvoiceUseCase
+	getUseCase      invoice.GetInvoiceUseCase
 }
 
 // NewInvoiceHandler creates a new InvoiceHandler instance.
 func NewInvoiceHandler(r registry.UseCase) *InvoiceHandler {
 	return &InvoiceHandler{
-		listUseCase: r.NewListInvoicesUseCase(),
+		listUseCase:     r.NewListInvoicesUseCase(),
+		generateUseCase: r.NewGenerateInvoicesUseCase(),
+		issueUseCase:    r.NewIssueInvoiceUseCase(),
+		getUseCase:      r.NewGetInvoiceUseCase(),
 	}
 }
 
@@ -43,7 +52,94 @@ func (h *InvoiceHandler) List(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// Generate handles the request to (re)generate draft invoices for a completed auction.
+func (h *InvoiceHandler) Generate(w http.ResponseWriter, r *http.Request) {
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid auction ID")
+		return
+	}
+
+	invoices, err := h.generateUseCase.Execute(r.Context(), auctionID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.InvoiceDetail, len(invoices))
+	for i := range invoices {
+		resp[i] = toInvoiceDetailResponse(&invoices[i])
+	}
+	util.WriteJSON(w, http.StatusCreated, resp)
+}
+
+// Get handles the request to get a single invoice with its lines.
+func (h *InvoiceHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid ID")
+		return
+	}
+
+	inv, err := h.getUseCase.Execute(r.Context(), id)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toInvoiceDetailResponse(inv))
+}
+
+// Issue handles the request to issue a draft invoice.
+func (h *InvoiceHandler) Issue(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid ID")
+		return
+	}
+
+	inv, err := h.issueUseCase.Execute(r.Context(), id)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toInvoiceDetailResponse(inv))
+}
+
+func toInvoiceDetailResponse(inv *model.Invoice) response.InvoiceDetail {
+	resp := response.InvoiceDetail{
+		ID:                inv.ID,
+		BuyerID:           inv.BuyerID,
+		BuyerName:         inv.BuyerName,
+		AuctionID:         inv.AuctionID,
+		Status:            string(inv.Status),
+		Subtotal:          inv.Subtotal,
+		TaxAmount:         inv.TaxAmount,
+		TotalAmount:       inv.TotalAmount,
+		CreditedAmount:    inv.CreditedAmount,
+		PaidAmount:        inv.PaidAmount,
+		OutstandingAmount: inv.OutstandingAmount(),
+		IssuedAt:          util.FormatTimestamp(inv.IssuedAt),
+		PaidAt:            util.FormatTimestamp(inv.PaidAt),
+	}
+	for _, l := range inv.Lines {
+		resp.Lines = append(resp.Lines, response.InvoiceLine{
+			ID:          l.ID,
+			ItemID:      l.ItemID,
+			ChargeID:    l.ChargeID,
+			Description: l.Description,
+			Quantity:    l.Quantity,
+			Unit:        l.Unit,
+			Amount:      l.Amount,
+			TaxRate:     l.TaxRate,
+		})
+	}
+	return resp
+}
+
 // RegisterRoutes registers the admin invoice handler routes to the given mux.
 func (h *InvoiceHandler) RegisterRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("GET /invoices", h.List)
+	mux.HandleFunc("GET /invoices", middleware.RequireAdminPermission(model.AdminPermissionViewAccounting, h.List))
+	mux.HandleFunc("GET /invoices/{id}", middleware.RequireAdminPermission(model.AdminPermissionViewAccounting, h.Get))
+	mux.HandleFunc("POST /invoices/{id}/issue", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.Issue))
+	mux.HandleFunc("POST /auctions/{id}/invoices", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.Generate))
 }
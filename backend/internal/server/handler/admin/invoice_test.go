@@ -2,11 +2,13 @@ package admin_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
@@ -54,6 +56,126 @@ func TestInvoiceHandler_List(t *testing.T) {
 	})
 }
 
+func TestInvoiceHandler_Generate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "1", wantStatus: http.StatusCreated},
+		{name: "InvalidID", pathID: "abc", wantStatus: http.StatusBadRequest},
+		{name: "AuctionNotCompleted", pathID: "1", execErr: &domainErrors.ConflictError{Message: "not completed"}, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				GenerateInvoicesUC: &mock.MockGenerateInvoicesUseCase{
+					ExecuteFunc: func(_ context.Context, auctionID int) ([]model.Invoice, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return []model.Invoice{{ID: 1, BuyerID: 2, AuctionID: auctionID, Status: model.InvoiceStatusDraft}}, nil
+					},
+				},
+			}
+			h := admin.NewInvoiceHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/auctions/"+tt.pathID+"/invoices", nil)
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.Generate(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestInvoiceHandler_Issue(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "1", wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "x", wantStatus: http.StatusBadRequest},
+		{name: "NotDraft", pathID: "1", execErr: &domainErrors.ConflictError{Message: "not draft"}, wantStatus: http.StatusConflict},
+		{name: "NotFound", pathID: "9", execErr: &domainErrors.NotFoundError{Resource: "Invoice", ID: 9}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				IssueInvoiceUC: &mock.MockIssueInvoiceUseCase{
+					ExecuteFunc: func(_ context.Context, id int) (*model.Invoice, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Invoice{ID: id, Status: model.InvoiceStatusIssued}, nil
+					},
+				},
+			}
+			h := admin.NewInvoiceHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/invoices/"+tt.pathID+"/issue", nil)
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.Issue(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestInvoiceHandler_Get(t *testing.T) {
+	itemID := 3
+	mockReg := &mock.MockRegistry{
+		GetInvoiceUC: &mock.MockGetInvoiceUseCase{
+			ExecuteFunc: func(_ context.Context, id int) (*model.Invoice, error) {
+				return &model.Invoice{
+					ID:          id,
+					Status:      model.InvoiceStatusIssued,
+					TotalAmount: 1080,
+					PaidAmount:  80,
+					Lines:       []model.InvoiceLine{{ID: 1, ItemID: &itemID, Amount: 1000, TaxRate: model.TaxRateReduced}},
+				}, nil
+			},
+		},
+	}
+	h := admin.NewInvoiceHandler(mockReg)
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/invoices/5", nil)
+	req.SetPathValue("id", "5")
+	w := httptest.NewRecorder()
+
+	h.Get(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	var body struct {
+		ID                int `json:"id"`
+		OutstandingAmount int `json:"outstanding_amount"`
+		Lines             []struct {
+			ItemID *int `json:"item_id"`
+		} `json:"lines"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if body.ID != 5 || body.OutstandingAmount != 1000 || len(body.Lines) != 1 {
+		t.Errorf("unexpected response: %+v", body)
+	}
+}
+
 func TestInvoiceHandler_RegisterRoutes(t *testing.T) {
 	t.Run("MethodNotAllowed", func(t *testing.T) {
 		mockReg := &mock.MockRegistry{}
@@ -9,6 +9,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 	"github.com/seka/fish-auction/backend/internal/usecase/item"
 )
@@ -136,24 +137,24 @@ func (h *ItemHandler) toResponse(it *model.AuctionItem) response.Item {
 		highestBid = &amt
 	}
 	return response.Item{
-		ID:                it.ID,
-		AuctionID:         it.AuctionID,
-		FishermanID:       it.FishermanID,
-		FishType:          it.FishType,
-		Quantity:          it.Quantity,
-		Unit:              it.Unit,
-		HighestBid:        highestBid,
-		HighestBidderID:   it.HighestBidderID,
-		HighestBidderName: it.HighestBidderName,
-		SortOrder:         it.SortOrder,
-		CreatedAt:         it.CreatedAt,
+		ID:                  it.ID,
+		AuctionID:           it.AuctionID,
+		FishermanID:         it.FishermanID,
+		FishType:            it.FishType,
+		Quantity:            it.Quantity,
+		Unit:                it.Unit,
+		HighestBid:          highestBid,
+		HighestBidderID:     it.HighestBidderID,
+		HighestBidderPaddle: it.HighestBidderPaddle,
+		SortOrder:           it.SortOrder,
+		CreatedAt:           it.CreatedAt,
 	}
 }
 
 // RegisterRoutes registers the admin item handler routes to the given mux.
 func (h *ItemHandler) RegisterRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("POST /items", h.Create)
-	mux.HandleFunc("PUT /items/{id}", h.Update)
-	mux.HandleFunc("DELETE /items/{id}", h.Delete)
-	mux.HandleFunc("PUT /items/{id}/sort-order", h.UpdateSortOrder)
+	mux.HandleFunc("POST /items", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Create))
+	mux.HandleFunc("PUT /items/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Update))
+	mux.HandleFunc("DELETE /items/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Delete))
+	mux.HandleFunc("PUT /items/{id}/sort-order", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.UpdateSortOrder))
 }
@@ -12,6 +12,7 @@ import (
 	admin "github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
 )
 
@@ -194,7 +195,7 @@ func TestItemHandler_RegisterRoutes(t *testing.T) {
 		mux := http.NewServeMux()
 		h.RegisterRoutes(mux)
 
-		req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/items", bytes.NewReader([]byte("{}")))
+		req := httptest.NewRequestWithContext(middleware.WithAdminRole(context.Background(), model.AdminRoleClerk), http.MethodPost, "/items", bytes.NewReader([]byte("{}")))
 		w := httptest.NewRecorder()
 		mux.ServeHTTP(w, req)
 		if w.Code != http.StatusCreated {
@@ -0,0 +1,111 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/label"
+)
+
+// LabelHandler handles admin HTTP requests for printing and scanning lot labels.
+type LabelHandler struct {
+	printAuctionUseCase label.PrintAuctionLabelsUseCase
+	printItemUseCase    label.PrintItemLabelUseCase
+	scanUseCase         label.ScanLabelUseCase
+}
+
+// NewLabelHandler creates a new LabelHandler instance.
+func NewLabelHandler(r registry.UseCase) *LabelHandler {
+	return &LabelHandler{
+		printAuctionUseCase: r.NewPrintAuctionLabelsUseCase(),
+		printItemUseCase:    r.NewPrintItemLabelUseCase(),
+		scanUseCase:         r.NewScanLabelUseCase(),
+	}
+}
+
+// PrintAuction handles the request to print the labels of every item in an auction.
+func (h *LabelHandler) PrintAuction(w http.ResponseWriter, r *http.Request) {
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid auction ID")
+		return
+	}
+
+	data, err := h.printAuctionUseCase.Execute(r.Context(), auctionID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	writePDF(w, fmt.Sprintf("labels_auction_%d.pdf", auctionID), data)
+}
+
+// PrintItem handles the request to reprint the label of a single item.
+func (h *LabelHandler) PrintItem(w http.ResponseWriter, r *http.Request) {
+	itemID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid item ID")
+		return
+	}
+
+	data, err := h.printItemUseCase.Execute(r.Context(), itemID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	writePDF(w, fmt.Sprintf("label_item_%d.pdf", itemID), data)
+}
+
+// Scan handles the request to look up the lot of a scanned label.
+func (h *LabelHandler) Scan(w http.ResponseWriter, r *http.Request) {
+	scan, err := h.scanUseCase.Execute(r.Context(), r.URL.Query().Get("code"))
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toLabelScanResponse(scan))
+}
+
+func toLabelScanResponse(scan *model.LabelScan) response.LabelScan {
+	item := scan.Item
+	var highestBid *int
+	if item.HighestBid != nil {
+		amt := item.HighestBid.Amount()
+		highestBid = &amt
+	}
+	return response.LabelScan{
+		ItemID:              item.ID,
+		AuctionID:           item.AuctionID,
+		LotNumber:           item.SortOrder,
+		FishermanID:         item.FishermanID,
+		FishType:            item.FishType,
+		Quantity:            item.Quantity,
+		Unit:                item.Unit,
+		HighestBid:          highestBid,
+		HighestBidderID:     item.HighestBidderID,
+		HighestBidderPaddle: item.HighestBidderPaddle,
+		MinimumBid:          item.MinimumNextBid().Amount(),
+		AuctionStatus:       string(scan.Auction.Status),
+		BiddingOpen:         scan.BiddingOpen,
+	}
+}
+
+func writePDF(w http.ResponseWriter, filename string, data []byte) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+// RegisterRoutes registers the admin label handler routes to the given mux.
+func (h *LabelHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /auctions/{id}/labels", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.PrintAuction))
+	mux.HandleFunc("GET /items/{id}/label", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.PrintItem))
+	mux.HandleFunc("GET /labels/scan", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.Scan))
+}
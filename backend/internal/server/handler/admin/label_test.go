@@ -0,0 +1,168 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+)
+
+func TestLabelHandler_PrintAuction(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "3", wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", wantStatus: http.StatusBadRequest},
+		{name: "AuctionNotFound", pathID: "9", execErr: &domainErrors.NotFoundError{Resource: "Auction", ID: 9}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				PrintAuctionLabelsUC: &mock.MockPrintAuctionLabelsUseCase{
+					ExecuteFunc: func(_ context.Context, _ int) ([]byte, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return []byte("%PDF-1.4"), nil
+					},
+				},
+			}
+			h := admin.NewLabelHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/auctions/"+tt.pathID+"/labels", nil)
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.PrintAuction(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if ct := w.Header().Get("Content-Type"); ct != "application/pdf" {
+				t.Errorf("unexpected content type %q", ct)
+			}
+			if cd := w.Header().Get("Content-Disposition"); cd != `attachment; filename="labels_auction_3.pdf"` {
+				t.Errorf("unexpected content disposition %q", cd)
+			}
+			if w.Body.String() != "%PDF-1.4" {
+				t.Errorf("unexpected body %q", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestLabelHandler_PrintItem(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "12", wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", wantStatus: http.StatusBadRequest},
+		{name: "ItemNotFound", pathID: "99", execErr: &domainErrors.NotFoundError{Resource: "Item", ID: 99}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotID int
+			mockReg := &mock.MockRegistry{
+				PrintItemLabelUC: &mock.MockPrintItemLabelUseCase{
+					ExecuteFunc: func(_ context.Context, itemID int) ([]byte, error) {
+						gotID = itemID
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return []byte("%PDF-1.4"), nil
+					},
+				},
+			}
+			h := admin.NewLabelHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/items/"+tt.pathID+"/label", nil)
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.PrintItem(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if gotID != 12 {
+				t.Errorf("expected item 12, got %d", gotID)
+			}
+			if cd := w.Header().Get("Content-Disposition"); cd != `attachment; filename="label_item_12.pdf"` {
+				t.Errorf("unexpected content disposition %q", cd)
+			}
+		})
+	}
+}
+
+func TestLabelHandler_Scan(t *testing.T) {
+	highest := model.NewBidPrice(3000)
+	bidderID, bidderPaddle := 7, "128"
+
+	tests := []struct {
+		name       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", wantStatus: http.StatusOK},
+		{name: "Expired", execErr: &domainErrors.GoneError{Resource: "LabelCode", Message: "label code has expired"}, wantStatus: http.StatusGone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ScanLabelUC: &mock.MockScanLabelUseCase{
+					ExecuteFunc: func(_ context.Context, _ string) (*model.LabelScan, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.LabelScan{
+							Item:    &model.AuctionItem{ID: 10, AuctionID: 1, SortOrder: 2, HighestBid: &highest, HighestBidderID: &bidderID, HighestBidderPaddle: &bidderPaddle},
+							Auction: &model.Auction{ID: 1, Status: model.AuctionStatusInProgress},
+						}, nil
+					},
+				},
+			}
+			h := admin.NewLabelHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/labels/scan?code=L1.10.1.sig", nil)
+			w := httptest.NewRecorder()
+
+			h.Scan(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var resp response.LabelScan
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.HighestBidderPaddle == nil || *resp.HighestBidderPaddle != bidderPaddle || resp.MinimumBid != 3500 {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
@@ -37,7 +37,8 @@ func (h *MeHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSON(w, http.StatusOK, struct {
 		ID    int    `json:"id"`
 		Email string `json:"email"`
-	}{ID: admin.ID, Email: admin.Email})
+		Role  string `json:"role"`
+	}{ID: admin.ID, Email: admin.Email, Role: string(admin.Role)})
 }
 
 // RegisterRoutes registers the me handler routes to the given mux.
@@ -0,0 +1,140 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
+)
+
+// PaymentHandler handles admin HTTP requests related to buyer payments and receivables.
+type PaymentHandler struct {
+	recordUseCase payment.RecordPaymentUseCase
+	ledgerUseCase payment.GetBuyerLedgerUseCase
+	agingUseCase  payment.ListAgedReceivablesUseCase
+}
+
+// NewPaymentHandler creates a new PaymentHandler instance.
+func NewPaymentHandler(r registry.UseCase) *PaymentHandler {
+	return &PaymentHandler{
+		recordUseCase: r.NewRecordPaymentUseCase(),
+		ledgerUseCase: r.NewGetBuyerLedgerUseCase(),
+		agingUseCase:  r.NewListAgedReceivablesUseCase(),
+	}
+}
+
+// Record handles the request to record a payment received from a buyer.
+func (h *PaymentHandler) Record(w http.ResponseWriter, r *http.Request) {
+	var req request.RecordPayment
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	receivedAt, err := parseTimestamp(req.ReceivedAt)
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid received_at format (RFC3339)")
+		return
+	}
+
+	p := &model.Payment{
+		BuyerID:   req.BuyerID,
+		InvoiceID: req.InvoiceID,
+		Method:    model.PaymentMethod(req.Method),
+		Amount:    req.Amount,
+		Note:      req.Note,
+	}
+	if receivedAt != nil {
+		p.ReceivedAt = *receivedAt
+	}
+	if adminID, ok := middleware.AdminIDFromContext(r.Context()); ok {
+		p.RecordedBy = &adminID
+	}
+
+	recorded, err := h.recordUseCase.Execute(r.Context(), p)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, response.Payment{
+		ID:                recorded.ID,
+		BuyerID:           recorded.BuyerID,
+		InvoiceID:         recorded.InvoiceID,
+		Method:            string(recorded.Method),
+		Amount:            recorded.Amount,
+		AllocatedAmount:   recorded.AllocatedAmount,
+		UnallocatedAmount: recorded.UnallocatedAmount(),
+		ReceivedAt:        recorded.ReceivedAt.Format(time.RFC3339),
+		Note:              recorded.Note,
+		RecordedBy:        recorded.RecordedBy,
+		CreatedAt:         recorded.CreatedAt.Format(time.RFC3339),
+	})
+}
+
+// Ledger handles the request to get a buyer's running ledger.
+func (h *PaymentHandler) Ledger(w http.ResponseWriter, r *http.Request) {
+	buyerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+
+	entries, err := h.ledgerUseCase.Execute(r.Context(), buyerID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.LedgerEntry, len(entries))
+	for i, e := range entries {
+		resp[i] = response.LedgerEntry{
+			Date:        e.Date.Format(time.RFC3339),
+			Type:        string(e.Type),
+			ReferenceID: e.ReferenceID,
+			Description: e.Description,
+			Debit:       e.Debit,
+			Credit:      e.Credit,
+			Balance:     e.Balance,
+		}
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// Aging handles the request to get the aged-receivables report.
+func (h *PaymentHandler) Aging(w http.ResponseWriter, r *http.Request) {
+	rows, err := h.agingUseCase.Execute(r.Context())
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.AgedReceivable, len(rows))
+	for i, a := range rows {
+		resp[i] = response.AgedReceivable{
+			BuyerID:    a.BuyerID,
+			BuyerName:  a.BuyerName,
+			Current:    a.Current,
+			Days31To60: a.Days31To60,
+			Days61To90: a.Days61To90,
+			Over90:     a.Over90,
+			Total:      a.Total,
+		}
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// RegisterRoutes registers the admin payment handler routes to the given mux.
+func (h *PaymentHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /payments", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.Record))
+	mux.HandleFunc("GET /buyers/{id}/ledger", middleware.RequireAdminPermission(model.AdminPermissionViewAccounting, h.Ledger))
+	mux.HandleFunc("GET /receivables/aging", middleware.RequireAdminPermission(model.AdminPermissionViewAccounting, h.Aging))
+}
@@ -0,0 +1,178 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+)
+
+func TestPaymentHandler_Record(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{
+			name:       "Success",
+			body:       `{"buyer_id":1,"method":"cash","amount":5000,"received_at":"2024-01-01T09:00:00+09:00"}`,
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "InvalidJSON",
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "InvalidReceivedAt",
+			body:       `{"buyer_id":1,"method":"cash","amount":5000,"received_at":"2024/01/01"}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "ValidationError",
+			body:       `{"buyer_id":1,"method":"cheque","amount":5000}`,
+			execErr:    &domainErrors.ValidationError{Field: "method", Message: "invalid"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *model.Payment
+			mockReg := &mock.MockRegistry{
+				RecordPaymentUC: &mock.MockRecordPaymentUseCase{
+					ExecuteFunc: func(_ context.Context, p *model.Payment) (*model.Payment, error) {
+						got = p
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						created := *p
+						created.ID = 1
+						created.AllocatedAmount = 3000
+						return &created, nil
+					},
+				},
+			}
+			h := admin.NewPaymentHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/payments", bytes.NewBufferString(tt.body))
+			req = req.WithContext(middleware.WithAdminID(req.Context(), 7))
+			w := httptest.NewRecorder()
+
+			h.Record(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+			if got.RecordedBy == nil || *got.RecordedBy != 7 {
+				t.Errorf("expected recorded_by 7, got %v", got.RecordedBy)
+			}
+			var body struct {
+				UnallocatedAmount int `json:"unallocated_amount"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if body.UnallocatedAmount != 2000 {
+				t.Errorf("expected unallocated 2000, got %d", body.UnallocatedAmount)
+			}
+		})
+	}
+}
+
+func TestPaymentHandler_Ledger(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "1", wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", wantStatus: http.StatusBadRequest},
+		{name: "BuyerNotFound", pathID: "9", execErr: &domainErrors.NotFoundError{Resource: "Buyer", ID: 9}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				GetBuyerLedgerUC: &mock.MockGetBuyerLedgerUseCase{
+					ExecuteFunc: func(_ context.Context, _ int) ([]model.LedgerEntry, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return []model.LedgerEntry{
+							{Date: time.Now(), Type: model.LedgerEntryTypeInvoice, ReferenceID: 1, Debit: 1000, Balance: 1000},
+						}, nil
+					},
+				},
+			}
+			h := admin.NewPaymentHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/buyers/"+tt.pathID+"/ledger", nil)
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.Ledger(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestPaymentHandler_Aging(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockReg := &mock.MockRegistry{
+			ListAgedReceivablesUC: &mock.MockListAgedReceivablesUseCase{
+				ExecuteFunc: func(_ context.Context) ([]model.AgedReceivable, error) {
+					return []model.AgedReceivable{{BuyerID: 1, BuyerName: "A", Current: 100, Total: 100}}, nil
+				},
+			},
+		}
+		h := admin.NewPaymentHandler(mockReg)
+
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/receivables/aging", nil)
+		w := httptest.NewRecorder()
+
+		h.Aging(w, req)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("expected status 200, got %d", w.Code)
+		}
+	})
+
+	t.Run("UseCaseError", func(t *testing.T) {
+		mockReg := &mock.MockRegistry{
+			ListAgedReceivablesUC: &mock.MockListAgedReceivablesUseCase{
+				ExecuteFunc: func(_ context.Context) ([]model.AgedReceivable, error) {
+					return nil, errors.New("db error")
+				},
+			},
+		}
+		h := admin.NewPaymentHandler(mockReg)
+
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/receivables/aging", nil)
+		w := httptest.NewRecorder()
+
+		h.Aging(w, req)
+
+		if w.Code != http.StatusInternalServerError {
+			t.Errorf("expected status 500, got %d", w.Code)
+		}
+	})
+}
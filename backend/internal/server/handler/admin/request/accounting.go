@@ -0,0 +1,12 @@
+package request
+
+// UpdateAccountingSettings holds the account titles used for a venue's journal export.
+type UpdateAccountingSettings struct {
+	SalesAccount      string `json:"sales_account"`
+	ReceivableAccount string `json:"receivable_account"`
+	CashAccount       string `json:"cash_account"`
+	DepositAccount    string `json:"deposit_account"`
+	PurchaseAccount   string `json:"purchase_account"`
+	PayableAccount    string `json:"payable_account"`
+	CommissionAccount string `json:"commission_account"`
+}
@@ -0,0 +1,25 @@
+package request
+
+// CreateAdmin holds data for creating another admin.
+type CreateAdmin struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	// Role is super_admin, clerk, accountant or read_only.
+	Role string `json:"role"`
+}
+
+// UpdateAdminRole holds data for changing the role of an admin.
+type UpdateAdminRole struct {
+	Role string `json:"role"`
+}
+
+// EnableTwoFactor holds the code from the authenticator app that confirms TOTP enrollment.
+type EnableTwoFactor struct {
+	Code string `json:"code"`
+}
+
+// DisableTwoFactor holds the current password and TOTP or recovery code required to turn off two-factor authentication.
+type DisableTwoFactor struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
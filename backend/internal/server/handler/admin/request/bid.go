@@ -0,0 +1,12 @@
+package request
+
+// EnterFloorBid holds data for a bid made on the auction floor and entered by a clerk.
+type EnterFloorBid struct {
+	BuyerID int `json:"buyer_id"`
+	Price   int `json:"price"`
+}
+
+// VoidBid holds the reason for voiding a mistaken bid.
+type VoidBid struct {
+	Reason string `json:"reason"`
+}
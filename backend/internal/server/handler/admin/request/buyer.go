@@ -8,3 +8,44 @@ type CreateBuyer struct {
 	Organization string `json:"organization"`
 	ContactInfo  string `json:"contact_info"`
 }
+
+// UpdateBuyer holds a buyer's details and business profile.
+// LicenseExpiresOn is a date (YYYY-MM-DD); null or empty means the licence has no expiry on record.
+type UpdateBuyer struct {
+	Name                      string  `json:"name"`
+	Organization              string  `json:"organization"`
+	ContactInfo               string  `json:"contact_info"`
+	BusinessName              string  `json:"business_name"`
+	InvoiceRegistrationNumber string  `json:"invoice_registration_number"`
+	Address                   string  `json:"address"`
+	Phone                     string  `json:"phone"`
+	LicenseNumber             string  `json:"license_number"`
+	LicenseExpiresOn          *string `json:"license_expires_on"`
+}
+
+// UpdateBuyerPaddleNumber holds the paddle number to assign to a buyer.
+// An empty value takes the number back.
+type UpdateBuyerPaddleNumber struct {
+	PaddleNumber string `json:"paddle_number"`
+}
+
+// UpdateBuyerCredit holds a buyer's credit limit and deposit.
+// A null credit limit removes the limit.
+type UpdateBuyerCredit struct {
+	CreditLimit *int `json:"credit_limit"`
+	Deposit     int  `json:"deposit"`
+}
+
+// SuspendBuyer holds a suspension to place on a buyer.
+// StartsAt defaults to now and a missing EndsAt suspends the buyer until an admin lifts it.
+type SuspendBuyer struct {
+	Severity string  `json:"severity"`
+	Reason   string  `json:"reason"`
+	StartsAt *string `json:"starts_at"`
+	EndsAt   *string `json:"ends_at"`
+}
+
+// RejectBuyerApplication holds the reason sent to an applicant whose registration is turned down.
+type RejectBuyerApplication struct {
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,19 @@
+package request
+
+// ChargeItem holds a venue charge catalog entry.
+// Active is only honored on update; new entries are always active.
+type ChargeItem struct {
+	Name      string `json:"name"`
+	Unit      string `json:"unit"`
+	UnitPrice int    `json:"unit_price"`
+	TaxRate   int    `json:"tax_rate"`
+	Active    bool   `json:"active"`
+}
+
+// AddBuyerCharge holds a quantity of a catalog charge billed to a buyer.
+type AddBuyerCharge struct {
+	BuyerID      int    `json:"buyer_id"`
+	ChargeItemID int    `json:"charge_item_id"`
+	Quantity     int    `json:"quantity"`
+	Note         string `json:"note"`
+}
@@ -0,0 +1,14 @@
+package request
+
+// ApproveClaim holds an admin's approval of a buyer claim.
+// Amount may be omitted to approve the requested amount.
+type ApproveClaim struct {
+	Amount   int    `json:"amount"`
+	Clawback bool   `json:"clawback"`
+	Note     string `json:"note"`
+}
+
+// RejectClaim holds an admin's rejection of a buyer claim.
+type RejectClaim struct {
+	Note string `json:"note"`
+}
@@ -4,3 +4,29 @@ package request
 type CreateFisherman struct {
 	Name string `json:"name"`
 }
+
+// UpdateFisherman holds a fisherman's name and profile.
+// The bank account has its own endpoint and is left unchanged.
+type UpdateFisherman struct {
+	Name                     string `json:"name"`
+	CooperativeMemberNumber  string `json:"cooperative_member_number"`
+	VesselName               string `json:"vessel_name"`
+	VesselRegistrationNumber string `json:"vessel_registration_number"`
+	Phone                    string `json:"phone"`
+	Address                  string `json:"address"`
+}
+
+// UpdateFishermanBankAccount holds the payout bank account of a fisherman.
+type UpdateFishermanBankAccount struct {
+	BankCode      string `json:"bank_code"`
+	BranchCode    string `json:"branch_code"`
+	AccountType   int    `json:"account_type"`
+	AccountNumber string `json:"account_number"`
+	HolderName    string `json:"account_holder_kana"`
+}
+
+// CreateFishermanLogin holds the portal credentials issued to a fisherman.
+type CreateFishermanLogin struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
@@ -0,0 +1,11 @@
+package request
+
+// RecordPayment holds data for recording a buyer payment.
+type RecordPayment struct {
+	BuyerID    int     `json:"buyer_id"`
+	InvoiceID  *int    `json:"invoice_id"`
+	Method     string  `json:"method"`
+	Amount     int     `json:"amount"`
+	ReceivedAt *string `json:"received_at"`
+	Note       string  `json:"note"`
+}
@@ -0,0 +1,27 @@
+package request
+
+// EnterResults holds the price tags of a paper seri to record in one batch.
+type EnterResults struct {
+	Rows []ResultRow `json:"rows"`
+}
+
+// ResultRow holds a single price tag.
+// The buyer is given by buyer_id or by the paddle_number written on the tag.
+type ResultRow struct {
+	LotNumber    int    `json:"lot_number"`
+	BuyerID      int    `json:"buyer_id"`
+	PaddleNumber string `json:"paddle_number"`
+	Price        int    `json:"price"`
+}
+
+// RequestCorrection holds the corrected result of an awarded lot.
+type RequestCorrection struct {
+	BuyerID int    `json:"buyer_id"`
+	Price   int    `json:"price"`
+	Reason  string `json:"reason"`
+}
+
+// ReviewCorrection holds an admin's note when approving or rejecting a correction.
+type ReviewCorrection struct {
+	Note string `json:"note"`
+}
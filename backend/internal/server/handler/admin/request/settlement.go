@@ -0,0 +1,27 @@
+package request
+
+// GenerateSettlements holds data for generating fisherman settlements of an auction.
+type GenerateSettlements struct {
+	CommissionRate int `json:"commission_rate"`
+}
+
+// ExportTransferFile holds data for exporting a Zengin transfer file.
+type ExportTransferFile struct {
+	SettlementIDs []int          `json:"settlement_ids"`
+	TransferDate  string         `json:"transfer_date"`
+	Remitter      ZenginRemitter `json:"remitter"`
+	// Reissue writes statements that were already exported again instead of rejecting them.
+	Reissue bool `json:"reissue"`
+}
+
+// ZenginRemitter holds the remitter (依頼人) details written to the header record.
+type ZenginRemitter struct {
+	CompanyCode    string `json:"company_code"`
+	NameKana       string `json:"name_kana"`
+	BankCode       string `json:"bank_code"`
+	BankNameKana   string `json:"bank_name_kana"`
+	BranchCode     string `json:"branch_code"`
+	BranchNameKana string `json:"branch_name_kana"`
+	AccountType    int    `json:"account_type"`
+	AccountNumber  string `json:"account_number"`
+}
@@ -0,0 +1,18 @@
+package request
+
+// CreateVenueRegistration holds a buyer's registration at a venue.
+// Dates are YYYY-MM-DD and may be omitted for an open-ended registration.
+type CreateVenueRegistration struct {
+	BuyerID    int     `json:"buyer_id"`
+	VenueID    int     `json:"venue_id"`
+	Status     string  `json:"status"`
+	ValidFrom  *string `json:"valid_from"`
+	ValidUntil *string `json:"valid_until"`
+}
+
+// UpdateVenueRegistration holds the new status and validity period of a registration.
+type UpdateVenueRegistration struct {
+	Status     string  `json:"status"`
+	ValidFrom  *string `json:"valid_from"`
+	ValidUntil *string `json:"valid_until"`
+}
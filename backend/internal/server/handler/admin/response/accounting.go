@@ -0,0 +1,13 @@
+package response
+
+// AccountingSettings represents the account titles a venue uses for journal export.
+type AccountingSettings struct {
+	VenueID           int    `json:"venue_id"`
+	SalesAccount      string `json:"sales_account"`
+	ReceivableAccount string `json:"receivable_account"`
+	CashAccount       string `json:"cash_account"`
+	DepositAccount    string `json:"deposit_account"`
+	PurchaseAccount   string `json:"purchase_account"`
+	PayableAccount    string `json:"payable_account"`
+	CommissionAccount string `json:"commission_account"`
+}
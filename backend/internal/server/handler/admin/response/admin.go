@@ -0,0 +1,35 @@
+package response
+
+import "time"
+
+// Admin represents an admin account and its role.
+type Admin struct {
+	ID    int    `json:"id"`
+	Email string `json:"email"`
+	Role  string `json:"role"`
+	// LockedUntil is set while the account is locked out by failed login attempts.
+	LockedUntil *time.Time `json:"locked_until"`
+	// DisabledAt is set while the account is disabled.
+	DisabledAt            *time.Time `json:"disabled_at"`
+	PasswordResetRequired bool       `json:"password_reset_required"`
+	CreatedAt             time.Time  `json:"created_at"`
+}
+
+// TwoFactorStatus represents the signed-in admin's two-factor authentication.
+type TwoFactorStatus struct {
+	Enabled                bool       `json:"enabled"`
+	EnabledAt              *time.Time `json:"enabled_at"`
+	RecoveryCodesRemaining int        `json:"recovery_codes_remaining"`
+}
+
+// TwoFactorSetup holds the TOTP secret to register in an authenticator app.
+// ProvisioningURI is meant to be rendered as a QR code by the client.
+type TwoFactorSetup struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// TwoFactorEnabled holds the recovery codes. They are shown only once.
+type TwoFactorEnabled struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
@@ -0,0 +1,44 @@
+package response
+
+import "time"
+
+// Bid represents a bid view for admins, including how it was entered and whether it was voided.
+type Bid struct {
+	ID         int        `json:"id"`
+	ItemID     int        `json:"item_id"`
+	BuyerID    int        `json:"buyer_id"`
+	LoginID    *int       `json:"login_id,omitempty"`
+	Price      int        `json:"price"`
+	Channel    string     `json:"channel"`
+	EnteredBy  *int       `json:"entered_by,omitempty"`
+	VoidedAt   *time.Time `json:"voided_at,omitempty"`
+	VoidedBy   *int       `json:"voided_by,omitempty"`
+	VoidReason string     `json:"void_reason,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// BidHistoryEntry represents one bid in a lot's history for admins, with the bidder and how the bid was entered.
+// Online bids also carry the login of the organization's member who placed them.
+// Voided bids stay in the history with who voided them and why.
+type BidHistoryEntry struct {
+	ID           int        `json:"id"`
+	ItemID       int        `json:"item_id"`
+	BuyerID      int        `json:"buyer_id"`
+	BuyerName    string     `json:"buyer_name"`
+	PaddleNumber *string    `json:"paddle_number,omitempty"`
+	LoginID      *int       `json:"login_id,omitempty"`
+	LoginName    *string    `json:"login_name,omitempty"`
+	Price        int        `json:"price"`
+	Channel      string     `json:"channel"`
+	EnteredBy    *int       `json:"entered_by,omitempty"`
+	VoidedAt     *time.Time `json:"voided_at,omitempty"`
+	VoidedBy     *int       `json:"voided_by,omitempty"`
+	VoidReason   *string    `json:"void_reason,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// BidHistory represents one page of a lot's bids, newest first.
+type BidHistory struct {
+	Bids       []BidHistoryEntry `json:"bids"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+}
@@ -4,4 +4,56 @@ package response
 type Buyer struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
+	// PaddleNumber is empty until an admin assigns one.
+	PaddleNumber string `json:"paddle_number"`
+	// CreditLimit is null when the buyer has no limit.
+	CreditLimit *int `json:"credit_limit"`
+	Deposit     int  `json:"deposit"`
+
+	Organization              string  `json:"organization"`
+	ContactInfo               string  `json:"contact_info"`
+	BusinessName              string  `json:"business_name"`
+	InvoiceRegistrationNumber string  `json:"invoice_registration_number"`
+	Address                   string  `json:"address"`
+	Phone                     string  `json:"phone"`
+	LicenseNumber             string  `json:"license_number"`
+	LicenseExpiresOn          *string `json:"license_expires_on"`
+
+	// RegistrationStatus is pending, approved or rejected. Buyers created by an admin are approved.
+	RegistrationStatus string  `json:"registration_status"`
+	RejectionReason    string  `json:"rejection_reason"`
+	ReviewedBy         *int    `json:"reviewed_by"`
+	ReviewedAt         *string `json:"reviewed_at"`
+}
+
+// BuyerApplication represents a self-registration in the review queue.
+type BuyerApplication struct {
+	Buyer
+	Email           string  `json:"email"`
+	EmailVerifiedAt *string `json:"email_verified_at"`
+}
+
+// BuyerCredit represents a buyer's credit limit and how much of it their open commitments use.
+type BuyerCredit struct {
+	BuyerID        int  `json:"buyer_id"`
+	CreditLimit    *int `json:"credit_limit"`
+	Deposit        int  `json:"deposit"`
+	LeadingBids    int  `json:"leading_bids"`
+	UnpaidInvoices int  `json:"unpaid_invoices"`
+	Committed      int  `json:"committed"`
+	Available      *int `json:"available"`
+}
+
+// BuyerSuspension represents a suspension in the audit list.
+type BuyerSuspension struct {
+	ID        int     `json:"id"`
+	BuyerID   int     `json:"buyer_id"`
+	Severity  string  `json:"severity"`
+	Reason    string  `json:"reason"`
+	StartsAt  string  `json:"starts_at"`
+	EndsAt    *string `json:"ends_at"`
+	CreatedBy int     `json:"created_by"`
+	LiftedAt  *string `json:"lifted_at"`
+	LiftedBy  *int    `json:"lifted_by"`
+	CreatedAt string  `json:"created_at"`
 }
@@ -0,0 +1,30 @@
+package response
+
+// ChargeItem represents a venue charge catalog entry.
+type ChargeItem struct {
+	ID        int    `json:"id"`
+	VenueID   int    `json:"venue_id"`
+	Name      string `json:"name"`
+	Unit      string `json:"unit"`
+	UnitPrice int    `json:"unit_price"`
+	TaxRate   int    `json:"tax_rate"`
+	Active    bool   `json:"active"`
+}
+
+// BuyerCharge represents a miscellaneous charge billed to a buyer for an auction.
+type BuyerCharge struct {
+	ID           int    `json:"id"`
+	AuctionID    int    `json:"auction_id"`
+	BuyerID      int    `json:"buyer_id"`
+	BuyerName    string `json:"buyer_name"`
+	ChargeItemID int    `json:"charge_item_id"`
+	Description  string `json:"description"`
+	Unit         string `json:"unit"`
+	UnitPrice    int    `json:"unit_price"`
+	Quantity     int    `json:"quantity"`
+	Amount       int    `json:"amount"`
+	TaxRate      int    `json:"tax_rate"`
+	Note         string `json:"note"`
+	RecordedBy   *int   `json:"recorded_by"`
+	CreatedAt    string `json:"created_at"`
+}
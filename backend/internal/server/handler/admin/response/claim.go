@@ -0,0 +1,48 @@
+package response
+
+// Claim represents a buyer claim for admins.
+type Claim struct {
+	ID              int          `json:"id"`
+	BuyerID         int          `json:"buyer_id"`
+	BuyerName       string       `json:"buyer_name"`
+	PurchaseID      int          `json:"purchase_id"`
+	ItemID          int          `json:"item_id"`
+	AuctionID       int          `json:"auction_id"`
+	FishermanID     int          `json:"fisherman_id"`
+	FishType        string       `json:"fish_type"`
+	PurchasePrice   int          `json:"purchase_price"`
+	Type            string       `json:"type"`
+	Reason          string       `json:"reason"`
+	RequestedAmount int          `json:"requested_amount"`
+	ApprovedAmount  *int         `json:"approved_amount"`
+	Clawback        bool         `json:"clawback"`
+	Status          string       `json:"status"`
+	ResolutionNote  string       `json:"resolution_note"`
+	ResolvedBy      *int         `json:"resolved_by"`
+	ResolvedAt      *string      `json:"resolved_at"`
+	CreatedAt       string       `json:"created_at"`
+	Events          []ClaimEvent `json:"events,omitempty"`
+	CreditNote      *CreditNote  `json:"credit_note,omitempty"`
+}
+
+// ClaimEvent represents an entry of a claim's audit trail.
+type ClaimEvent struct {
+	Action    string `json:"action"`
+	ActorType string `json:"actor_type"`
+	ActorID   int    `json:"actor_id"`
+	Amount    *int   `json:"amount"`
+	Note      string `json:"note"`
+	CreatedAt string `json:"created_at"`
+}
+
+// CreditNote represents a credit note issued for an approved claim or result correction.
+type CreditNote struct {
+	ID          int    `json:"id"`
+	InvoiceID   int    `json:"invoice_id"`
+	Amount      int    `json:"amount"`
+	TaxRate     int    `json:"tax_rate"`
+	TaxAmount   int    `json:"tax_amount"`
+	TotalAmount int    `json:"total_amount"`
+	IssuedBy    *int   `json:"issued_by"`
+	CreatedAt   string `json:"created_at"`
+}
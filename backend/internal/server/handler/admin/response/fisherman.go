@@ -2,6 +2,27 @@ package response
 
 // Fisherman represents a view of a fisherman for admins.
 type Fisherman struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID                       int          `json:"id"`
+	Name                     string       `json:"name"`
+	CooperativeMemberNumber  string       `json:"cooperative_member_number"`
+	VesselName               string       `json:"vessel_name"`
+	VesselRegistrationNumber string       `json:"vessel_registration_number"`
+	Phone                    string       `json:"phone"`
+	Address                  string       `json:"address"`
+	BankAccount              *BankAccount `json:"bank_account,omitempty"`
+}
+
+// BankAccount represents the payout bank account of a fisherman.
+type BankAccount struct {
+	BankCode      string `json:"bank_code"`
+	BranchCode    string `json:"branch_code"`
+	AccountType   int    `json:"account_type"`
+	AccountNumber string `json:"account_number"`
+	HolderKana    string `json:"account_holder_kana"`
+}
+
+// FishermanLogin represents the portal login issued to a fisherman.
+type FishermanLogin struct {
+	FishermanID int    `json:"fisherman_id"`
+	Email       string `json:"email"`
 }
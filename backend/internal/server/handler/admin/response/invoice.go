@@ -6,3 +6,33 @@ type Invoice struct {
 	BuyerName   string `json:"buyer_name"`
 	TotalAmount int    `json:"total_amount"`
 }
+
+// InvoiceDetail represents a persisted invoice for admins.
+type InvoiceDetail struct {
+	ID                int           `json:"id"`
+	BuyerID           int           `json:"buyer_id"`
+	BuyerName         string        `json:"buyer_name"`
+	AuctionID         int           `json:"auction_id"`
+	Status            string        `json:"status"`
+	Subtotal          int           `json:"subtotal"`
+	TaxAmount         int           `json:"tax_amount"`
+	TotalAmount       int           `json:"total_amount"`
+	CreditedAmount    int           `json:"credited_amount"`
+	PaidAmount        int           `json:"paid_amount"`
+	OutstandingAmount int           `json:"outstanding_amount"`
+	IssuedAt          *string       `json:"issued_at"`
+	PaidAt            *string       `json:"paid_at"`
+	Lines             []InvoiceLine `json:"lines,omitempty"`
+}
+
+// InvoiceLine represents a single line of an invoice.
+type InvoiceLine struct {
+	ID          int    `json:"id"`
+	ItemID      *int   `json:"item_id"`
+	ChargeID    *int   `json:"charge_id"`
+	Description string `json:"description"`
+	Quantity    int    `json:"quantity"`
+	Unit        string `json:"unit"`
+	Amount      int    `json:"amount"`
+	TaxRate     int    `json:"tax_rate"`
+}
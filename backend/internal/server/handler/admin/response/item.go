@@ -4,15 +4,15 @@ import "time"
 
 // Item represents a detailed view of an auction item for admins.
 type Item struct {
-	ID                int       `json:"id"`
-	AuctionID         int       `json:"auction_id"`
-	FishermanID       int       `json:"fisherman_id"`
-	FishType          string    `json:"fish_type"`
-	Quantity          int       `json:"quantity"`
-	Unit              string    `json:"unit"`
-	HighestBid        *int      `json:"highest_bid,omitempty"`
-	HighestBidderID   *int      `json:"highest_bidder_id,omitempty"`
-	HighestBidderName *string   `json:"highest_bidder_name,omitempty"`
-	SortOrder         int       `json:"sort_order"`
-	CreatedAt         time.Time `json:"created_at"`
+	ID                  int       `json:"id"`
+	AuctionID           int       `json:"auction_id"`
+	FishermanID         int       `json:"fisherman_id"`
+	FishType            string    `json:"fish_type"`
+	Quantity            int       `json:"quantity"`
+	Unit                string    `json:"unit"`
+	HighestBid          *int      `json:"highest_bid,omitempty"`
+	HighestBidderID     *int      `json:"highest_bidder_id,omitempty"`
+	HighestBidderPaddle *string   `json:"highest_bidder_paddle,omitempty"`
+	SortOrder           int       `json:"sort_order"`
+	CreatedAt           time.Time `json:"created_at"`
 }
@@ -0,0 +1,18 @@
+package response
+
+// LabelScan represents the lot a scanned label resolves to, as seen by floor staff.
+type LabelScan struct {
+	ItemID              int     `json:"item_id"`
+	AuctionID           int     `json:"auction_id"`
+	LotNumber           int     `json:"lot_number"`
+	FishermanID         int     `json:"fisherman_id"`
+	FishType            string  `json:"fish_type"`
+	Quantity            int     `json:"quantity"`
+	Unit                string  `json:"unit"`
+	HighestBid          *int    `json:"highest_bid,omitempty"`
+	HighestBidderID     *int    `json:"highest_bidder_id,omitempty"`
+	HighestBidderPaddle *string `json:"highest_bidder_paddle,omitempty"`
+	MinimumBid          int     `json:"minimum_bid"`
+	AuctionStatus       string  `json:"auction_status"`
+	BiddingOpen         bool    `json:"bidding_open"`
+}
@@ -0,0 +1,38 @@
+package response
+
+// Payment represents a recorded buyer payment.
+type Payment struct {
+	ID                int    `json:"id"`
+	BuyerID           int    `json:"buyer_id"`
+	InvoiceID         *int   `json:"invoice_id"`
+	Method            string `json:"method"`
+	Amount            int    `json:"amount"`
+	AllocatedAmount   int    `json:"allocated_amount"`
+	UnallocatedAmount int    `json:"unallocated_amount"`
+	ReceivedAt        string `json:"received_at"`
+	Note              string `json:"note"`
+	RecordedBy        *int   `json:"recorded_by"`
+	CreatedAt         string `json:"created_at"`
+}
+
+// LedgerEntry represents a single line of a buyer's running ledger.
+type LedgerEntry struct {
+	Date        string `json:"date"`
+	Type        string `json:"type"`
+	ReferenceID int    `json:"reference_id"`
+	Description string `json:"description"`
+	Debit       int    `json:"debit"`
+	Credit      int    `json:"credit"`
+	Balance     int    `json:"balance"`
+}
+
+// AgedReceivable represents one buyer's row in the aged-receivables report.
+type AgedReceivable struct {
+	BuyerID    int    `json:"buyer_id"`
+	BuyerName  string `json:"buyer_name"`
+	Current    int    `json:"current"`
+	Days31To60 int    `json:"days_31_60"`
+	Days61To90 int    `json:"days_61_90"`
+	Over90     int    `json:"over_90"`
+	Total      int    `json:"total"`
+}
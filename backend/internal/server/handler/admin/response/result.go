@@ -0,0 +1,43 @@
+package response
+
+// EnterResults represents the outcome of a committed result batch.
+type EnterResults struct {
+	Created int   `json:"created"`
+	Bids    []Bid `json:"bids"`
+}
+
+// ResultBatchError represents a rejected result batch with the problem on each row.
+type ResultBatchError struct {
+	Error   string           `json:"error"`
+	Message string           `json:"message"`
+	Code    int              `json:"code"`
+	Rows    []ResultRowError `json:"rows"`
+}
+
+// ResultRowError represents the problem with one row of a result batch.
+type ResultRowError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ResultCorrection represents a requested change to the buyer or price of an awarded lot.
+type ResultCorrection struct {
+	ID          int         `json:"id"`
+	PurchaseID  int         `json:"purchase_id"`
+	ItemID      int         `json:"item_id"`
+	AuctionID   int         `json:"auction_id"`
+	FishType    string      `json:"fish_type"`
+	OldBuyerID  int         `json:"old_buyer_id"`
+	OldPrice    int         `json:"old_price"`
+	NewBuyerID  int         `json:"new_buyer_id"`
+	NewPrice    int         `json:"new_price"`
+	Reason      string      `json:"reason"`
+	Status      string      `json:"status"`
+	RequestedBy int         `json:"requested_by"`
+	ReviewedBy  *int        `json:"reviewed_by"`
+	ReviewNote  string      `json:"review_note"`
+	ReviewedAt  *string     `json:"reviewed_at"`
+	CreatedAt   string      `json:"created_at"`
+	CreditNote  *CreditNote `json:"credit_note,omitempty"`
+}
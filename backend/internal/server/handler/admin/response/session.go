@@ -0,0 +1,22 @@
+package response
+
+import "time"
+
+// Session represents one signed-in device of an admin or a buyer.
+// ID is not the session cookie; it only identifies the session for revocation.
+type Session struct {
+	ID string `json:"id"`
+	// LoginID is the individual login of a buyer session.
+	LoginID    int       `json:"login_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	IPAddress  string    `json:"ip_address"`
+	UserAgent  string    `json:"user_agent"`
+	// Current marks the session the request was made with.
+	Current bool `json:"current"`
+}
+
+// SessionsRevoked reports how many sessions were signed out.
+type SessionsRevoked struct {
+	Revoked int `json:"revoked"`
+}
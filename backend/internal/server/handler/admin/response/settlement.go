@@ -0,0 +1,28 @@
+package response
+
+// Settlement represents a fisherman settlement statement (仕切書) for admins.
+type Settlement struct {
+	ID               int              `json:"id"`
+	FishermanID      int              `json:"fisherman_id"`
+	FishermanName    string           `json:"fisherman_name"`
+	AuctionID        int              `json:"auction_id"`
+	Status           string           `json:"status"`
+	GrossAmount      int              `json:"gross_amount"`
+	CommissionRate   int              `json:"commission_rate"`
+	CommissionAmount int              `json:"commission_amount"`
+	CommissionTax    int              `json:"commission_tax"`
+	NetAmount        int              `json:"net_amount"`
+	SettledAt        *string          `json:"settled_at"`
+	ExportedAt       *string          `json:"exported_at"`
+	Lines            []SettlementLine `json:"lines,omitempty"`
+}
+
+// SettlementLine represents a single sold item on a settlement statement.
+type SettlementLine struct {
+	ID          int    `json:"id"`
+	ItemID      *int   `json:"item_id"`
+	Description string `json:"description"`
+	Quantity    int    `json:"quantity"`
+	Unit        string `json:"unit"`
+	Amount      int    `json:"amount"`
+}
@@ -0,0 +1,13 @@
+package response
+
+// VenueRegistration represents a buyer's eligibility to bid at a venue.
+type VenueRegistration struct {
+	ID         int     `json:"id"`
+	BuyerID    int     `json:"buyer_id"`
+	VenueID    int     `json:"venue_id"`
+	Status     string  `json:"status"`
+	ValidFrom  *string `json:"valid_from"`
+	ValidUntil *string `json:"valid_until"`
+	CreatedAt  string  `json:"created_at"`
+	UpdatedAt  string  `json:"updated_at"`
+}
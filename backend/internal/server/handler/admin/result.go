@@ -0,0 +1,267 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/result"
+)
+
+// ResultHandler handles admin HTTP requests for entering auction results in bulk and correcting them.
+type ResultHandler struct {
+	enterUseCase             result.EnterResultsUseCase
+	requestCorrectionUseCase result.RequestCorrectionUseCase
+	approveCorrectionUseCase result.ApproveCorrectionUseCase
+	rejectCorrectionUseCase  result.RejectCorrectionUseCase
+	listCorrectionsUseCase   result.ListCorrectionsUseCase
+}
+
+// NewResultHandler creates a new ResultHandler instance.
+func NewResultHandler(r registry.UseCase) *ResultHandler {
+	return &ResultHandler{
+		enterUseCase:             r.NewEnterResultsUseCase(),
+		requestCorrectionUseCase: r.NewRequestCorrectionUseCase(),
+		approveCorrectionUseCase: r.NewApproveCorrectionUseCase(),
+		rejectCorrectionUseCase:  r.NewRejectCorrectionUseCase(),
+		listCorrectionsUseCase:   r.NewListCorrectionsUseCase(),
+	}
+}
+
+// Enter handles the request to record the price tags of a paper seri.
+// The rows are sent as JSON, as a text/csv body, or as a CSV file in the "file" field of a multipart form.
+func (h *ResultHandler) Enter(w http.ResponseWriter, r *http.Request) {
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid auction ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	entries, err := readResultEntries(r)
+	if err != nil {
+		writeResultError(w, err)
+		return
+	}
+
+	bids, err := h.enterUseCase.Execute(r.Context(), adminID, auctionID, entries)
+	if err != nil {
+		writeResultError(w, err)
+		return
+	}
+
+	resp := response.EnterResults{Created: len(bids), Bids: make([]response.Bid, len(bids))}
+	for i := range bids {
+		resp.Bids[i] = toBidResponse(&bids[i])
+	}
+	util.WriteJSON(w, http.StatusCreated, resp)
+}
+
+var errInvalidResultBody = errors.New("invalid result body")
+
+func readResultEntries(r *http.Request) ([]model.ResultEntry, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/csv":
+		return model.ParseResultCSV(r.Body)
+	case "multipart/form-data":
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			return nil, errInvalidResultBody
+		}
+		defer func() { _ = file.Close() }()
+		return model.ParseResultCSV(file)
+	default:
+		var req request.EnterResults
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, errInvalidResultBody
+		}
+		entries := make([]model.ResultEntry, len(req.Rows))
+		for i, row := range req.Rows {
+			entries[i] = model.ResultEntry{
+				Row:          i + 1,
+				LotNumber:    row.LotNumber,
+				BuyerID:      row.BuyerID,
+				PaddleNumber: row.PaddleNumber,
+				Price:        row.Price,
+			}
+		}
+		return entries, nil
+	}
+}
+
+func writeResultError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errInvalidResultBody) {
+		util.WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	var batchErr *model.ResultBatchError
+	if !errors.As(err, &batchErr) {
+		util.HandleError(w, err)
+		return
+	}
+	rows := make([]response.ResultRowError, len(batchErr.Rows))
+	for i, e := range batchErr.Rows {
+		rows[i] = response.ResultRowError{Row: e.Row, Field: e.Field, Message: e.Message}
+	}
+	util.WriteJSON(w, http.StatusBadRequest, response.ResultBatchError{
+		Error:   "validation_error",
+		Message: batchErr.Error(),
+		Code:    http.StatusBadRequest,
+		Rows:    rows,
+	})
+}
+
+// ListCorrections handles the request to list result corrections, optionally filtered by status and auction.
+func (h *ResultHandler) ListCorrections(w http.ResponseWriter, r *http.Request) {
+	filters := &repository.ResultCorrectionFilters{}
+	if s := r.URL.Query().Get("status"); s != "" {
+		status := model.ResultCorrectionStatus(s)
+		if !status.IsValid() {
+			util.WriteError(w, http.StatusBadRequest, "Invalid status")
+			return
+		}
+		filters.Status = &status
+	}
+	if s := r.URL.Query().Get("auction_id"); s != "" {
+		auctionID, err := strconv.Atoi(s)
+		if err != nil {
+			util.WriteError(w, http.StatusBadRequest, "Invalid auction_id")
+			return
+		}
+		filters.AuctionID = &auctionID
+	}
+
+	corrections, err := h.listCorrectionsUseCase.Execute(r.Context(), filters)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.ResultCorrection, len(corrections))
+	for i := range corrections {
+		resp[i] = toResultCorrectionResponse(&corrections[i])
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// RequestCorrection handles the request to correct the buyer or price of an awarded lot.
+// The correction takes effect only after another admin approves it.
+func (h *ResultHandler) RequestCorrection(w http.ResponseWriter, r *http.Request) {
+	itemID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid item ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.RequestCorrection
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	c, err := h.requestCorrectionUseCase.Execute(r.Context(), adminID, itemID, result.RequestCorrectionInput{
+		BuyerID: req.BuyerID,
+		Price:   req.Price,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusCreated, toResultCorrectionResponse(c))
+}
+
+// ApproveCorrection handles the request to approve a result correction.
+func (h *ResultHandler) ApproveCorrection(w http.ResponseWriter, r *http.Request) {
+	h.reviewCorrection(w, r, h.approveCorrectionUseCase.Execute)
+}
+
+// RejectCorrection handles the request to reject a result correction.
+func (h *ResultHandler) RejectCorrection(w http.ResponseWriter, r *http.Request) {
+	h.reviewCorrection(w, r, h.rejectCorrectionUseCase.Execute)
+}
+
+func (h *ResultHandler) reviewCorrection(
+	w http.ResponseWriter,
+	r *http.Request,
+	review func(ctx context.Context, id, adminID int, note string) (*model.ResultCorrection, error),
+) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid ID")
+		return
+	}
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.ReviewCorrection
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	c, err := review(r.Context(), id, adminID, req.Note)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toResultCorrectionResponse(c))
+}
+
+// RegisterRoutes registers the admin result handler routes to the given mux.
+func (h *ResultHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /auctions/{id}/results", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Enter))
+	mux.HandleFunc("GET /corrections", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.ListCorrections))
+	mux.HandleFunc("POST /items/{id}/corrections", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.RequestCorrection))
+	mux.HandleFunc("POST /corrections/{id}/approve", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.ApproveCorrection))
+	mux.HandleFunc("POST /corrections/{id}/reject", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.RejectCorrection))
+}
+
+func toResultCorrectionResponse(c *model.ResultCorrection) response.ResultCorrection {
+	resp := response.ResultCorrection{
+		ID:          c.ID,
+		PurchaseID:  c.PurchaseID,
+		ItemID:      c.ItemID,
+		AuctionID:   c.AuctionID,
+		FishType:    c.FishType,
+		OldBuyerID:  c.OldBuyerID,
+		OldPrice:    c.OldPrice,
+		NewBuyerID:  c.NewBuyerID,
+		NewPrice:    c.NewPrice,
+		Reason:      c.Reason,
+		Status:      string(c.Status),
+		RequestedBy: c.RequestedBy,
+		ReviewedBy:  c.ReviewedBy,
+		ReviewNote:  c.ReviewNote,
+		ReviewedAt:  util.FormatTimestamp(c.ReviewedAt),
+		CreatedAt:   c.CreatedAt.Format(time.RFC3339),
+	}
+	if c.CreditNote != nil {
+		resp.CreditNote = toCreditNoteResponse(c.CreditNote)
+	}
+	return resp
+}
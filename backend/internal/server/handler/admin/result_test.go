@@ -0,0 +1,337 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/result"
+)
+
+func multipartCSV(t *testing.T, content string) (*bytes.Buffer, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fw, err := mw.CreateFormFile("file", "results.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = fw.Write([]byte(content))
+	_ = mw.Close()
+	return &buf, mw.FormDataContentType()
+}
+
+func TestResultHandler_Enter(t *testing.T) {
+	csvBody := "lot_number,buyer_id,price\n1,2,1500\n2,3,800\n"
+	formBody, formType := multipartCSV(t, csvBody)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        *bytes.Buffer
+		withAdmin   bool
+		execErr     error
+		wantStatus  int
+		wantEntries []model.ResultEntry
+		wantRowErrs int
+	}{
+		{
+			name:        "JSON",
+			contentType: "application/json",
+			body:        bytes.NewBufferString(`{"rows":[{"lot_number":1,"buyer_id":2,"price":1500}]}`),
+			withAdmin:   true,
+			wantStatus:  http.StatusCreated,
+			wantEntries: []model.ResultEntry{{Row: 1, LotNumber: 1, BuyerID: 2, Price: 1500}},
+		},
+		{
+			name:        "JSONPaddleNumber",
+			contentType: "application/json",
+			body:        bytes.NewBufferString(`{"rows":[{"lot_number":1,"paddle_number":"A-12","price":1500}]}`),
+			withAdmin:   true,
+			wantStatus:  http.StatusCreated,
+			wantEntries: []model.ResultEntry{{Row: 1, LotNumber: 1, PaddleNumber: "A-12", Price: 1500}},
+		},
+		{
+			name:        "CSVBody",
+			contentType: "text/csv; charset=utf-8",
+			body:        bytes.NewBufferString(csvBody),
+			withAdmin:   true,
+			wantStatus:  http.StatusCreated,
+			wantEntries: []model.ResultEntry{{Row: 2, LotNumber: 1, BuyerID: 2, Price: 1500}, {Row: 3, LotNumber: 2, BuyerID: 3, Price: 800}},
+		},
+		{
+			name:        "CSVUpload",
+			contentType: formType,
+			body:        formBody,
+			withAdmin:   true,
+			wantStatus:  http.StatusCreated,
+			wantEntries: []model.ResultEntry{{Row: 2, LotNumber: 1, BuyerID: 2, Price: 1500}, {Row: 3, LotNumber: 2, BuyerID: 3, Price: 800}},
+		},
+		{
+			name:        "MalformedCSV",
+			contentType: "text/csv",
+			body:        bytes.NewBufferString("lot_number,buyer_id,price\n1,2,abc\n"),
+			withAdmin:   true,
+			wantStatus:  http.StatusBadRequest,
+			wantRowErrs: 1,
+		},
+		{
+			name:        "RowErrorsFromUseCase",
+			contentType: "application/json",
+			body:        bytes.NewBufferString(`{"rows":[{"lot_number":9,"buyer_id":2,"price":1500}]}`),
+			withAdmin:   true,
+			execErr:     &model.ResultBatchError{Rows: []model.ResultEntryError{{Row: 1, Field: "lot_number", Message: "no such lot in this auction"}}},
+			wantStatus:  http.StatusBadRequest,
+			wantRowErrs: 1,
+		},
+		{
+			name:        "AuctionNotFound",
+			contentType: "application/json",
+			body:        bytes.NewBufferString(`{"rows":[{"lot_number":1,"buyer_id":2,"price":1500}]}`),
+			withAdmin:   true,
+			execErr:     &domainErrors.NotFoundError{Resource: "Auction", ID: 1},
+			wantStatus:  http.StatusNotFound,
+		},
+		{name: "InvalidJSON", contentType: "application/json", body: bytes.NewBufferString(`{`), withAdmin: true, wantStatus: http.StatusBadRequest},
+		{name: "NoAdmin", contentType: "application/json", body: bytes.NewBufferString(`{}`), wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAdmin, gotAuction int
+			var gotEntries []model.ResultEntry
+			mockReg := &mock.MockRegistry{
+				EnterResultsUC: &mock.MockEnterResultsUseCase{
+					ExecuteFunc: func(_ context.Context, adminID, auctionID int, entries []model.ResultEntry) ([]model.Bid, error) {
+						gotAdmin, gotAuction, gotEntries = adminID, auctionID, entries
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						bids := make([]model.Bid, len(entries))
+						for i, e := range entries {
+							bids[i] = model.Bid{ID: i + 1, ItemID: e.LotNumber + 100, BuyerID: e.BuyerID, Price: model.NewBidPrice(e.Price), Channel: model.BidChannelFloor, EnteredBy: &adminID}
+						}
+						return bids, nil
+					},
+				},
+			}
+			h := admin.NewResultHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/auctions/1/results", tt.body)
+			req.Header.Set("Content-Type", tt.contentType)
+			req.SetPathValue("id", "1")
+			if tt.withAdmin {
+				req = req.WithContext(middleware.WithAdminID(req.Context(), 9))
+			}
+			w := httptest.NewRecorder()
+
+			h.Enter(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantRowErrs > 0 {
+				var resp response.ResultBatchError
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if len(resp.Rows) != tt.wantRowErrs || resp.Error != "validation_error" {
+					t.Errorf("unexpected error response: %+v", resp)
+				}
+				return
+			}
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+			if gotAdmin != 9 || gotAuction != 1 {
+				t.Errorf("unexpected call: admin %d, auction %d", gotAdmin, gotAuction)
+			}
+			if len(gotEntries) != len(tt.wantEntries) {
+				t.Fatalf("expected entries %+v, got %+v", tt.wantEntries, gotEntries)
+			}
+			for i := range tt.wantEntries {
+				if gotEntries[i] != tt.wantEntries[i] {
+					t.Errorf("entry %d: expected %+v, got %+v", i, tt.wantEntries[i], gotEntries[i])
+				}
+			}
+			var resp response.EnterResults
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Created != len(tt.wantEntries) || resp.Bids[0].Channel != "floor" {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestResultHandler_RequestCorrection(t *testing.T) {
+	tests := []struct {
+		name       string
+		itemID     string
+		body       string
+		withAdmin  bool
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", itemID: "10", body: `{"buyer_id":3,"price":5000,"reason":"札の読み違い"}`, withAdmin: true, wantStatus: http.StatusCreated},
+		{name: "InvalidItemID", itemID: "x", body: `{}`, withAdmin: true, wantStatus: http.StatusBadRequest},
+		{name: "NotAuthenticated", itemID: "10", body: `{}`, wantStatus: http.StatusUnauthorized},
+		{name: "InvalidJSON", itemID: "10", body: `{`, withAdmin: true, wantStatus: http.StatusBadRequest},
+		{
+			name:       "AlreadyPending",
+			itemID:     "10",
+			body:       `{"buyer_id":3,"price":5000,"reason":"札の読み違い"}`,
+			withAdmin:  true,
+			execErr:    &domainErrors.ConflictError{Message: "a correction for this lot is already pending"},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotAdmin, gotItem int
+			var gotInput result.RequestCorrectionInput
+			mockReg := &mock.MockRegistry{
+				RequestCorrectionUC: &mock.MockRequestCorrectionUseCase{
+					ExecuteFunc: func(_ context.Context, adminID, itemID int, input result.RequestCorrectionInput) (*model.ResultCorrection, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						gotAdmin, gotItem, gotInput = adminID, itemID, input
+						return &model.ResultCorrection{
+							ID: 1, ItemID: itemID, OldBuyerID: 2, OldPrice: 5000,
+							NewBuyerID: input.BuyerID, NewPrice: input.Price, Reason: input.Reason,
+							Status: model.ResultCorrectionStatusPending, RequestedBy: adminID,
+						}, nil
+					},
+				},
+			}
+			h := admin.NewResultHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/items/"+tt.itemID+"/corrections", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.itemID)
+			if tt.withAdmin {
+				req = req.WithContext(middleware.WithAdminID(req.Context(), 7))
+			}
+			w := httptest.NewRecorder()
+
+			h.RequestCorrection(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusCreated {
+				return
+			}
+			if gotAdmin != 7 || gotItem != 10 || gotInput.BuyerID != 3 || gotInput.Reason != "札の読み違い" {
+				t.Errorf("unexpected call: admin %d, item %d, input %+v", gotAdmin, gotItem, gotInput)
+			}
+			var resp response.ResultCorrection
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.OldBuyerID != 2 || resp.NewBuyerID != 3 || resp.Status != "pending" {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestResultHandler_ApproveCorrection(t *testing.T) {
+	tests := []struct {
+		name       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", wantStatus: http.StatusOK},
+		{
+			name:       "SameAdmin",
+			execErr:    &domainErrors.ForbiddenError{Message: "a correction must be approved by a different admin than the one who requested it"},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "AlreadyReviewed",
+			execErr:    &domainErrors.ConflictError{Message: "correction has already been reviewed"},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ApproveCorrectionUC: &mock.MockApproveCorrectionUseCase{
+					ExecuteFunc: func(_ context.Context, id, adminID int, _ string) (*model.ResultCorrection, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.ResultCorrection{
+							ID: id, Status: model.ResultCorrectionStatusApproved, ReviewedBy: &adminID,
+							CreditNote: &model.CreditNote{ID: 30, TotalAmount: 1080},
+						}, nil
+					},
+				},
+			}
+			h := admin.NewResultHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/corrections/1/approve", bytes.NewBufferString(`{"note":"確認済み"}`))
+			req.SetPathValue("id", "1")
+			req = req.WithContext(middleware.WithAdminID(req.Context(), 8))
+			w := httptest.NewRecorder()
+
+			h.ApproveCorrection(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var resp response.ResultCorrection
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Status != "approved" || resp.CreditNote == nil || resp.CreditNote.TotalAmount != 1080 {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestResultHandler_ListCorrections(t *testing.T) {
+	var gotStatus *model.ResultCorrectionStatus
+	mockReg := &mock.MockRegistry{
+		ListCorrectionsUC: &mock.MockListCorrectionsUseCase{
+			ExecuteFunc: func(_ context.Context, filters *repository.ResultCorrectionFilters) ([]model.ResultCorrection, error) {
+				gotStatus = filters.Status
+				return []model.ResultCorrection{{ID: 1, Status: model.ResultCorrectionStatusPending}}, nil
+			},
+		},
+	}
+	h := admin.NewResultHandler(mockReg)
+
+	for query, want := range map[string]int{"?status=pending": http.StatusOK, "?status=done": http.StatusBadRequest, "?auction_id=x": http.StatusBadRequest} {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/corrections"+query, nil)
+		w := httptest.NewRecorder()
+
+		h.ListCorrections(w, req)
+
+		if w.Code != want {
+			t.Errorf("query %s: expected status %d, got %d", query, want, w.Code)
+		}
+	}
+	if gotStatus == nil || *gotStatus != model.ResultCorrectionStatusPending {
+		t.Errorf("expected pending status filter, got %v", gotStatus)
+	}
+}
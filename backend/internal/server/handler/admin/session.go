@@ -0,0 +1,141 @@
+package admin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/session"
+)
+
+// SessionHandler handles admin HTTP requests related to signed-in sessions, the admin's own and buyers'.
+type SessionHandler struct {
+	listUseCase       session.ListSessionsUseCase
+	revokeUseCase     session.RevokeSessionUseCase
+	revokeManyUseCase session.RevokeSessionsUseCase
+}
+
+// NewSessionHandler creates a new SessionHandler instance.
+func NewSessionHandler(r registry.UseCase) *SessionHandler {
+	return &SessionHandler{
+		listUseCase:       r.NewListSessionsUseCase(),
+		revokeUseCase:     r.NewRevokeSessionUseCase(),
+		revokeManyUseCase: r.NewRevokeSessionsUseCase(),
+	}
+}
+
+// List handles the request to list the signed-in admin's sessions.
+func (h *SessionHandler) List(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	h.list(w, r, model.SessionOwner{Role: model.SessionRoleAdmin, UserID: adminID})
+}
+
+// Revoke handles the request to sign out one of the signed-in admin's sessions.
+func (h *SessionHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	h.revoke(w, r, model.SessionOwner{Role: model.SessionRoleAdmin, UserID: adminID}, r.PathValue("id"))
+}
+
+// RevokeOthers handles the request to sign out every session of the signed-in admin but the current one.
+func (h *SessionHandler) RevokeOthers(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := middleware.AdminIDFromContext(r.Context())
+	current, hasSession := middleware.SessionIDFromContext(r.Context())
+	if !ok || !hasSession {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	h.revokeMany(w, r, model.SessionOwner{Role: model.SessionRoleAdmin, UserID: adminID}, current)
+}
+
+// ListBuyerSessions handles the request to list the sessions of every login of a buyer.
+func (h *SessionHandler) ListBuyerSessions(w http.ResponseWriter, r *http.Request) {
+	buyerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+	h.list(w, r, model.SessionOwner{Role: model.SessionRoleBuyer, UserID: buyerID})
+}
+
+// RevokeBuyerSession handles the request to sign out one session of a buyer.
+func (h *SessionHandler) RevokeBuyerSession(w http.ResponseWriter, r *http.Request) {
+	buyerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+	h.revoke(w, r, model.SessionOwner{Role: model.SessionRoleBuyer, UserID: buyerID}, r.PathValue("sessionId"))
+}
+
+// RevokeBuyerSessions handles the request to sign out every session of a buyer.
+func (h *SessionHandler) RevokeBuyerSessions(w http.ResponseWriter, r *http.Request) {
+	buyerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid buyer ID")
+		return
+	}
+	h.revokeMany(w, r, model.SessionOwner{Role: model.SessionRoleBuyer, UserID: buyerID}, "")
+}
+
+func (h *SessionHandler) list(w http.ResponseWriter, r *http.Request, owner model.SessionOwner) {
+	sessions, err := h.listUseCase.Execute(r.Context(), owner)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	current, _ := middleware.SessionIDFromContext(r.Context())
+	resp := make([]response.Session, 0, len(sessions))
+	for _, s := range sessions {
+		resp = append(resp, response.Session{
+			ID:         s.PublicID(),
+			LoginID:    s.LoginID,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			IPAddress:  s.IPAddress,
+			UserAgent:  s.UserAgent,
+			Current:    s.ID == current,
+		})
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+func (h *SessionHandler) revoke(w http.ResponseWriter, r *http.Request, owner model.SessionOwner, publicID string) {
+	if err := h.revokeUseCase.Execute(r.Context(), owner, publicID); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, response.Message{Message: "Session revoked"})
+}
+
+func (h *SessionHandler) revokeMany(w http.ResponseWriter, r *http.Request, owner model.SessionOwner, keepSessionID string) {
+	n, err := h.revokeManyUseCase.Execute(r.Context(), owner, keepSessionID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, response.SessionsRevoked{Revoked: n})
+}
+
+// RegisterRoutes registers the admin session handler routes to the given mux.
+// 自分のセッションは権限によらず操作できる。購入者のセッションは購入者管理と同じ権限で扱う。
+func (h *SessionHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /sessions", h.List)
+	mux.HandleFunc("DELETE /sessions", h.RevokeOthers)
+	mux.HandleFunc("DELETE /sessions/{id}", h.Revoke)
+	mux.HandleFunc("GET /buyers/{id}/sessions", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.ListBuyerSessions))
+	mux.HandleFunc("DELETE /buyers/{id}/sessions", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.RevokeBuyerSessions))
+	mux.HandleFunc("DELETE /buyers/{id}/sessions/{sessionId}", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.RevokeBuyerSession))
+}
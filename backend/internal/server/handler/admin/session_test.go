@@ -0,0 +1,95 @@
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+)
+
+func TestSessionHandler_Routes(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	var gotOwner model.SessionOwner
+	var gotKeep string
+	mockReg := &mock.MockRegistry{
+		ListSessionsUC: &mock.MockListSessionsUseCase{
+			ExecuteFunc: func(_ context.Context, owner model.SessionOwner) ([]model.Session, error) {
+				gotOwner = owner
+				return []model.Session{
+					{ID: "current-session", UserID: owner.UserID, Role: owner.Role, LastSeenAt: now},
+					{ID: "phone-session", UserID: owner.UserID, LoginID: 12, Role: owner.Role, LastSeenAt: now.Add(-time.Hour)},
+				}, nil
+			},
+		},
+		RevokeSessionUC: &mock.MockRevokeSessionUseCase{
+			ExecuteFunc: func(_ context.Context, owner model.SessionOwner, _ string) error {
+				gotOwner = owner
+				return nil
+			},
+		},
+		RevokeSessionsUC: &mock.MockRevokeSessionsUseCase{
+			ExecuteFunc: func(_ context.Context, owner model.SessionOwner, keepSessionID string) (int, error) {
+				gotOwner, gotKeep = owner, keepSessionID
+				return 3, nil
+			},
+		},
+	}
+	h := admin.NewSessionHandler(mockReg)
+	mux := http.NewServeMux()
+	h.RegisterRoutes(mux)
+
+	self := model.SessionOwner{Role: model.SessionRoleAdmin, UserID: 3}
+	buyer := model.SessionOwner{Role: model.SessionRoleBuyer, UserID: 7}
+
+	tests := []struct {
+		name       string
+		role       model.AdminRole
+		method     string
+		path       string
+		wantStatus int
+		wantOwner  model.SessionOwner
+		wantKeep   string
+		wantBody   string
+	}{
+		{name: "ListOwn", role: model.AdminRoleReadOnly, method: http.MethodGet, path: "/sessions", wantStatus: http.StatusOK, wantOwner: self, wantBody: `"current":true`},
+		{name: "RevokeOwn", role: model.AdminRoleReadOnly, method: http.MethodDelete, path: "/sessions/abc", wantStatus: http.StatusOK, wantOwner: self},
+		{name: "RevokeOwnOthers", role: model.AdminRoleReadOnly, method: http.MethodDelete, path: "/sessions", wantStatus: http.StatusOK, wantOwner: self, wantKeep: "current-session", wantBody: `"revoked":3`},
+		{name: "ListBuyer", role: model.AdminRoleReadOnly, method: http.MethodGet, path: "/buyers/7/sessions", wantStatus: http.StatusOK, wantOwner: buyer, wantBody: `"login_id":12`},
+		{name: "RevokeBuyer", role: model.AdminRoleClerk, method: http.MethodDelete, path: "/buyers/7/sessions/abc", wantStatus: http.StatusOK, wantOwner: buyer},
+		{name: "RevokeBuyerAll", role: model.AdminRoleClerk, method: http.MethodDelete, path: "/buyers/7/sessions", wantStatus: http.StatusOK, wantOwner: buyer, wantBody: `"revoked":3`},
+		{name: "RevokeBuyerForbidden", role: model.AdminRoleReadOnly, method: http.MethodDelete, path: "/buyers/7/sessions", wantStatus: http.StatusForbidden},
+		{name: "InvalidBuyerID", role: model.AdminRoleClerk, method: http.MethodGet, path: "/buyers/x/sessions", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotOwner, gotKeep = model.SessionOwner{}, ""
+			ctx := middleware.WithAdminRole(middleware.WithAdminID(context.Background(), 3), tt.role)
+			ctx = middleware.WithSessionID(ctx, "current-session")
+			req := httptest.NewRequestWithContext(ctx, tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			mux.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if gotOwner != tt.wantOwner || gotKeep != tt.wantKeep {
+				t.Errorf("expected owner %+v keeping %q, got %+v keeping %q", tt.wantOwner, tt.wantKeep, gotOwner, gotKeep)
+			}
+			if tt.wantBody != "" && !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("expected body to contain %s, got %s", tt.wantBody, w.Body.String())
+			}
+			if strings.Contains(w.Body.String(), "current-session") {
+				t.Errorf("expected raw session IDs not to be exposed, got %s", w.Body.String())
+			}
+		})
+	}
+}
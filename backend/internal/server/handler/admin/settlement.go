@@ -0,0 +1,173 @@
+package admin
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/settlement"
+)
+
+// SettlementHandler handles admin HTTP requests related to fisherman settlements and payouts.
+type SettlementHandler struct {
+	generateUseCase settlement.GenerateSettlementsUseCase
+	settleUseCase   settlement.SettleStatementUseCase
+	listUseCase     settlement.ListSettlementsUseCase
+	exportUseCase   settlement.ExportTransferFileUseCase
+}
+
+// NewSettlementHandler creates a new SettlementHandler instance.
+func NewSettlementHandler(r registry.UseCase) *SettlementHandler {
+	return &SettlementHandler{
+		generateUseCase: r.NewGenerateSettlementsUseCase(),
+		settleUseCase:   r.NewSettleStatementUseCase(),
+		listUseCase:     r.NewListSettlementsUseCase(),
+		exportUseCase:   r.NewExportTransferFileUseCase(),
+	}
+}
+
+// Generate handles the request to (re)generate draft settlements for a completed auction.
+func (h *SettlementHandler) Generate(w http.ResponseWriter, r *http.Request) {
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid auction ID")
+		return
+	}
+
+	var req request.GenerateSettlements
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	settlements, err := h.generateUseCase.Execute(r.Context(), auctionID, req.CommissionRate)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusCreated, toSettlementResponses(settlements))
+}
+
+// List handles the request to list the settlements of an auction.
+func (h *SettlementHandler) List(w http.ResponseWriter, r *http.Request) {
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid auction ID")
+		return
+	}
+
+	settlements, err := h.listUseCase.Execute(r.Context(), auctionID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toSettlementResponses(settlements))
+}
+
+// Settle handles the request to finalize a draft settlement.
+func (h *SettlementHandler) Settle(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid ID")
+		return
+	}
+
+	s, err := h.settleUseCase.Execute(r.Context(), id)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toSettlementResponse(s))
+}
+
+// ExportTransferFile handles the request to download a Zengin transfer file for settled statements.
+func (h *SettlementHandler) ExportTransferFile(w http.ResponseWriter, r *http.Request) {
+	var req request.ExportTransferFile
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	transferDate, err := time.Parse("2006-01-02", req.TransferDate)
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid transfer_date format (YYYY-MM-DD)")
+		return
+	}
+
+	data, err := h.exportUseCase.Execute(r.Context(), &settlement.ExportTransferFileInput{
+		SettlementIDs: req.SettlementIDs,
+		TransferDate:  transferDate,
+		Reissue:       req.Reissue,
+		Remitter: model.ZenginRemitter{
+			CompanyCode:    req.Remitter.CompanyCode,
+			NameKana:       req.Remitter.NameKana,
+			BankCode:       req.Remitter.BankCode,
+			BankNameKana:   req.Remitter.BankNameKana,
+			BranchCode:     req.Remitter.BranchCode,
+			BranchNameKana: req.Remitter.BranchNameKana,
+			AccountType:    model.BankAccountType(req.Remitter.AccountType),
+			AccountNumber:  req.Remitter.AccountNumber,
+		},
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=Shift_JIS")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="zengin_%s.txt"`, transferDate.Format("20060102")))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func toSettlementResponses(settlements []model.Settlement) []response.Settlement {
+	resp := make([]response.Settlement, len(settlements))
+	for i := range settlements {
+		resp[i] = toSettlementResponse(&settlements[i])
+	}
+	return resp
+}
+
+func toSettlementResponse(s *model.Settlement) response.Settlement {
+	resp := response.Settlement{
+		ID:               s.ID,
+		FishermanID:      s.FishermanID,
+		FishermanName:    s.FishermanName,
+		AuctionID:        s.AuctionID,
+		Status:           string(s.Status),
+		GrossAmount:      s.GrossAmount,
+		CommissionRate:   s.CommissionRate,
+		CommissionAmount: s.CommissionAmount,
+		CommissionTax:    s.CommissionTax,
+		NetAmount:        s.NetAmount,
+		SettledAt:        util.FormatTimestamp(s.SettledAt),
+		ExportedAt:       util.FormatTimestamp(s.ExportedAt),
+	}
+	for _, l := range s.Lines {
+		resp.Lines = append(resp.Lines, response.SettlementLine{
+			ID:          l.ID,
+			ItemID:      l.ItemID,
+			Description: l.Description,
+			Quantity:    l.Quantity,
+			Unit:        l.Unit,
+			Amount:      l.Amount,
+		})
+	}
+	return resp
+}
+
+// RegisterRoutes registers the admin settlement handler routes to the given mux.
+func (h *SettlementHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /auctions/{id}/settlements", middleware.RequireAdminPermission(model.AdminPermissionViewAccounting, h.List))
+	mux.HandleFunc("POST /auctions/{id}/settlements", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.Generate))
+	mux.HandleFunc("POST /settlements/{id}/settle", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.Settle))
+	mux.HandleFunc("POST /settlements/transfer-file", middleware.RequireAdminPermission(model.AdminPermissionManageAccounting, h.ExportTransferFile))
+}
@@ -0,0 +1,173 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/settlement"
+)
+
+func TestSettlementHandler_Generate(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "1", body: `{"commission_rate":5}`, wantStatus: http.StatusCreated},
+		{name: "InvalidID", pathID: "abc", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", pathID: "1", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "AuctionNotCompleted", pathID: "1", body: `{"commission_rate":5}`, execErr: &domainErrors.ConflictError{Message: "not completed"}, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				GenerateSettlementsUC: &mock.MockGenerateSettlementsUseCase{
+					ExecuteFunc: func(_ context.Context, auctionID, rate int) ([]model.Settlement, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return []model.Settlement{{ID: 1, AuctionID: auctionID, CommissionRate: rate, Status: model.SettlementStatusDraft}}, nil
+					},
+				},
+			}
+			h := admin.NewSettlementHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/auctions/"+tt.pathID+"/settlements", bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.Generate(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestSettlementHandler_Settle(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "1", wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "x", wantStatus: http.StatusBadRequest},
+		{name: "NotDraft", pathID: "1", execErr: &domainErrors.ConflictError{Message: "not draft"}, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				SettleStatementUC: &mock.MockSettleStatementUseCase{
+					ExecuteFunc: func(_ context.Context, id int) (*model.Settlement, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						now := time.Now()
+						return &model.Settlement{ID: id, Status: model.SettlementStatusSettled, SettledAt: &now}, nil
+					},
+				},
+			}
+			h := admin.NewSettlementHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/settlements/"+tt.pathID+"/settle", nil)
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.Settle(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestSettlementHandler_List(t *testing.T) {
+	mockReg := &mock.MockRegistry{
+		ListSettlementsUC: &mock.MockListSettlementsUseCase{
+			ExecuteFunc: func(_ context.Context, auctionID int) ([]model.Settlement, error) {
+				return []model.Settlement{{ID: 1, AuctionID: auctionID}}, nil
+			},
+		},
+	}
+	h := admin.NewSettlementHandler(mockReg)
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/auctions/1/settlements", nil)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	h.List(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", w.Code)
+	}
+}
+
+func TestSettlementHandler_ExportTransferFile(t *testing.T) {
+	validBody := `{"settlement_ids":[1,2],"transfer_date":"2024-03-05","remitter":{"company_code":"1234567890","name_kana":"ｻｶﾅ","bank_code":"0001","bank_name_kana":"ﾐｽﾞﾎ","branch_code":"100","branch_name_kana":"ﾄｳｷﾖｳ","account_type":1,"account_number":"1234567"}}`
+
+	tests := []struct {
+		name       string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", body: validBody, wantStatus: http.StatusOK},
+		{name: "InvalidJSON", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidDate", body: `{"settlement_ids":[1],"transfer_date":"03/05"}`, wantStatus: http.StatusBadRequest},
+		{name: "NotSettled", body: validBody, execErr: &domainErrors.ConflictError{Message: "not settled"}, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *settlement.ExportTransferFileInput
+			mockReg := &mock.MockRegistry{
+				ExportTransferFileUC: &mock.MockExportTransferFileUseCase{
+					ExecuteFunc: func(_ context.Context, input *settlement.ExportTransferFileInput) ([]byte, error) {
+						got = input
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return []byte("1210\r\n"), nil
+					},
+				},
+			}
+			h := admin.NewSettlementHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/settlements/transfer-file", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			h.ExportTransferFile(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if ct := w.Header().Get("Content-Type"); ct != "text/plain; charset=Shift_JIS" {
+				t.Errorf("unexpected content type %q", ct)
+			}
+			if cd := w.Header().Get("Content-Disposition"); cd != `attachment; filename="zengin_20240305.txt"` {
+				t.Errorf("unexpected content disposition %q", cd)
+			}
+			if got.Remitter.AccountType != model.BankAccountTypeOrdinary || len(got.SettlementIDs) != 2 {
+				t.Errorf("unexpected input: %+v", got)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 	"github.com/seka/fish-auction/backend/internal/usecase/venue"
 )
@@ -129,8 +130,8 @@ func (h *VenueHandler) toResponse(v *model.Venue) response.Venue {
 
 // RegisterRoutes registers the admin venue handler routes to the given mux.
 func (h *VenueHandler) RegisterRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("POST /venues", h.Create)
-	mux.HandleFunc("GET /venues", h.List)
-	mux.HandleFunc("PUT /venues/{id}", h.Update)
-	mux.HandleFunc("DELETE /venues/{id}", h.Delete)
+	mux.HandleFunc("POST /venues", middleware.RequireAdminPermission(model.AdminPermissionManageVenues, h.Create))
+	mux.HandleFunc("GET /venues", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.List))
+	mux.HandleFunc("PUT /venues/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageVenues, h.Update))
+	mux.HandleFunc("DELETE /venues/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageVenues, h.Delete))
 }
@@ -0,0 +1,185 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
+)
+
+// VenueRegistrationHandler handles admin HTTP requests for buyer registrations at venues.
+type VenueRegistrationHandler struct {
+	createUseCase registration.CreateRegistrationUseCase
+	updateUseCase registration.UpdateRegistrationUseCase
+	listUseCase   registration.ListRegistrationsUseCase
+}
+
+// NewVenueRegistrationHandler creates a new VenueRegistrationHandler instance.
+func NewVenueRegistrationHandler(r registry.UseCase) *VenueRegistrationHandler {
+	return &VenueRegistrationHandler{
+		createUseCase: r.NewCreateVenueRegistrationUseCase(),
+		updateUseCase: r.NewUpdateVenueRegistrationUseCase(),
+		listUseCase:   r.NewListVenueRegistrationsUseCase(),
+	}
+}
+
+// List handles the request to list registrations, optionally filtered by buyer, venue and status.
+func (h *VenueRegistrationHandler) List(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	filters := &repository.VenueRegistrationFilters{}
+	if s := q.Get("buyer_id"); s != "" {
+		buyerID, err := strconv.Atoi(s)
+		if err != nil {
+			util.WriteError(w, http.StatusBadRequest, "Invalid buyer_id")
+			return
+		}
+		filters.BuyerID = &buyerID
+	}
+	if s := q.Get("venue_id"); s != "" {
+		venueID, err := strconv.Atoi(s)
+		if err != nil {
+			util.WriteError(w, http.StatusBadRequest, "Invalid venue_id")
+			return
+		}
+		filters.VenueID = &venueID
+	}
+	if s := q.Get("status"); s != "" {
+		status := model.VenueRegistrationStatus(s)
+		if !status.IsValid() {
+			util.WriteError(w, http.StatusBadRequest, "Invalid status")
+			return
+		}
+		filters.Status = &status
+	}
+
+	regs, err := h.listUseCase.Execute(r.Context(), filters)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.VenueRegistration, len(regs))
+	for i := range regs {
+		resp[i] = toVenueRegistrationResponse(&regs[i])
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// Create handles the request to register a buyer at a venue.
+func (h *VenueRegistrationHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req request.CreateVenueRegistration
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+	validFrom, validUntil, ok := parseValidityPeriod(w, req.ValidFrom, req.ValidUntil)
+	if !ok {
+		return
+	}
+
+	reg, err := h.createUseCase.Execute(r.Context(), &model.VenueRegistration{
+		BuyerID:    req.BuyerID,
+		VenueID:    req.VenueID,
+		Status:     model.VenueRegistrationStatus(req.Status),
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusCreated, toVenueRegistrationResponse(reg))
+}
+
+// Update handles the request to approve, suspend or renew a registration.
+func (h *VenueRegistrationHandler) Update(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid registration ID")
+		return
+	}
+
+	var req request.UpdateVenueRegistration
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+	validFrom, validUntil, ok := parseValidityPeriod(w, req.ValidFrom, req.ValidUntil)
+	if !ok {
+		return
+	}
+
+	reg, err := h.updateUseCase.Execute(r.Context(), id, registration.UpdateRegistrationInput{
+		Status:     model.VenueRegistrationStatus(req.Status),
+		ValidFrom:  validFrom,
+		ValidUntil: validUntil,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, toVenueRegistrationResponse(reg))
+}
+
+// RegisterRoutes registers the admin venue registration handler routes to the given mux.
+func (h *VenueRegistrationHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /venue-registrations", middleware.RequireAdminPermission(model.AdminPermissionViewOperations, h.List))
+	mux.HandleFunc("POST /venue-registrations", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Create))
+	mux.HandleFunc("PUT /venue-registrations/{id}", middleware.RequireAdminPermission(model.AdminPermissionManageOperations, h.Update))
+}
+
+// parseValidityPeriod parses the optional YYYY-MM-DD bounds of a registration and writes a 400 on failure.
+func parseValidityPeriod(w http.ResponseWriter, from, until *string) (*time.Time, *time.Time, bool) {
+	validFrom, err := parseOptionalDate(from)
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid valid_from format (YYYY-MM-DD)")
+		return nil, nil, false
+	}
+	validUntil, err := parseOptionalDate(until)
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid valid_until format (YYYY-MM-DD)")
+		return nil, nil, false
+	}
+	return validFrom, validUntil, true
+}
+
+func parseOptionalDate(s *string) (*time.Time, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02", *s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func formatOptionalDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format("2006-01-02")
+	return &s
+}
+
+func toVenueRegistrationResponse(reg *model.VenueRegistration) response.VenueRegistration {
+	return response.VenueRegistration{
+		ID:         reg.ID,
+		BuyerID:    reg.BuyerID,
+		VenueID:    reg.VenueID,
+		Status:     string(reg.Status),
+		ValidFrom:  formatOptionalDate(reg.ValidFrom),
+		ValidUntil: formatOptionalDate(reg.ValidUntil),
+		CreatedAt:  reg.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  reg.UpdatedAt.Format(time.RFC3339),
+	}
+}
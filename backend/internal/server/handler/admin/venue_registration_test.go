@@ -0,0 +1,173 @@
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
+	"github.com/seka/fish-auction/backend/internal/server/handler/admin/response"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
+)
+
+func TestVenueRegistrationHandler_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+		check      func(t *testing.T, f *repository.VenueRegistrationFilters)
+	}{
+		{
+			name:       "Filtered",
+			query:      "?buyer_id=1&venue_id=2&status=approved",
+			wantStatus: http.StatusOK,
+			check: func(t *testing.T, f *repository.VenueRegistrationFilters) {
+				if f.BuyerID == nil || *f.BuyerID != 1 || f.VenueID == nil || *f.VenueID != 2 || f.Status == nil || *f.Status != model.VenueRegistrationStatusApproved {
+					t.Errorf("unexpected filters: %+v", f)
+				}
+			},
+		},
+		{name: "InvalidBuyerID", query: "?buyer_id=abc", wantStatus: http.StatusBadRequest},
+		{name: "InvalidVenueID", query: "?venue_id=abc", wantStatus: http.StatusBadRequest},
+		{name: "InvalidStatus", query: "?status=unknown", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ListVenueRegistrationsUC: &mock.MockListRegistrationsUseCase{
+					ExecuteFunc: func(_ context.Context, filters *repository.VenueRegistrationFilters) ([]model.VenueRegistration, error) {
+						if tt.check != nil {
+							tt.check(t, filters)
+						}
+						return []model.VenueRegistration{{ID: 1, BuyerID: 1, VenueID: 2, Status: model.VenueRegistrationStatusApproved}}, nil
+					},
+				},
+			}
+			h := admin.NewVenueRegistrationHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/venue-registrations"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.List(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestVenueRegistrationHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", body: `{"buyer_id":1,"venue_id":2,"status":"approved","valid_from":"2026-04-01","valid_until":"2027-03-31"}`, wantStatus: http.StatusCreated},
+		{name: "OpenEnded", body: `{"buyer_id":1,"venue_id":2}`, wantStatus: http.StatusCreated},
+		{name: "InvalidJSON", body: `{`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidValidFrom", body: `{"buyer_id":1,"venue_id":2,"valid_from":"2026/04/01"}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidValidUntil", body: `{"buyer_id":1,"venue_id":2,"valid_until":"next year"}`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "AlreadyRegistered",
+			body:       `{"buyer_id":1,"venue_id":2}`,
+			execErr:    &domainErrors.ConflictError{Message: "buyer is already registered at this venue"},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				CreateVenueRegistrationUC: &mock.MockCreateRegistrationUseCase{
+					ExecuteFunc: func(_ context.Context, reg *model.VenueRegistration) (*model.VenueRegistration, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						created := *reg
+						created.ID = 5
+						return &created, nil
+					},
+				},
+			}
+			h := admin.NewVenueRegistrationHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/venue-registrations", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			h.Create(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.name != "Success" {
+				return
+			}
+			var resp response.VenueRegistration
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.ID != 5 || resp.Status != "approved" || resp.ValidFrom == nil || *resp.ValidFrom != "2026-04-01" || resp.ValidUntil == nil || *resp.ValidUntil != "2027-03-31" {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestVenueRegistrationHandler_Update(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Suspend", pathID: "5", body: `{"status":"suspended"}`, wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", pathID: "5", body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "InvalidStatus",
+			pathID:     "5",
+			body:       `{"status":"unknown"}`,
+			execErr:    &domainErrors.ValidationError{Field: "status", Message: "invalid registration status"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{name: "NotFound", pathID: "9", body: `{"status":"approved"}`, execErr: &domainErrors.NotFoundError{Resource: "VenueRegistration", ID: 9}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				UpdateVenueRegistrationUC: &mock.MockUpdateRegistrationUseCase{
+					ExecuteFunc: func(_ context.Context, id int, input registration.UpdateRegistrationInput) (*model.VenueRegistration, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.VenueRegistration{ID: id, BuyerID: 1, VenueID: 2, Status: input.Status, UpdatedAt: time.Now()}, nil
+					},
+				},
+			}
+			h := admin.NewVenueRegistrationHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/venue-registrations/"+tt.pathID, bytes.NewBufferString(tt.body))
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+
+			h.Update(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
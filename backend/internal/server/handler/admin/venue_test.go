@@ -12,6 +12,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	admin "github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin/request"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
 )
 
@@ -154,11 +155,24 @@ func TestVenueHandler_RegisterRoutes(t *testing.T) {
 		mux := http.NewServeMux()
 		h.RegisterRoutes(mux)
 
-		req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/venues", bytes.NewReader([]byte("{}")))
+		req := httptest.NewRequestWithContext(middleware.WithAdminRole(context.Background(), model.AdminRoleSuperAdmin), http.MethodPost, "/venues", bytes.NewReader([]byte("{}")))
 		w := httptest.NewRecorder()
 		mux.ServeHTTP(w, req)
 		if w.Code != http.StatusCreated {
 			t.Errorf("expected 201, got %d", w.Code)
 		}
 	})
+
+	t.Run("ClerkCannotCreateVenue", func(t *testing.T) {
+		h := admin.NewVenueHandler(mockReg)
+		mux := http.NewServeMux()
+		h.RegisterRoutes(mux)
+
+		req := httptest.NewRequestWithContext(middleware.WithAdminRole(context.Background(), model.AdminRoleClerk), http.MethodPost, "/venues", bytes.NewReader([]byte("{}")))
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		if w.Code != http.StatusForbidden {
+			t.Errorf("expected 403, got %d", w.Code)
+		}
+	})
 }
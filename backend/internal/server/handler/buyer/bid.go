@@ -1,8 +1,10 @@
 package buyer
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/registry"
@@ -11,17 +13,22 @@ import (
 	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 	"github.com/seka/fish-auction/backend/internal/usecase/bid"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
 )
 
 // BidHandler handles buyer HTTP requests related to bidding.
 type BidHandler struct {
-	createUseCase bid.CreateBidUseCase
+	createUseCase    bid.CreateBidUseCase
+	historyUseCase   bid.ListItemBidsUseCase
+	authorizeUseCase registration.AuthorizeAuctionViewUseCase
 }
 
 // NewBidHandler creates a new BidHandler instance.
 func NewBidHandler(r registry.UseCase) *BidHandler {
 	return &BidHandler{
-		createUseCase: r.NewCreateBidUseCase(),
+		createUseCase:    r.NewCreateBidUseCase(),
+		historyUseCase:   r.NewListVisibleItemBidsUseCase(),
+		authorizeUseCase: r.NewAuthorizeAuctionViewUseCase(),
 	}
 }
 
@@ -42,7 +49,9 @@ func (h *BidHandler) Create(w http.ResponseWriter, r *http.Request) {
 	b := &model.Bid{
 		ItemID:  req.ItemID,
 		BuyerID: buyerID,
+		LoginID: loginIDFromContext(r.Context()),
 		Price:   model.NewBidPrice(req.Price),
+		Channel: model.BidChannelOnline,
 	}
 
 	created, err := h.createUseCase.Execute(r.Context(), b)
@@ -60,7 +69,63 @@ func (h *BidHandler) Create(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// History handles the request to page through the bid ladder of a lot, newest first.
+// Bidders are shown only by paddle number, and the buyer's own bids as "you".
+// The route lives under /api/auctions rather than /api/buyer, so the server registers it behind the buyer auth middleware itself.
+func (h *BidHandler) History(w http.ResponseWriter, r *http.Request) {
+	buyerID, ok := middleware.BuyerIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	auctionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid auction ID")
+		return
+	}
+	itemID, err := strconv.Atoi(r.PathValue("itemId"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid item ID")
+		return
+	}
+	q, err := util.ParseBidHistoryQuery(r)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	if err := h.authorizeUseCase.Execute(r.Context(), buyerID, auctionID); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	page, err := h.historyUseCase.Execute(r.Context(), auctionID, itemID, q)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := response.BidHistory{Bids: make([]response.BidLadderEntry, len(page.Bids)), NextCursor: page.NextCursor}
+	for i := range page.Bids {
+		b := &page.Bids[i]
+		resp.Bids[i] = response.BidLadderEntry{
+			Bidder:    b.Bidder(buyerID),
+			Price:     b.Price,
+			CreatedAt: b.CreatedAt,
+		}
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
 // RegisterRoutes registers the buyer bid handler routes to the given mux.
 func (h *BidHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("POST /bids", h.Create)
 }
+
+// loginIDFromContext returns the login that placed the bid, so the bid records the individual as well as the organization.
+func loginIDFromContext(ctx context.Context) *int {
+	loginID, ok := middleware.BuyerLoginIDFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return &loginID
+}
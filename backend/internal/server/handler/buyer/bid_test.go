@@ -9,9 +9,11 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/server/handler/buyer"
 	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/response"
 	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
 )
@@ -23,6 +25,9 @@ func TestBidHandler_Create(t *testing.T) {
 				if bid.BuyerID != 1 {
 					t.Errorf("expected buyerID 1, got %d", bid.BuyerID)
 				}
+				if bid.LoginID == nil || *bid.LoginID != 12 {
+					t.Errorf("expected loginID 12, got %v", bid.LoginID)
+				}
 				bid.ID = 1
 				return bid, nil
 			},
@@ -36,6 +41,7 @@ func TestBidHandler_Create(t *testing.T) {
 
 		// Inject buyer_id into context (simulating middleware)
 		ctx := middleware.WithBuyerID(req.Context(), 1)
+		ctx = middleware.WithBuyerLoginID(ctx, 12)
 		req = req.WithContext(ctx)
 
 		w := httptest.NewRecorder()
@@ -107,3 +113,95 @@ func TestBidHandler_RegisterRoutes(t *testing.T) {
 		}
 	})
 }
+
+func TestBidHandler_History(t *testing.T) {
+	paddle := "128"
+	tests := []struct {
+		name        string
+		withContext bool
+		itemID      string
+		query       string
+		execErr     error
+		authErr     error
+		wantStatus  int
+	}{
+		{name: "Success", withContext: true, itemID: "10", query: "?limit=2", wantStatus: http.StatusOK},
+		{name: "NotAuthenticated", itemID: "10", wantStatus: http.StatusUnauthorized},
+		{name: "InvalidItemID", withContext: true, itemID: "abc", wantStatus: http.StatusBadRequest},
+		{name: "InvalidLimit", withContext: true, itemID: "10", query: "?limit=abc", wantStatus: http.StatusBadRequest},
+		{name: "InvalidCursor", withContext: true, itemID: "10", query: "?cursor=!!", wantStatus: http.StatusBadRequest},
+		{
+			name: "NotVisible", withContext: true, itemID: "10",
+			execErr:    &domainErrors.ForbiddenError{Message: "bid history is not available for this auction"},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "NotRegistered", withContext: true, itemID: "10", query: "?limit=2",
+			authErr:    &domainErrors.ForbiddenError{Message: "Venue registration is suspended"},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				AuthorizeAuctionViewUC: &mock.MockAuthorizeAuctionViewUseCase{
+					ExecuteFunc: func(_ context.Context, buyerID, auctionID int) error {
+						if buyerID != 1 || auctionID != 1 {
+							t.Errorf("unexpected arguments: %d %d", buyerID, auctionID)
+						}
+						return tt.authErr
+					},
+				},
+				ListVisibleItemBidsUC: &mock.MockListItemBidsUseCase{
+					ExecuteFunc: func(_ context.Context, auctionID, itemID int, q *model.BidHistoryQuery) (*model.BidHistoryPage, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						if auctionID != 1 || itemID != 10 || q.Limit != 2 {
+							t.Errorf("unexpected arguments: %d %d %+v", auctionID, itemID, q)
+						}
+						return &model.BidHistoryPage{
+							Bids: []model.BidHistoryEntry{
+								{ID: 6, BuyerID: 3, BuyerName: "丸魚商店", BuyerPaddle: &paddle, Price: 3000},
+								{ID: 5, BuyerID: 1, BuyerName: "自分", Price: 2500},
+							},
+							NextCursor: "NQ",
+						}, nil
+					},
+				},
+			}
+			h := buyer.NewBidHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/api/auctions/1/items/"+tt.itemID+"/bids"+tt.query, nil)
+			req.SetPathValue("id", "1")
+			req.SetPathValue("itemId", tt.itemID)
+			if tt.withContext {
+				req = withBuyerID(req, 1)
+			}
+			w := httptest.NewRecorder()
+
+			h.History(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if bytes.Contains(w.Body.Bytes(), []byte("丸魚商店")) || bytes.Contains(w.Body.Bytes(), []byte("buyer_id")) {
+				t.Fatalf("bid history leaks buyer identity: %s", w.Body.String())
+			}
+			var resp response.BidHistory
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(resp.Bids) != 2 || resp.NextCursor != "NQ" {
+				t.Fatalf("unexpected response: %+v", resp)
+			}
+			if *resp.Bids[0].Bidder != "128" || *resp.Bids[1].Bidder != "you" {
+				t.Errorf("unexpected bidders: %v, %v", *resp.Bids[0].Bidder, *resp.Bids[1].Bidder)
+			}
+		})
+	}
+}
@@ -3,14 +3,17 @@ package buyer
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 	"time"
 
+	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/request"
 	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/response"
 	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
 )
 
 // BuyerHandler handles buyer HTTP requests related to their account and purchases.
@@ -19,6 +22,13 @@ type BuyerHandler struct {
 	getPurchasesUseCase buyer.GetBuyerPurchasesUseCase
 	getAuctionsUseCase  buyer.GetBuyerAuctionsUseCase
 	updatePassUseCase   buyer.UpdatePasswordUseCase
+	getBalanceUseCase   payment.GetBuyerBalanceUseCase
+	updateProfileUC     buyer.UpdateProfileUseCase
+	requestEmailUC      buyer.RequestEmailChangeUseCase
+	confirmEmailUC      buyer.ConfirmEmailChangeUseCase
+	listLoginsUC        buyer.ListLoginsUseCase
+	createLoginUC       buyer.CreateLoginUseCase
+	updateLoginUC       buyer.UpdateLoginUseCase
 }
 
 // NewBuyerHandler creates a new BuyerHandler instance.
@@ -28,6 +38,13 @@ func NewBuyerHandler(r registry.UseCase) *BuyerHandler {
 		getPurchasesUseCase: r.NewGetBuyerPurchasesUseCase(),
 		getAuctionsUseCase:  r.NewGetBuyerAuctionsUseCase(),
 		updatePassUseCase:   r.NewBuyerUpdatePasswordUseCase(),
+		getBalanceUseCase:   r.NewGetBuyerBalanceUseCase(),
+		updateProfileUC:     r.NewUpdateBuyerProfileUseCase(),
+		requestEmailUC:      r.NewRequestBuyerEmailChangeUseCase(),
+		confirmEmailUC:      r.NewConfirmBuyerEmailChangeUseCase(),
+		listLoginsUC:        r.NewListBuyerLoginsUseCase(),
+		createLoginUC:       r.NewCreateBuyerLoginUseCase(),
+		updateLoginUC:       r.NewUpdateBuyerLoginUseCase(),
 	}
 }
 
@@ -45,11 +62,77 @@ func (h *BuyerHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	util.WriteJSON(w, http.StatusOK, response.Me{
-		Authenticated: true,
-		BuyerID:       b.ID,
-		Name:          b.Name,
+	util.WriteJSON(w, http.StatusOK, toMeResponse(b))
+}
+
+// UpdateMe handles the request to update the current buyer's contact and business details.
+func (h *BuyerHandler) UpdateMe(w http.ResponseWriter, r *http.Request) {
+	loginID, ok := middleware.BuyerLoginIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.UpdateProfile
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	b, err := h.updateProfileUC.Execute(r.Context(), loginID, &buyer.UpdateProfileInput{
+		Name:                      req.Name,
+		Organization:              req.Organization,
+		ContactInfo:               req.ContactInfo,
+		BusinessName:              req.BusinessName,
+		InvoiceRegistrationNumber: req.InvoiceRegistrationNumber,
+		Address:                   req.Address,
+		Phone:                     req.Phone,
 	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toMeResponse(b))
+}
+
+// RequestEmailChange handles the request to change the login email by sending a verification link to the new address.
+func (h *BuyerHandler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	loginID, ok := middleware.BuyerLoginIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.RequestEmailChange
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.requestEmailUC.Execute(r.Context(), loginID, req.Email, req.Password); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusAccepted, response.Message{Message: "A verification link has been sent to the new email address"})
+}
+
+// ConfirmEmailChange handles the request from the verification link. It does not require a session,
+// since the link may be opened on a device where the buyer is not logged in.
+func (h *BuyerHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req request.ConfirmEmailChange
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.confirmEmailUC.Execute(r.Context(), req.Token); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, response.Message{Message: "Email updated successfully"})
 }
 
 // GetPurchases handles the request to get the buyer's purchases.
@@ -115,9 +198,9 @@ func (h *BuyerHandler) GetAuctions(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSON(w, http.StatusOK, resp)
 }
 
-// UpdatePassword handles the request to update the buyer's password.
+// UpdatePassword handles the request to update the password of the current login.
 func (h *BuyerHandler) UpdatePassword(w http.ResponseWriter, r *http.Request) {
-	buyerID, ok := middleware.BuyerIDFromContext(r.Context())
+	loginID, ok := middleware.BuyerLoginIDFromContext(r.Context())
 	if !ok {
 		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
 		return
@@ -129,7 +212,7 @@ func (h *BuyerHandler) UpdatePassword(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.updatePassUseCase.Execute(r.Context(), buyerID, req.CurrentPassword, req.NewPassword); err != nil {
+	if err := h.updatePassUseCase.Execute(r.Context(), loginID, req.CurrentPassword, req.NewPassword); err != nil {
 		util.HandleError(w, err)
 		return
 	}
@@ -137,10 +220,166 @@ func (h *BuyerHandler) UpdatePassword(w http.ResponseWriter, r *http.Request) {
 	util.WriteJSON(w, http.StatusOK, response.Message{Message: "Password updated successfully"})
 }
 
+// ListLogins handles the request from the owner to list the organization's logins.
+func (h *BuyerHandler) ListLogins(w http.ResponseWriter, r *http.Request) {
+	loginID, ok := middleware.BuyerLoginIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	logins, err := h.listLoginsUC.Execute(r.Context(), loginID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.Login, len(logins))
+	for i := range logins {
+		resp[i] = toLoginResponse(&logins[i])
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// CreateLogin handles the request from the owner to add a staff login.
+func (h *BuyerHandler) CreateLogin(w http.ResponseWriter, r *http.Request) {
+	loginID, ok := middleware.BuyerLoginIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.CreateLogin
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	login, err := h.createLoginUC.Execute(r.Context(), loginID, &buyer.CreateLoginInput{
+		Name:     req.Name,
+		Email:    req.Email,
+		Password: req.Password,
+		BidLimit: req.BidLimit,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, toLoginResponse(login))
+}
+
+// UpdateLogin handles the request from the owner to rename, limit or disable a login.
+func (h *BuyerHandler) UpdateLogin(w http.ResponseWriter, r *http.Request) {
+	ownerLoginID, ok := middleware.BuyerLoginIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid login ID")
+		return
+	}
+
+	var req request.UpdateLogin
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	login, err := h.updateLoginUC.Execute(r.Context(), ownerLoginID, id, &buyer.UpdateLoginInput{
+		Name:     req.Name,
+		BidLimit: req.BidLimit,
+		Disabled: req.Disabled,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toLoginResponse(login))
+}
+
+// GetBalance handles the request to get the buyer's outstanding balance and credit.
+func (h *BuyerHandler) GetBalance(w http.ResponseWriter, r *http.Request) {
+	buyerID, ok := middleware.BuyerIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	b, err := h.getBalanceUseCase.Execute(r.Context(), buyerID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := response.Balance{
+		InvoicedAmount:    b.InvoicedAmount,
+		PaidAmount:        b.PaidAmount,
+		OutstandingAmount: b.OutstandingAmount,
+		CreditAmount:      b.CreditAmount,
+		OpenInvoices:      make([]response.BalanceInvoice, len(b.OpenInvoices)),
+	}
+	for i, inv := range b.OpenInvoices {
+		resp.OpenInvoices[i] = response.BalanceInvoice{
+			ID:                inv.ID,
+			AuctionID:         inv.AuctionID,
+			TotalAmount:       inv.TotalAmount,
+			CreditedAmount:    inv.CreditedAmount,
+			PaidAmount:        inv.PaidAmount,
+			OutstandingAmount: inv.OutstandingAmount(),
+			IssuedAt:          util.FormatTimestamp(inv.IssuedAt),
+		}
+	}
+
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
 // RegisterRoutes registers the buyer account handler routes to the given mux.
 func (h *BuyerHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("GET /me", h.GetMe)
+	mux.HandleFunc("PUT /me", h.UpdateMe)
+	mux.HandleFunc("POST /email-change", h.RequestEmailChange)
 	mux.HandleFunc("GET /purchases", h.GetPurchases)
 	mux.HandleFunc("GET /auctions", h.GetAuctions)
 	mux.HandleFunc("PUT /password", h.UpdatePassword)
+	mux.HandleFunc("GET /balance", h.GetBalance)
+	mux.HandleFunc("GET /logins", h.ListLogins)
+	mux.HandleFunc("POST /logins", h.CreateLogin)
+	mux.HandleFunc("PUT /logins/{id}", h.UpdateLogin)
+}
+
+func toMeResponse(b *model.Buyer) response.Me {
+	resp := response.Me{
+		Authenticated:             true,
+		BuyerID:                   b.ID,
+		Name:                      b.Name,
+		Organization:              b.Organization,
+		ContactInfo:               b.ContactInfo,
+		BusinessName:              b.BusinessName,
+		InvoiceRegistrationNumber: b.InvoiceRegistrationNumber,
+		Address:                   b.Address,
+		Phone:                     b.Phone,
+		LicenseNumber:             b.LicenseNumber,
+		RegistrationStatus:        string(b.Registration.Status),
+	}
+	if b.LicenseExpiresOn != nil {
+		s := b.LicenseExpiresOn.Format("2006-01-02")
+		resp.LicenseExpiresOn = &s
+	}
+	return resp
+}
+
+func toLoginResponse(a *model.Authentication) response.Login {
+	return response.Login{
+		ID:          a.ID,
+		Name:        a.Name,
+		Email:       a.Email,
+		Role:        string(a.Role),
+		BidLimit:    a.BidLimit,
+		Disabled:    a.DisabledAt != nil,
+		LastLoginAt: util.FormatTimestamp(a.LastLoginAt),
+	}
 }
@@ -10,17 +10,25 @@ import (
 	"testing"
 	"time"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/server/handler/buyer"
 	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/response"
 	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	buyerusecase "github.com/seka/fish-auction/backend/internal/usecase/buyer"
 )
 
 func withBuyerID(req *http.Request, buyerID int) *http.Request {
 	return req.WithContext(middleware.WithBuyerID(req.Context(), buyerID))
 }
 
+func withBuyerLogin(req *http.Request, buyerID, loginID int) *http.Request {
+	ctx := middleware.WithBuyerID(req.Context(), buyerID)
+	return req.WithContext(middleware.WithBuyerLoginID(ctx, loginID))
+}
+
 func TestBuyerHandler_GetMe(t *testing.T) {
 	type testCase struct {
 		name        string
@@ -188,7 +196,12 @@ func TestBuyerHandler_UpdatePassword(t *testing.T) {
 			body:        request.UpdatePassword{CurrentPassword: "old", NewPassword: "new"},
 			mockSetup: func(r *mock.MockRegistry) {
 				r.UpdateBuyerPasswordUC = &mock.MockBuyerUpdatePasswordUseCase{
-					ExecuteFunc: func(_ context.Context, _ int, _, _ string) error { return nil },
+					ExecuteFunc: func(_ context.Context, loginID int, _, _ string) error {
+						if loginID != 12 {
+							t.Errorf("expected login 12, got %d", loginID)
+						}
+						return nil
+					},
 				}
 			},
 			wantStatus: http.StatusOK,
@@ -222,7 +235,7 @@ func TestBuyerHandler_UpdatePassword(t *testing.T) {
 			}
 			req := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/password", bytes.NewReader(reqBody))
 			if tc.withContext {
-				req = withBuyerID(req, 1)
+				req = withBuyerLogin(req, 1, 12)
 			}
 
 			w := httptest.NewRecorder()
@@ -233,3 +246,456 @@ func TestBuyerHandler_UpdatePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestBuyerHandler_UpdateMe(t *testing.T) {
+	expires := time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name        string
+		withContext bool
+		body        string
+		execErr     error
+		wantStatus  int
+	}
+	tests := []testCase{
+		{name: "Success", withContext: true, body: `{"name":"Buyer 1","phone":"090-0000-0000"}`, wantStatus: http.StatusOK},
+		{name: "InvalidJSON", withContext: true, body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:        "ValidationError",
+			withContext: true,
+			body:        `{"name":"Buyer 1","phone":"abc"}`,
+			execErr:     &domainErrors.ValidationError{Field: "phone", Message: "must be a phone number"},
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "StaffLogin",
+			withContext: true,
+			body:        `{"name":"Buyer 1"}`,
+			execErr:     &domainErrors.ForbiddenError{Message: "Only the organization owner can do this"},
+			wantStatus:  http.StatusForbidden,
+		},
+		{name: "Unauthorized_NoContext", body: `{}`, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				UpdateBuyerProfileUC: &mock.MockUpdateProfileUseCase{
+					ExecuteFunc: func(_ context.Context, _ int, input *buyerusecase.UpdateProfileInput) (*model.Buyer, error) {
+						if tc.execErr != nil {
+							return nil, tc.execErr
+						}
+						return &model.Buyer{ID: 1, Name: input.Name, BuyerProfile: model.BuyerProfile{
+							Phone:            input.Phone,
+							LicenseNumber:    "128",
+							LicenseExpiresOn: &expires,
+						}}, nil
+					},
+				},
+			}
+			h := buyer.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/me", bytes.NewBufferString(tc.body))
+			if tc.withContext {
+				req = withBuyerLogin(req, 1, 12)
+			}
+			w := httptest.NewRecorder()
+			h.UpdateMe(w, req)
+
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected %d, got %d", tc.wantStatus, w.Code)
+			}
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			var body response.Me
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if body.Phone != "090-0000-0000" || body.LicenseExpiresOn == nil || *body.LicenseExpiresOn != "2027-03-31" {
+				t.Errorf("unexpected profile: %+v", body)
+			}
+		})
+	}
+}
+
+func TestBuyerHandler_RequestEmailChange(t *testing.T) {
+	type testCase struct {
+		name        string
+		withContext bool
+		body        string
+		execErr     error
+		wantStatus  int
+	}
+	tests := []testCase{
+		{name: "Success", withContext: true, body: `{"email":"new@example.com","password":"secret"}`, wantStatus: http.StatusAccepted},
+		{name: "InvalidJSON", withContext: true, body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:        "WrongPassword",
+			withContext: true,
+			body:        `{"email":"new@example.com","password":"wrong"}`,
+			execErr:     &domainErrors.UnauthorizedError{Message: "Invalid credentials"},
+			wantStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:        "EmailInUse",
+			withContext: true,
+			body:        `{"email":"taken@example.com","password":"secret"}`,
+			execErr:     &domainErrors.ConflictError{Message: "email is already in use"},
+			wantStatus:  http.StatusConflict,
+		},
+		{name: "Unauthorized_NoContext", body: `{}`, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotEmail string
+			mockReg := &mock.MockRegistry{
+				RequestBuyerEmailChangeUC: &mock.MockRequestEmailChangeUseCase{
+					ExecuteFunc: func(_ context.Context, _ int, newEmail, _ string) error {
+						gotEmail = newEmail
+						return tc.execErr
+					},
+				},
+			}
+			h := buyer.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/email-change", bytes.NewBufferString(tc.body))
+			if tc.withContext {
+				req = withBuyerLogin(req, 1, 12)
+			}
+			w := httptest.NewRecorder()
+			h.RequestEmailChange(w, req)
+
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected %d, got %d", tc.wantStatus, w.Code)
+			}
+			if tc.wantStatus == http.StatusAccepted && gotEmail != "new@example.com" {
+				t.Errorf("expected new@example.com, got %q", gotEmail)
+			}
+		})
+	}
+}
+
+func TestBuyerHandler_ConfirmEmailChange(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", body: `{"token":"abc"}`, wantStatus: http.StatusOK},
+		{name: "InvalidJSON", body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:       "ExpiredToken",
+			body:       `{"token":"abc"}`,
+			execErr:    &domainErrors.UnauthorizedError{Message: "Invalid or expired token"},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "EmailTakenMeanwhile",
+			body:       `{"token":"abc"}`,
+			execErr:    &domainErrors.ConflictError{Message: "email is already in use"},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ConfirmBuyerEmailChangeUC: &mock.MockConfirmEmailChangeUseCase{
+					ExecuteFunc: func(_ context.Context, _ string) error { return tc.execErr },
+				},
+			}
+			h := buyer.NewBuyerHandler(mockReg)
+
+			// 確認リンクはセッションなしで開かれる想定なので、コンテキストに買受人 ID を入れない。
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/api/buyer/email-change/confirm", bytes.NewBufferString(tc.body))
+			w := httptest.NewRecorder()
+			h.ConfirmEmailChange(w, req)
+
+			if w.Code != tc.wantStatus {
+				t.Errorf("expected %d, got %d", tc.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestBuyerHandler_GetBalance(t *testing.T) {
+	issuedAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	type testCase struct {
+		name        string
+		withContext bool
+		mockSetup   func(*mock.MockRegistry)
+		wantStatus  int
+		wantBody    bool
+	}
+	tests := []testCase{
+		{
+			name:        "Success",
+			withContext: true,
+			mockSetup: func(r *mock.MockRegistry) {
+				r.GetBuyerBalanceUC = &mock.MockGetBuyerBalanceUseCase{
+					ExecuteFunc: func(_ context.Context, buyerID int) (*model.BuyerBalance, error) {
+						if buyerID != 1 {
+							return nil, errors.New("wrong ID")
+						}
+						return &model.BuyerBalance{
+							BuyerID:           1,
+							InvoicedAmount:    3000,
+							PaidAmount:        1000,
+							OutstandingAmount: 2000,
+							OpenInvoices: []model.Invoice{
+								{ID: 5, Status: model.InvoiceStatusIssued, TotalAmount: 3000, PaidAmount: 1000, IssuedAt: &issuedAt},
+							},
+						}, nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   true,
+		},
+		{
+			name:        "UseCaseError",
+			withContext: true,
+			mockSetup: func(r *mock.MockRegistry) {
+				r.GetBuyerBalanceUC = &mock.MockGetBuyerBalanceUseCase{
+					ExecuteFunc: func(_ context.Context, _ int) (*model.BuyerBalance, error) {
+						return nil, errors.New("db error")
+					},
+				}
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:        "NoContext_NotAuthenticated",
+			withContext: false,
+			mockSetup:   func(_ *mock.MockRegistry) {},
+			wantStatus:  http.StatusUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{}
+			tc.mockSetup(mockReg)
+			h := buyer.NewBuyerHandler(mockReg)
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/balance", nil)
+			if tc.withContext {
+				req = withBuyerID(req, 1)
+			}
+
+			w := httptest.NewRecorder()
+			h.GetBalance(w, req)
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected %d, got %d", tc.wantStatus, w.Code)
+			}
+			if !tc.wantBody {
+				return
+			}
+
+			var body struct {
+				OutstandingAmount int `json:"outstanding_amount"`
+				OpenInvoices      []struct {
+					ID                int `json:"id"`
+					OutstandingAmount int `json:"outstanding_amount"`
+				} `json:"open_invoices"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if body.OutstandingAmount != 2000 {
+				t.Errorf("expected outstanding 2000, got %d", body.OutstandingAmount)
+			}
+			if len(body.OpenInvoices) != 1 || body.OpenInvoices[0].OutstandingAmount != 2000 {
+				t.Errorf("unexpected open invoices: %+v", body.OpenInvoices)
+			}
+		})
+	}
+}
+
+func TestBuyerHandler_ListLogins(t *testing.T) {
+	limit := 30000
+	tests := []struct {
+		name        string
+		withContext bool
+		execErr     error
+		wantStatus  int
+	}{
+		{name: "Success", withContext: true, wantStatus: http.StatusOK},
+		{
+			name:        "StaffLogin",
+			withContext: true,
+			execErr:     &domainErrors.ForbiddenError{Message: "Only the organization owner can do this"},
+			wantStatus:  http.StatusForbidden,
+		},
+		{name: "Unauthorized_NoContext", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ListBuyerLoginsUC: &mock.MockListLoginsUseCase{
+					ExecuteFunc: func(_ context.Context, loginID int) ([]model.Authentication, error) {
+						if loginID != 11 {
+							t.Errorf("expected login 11, got %d", loginID)
+						}
+						if tc.execErr != nil {
+							return nil, tc.execErr
+						}
+						return []model.Authentication{
+							{ID: 11, BuyerID: 1, Name: "山田", Email: "owner@example.com", Role: model.BuyerLoginOwner},
+							{ID: 12, BuyerID: 1, Name: "佐藤", Email: "staff@example.com", Role: model.BuyerLoginStaff, BidLimit: &limit},
+						}, nil
+					},
+				},
+			}
+			h := buyer.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/logins", nil)
+			if tc.withContext {
+				req = withBuyerLogin(req, 1, 11)
+			}
+			w := httptest.NewRecorder()
+			h.ListLogins(w, req)
+
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected %d, got %d", tc.wantStatus, w.Code)
+			}
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			var body []response.Login
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(body) != 2 || body[1].Role != "staff" || body[1].BidLimit == nil || *body[1].BidLimit != limit {
+				t.Errorf("unexpected logins: %+v", body)
+			}
+		})
+	}
+}
+
+func TestBuyerHandler_CreateLogin(t *testing.T) {
+	tests := []struct {
+		name        string
+		withContext bool
+		body        string
+		execErr     error
+		wantStatus  int
+	}{
+		{
+			name:        "Success",
+			withContext: true,
+			body:        `{"name":"佐藤","email":"staff@example.com","password":"Password1!","bid_limit":30000}`,
+			wantStatus:  http.StatusCreated,
+		},
+		{name: "InvalidJSON", withContext: true, body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:        "EmailInUse",
+			withContext: true,
+			body:        `{"name":"佐藤","email":"taken@example.com","password":"Password1!"}`,
+			execErr:     &domainErrors.ConflictError{Message: "email is already in use"},
+			wantStatus:  http.StatusConflict,
+		},
+		{name: "Unauthorized_NoContext", body: `{}`, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *buyerusecase.CreateLoginInput
+			mockReg := &mock.MockRegistry{
+				CreateBuyerLoginUC: &mock.MockCreateLoginUseCase{
+					ExecuteFunc: func(_ context.Context, _ int, input *buyerusecase.CreateLoginInput) (*model.Authentication, error) {
+						got = input
+						if tc.execErr != nil {
+							return nil, tc.execErr
+						}
+						return &model.Authentication{ID: 12, BuyerID: 1, Name: input.Name, Email: input.Email, Role: model.BuyerLoginStaff, BidLimit: input.BidLimit}, nil
+					},
+				},
+			}
+			h := buyer.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/logins", bytes.NewBufferString(tc.body))
+			if tc.withContext {
+				req = withBuyerLogin(req, 1, 11)
+			}
+			w := httptest.NewRecorder()
+			h.CreateLogin(w, req)
+
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected %d, got %d", tc.wantStatus, w.Code)
+			}
+			if tc.wantStatus == http.StatusCreated && (got.BidLimit == nil || *got.BidLimit != 30000 || got.Password != "Password1!") {
+				t.Errorf("unexpected input: %+v", got)
+			}
+		})
+	}
+}
+
+func TestBuyerHandler_UpdateLogin(t *testing.T) {
+	tests := []struct {
+		name        string
+		withContext bool
+		id          string
+		body        string
+		execErr     error
+		wantStatus  int
+	}{
+		{name: "Disable", withContext: true, id: "12", body: `{"name":"佐藤","disabled":true}`, wantStatus: http.StatusOK},
+		{name: "InvalidID", withContext: true, id: "abc", body: `{}`, wantStatus: http.StatusBadRequest},
+		{name: "InvalidJSON", withContext: true, id: "12", body: `{`, wantStatus: http.StatusBadRequest},
+		{
+			name:        "DisableOwner",
+			withContext: true,
+			id:          "11",
+			body:        `{"name":"山田","disabled":true}`,
+			execErr:     &domainErrors.ValidationError{Field: "disabled", Message: "the owner login cannot be disabled"},
+			wantStatus:  http.StatusBadRequest,
+		},
+		{name: "Unauthorized_NoContext", id: "12", body: `{}`, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+			mockReg := &mock.MockRegistry{
+				UpdateBuyerLoginUC: &mock.MockUpdateLoginUseCase{
+					ExecuteFunc: func(_ context.Context, ownerLoginID, loginID int, input *buyerusecase.UpdateLoginInput) (*model.Authentication, error) {
+						if tc.execErr != nil {
+							return nil, tc.execErr
+						}
+						if ownerLoginID != 11 || loginID != 12 || !input.Disabled {
+							t.Errorf("unexpected call: owner %d, login %d, input %+v", ownerLoginID, loginID, input)
+						}
+						return &model.Authentication{ID: loginID, BuyerID: 1, Name: input.Name, Role: model.BuyerLoginStaff, DisabledAt: &now}, nil
+					},
+				},
+			}
+			h := buyer.NewBuyerHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/logins/"+tc.id, bytes.NewBufferString(tc.body))
+			req.SetPathValue("id", tc.id)
+			if tc.withContext {
+				req = withBuyerLogin(req, 1, 11)
+			}
+			w := httptest.NewRecorder()
+			h.UpdateLogin(w, req)
+
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected %d, got %d", tc.wantStatus, w.Code)
+			}
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			var body response.Login
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if !body.Disabled {
+				t.Errorf("expected the login to be disabled: %+v", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package buyer
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
+)
+
+// ClaimHandler handles buyer HTTP requests related to quality claims.
+type ClaimHandler struct {
+	fileUseCase claim.FileClaimUseCase
+	listUseCase claim.ListClaimsUseCase
+}
+
+// NewClaimHandler creates a new ClaimHandler instance.
+func NewClaimHandler(r registry.UseCase) *ClaimHandler {
+	return &ClaimHandler{
+		fileUseCase: r.NewFileClaimUseCase(),
+		listUseCase: r.NewListClaimsUseCase(),
+	}
+}
+
+// Create handles the request to file a claim against one of the buyer's purchases.
+func (h *ClaimHandler) Create(w http.ResponseWriter, r *http.Request) {
+	buyerID, ok := middleware.BuyerIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.FileClaim
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	c, err := h.fileUseCase.Execute(r.Context(), buyerID, claim.FileClaimInput{
+		PurchaseID: req.PurchaseID,
+		Type:       model.ClaimType(req.Type),
+		Reason:     req.Reason,
+		Amount:     req.Amount,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, toClaimResponse(c))
+}
+
+// List handles the request to list the buyer's own claims.
+func (h *ClaimHandler) List(w http.ResponseWriter, r *http.Request) {
+	buyerID, ok := middleware.BuyerIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	claims, err := h.listUseCase.Execute(r.Context(), &repository.ClaimFilters{BuyerID: &buyerID})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.Claim, len(claims))
+	for i := range claims {
+		resp[i] = toClaimResponse(&claims[i])
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// RegisterRoutes registers the buyer claim handler routes to the given mux.
+func (h *ClaimHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /claims", h.Create)
+	mux.HandleFunc("GET /claims", h.List)
+}
+
+func toClaimResponse(c *model.Claim) response.Claim {
+	return response.Claim{
+		ID:              c.ID,
+		PurchaseID:      c.PurchaseID,
+		ItemID:          c.ItemID,
+		AuctionID:       c.AuctionID,
+		FishType:        c.FishType,
+		Type:            string(c.Type),
+		Reason:          c.Reason,
+		RequestedAmount: c.RequestedAmount,
+		ApprovedAmount:  c.ApprovedAmount,
+		Status:          string(c.Status),
+		ResolutionNote:  c.ResolutionNote,
+		ResolvedAt:      util.FormatTimestamp(c.ResolvedAt),
+		CreatedAt:       c.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,96 @@
+package buyer_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
+)
+
+func TestClaimHandler_Create(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		withContext bool
+		execErr     error
+		wantStatus  int
+	}{
+		{name: "Success", body: `{"purchase_id":1,"type":"return","reason":"鮮度不良"}`, withContext: true, wantStatus: http.StatusCreated},
+		{name: "NotAuthenticated", body: `{}`, wantStatus: http.StatusUnauthorized},
+		{name: "InvalidJSON", body: `{`, withContext: true, wantStatus: http.StatusInternalServerError},
+		{
+			name:        "OtherBuyersPurchase",
+			body:        `{"purchase_id":9,"type":"return","reason":"x"}`,
+			withContext: true,
+			execErr:     &domainErrors.NotFoundError{Resource: "Purchase", ID: 9},
+			wantStatus:  http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBuyer int
+			var gotInput claim.FileClaimInput
+			mockReg := &mock.MockRegistry{
+				FileClaimUC: &mock.MockFileClaimUseCase{
+					ExecuteFunc: func(_ context.Context, buyerID int, input claim.FileClaimInput) (*model.Claim, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						gotBuyer, gotInput = buyerID, input
+						return &model.Claim{ID: 1, BuyerID: buyerID, Type: input.Type, Status: model.ClaimStatusPending}, nil
+					},
+				},
+			}
+			h := buyer.NewClaimHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/claims", bytes.NewBufferString(tt.body))
+			if tt.withContext {
+				req = withBuyerID(req, 3)
+			}
+			w := httptest.NewRecorder()
+
+			h.Create(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.name == "Success" && (gotBuyer != 3 || gotInput.Type != model.ClaimTypeReturn || gotInput.PurchaseID != 1) {
+				t.Errorf("unexpected call: buyer %d, input %+v", gotBuyer, gotInput)
+			}
+		})
+	}
+}
+
+func TestClaimHandler_List(t *testing.T) {
+	var got *repository.ClaimFilters
+	mockReg := &mock.MockRegistry{
+		ListClaimsUC: &mock.MockListClaimsUseCase{
+			ExecuteFunc: func(_ context.Context, filters *repository.ClaimFilters) ([]model.Claim, error) {
+				got = filters
+				return []model.Claim{{ID: 1}}, nil
+			},
+		},
+	}
+	h := buyer.NewClaimHandler(mockReg)
+
+	req := withBuyerID(httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/claims", nil), 3)
+	w := httptest.NewRecorder()
+
+	h.List(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+	if got == nil || got.BuyerID == nil || *got.BuyerID != 3 {
+		t.Errorf("expected claims to be scoped to the buyer, got %+v", got)
+	}
+}
@@ -0,0 +1,124 @@
+package buyer
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/bid"
+	"github.com/seka/fish-auction/backend/internal/usecase/label"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
+)
+
+// LabelHandler handles buyer HTTP requests made by scanning a lot label.
+type LabelHandler struct {
+	scanUseCase      label.ScanLabelUseCase
+	createBidUseCase bid.CreateBidUseCase
+	authorizeUseCase registration.AuthorizeAuctionViewUseCase
+}
+
+// NewLabelHandler creates a new LabelHandler instance.
+func NewLabelHandler(r registry.UseCase) *LabelHandler {
+	return &LabelHandler{
+		scanUseCase:      r.NewScanLabelUseCase(),
+		createBidUseCase: r.NewCreateBidUseCase(),
+		authorizeUseCase: r.NewAuthorizeAuctionViewUseCase(),
+	}
+}
+
+// Scan handles the request to look up the lot of a scanned label.
+func (h *LabelHandler) Scan(w http.ResponseWriter, r *http.Request) {
+	buyerID, ok := middleware.BuyerIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	scan, err := h.scanUseCase.Execute(r.Context(), r.URL.Query().Get("code"))
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	if err := h.authorizeUseCase.Execute(r.Context(), buyerID, scan.Item.AuctionID); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toLabelScanResponse(scan, buyerID))
+}
+
+// Bid handles the request to bid on the lot of a scanned label.
+// The code is verified again so an expired or withdrawn label cannot be bid on.
+func (h *LabelHandler) Bid(w http.ResponseWriter, r *http.Request) {
+	buyerID, ok := middleware.BuyerIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var req request.ScanBid
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	scan, err := h.scanUseCase.Execute(r.Context(), req.Code)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	created, err := h.createBidUseCase.Execute(r.Context(), &model.Bid{
+		ItemID:  scan.Item.ID,
+		BuyerID: buyerID,
+		LoginID: loginIDFromContext(r.Context()),
+		Price:   model.NewBidPrice(req.Price),
+		Channel: model.BidChannelOnline,
+	})
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, response.Bid{
+		ID:        created.ID,
+		ItemID:    created.ItemID,
+		BuyerID:   created.BuyerID,
+		Price:     created.Price.Amount(),
+		CreatedAt: created.CreatedAt,
+	})
+}
+
+func toLabelScanResponse(scan *model.LabelScan, buyerID int) response.LabelScan {
+	item := scan.Item
+	var highestBid *int
+	if item.HighestBid != nil {
+		amt := item.HighestBid.Amount()
+		highestBid = &amt
+	}
+	return response.LabelScan{
+		ItemID:        item.ID,
+		AuctionID:     item.AuctionID,
+		LotNumber:     item.SortOrder,
+		FishType:      item.FishType,
+		Quantity:      item.Quantity,
+		Unit:          item.Unit,
+		HighestBid:    highestBid,
+		HighestBidder: item.HighestBidderFor(buyerID),
+		MinimumBid:    item.MinimumNextBid().Amount(),
+		IsLeading:     item.HighestBidderID != nil && *item.HighestBidderID == buyerID,
+		AuctionStatus: string(scan.Auction.Status),
+		BiddingOpen:   scan.BiddingOpen,
+	}
+}
+
+// RegisterRoutes registers the buyer label handler routes to the given mux.
+func (h *LabelHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /labels/scan", h.Scan)
+	mux.HandleFunc("POST /labels/scan/bids", h.Bid)
+}
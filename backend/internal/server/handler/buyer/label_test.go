@@ -0,0 +1,175 @@
+package buyer_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/response"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+)
+
+func scannedLot(highestBidderID int) *model.LabelScan {
+	highest := model.NewBidPrice(2000)
+	paddle := "128"
+	return &model.LabelScan{
+		Item: &model.AuctionItem{
+			ID: 10, AuctionID: 1, FishType: "マグロ", Quantity: 2, Unit: "本", SortOrder: 5,
+			HighestBid: &highest, HighestBidderID: &highestBidderID, HighestBidderPaddle: &paddle,
+		},
+		Auction:     &model.Auction{ID: 1, Status: model.AuctionStatusInProgress},
+		BiddingOpen: true,
+	}
+}
+
+func TestLabelHandler_Scan(t *testing.T) {
+	tests := []struct {
+		name        string
+		withContext bool
+		execErr     error
+		authErr     error
+		wantStatus  int
+	}{
+		{name: "Success", withContext: true, wantStatus: http.StatusOK},
+		{name: "NotRegistered", withContext: true, authErr: &domainErrors.ForbiddenError{Message: "Buyer is not registered at this venue"}, wantStatus: http.StatusForbidden},
+		{name: "NotAuthenticated", wantStatus: http.StatusUnauthorized},
+		{name: "InvalidCode", withContext: true, execErr: &domainErrors.ValidationError{Field: "code", Message: "label code is invalid"}, wantStatus: http.StatusBadRequest},
+		{name: "Expired", withContext: true, execErr: &domainErrors.GoneError{Resource: "LabelCode", Message: "label code has expired"}, wantStatus: http.StatusGone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotCode string
+			mockReg := &mock.MockRegistry{
+				ScanLabelUC: &mock.MockScanLabelUseCase{
+					ExecuteFunc: func(_ context.Context, code string) (*model.LabelScan, error) {
+						gotCode = code
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return scannedLot(3), nil
+					},
+				},
+				AuthorizeAuctionViewUC: &mock.MockAuthorizeAuctionViewUseCase{
+					ExecuteFunc: func(_ context.Context, buyerID, auctionID int) error {
+						if buyerID != 3 || auctionID != 1 {
+							t.Errorf("unexpected arguments: %d %d", buyerID, auctionID)
+						}
+						return tt.authErr
+					},
+				},
+			}
+			h := buyer.NewLabelHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/labels/scan?code=L1.10.1.sig", nil)
+			if tt.withContext {
+				req = withBuyerID(req, 3)
+			}
+			w := httptest.NewRecorder()
+
+			h.Scan(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if gotCode != "L1.10.1.sig" {
+				t.Errorf("unexpected code %q", gotCode)
+			}
+			var resp response.LabelScan
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.LotNumber != 5 || resp.MinimumBid != 2500 || !resp.IsLeading || !resp.BiddingOpen || resp.AuctionStatus != "in_progress" {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+			if resp.HighestBidder == nil || *resp.HighestBidder != "you" {
+				t.Errorf("expected own high bid to be shown as you, got %v", resp.HighestBidder)
+			}
+		})
+	}
+}
+
+func TestLabelHandler_Bid(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		withContext bool
+		scanErr     error
+		bidErr      error
+		wantStatus  int
+		wantBid     bool
+	}{
+		{name: "Success", body: `{"code":"L1.10.1.sig","price":2500}`, withContext: true, wantStatus: http.StatusCreated, wantBid: true},
+		{name: "NotAuthenticated", body: `{}`, wantStatus: http.StatusUnauthorized},
+		{name: "InvalidJSON", body: `{`, withContext: true, wantStatus: http.StatusInternalServerError},
+		{
+			name:        "WithdrawnLot",
+			body:        `{"code":"L1.10.1.sig","price":2500}`,
+			withContext: true,
+			scanErr:     &domainErrors.GoneError{Resource: "Item", ID: 10, Message: "this lot has been withdrawn from the auction"},
+			wantStatus:  http.StatusGone,
+		},
+		{
+			name:        "PriceTooLow",
+			body:        `{"code":"L1.10.1.sig","price":2000}`,
+			withContext: true,
+			bidErr:      &domainErrors.ValidationError{Field: "price", Message: "Bid price must be at least 2500"},
+			wantStatus:  http.StatusBadRequest,
+			wantBid:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBid *model.Bid
+			mockReg := &mock.MockRegistry{
+				ScanLabelUC: &mock.MockScanLabelUseCase{
+					ExecuteFunc: func(_ context.Context, _ string) (*model.LabelScan, error) {
+						if tt.scanErr != nil {
+							return nil, tt.scanErr
+						}
+						return scannedLot(7), nil
+					},
+				},
+				CreateBidUC: &mock.MockCreateBidUseCase{
+					ExecuteFunc: func(_ context.Context, b *model.Bid) (*model.Bid, error) {
+						gotBid = b
+						if tt.bidErr != nil {
+							return nil, tt.bidErr
+						}
+						b.ID = 1
+						return b, nil
+					},
+				},
+			}
+			h := buyer.NewLabelHandler(mockReg)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/labels/scan/bids", bytes.NewBufferString(tt.body))
+			if tt.withContext {
+				req = withBuyerID(req, 3)
+			}
+			w := httptest.NewRecorder()
+
+			h.Bid(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d", tt.wantStatus, w.Code)
+			}
+			if (gotBid != nil) != tt.wantBid {
+				t.Fatalf("expected bid placed %v, got %+v", tt.wantBid, gotBid)
+			}
+			if gotBid != nil && (gotBid.ItemID != 10 || gotBid.BuyerID != 3 || gotBid.Price.Amount() != 2500 && tt.name == "Success") {
+				t.Errorf("unexpected bid: %+v", gotBid)
+			}
+		})
+	}
+}
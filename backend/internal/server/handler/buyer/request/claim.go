@@ -0,0 +1,10 @@
+package request
+
+// FileClaim holds data for filing a claim against a purchase.
+// Amount may be omitted for a return to claim the full purchase price.
+type FileClaim struct {
+	PurchaseID int    `json:"purchase_id"`
+	Type       string `json:"type"`
+	Reason     string `json:"reason"`
+	Amount     int    `json:"amount"`
+}
@@ -0,0 +1,7 @@
+package request
+
+// ScanBid holds data for placing a bid on the lot of a scanned label.
+type ScanBid struct {
+	Code  string `json:"code"`
+	Price int    `json:"price"`
+}
@@ -0,0 +1,16 @@
+package request
+
+// CreateLogin holds a new staff login of the buyer organization.
+type CreateLogin struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	BidLimit *int   `json:"bid_limit"`
+}
+
+// UpdateLogin holds the changes to a login of the buyer organization.
+type UpdateLogin struct {
+	Name     string `json:"name"`
+	BidLimit *int   `json:"bid_limit"`
+	Disabled bool   `json:"disabled"`
+}
@@ -0,0 +1,23 @@
+package request
+
+// UpdateProfile holds the details a buyer can change themselves.
+type UpdateProfile struct {
+	Name                      string `json:"name"`
+	Organization              string `json:"organization"`
+	ContactInfo               string `json:"contact_info"`
+	BusinessName              string `json:"business_name"`
+	InvoiceRegistrationNumber string `json:"invoice_registration_number"`
+	Address                   string `json:"address"`
+	Phone                     string `json:"phone"`
+}
+
+// RequestEmailChange holds the new login email and the current password confirming the request.
+type RequestEmailChange struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// ConfirmEmailChange holds the token from the verification link.
+type ConfirmEmailChange struct {
+	Token string `json:"token"`
+}
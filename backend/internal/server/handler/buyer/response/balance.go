@@ -0,0 +1,21 @@
+package response
+
+// Balance represents the buyer's own billing balance.
+type Balance struct {
+	InvoicedAmount    int              `json:"invoiced_amount"`
+	PaidAmount        int              `json:"paid_amount"`
+	OutstandingAmount int              `json:"outstanding_amount"`
+	CreditAmount      int              `json:"credit_amount"`
+	OpenInvoices      []BalanceInvoice `json:"open_invoices"`
+}
+
+// BalanceInvoice represents an invoice that still has an amount to pay.
+type BalanceInvoice struct {
+	ID                int     `json:"id"`
+	AuctionID         int     `json:"auction_id"`
+	TotalAmount       int     `json:"total_amount"`
+	CreditedAmount    int     `json:"credited_amount"`
+	PaidAmount        int     `json:"paid_amount"`
+	OutstandingAmount int     `json:"outstanding_amount"`
+	IssuedAt          *string `json:"issued_at"`
+}
@@ -10,3 +10,17 @@ type Bid struct {
 	Price     int       `json:"price"`
 	CreatedAt time.Time `json:"created_at"`
 }
+
+// BidLadderEntry represents one bid in a lot's history as buyers see it.
+// Bidder is the paddle number, or "you" for the buyer's own bids; other buyers' names and IDs are never shown.
+type BidLadderEntry struct {
+	Bidder    *string   `json:"bidder"`
+	Price     int       `json:"price"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BidHistory represents one page of a lot's bids, newest first.
+type BidHistory struct {
+	Bids       []BidLadderEntry `json:"bids"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,18 @@
+package response
+
+// Claim represents a view of a claim for the buyer who filed it.
+type Claim struct {
+	ID              int     `json:"id"`
+	PurchaseID      int     `json:"purchase_id"`
+	ItemID          int     `json:"item_id"`
+	AuctionID       int     `json:"auction_id"`
+	FishType        string  `json:"fish_type"`
+	Type            string  `json:"type"`
+	Reason          string  `json:"reason"`
+	RequestedAmount int     `json:"requested_amount"`
+	ApprovedAmount  *int    `json:"approved_amount"`
+	Status          string  `json:"status"`
+	ResolutionNote  string  `json:"resolution_note"`
+	ResolvedAt      *string `json:"resolved_at"`
+	CreatedAt       string  `json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package response
+
+// LabelScan represents the lot a scanned label resolves to.
+// The current high bidder is shown only by paddle number, or "you" when it is the buyer; IsLeading tells the buyer whether it is them.
+type LabelScan struct {
+	ItemID        int     `json:"item_id"`
+	AuctionID     int     `json:"auction_id"`
+	LotNumber     int     `json:"lot_number"`
+	FishType      string  `json:"fish_type"`
+	Quantity      int     `json:"quantity"`
+	Unit          string  `json:"unit"`
+	HighestBid    *int    `json:"highest_bid,omitempty"`
+	HighestBidder *string `json:"highest_bidder,omitempty"`
+	MinimumBid    int     `json:"minimum_bid"`
+	IsLeading     bool    `json:"is_leading"`
+	AuctionStatus string  `json:"auction_status"`
+	BiddingOpen   bool    `json:"bidding_open"`
+}
@@ -0,0 +1,12 @@
+package response
+
+// Login represents a login of the buyer organization.
+type Login struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Email       string  `json:"email"`
+	Role        string  `json:"role"`
+	BidLimit    *int    `json:"bid_limit"`
+	Disabled    bool    `json:"disabled"`
+	LastLoginAt *string `json:"last_login_at"`
+}
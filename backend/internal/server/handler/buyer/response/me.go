@@ -5,4 +5,15 @@ type Me struct {
 	Authenticated bool   `json:"authenticated"`
 	BuyerID       int    `json:"buyer_id"`
 	Name          string `json:"name"`
+
+	Organization              string  `json:"organization"`
+	ContactInfo               string  `json:"contact_info"`
+	BusinessName              string  `json:"business_name"`
+	InvoiceRegistrationNumber string  `json:"invoice_registration_number"`
+	Address                   string  `json:"address"`
+	Phone                     string  `json:"phone"`
+	LicenseNumber             string  `json:"license_number"`
+	LicenseExpiresOn          *string `json:"license_expires_on"`
+	// RegistrationStatus is pending until the market office approves a self-registration.
+	RegistrationStatus string `json:"registration_status"`
 }
@@ -0,0 +1,109 @@
+package buyer
+
+import (
+	"net/http"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/session"
+)
+
+// SessionHandler handles buyer HTTP requests related to the buyer's signed-in sessions.
+type SessionHandler struct {
+	listUseCase       session.ListSessionsUseCase
+	revokeUseCase     session.RevokeSessionUseCase
+	revokeManyUseCase session.RevokeSessionsUseCase
+}
+
+// NewSessionHandler creates a new SessionHandler instance.
+func NewSessionHandler(r registry.UseCase) *SessionHandler {
+	return &SessionHandler{
+		listUseCase:       r.NewListSessionsUseCase(),
+		revokeUseCase:     r.NewRevokeSessionUseCase(),
+		revokeManyUseCase: r.NewRevokeSessionsUseCase(),
+	}
+}
+
+// sessionOwner returns the login of the request. Staff of an organization see only their own sessions.
+func sessionOwner(r *http.Request) (model.SessionOwner, bool) {
+	buyerID, ok := middleware.BuyerIDFromContext(r.Context())
+	if !ok {
+		return model.SessionOwner{}, false
+	}
+	loginID, ok := middleware.BuyerLoginIDFromContext(r.Context())
+	if !ok {
+		return model.SessionOwner{}, false
+	}
+	return model.SessionOwner{Role: model.SessionRoleBuyer, UserID: buyerID, LoginID: loginID}, true
+}
+
+// List handles the request to list the sessions of the signed-in login.
+func (h *SessionHandler) List(w http.ResponseWriter, r *http.Request) {
+	owner, ok := sessionOwner(r)
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	sessions, err := h.listUseCase.Execute(r.Context(), owner)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	current, _ := middleware.SessionIDFromContext(r.Context())
+	resp := make([]response.Session, 0, len(sessions))
+	for _, s := range sessions {
+		resp = append(resp, response.Session{
+			ID:         s.PublicID(),
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			IPAddress:  s.IPAddress,
+			UserAgent:  s.UserAgent,
+			Current:    s.ID == current,
+		})
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// Revoke handles the request to sign out one session.
+func (h *SessionHandler) Revoke(w http.ResponseWriter, r *http.Request) {
+	owner, ok := sessionOwner(r)
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	if err := h.revokeUseCase.Execute(r.Context(), owner, r.PathValue("id")); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, response.Message{Message: "Session revoked"})
+}
+
+// RevokeOthers handles the request to sign out every session but the current one.
+func (h *SessionHandler) RevokeOthers(w http.ResponseWriter, r *http.Request) {
+	owner, ok := sessionOwner(r)
+	current, hasSession := middleware.SessionIDFromContext(r.Context())
+	if !ok || !hasSession {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	n, err := h.revokeManyUseCase.Execute(r.Context(), owner, current)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	util.WriteJSON(w, http.StatusOK, response.SessionsRevoked{Revoked: n})
+}
+
+// RegisterRoutes registers the buyer session handler routes to the given mux.
+func (h *SessionHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /sessions", h.List)
+	mux.HandleFunc("DELETE /sessions", h.RevokeOthers)
+	mux.HandleFunc("DELETE /sessions/{id}", h.Revoke)
+}
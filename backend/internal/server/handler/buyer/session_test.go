@@ -0,0 +1,130 @@
+package buyer_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer"
+	"github.com/seka/fish-auction/backend/internal/server/handler/buyer/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+)
+
+func newBuyerSessionRequest(method, target string) *http.Request {
+	req := httptest.NewRequestWithContext(context.Background(), method, target, nil)
+	ctx := middleware.WithBuyerID(req.Context(), 1)
+	ctx = middleware.WithBuyerLoginID(ctx, 10)
+	ctx = middleware.WithSessionID(ctx, "current-session")
+	return req.WithContext(ctx)
+}
+
+func TestSessionHandler_List(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	mockReg := &mock.MockRegistry{
+		ListSessionsUC: &mock.MockListSessionsUseCase{
+			ExecuteFunc: func(_ context.Context, owner model.SessionOwner) ([]model.Session, error) {
+				if owner != (model.SessionOwner{Role: model.SessionRoleBuyer, UserID: 1, LoginID: 10}) {
+					t.Errorf("unexpected owner %+v", owner)
+				}
+				return []model.Session{
+					{ID: "current-session", LastSeenAt: now, IPAddress: "203.0.113.5", UserAgent: "Mozilla/5.0"},
+					{ID: "other-session", LastSeenAt: now.Add(-time.Hour)},
+				}, nil
+			},
+		},
+	}
+	h := buyer.NewSessionHandler(mockReg)
+
+	w := httptest.NewRecorder()
+	h.List(w, newBuyerSessionRequest(http.MethodGet, "/sessions"))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	if body := w.Body.String(); strings.Contains(body, "current-session") || strings.Contains(body, "other-session") {
+		t.Errorf("expected raw session IDs not to be exposed, got %s", body)
+	}
+	var resp []response.Session
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp) != 2 || !resp[0].Current || resp[1].Current {
+		t.Fatalf("expected the first session to be marked current, got %+v", resp)
+	}
+	if resp[0].ID != (&model.Session{ID: "current-session"}).PublicID() || resp[0].IPAddress != "203.0.113.5" {
+		t.Errorf("unexpected session %+v", resp[0])
+	}
+}
+
+func TestSessionHandler_Revoke(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "Success", wantStatus: http.StatusOK},
+		{name: "NotFound", err: &domainErrors.NotFoundError{Resource: "Session", ID: "abc"}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				RevokeSessionUC: &mock.MockRevokeSessionUseCase{
+					ExecuteFunc: func(_ context.Context, owner model.SessionOwner, publicID string) error {
+						if owner.LoginID != 10 || publicID != "abc" {
+							t.Errorf("unexpected revocation %+v %q", owner, publicID)
+						}
+						return tt.err
+					},
+				},
+			}
+			h := buyer.NewSessionHandler(mockReg)
+
+			req := newBuyerSessionRequest(http.MethodDelete, "/sessions/abc")
+			req.SetPathValue("id", "abc")
+			w := httptest.NewRecorder()
+			h.Revoke(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestSessionHandler_RevokeOthers(t *testing.T) {
+	mockReg := &mock.MockRegistry{
+		RevokeSessionsUC: &mock.MockRevokeSessionsUseCase{
+			ExecuteFunc: func(_ context.Context, owner model.SessionOwner, keepSessionID string) (int, error) {
+				if owner.LoginID != 10 || keepSessionID != "current-session" {
+					t.Errorf("expected the current session to be kept, got %+v %q", owner, keepSessionID)
+				}
+				return 2, nil
+			},
+		},
+	}
+	h := buyer.NewSessionHandler(mockReg)
+
+	w := httptest.NewRecorder()
+	h.RevokeOthers(w, newBuyerSessionRequest(http.MethodDelete, "/sessions"))
+
+	var resp response.SessionsRevoked
+	if w.Code != http.StatusOK || json.NewDecoder(w.Body).Decode(&resp) != nil || resp.Revoked != 2 {
+		t.Errorf("expected 2 sessions to be revoked, got %d %s", w.Code, w.Body.String())
+	}
+
+	t.Run("Unauthorized_NoContext", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.RevokeOthers(w, httptest.NewRequestWithContext(context.Background(), http.MethodDelete, "/sessions", nil))
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("expected status 401, got %d", w.Code)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package fisherman_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/server/handler/fisherman"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+)
+
+func TestAuthResetHandler_RequestReset(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", body: `{"email":"f1@example.com"}`, wantStatus: http.StatusOK},
+		{name: "UnknownEmail", body: `{"email":"nobody@example.com"}`, execErr: &domainErrors.NotFoundError{Resource: "FishermanAuthentication"}, wantStatus: http.StatusOK},
+		{name: "InvalidJSON", body: `{`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				RequestFishermanPasswordResetUC: &mock.MockRequestFishermanPasswordResetUseCase{
+					ExecuteFunc: func(_ context.Context, _ string) error { return tt.execErr },
+				},
+			}
+			h := fisherman.NewAuthResetHandler(mockReg)
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/api/fisherman/password-reset/request", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			h.RequestReset(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestAuthResetHandler_ConfirmReset(t *testing.T) {
+	tests := []struct {
+		name       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", wantStatus: http.StatusOK},
+		{name: "ExpiredToken", execErr: &domainErrors.UnauthorizedError{Message: "Token expired"}, wantStatus: http.StatusBadRequest},
+		{name: "WeakPassword", execErr: &domainErrors.ValidationError{Field: "password", Message: "too short"}, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ResetFishermanPasswordUC: &mock.MockResetFishermanPasswordUseCase{
+					ExecuteFunc: func(_ context.Context, _, _ string) error { return tt.execErr },
+				},
+			}
+			h := fisherman.NewAuthResetHandler(mockReg)
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/api/fisherman/password-reset/confirm", bytes.NewBufferString(`{"token":"t","new_password":"Password1!"}`))
+			w := httptest.NewRecorder()
+
+			h.ConfirmReset(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
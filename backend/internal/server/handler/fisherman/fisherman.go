@@ -0,0 +1,115 @@
+package fisherman
+
+import (
+	"net/http"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/fisherman/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
+)
+
+// FishermanHandler handles fisherman HTTP requests related to their account and consigned lots.
+type FishermanHandler struct {
+	getMeUseCase       fisherman.GetFishermanUseCase
+	listLotsUseCase    fisherman.ListConsignedLotsUseCase
+	listResultsUseCase fisherman.ListSaleResultsUseCase
+}
+
+// NewFishermanHandler creates a new FishermanHandler instance.
+func NewFishermanHandler(r registry.UseCase) *FishermanHandler {
+	return &FishermanHandler{
+		getMeUseCase:       r.NewGetFishermanUseCase(),
+		listLotsUseCase:    r.NewListConsignedLotsUseCase(),
+		listResultsUseCase: r.NewListSaleResultsUseCase(),
+	}
+}
+
+// GetMe handles the request to get the current authenticated fisherman's info.
+func (h *FishermanHandler) GetMe(w http.ResponseWriter, r *http.Request) {
+	fishermanID, ok := middleware.FishermanIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	f, err := h.getMeUseCase.Execute(r.Context(), fishermanID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, response.Me{
+		Authenticated: true,
+		FishermanID:   f.ID,
+		Name:          f.Name,
+	})
+}
+
+// ListLots handles the request to list the fisherman's consigned lots.
+// ?status=in_progress で開催中の競りに絞ると、現在の最高値をライブ価格として確認できる。
+func (h *FishermanHandler) ListLots(w http.ResponseWriter, r *http.Request) {
+	fishermanID, ok := middleware.FishermanIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var status *model.AuctionStatus
+	if s := r.URL.Query().Get("status"); s != "" {
+		st := model.AuctionStatus(s)
+		status = &st
+	}
+
+	lots, err := h.listLotsUseCase.Execute(r.Context(), fishermanID, status)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toLotResponses(lots))
+}
+
+// ListResults handles the request to list the fisherman's sold lots with their winning prices.
+func (h *FishermanHandler) ListResults(w http.ResponseWriter, r *http.Request) {
+	fishermanID, ok := middleware.FishermanIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	lots, err := h.listResultsUseCase.Execute(r.Context(), fishermanID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toLotResponses(lots))
+}
+
+func toLotResponses(lots []model.ConsignedLot) []response.Lot {
+	resp := make([]response.Lot, len(lots))
+	for i, l := range lots {
+		resp[i] = response.Lot{
+			ItemID:        l.ItemID,
+			AuctionID:     l.AuctionID,
+			AuctionDate:   l.AuctionDate,
+			AuctionStatus: string(l.AuctionStatus),
+			FishType:      l.FishType,
+			Quantity:      l.Quantity,
+			Unit:          l.Unit,
+			Price:         l.Price,
+			BidCount:      l.BidCount,
+		}
+	}
+	return resp
+}
+
+// RegisterRoutes registers the fisherman handler routes to the given mux.
+func (h *FishermanHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /me", h.GetMe)
+	mux.HandleFunc("GET /lots", h.ListLots)
+	mux.HandleFunc("GET /results", h.ListResults)
+}
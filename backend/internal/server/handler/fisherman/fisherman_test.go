@@ -0,0 +1,107 @@
+package fisherman_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/fisherman"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+)
+
+func withFishermanID(req *http.Request, fishermanID int) *http.Request {
+	return req.WithContext(middleware.WithFishermanID(req.Context(), fishermanID))
+}
+
+func TestFishermanHandler_GetMe(t *testing.T) {
+	mockReg := &mock.MockRegistry{
+		GetFishermanUC: &mock.MockGetFishermanUseCase{
+			ExecuteFunc: func(_ context.Context, id int) (*model.Fisherman, error) {
+				return &model.Fisherman{ID: id, Name: "F3"}, nil
+			},
+		},
+	}
+	h := fisherman.NewFishermanHandler(mockReg)
+
+	req := withFishermanID(httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/me", nil), 3)
+	w := httptest.NewRecorder()
+	h.GetMe(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Code)
+	}
+
+	req = httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/me", nil)
+	w = httptest.NewRecorder()
+	h.GetMe(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without session, got %d", w.Code)
+	}
+}
+
+func TestFishermanHandler_ListLots(t *testing.T) {
+	price := 12000
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus *model.AuctionStatus
+		execErr    error
+		wantCode   int
+	}{
+		{name: "All", wantCode: http.StatusOK},
+		{name: "Live", query: "?status=in_progress", wantStatus: func() *model.AuctionStatus { s := model.AuctionStatusInProgress; return &s }(), wantCode: http.StatusOK},
+		{
+			name:     "InvalidStatus",
+			query:    "?status=bogus",
+			execErr:  &domainErrors.ValidationError{Field: "status", Message: "is invalid"},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ListConsignedLotsUC: &mock.MockListConsignedLotsUseCase{
+					ExecuteFunc: func(_ context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error) {
+						if fishermanID != 3 {
+							t.Errorf("expected fisherman 3, got %d", fishermanID)
+						}
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						if (status == nil) != (tt.wantStatus == nil) || status != nil && *status != *tt.wantStatus {
+							t.Errorf("unexpected status filter: %v", status)
+						}
+						return []model.ConsignedLot{{ItemID: 1, AuctionID: 2, AuctionStatus: model.AuctionStatusInProgress, FishType: "Tuna", Price: &price, BidCount: 4}}, nil
+					},
+				},
+			}
+			h := fisherman.NewFishermanHandler(mockReg)
+
+			req := withFishermanID(httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/lots"+tt.query, nil), 3)
+			w := httptest.NewRecorder()
+			h.ListLots(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Fatalf("expected %d, got %d", tt.wantCode, w.Code)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			var body []struct {
+				Price    *int `json:"price"`
+				BidCount int  `json:"bid_count"`
+			}
+			if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(body) != 1 || body[0].Price == nil || *body[0].Price != price || body[0].BidCount != 4 {
+				t.Errorf("unexpected lots: %+v", body)
+			}
+		})
+	}
+}
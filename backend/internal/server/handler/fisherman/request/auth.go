@@ -0,0 +1,17 @@
+package request
+
+// ResetPassword holds data for requesting a fisherman password reset.
+type ResetPassword struct {
+	Email string `json:"email"`
+}
+
+// ResetPasswordVerify holds data for verifying a fisherman reset token.
+type ResetPasswordVerify struct {
+	Token string `json:"token"`
+}
+
+// ResetPasswordConfirm holds data for confirming a fisherman password reset.
+type ResetPasswordConfirm struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
@@ -0,0 +1,15 @@
+package response
+
+// Lot represents a lot consigned by the fisherman.
+// Price is the current highest bid while the auction is running and the winning price once it has completed.
+type Lot struct {
+	ItemID        int    `json:"item_id"`
+	AuctionID     int    `json:"auction_id"`
+	AuctionDate   string `json:"auction_date"`
+	AuctionStatus string `json:"auction_status"`
+	FishType      string `json:"fish_type"`
+	Quantity      int    `json:"quantity"`
+	Unit          string `json:"unit"`
+	Price         *int   `json:"price"`
+	BidCount      int    `json:"bid_count"`
+}
@@ -0,0 +1,8 @@
+package response
+
+// Me represents the currently authenticated fisherman's information.
+type Me struct {
+	Authenticated bool   `json:"authenticated"`
+	FishermanID   int    `json:"fisherman_id"`
+	Name          string `json:"name"`
+}
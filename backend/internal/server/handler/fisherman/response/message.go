@@ -0,0 +1,6 @@
+package response
+
+// Message represents a simple message response.
+type Message struct {
+	Message string `json:"message"`
+}
@@ -0,0 +1,24 @@
+package response
+
+// Settlement represents a settlement statement (仕切書) as seen by the fisherman it pays.
+type Settlement struct {
+	ID               int              `json:"id"`
+	AuctionID        int              `json:"auction_id"`
+	GrossAmount      int              `json:"gross_amount"`
+	CommissionRate   int              `json:"commission_rate"`
+	CommissionAmount int              `json:"commission_amount"`
+	CommissionTax    int              `json:"commission_tax"`
+	NetAmount        int              `json:"net_amount"`
+	SettledAt        *string          `json:"settled_at"`
+	Lines            []SettlementLine `json:"lines,omitempty"`
+}
+
+// SettlementLine represents a single sold item on a settlement statement.
+type SettlementLine struct {
+	ID          int    `json:"id"`
+	ItemID      *int   `json:"item_id"`
+	Description string `json:"description"`
+	Quantity    int    `json:"quantity"`
+	Unit        string `json:"unit"`
+	Amount      int    `json:"amount"`
+}
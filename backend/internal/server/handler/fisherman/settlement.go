@@ -0,0 +1,101 @@
+package fisherman
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/fisherman/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
+)
+
+// SettlementHandler handles fisherman HTTP requests related to their settlement statements.
+type SettlementHandler struct {
+	listUseCase fisherman.ListStatementsUseCase
+	getUseCase  fisherman.GetStatementUseCase
+}
+
+// NewSettlementHandler creates a new SettlementHandler instance.
+func NewSettlementHandler(r registry.UseCase) *SettlementHandler {
+	return &SettlementHandler{
+		listUseCase: r.NewListFishermanStatementsUseCase(),
+		getUseCase:  r.NewGetFishermanStatementUseCase(),
+	}
+}
+
+// List handles the request to list the fisherman's settled statements.
+func (h *SettlementHandler) List(w http.ResponseWriter, r *http.Request) {
+	fishermanID, ok := middleware.FishermanIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	settlements, err := h.listUseCase.Execute(r.Context(), fishermanID)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.Settlement, len(settlements))
+	for i := range settlements {
+		resp[i] = toSettlementResponse(&settlements[i])
+	}
+	util.WriteJSON(w, http.StatusOK, resp)
+}
+
+// Get handles the request to get a single settled statement with its lines.
+func (h *SettlementHandler) Get(w http.ResponseWriter, r *http.Request) {
+	fishermanID, ok := middleware.FishermanIDFromContext(r.Context())
+	if !ok {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid settlement ID")
+		return
+	}
+
+	s, err := h.getUseCase.Execute(r.Context(), fishermanID, id)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, toSettlementResponse(s))
+}
+
+func toSettlementResponse(s *model.Settlement) response.Settlement {
+	resp := response.Settlement{
+		ID:               s.ID,
+		AuctionID:        s.AuctionID,
+		GrossAmount:      s.GrossAmount,
+		CommissionRate:   s.CommissionRate,
+		CommissionAmount: s.CommissionAmount,
+		CommissionTax:    s.CommissionTax,
+		NetAmount:        s.NetAmount,
+		SettledAt:        util.FormatTimestamp(s.SettledAt),
+	}
+	for _, l := range s.Lines {
+		resp.Lines = append(resp.Lines, response.SettlementLine{
+			ID:          l.ID,
+			ItemID:      l.ItemID,
+			Description: l.Description,
+			Quantity:    l.Quantity,
+			Unit:        l.Unit,
+			Amount:      l.Amount,
+		})
+	}
+	return resp
+}
+
+// RegisterRoutes registers the fisherman settlement handler routes to the given mux.
+func (h *SettlementHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /settlements", h.List)
+	mux.HandleFunc("GET /settlements/{id}", h.Get)
+}
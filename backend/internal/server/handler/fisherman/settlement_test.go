@@ -0,0 +1,52 @@
+package fisherman_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/fisherman"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+)
+
+func TestSettlementHandler_Get(t *testing.T) {
+	tests := []struct {
+		name       string
+		pathID     string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", pathID: "5", wantStatus: http.StatusOK},
+		{name: "InvalidID", pathID: "abc", wantStatus: http.StatusBadRequest},
+		{name: "OtherFishermansStatement", pathID: "6", execErr: &domainErrors.NotFoundError{Resource: "Settlement", ID: 6}, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ListFishermanStatementsUC: &mock.MockListFishermanStatementsUseCase{},
+				GetFishermanStatementUC: &mock.MockGetFishermanStatementUseCase{
+					ExecuteFunc: func(_ context.Context, fishermanID, id int) (*model.Settlement, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Settlement{ID: id, FishermanID: fishermanID, Status: model.SettlementStatusSettled}, nil
+					},
+				},
+			}
+			h := fisherman.NewSettlementHandler(mockReg)
+
+			req := withFishermanID(httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/settlements/"+tt.pathID, nil), 3)
+			req.SetPathValue("id", tt.pathID)
+			w := httptest.NewRecorder()
+			h.Get(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
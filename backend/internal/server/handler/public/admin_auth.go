@@ -2,10 +2,10 @@ package public
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	domainerrors "github.com/seka/fish-auction/backend/internal/domain/errors"
-	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/public/request"
@@ -29,14 +29,23 @@ func NewAdminAuthHandler(r registry.UseCase, sessionRepo repository.SessionRepos
 }
 
 // Login handles the login request for admins.
+// 二要素認証が有効な管理者がコードなしで送った場合は、セッションを作らずに two_factor_required を返す。
+// クライアントはコードを入力させ、同じメールアドレスとパスワードに code を添えて再送する。
 func (h *AdminAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
-	var req request.Login
+	var req request.AdminLogin
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		util.HandleError(w, err)
 		return
 	}
 
-	admin, err := h.loginUseCase.Execute(r.Context(), req.Email, req.Password)
+	admin, err := h.loginUseCase.Execute(r.Context(), req.Email, req.Password, req.Code)
+	if errors.Is(err, auth.ErrTwoFactorRequired) {
+		util.WriteJSON(w, http.StatusOK, response.TwoFactorRequired{
+			Message:           "Two-factor authentication code required",
+			TwoFactorRequired: true,
+		})
+		return
+	}
 	if err != nil {
 		util.HandleError(w, err)
 		return
@@ -46,7 +55,7 @@ func (h *AdminAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sessionID, err := h.sessionRepo.Create(r.Context(), admin.ID, model.SessionRoleAdmin)
+	sessionID, err := h.sessionRepo.CreateForAdmin(r.Context(), admin.ID, admin.Role)
 	if err != nil {
 		util.HandleError(w, err)
 		return
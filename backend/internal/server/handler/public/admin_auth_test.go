@@ -6,18 +6,21 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/server/handler/public"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/auth"
 )
 
 func TestAdminAuthHandler_Login(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockLoginUC := &mock.MockLoginUseCase{
-			ExecuteFunc: func(_ context.Context, email, _ string) (*model.Admin, error) {
-				return &model.Admin{ID: 1, Email: email}, nil
+			ExecuteFunc: func(_ context.Context, email, _, _ string) (*model.Admin, error) {
+				return &model.Admin{ID: 1, Email: email, Role: model.AdminRoleAccountant}, nil
 			},
 		}
 		mockReg := &mock.MockRegistry{LoginUC: mockLoginUC}
@@ -46,11 +49,14 @@ func TestAdminAuthHandler_Login(t *testing.T) {
 		if !foundSession {
 			t.Error("expected admin_session cookie")
 		}
+		if s := sessionRepo.Sessions["admin-session-1"]; s == nil || s.Role != model.SessionRoleAdmin || s.AdminRole != model.AdminRoleAccountant {
+			t.Errorf("expected an accountant admin session, got %+v", s)
+		}
 	})
 
 	t.Run("InvalidCredentials", func(t *testing.T) {
 		mockLoginUC := &mock.MockLoginUseCase{
-			ExecuteFunc: func(_ context.Context, _, _ string) (*model.Admin, error) {
+			ExecuteFunc: func(_ context.Context, _, _, _ string) (*model.Admin, error) {
 				return nil, nil // Returns nil, nil for invalid credentials (as per implementation inspection)
 			},
 		}
@@ -70,6 +76,61 @@ func TestAdminAuthHandler_Login(t *testing.T) {
 	})
 }
 
+func TestAdminAuthHandler_Login_TwoFactor(t *testing.T) {
+	mockLoginUC := &mock.MockLoginUseCase{
+		ExecuteFunc: func(_ context.Context, email, _, code string) (*model.Admin, error) {
+			if code == "" {
+				return nil, auth.ErrTwoFactorRequired
+			}
+			if code != "123456" {
+				return nil, &apperrors.UnauthorizedError{Message: "Invalid two-factor authentication code"}
+			}
+			return &model.Admin{ID: 1, Email: email, Role: model.AdminRoleSuperAdmin}, nil
+		},
+	}
+	sessionRepo := &mock.MockSessionRepository{NextSessionID: "admin-session-1"}
+	h := public.NewAdminAuthHandler(&mock.MockRegistry{LoginUC: mockLoginUC}, sessionRepo)
+
+	login := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/api/login", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		h.Login(w, req)
+		return w
+	}
+
+	t.Run("CodeRequired", func(t *testing.T) {
+		w := login(`{"email":"admin@example.com","password":"Password123"}`)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+		var resp map[string]any
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		if resp["two_factor_required"] != true {
+			t.Errorf("expected two_factor_required, got %s", w.Body.String())
+		}
+		if len(w.Result().Cookies()) != 0 || len(sessionRepo.Sessions) != 0 {
+			t.Error("expected no session before the code is verified")
+		}
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		w := login(`{"email":"admin@example.com","password":"Password123","code":"000000"}`)
+		if w.Code != http.StatusUnauthorized {
+			t.Fatalf("expected status 401, got %d", w.Code)
+		}
+	})
+
+	t.Run("WithCode", func(t *testing.T) {
+		w := login(`{"email":"admin@example.com","password":"Password123","code":"123456"}`)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", w.Code)
+		}
+		if sessionRepo.Sessions["admin-session-1"] == nil {
+			t.Error("expected a session once the code is verified")
+		}
+	})
+}
+
 func TestAdminAuthHandler_RegisterRoutes(t *testing.T) {
 	t.Run("MethodNotAllowed", func(t *testing.T) {
 		mockReg := &mock.MockRegistry{}
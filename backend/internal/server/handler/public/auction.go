@@ -10,23 +10,27 @@ import (
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/public/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 	"github.com/seka/fish-auction/backend/internal/usecase/auction"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
 )
 
 // AuctionHandler handles public HTTP requests related to auctions.
 type AuctionHandler struct {
-	listUseCase     auction.ListAuctionsUseCase
-	getUseCase      auction.GetAuctionUseCase
-	getItemsUseCase auction.GetAuctionItemsUseCase
+	listUseCase      auction.ListAuctionsUseCase
+	getUseCase       auction.GetAuctionUseCase
+	getItemsUseCase  auction.GetAuctionItemsUseCase
+	authorizeUseCase registration.AuthorizeAuctionViewUseCase
 }
 
 // NewAuctionHandler creates a new AuctionHandler instance.
 func NewAuctionHandler(r registry.UseCase) *AuctionHandler {
 	return &AuctionHandler{
-		listUseCase:     r.NewListAuctionsUseCase(),
-		getUseCase:      r.NewGetAuctionUseCase(),
-		getItemsUseCase: r.NewGetAuctionItemsUseCase(),
+		listUseCase:      r.NewListAuctionsUseCase(),
+		getUseCase:       r.NewGetAuctionUseCase(),
+		getItemsUseCase:  r.NewGetAuctionItemsUseCase(),
+		authorizeUseCase: r.NewAuthorizeAuctionViewUseCase(),
 	}
 }
 
@@ -105,6 +109,8 @@ func (h *AuctionHandler) Get(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetItems handles the request to get items for a specific auction.
+// When VENUE_REGISTRATION_REQUIRED_TO_VIEW is on, only buyers registered at the auction's venue may list its lots,
+// so the server registers the route behind the optional buyer auth middleware itself.
 func (h *AuctionHandler) GetItems(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
 	id, err := strconv.Atoi(idStr)
@@ -112,6 +118,11 @@ func (h *AuctionHandler) GetItems(w http.ResponseWriter, r *http.Request) {
 		util.WriteError(w, http.StatusBadRequest, "Invalid ID")
 		return
 	}
+	buyerID, _ := middleware.BuyerIDFromContext(r.Context())
+	if err := h.authorizeUseCase.Execute(r.Context(), buyerID, id); err != nil {
+		util.HandleError(w, err)
+		return
+	}
 
 	items, err := h.getItemsUseCase.Execute(r.Context(), id)
 	if err != nil {
@@ -128,15 +139,16 @@ func (h *AuctionHandler) GetItems(w http.ResponseWriter, r *http.Request) {
 			highestBid = &amt
 		}
 		resp[i] = response.Item{
-			ID:          item.ID,
-			AuctionID:   item.AuctionID,
-			FishermanID: item.FishermanID,
-			FishType:    item.FishType,
-			Quantity:    item.Quantity,
-			Unit:        item.Unit,
-			HighestBid:  highestBid,
-			SortOrder:   item.SortOrder,
-			CreatedAt:   item.CreatedAt,
+			ID:                  item.ID,
+			AuctionID:           item.AuctionID,
+			FishermanID:         item.FishermanID,
+			FishType:            item.FishType,
+			Quantity:            item.Quantity,
+			Unit:                item.Unit,
+			HighestBid:          highestBid,
+			HighestBidderPaddle: item.HighestBidderFor(buyerID),
+			SortOrder:           item.SortOrder,
+			CreatedAt:           item.CreatedAt,
 		}
 	}
 
@@ -148,5 +160,4 @@ func (h *AuctionHandler) GetItems(w http.ResponseWriter, r *http.Request) {
 func (h *AuctionHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("GET /api/auctions", h.List)
 	mux.HandleFunc("GET /api/auctions/{id}", h.Get)
-	mux.HandleFunc("GET /api/auctions/{id}/items", h.GetItems)
 }
@@ -2,14 +2,18 @@ package public_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/server/handler/public"
+	"github.com/seka/fish-auction/backend/internal/server/handler/public/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
 )
 
@@ -171,6 +175,7 @@ func TestPublicAuctionHandler_GetItems(t *testing.T) {
 	type testCase struct {
 		name       string
 		idStr      string
+		buyer      int
 		mockSetup  func(*mock.MockRegistry)
 		wantStatus int
 	}
@@ -194,6 +199,34 @@ func TestPublicAuctionHandler_GetItems(t *testing.T) {
 			mockSetup:  func(_ *mock.MockRegistry) {},
 			wantStatus: http.StatusBadRequest,
 		},
+		{
+			name:  "NotRegisteredAtVenue",
+			idStr: "1",
+			buyer: 5,
+			mockSetup: func(r *mock.MockRegistry) {
+				r.AuthorizeAuctionViewUC = &mock.MockAuthorizeAuctionViewUseCase{
+					ExecuteFunc: func(_ context.Context, buyerID, auctionID int) error {
+						if buyerID != 5 || auctionID != 1 {
+							t.Errorf("unexpected authorization for buyer %d, auction %d", buyerID, auctionID)
+						}
+						return &domainErrors.ForbiddenError{Message: "not registered at this venue"}
+					},
+				}
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:  "AnonymousWhenRegistrationRequired",
+			idStr: "1",
+			mockSetup: func(r *mock.MockRegistry) {
+				r.AuthorizeAuctionViewUC = &mock.MockAuthorizeAuctionViewUseCase{
+					ExecuteFunc: func(_ context.Context, _, _ int) error {
+						return &domainErrors.UnauthorizedError{Message: "sign in"}
+					},
+				}
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
 		{
 			name:  "NotFound",
 			idStr: "999",
@@ -210,12 +243,15 @@ func TestPublicAuctionHandler_GetItems(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			mockReg := &mock.MockRegistry{}
+			mockReg := &mock.MockRegistry{AuthorizeAuctionViewUC: &mock.MockAuthorizeAuctionViewUseCase{}}
 			tc.mockSetup(mockReg)
 			h := public.NewAuctionHandler(mockReg)
 
 			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/api/auctions/"+tc.idStr+"/items", nil)
 			req.SetPathValue("id", tc.idStr)
+			if tc.buyer != 0 {
+				req = req.WithContext(middleware.WithBuyerID(req.Context(), tc.buyer))
+			}
 			w := httptest.NewRecorder()
 
 			h.GetItems(w, req)
@@ -227,6 +263,43 @@ func TestPublicAuctionHandler_GetItems(t *testing.T) {
 	}
 }
 
+func TestPublicAuctionHandler_GetItems_ViewerLeads(t *testing.T) {
+	viewer, other := 7, 8
+	viewerPaddle, otherPaddle := "128", "129"
+	mockReg := &mock.MockRegistry{
+		AuthorizeAuctionViewUC: &mock.MockAuthorizeAuctionViewUseCase{},
+		GetAuctionItemsUC: &mock.MockGetAuctionItemsUseCase{
+			ExecuteFunc: func(_ context.Context, _ int) ([]model.AuctionItem, error) {
+				return []model.AuctionItem{
+					{ID: 1, HighestBidderID: &viewer, HighestBidderPaddle: &viewerPaddle},
+					{ID: 2, HighestBidderID: &other, HighestBidderPaddle: &otherPaddle},
+				}, nil
+			},
+		},
+	}
+	h := public.NewAuctionHandler(mockReg)
+
+	req := httptest.NewRequestWithContext(middleware.WithBuyerID(context.Background(), viewer), http.MethodGet, "/api/auctions/1/items", nil)
+	req.SetPathValue("id", "1")
+	w := httptest.NewRecorder()
+
+	h.GetItems(w, req)
+
+	var resp []response.Item
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp) != 2 || resp[0].HighestBidderPaddle == nil || resp[1].HighestBidderPaddle == nil {
+		t.Fatalf("unexpected items: %+v", resp)
+	}
+	if *resp[0].HighestBidderPaddle != model.BidderYou {
+		t.Errorf("expected the viewer's own lead to show %q, got %q", model.BidderYou, *resp[0].HighestBidderPaddle)
+	}
+	if *resp[1].HighestBidderPaddle != otherPaddle {
+		t.Errorf("expected another buyer's paddle %q, got %q", otherPaddle, *resp[1].HighestBidderPaddle)
+	}
+}
+
 func TestPublicAuctionHandler_RegisterRoutes(_ *testing.T) {
 	mockReg := &mock.MockRegistry{
 		ListAuctionsUC: &mock.MockListAuctionsUseCase{ExecuteFunc: func(_ context.Context, _ *repository.AuctionFilters) ([]model.Auction, error) {
@@ -245,7 +318,6 @@ func TestPublicAuctionHandler_RegisterRoutes(_ *testing.T) {
 	}{
 		{http.MethodGet, "/api/auctions"},
 		{http.MethodGet, "/api/auctions/1"},
-		{http.MethodGet, "/api/auctions/1/items"},
 	}
 
 	for _, tt := range tests {
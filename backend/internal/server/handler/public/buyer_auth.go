@@ -2,8 +2,10 @@ package public
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/registry"
@@ -15,15 +17,21 @@ import (
 
 // BuyerAuthHandler handles public HTTP requests related to buyer authentication.
 type BuyerAuthHandler struct {
-	loginUseCase buyer.LoginBuyerUseCase
-	sessionRepo  repository.SessionRepository
+	loginUseCase    buyer.LoginBuyerUseCase
+	registerUseCase buyer.RegisterBuyerUseCase
+	verifyUseCase   buyer.VerifyRegistrationEmailUseCase
+	resendUseCase   buyer.ResendRegistrationVerificationUseCase
+	sessionRepo     repository.SessionRepository
 }
 
 // NewBuyerAuthHandler creates a new BuyerAuthHandler instance.
 func NewBuyerAuthHandler(r registry.UseCase, sessionRepo repository.SessionRepository) *BuyerAuthHandler {
 	return &BuyerAuthHandler{
-		loginUseCase: r.NewLoginBuyerUseCase(),
-		sessionRepo:  sessionRepo,
+		loginUseCase:    r.NewLoginBuyerUseCase(),
+		registerUseCase: r.NewRegisterBuyerUseCase(),
+		verifyUseCase:   r.NewVerifyBuyerRegistrationEmailUseCase(),
+		resendUseCase:   r.NewResendBuyerRegistrationVerificationUseCase(),
+		sessionRepo:     sessionRepo,
 	}
 }
 
@@ -35,17 +43,17 @@ func (h *BuyerAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	buy, err := h.loginUseCase.Execute(r.Context(), req.Email, req.Password)
+	buy, login, err := h.loginUseCase.Execute(r.Context(), req.Email, req.Password)
 	if err != nil {
 		util.HandleError(w, err)
 		return
 	}
-	if buy == nil {
+	if buy == nil || login == nil {
 		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
 		return
 	}
 
-	sessionID, err := h.sessionRepo.Create(r.Context(), buy.ID, model.SessionRoleBuyer)
+	sessionID, err := h.sessionRepo.CreateForLogin(r.Context(), buy.ID, login.ID, model.SessionRoleBuyer)
 	if err != nil {
 		util.HandleError(w, err)
 		return
@@ -53,7 +61,7 @@ func (h *BuyerAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 
 	setSessionCookie(w, "buyer_session", sessionID)
 
-	resp := response.Buyer{ID: buy.ID, Name: buy.Name}
+	resp := response.Buyer{ID: buy.ID, Name: buy.Name, LoginID: login.ID, LoginName: login.Name, LoginRole: string(login.Role)}
 	w.Header().Set("Content-Type", "application/json")
 	_ = json.NewEncoder(w).Encode(resp)
 }
@@ -73,8 +81,73 @@ func (h *BuyerAuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(response.Message{Message: "Logged out"})
 }
 
+// SignUp handles a buyer's self-registration. The buyer cannot bid until an admin approves the application.
+func (h *BuyerAuthHandler) SignUp(w http.ResponseWriter, r *http.Request) {
+	var req request.SignUp
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	if _, err := h.registerUseCase.Execute(r.Context(), &buyer.RegisterBuyerInput{
+		Name:                      req.Name,
+		Email:                     req.Email,
+		Password:                  req.Password,
+		Organization:              req.Organization,
+		ContactInfo:               req.ContactInfo,
+		BusinessName:              req.BusinessName,
+		InvoiceRegistrationNumber: req.InvoiceRegistrationNumber,
+		Address:                   req.Address,
+		Phone:                     req.Phone,
+	}); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusCreated, response.Message{Message: "Please check your email to verify your address"})
+}
+
+// VerifySignUp handles the verification link sent on sign-up and puts the application in the review queue.
+func (h *BuyerAuthHandler) VerifySignUp(w http.ResponseWriter, r *http.Request) {
+	var req request.VerifySignUp
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.verifyUseCase.Execute(r.Context(), req.Token); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	util.WriteJSON(w, http.StatusOK, response.Message{Message: "Email verified. Your registration is awaiting review"})
+}
+
+// ResendSignUpVerification sends a new verification link to an applicant whose link was lost or has expired.
+func (h *BuyerAuthHandler) ResendSignUpVerification(w http.ResponseWriter, r *http.Request) {
+	var req request.ResendSignUpVerification
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.WriteError(w, http.StatusBadRequest, "Invalid JSON")
+		return
+	}
+
+	if err := h.resendUseCase.Execute(r.Context(), req.Email); err != nil {
+		var notFoundErr *domainErrors.NotFoundError
+		if !errors.As(err, &notFoundErr) {
+			util.HandleError(w, err)
+			return
+		}
+		// Security: Don't reveal whether an application exists for the email.
+	}
+
+	util.WriteJSON(w, http.StatusOK, response.Message{Message: "If the application is awaiting verification, a new link has been sent."})
+}
+
 // RegisterRoutes registers the public buyer handler routes to the given mux.
 func (h *BuyerAuthHandler) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("POST /api/buyer/login", h.Login)
 	mux.HandleFunc("POST /api/buyer/logout", h.Logout)
+	mux.HandleFunc("POST /api/buyer/signup", h.SignUp)
+	mux.HandleFunc("POST /api/buyer/signup/verify", h.VerifySignUp)
+	mux.HandleFunc("POST /api/buyer/signup/resend", h.ResendSignUpVerification)
 }
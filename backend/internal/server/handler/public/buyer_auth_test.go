@@ -9,10 +9,13 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/server/handler/public"
 	"github.com/seka/fish-auction/backend/internal/server/handler/public/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/public/response"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
 )
 
 func TestBuyerAuthHandler_Login(t *testing.T) {
@@ -30,8 +33,8 @@ func TestBuyerAuthHandler_Login(t *testing.T) {
 			body: request.Login{Email: "buyer@example.com", Password: "password"},
 			mockSetup: func(r *mock.MockRegistry) {
 				r.LoginBuyerUC = &mock.MockLoginBuyerUseCase{
-					ExecuteFunc: func(_ context.Context, _, _ string) (*model.Buyer, error) {
-						return &model.Buyer{ID: 1, Name: "Buyer 1"}, nil
+					ExecuteFunc: func(_ context.Context, _, _ string) (*model.Buyer, *model.Authentication, error) {
+						return &model.Buyer{ID: 1, Name: "Buyer 1"}, &model.Authentication{ID: 12, BuyerID: 1, Name: "佐藤", Role: model.BuyerLoginStaff}, nil
 					},
 				}
 			},
@@ -49,13 +52,25 @@ func TestBuyerAuthHandler_Login(t *testing.T) {
 			body: request.Login{Email: "buyer@example.com", Password: "wrong"},
 			mockSetup: func(r *mock.MockRegistry) {
 				r.LoginBuyerUC = &mock.MockLoginBuyerUseCase{
-					ExecuteFunc: func(_ context.Context, _, _ string) (*model.Buyer, error) {
-						return nil, errors.New("invalid credentials")
+					ExecuteFunc: func(_ context.Context, _, _ string) (*model.Buyer, *model.Authentication, error) {
+						return nil, nil, errors.New("invalid credentials")
 					},
 				}
 			},
 			wantStatus: http.StatusInternalServerError,
 		},
+		{
+			name: "Suspended",
+			body: request.Login{Email: "buyer@example.com", Password: "password"},
+			mockSetup: func(r *mock.MockRegistry) {
+				r.LoginBuyerUC = &mock.MockLoginBuyerUseCase{
+					ExecuteFunc: func(_ context.Context, _, _ string) (*model.Buyer, *model.Authentication, error) {
+						return nil, nil, &domainErrors.ForbiddenError{Message: "Your account is suspended until further notice. Please contact the market office."}
+					},
+				}
+			},
+			wantStatus: http.StatusForbidden,
+		},
 	}
 
 	for _, tc := range tests {
@@ -93,6 +108,16 @@ func TestBuyerAuthHandler_Login(t *testing.T) {
 				if !found {
 					t.Error("expected buyer_session cookie")
 				}
+				if s := sessionRepo.Sessions["buyer-session-1"]; s == nil || s.UserID != 1 || s.LoginID != 12 {
+					t.Errorf("expected a session for login 12 of buyer 1, got %+v", s)
+				}
+				var resp response.Buyer
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if resp.LoginName != "佐藤" || resp.LoginRole != "staff" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
 			}
 		})
 	}
@@ -116,3 +141,158 @@ func TestBuyerAuthHandler_Logout(t *testing.T) {
 		t.Errorf("expected buyer-session-1 to be deleted, got %#v", sessionRepo.DeletedSessionIDs)
 	}
 }
+
+func TestBuyerAuthHandler_SignUp(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       any
+		execErr    error
+		wantStatus int
+	}{
+		{
+			name:       "Success",
+			body:       request.SignUp{Name: "魚一", Email: "new@example.com", Password: "Password1!", Organization: "株式会社魚一", ContactInfo: "0225-00-0000"},
+			wantStatus: http.StatusCreated,
+		},
+		{name: "InvalidJSON", body: "invalid-json", wantStatus: http.StatusBadRequest},
+		{
+			name:       "EmailInUse",
+			body:       request.SignUp{Name: "魚一", Email: "taken@example.com", Password: "Password1!"},
+			execErr:    &domainErrors.ConflictError{Message: "email is already in use"},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "ValidationError",
+			body:       request.SignUp{Name: "魚一", Email: "not-an-email"},
+			execErr:    &domainErrors.ValidationError{Field: "email", Message: "must be a valid email address"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *buyer.RegisterBuyerInput
+			mockReg := &mock.MockRegistry{
+				RegisterBuyerUC: &mock.MockRegisterBuyerUseCase{
+					ExecuteFunc: func(_ context.Context, input *buyer.RegisterBuyerInput) (*model.Buyer, error) {
+						got = input
+						if tc.execErr != nil {
+							return nil, tc.execErr
+						}
+						return &model.Buyer{ID: 7, Name: input.Name}, nil
+					},
+				},
+			}
+			h := public.NewBuyerAuthHandler(mockReg, &mock.MockSessionRepository{})
+
+			var reqBody []byte
+			if s, ok := tc.body.(string); ok {
+				reqBody = []byte(s)
+			} else {
+				reqBody, _ = json.Marshal(tc.body)
+			}
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/api/buyer/signup", bytes.NewReader(reqBody))
+			w := httptest.NewRecorder()
+
+			h.SignUp(w, req)
+
+			if w.Code != tc.wantStatus {
+				t.Fatalf("expected status %d, got %d", tc.wantStatus, w.Code)
+			}
+			if tc.wantStatus == http.StatusCreated && (got == nil || got.Email != "new@example.com" || got.Organization != "株式会社魚一") {
+				t.Errorf("unexpected input: %+v", got)
+			}
+			if len(w.Result().Cookies()) != 0 {
+				t.Error("sign-up must not start a session")
+			}
+		})
+	}
+}
+
+func TestBuyerAuthHandler_VerifySignUp(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", body: `{"token":"abc"}`, wantStatus: http.StatusOK},
+		{name: "InvalidJSON", body: "invalid-json", wantStatus: http.StatusBadRequest},
+		{
+			name:       "ExpiredToken",
+			body:       `{"token":"abc"}`,
+			execErr:    &domainErrors.UnauthorizedError{Message: "Invalid or expired token"},
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				VerifyBuyerRegistrationEmailUC: &mock.MockVerifyRegistrationEmailUseCase{
+					ExecuteFunc: func(_ context.Context, token string) error {
+						if token != "abc" {
+							t.Errorf("unexpected token %q", token)
+						}
+						return tc.execErr
+					},
+				},
+			}
+			h := public.NewBuyerAuthHandler(mockReg, &mock.MockSessionRepository{})
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/api/buyer/signup/verify", bytes.NewReader([]byte(tc.body)))
+			w := httptest.NewRecorder()
+
+			h.VerifySignUp(w, req)
+
+			if w.Code != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestBuyerAuthHandler_ResendSignUpVerification(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		execErr    error
+		wantStatus int
+	}{
+		{name: "Success", body: `{"email":"new@example.com"}`, wantStatus: http.StatusOK},
+		{name: "InvalidJSON", body: "invalid-json", wantStatus: http.StatusBadRequest},
+		{
+			// 申請の有無を漏らさないよう、該当なしでも同じ応答を返す
+			name:       "NoPendingApplication",
+			body:       `{"email":"new@example.com"}`,
+			execErr:    &domainErrors.NotFoundError{Resource: "Authentication"},
+			wantStatus: http.StatusOK,
+		},
+		{name: "InternalError", body: `{"email":"new@example.com"}`, execErr: errors.New("db down"), wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				ResendBuyerRegistrationVerificationUC: &mock.MockResendRegistrationVerificationUseCase{
+					ExecuteFunc: func(_ context.Context, email string) error {
+						if email != "new@example.com" {
+							t.Errorf("unexpected email %q", email)
+						}
+						return tc.execErr
+					},
+				},
+			}
+			h := public.NewBuyerAuthHandler(mockReg, &mock.MockSessionRepository{})
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/api/buyer/signup/resend", bytes.NewReader([]byte(tc.body)))
+			w := httptest.NewRecorder()
+
+			h.ResendSignUpVerification(w, req)
+
+			if w.Code != tc.wantStatus {
+				t.Errorf("expected status %d, got %d", tc.wantStatus, w.Code)
+			}
+		})
+	}
+}
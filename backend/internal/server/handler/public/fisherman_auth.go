@@ -0,0 +1,80 @@
+package public
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/server/handler/public/request"
+	"github.com/seka/fish-auction/backend/internal/server/handler/public/response"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
+)
+
+// FishermanAuthHandler handles public HTTP requests related to fisherman authentication.
+type FishermanAuthHandler struct {
+	loginUseCase fisherman.LoginFishermanUseCase
+	sessionRepo  repository.SessionRepository
+}
+
+// NewFishermanAuthHandler creates a new FishermanAuthHandler instance.
+func NewFishermanAuthHandler(r registry.UseCase, sessionRepo repository.SessionRepository) *FishermanAuthHandler {
+	return &FishermanAuthHandler{
+		loginUseCase: r.NewLoginFishermanUseCase(),
+		sessionRepo:  sessionRepo,
+	}
+}
+
+// Login handles the fisherman login request.
+func (h *FishermanAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var req request.Login
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	fm, err := h.loginUseCase.Execute(r.Context(), req.Email, req.Password)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+	if fm == nil {
+		util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	sessionID, err := h.sessionRepo.Create(r.Context(), fm.ID, model.SessionRoleFisherman)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	setSessionCookie(w, "fisherman_session", sessionID)
+
+	resp := response.Fisherman{ID: fm.ID, Name: fm.Name}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// Logout handles the fisherman logout request.
+func (h *FishermanAuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	if cookie, err := r.Cookie("fisherman_session"); err == nil {
+		if err := h.sessionRepo.Delete(r.Context(), cookie.Value); err != nil {
+			util.HandleError(w, err)
+			return
+		}
+	}
+
+	clearSessionCookie(w, "fisherman_session")
+
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(response.Message{Message: "Logged out"})
+}
+
+// RegisterRoutes registers the public fisherman auth handler routes to the given mux.
+func (h *FishermanAuthHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("POST /api/fisherman/login", h.Login)
+	mux.HandleFunc("POST /api/fisherman/logout", h.Logout)
+}
@@ -0,0 +1,83 @@
+package public_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/server/handler/public"
+	"github.com/seka/fish-auction/backend/internal/server/handler/public/request"
+	mock "github.com/seka/fish-auction/backend/internal/server/testing"
+)
+
+func TestFishermanAuthHandler_Login(t *testing.T) {
+	tests := []struct {
+		name         string
+		execErr      error
+		wantStatus   int
+		expectCookie bool
+	}{
+		{name: "Success", wantStatus: http.StatusOK, expectCookie: true},
+		{name: "InvalidCredentials", execErr: &domainErrors.UnauthorizedError{Message: "Invalid email or password"}, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockReg := &mock.MockRegistry{
+				LoginFishermanUC: &mock.MockLoginFishermanUseCase{
+					ExecuteFunc: func(_ context.Context, _, _ string) (*model.Fisherman, error) {
+						if tt.execErr != nil {
+							return nil, tt.execErr
+						}
+						return &model.Fisherman{ID: 3, Name: "Fisherman 3"}, nil
+					},
+				},
+			}
+			sessionRepo := &mock.MockSessionRepository{NextSessionID: "fisherman-session-1"}
+			h := public.NewFishermanAuthHandler(mockReg, sessionRepo)
+
+			reqBody, _ := json.Marshal(request.Login{Email: "f3@example.com", Password: "password"})
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/api/fisherman/login", bytes.NewReader(reqBody))
+			w := httptest.NewRecorder()
+
+			h.Login(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			found := false
+			for _, c := range w.Result().Cookies() {
+				if c.Name == "fisherman_session" && c.Value == "fisherman-session-1" {
+					found = true
+				}
+			}
+			if found != tt.expectCookie {
+				t.Errorf("fisherman_session cookie set = %v, want %v", found, tt.expectCookie)
+			}
+		})
+	}
+}
+
+func TestFishermanAuthHandler_Logout(t *testing.T) {
+	sessionRepo := &mock.MockSessionRepository{
+		Sessions: map[string]*model.Session{
+			"fisherman-session-1": {ID: "fisherman-session-1", UserID: 3, Role: model.SessionRoleFisherman},
+		},
+	}
+	h := public.NewFishermanAuthHandler(&mock.MockRegistry{}, sessionRepo)
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "/api/fisherman/logout", nil)
+	req.AddCookie(&http.Cookie{Name: "fisherman_session", Value: "fisherman-session-1", Secure: true, HttpOnly: true, SameSite: http.SameSiteStrictMode})
+	w := httptest.NewRecorder()
+	h.Logout(w, req)
+	if w.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", w.Code)
+	}
+	if len(sessionRepo.DeletedSessionIDs) != 1 || sessionRepo.DeletedSessionIDs[0] != "fisherman-session-1" {
+		t.Errorf("expected fisherman-session-1 to be deleted, got %#v", sessionRepo.DeletedSessionIDs)
+	}
+}
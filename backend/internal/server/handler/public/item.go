@@ -1,28 +1,37 @@
 package public
 
 import (
+	"context"
+	"errors"
 	"net/http"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/registry"
 	"github.com/seka/fish-auction/backend/internal/server/handler/public/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 	"github.com/seka/fish-auction/backend/internal/usecase/item"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
 )
 
 // ItemHandler handles public HTTP requests related to items.
 type ItemHandler struct {
-	listUseCase item.ListItemsUseCase
+	listUseCase      item.ListItemsUseCase
+	authorizeUseCase registration.AuthorizeAuctionViewUseCase
 }
 
 // NewItemHandler creates a new ItemHandler instance.
 func NewItemHandler(r registry.UseCase) *ItemHandler {
 	return &ItemHandler{
-		listUseCase: r.NewListItemsUseCase(),
+		listUseCase:      r.NewListItemsUseCase(),
+		authorizeUseCase: r.NewAuthorizeAuctionViewUseCase(),
 	}
 }
 
 // List handles the request to list items.
+// When VENUE_REGISTRATION_REQUIRED_TO_VIEW is on, lots of auctions the buyer may not view are left out,
+// so the server registers the route behind the optional buyer auth middleware itself.
 func (h *ItemHandler) List(w http.ResponseWriter, r *http.Request) {
 	items, err := h.listUseCase.Execute(r.Context())
 	if err != nil {
@@ -30,35 +39,65 @@ func (h *ItemHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp := make([]response.Item, len(items))
+	buyerID, _ := middleware.BuyerIDFromContext(r.Context())
+	visible, err := h.visibleAuctions(r.Context(), buyerID, items)
+	if err != nil {
+		util.HandleError(w, err)
+		return
+	}
+
+	resp := make([]response.Item, 0, len(items))
 	for i := range items {
 		it := items[i]
-		resp[i] = h.toResponse(&it)
+		if !visible[it.AuctionID] {
+			continue
+		}
+		resp = append(resp, h.toResponse(&it, buyerID))
 	}
 
 	util.WriteJSON(w, http.StatusOK, resp)
 }
 
-func (h *ItemHandler) toResponse(it *model.AuctionItem) response.Item {
+// visibleAuctions checks each auction of the items once and returns those the buyer may view.
+// 閲覧に登録が要らない設定では、すべてのせりが見える。登録が要る設定で未ログインなら Unauthorized を返す。
+func (h *ItemHandler) visibleAuctions(ctx context.Context, buyerID int, items []model.AuctionItem) (map[int]bool, error) {
+	visible := make(map[int]bool)
+	checked := make(map[int]bool)
+	for _, it := range items {
+		if checked[it.AuctionID] {
+			continue
+		}
+		checked[it.AuctionID] = true
+		err := h.authorizeUseCase.Execute(ctx, buyerID, it.AuctionID)
+		var forbiddenErr *domainErrors.ForbiddenError
+		switch {
+		case err == nil:
+			visible[it.AuctionID] = true
+		case errors.As(err, &forbiddenErr):
+		default:
+			return nil, err
+		}
+	}
+	return visible, nil
+}
+
+// toResponse converts the item for the viewing buyer, who sees their own leading bid as "you".
+func (h *ItemHandler) toResponse(it *model.AuctionItem, viewerID int) response.Item {
 	var highestBid *int
 	if it.HighestBid != nil {
 		amt := it.HighestBid.Amount()
 		highestBid = &amt
 	}
 	return response.Item{
-		ID:          it.ID,
-		AuctionID:   it.AuctionID,
-		FishermanID: it.FishermanID,
-		FishType:    it.FishType,
-		Quantity:    it.Quantity,
-		Unit:        it.Unit,
-		HighestBid:  highestBid,
-		SortOrder:   it.SortOrder,
-		CreatedAt:   it.CreatedAt,
+		ID:                  it.ID,
+		AuctionID:           it.AuctionID,
+		FishermanID:         it.FishermanID,
+		FishType:            it.FishType,
+		Quantity:            it.Quantity,
+		Unit:                it.Unit,
+		HighestBid:          highestBid,
+		HighestBidderPaddle: it.HighestBidderFor(viewerID),
+		SortOrder:           it.SortOrder,
+		CreatedAt:           it.CreatedAt,
 	}
 }
-
-// RegisterRoutes registers the public item handler routes to the given mux.
-func (h *ItemHandler) RegisterRoutes(mux *http.ServeMux) {
-	mux.HandleFunc("GET /api/items", h.List)
-}
@@ -2,13 +2,18 @@ package public_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/server/handler/public"
+	"github.com/seka/fish-auction/backend/internal/server/handler/public/response"
+	"github.com/seka/fish-auction/backend/internal/server/middleware"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
 )
 
@@ -17,6 +22,7 @@ func TestPublicItemHandler_List(t *testing.T) {
 		name       string
 		mockSetup  func(*mock.MockRegistry)
 		wantStatus int
+		wantItems  []int
 	}
 
 	tests := []testCase{
@@ -31,6 +37,42 @@ func TestPublicItemHandler_List(t *testing.T) {
 			},
 			wantStatus: http.StatusOK,
 		},
+		{
+			name: "HidesAuctionsNotRegisteredAtVenue",
+			mockSetup: func(r *mock.MockRegistry) {
+				r.ListItemsUC = &mock.MockListItemsUseCase{
+					ExecuteFunc: func(_ context.Context) ([]model.AuctionItem, error) {
+						return []model.AuctionItem{{ID: 1, AuctionID: 1}, {ID: 2, AuctionID: 2}, {ID: 3, AuctionID: 1}}, nil
+					},
+				}
+				r.AuthorizeAuctionViewUC = &mock.MockAuthorizeAuctionViewUseCase{
+					ExecuteFunc: func(_ context.Context, _, auctionID int) error {
+						if auctionID == 2 {
+							return &domainErrors.ForbiddenError{Message: "not registered at this venue"}
+						}
+						return nil
+					},
+				}
+			},
+			wantStatus: http.StatusOK,
+			wantItems:  []int{1, 3},
+		},
+		{
+			name: "AnonymousWhenRegistrationRequired",
+			mockSetup: func(r *mock.MockRegistry) {
+				r.ListItemsUC = &mock.MockListItemsUseCase{
+					ExecuteFunc: func(_ context.Context) ([]model.AuctionItem, error) {
+						return []model.AuctionItem{{ID: 1, AuctionID: 1}}, nil
+					},
+				}
+				r.AuthorizeAuctionViewUC = &mock.MockAuthorizeAuctionViewUseCase{
+					ExecuteFunc: func(_ context.Context, _, _ int) error {
+						return &domainErrors.UnauthorizedError{Message: "sign in"}
+					},
+				}
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
 		{
 			name: "UseCaseError",
 			mockSetup: func(r *mock.MockRegistry) {
@@ -46,7 +88,7 @@ func TestPublicItemHandler_List(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			mockReg := &mock.MockRegistry{}
+			mockReg := &mock.MockRegistry{AuthorizeAuctionViewUC: &mock.MockAuthorizeAuctionViewUseCase{}}
 			tc.mockSetup(mockReg)
 			h := public.NewItemHandler(mockReg)
 
@@ -58,6 +100,55 @@ func TestPublicItemHandler_List(t *testing.T) {
 			if w.Code != tc.wantStatus {
 				t.Errorf("expected status %d, got %d", tc.wantStatus, w.Code)
 			}
+			if tc.wantItems != nil {
+				var resp []response.Item
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				var got []int
+				for _, it := range resp {
+					got = append(got, it.ID)
+				}
+				if !slices.Equal(got, tc.wantItems) {
+					t.Errorf("expected items %v, got %v", tc.wantItems, got)
+				}
+			}
 		})
 	}
 }
+
+func TestPublicItemHandler_List_ViewerLeads(t *testing.T) {
+	viewer, other := 7, 8
+	viewerPaddle, otherPaddle := "128", "129"
+	mockReg := &mock.MockRegistry{
+		AuthorizeAuctionViewUC: &mock.MockAuthorizeAuctionViewUseCase{},
+		ListItemsUC: &mock.MockListItemsUseCase{
+			ExecuteFunc: func(_ context.Context) ([]model.AuctionItem, error) {
+				return []model.AuctionItem{
+					{ID: 1, HighestBidderID: &viewer, HighestBidderPaddle: &viewerPaddle},
+					{ID: 2, HighestBidderID: &other, HighestBidderPaddle: &otherPaddle},
+				}, nil
+			},
+		},
+	}
+	h := public.NewItemHandler(mockReg)
+
+	req := httptest.NewRequestWithContext(middleware.WithBuyerID(context.Background(), viewer), http.MethodGet, "/api/items", nil)
+	w := httptest.NewRecorder()
+
+	h.List(w, req)
+
+	var resp []response.Item
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp) != 2 || resp[0].HighestBidderPaddle == nil || resp[1].HighestBidderPaddle == nil {
+		t.Fatalf("unexpected items: %+v", resp)
+	}
+	if *resp[0].HighestBidderPaddle != model.BidderYou {
+		t.Errorf("expected the viewer's own lead to show %q, got %q", model.BidderYou, *resp[0].HighestBidderPaddle)
+	}
+	if *resp[1].HighestBidderPaddle != otherPaddle {
+		t.Errorf("expected another buyer's paddle %q, got %q", otherPaddle, *resp[1].HighestBidderPaddle)
+	}
+}
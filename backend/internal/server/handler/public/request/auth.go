@@ -6,6 +6,37 @@ type Login struct {
 	Password string `json:"password"`
 }
 
+// AdminLogin holds admin login request data.
+// Code is the TOTP or recovery code, sent on the second attempt when two-factor authentication is enabled.
+type AdminLogin struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Code     string `json:"code,omitempty"`
+}
+
+// SignUp holds the public buyer sign-up form.
+type SignUp struct {
+	Name                      string `json:"name"`
+	Email                     string `json:"email"`
+	Password                  string `json:"password"`
+	Organization              string `json:"organization"`
+	ContactInfo               string `json:"contact_info"`
+	BusinessName              string `json:"business_name"`
+	InvoiceRegistrationNumber string `json:"invoice_registration_number"`
+	Address                   string `json:"address"`
+	Phone                     string `json:"phone"`
+}
+
+// VerifySignUp holds the token from the sign-up verification link.
+type VerifySignUp struct {
+	Token string `json:"token"`
+}
+
+// ResendSignUpVerification holds the email of an applicant asking for a new verification link.
+type ResendSignUpVerification struct {
+	Email string `json:"email"`
+}
+
 // ResetPassword holds data for requesting a password reset.
 type ResetPassword struct {
 	Email string `json:"email"`
@@ -5,8 +5,23 @@ type Login struct {
 	Success bool `json:"success"`
 }
 
+// TwoFactorRequired tells the client to ask for a two-factor code and log in again with it.
+type TwoFactorRequired struct {
+	Message           string `json:"message"`
+	TwoFactorRequired bool   `json:"two_factor_required"`
+}
+
 // Buyer represents the buyer's basic session info for public auth endpoints.
 type Buyer struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	LoginID   int    `json:"login_id,omitempty"`
+	LoginName string `json:"login_name,omitempty"`
+	LoginRole string `json:"login_role,omitempty"`
+}
+
+// Fisherman represents the fisherman's basic session info for public auth endpoints.
+type Fisherman struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
@@ -4,13 +4,15 @@ import "time"
 
 // Item represents a public view of an auction item.
 type Item struct {
-	ID          int       `json:"id"`
-	AuctionID   int       `json:"auction_id"`
-	FishermanID int       `json:"fisherman_id"`
-	FishType    string    `json:"fish_type"`
-	Quantity    int       `json:"quantity"`
-	Unit        string    `json:"unit"`
-	HighestBid  *int      `json:"highest_bid,omitempty"`
-	SortOrder   int       `json:"sort_order"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID          int    `json:"id"`
+	AuctionID   int    `json:"auction_id"`
+	FishermanID int    `json:"fisherman_id"`
+	FishType    string `json:"fish_type"`
+	Quantity    int    `json:"quantity"`
+	Unit        string `json:"unit"`
+	HighestBid  *int   `json:"highest_bid,omitempty"`
+	// HighestBidderPaddle is the paddle number of the current high bidder; names and IDs are never public.
+	HighestBidderPaddle *string   `json:"highest_bidder_paddle,omitempty"`
+	SortOrder           int       `json:"sort_order"`
+	CreatedAt           time.Time `json:"created_at"`
 }
@@ -42,7 +42,8 @@ func (m *AdminAuthMiddleware) Handle(next http.Handler) http.Handler {
 			util.WriteError(w, http.StatusInternalServerError, "Internal Server Error")
 			return
 		}
-		if session == nil || session.Role != model.SessionRoleAdmin {
+		// 権限導入前のセッションはロールを持たないため、再ログインさせる。
+		if session == nil || session.Role != model.SessionRoleAdmin || !session.AdminRole.IsValid() {
 			slog.Warn("auth: admin session invalid",
 				"remote_addr", r.RemoteAddr,
 				"has_session", session != nil,
@@ -52,7 +53,42 @@ func (m *AdminAuthMiddleware) Handle(next http.Handler) http.Handler {
 			return
 		}
 
+		m.touch(r, session.ID)
 		ctx := WithAdminID(r.Context(), session.UserID)
+		ctx = WithAdminRole(ctx, session.AdminRole)
+		ctx = WithSessionID(ctx, session.ID)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// touch records the client of the session for the session list.
+// 記録に失敗してもリクエストは通す。
+func (m *AdminAuthMiddleware) touch(r *http.Request, sessionID string) {
+	if err := m.sessionRepo.Touch(r.Context(), sessionID, extractIP(r.RemoteAddr), r.UserAgent()); err != nil {
+		slog.Warn("auth: admin session touch failed",
+			"err", err,
+			"request_id", RequestIDFromContext(r.Context()),
+		)
+	}
+}
+
+// RequireAdminPermission wraps an admin handler so that only roles granted perm may call it.
+// It must run behind AdminAuthMiddleware, which puts the session's role in the context.
+func RequireAdminPermission(perm model.AdminPermission, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		role, _ := AdminRoleFromContext(r.Context())
+		if err := role.CheckPermission(perm); err != nil {
+			adminID, _ := AdminIDFromContext(r.Context())
+			slog.Warn("auth: admin permission denied",
+				"admin_id", adminID,
+				"role", role,
+				"permission", perm,
+				"path", r.URL.Path,
+				"request_id", RequestIDFromContext(r.Context()),
+			)
+			util.HandleError(w, err)
+			return
+		}
+		next(w, r)
+	}
+}
@@ -1,58 +1,159 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 	"net/http"
+	"time"
 
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/server/util"
 )
 
 // BuyerAuthMiddleware provides BuyerAuthMiddleware related functionality.
+// Besides the session it checks the buyer's login suspensions, so a suspension that starts later
+// also ends the sessions created before it.
 type BuyerAuthMiddleware struct {
-	sessionRepo repository.SessionRepository
+	sessionRepo    repository.SessionRepository
+	suspensionRepo repository.BuyerSuspensionRepository
+	now            func() time.Time
 }
 
 // NewBuyerAuthMiddleware creates a new BuyerAuthMiddleware instance.
-func NewBuyerAuthMiddleware(sessionRepo repository.SessionRepository) *BuyerAuthMiddleware {
-	return &BuyerAuthMiddleware{sessionRepo: sessionRepo}
+func NewBuyerAuthMiddleware(sessionRepo repository.SessionRepository, suspensionRepo repository.BuyerSuspensionRepository) *BuyerAuthMiddleware {
+	return &BuyerAuthMiddleware{
+		sessionRepo:    sessionRepo,
+		suspensionRepo: suspensionRepo,
+		now:            time.Now,
+	}
 }
 
 // Handle provides Handle related functionality.
 func (m *BuyerAuthMiddleware) Handle(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		cookie, err := r.Cookie("buyer_session")
-		if err != nil || cookie.Value == "" {
-			slog.Warn("auth: buyer cookie missing",
-				"remote_addr", r.RemoteAddr,
-				"path", r.URL.Path,
-				"request_id", RequestIDFromContext(r.Context()),
-			)
+		session, err := m.authenticate(r)
+		var forbiddenErr *domainErrors.ForbiddenError
+		if errors.As(err, &forbiddenErr) {
+			util.HandleError(w, err)
+			return
+		}
+		if err != nil {
+			util.WriteError(w, http.StatusInternalServerError, "Internal Server Error")
+			return
+		}
+		if session == nil {
 			util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
 			return
 		}
+		next.ServeHTTP(w, r.WithContext(m.withSession(r, session)))
+	})
+}
 
-		session, err := m.sessionRepo.FindByID(r.Context(), cookie.Value)
-		if err != nil {
-			slog.Error("auth: buyer session lookup failed",
+// Optional identifies the buyer when the request carries a valid session and lets anonymous requests through.
+// 公開の一覧でも、会場の登録を閲覧に求める設定のときは誰が見ているかで絞り込むために使う。
+func (m *BuyerAuthMiddleware) Optional(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("buyer_session"); err != nil || cookie.Value == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		session, err := m.authenticate(r)
+		var forbiddenErr *domainErrors.ForbiddenError
+		if err != nil && !errors.As(err, &forbiddenErr) {
+			util.WriteError(w, http.StatusInternalServerError, "Internal Server Error")
+			return
+		}
+		if session == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(m.withSession(r, session)))
+	})
+}
+
+// authenticate returns the buyer session of the request, or nil when there is no valid one.
+func (m *BuyerAuthMiddleware) authenticate(r *http.Request) (*model.Session, error) {
+	cookie, err := r.Cookie("buyer_session")
+	if err != nil || cookie.Value == "" {
+		slog.Warn("auth: buyer cookie missing",
+			"remote_addr", r.RemoteAddr,
+			"path", r.URL.Path,
+			"request_id", RequestIDFromContext(r.Context()),
+		)
+		return nil, nil
+	}
+
+	session, err := m.sessionRepo.FindByID(r.Context(), cookie.Value)
+	if err != nil {
+		slog.Error("auth: buyer session lookup failed",
+			"err", err,
+			"request_id", RequestIDFromContext(r.Context()),
+		)
+		return nil, err
+	}
+	// 組織アカウント導入前のセッションはどのログインか分からないため、再ログインさせる。
+	if session == nil || session.Role != model.SessionRoleBuyer || session.LoginID == 0 {
+		slog.Warn("auth: buyer session invalid",
+			"remote_addr", r.RemoteAddr,
+			"has_session", session != nil,
+			"request_id", RequestIDFromContext(r.Context()),
+		)
+		return nil, nil
+	}
+
+	if err := m.checkSuspensions(r.Context(), session.UserID); err != nil {
+		var forbiddenErr *domainErrors.ForbiddenError
+		if !errors.As(err, &forbiddenErr) {
+			slog.Error("auth: buyer suspension lookup failed",
 				"err", err,
 				"request_id", RequestIDFromContext(r.Context()),
 			)
-			util.WriteError(w, http.StatusInternalServerError, "Internal Server Error")
-			return
+			return nil, err
 		}
-		if session == nil || session.Role != model.SessionRoleBuyer {
-			slog.Warn("auth: buyer session invalid",
-				"remote_addr", r.RemoteAddr,
-				"has_session", session != nil,
+		// 開始日時が先のログイン停止は登録時にセッションを失効できないため、停止が始まった時点でここで失効させる。
+		slog.Warn("auth: buyer suspended",
+			"buyer_id", session.UserID,
+			"request_id", RequestIDFromContext(r.Context()),
+		)
+		if delErr := m.sessionRepo.DeleteAllByUserID(r.Context(), session.UserID, model.SessionRoleBuyer); delErr != nil {
+			slog.Warn("auth: failed to invalidate suspended buyer sessions",
+				"err", delErr,
 				"request_id", RequestIDFromContext(r.Context()),
 			)
-			util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
-			return
 		}
+		return nil, err
+	}
+	return session, nil
+}
 
-		ctx := WithBuyerID(r.Context(), session.UserID)
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+// checkSuspensions returns a forbidden error while a login suspension of the buyer is in force.
+// 解除がすぐ反映されるよう、停止はリクエストごとに読み直す。
+func (m *BuyerAuthMiddleware) checkSuspensions(ctx context.Context, buyerID int) error {
+	list, err := m.suspensionRepo.List(ctx, &repository.BuyerSuspensionFilters{BuyerID: &buyerID})
+	if err != nil {
+		return err
+	}
+	return model.CheckSuspensions(list, model.SuspensionSeverityLogin, m.now())
+}
+
+// withSession records the session's client and returns the request context carrying the buyer.
+func (m *BuyerAuthMiddleware) withSession(r *http.Request, session *model.Session) context.Context {
+	m.touch(r, session.ID)
+	ctx := WithBuyerID(r.Context(), session.UserID)
+	ctx = WithBuyerLoginID(ctx, session.LoginID)
+	return WithSessionID(ctx, session.ID)
+}
+
+// touch records the client of the session for the session list.
+// 記録に失敗してもリクエストは通す。
+func (m *BuyerAuthMiddleware) touch(r *http.Request, sessionID string) {
+	if err := m.sessionRepo.Touch(r.Context(), sessionID, extractIP(r.RemoteAddr), r.UserAgent()); err != nil {
+		slog.Warn("auth: buyer session touch failed",
+			"err", err,
+			"request_id", RequestIDFromContext(r.Context()),
+		)
+	}
 }
@@ -1,14 +1,26 @@
 package middleware
 
-import "context"
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
 
 type contextKey string
 
 const (
 	// AdminIDKey provides AdminIDKey related functionality.
 	AdminIDKey contextKey = "admin_id"
+	// AdminRoleKey is the key of the role of the admin session.
+	AdminRoleKey contextKey = "admin_role"
 	// BuyerIDKey provides BuyerIDKey related functionality.
 	BuyerIDKey contextKey = "buyer_id"
+	// BuyerLoginIDKey is the key of the individual login within the buyer organization.
+	BuyerLoginIDKey contextKey = "buyer_login_id"
+	// FishermanIDKey provides FishermanIDKey related functionality.
+	FishermanIDKey contextKey = "fisherman_id"
+	// SessionIDKey is the key of the session the request was authenticated with.
+	SessionIDKey contextKey = "session_id"
 )
 
 // AdminIDFromContext provides AdminIDFromContext related functionality.
@@ -17,18 +29,62 @@ func AdminIDFromContext(ctx context.Context) (int, bool) {
 	return adminID, ok
 }
 
+// AdminRoleFromContext returns the role of the admin session.
+func AdminRoleFromContext(ctx context.Context) (model.AdminRole, bool) {
+	role, ok := ctx.Value(AdminRoleKey).(model.AdminRole)
+	return role, ok
+}
+
 // BuyerIDFromContext provides BuyerIDFromContext related functionality.
 func BuyerIDFromContext(ctx context.Context) (int, bool) {
 	buyerID, ok := ctx.Value(BuyerIDKey).(int)
 	return buyerID, ok
 }
 
+// BuyerLoginIDFromContext returns the individual login of the buyer session.
+func BuyerLoginIDFromContext(ctx context.Context) (int, bool) {
+	loginID, ok := ctx.Value(BuyerLoginIDKey).(int)
+	return loginID, ok
+}
+
+// FishermanIDFromContext provides FishermanIDFromContext related functionality.
+func FishermanIDFromContext(ctx context.Context) (int, bool) {
+	fishermanID, ok := ctx.Value(FishermanIDKey).(int)
+	return fishermanID, ok
+}
+
+// SessionIDFromContext returns the session the request was authenticated with.
+func SessionIDFromContext(ctx context.Context) (string, bool) {
+	sessionID, ok := ctx.Value(SessionIDKey).(string)
+	return sessionID, ok
+}
+
 // WithAdminID returns a new context with the given admin ID.
 func WithAdminID(ctx context.Context, id int) context.Context {
 	return context.WithValue(ctx, AdminIDKey, id)
 }
 
+// WithAdminRole returns a new context with the given admin role.
+func WithAdminRole(ctx context.Context, role model.AdminRole) context.Context {
+	return context.WithValue(ctx, AdminRoleKey, role)
+}
+
 // WithBuyerID returns a new context with the given buyer ID.
 func WithBuyerID(ctx context.Context, id int) context.Context {
 	return context.WithValue(ctx, BuyerIDKey, id)
 }
+
+// WithBuyerLoginID returns a new context with the given buyer login ID.
+func WithBuyerLoginID(ctx context.Context, id int) context.Context {
+	return context.WithValue(ctx, BuyerLoginIDKey, id)
+}
+
+// WithFishermanID returns a new context with the given fisherman ID.
+func WithFishermanID(ctx context.Context, id int) context.Context {
+	return context.WithValue(ctx, FishermanIDKey, id)
+}
+
+// WithSessionID returns a new context with the given session ID.
+func WithSessionID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, SessionIDKey, id)
+}
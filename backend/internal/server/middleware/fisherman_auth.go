@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/server/util"
+)
+
+// FishermanAuthMiddleware provides FishermanAuthMiddleware related functionality.
+type FishermanAuthMiddleware struct {
+	sessionRepo repository.SessionRepository
+}
+
+// NewFishermanAuthMiddleware creates a new FishermanAuthMiddleware instance.
+func NewFishermanAuthMiddleware(sessionRepo repository.SessionRepository) *FishermanAuthMiddleware {
+	return &FishermanAuthMiddleware{sessionRepo: sessionRepo}
+}
+
+// Handle provides Handle related functionality.
+func (m *FishermanAuthMiddleware) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("fisherman_session")
+		if err != nil || cookie.Value == "" {
+			slog.Warn("auth: fisherman cookie missing",
+				"remote_addr", r.RemoteAddr,
+				"path", r.URL.Path,
+				"request_id", RequestIDFromContext(r.Context()),
+			)
+			util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+
+		session, err := m.sessionRepo.FindByID(r.Context(), cookie.Value)
+		if err != nil {
+			slog.Error("auth: fisherman session lookup failed",
+				"err", err,
+				"request_id", RequestIDFromContext(r.Context()),
+			)
+			util.WriteError(w, http.StatusInternalServerError, "Internal Server Error")
+			return
+		}
+		if session == nil || session.Role != model.SessionRoleFisherman {
+			slog.Warn("auth: fisherman session invalid",
+				"remote_addr", r.RemoteAddr,
+				"has_session", session != nil,
+				"request_id", RequestIDFromContext(r.Context()),
+			)
+			util.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+			return
+		}
+
+		ctx := WithFishermanID(r.Context(), session.UserID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
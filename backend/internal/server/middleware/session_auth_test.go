@@ -5,15 +5,17 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
 )
 
 func TestAdminAuthMiddleware_Success(t *testing.T) {
 	sessionRepo := &mock.MockSessionRepository{
 		Sessions: map[string]*model.Session{
-			"admin-session-1": {ID: "admin-session-1", UserID: 1, Role: model.SessionRoleAdmin},
+			"admin-session-1": {ID: "admin-session-1", UserID: 1, Role: model.SessionRoleAdmin, AdminRole: model.AdminRoleClerk},
 		},
 	}
 	mw := NewAdminAuthMiddleware(sessionRepo)
@@ -23,6 +25,10 @@ func TestAdminAuthMiddleware_Success(t *testing.T) {
 		if !ok || adminID != 1 {
 			t.Fatalf("expected admin id 1 in context, got %v %v", adminID, ok)
 		}
+		role, ok := AdminRoleFromContext(r.Context())
+		if !ok || role != model.AdminRoleClerk {
+			t.Fatalf("expected clerk role in context, got %v %v", role, ok)
+		}
 		w.WriteHeader(http.StatusOK)
 	})
 
@@ -56,23 +62,93 @@ func TestAdminAuthMiddleware_RoleMismatch(t *testing.T) {
 	}
 }
 
+func TestAdminAuthMiddleware_SessionWithoutRole(t *testing.T) {
+	sessionRepo := &mock.MockSessionRepository{
+		Sessions: map[string]*model.Session{
+			"admin-session-1": {ID: "admin-session-1", UserID: 1, Role: model.SessionRoleAdmin},
+		},
+	}
+	mw := NewAdminAuthMiddleware(sessionRepo)
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/api/admin/fishermen", nil)
+	req.AddCookie(&http.Cookie{Name: "admin_session", Value: "admin-session-1", Secure: true, HttpOnly: true, SameSite: http.SameSiteStrictMode})
+	w := httptest.NewRecorder()
+
+	mw.Handle(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {})).ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", w.Code)
+	}
+}
+
+func TestRequireAdminPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       model.AdminRole
+		perm       model.AdminPermission
+		wantStatus int
+	}{
+		{name: "ClerkRunsAuctions", role: model.AdminRoleClerk, perm: model.AdminPermissionManageOperations, wantStatus: http.StatusOK},
+		{name: "ClerkCannotIssueInvoices", role: model.AdminRoleClerk, perm: model.AdminPermissionManageAccounting, wantStatus: http.StatusForbidden},
+		{name: "ReadOnlyCannotChange", role: model.AdminRoleReadOnly, perm: model.AdminPermissionManageOperations, wantStatus: http.StatusForbidden},
+		{name: "SuperAdminManagesAdmins", role: model.AdminRoleSuperAdmin, perm: model.AdminPermissionManageAdmins, wantStatus: http.StatusOK},
+		{name: "NoRole", perm: model.AdminPermissionViewOperations, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			h := RequireAdminPermission(tt.perm, func(w http.ResponseWriter, _ *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusOK)
+			})
+
+			ctx := context.Background()
+			if tt.role != "" {
+				ctx = WithAdminRole(ctx, tt.role)
+			}
+			req := httptest.NewRequestWithContext(ctx, http.MethodPost, "/invoices/1/issue", nil)
+			w := httptest.NewRecorder()
+
+			h(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if called != (tt.wantStatus == http.StatusOK) {
+				t.Errorf("handler called = %v", called)
+			}
+		})
+	}
+}
+
 func TestBuyerAuthMiddleware_Success(t *testing.T) {
 	sessionRepo := &mock.MockSessionRepository{
 		Sessions: map[string]*model.Session{
-			"buyer-session-1": {ID: "buyer-session-1", UserID: 7, Role: model.SessionRoleBuyer},
+			"buyer-session-1": {ID: "buyer-session-1", UserID: 7, LoginID: 12, Role: model.SessionRoleBuyer},
 		},
 	}
-	mw := NewBuyerAuthMiddleware(sessionRepo)
+	mw := NewBuyerAuthMiddleware(sessionRepo, &mock.MockBuyerSuspensionRepository{})
 
 	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		buyerID, ok := BuyerIDFromContext(r.Context())
 		if !ok || buyerID != 7 {
 			t.Fatalf("expected buyer id 7 in context, got %v %v", buyerID, ok)
 		}
+		loginID, ok := BuyerLoginIDFromContext(r.Context())
+		if !ok || loginID != 12 {
+			t.Fatalf("expected login id 12 in context, got %v %v", loginID, ok)
+		}
+		sessionID, ok := SessionIDFromContext(r.Context())
+		if !ok || sessionID != "buyer-session-1" {
+			t.Fatalf("expected session id in context, got %v %v", sessionID, ok)
+		}
 		w.WriteHeader(http.StatusOK)
 	})
 
 	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/api/buyer/me", nil)
+	req.RemoteAddr = "203.0.113.5:51234"
+	req.Header.Set("User-Agent", "Mozilla/5.0 (iPhone)")
 	req.AddCookie(&http.Cookie{Name: "buyer_session", Value: "buyer-session-1", Secure: true, HttpOnly: true, SameSite: http.SameSiteStrictMode})
 	w := httptest.NewRecorder()
 
@@ -81,4 +157,161 @@ func TestBuyerAuthMiddleware_Success(t *testing.T) {
 	if w.Code != http.StatusOK {
 		t.Fatalf("expected status 200, got %d", w.Code)
 	}
+	if s := sessionRepo.Sessions["buyer-session-1"]; s.IPAddress != "203.0.113.5" || s.UserAgent != "Mozilla/5.0 (iPhone)" {
+		t.Errorf("expected the client to be recorded on the session, got %q %q", s.IPAddress, s.UserAgent)
+	}
+}
+
+func TestBuyerAuthMiddleware_SessionWithoutLogin(t *testing.T) {
+	sessionRepo := &mock.MockSessionRepository{
+		Sessions: map[string]*model.Session{
+			"buyer-session-1": {ID: "buyer-session-1", UserID: 7, Role: model.SessionRoleBuyer},
+		},
+	}
+	mw := NewBuyerAuthMiddleware(sessionRepo, &mock.MockBuyerSuspensionRepository{})
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/api/buyer/me", nil)
+	req.AddCookie(&http.Cookie{Name: "buyer_session", Value: "buyer-session-1", Secure: true, HttpOnly: true, SameSite: http.SameSiteStrictMode})
+	w := httptest.NewRecorder()
+
+	mw.Handle(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {})).ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", w.Code)
+	}
+}
+
+func TestBuyerAuthMiddleware_FutureLoginSuspension(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	startsAt := now.Add(time.Hour)
+	sessionRepo := &mock.MockSessionRepository{
+		Sessions: map[string]*model.Session{
+			"buyer-session-1": {ID: "buyer-session-1", UserID: 7, LoginID: 12, Role: model.SessionRoleBuyer},
+			"buyer-session-2": {ID: "buyer-session-2", UserID: 7, LoginID: 13, Role: model.SessionRoleBuyer},
+		},
+	}
+	suspensionRepo := &mock.MockBuyerSuspensionRepository{
+		ListFunc: func(_ context.Context, filters *repository.BuyerSuspensionFilters) ([]model.BuyerSuspension, error) {
+			if filters == nil || filters.BuyerID == nil || *filters.BuyerID != 7 {
+				t.Errorf("expected the suspensions of buyer 7 to be listed, got %+v", filters)
+			}
+			return []model.BuyerSuspension{
+				{ID: 1, BuyerID: 7, Severity: model.SuspensionSeverityBidding, StartsAt: now.Add(-time.Hour)},
+				{ID: 2, BuyerID: 7, Severity: model.SuspensionSeverityLogin, StartsAt: startsAt},
+			}, nil
+		},
+	}
+	mw := NewBuyerAuthMiddleware(sessionRepo, suspensionRepo)
+	mw.now = func() time.Time { return now }
+
+	serve := func() int {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/api/buyer/me", nil)
+		req.AddCookie(&http.Cookie{Name: "buyer_session", Value: "buyer-session-1"})
+		w := httptest.NewRecorder()
+		mw.Handle(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })).ServeHTTP(w, req)
+		return w.Code
+	}
+
+	if code := serve(); code != http.StatusOK {
+		t.Fatalf("expected the session to work before the suspension starts, got %d", code)
+	}
+	now = startsAt
+	if code := serve(); code != http.StatusForbidden {
+		t.Fatalf("expected status 403 once the suspension starts, got %d", code)
+	}
+	if len(sessionRepo.Sessions) != 0 {
+		t.Errorf("expected every session of the buyer to be invalidated, got %v", sessionRepo.Sessions)
+	}
+	if code := serve(); code != http.StatusUnauthorized {
+		t.Errorf("expected status 401 after the sessions were invalidated, got %d", code)
+	}
+}
+
+func TestBuyerAuthMiddleware_Optional(t *testing.T) {
+	sessionRepo := &mock.MockSessionRepository{
+		Sessions: map[string]*model.Session{
+			"buyer-session-1": {ID: "buyer-session-1", UserID: 7, LoginID: 12, Role: model.SessionRoleBuyer},
+		},
+	}
+	mw := NewBuyerAuthMiddleware(sessionRepo, &mock.MockBuyerSuspensionRepository{})
+
+	tests := []struct {
+		name      string
+		cookie    string
+		wantBuyer int
+	}{
+		{name: "Anonymous"},
+		{name: "UnknownSession", cookie: "expired"},
+		{name: "SignedIn", cookie: "buyer-session-1", wantBuyer: 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBuyer int
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotBuyer, _ = BuyerIDFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/api/items", nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "buyer_session", Value: tt.cookie})
+			}
+			w := httptest.NewRecorder()
+
+			mw.Optional(next).ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d", w.Code)
+			}
+			if gotBuyer != tt.wantBuyer {
+				t.Errorf("expected buyer %d in context, got %d", tt.wantBuyer, gotBuyer)
+			}
+		})
+	}
+}
+
+func TestFishermanAuthMiddleware_Success(t *testing.T) {
+	sessionRepo := &mock.MockSessionRepository{
+		Sessions: map[string]*model.Session{
+			"fisherman-session-1": {ID: "fisherman-session-1", UserID: 3, Role: model.SessionRoleFisherman},
+		},
+	}
+	mw := NewFishermanAuthMiddleware(sessionRepo)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fishermanID, ok := FishermanIDFromContext(r.Context())
+		if !ok || fishermanID != 3 {
+			t.Fatalf("expected fisherman id 3 in context, got %v %v", fishermanID, ok)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/api/fisherman/me", nil)
+	req.AddCookie(&http.Cookie{Name: "fisherman_session", Value: "fisherman-session-1", Secure: true, HttpOnly: true, SameSite: http.SameSiteStrictMode})
+	w := httptest.NewRecorder()
+
+	mw.Handle(next).ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+}
+
+func TestFishermanAuthMiddleware_RoleMismatch(t *testing.T) {
+	sessionRepo := &mock.MockSessionRepository{
+		Sessions: map[string]*model.Session{
+			"buyer-session-1": {ID: "buyer-session-1", UserID: 3, Role: model.SessionRoleBuyer},
+		},
+	}
+	mw := NewFishermanAuthMiddleware(sessionRepo)
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/api/fisherman/me", nil)
+	req.AddCookie(&http.Cookie{Name: "fisherman_session", Value: "buyer-session-1", Secure: true, HttpOnly: true, SameSite: http.SameSiteStrictMode})
+	w := httptest.NewRecorder()
+
+	mw.Handle(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {})).ServeHTTP(w, req)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, got %d", w.Code)
+	}
 }
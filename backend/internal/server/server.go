@@ -10,6 +10,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	"github.com/seka/fish-auction/backend/internal/server/handler/buyer"
+	"github.com/seka/fish-auction/backend/internal/server/handler/fisherman"
 	"github.com/seka/fish-auction/backend/internal/server/handler/public"
 	"github.com/seka/fish-auction/backend/internal/server/middleware"
 )
@@ -20,45 +21,66 @@ const (
 
 // Server serves the request.
 type Server struct {
-	router                *http.ServeMux
-	httpServer            *http.Server
-	healthHandler         *public.HealthHandler
-	fishermanHandler      *admin.FishermanHandler
-	buyerAuthHandler      *public.BuyerAuthHandler
-	buyerHandler          *buyer.BuyerHandler
-	adminBuyerHandler     *admin.BuyerHandler
-	publicItemHandler     *public.ItemHandler
-	adminItemHandler      *admin.ItemHandler
-	bidHandler            *buyer.BidHandler
-	invoiceHandler        *admin.InvoiceHandler
-	adminAuthHandler      *public.AdminAuthHandler
-	publicVenueHandler    *public.VenueHandler
-	adminVenueHandler     *admin.VenueHandler
-	publicAuctionHandler  *public.AuctionHandler
-	adminAuctionHandler   *admin.AuctionHandler
-	adminHandler          *admin.AdminHandler
-	adminAuthResetHandler *admin.AuthResetHandler
-	authResetHandler      *public.AuthResetHandler
-	pushHandler           *buyer.PushHandler
-	adminLoginRL          *middleware.RateLimiterMiddleware
-	buyerLoginRL          *middleware.RateLimiterMiddleware
-	adminResetRL          *middleware.RateLimiterMiddleware
-	buyerResetRL          *middleware.RateLimiterMiddleware
-	adminMe               *admin.MeHandler
-	adminAuth             *middleware.AdminAuthMiddleware
-	buyerAuth             *middleware.BuyerAuthMiddleware
-	cors                  *middleware.CORSMiddleware
-	securityHeaders       *middleware.SecurityHeadersMiddleware
-	csrf                  *middleware.CSRFMiddleware
-	cacheControl          *middleware.CacheControlMiddleware
-	gzip                  *middleware.GzipMiddleware
-	maxBody               *middleware.MaxBodyMiddleware
-	recovery              *middleware.RecoveryMiddleware
-	trustedProxy          *middleware.TrustedProxyMiddleware
-	requestID             *middleware.RequestIDMiddleware
-	readTimeout           time.Duration
-	writeTimeout          time.Duration
-	idleTimeout           time.Duration
+	router                    *http.ServeMux
+	httpServer                *http.Server
+	healthHandler             *public.HealthHandler
+	fishermanHandler          *admin.FishermanHandler
+	buyerAuthHandler          *public.BuyerAuthHandler
+	buyerHandler              *buyer.BuyerHandler
+	adminBuyerHandler         *admin.BuyerHandler
+	publicItemHandler         *public.ItemHandler
+	adminItemHandler          *admin.ItemHandler
+	bidHandler                *buyer.BidHandler
+	invoiceHandler            *admin.InvoiceHandler
+	adminAuthHandler          *public.AdminAuthHandler
+	publicVenueHandler        *public.VenueHandler
+	adminVenueHandler         *admin.VenueHandler
+	publicAuctionHandler      *public.AuctionHandler
+	adminAuctionHandler       *admin.AuctionHandler
+	adminHandler              *admin.AdminHandler
+	adminAuthResetHandler     *admin.AuthResetHandler
+	authResetHandler          *public.AuthResetHandler
+	pushHandler               *buyer.PushHandler
+	adminPayment              *admin.PaymentHandler
+	adminSettlement           *admin.SettlementHandler
+	adminAccounting           *admin.AccountingHandler
+	adminClaim                *admin.ClaimHandler
+	buyerClaim                *buyer.ClaimHandler
+	adminCharge               *admin.ChargeHandler
+	adminLabel                *admin.LabelHandler
+	buyerLabel                *buyer.LabelHandler
+	adminBid                  *admin.BidHandler
+	adminResult               *admin.ResultHandler
+	adminVenueRegistration    *admin.VenueRegistrationHandler
+	adminSession              *admin.SessionHandler
+	buyerSession              *buyer.SessionHandler
+	fishermanAuthHandler      *public.FishermanAuthHandler
+	fishermanAuthResetHandler *fisherman.AuthResetHandler
+	fishermanPortal           *fisherman.FishermanHandler
+	fishermanSettlement       *fisherman.SettlementHandler
+	adminLoginRL              *middleware.RateLimiterMiddleware
+	buyerLoginRL              *middleware.RateLimiterMiddleware
+	adminResetRL              *middleware.RateLimiterMiddleware
+	buyerResetRL              *middleware.RateLimiterMiddleware
+	fishermanLoginRL          *middleware.RateLimiterMiddleware
+	fishermanResetRL          *middleware.RateLimiterMiddleware
+	buyerSignupRL             *middleware.RateLimiterMiddleware
+	adminMe                   *admin.MeHandler
+	adminAuth                 *middleware.AdminAuthMiddleware
+	buyerAuth                 *middleware.BuyerAuthMiddleware
+	fishermanAuth             *middleware.FishermanAuthMiddleware
+	cors                      *middleware.CORSMiddleware
+	securityHeaders           *middleware.SecurityHeadersMiddleware
+	csrf                      *middleware.CSRFMiddleware
+	cacheControl              *middleware.CacheControlMiddleware
+	gzip                      *middleware.GzipMiddleware
+	maxBody                   *middleware.MaxBodyMiddleware
+	recovery                  *middleware.RecoveryMiddleware
+	trustedProxy              *middleware.TrustedProxyMiddleware
+	requestID                 *middleware.RequestIDMiddleware
+	readTimeout               time.Duration
+	writeTimeout              time.Duration
+	idleTimeout               time.Duration
 }
 
 // NewServer creates a new Server instance.
@@ -82,7 +104,25 @@ func NewServer(
 	adminAuthResetHandler *admin.AuthResetHandler,
 	pushHandler *buyer.PushHandler,
 	adminMeHandler *admin.MeHandler,
+	adminPayment *admin.PaymentHandler,
+	adminSettlement *admin.SettlementHandler,
+	adminAccounting *admin.AccountingHandler,
+	adminClaim *admin.ClaimHandler,
+	buyerClaim *buyer.ClaimHandler,
+	adminCharge *admin.ChargeHandler,
+	adminLabel *admin.LabelHandler,
+	buyerLabel *buyer.LabelHandler,
+	adminBid *admin.BidHandler,
+	adminResult *admin.ResultHandler,
+	adminVenueRegistration *admin.VenueRegistrationHandler,
+	adminSession *admin.SessionHandler,
+	buyerSession *buyer.SessionHandler,
+	fishermanAuthHandler *public.FishermanAuthHandler,
+	fishermanAuthResetHandler *fisherman.AuthResetHandler,
+	fishermanPortal *fisherman.FishermanHandler,
+	fishermanSettlement *fisherman.SettlementHandler,
 	sessionRepo repository.SessionRepository,
+	suspensionRepo repository.BuyerSuspensionRepository,
 	rateLimitRepo repository.RateLimitRepository,
 	allowedOrigins []string,
 	trustedProxies []string,
@@ -91,44 +131,65 @@ func NewServer(
 	idleTimeout time.Duration,
 ) *Server {
 	s := &Server{
-		router:                http.NewServeMux(),
-		healthHandler:         healthHandler,
-		fishermanHandler:      fishermanHandler,
-		buyerAuthHandler:      buyerAuthHandler,
-		buyerHandler:          buyerHandler,
-		adminBuyerHandler:     adminBuyerHandler,
-		publicItemHandler:     publicItemHandler,
-		adminItemHandler:      adminItemHandler,
-		bidHandler:            bidHandler,
-		invoiceHandler:        invoiceHandler,
-		adminAuthHandler:      adminAuthHandler,
-		publicVenueHandler:    publicVenueHandler,
-		adminVenueHandler:     adminVenueHandler,
-		publicAuctionHandler:  publicAuctionHandler,
-		adminAuctionHandler:   adminAuctionHandler,
-		adminHandler:          adminHandler,
-		authResetHandler:      authResetHandler,
-		adminAuthResetHandler: adminAuthResetHandler,
-		pushHandler:           pushHandler,
-		adminMe:               adminMeHandler,
-		adminLoginRL:          middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementAdminLogin, middleware.LoginRateLimit, middleware.LoginRateWindow),
-		buyerLoginRL:          middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementBuyerLogin, middleware.LoginRateLimit, middleware.LoginRateWindow),
-		adminResetRL:          middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementAdminReset, middleware.ResetRateLimit, middleware.ResetRateWindow),
-		buyerResetRL:          middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementBuyerReset, middleware.ResetRateLimit, middleware.ResetRateWindow),
-		adminAuth:             middleware.NewAdminAuthMiddleware(sessionRepo),
-		buyerAuth:             middleware.NewBuyerAuthMiddleware(sessionRepo),
-		cors:                  middleware.NewCORSMiddleware(allowedOrigins),
-		securityHeaders:       middleware.NewSecurityHeadersMiddleware(),
-		csrf:                  middleware.NewCSRFMiddleware(allowedOrigins),
-		cacheControl:          middleware.NewCacheControlMiddleware(),
-		gzip:                  middleware.NewGzipMiddleware(),
-		maxBody:               middleware.NewMaxBodyMiddleware(1024 * 1024), // 1MB
-		recovery:              middleware.NewRecoveryMiddleware(),
-		trustedProxy:          middleware.NewTrustedProxyMiddleware(trustedProxies),
-		requestID:             middleware.NewRequestIDMiddleware(),
-		readTimeout:           readTimeout,
-		writeTimeout:          writeTimeout,
-		idleTimeout:           idleTimeout,
+		router:                    http.NewServeMux(),
+		healthHandler:             healthHandler,
+		fishermanHandler:          fishermanHandler,
+		buyerAuthHandler:          buyerAuthHandler,
+		buyerHandler:              buyerHandler,
+		adminBuyerHandler:         adminBuyerHandler,
+		publicItemHandler:         publicItemHandler,
+		adminItemHandler:          adminItemHandler,
+		bidHandler:                bidHandler,
+		invoiceHandler:            invoiceHandler,
+		adminAuthHandler:          adminAuthHandler,
+		publicVenueHandler:        publicVenueHandler,
+		adminVenueHandler:         adminVenueHandler,
+		publicAuctionHandler:      publicAuctionHandler,
+		adminAuctionHandler:       adminAuctionHandler,
+		adminHandler:              adminHandler,
+		authResetHandler:          authResetHandler,
+		adminAuthResetHandler:     adminAuthResetHandler,
+		pushHandler:               pushHandler,
+		adminMe:                   adminMeHandler,
+		adminPayment:              adminPayment,
+		adminSettlement:           adminSettlement,
+		adminAccounting:           adminAccounting,
+		adminClaim:                adminClaim,
+		buyerClaim:                buyerClaim,
+		adminCharge:               adminCharge,
+		adminLabel:                adminLabel,
+		buyerLabel:                buyerLabel,
+		adminBid:                  adminBid,
+		adminResult:               adminResult,
+		adminVenueRegistration:    adminVenueRegistration,
+		adminSession:              adminSession,
+		buyerSession:              buyerSession,
+		fishermanAuthHandler:      fishermanAuthHandler,
+		fishermanAuthResetHandler: fishermanAuthResetHandler,
+		fishermanPortal:           fishermanPortal,
+		fishermanSettlement:       fishermanSettlement,
+		adminLoginRL:              middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementAdminLogin, middleware.LoginRateLimit, middleware.LoginRateWindow),
+		buyerLoginRL:              middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementBuyerLogin, middleware.LoginRateLimit, middleware.LoginRateWindow),
+		adminResetRL:              middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementAdminReset, middleware.ResetRateLimit, middleware.ResetRateWindow),
+		buyerResetRL:              middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementBuyerReset, middleware.ResetRateLimit, middleware.ResetRateWindow),
+		fishermanLoginRL:          middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementFishermanLogin, middleware.LoginRateLimit, middleware.LoginRateWindow),
+		fishermanResetRL:          middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementFishermanReset, middleware.ResetRateLimit, middleware.ResetRateWindow),
+		buyerSignupRL:             middleware.NewRateLimiterMiddleware(rateLimitRepo.IncrementBuyerSignup, middleware.ResetRateLimit, middleware.ResetRateWindow),
+		adminAuth:                 middleware.NewAdminAuthMiddleware(sessionRepo),
+		buyerAuth:                 middleware.NewBuyerAuthMiddleware(sessionRepo, suspensionRepo),
+		fishermanAuth:             middleware.NewFishermanAuthMiddleware(sessionRepo),
+		cors:                      middleware.NewCORSMiddleware(allowedOrigins),
+		securityHeaders:           middleware.NewSecurityHeadersMiddleware(),
+		csrf:                      middleware.NewCSRFMiddleware(allowedOrigins),
+		cacheControl:              middleware.NewCacheControlMiddleware(),
+		gzip:                      middleware.NewGzipMiddleware(),
+		maxBody:                   middleware.NewMaxBodyMiddleware(1024 * 1024), // 1MB
+		recovery:                  middleware.NewRecoveryMiddleware(),
+		trustedProxy:              middleware.NewTrustedProxyMiddleware(trustedProxies),
+		requestID:                 middleware.NewRequestIDMiddleware(),
+		readTimeout:               readTimeout,
+		writeTimeout:              writeTimeout,
+		idleTimeout:               idleTimeout,
 	}
 	s.routes()
 	return s
@@ -138,6 +199,7 @@ func (s *Server) routes() {
 	s.registerPublicRoutes()
 	s.registerAdminRoutes()
 	s.registerBuyerRoutes()
+	s.registerFishermanRoutes()
 }
 
 func (s *Server) registerPublicRoutes() {
@@ -151,6 +213,12 @@ func (s *Server) registerPublicRoutes() {
 	s.router.Handle("POST /api/buyer/login", s.buyerLoginRL.Handle(http.HandlerFunc(s.buyerAuthHandler.Login)))
 	s.router.HandleFunc("POST /api/buyer/logout", s.buyerAuthHandler.Logout)
 
+	// 利用申請はメール送信を伴うため RL 対象。確認リンクはトークンで本人を確かめる。
+	s.router.Handle("POST /api/buyer/signup", s.buyerSignupRL.Handle(http.HandlerFunc(s.buyerAuthHandler.SignUp)))
+	s.router.HandleFunc("POST /api/buyer/signup/verify", s.buyerAuthHandler.VerifySignUp)
+	// 再送もメール送信を伴うため、申請と同じ IP 単位の上限を共有する。
+	s.router.Handle("POST /api/buyer/signup/resend", s.buyerSignupRL.Handle(http.HandlerFunc(s.buyerAuthHandler.ResendSignUpVerification)))
+
 	// パスワードリセット request エンドポイントのみ RL 対象。verify / confirm は RL なし。
 	s.router.Handle("POST /api/auth/password-reset/request", s.buyerResetRL.Handle(http.HandlerFunc(s.authResetHandler.RequestReset)))
 	s.router.HandleFunc("POST /api/auth/password-reset/verify", s.authResetHandler.VerifyToken)
@@ -160,7 +228,12 @@ func (s *Server) registerPublicRoutes() {
 	s.router.HandleFunc("POST /api/admin/password-reset/verify", s.adminAuthResetHandler.VerifyToken)
 	s.router.HandleFunc("POST /api/admin/password-reset/confirm", s.adminAuthResetHandler.ConfirmReset)
 
-	s.publicItemHandler.RegisterRoutes(s.router)
+	s.router.Handle("POST /api/fisherman/login", s.fishermanLoginRL.Handle(http.HandlerFunc(s.fishermanAuthHandler.Login)))
+	s.router.HandleFunc("POST /api/fisherman/logout", s.fishermanAuthHandler.Logout)
+	s.router.Handle("POST /api/fisherman/password-reset/request", s.fishermanResetRL.Handle(http.HandlerFunc(s.fishermanAuthResetHandler.RequestReset)))
+	s.router.HandleFunc("POST /api/fisherman/password-reset/verify", s.fishermanAuthResetHandler.VerifyToken)
+	s.router.HandleFunc("POST /api/fisherman/password-reset/confirm", s.fishermanAuthResetHandler.ConfirmReset)
+
 	s.publicAuctionHandler.RegisterRoutes(s.router)
 	s.publicVenueHandler.RegisterRoutes(s.router)
 }
@@ -176,6 +249,16 @@ func (s *Server) registerAdminRoutes() {
 	s.adminHandler.RegisterRoutes(adminMux)
 	s.invoiceHandler.RegisterRoutes(adminMux)
 	s.adminMe.RegisterRoutes(adminMux)
+	s.adminPayment.RegisterRoutes(adminMux)
+	s.adminSettlement.RegisterRoutes(adminMux)
+	s.adminAccounting.RegisterRoutes(adminMux)
+	s.adminClaim.RegisterRoutes(adminMux)
+	s.adminCharge.RegisterRoutes(adminMux)
+	s.adminLabel.RegisterRoutes(adminMux)
+	s.adminBid.RegisterRoutes(adminMux)
+	s.adminResult.RegisterRoutes(adminMux)
+	s.adminVenueRegistration.RegisterRoutes(adminMux)
+	s.adminSession.RegisterRoutes(adminMux)
 
 	s.router.Handle("/api/admin/", s.adminAuth.Handle(http.StripPrefix("/api/admin", adminMux)))
 }
@@ -186,8 +269,31 @@ func (s *Server) registerBuyerRoutes() {
 	s.bidHandler.RegisterRoutes(buyerMux)
 	s.buyerHandler.RegisterRoutes(buyerMux)
 	s.pushHandler.RegisterRoutes(buyerMux)
+	s.buyerClaim.RegisterRoutes(buyerMux)
+	s.buyerLabel.RegisterRoutes(buyerMux)
+	s.buyerSession.RegisterRoutes(buyerMux)
 
 	s.router.Handle("/api/buyer/", s.buyerAuth.Handle(http.StripPrefix("/api/buyer", buyerMux)))
+
+	// 確認リンクはログインしていない端末で開かれることもあるため、セッションではなくトークンで本人を確かめる。
+	s.router.HandleFunc("POST /api/buyer/email-change/confirm", s.buyerHandler.ConfirmEmailChange)
+
+	// 入札履歴はせり・品目の下にあるが、誰の入札かを "you" で示すため買受人の認証を通す。
+	s.router.Handle("GET /api/auctions/{id}/items/{itemId}/bids", s.buyerAuth.Handle(http.HandlerFunc(s.bidHandler.History)))
+
+	// 品目の一覧は公開だが、会場の登録を閲覧にも求める設定（VENUE_REGISTRATION_REQUIRED_TO_VIEW）では
+	// 登録済みの買受人にしか見せないため、セッションがあれば買受人を読み取る。
+	s.router.Handle("GET /api/auctions/{id}/items", s.buyerAuth.Optional(http.HandlerFunc(s.publicAuctionHandler.GetItems)))
+	s.router.Handle("GET /api/items", s.buyerAuth.Optional(http.HandlerFunc(s.publicItemHandler.List)))
+}
+
+func (s *Server) registerFishermanRoutes() {
+	fishermanMux := http.NewServeMux()
+
+	s.fishermanPortal.RegisterRoutes(fishermanMux)
+	s.fishermanSettlement.RegisterRoutes(fishermanMux)
+
+	s.router.Handle("/api/fisherman/", s.fishermanAuth.Handle(http.StripPrefix("/api/fisherman", fishermanMux)))
 }
 
 // Start starts the HTTP server and blocks until the context is canceled.
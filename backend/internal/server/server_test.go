@@ -12,6 +12,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	adminHandler "github.com/seka/fish-auction/backend/internal/server/handler/admin"
 	buyerHandler "github.com/seka/fish-auction/backend/internal/server/handler/buyer"
+	fishermanHandler "github.com/seka/fish-auction/backend/internal/server/handler/fisherman"
 	publicHandler "github.com/seka/fish-auction/backend/internal/server/handler/public"
 	mock "github.com/seka/fish-auction/backend/internal/server/testing"
 )
@@ -34,13 +35,18 @@ func TestServer_SecurityRoutes(t *testing.T) {
 		GetBuyerUC: &mock.MockGetBuyerUseCase{ExecuteFunc: func(_ context.Context, _ int) (*model.Buyer, error) {
 			return &model.Buyer{ID: 1, Name: "Test Buyer"}, nil
 		}},
+		ConfirmBuyerEmailChangeUC:             &mock.MockConfirmEmailChangeUseCase{},
+		RegisterBuyerUC:                       &mock.MockRegisterBuyerUseCase{},
+		VerifyBuyerRegistrationEmailUC:        &mock.MockVerifyRegistrationEmailUseCase{},
+		ResendBuyerRegistrationVerificationUC: &mock.MockResendRegistrationVerificationUseCase{},
 	}
 
 	// Initialize Handlers
 	sessionRepo := &mock.MockSessionRepository{
 		Sessions: map[string]*model.Session{
-			"admin-session-1": {ID: "admin-session-1", UserID: 1, Role: model.SessionRoleAdmin},
-			"buyer-session-1": {ID: "buyer-session-1", UserID: 1, Role: model.SessionRoleBuyer},
+			"admin-session-1": {ID: "admin-session-1", UserID: 1, Role: model.SessionRoleAdmin, AdminRole: model.AdminRoleSuperAdmin},
+			"admin-session-2": {ID: "admin-session-2", UserID: 2, Role: model.SessionRoleAdmin, AdminRole: model.AdminRoleReadOnly},
+			"buyer-session-1": {ID: "buyer-session-1", UserID: 1, LoginID: 1, Role: model.SessionRoleBuyer},
 		},
 	}
 	hHealth := publicHandler.NewHealthHandler()
@@ -62,6 +68,23 @@ func TestServer_SecurityRoutes(t *testing.T) {
 	hAdminAuthReset := adminHandler.NewAuthResetHandler(mockReg)
 	hPush := buyerHandler.NewPushHandler(mockReg)
 	hAdminMe := adminHandler.NewMeHandler(nil)
+	hAdminPayment := adminHandler.NewPaymentHandler(mockReg)
+	hAdminSettlement := adminHandler.NewSettlementHandler(mockReg)
+	hAdminAccounting := adminHandler.NewAccountingHandler(mockReg)
+	hAdminClaim := adminHandler.NewClaimHandler(mockReg)
+	hBuyerClaim := buyerHandler.NewClaimHandler(mockReg)
+	hAdminCharge := adminHandler.NewChargeHandler(mockReg)
+	hAdminLabel := adminHandler.NewLabelHandler(mockReg)
+	hBuyerLabel := buyerHandler.NewLabelHandler(mockReg)
+	hAdminBid := adminHandler.NewBidHandler(mockReg)
+	hAdminResult := adminHandler.NewResultHandler(mockReg)
+	hAdminVenueRegistration := adminHandler.NewVenueRegistrationHandler(mockReg)
+	hAdminSession := adminHandler.NewSessionHandler(mockReg)
+	hBuyerSession := buyerHandler.NewSessionHandler(mockReg)
+	hFishermanAuth := publicHandler.NewFishermanAuthHandler(mockReg, sessionRepo)
+	hFishermanAuthReset := fishermanHandler.NewAuthResetHandler(mockReg)
+	hFishermanPortal := fishermanHandler.NewFishermanHandler(mockReg)
+	hFishermanSettlement := fishermanHandler.NewSettlementHandler(mockReg)
 
 	// Initialize Server
 	s := NewServer(
@@ -84,7 +107,25 @@ func TestServer_SecurityRoutes(t *testing.T) {
 		hAdminAuthReset,
 		hPush,
 		hAdminMe,
+		hAdminPayment,
+		hAdminSettlement,
+		hAdminAccounting,
+		hAdminClaim,
+		hBuyerClaim,
+		hAdminCharge,
+		hAdminLabel,
+		hBuyerLabel,
+		hAdminBid,
+		hAdminResult,
+		hAdminVenueRegistration,
+		hAdminSession,
+		hBuyerSession,
+		hFishermanAuth,
+		hFishermanAuthReset,
+		hFishermanPortal,
+		hFishermanSettlement,
 		sessionRepo,
+		&mock.MockBuyerSuspensionRepository{},
 		&mock.MockRateLimitRepository{},
 		[]string{"https://localhost", "http://localhost:3000"},
 		nil,
@@ -105,16 +146,39 @@ func TestServer_SecurityRoutes(t *testing.T) {
 		// 0. Public Routes Verification
 		// --------------------------------------------------------------------
 		{name: "Public_Health", method: http.MethodGet, path: "/api/health", expectedStatus: http.StatusOK},
+		{name: "Public_ConfirmBuyerEmailChange", method: http.MethodPost, path: "/api/buyer/email-change/confirm", expectedStatus: http.StatusOK},
+		{name: "Public_BuyerSignUp", method: http.MethodPost, path: "/api/buyer/signup", expectedStatus: http.StatusCreated},
+		{name: "Public_VerifyBuyerSignUp", method: http.MethodPost, path: "/api/buyer/signup/verify", expectedStatus: http.StatusOK},
+		{name: "Public_ResendBuyerSignUpVerification", method: http.MethodPost, path: "/api/buyer/signup/resend", expectedStatus: http.StatusOK},
 
 		// --------------------------------------------------------------------
 		// 1. Admin Routes Security Verification (Must be 401 without cookie)
 		// --------------------------------------------------------------------
+		// Admins
+		{name: "Admin_ListAdmins_NoAuth", method: http.MethodGet, path: "/api/admin/admins", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_UpdateAdminRole_NoAuth", method: http.MethodPut, path: "/api/admin/admins/1/role", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_DisableAdmin_NoAuth", method: http.MethodPost, path: "/api/admin/admins/1/disable", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_UnlockAdmin_NoAuth", method: http.MethodPost, path: "/api/admin/admins/1/unlock", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ForceAdminPasswordReset_NoAuth", method: http.MethodPost, path: "/api/admin/admins/1/password-reset", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ResetAdminTwoFactor_NoAuth", method: http.MethodPost, path: "/api/admin/admins/1/2fa/reset", expectedStatus: http.StatusUnauthorized},
 		// Fishermen
 		{name: "Admin_ListFishermen_NoAuth", method: http.MethodGet, path: "/api/admin/fishermen", expectedStatus: http.StatusUnauthorized},
 		{name: "Admin_CreateFisherman_NoAuth", method: http.MethodPost, path: "/api/admin/fishermen", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_CreateFishermanLogin_NoAuth", method: http.MethodPost, path: "/api/admin/fishermen/1/login", expectedStatus: http.StatusUnauthorized},
 		// Buyers
 		{name: "Admin_ListBuyers_NoAuth", method: http.MethodGet, path: "/api/admin/buyers", expectedStatus: http.StatusUnauthorized},
 		{name: "Admin_CreateBuyer_NoAuth", method: http.MethodPost, path: "/api/admin/buyers", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_UpdateBuyer_NoAuth", method: http.MethodPut, path: "/api/admin/buyers/1", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_UpdateFisherman_NoAuth", method: http.MethodPut, path: "/api/admin/fishermen/1", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_UpdateBuyerPaddleNumber_NoAuth", method: http.MethodPut, path: "/api/admin/buyers/1/paddle-number", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_GetBuyerCredit_NoAuth", method: http.MethodGet, path: "/api/admin/buyers/1/credit", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_UpdateBuyerCredit_NoAuth", method: http.MethodPut, path: "/api/admin/buyers/1/credit", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_SuspendBuyer_NoAuth", method: http.MethodPost, path: "/api/admin/buyers/1/suspensions", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ListBuyerSuspensions_NoAuth", method: http.MethodGet, path: "/api/admin/buyer-suspensions", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_LiftBuyerSuspension_NoAuth", method: http.MethodPost, path: "/api/admin/buyer-suspensions/1/lift", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ListBuyerApplications_NoAuth", method: http.MethodGet, path: "/api/admin/buyer-applications", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ApproveBuyerApplication_NoAuth", method: http.MethodPost, path: "/api/admin/buyer-applications/1/approve", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_RejectBuyerApplication_NoAuth", method: http.MethodPost, path: "/api/admin/buyer-applications/1/reject", expectedStatus: http.StatusUnauthorized},
 		// Items
 		{name: "Admin_CreateItem_NoAuth", method: http.MethodPost, path: "/api/admin/items", expectedStatus: http.StatusUnauthorized},
 		// Auctions
@@ -126,8 +190,38 @@ func TestServer_SecurityRoutes(t *testing.T) {
 		{name: "Admin_CreateVenue_NoAuth", method: http.MethodPost, path: "/api/admin/venues", expectedStatus: http.StatusUnauthorized},
 		{name: "Admin_UpdateVenue_NoAuth", method: http.MethodPut, path: "/api/admin/venues/1", expectedStatus: http.StatusUnauthorized},
 		{name: "Admin_DeleteVenue_NoAuth", method: http.MethodDelete, path: "/api/admin/venues/1", expectedStatus: http.StatusUnauthorized},
+		// Payments
+		{name: "Admin_RecordPayment_NoAuth", method: http.MethodPost, path: "/api/admin/payments", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ListClaims_NoAuth", method: http.MethodGet, path: "/api/admin/claims", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ApproveClaim_NoAuth", method: http.MethodPost, path: "/api/admin/claims/1/approve", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_AddBuyerCharge_NoAuth", method: http.MethodPost, path: "/api/admin/auctions/1/charges", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ListChargeItems_NoAuth", method: http.MethodGet, path: "/api/admin/venues/1/charge-items", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_PrintAuctionLabels_NoAuth", method: http.MethodGet, path: "/api/admin/auctions/1/labels", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_PrintItemLabel_NoAuth", method: http.MethodGet, path: "/api/admin/items/1/label", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ScanLabel_NoAuth", method: http.MethodGet, path: "/api/admin/labels/scan?code=x", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_EnterFloorBid_NoAuth", method: http.MethodPost, path: "/api/admin/items/1/bids", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_BidHistory_NoAuth", method: http.MethodGet, path: "/api/admin/auctions/1/items/1/bids", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_VoidBid_NoAuth", method: http.MethodPost, path: "/api/admin/bids/1/void", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_EnterResults_NoAuth", method: http.MethodPost, path: "/api/admin/auctions/1/results", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_RequestCorrection_NoAuth", method: http.MethodPost, path: "/api/admin/items/1/corrections", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ApproveCorrection_NoAuth", method: http.MethodPost, path: "/api/admin/corrections/1/approve", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_GenerateSettlements_NoAuth", method: http.MethodPost, path: "/api/admin/auctions/1/settlements", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_TransferFile_NoAuth", method: http.MethodPost, path: "/api/admin/settlements/transfer-file", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ExportJournal_NoAuth", method: http.MethodGet, path: "/api/admin/accounting/journal", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_AgedReceivables_NoAuth", method: http.MethodGet, path: "/api/admin/receivables/aging", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ListVenueRegistrations_NoAuth", method: http.MethodGet, path: "/api/admin/venue-registrations", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_CreateVenueRegistration_NoAuth", method: http.MethodPost, path: "/api/admin/venue-registrations", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_UpdateVenueRegistration_NoAuth", method: http.MethodPut, path: "/api/admin/venue-registrations/1", expectedStatus: http.StatusUnauthorized},
 		// Password
 		{name: "Admin_UpdatePassword_NoAuth", method: http.MethodPut, path: "/api/admin/password", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_TwoFactorStatus_NoAuth", method: http.MethodGet, path: "/api/admin/2fa", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_SetupTwoFactor_NoAuth", method: http.MethodPost, path: "/api/admin/2fa/setup", expectedStatus: http.StatusUnauthorized},
+		// Sessions
+		{name: "Admin_ListSessions_NoAuth", method: http.MethodGet, path: "/api/admin/sessions", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_RevokeSession_NoAuth", method: http.MethodDelete, path: "/api/admin/sessions/abc", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_RevokeOtherSessions_NoAuth", method: http.MethodDelete, path: "/api/admin/sessions", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_ListBuyerSessions_NoAuth", method: http.MethodGet, path: "/api/admin/buyers/1/sessions", expectedStatus: http.StatusUnauthorized},
+		{name: "Admin_RevokeBuyerSessions_NoAuth", method: http.MethodDelete, path: "/api/admin/buyers/1/sessions", expectedStatus: http.StatusUnauthorized},
 
 		// --------------------------------------------------------------------
 		// 3. Buyer Routes Security Verification (Must be 401 without cookie)
@@ -136,10 +230,30 @@ func TestServer_SecurityRoutes(t *testing.T) {
 		{name: "Buyer_GetMe_NoAuth", method: http.MethodGet, path: "/api/buyer/me", expectedStatus: http.StatusUnauthorized},
 		{name: "Buyer_GetPurchases_NoAuth", method: http.MethodGet, path: "/api/buyer/me/purchases", expectedStatus: http.StatusUnauthorized},
 		{name: "Buyer_GetAuctions_NoAuth", method: http.MethodGet, path: "/api/buyer/me/auctions", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_GetBalance_NoAuth", method: http.MethodGet, path: "/api/buyer/balance", expectedStatus: http.StatusUnauthorized},
 		// Bids
 		{name: "Buyer_CreateBid_NoAuth", method: http.MethodPost, path: "/api/buyer/bids", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_FileClaim_NoAuth", method: http.MethodPost, path: "/api/buyer/claims", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_ScanLabel_NoAuth", method: http.MethodGet, path: "/api/buyer/labels/scan?code=x", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_ScanBid_NoAuth", method: http.MethodPost, path: "/api/buyer/labels/scan/bids", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_BidHistory_NoAuth", method: http.MethodGet, path: "/api/auctions/1/items/1/bids", expectedStatus: http.StatusUnauthorized},
 		// Password
 		{name: "Buyer_UpdatePassword_NoAuth", method: http.MethodPut, path: "/api/buyer/password", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_UpdateMe_NoAuth", method: http.MethodPut, path: "/api/buyer/me", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_RequestEmailChange_NoAuth", method: http.MethodPost, path: "/api/buyer/email-change", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_ListLogins_NoAuth", method: http.MethodGet, path: "/api/buyer/logins", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_CreateLogin_NoAuth", method: http.MethodPost, path: "/api/buyer/logins", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_UpdateLogin_NoAuth", method: http.MethodPut, path: "/api/buyer/logins/1", expectedStatus: http.StatusUnauthorized},
+		// Sessions
+		{name: "Buyer_ListSessions_NoAuth", method: http.MethodGet, path: "/api/buyer/sessions", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_RevokeSession_NoAuth", method: http.MethodDelete, path: "/api/buyer/sessions/abc", expectedStatus: http.StatusUnauthorized},
+		{name: "Buyer_RevokeOtherSessions_NoAuth", method: http.MethodDelete, path: "/api/buyer/sessions", expectedStatus: http.StatusUnauthorized},
+		// Fisherman portal
+		{name: "Fisherman_GetMe_NoAuth", method: http.MethodGet, path: "/api/fisherman/me", expectedStatus: http.StatusUnauthorized},
+		{name: "Fisherman_ListLots_NoAuth", method: http.MethodGet, path: "/api/fisherman/lots", expectedStatus: http.StatusUnauthorized},
+		{name: "Fisherman_ListResults_NoAuth", method: http.MethodGet, path: "/api/fisherman/results", expectedStatus: http.StatusUnauthorized},
+		{name: "Fisherman_ListSettlements_NoAuth", method: http.MethodGet, path: "/api/fisherman/settlements", expectedStatus: http.StatusUnauthorized},
+		{name: "Fisherman_BuyerSession_NoAuth", method: http.MethodGet, path: "/api/fisherman/me", cookieName: "fisherman_session", cookieValue: "buyer-session-1", expectedStatus: http.StatusUnauthorized},
 
 		// --------------------------------------------------------------------
 		// 4. Authorized Access Verification (Sample check with cookie)
@@ -198,6 +312,42 @@ func TestServer_SecurityRoutes(t *testing.T) {
 			cookieValue:    "admin-session-1",
 			expectedStatus: http.StatusCreated,
 		},
+
+		// --------------------------------------------------------------------
+		// 5. Admin Role Verification (read-only admin)
+		// --------------------------------------------------------------------
+		{
+			name:           "Admin_ListFishermen_ReadOnly",
+			method:         http.MethodGet,
+			path:           "/api/admin/fishermen",
+			cookieName:     "admin_session",
+			cookieValue:    "admin-session-2",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Admin_CreateAuction_ReadOnly",
+			method:         http.MethodPost,
+			path:           "/api/admin/auctions",
+			cookieName:     "admin_session",
+			cookieValue:    "admin-session-2",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Admin_ListAdmins_ReadOnly",
+			method:         http.MethodGet,
+			path:           "/api/admin/admins",
+			cookieName:     "admin_session",
+			cookieValue:    "admin-session-2",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Admin_IssueInvoice_ReadOnly",
+			method:         http.MethodPost,
+			path:           "/api/admin/invoices/1/issue",
+			cookieName:     "admin_session",
+			cookieValue:    "admin-session-2",
+			expectedStatus: http.StatusForbidden,
+		},
 	}
 
 	for _, tc := range tests {
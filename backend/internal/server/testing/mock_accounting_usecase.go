@@ -0,0 +1,47 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/accounting"
+)
+
+// MockGetAccountingSettingsUseCase is a mock implementation of GetAccountingSettingsUseCase for testing.
+type MockGetAccountingSettingsUseCase struct {
+	ExecuteFunc func(ctx context.Context, venueID int) (*model.AccountingSettings, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGetAccountingSettingsUseCase) Execute(ctx context.Context, venueID int) (*model.AccountingSettings, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, venueID)
+	}
+	return nil, nil
+}
+
+// MockUpdateAccountingSettingsUseCase is a mock implementation of UpdateAccountingSettingsUseCase for testing.
+type MockUpdateAccountingSettingsUseCase struct {
+	ExecuteFunc func(ctx context.Context, settings *model.AccountingSettings) (*model.AccountingSettings, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdateAccountingSettingsUseCase) Execute(ctx context.Context, settings *model.AccountingSettings) (*model.AccountingSettings, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, settings)
+	}
+	return nil, nil
+}
+
+// MockExportJournalUseCase is a mock implementation of ExportJournalUseCase for testing.
+type MockExportJournalUseCase struct {
+	ExecuteFunc func(ctx context.Context, input *accounting.ExportJournalInput) ([]byte, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockExportJournalUseCase) Execute(ctx context.Context, input *accounting.ExportJournalInput) ([]byte, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, input)
+	}
+	return nil, nil
+}
@@ -4,21 +4,152 @@ import (
 	"context"
 
 	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/admin"
 )
 
 // MockCreateAdminUseCase is a mock implementation of CreateAdminUseCase for testing.
 type MockCreateAdminUseCase struct {
-	ExecuteFunc func(ctx context.Context, email, password string) (*model.Admin, error)
+	ExecuteFunc func(ctx context.Context, email, password string, role model.AdminRole) (*model.Admin, error)
 }
 
 // Execute executes the use case logic.
-func (m *MockCreateAdminUseCase) Execute(ctx context.Context, email, password string) (*model.Admin, error) {
+func (m *MockCreateAdminUseCase) Execute(ctx context.Context, email, password string, role model.AdminRole) (*model.Admin, error) {
 	if m.ExecuteFunc != nil {
-		return m.ExecuteFunc(ctx, email, password)
+		return m.ExecuteFunc(ctx, email, password, role)
 	}
 	return nil, nil
 }
 
+// MockListAdminsUseCase is a mock implementation of ListAdminsUseCase for testing.
+type MockListAdminsUseCase struct {
+	ExecuteFunc func(ctx context.Context) ([]model.Admin, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListAdminsUseCase) Execute(ctx context.Context) ([]model.Admin, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx)
+	}
+	return nil, nil
+}
+
+// MockUpdateAdminRoleUseCase is a mock implementation of UpdateRoleUseCase for testing.
+type MockUpdateAdminRoleUseCase struct {
+	ExecuteFunc func(ctx context.Context, actorID, adminID int, role model.AdminRole) (*model.Admin, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdateAdminRoleUseCase) Execute(ctx context.Context, actorID, adminID int, role model.AdminRole) (*model.Admin, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, actorID, adminID, role)
+	}
+	return nil, nil
+}
+
+// MockSetAdminDisabledUseCase is a mock implementation of SetDisabledUseCase for testing.
+type MockSetAdminDisabledUseCase struct {
+	ExecuteFunc func(ctx context.Context, actorID, adminID int, disabled bool) (*model.Admin, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockSetAdminDisabledUseCase) Execute(ctx context.Context, actorID, adminID int, disabled bool) (*model.Admin, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, actorID, adminID, disabled)
+	}
+	return nil, nil
+}
+
+// MockUnlockAdminUseCase is a mock implementation of UnlockUseCase for testing.
+type MockUnlockAdminUseCase struct {
+	ExecuteFunc func(ctx context.Context, adminID int) (*model.Admin, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUnlockAdminUseCase) Execute(ctx context.Context, adminID int) (*model.Admin, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, adminID)
+	}
+	return nil, nil
+}
+
+// MockForceAdminPasswordResetUseCase is a mock implementation of ForcePasswordResetUseCase for testing.
+type MockForceAdminPasswordResetUseCase struct {
+	ExecuteFunc func(ctx context.Context, actorID, adminID int) error
+}
+
+// Execute executes the use case logic.
+func (m *MockForceAdminPasswordResetUseCase) Execute(ctx context.Context, actorID, adminID int) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, actorID, adminID)
+	}
+	return nil
+}
+
+// MockGetAdminTwoFactorStatusUseCase is a mock implementation of GetTwoFactorStatusUseCase for testing.
+type MockGetAdminTwoFactorStatusUseCase struct {
+	ExecuteFunc func(ctx context.Context, adminID int) (*admin.TwoFactorStatus, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGetAdminTwoFactorStatusUseCase) Execute(ctx context.Context, adminID int) (*admin.TwoFactorStatus, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, adminID)
+	}
+	return &admin.TwoFactorStatus{}, nil
+}
+
+// MockSetupAdminTwoFactorUseCase is a mock implementation of SetupTwoFactorUseCase for testing.
+type MockSetupAdminTwoFactorUseCase struct {
+	ExecuteFunc func(ctx context.Context, adminID int) (*admin.TwoFactorSetup, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockSetupAdminTwoFactorUseCase) Execute(ctx context.Context, adminID int) (*admin.TwoFactorSetup, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, adminID)
+	}
+	return &admin.TwoFactorSetup{}, nil
+}
+
+// MockEnableAdminTwoFactorUseCase is a mock implementation of EnableTwoFactorUseCase for testing.
+type MockEnableAdminTwoFactorUseCase struct {
+	ExecuteFunc func(ctx context.Context, adminID int, code string) ([]string, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockEnableAdminTwoFactorUseCase) Execute(ctx context.Context, adminID int, code string) ([]string, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, adminID, code)
+	}
+	return nil, nil
+}
+
+// MockDisableAdminTwoFactorUseCase is a mock implementation of DisableTwoFactorUseCase for testing.
+type MockDisableAdminTwoFactorUseCase struct {
+	ExecuteFunc func(ctx context.Context, adminID int, password, code string) error
+}
+
+// Execute executes the use case logic.
+func (m *MockDisableAdminTwoFactorUseCase) Execute(ctx context.Context, adminID int, password, code string) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, adminID, password, code)
+	}
+	return nil
+}
+
+// MockResetAdminTwoFactorUseCase is a mock implementation of ResetTwoFactorUseCase for testing.
+type MockResetAdminTwoFactorUseCase struct {
+	ExecuteFunc func(ctx context.Context, actorID, adminID int) error
+}
+
+// Execute executes the use case logic.
+func (m *MockResetAdminTwoFactorUseCase) Execute(ctx context.Context, actorID, adminID int) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, actorID, adminID)
+	}
+	return nil
+}
+
 // MockAdminUpdatePasswordUseCase is a mock implementation of AdminUpdatePasswordUseCase for testing.
 type MockAdminUpdatePasswordUseCase struct {
 	ExecuteFunc func(ctx context.Context, id int, currentPassword, newPassword string) error
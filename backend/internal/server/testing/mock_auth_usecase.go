@@ -8,13 +8,13 @@ import (
 
 // MockLoginUseCase is a mock implementation of LoginUseCase for testing.
 type MockLoginUseCase struct {
-	ExecuteFunc func(ctx context.Context, email, password string) (*model.Admin, error)
+	ExecuteFunc func(ctx context.Context, email, password, code string) (*model.Admin, error)
 }
 
 // Execute executes the use case logic.
-func (m *MockLoginUseCase) Execute(ctx context.Context, email, password string) (*model.Admin, error) {
+func (m *MockLoginUseCase) Execute(ctx context.Context, email, password, code string) (*model.Admin, error) {
 	if m.ExecuteFunc != nil {
-		return m.ExecuteFunc(ctx, email, password)
+		return m.ExecuteFunc(ctx, email, password, code)
 	}
 	return nil, nil
 }
@@ -18,3 +18,29 @@ func (m *MockCreateBidUseCase) Execute(ctx context.Context, bid *model.Bid) (*mo
 	}
 	return nil, nil
 }
+
+// MockListItemBidsUseCase is a mock implementation of ListItemBidsUseCase for testing.
+type MockListItemBidsUseCase struct {
+	ExecuteFunc func(ctx context.Context, auctionID, itemID int, q *model.BidHistoryQuery) (*model.BidHistoryPage, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListItemBidsUseCase) Execute(ctx context.Context, auctionID, itemID int, q *model.BidHistoryQuery) (*model.BidHistoryPage, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, auctionID, itemID, q)
+	}
+	return &model.BidHistoryPage{}, nil
+}
+
+// MockVoidBidUseCase is a mock implementation of VoidBidUseCase for testing.
+type MockVoidBidUseCase struct {
+	ExecuteFunc func(ctx context.Context, id, adminID int, reason string) (*model.Bid, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockVoidBidUseCase) Execute(ctx context.Context, id, adminID int, reason string) (*model.Bid, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, adminID, reason)
+	}
+	return nil, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
 )
 
 // MockCreateBuyerUseCase is a mock implementation of CreateBuyerUseCase for testing.
@@ -34,15 +35,15 @@ func (m *MockListBuyersUseCase) Execute(ctx context.Context) ([]model.Buyer, err
 
 // MockLoginBuyerUseCase is a mock implementation of LoginBuyerUseCase for testing.
 type MockLoginBuyerUseCase struct {
-	ExecuteFunc func(ctx context.Context, email, password string) (*model.Buyer, error)
+	ExecuteFunc func(ctx context.Context, email, password string) (*model.Buyer, *model.Authentication, error)
 }
 
 // Execute executes the use case logic.
-func (m *MockLoginBuyerUseCase) Execute(ctx context.Context, email, password string) (*model.Buyer, error) {
+func (m *MockLoginBuyerUseCase) Execute(ctx context.Context, email, password string) (*model.Buyer, *model.Authentication, error) {
 	if m.ExecuteFunc != nil {
 		return m.ExecuteFunc(ctx, email, password)
 	}
-	return nil, nil
+	return nil, nil, nil
 }
 
 // MockGetBuyerPurchasesUseCase is a mock implementation of GetBuyerPurchasesUseCase for testing.
@@ -73,13 +74,13 @@ func (m *MockGetBuyerAuctionsUseCase) Execute(ctx context.Context, buyerID int)
 
 // MockBuyerUpdatePasswordUseCase is a mock implementation of BuyerUpdatePasswordUseCase for testing.
 type MockBuyerUpdatePasswordUseCase struct {
-	ExecuteFunc func(ctx context.Context, buyerID int, currentPassword, newPassword string) error
+	ExecuteFunc func(ctx context.Context, loginID int, currentPassword, newPassword string) error
 }
 
 // Execute executes the use case logic.
-func (m *MockBuyerUpdatePasswordUseCase) Execute(ctx context.Context, buyerID int, currentPassword, newPassword string) error {
+func (m *MockBuyerUpdatePasswordUseCase) Execute(ctx context.Context, loginID int, currentPassword, newPassword string) error {
 	if m.ExecuteFunc != nil {
-		return m.ExecuteFunc(ctx, buyerID, currentPassword, newPassword)
+		return m.ExecuteFunc(ctx, loginID, currentPassword, newPassword)
 	}
 	return nil
 }
@@ -109,3 +110,250 @@ func (m *MockDeleteBuyerUseCase) Execute(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// MockUpdateBuyerUseCase is a mock implementation of UpdateBuyerUseCase for testing.
+type MockUpdateBuyerUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int, input *buyer.UpdateBuyerInput) (*model.Buyer, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdateBuyerUseCase) Execute(ctx context.Context, id int, input *buyer.UpdateBuyerInput) (*model.Buyer, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, input)
+	}
+	return nil, nil
+}
+
+// MockUpdatePaddleNumberUseCase is a mock implementation of UpdatePaddleNumberUseCase for testing.
+type MockUpdatePaddleNumberUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int, paddleNumber string) (*model.Buyer, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdatePaddleNumberUseCase) Execute(ctx context.Context, id int, paddleNumber string) (*model.Buyer, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, paddleNumber)
+	}
+	return nil, nil
+}
+
+// MockGetCreditUtilizationUseCase is a mock implementation of GetCreditUtilizationUseCase for testing.
+type MockGetCreditUtilizationUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int) (*model.CreditUtilization, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGetCreditUtilizationUseCase) Execute(ctx context.Context, id int) (*model.CreditUtilization, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// MockUpdateCreditTermsUseCase is a mock implementation of UpdateCreditTermsUseCase for testing.
+type MockUpdateCreditTermsUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int, terms model.CreditTerms) (*model.CreditUtilization, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdateCreditTermsUseCase) Execute(ctx context.Context, id int, terms model.CreditTerms) (*model.CreditUtilization, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, terms)
+	}
+	return nil, nil
+}
+
+// MockSuspendBuyerUseCase is a mock implementation of SuspendBuyerUseCase for testing.
+type MockSuspendBuyerUseCase struct {
+	ExecuteFunc func(ctx context.Context, s *model.BuyerSuspension) (*model.BuyerSuspension, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockSuspendBuyerUseCase) Execute(ctx context.Context, s *model.BuyerSuspension) (*model.BuyerSuspension, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, s)
+	}
+	return nil, nil
+}
+
+// MockLiftSuspensionUseCase is a mock implementation of LiftSuspensionUseCase for testing.
+type MockLiftSuspensionUseCase struct {
+	ExecuteFunc func(ctx context.Context, id, adminID int) (*model.BuyerSuspension, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockLiftSuspensionUseCase) Execute(ctx context.Context, id, adminID int) (*model.BuyerSuspension, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, adminID)
+	}
+	return nil, nil
+}
+
+// MockListSuspensionsUseCase is a mock implementation of ListSuspensionsUseCase for testing.
+type MockListSuspensionsUseCase struct {
+	ExecuteFunc func(ctx context.Context, buyerID *int, activeOnly bool) ([]model.BuyerSuspension, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListSuspensionsUseCase) Execute(ctx context.Context, buyerID *int, activeOnly bool) ([]model.BuyerSuspension, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, buyerID, activeOnly)
+	}
+	return nil, nil
+}
+
+// MockUpdateProfileUseCase is a mock implementation of UpdateProfileUseCase for testing.
+type MockUpdateProfileUseCase struct {
+	ExecuteFunc func(ctx context.Context, loginID int, input *buyer.UpdateProfileInput) (*model.Buyer, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdateProfileUseCase) Execute(ctx context.Context, loginID int, input *buyer.UpdateProfileInput) (*model.Buyer, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, loginID, input)
+	}
+	return nil, nil
+}
+
+// MockRequestEmailChangeUseCase is a mock implementation of RequestEmailChangeUseCase for testing.
+type MockRequestEmailChangeUseCase struct {
+	ExecuteFunc func(ctx context.Context, loginID int, newEmail, password string) error
+}
+
+// Execute executes the use case logic.
+func (m *MockRequestEmailChangeUseCase) Execute(ctx context.Context, loginID int, newEmail, password string) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, loginID, newEmail, password)
+	}
+	return nil
+}
+
+// MockConfirmEmailChangeUseCase is a mock implementation of ConfirmEmailChangeUseCase for testing.
+type MockConfirmEmailChangeUseCase struct {
+	ExecuteFunc func(ctx context.Context, token string) error
+}
+
+// Execute executes the use case logic.
+func (m *MockConfirmEmailChangeUseCase) Execute(ctx context.Context, token string) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, token)
+	}
+	return nil
+}
+
+// MockRegisterBuyerUseCase is a mock implementation of RegisterBuyerUseCase for testing.
+type MockRegisterBuyerUseCase struct {
+	ExecuteFunc func(ctx context.Context, input *buyer.RegisterBuyerInput) (*model.Buyer, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockRegisterBuyerUseCase) Execute(ctx context.Context, input *buyer.RegisterBuyerInput) (*model.Buyer, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, input)
+	}
+	return nil, nil
+}
+
+// MockVerifyRegistrationEmailUseCase is a mock implementation of VerifyRegistrationEmailUseCase for testing.
+type MockVerifyRegistrationEmailUseCase struct {
+	ExecuteFunc func(ctx context.Context, token string) error
+}
+
+// Execute executes the use case logic.
+func (m *MockVerifyRegistrationEmailUseCase) Execute(ctx context.Context, token string) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, token)
+	}
+	return nil
+}
+
+// MockResendRegistrationVerificationUseCase is a mock implementation of ResendRegistrationVerificationUseCase for testing.
+type MockResendRegistrationVerificationUseCase struct {
+	ExecuteFunc func(ctx context.Context, email string) error
+}
+
+// Execute executes the use case logic.
+func (m *MockResendRegistrationVerificationUseCase) Execute(ctx context.Context, email string) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, email)
+	}
+	return nil
+}
+
+// MockListApplicationsUseCase is a mock implementation of ListApplicationsUseCase for testing.
+type MockListApplicationsUseCase struct {
+	ExecuteFunc func(ctx context.Context) ([]model.BuyerApplication, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListApplicationsUseCase) Execute(ctx context.Context) ([]model.BuyerApplication, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx)
+	}
+	return nil, nil
+}
+
+// MockApproveApplicationUseCase is a mock implementation of ApproveApplicationUseCase for testing.
+type MockApproveApplicationUseCase struct {
+	ExecuteFunc func(ctx context.Context, buyerID, adminID int) (*model.Buyer, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockApproveApplicationUseCase) Execute(ctx context.Context, buyerID, adminID int) (*model.Buyer, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, buyerID, adminID)
+	}
+	return nil, nil
+}
+
+// MockRejectApplicationUseCase is a mock implementation of RejectApplicationUseCase for testing.
+type MockRejectApplicationUseCase struct {
+	ExecuteFunc func(ctx context.Context, buyerID, adminID int, reason string) (*model.Buyer, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockRejectApplicationUseCase) Execute(ctx context.Context, buyerID, adminID int, reason string) (*model.Buyer, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, buyerID, adminID, reason)
+	}
+	return nil, nil
+}
+
+// MockListLoginsUseCase is a mock implementation of ListLoginsUseCase for testing.
+type MockListLoginsUseCase struct {
+	ExecuteFunc func(ctx context.Context, loginID int) ([]model.Authentication, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListLoginsUseCase) Execute(ctx context.Context, loginID int) ([]model.Authentication, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, loginID)
+	}
+	return nil, nil
+}
+
+// MockCreateLoginUseCase is a mock implementation of CreateLoginUseCase for testing.
+type MockCreateLoginUseCase struct {
+	ExecuteFunc func(ctx context.Context, ownerLoginID int, input *buyer.CreateLoginInput) (*model.Authentication, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockCreateLoginUseCase) Execute(ctx context.Context, ownerLoginID int, input *buyer.CreateLoginInput) (*model.Authentication, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, ownerLoginID, input)
+	}
+	return nil, nil
+}
+
+// MockUpdateLoginUseCase is a mock implementation of UpdateLoginUseCase for testing.
+type MockUpdateLoginUseCase struct {
+	ExecuteFunc func(ctx context.Context, ownerLoginID, loginID int, input *buyer.UpdateLoginInput) (*model.Authentication, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdateLoginUseCase) Execute(ctx context.Context, ownerLoginID, loginID int, input *buyer.UpdateLoginInput) (*model.Authentication, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, ownerLoginID, loginID, input)
+	}
+	return nil, nil
+}
@@ -0,0 +1,86 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/charge"
+)
+
+// MockCreateChargeItemUseCase is a mock implementation of CreateChargeItemUseCase for testing.
+type MockCreateChargeItemUseCase struct {
+	ExecuteFunc func(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockCreateChargeItemUseCase) Execute(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, item)
+	}
+	return nil, nil
+}
+
+// MockUpdateChargeItemUseCase is a mock implementation of UpdateChargeItemUseCase for testing.
+type MockUpdateChargeItemUseCase struct {
+	ExecuteFunc func(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdateChargeItemUseCase) Execute(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, item)
+	}
+	return nil, nil
+}
+
+// MockListChargeItemsUseCase is a mock implementation of ListChargeItemsUseCase for testing.
+type MockListChargeItemsUseCase struct {
+	ExecuteFunc func(ctx context.Context, venueID int) ([]model.ChargeItem, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListChargeItemsUseCase) Execute(ctx context.Context, venueID int) ([]model.ChargeItem, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, venueID)
+	}
+	return nil, nil
+}
+
+// MockAddBuyerChargeUseCase is a mock implementation of AddBuyerChargeUseCase for testing.
+type MockAddBuyerChargeUseCase struct {
+	ExecuteFunc func(ctx context.Context, adminID int, input *charge.AddBuyerChargeInput) (*model.BuyerCharge, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockAddBuyerChargeUseCase) Execute(ctx context.Context, adminID int, input *charge.AddBuyerChargeInput) (*model.BuyerCharge, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, adminID, input)
+	}
+	return nil, nil
+}
+
+// MockListBuyerChargesUseCase is a mock implementation of ListBuyerChargesUseCase for testing.
+type MockListBuyerChargesUseCase struct {
+	ExecuteFunc func(ctx context.Context, auctionID int) ([]model.BuyerCharge, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListBuyerChargesUseCase) Execute(ctx context.Context, auctionID int) ([]model.BuyerCharge, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, auctionID)
+	}
+	return nil, nil
+}
+
+// MockDeleteBuyerChargeUseCase is a mock implementation of DeleteBuyerChargeUseCase for testing.
+type MockDeleteBuyerChargeUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int) error
+}
+
+// Execute executes the use case logic.
+func (m *MockDeleteBuyerChargeUseCase) Execute(ctx context.Context, id int) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id)
+	}
+	return nil
+}
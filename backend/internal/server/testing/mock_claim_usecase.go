@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
+)
+
+// MockFileClaimUseCase is a mock implementation of FileClaimUseCase for testing.
+type MockFileClaimUseCase struct {
+	ExecuteFunc func(ctx context.Context, buyerID int, input claim.FileClaimInput) (*model.Claim, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockFileClaimUseCase) Execute(ctx context.Context, buyerID int, input claim.FileClaimInput) (*model.Claim, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, buyerID, input)
+	}
+	return nil, nil
+}
+
+// MockListClaimsUseCase is a mock implementation of ListClaimsUseCase for testing.
+type MockListClaimsUseCase struct {
+	ExecuteFunc func(ctx context.Context, filters *repository.ClaimFilters) ([]model.Claim, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListClaimsUseCase) Execute(ctx context.Context, filters *repository.ClaimFilters) ([]model.Claim, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, filters)
+	}
+	return nil, nil
+}
+
+// MockGetClaimUseCase is a mock implementation of GetClaimUseCase for testing.
+type MockGetClaimUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int) (*model.Claim, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGetClaimUseCase) Execute(ctx context.Context, id int) (*model.Claim, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// MockApproveClaimUseCase is a mock implementation of ApproveClaimUseCase for testing.
+type MockApproveClaimUseCase struct {
+	ExecuteFunc func(ctx context.Context, id, adminID int, input claim.ApproveClaimInput) (*model.Claim, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockApproveClaimUseCase) Execute(ctx context.Context, id, adminID int, input claim.ApproveClaimInput) (*model.Claim, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, adminID, input)
+	}
+	return nil, nil
+}
+
+// MockRejectClaimUseCase is a mock implementation of RejectClaimUseCase for testing.
+type MockRejectClaimUseCase struct {
+	ExecuteFunc func(ctx context.Context, id, adminID int, note string) (*model.Claim, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockRejectClaimUseCase) Execute(ctx context.Context, id, adminID int, note string) (*model.Claim, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, adminID, note)
+	}
+	return nil, nil
+}
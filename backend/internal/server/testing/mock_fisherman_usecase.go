@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
 )
 
 // MockCreateFishermanUseCase is a mock implementation of CreateFishermanUseCase for testing.
@@ -44,3 +45,159 @@ func (m *MockDeleteFishermanUseCase) Execute(ctx context.Context, id int) error
 	}
 	return nil
 }
+
+// MockUpdateFishermanUseCase is a mock implementation of UpdateFishermanUseCase for testing.
+type MockUpdateFishermanUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int, input *fisherman.UpdateFishermanInput) (*model.Fisherman, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdateFishermanUseCase) Execute(ctx context.Context, id int, input *fisherman.UpdateFishermanInput) (*model.Fisherman, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, input)
+	}
+	return nil, nil
+}
+
+// MockUpdateBankAccountUseCase is a mock implementation of UpdateBankAccountUseCase for testing.
+type MockUpdateBankAccountUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int, input *fisherman.UpdateBankAccountInput) (*model.Fisherman, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdateBankAccountUseCase) Execute(ctx context.Context, id int, input *fisherman.UpdateBankAccountInput) (*model.Fisherman, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, input)
+	}
+	return nil, nil
+}
+
+// MockCreateFishermanLoginUseCase is a mock implementation of CreateLoginUseCase for testing.
+type MockCreateFishermanLoginUseCase struct {
+	ExecuteFunc func(ctx context.Context, fishermanID int, email, password string) (*model.FishermanAuthentication, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockCreateFishermanLoginUseCase) Execute(ctx context.Context, fishermanID int, email, password string) (*model.FishermanAuthentication, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, fishermanID, email, password)
+	}
+	return nil, nil
+}
+
+// MockLoginFishermanUseCase is a mock implementation of LoginFishermanUseCase for testing.
+type MockLoginFishermanUseCase struct {
+	ExecuteFunc func(ctx context.Context, email, password string) (*model.Fisherman, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockLoginFishermanUseCase) Execute(ctx context.Context, email, password string) (*model.Fisherman, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, email, password)
+	}
+	return nil, nil
+}
+
+// MockGetFishermanUseCase is a mock implementation of GetFishermanUseCase for testing.
+type MockGetFishermanUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int) (*model.Fisherman, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGetFishermanUseCase) Execute(ctx context.Context, id int) (*model.Fisherman, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// MockListConsignedLotsUseCase is a mock implementation of ListConsignedLotsUseCase for testing.
+type MockListConsignedLotsUseCase struct {
+	ExecuteFunc func(ctx context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListConsignedLotsUseCase) Execute(ctx context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, fishermanID, status)
+	}
+	return nil, nil
+}
+
+// MockListSaleResultsUseCase is a mock implementation of ListSaleResultsUseCase for testing.
+type MockListSaleResultsUseCase struct {
+	ExecuteFunc func(ctx context.Context, fishermanID int) ([]model.ConsignedLot, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListSaleResultsUseCase) Execute(ctx context.Context, fishermanID int) ([]model.ConsignedLot, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, fishermanID)
+	}
+	return nil, nil
+}
+
+// MockListFishermanStatementsUseCase is a mock implementation of ListStatementsUseCase for testing.
+type MockListFishermanStatementsUseCase struct {
+	ExecuteFunc func(ctx context.Context, fishermanID int) ([]model.Settlement, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListFishermanStatementsUseCase) Execute(ctx context.Context, fishermanID int) ([]model.Settlement, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, fishermanID)
+	}
+	return nil, nil
+}
+
+// MockGetFishermanStatementUseCase is a mock implementation of GetStatementUseCase for testing.
+type MockGetFishermanStatementUseCase struct {
+	ExecuteFunc func(ctx context.Context, fishermanID, id int) (*model.Settlement, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGetFishermanStatementUseCase) Execute(ctx context.Context, fishermanID, id int) (*model.Settlement, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, fishermanID, id)
+	}
+	return nil, nil
+}
+
+// MockRequestFishermanPasswordResetUseCase is a mock implementation of RequestPasswordResetUseCase for testing.
+type MockRequestFishermanPasswordResetUseCase struct {
+	ExecuteFunc func(ctx context.Context, email string) error
+}
+
+// Execute executes the use case logic.
+func (m *MockRequestFishermanPasswordResetUseCase) Execute(ctx context.Context, email string) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, email)
+	}
+	return nil
+}
+
+// MockVerifyFishermanResetTokenUseCase is a mock implementation of VerifyResetTokenUseCase for testing.
+type MockVerifyFishermanResetTokenUseCase struct {
+	ExecuteFunc func(ctx context.Context, token string) error
+}
+
+// Execute executes the use case logic.
+func (m *MockVerifyFishermanResetTokenUseCase) Execute(ctx context.Context, token string) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, token)
+	}
+	return nil
+}
+
+// MockResetFishermanPasswordUseCase is a mock implementation of ResetPasswordUseCase for testing.
+type MockResetFishermanPasswordUseCase struct {
+	ExecuteFunc func(ctx context.Context, token, newPassword string) error
+}
+
+// Execute executes the use case logic.
+func (m *MockResetFishermanPasswordUseCase) Execute(ctx context.Context, token, newPassword string) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, token, newPassword)
+	}
+	return nil
+}
@@ -18,3 +18,42 @@ func (m *MockListInvoicesUseCase) Execute(ctx context.Context) ([]model.InvoiceI
 	}
 	return nil, nil
 }
+
+// MockGenerateInvoicesUseCase is a mock implementation of GenerateInvoicesUseCase for testing.
+type MockGenerateInvoicesUseCase struct {
+	ExecuteFunc func(ctx context.Context, auctionID int) ([]model.Invoice, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGenerateInvoicesUseCase) Execute(ctx context.Context, auctionID int) ([]model.Invoice, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, auctionID)
+	}
+	return nil, nil
+}
+
+// MockIssueInvoiceUseCase is a mock implementation of IssueInvoiceUseCase for testing.
+type MockIssueInvoiceUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int) (*model.Invoice, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockIssueInvoiceUseCase) Execute(ctx context.Context, id int) (*model.Invoice, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// MockGetInvoiceUseCase is a mock implementation of GetInvoiceUseCase for testing.
+type MockGetInvoiceUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int) (*model.Invoice, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGetInvoiceUseCase) Execute(ctx context.Context, id int) (*model.Invoice, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id)
+	}
+	return nil, nil
+}
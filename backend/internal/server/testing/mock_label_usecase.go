@@ -0,0 +1,46 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// MockPrintAuctionLabelsUseCase is a mock implementation of PrintAuctionLabelsUseCase for testing.
+type MockPrintAuctionLabelsUseCase struct {
+	ExecuteFunc func(ctx context.Context, auctionID int) ([]byte, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockPrintAuctionLabelsUseCase) Execute(ctx context.Context, auctionID int) ([]byte, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, auctionID)
+	}
+	return nil, nil
+}
+
+// MockPrintItemLabelUseCase is a mock implementation of PrintItemLabelUseCase for testing.
+type MockPrintItemLabelUseCase struct {
+	ExecuteFunc func(ctx context.Context, itemID int) ([]byte, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockPrintItemLabelUseCase) Execute(ctx context.Context, itemID int) ([]byte, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, itemID)
+	}
+	return nil, nil
+}
+
+// MockScanLabelUseCase is a mock implementation of ScanLabelUseCase for testing.
+type MockScanLabelUseCase struct {
+	ExecuteFunc func(ctx context.Context, code string) (*model.LabelScan, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockScanLabelUseCase) Execute(ctx context.Context, code string) (*model.LabelScan, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, code)
+	}
+	return nil, nil
+}
@@ -0,0 +1,59 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// MockRecordPaymentUseCase is a mock implementation of RecordPaymentUseCase for testing.
+type MockRecordPaymentUseCase struct {
+	ExecuteFunc func(ctx context.Context, payment *model.Payment) (*model.Payment, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockRecordPaymentUseCase) Execute(ctx context.Context, payment *model.Payment) (*model.Payment, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, payment)
+	}
+	return nil, nil
+}
+
+// MockGetBuyerBalanceUseCase is a mock implementation of GetBuyerBalanceUseCase for testing.
+type MockGetBuyerBalanceUseCase struct {
+	ExecuteFunc func(ctx context.Context, buyerID int) (*model.BuyerBalance, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGetBuyerBalanceUseCase) Execute(ctx context.Context, buyerID int) (*model.BuyerBalance, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, buyerID)
+	}
+	return nil, nil
+}
+
+// MockGetBuyerLedgerUseCase is a mock implementation of GetBuyerLedgerUseCase for testing.
+type MockGetBuyerLedgerUseCase struct {
+	ExecuteFunc func(ctx context.Context, buyerID int) ([]model.LedgerEntry, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGetBuyerLedgerUseCase) Execute(ctx context.Context, buyerID int) ([]model.LedgerEntry, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, buyerID)
+	}
+	return nil, nil
+}
+
+// MockListAgedReceivablesUseCase is a mock implementation of ListAgedReceivablesUseCase for testing.
+type MockListAgedReceivablesUseCase struct {
+	ExecuteFunc func(ctx context.Context) ([]model.AgedReceivable, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListAgedReceivablesUseCase) Execute(ctx context.Context) ([]model.AgedReceivable, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx)
+	}
+	return nil, nil
+}
@@ -24,3 +24,15 @@ func (m *MockRateLimitRepository) IncrementAdminReset(_ context.Context, _ strin
 func (m *MockRateLimitRepository) IncrementBuyerReset(_ context.Context, _ string, _ time.Duration) (int64, error) {
 	return 0, nil
 }
+
+func (m *MockRateLimitRepository) IncrementFishermanLogin(_ context.Context, _ string, _ time.Duration) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockRateLimitRepository) IncrementFishermanReset(_ context.Context, _ string, _ time.Duration) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockRateLimitRepository) IncrementBuyerSignup(_ context.Context, _ string, _ time.Duration) (int64, error) {
+	return 0, nil
+}
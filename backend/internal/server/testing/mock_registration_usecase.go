@@ -0,0 +1,61 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
+)
+
+// MockCreateRegistrationUseCase is a mock implementation of CreateRegistrationUseCase for testing.
+type MockCreateRegistrationUseCase struct {
+	ExecuteFunc func(ctx context.Context, reg *model.VenueRegistration) (*model.VenueRegistration, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockCreateRegistrationUseCase) Execute(ctx context.Context, reg *model.VenueRegistration) (*model.VenueRegistration, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, reg)
+	}
+	return nil, nil
+}
+
+// MockUpdateRegistrationUseCase is a mock implementation of UpdateRegistrationUseCase for testing.
+type MockUpdateRegistrationUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int, input registration.UpdateRegistrationInput) (*model.VenueRegistration, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockUpdateRegistrationUseCase) Execute(ctx context.Context, id int, input registration.UpdateRegistrationInput) (*model.VenueRegistration, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, input)
+	}
+	return nil, nil
+}
+
+// MockListRegistrationsUseCase is a mock implementation of ListRegistrationsUseCase for testing.
+type MockListRegistrationsUseCase struct {
+	ExecuteFunc func(ctx context.Context, filters *repository.VenueRegistrationFilters) ([]model.VenueRegistration, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListRegistrationsUseCase) Execute(ctx context.Context, filters *repository.VenueRegistrationFilters) ([]model.VenueRegistration, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, filters)
+	}
+	return nil, nil
+}
+
+// MockAuthorizeAuctionViewUseCase is a mock implementation of AuthorizeAuctionViewUseCase for testing.
+type MockAuthorizeAuctionViewUseCase struct {
+	ExecuteFunc func(ctx context.Context, buyerID, auctionID int) error
+}
+
+// Execute executes the use case logic.
+func (m *MockAuthorizeAuctionViewUseCase) Execute(ctx context.Context, buyerID, auctionID int) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, buyerID, auctionID)
+	}
+	return nil
+}
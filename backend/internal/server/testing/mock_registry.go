@@ -3,61 +3,154 @@ package testing
 import (
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/registry"
+	"github.com/seka/fish-auction/backend/internal/usecase/accounting"
 	"github.com/seka/fish-auction/backend/internal/usecase/admin"
 	"github.com/seka/fish-auction/backend/internal/usecase/auction"
 	"github.com/seka/fish-auction/backend/internal/usecase/auth"
 	"github.com/seka/fish-auction/backend/internal/usecase/bid"
 	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	"github.com/seka/fish-auction/backend/internal/usecase/charge"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
 	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
 	"github.com/seka/fish-auction/backend/internal/usecase/invoice"
 	"github.com/seka/fish-auction/backend/internal/usecase/item"
+	"github.com/seka/fish-auction/backend/internal/usecase/label"
 	"github.com/seka/fish-auction/backend/internal/usecase/notification"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
+	"github.com/seka/fish-auction/backend/internal/usecase/result"
+	"github.com/seka/fish-auction/backend/internal/usecase/session"
+	"github.com/seka/fish-auction/backend/internal/usecase/settlement"
 	"github.com/seka/fish-auction/backend/internal/usecase/venue"
 )
 
 // MockRegistry is a mock implementation of Registry for testing.
 type MockRegistry struct {
-	CreateItemUC                item.CreateItemUseCase
-	ListItemsUC                 item.ListItemsUseCase
-	UpdateItemUC                item.UpdateItemUseCase
-	DeleteItemUC                item.DeleteItemUseCase
-	UpdateItemSortOrderUC       item.UpdateItemSortOrderUseCase
-	ReorderItemsUC              item.ReorderItemsUseCase
-	CreateBidUC                 bid.CreateBidUseCase
-	CreateBuyerUC               buyer.CreateBuyerUseCase
-	ListBuyersUC                buyer.ListBuyersUseCase
-	CreateFishermanUC           fisherman.CreateFishermanUseCase
-	ListFishermenUC             fisherman.ListFishermenUseCase
-	ListInvoicesUC              invoice.ListInvoicesUseCase
-	LoginUC                     auth.LoginUseCase
-	CreateVenueUC               venue.CreateVenueUseCase
-	ListVenuesUC                venue.ListVenuesUseCase
-	GetVenueUC                  venue.GetVenueUseCase
-	UpdateVenueUC               venue.UpdateVenueUseCase
-	DeleteVenueUC               venue.DeleteVenueUseCase
-	CreateAuctionUC             auction.CreateAuctionUseCase
-	ListAuctionsUC              auction.ListAuctionsUseCase
-	GetAuctionUC                auction.GetAuctionUseCase
-	GetAuctionItemsUC           auction.GetAuctionItemsUseCase
-	UpdateAuctionUC             auction.UpdateAuctionUseCase
-	UpdateAuctionStatusUC       auction.UpdateAuctionStatusUseCase
-	DeleteAuctionUC             auction.DeleteAuctionUseCase
-	LoginBuyerUC                buyer.LoginBuyerUseCase
-	GetBuyerPurchasesUC         buyer.GetBuyerPurchasesUseCase
-	GetBuyerAuctionsUC          buyer.GetBuyerAuctionsUseCase
-	UpdateBuyerPasswordUC       buyer.UpdatePasswordUseCase
-	UpdateAdminPasswordUC       admin.UpdatePasswordUseCase
-	GetBuyerUC                  buyer.GetBuyerUseCase
-	RequestPasswordResetUC      auth.RequestPasswordResetUseCase
-	ResetPasswordUC             auth.ResetPasswordUseCase
-	VerifyResetTokenUC          auth.VerifyResetTokenUseCase
-	VerifyAdminResetTokenUC     admin.VerifyResetTokenUseCase
-	RequestAdminPasswordResetUC admin.RequestPasswordResetUseCase
-	ResetAdminPasswordUC        admin.ResetPasswordUseCase
-	DeleteFishermanUC           fisherman.DeleteFishermanUseCase
-	DeleteBuyerUC               buyer.DeleteBuyerUseCase
-	SubscribeNotificationUC     notification.SubscribeNotificationUseCase
-	CreateAdminUC               admin.CreateAdminUseCase
+	CreateItemUC                          item.CreateItemUseCase
+	ListItemsUC                           item.ListItemsUseCase
+	UpdateItemUC                          item.UpdateItemUseCase
+	DeleteItemUC                          item.DeleteItemUseCase
+	UpdateItemSortOrderUC                 item.UpdateItemSortOrderUseCase
+	ReorderItemsUC                        item.ReorderItemsUseCase
+	CreateBidUC                           bid.CreateBidUseCase
+	CreateBuyerUC                         buyer.CreateBuyerUseCase
+	ListBuyersUC                          buyer.ListBuyersUseCase
+	CreateFishermanUC                     fisherman.CreateFishermanUseCase
+	ListFishermenUC                       fisherman.ListFishermenUseCase
+	ListInvoicesUC                        invoice.ListInvoicesUseCase
+	LoginUC                               auth.LoginUseCase
+	CreateVenueUC                         venue.CreateVenueUseCase
+	ListVenuesUC                          venue.ListVenuesUseCase
+	GetVenueUC                            venue.GetVenueUseCase
+	UpdateVenueUC                         venue.UpdateVenueUseCase
+	DeleteVenueUC                         venue.DeleteVenueUseCase
+	CreateAuctionUC                       auction.CreateAuctionUseCase
+	ListAuctionsUC                        auction.ListAuctionsUseCase
+	GetAuctionUC                          auction.GetAuctionUseCase
+	GetAuctionItemsUC                     auction.GetAuctionItemsUseCase
+	UpdateAuctionUC                       auction.UpdateAuctionUseCase
+	UpdateAuctionStatusUC                 auction.UpdateAuctionStatusUseCase
+	DeleteAuctionUC                       auction.DeleteAuctionUseCase
+	LoginBuyerUC                          buyer.LoginBuyerUseCase
+	GetBuyerPurchasesUC                   buyer.GetBuyerPurchasesUseCase
+	GetBuyerAuctionsUC                    buyer.GetBuyerAuctionsUseCase
+	UpdateBuyerPasswordUC                 buyer.UpdatePasswordUseCase
+	UpdateAdminPasswordUC                 admin.UpdatePasswordUseCase
+	GetBuyerUC                            buyer.GetBuyerUseCase
+	RequestPasswordResetUC                auth.RequestPasswordResetUseCase
+	ResetPasswordUC                       auth.ResetPasswordUseCase
+	VerifyResetTokenUC                    auth.VerifyResetTokenUseCase
+	VerifyAdminResetTokenUC               admin.VerifyResetTokenUseCase
+	RequestAdminPasswordResetUC           admin.RequestPasswordResetUseCase
+	ResetAdminPasswordUC                  admin.ResetPasswordUseCase
+	DeleteFishermanUC                     fisherman.DeleteFishermanUseCase
+	DeleteBuyerUC                         buyer.DeleteBuyerUseCase
+	SubscribeNotificationUC               notification.SubscribeNotificationUseCase
+	CreateAdminUC                         admin.CreateAdminUseCase
+	GenerateInvoicesUC                    invoice.GenerateInvoicesUseCase
+	IssueInvoiceUC                        invoice.IssueInvoiceUseCase
+	GetInvoiceUC                          invoice.GetInvoiceUseCase
+	RecordPaymentUC                       payment.RecordPaymentUseCase
+	GetBuyerBalanceUC                     payment.GetBuyerBalanceUseCase
+	GetBuyerLedgerUC                      payment.GetBuyerLedgerUseCase
+	ListAgedReceivablesUC                 payment.ListAgedReceivablesUseCase
+	UpdateFishermanBankAccountUC          fisherman.UpdateBankAccountUseCase
+	GenerateSettlementsUC                 settlement.GenerateSettlementsUseCase
+	SettleStatementUC                     settlement.SettleStatementUseCase
+	ListSettlementsUC                     settlement.ListSettlementsUseCase
+	ExportTransferFileUC                  settlement.ExportTransferFileUseCase
+	GetAccountingSettingsUC               accounting.GetAccountingSettingsUseCase
+	UpdateAccountingSettingsUC            accounting.UpdateAccountingSettingsUseCase
+	ExportJournalUC                       accounting.ExportJournalUseCase
+	FileClaimUC                           claim.FileClaimUseCase
+	ListClaimsUC                          claim.ListClaimsUseCase
+	GetClaimUC                            claim.GetClaimUseCase
+	ApproveClaimUC                        claim.ApproveClaimUseCase
+	RejectClaimUC                         claim.RejectClaimUseCase
+	CreateChargeItemUC                    charge.CreateChargeItemUseCase
+	UpdateChargeItemUC                    charge.UpdateChargeItemUseCase
+	ListChargeItemsUC                     charge.ListChargeItemsUseCase
+	AddBuyerChargeUC                      charge.AddBuyerChargeUseCase
+	ListBuyerChargesUC                    charge.ListBuyerChargesUseCase
+	DeleteBuyerChargeUC                   charge.DeleteBuyerChargeUseCase
+	PrintAuctionLabelsUC                  label.PrintAuctionLabelsUseCase
+	PrintItemLabelUC                      label.PrintItemLabelUseCase
+	ScanLabelUC                           label.ScanLabelUseCase
+	EnterResultsUC                        result.EnterResultsUseCase
+	RequestCorrectionUC                   result.RequestCorrectionUseCase
+	ApproveCorrectionUC                   result.ApproveCorrectionUseCase
+	RejectCorrectionUC                    result.RejectCorrectionUseCase
+	ListCorrectionsUC                     result.ListCorrectionsUseCase
+	UpdateBuyerPaddleNumberUC             buyer.UpdatePaddleNumberUseCase
+	ListItemBidsUC                        bid.ListItemBidsUseCase
+	ListVisibleItemBidsUC                 bid.ListItemBidsUseCase
+	VoidBidUC                             bid.VoidBidUseCase
+	GetBuyerCreditUC                      buyer.GetCreditUtilizationUseCase
+	UpdateBuyerCreditUC                   buyer.UpdateCreditTermsUseCase
+	CreateVenueRegistrationUC             registration.CreateRegistrationUseCase
+	UpdateVenueRegistrationUC             registration.UpdateRegistrationUseCase
+	ListVenueRegistrationsUC              registration.ListRegistrationsUseCase
+	AuthorizeAuctionViewUC                registration.AuthorizeAuctionViewUseCase
+	SuspendBuyerUC                        buyer.SuspendBuyerUseCase
+	LiftBuyerSuspensionUC                 buyer.LiftSuspensionUseCase
+	ListBuyerSuspensionsUC                buyer.ListSuspensionsUseCase
+	CreateFishermanLoginUC                fisherman.CreateLoginUseCase
+	LoginFishermanUC                      fisherman.LoginFishermanUseCase
+	GetFishermanUC                        fisherman.GetFishermanUseCase
+	ListConsignedLotsUC                   fisherman.ListConsignedLotsUseCase
+	ListSaleResultsUC                     fisherman.ListSaleResultsUseCase
+	ListFishermanStatementsUC             fisherman.ListStatementsUseCase
+	GetFishermanStatementUC               fisherman.GetStatementUseCase
+	RequestFishermanPasswordResetUC       fisherman.RequestPasswordResetUseCase
+	VerifyFishermanResetTokenUC           fisherman.VerifyResetTokenUseCase
+	ResetFishermanPasswordUC              fisherman.ResetPasswordUseCase
+	UpdateFishermanUC                     fisherman.UpdateFishermanUseCase
+	UpdateBuyerUC                         buyer.UpdateBuyerUseCase
+	UpdateBuyerProfileUC                  buyer.UpdateProfileUseCase
+	RequestBuyerEmailChangeUC             buyer.RequestEmailChangeUseCase
+	ConfirmBuyerEmailChangeUC             buyer.ConfirmEmailChangeUseCase
+	RegisterBuyerUC                       buyer.RegisterBuyerUseCase
+	VerifyBuyerRegistrationEmailUC        buyer.VerifyRegistrationEmailUseCase
+	ResendBuyerRegistrationVerificationUC buyer.ResendRegistrationVerificationUseCase
+	ListBuyerApplicationsUC               buyer.ListApplicationsUseCase
+	ApproveBuyerApplicationUC             buyer.ApproveApplicationUseCase
+	RejectBuyerApplicationUC              buyer.RejectApplicationUseCase
+	ListBuyerLoginsUC                     buyer.ListLoginsUseCase
+	CreateBuyerLoginUC                    buyer.CreateLoginUseCase
+	UpdateBuyerLoginUC                    buyer.UpdateLoginUseCase
+	ListAdminsUC                          admin.ListAdminsUseCase
+	UpdateAdminRoleUC                     admin.UpdateRoleUseCase
+	SetAdminDisabledUC                    admin.SetDisabledUseCase
+	UnlockAdminUC                         admin.UnlockUseCase
+	ForceAdminPasswordResetUC             admin.ForcePasswordResetUseCase
+	GetAdminTwoFactorStatusUC             admin.GetTwoFactorStatusUseCase
+	SetupAdminTwoFactorUC                 admin.SetupTwoFactorUseCase
+	EnableAdminTwoFactorUC                admin.EnableTwoFactorUseCase
+	DisableAdminTwoFactorUC               admin.DisableTwoFactorUseCase
+	ResetAdminTwoFactorUC                 admin.ResetTwoFactorUseCase
+	ListSessionsUC                        session.ListSessionsUseCase
+	RevokeSessionUC                       session.RevokeSessionUseCase
+	RevokeSessionsUC                      session.RevokeSessionsUseCase
 }
 
 // NewItemRepository creates a new ItemRepository instance.
@@ -290,5 +383,425 @@ func (m *MockRegistry) NewCreateAdminUseCase() admin.CreateAdminUseCase {
 	return m.CreateAdminUC
 }
 
+// NewGenerateInvoicesUseCase creates a new GenerateInvoicesUseCase instance.
+func (m *MockRegistry) NewGenerateInvoicesUseCase() invoice.GenerateInvoicesUseCase {
+	return m.GenerateInvoicesUC
+}
+
+// NewIssueInvoiceUseCase creates a new IssueInvoiceUseCase instance.
+func (m *MockRegistry) NewIssueInvoiceUseCase() invoice.IssueInvoiceUseCase {
+	return m.IssueInvoiceUC
+}
+
+// NewGetInvoiceUseCase creates a new GetInvoiceUseCase instance.
+func (m *MockRegistry) NewGetInvoiceUseCase() invoice.GetInvoiceUseCase {
+	return m.GetInvoiceUC
+}
+
+// NewRecordPaymentUseCase creates a new RecordPaymentUseCase instance.
+func (m *MockRegistry) NewRecordPaymentUseCase() payment.RecordPaymentUseCase {
+	return m.RecordPaymentUC
+}
+
+// NewGetBuyerBalanceUseCase creates a new GetBuyerBalanceUseCase instance.
+func (m *MockRegistry) NewGetBuyerBalanceUseCase() payment.GetBuyerBalanceUseCase {
+	return m.GetBuyerBalanceUC
+}
+
+// NewGetBuyerLedgerUseCase creates a new GetBuyerLedgerUseCase instance.
+func (m *MockRegistry) NewGetBuyerLedgerUseCase() payment.GetBuyerLedgerUseCase {
+	return m.GetBuyerLedgerUC
+}
+
+// NewListAgedReceivablesUseCase creates a new ListAgedReceivablesUseCase instance.
+func (m *MockRegistry) NewListAgedReceivablesUseCase() payment.ListAgedReceivablesUseCase {
+	return m.ListAgedReceivablesUC
+}
+
+// NewUpdateFishermanBankAccountUseCase creates a new UpdateBankAccountUseCase instance.
+func (m *MockRegistry) NewUpdateFishermanBankAccountUseCase() fisherman.UpdateBankAccountUseCase {
+	return m.UpdateFishermanBankAccountUC
+}
+
+// NewGenerateSettlementsUseCase creates a new GenerateSettlementsUseCase instance.
+func (m *MockRegistry) NewGenerateSettlementsUseCase() settlement.GenerateSettlementsUseCase {
+	return m.GenerateSettlementsUC
+}
+
+// NewSettleStatementUseCase creates a new SettleStatementUseCase instance.
+func (m *MockRegistry) NewSettleStatementUseCase() settlement.SettleStatementUseCase {
+	return m.SettleStatementUC
+}
+
+// NewListSettlementsUseCase creates a new ListSettlementsUseCase instance.
+func (m *MockRegistry) NewListSettlementsUseCase() settlement.ListSettlementsUseCase {
+	return m.ListSettlementsUC
+}
+
+// NewExportTransferFileUseCase creates a new ExportTransferFileUseCase instance.
+func (m *MockRegistry) NewExportTransferFileUseCase() settlement.ExportTransferFileUseCase {
+	return m.ExportTransferFileUC
+}
+
+// NewGetAccountingSettingsUseCase creates a new GetAccountingSettingsUseCase instance.
+func (m *MockRegistry) NewGetAccountingSettingsUseCase() accounting.GetAccountingSettingsUseCase {
+	return m.GetAccountingSettingsUC
+}
+
+// NewUpdateAccountingSettingsUseCase creates a new UpdateAccountingSettingsUseCase instance.
+func (m *MockRegistry) NewUpdateAccountingSettingsUseCase() accounting.UpdateAccountingSettingsUseCase {
+	return m.UpdateAccountingSettingsUC
+}
+
+// NewExportJournalUseCase creates a new ExportJournalUseCase instance.
+func (m *MockRegistry) NewExportJournalUseCase() accounting.ExportJournalUseCase {
+	return m.ExportJournalUC
+}
+
+// NewFileClaimUseCase creates a new FileClaimUseCase instance.
+func (m *MockRegistry) NewFileClaimUseCase() claim.FileClaimUseCase {
+	return m.FileClaimUC
+}
+
+// NewListClaimsUseCase creates a new ListClaimsUseCase instance.
+func (m *MockRegistry) NewListClaimsUseCase() claim.ListClaimsUseCase {
+	return m.ListClaimsUC
+}
+
+// NewGetClaimUseCase creates a new GetClaimUseCase instance.
+func (m *MockRegistry) NewGetClaimUseCase() claim.GetClaimUseCase {
+	return m.GetClaimUC
+}
+
+// NewApproveClaimUseCase creates a new ApproveClaimUseCase instance.
+func (m *MockRegistry) NewApproveClaimUseCase() claim.ApproveClaimUseCase {
+	return m.ApproveClaimUC
+}
+
+// NewRejectClaimUseCase creates a new RejectClaimUseCase instance.
+func (m *MockRegistry) NewRejectClaimUseCase() claim.RejectClaimUseCase {
+	return m.RejectClaimUC
+}
+
+// NewCreateChargeItemUseCase creates a new CreateChargeItemUseCase instance.
+func (m *MockRegistry) NewCreateChargeItemUseCase() charge.CreateChargeItemUseCase {
+	return m.CreateChargeItemUC
+}
+
+// NewUpdateChargeItemUseCase creates a new UpdateChargeItemUseCase instance.
+func (m *MockRegistry) NewUpdateChargeItemUseCase() charge.UpdateChargeItemUseCase {
+	return m.UpdateChargeItemUC
+}
+
+// NewListChargeItemsUseCase creates a new ListChargeItemsUseCase instance.
+func (m *MockRegistry) NewListChargeItemsUseCase() charge.ListChargeItemsUseCase {
+	return m.ListChargeItemsUC
+}
+
+// NewAddBuyerChargeUseCase creates a new AddBuyerChargeUseCase instance.
+func (m *MockRegistry) NewAddBuyerChargeUseCase() charge.AddBuyerChargeUseCase {
+	return m.AddBuyerChargeUC
+}
+
+// NewListBuyerChargesUseCase creates a new ListBuyerChargesUseCase instance.
+func (m *MockRegistry) NewListBuyerChargesUseCase() charge.ListBuyerChargesUseCase {
+	return m.ListBuyerChargesUC
+}
+
+// NewDeleteBuyerChargeUseCase creates a new DeleteBuyerChargeUseCase instance.
+func (m *MockRegistry) NewDeleteBuyerChargeUseCase() charge.DeleteBuyerChargeUseCase {
+	return m.DeleteBuyerChargeUC
+}
+
+// NewPrintAuctionLabelsUseCase creates a new PrintAuctionLabelsUseCase instance.
+func (m *MockRegistry) NewPrintAuctionLabelsUseCase() label.PrintAuctionLabelsUseCase {
+	return m.PrintAuctionLabelsUC
+}
+
+// NewPrintItemLabelUseCase creates a new PrintItemLabelUseCase instance.
+func (m *MockRegistry) NewPrintItemLabelUseCase() label.PrintItemLabelUseCase {
+	return m.PrintItemLabelUC
+}
+
+// NewScanLabelUseCase creates a new ScanLabelUseCase instance.
+func (m *MockRegistry) NewScanLabelUseCase() label.ScanLabelUseCase {
+	return m.ScanLabelUC
+}
+
+// NewEnterResultsUseCase creates a new EnterResultsUseCase instance.
+func (m *MockRegistry) NewEnterResultsUseCase() result.EnterResultsUseCase {
+	return m.EnterResultsUC
+}
+
+// NewRequestCorrectionUseCase creates a new RequestCorrectionUseCase instance.
+func (m *MockRegistry) NewRequestCorrectionUseCase() result.RequestCorrectionUseCase {
+	return m.RequestCorrectionUC
+}
+
+// NewApproveCorrectionUseCase creates a new ApproveCorrectionUseCase instance.
+func (m *MockRegistry) NewApproveCorrectionUseCase() result.ApproveCorrectionUseCase {
+	return m.ApproveCorrectionUC
+}
+
+// NewRejectCorrectionUseCase creates a new RejectCorrectionUseCase instance.
+func (m *MockRegistry) NewRejectCorrectionUseCase() result.RejectCorrectionUseCase {
+	return m.RejectCorrectionUC
+}
+
+// NewListCorrectionsUseCase creates a new ListCorrectionsUseCase instance.
+func (m *MockRegistry) NewListCorrectionsUseCase() result.ListCorrectionsUseCase {
+	return m.ListCorrectionsUC
+}
+
+// NewUpdateBuyerPaddleNumberUseCase creates a new UpdatePaddleNumberUseCase instance.
+func (m *MockRegistry) NewUpdateBuyerPaddleNumberUseCase() buyer.UpdatePaddleNumberUseCase {
+	return m.UpdateBuyerPaddleNumberUC
+}
+
+// NewListItemBidsUseCase creates a new ListItemBidsUseCase instance.
+func (m *MockRegistry) NewListItemBidsUseCase() bid.ListItemBidsUseCase {
+	return m.ListItemBidsUC
+}
+
+// NewListVisibleItemBidsUseCase creates a new ListItemBidsUseCase instance.
+func (m *MockRegistry) NewListVisibleItemBidsUseCase() bid.ListItemBidsUseCase {
+	return m.ListVisibleItemBidsUC
+}
+
+// NewVoidBidUseCase creates a new VoidBidUseCase instance.
+func (m *MockRegistry) NewVoidBidUseCase() bid.VoidBidUseCase {
+	return m.VoidBidUC
+}
+
+// NewGetBuyerCreditUseCase creates a new GetCreditUtilizationUseCase instance.
+func (m *MockRegistry) NewGetBuyerCreditUseCase() buyer.GetCreditUtilizationUseCase {
+	return m.GetBuyerCreditUC
+}
+
+// NewUpdateBuyerCreditUseCase creates a new UpdateCreditTermsUseCase instance.
+func (m *MockRegistry) NewUpdateBuyerCreditUseCase() buyer.UpdateCreditTermsUseCase {
+	return m.UpdateBuyerCreditUC
+}
+
+// NewCreateVenueRegistrationUseCase creates a new CreateRegistrationUseCase instance.
+func (m *MockRegistry) NewCreateVenueRegistrationUseCase() registration.CreateRegistrationUseCase {
+	return m.CreateVenueRegistrationUC
+}
+
+// NewUpdateVenueRegistrationUseCase creates a new UpdateRegistrationUseCase instance.
+func (m *MockRegistry) NewUpdateVenueRegistrationUseCase() registration.UpdateRegistrationUseCase {
+	return m.UpdateVenueRegistrationUC
+}
+
+// NewListVenueRegistrationsUseCase creates a new ListRegistrationsUseCase instance.
+func (m *MockRegistry) NewListVenueRegistrationsUseCase() registration.ListRegistrationsUseCase {
+	return m.ListVenueRegistrationsUC
+}
+
+// NewAuthorizeAuctionViewUseCase creates a new AuthorizeAuctionViewUseCase instance.
+func (m *MockRegistry) NewAuthorizeAuctionViewUseCase() registration.AuthorizeAuctionViewUseCase {
+	return m.AuthorizeAuctionViewUC
+}
+
+// NewSuspendBuyerUseCase creates a new SuspendBuyerUseCase instance.
+func (m *MockRegistry) NewSuspendBuyerUseCase() buyer.SuspendBuyerUseCase {
+	return m.SuspendBuyerUC
+}
+
+// NewLiftBuyerSuspensionUseCase creates a new LiftSuspensionUseCase instance.
+func (m *MockRegistry) NewLiftBuyerSuspensionUseCase() buyer.LiftSuspensionUseCase {
+	return m.LiftBuyerSuspensionUC
+}
+
+// NewListBuyerSuspensionsUseCase creates a new ListSuspensionsUseCase instance.
+func (m *MockRegistry) NewListBuyerSuspensionsUseCase() buyer.ListSuspensionsUseCase {
+	return m.ListBuyerSuspensionsUC
+}
+
+// NewCreateFishermanLoginUseCase creates a new CreateLoginUseCase instance.
+func (m *MockRegistry) NewCreateFishermanLoginUseCase() fisherman.CreateLoginUseCase {
+	return m.CreateFishermanLoginUC
+}
+
+// NewLoginFishermanUseCase creates a new LoginFishermanUseCase instance.
+func (m *MockRegistry) NewLoginFishermanUseCase() fisherman.LoginFishermanUseCase {
+	return m.LoginFishermanUC
+}
+
+// NewGetFishermanUseCase creates a new GetFishermanUseCase instance.
+func (m *MockRegistry) NewGetFishermanUseCase() fisherman.GetFishermanUseCase {
+	return m.GetFishermanUC
+}
+
+// NewListConsignedLotsUseCase creates a new ListConsignedLotsUseCase instance.
+func (m *MockRegistry) NewListConsignedLotsUseCase() fisherman.ListConsignedLotsUseCase {
+	return m.ListConsignedLotsUC
+}
+
+// NewListSaleResultsUseCase creates a new ListSaleResultsUseCase instance.
+func (m *MockRegistry) NewListSaleResultsUseCase() fisherman.ListSaleResultsUseCase {
+	return m.ListSaleResultsUC
+}
+
+// NewListFishermanStatementsUseCase creates a new ListStatementsUseCase instance.
+func (m *MockRegistry) NewListFishermanStatementsUseCase() fisherman.ListStatementsUseCase {
+	return m.ListFishermanStatementsUC
+}
+
+// NewGetFishermanStatementUseCase creates a new GetStatementUseCase instance.
+func (m *MockRegistry) NewGetFishermanStatementUseCase() fisherman.GetStatementUseCase {
+	return m.GetFishermanStatementUC
+}
+
+// NewRequestFishermanPasswordResetUseCase creates a new RequestPasswordResetUseCase instance.
+func (m *MockRegistry) NewRequestFishermanPasswordResetUseCase() fisherman.RequestPasswordResetUseCase {
+	return m.RequestFishermanPasswordResetUC
+}
+
+// NewVerifyFishermanResetTokenUseCase creates a new VerifyResetTokenUseCase instance.
+func (m *MockRegistry) NewVerifyFishermanResetTokenUseCase() fisherman.VerifyResetTokenUseCase {
+	return m.VerifyFishermanResetTokenUC
+}
+
+// NewResetFishermanPasswordUseCase creates a new ResetPasswordUseCase instance.
+func (m *MockRegistry) NewResetFishermanPasswordUseCase() fisherman.ResetPasswordUseCase {
+	return m.ResetFishermanPasswordUC
+}
+
+// NewUpdateFishermanUseCase creates a new UpdateFishermanUseCase instance.
+func (m *MockRegistry) NewUpdateFishermanUseCase() fisherman.UpdateFishermanUseCase {
+	return m.UpdateFishermanUC
+}
+
+// NewUpdateBuyerUseCase creates a new UpdateBuyerUseCase instance.
+func (m *MockRegistry) NewUpdateBuyerUseCase() buyer.UpdateBuyerUseCase {
+	return m.UpdateBuyerUC
+}
+
+// NewUpdateBuyerProfileUseCase creates a new UpdateProfileUseCase instance.
+func (m *MockRegistry) NewUpdateBuyerProfileUseCase() buyer.UpdateProfileUseCase {
+	return m.UpdateBuyerProfileUC
+}
+
+// NewRequestBuyerEmailChangeUseCase creates a new RequestEmailChangeUseCase instance.
+func (m *MockRegistry) NewRequestBuyerEmailChangeUseCase() buyer.RequestEmailChangeUseCase {
+	return m.RequestBuyerEmailChangeUC
+}
+
+// NewConfirmBuyerEmailChangeUseCase creates a new ConfirmEmailChangeUseCase instance.
+func (m *MockRegistry) NewConfirmBuyerEmailChangeUseCase() buyer.ConfirmEmailChangeUseCase {
+	return m.ConfirmBuyerEmailChangeUC
+}
+
+// NewRegisterBuyerUseCase creates a new RegisterBuyerUseCase instance.
+func (m *MockRegistry) NewRegisterBuyerUseCase() buyer.RegisterBuyerUseCase {
+	return m.RegisterBuyerUC
+}
+
+// NewVerifyBuyerRegistrationEmailUseCase creates a new VerifyRegistrationEmailUseCase instance.
+func (m *MockRegistry) NewVerifyBuyerRegistrationEmailUseCase() buyer.VerifyRegistrationEmailUseCase {
+	return m.VerifyBuyerRegistrationEmailUC
+}
+
+// NewResendBuyerRegistrationVerificationUseCase creates a new ResendRegistrationVerificationUseCase instance.
+func (m *MockRegistry) NewResendBuyerRegistrationVerificationUseCase() buyer.ResendRegistrationVerificationUseCase {
+	return m.ResendBuyerRegistrationVerificationUC
+}
+
+// NewListBuyerApplicationsUseCase creates a new ListApplicationsUseCase instance.
+func (m *MockRegistry) NewListBuyerApplicationsUseCase() buyer.ListApplicationsUseCase {
+	return m.ListBuyerApplicationsUC
+}
+
+// NewApproveBuyerApplicationUseCase creates a new ApproveApplicationUseCase instance.
+func (m *MockRegistry) NewApproveBuyerApplicationUseCase() buyer.ApproveApplicationUseCase {
+	return m.ApproveBuyerApplicationUC
+}
+
+// NewRejectBuyerApplicationUseCase creates a new RejectApplicationUseCase instance.
+func (m *MockRegistry) NewRejectBuyerApplicationUseCase() buyer.RejectApplicationUseCase {
+	return m.RejectBuyerApplicationUC
+}
+
+// NewListBuyerLoginsUseCase creates a new ListLoginsUseCase instance.
+func (m *MockRegistry) NewListBuyerLoginsUseCase() buyer.ListLoginsUseCase {
+	return m.ListBuyerLoginsUC
+}
+
+// NewCreateBuyerLoginUseCase creates a new CreateLoginUseCase instance.
+func (m *MockRegistry) NewCreateBuyerLoginUseCase() buyer.CreateLoginUseCase {
+	return m.CreateBuyerLoginUC
+}
+
+// NewUpdateBuyerLoginUseCase creates a new UpdateLoginUseCase instance.
+func (m *MockRegistry) NewUpdateBuyerLoginUseCase() buyer.UpdateLoginUseCase {
+	return m.UpdateBuyerLoginUC
+}
+
+// NewListAdminsUseCase creates a new ListAdminsUseCase instance.
+func (m *MockRegistry) NewListAdminsUseCase() admin.ListAdminsUseCase {
+	return m.ListAdminsUC
+}
+
+// NewUpdateAdminRoleUseCase creates a new UpdateRoleUseCase instance.
+func (m *MockRegistry) NewUpdateAdminRoleUseCase() admin.UpdateRoleUseCase {
+	return m.UpdateAdminRoleUC
+}
+
+// NewSetAdminDisabledUseCase creates a new SetDisabledUseCase instance.
+func (m *MockRegistry) NewSetAdminDisabledUseCase() admin.SetDisabledUseCase {
+	return m.SetAdminDisabledUC
+}
+
+// NewUnlockAdminUseCase creates a new UnlockUseCase instance.
+func (m *MockRegistry) NewUnlockAdminUseCase() admin.UnlockUseCase {
+	return m.UnlockAdminUC
+}
+
+// NewForceAdminPasswordResetUseCase creates a new ForcePasswordResetUseCase instance.
+func (m *MockRegistry) NewForceAdminPasswordResetUseCase() admin.ForcePasswordResetUseCase {
+	return m.ForceAdminPasswordResetUC
+}
+
+// NewGetAdminTwoFactorStatusUseCase creates a new GetTwoFactorStatusUseCase instance.
+func (m *MockRegistry) NewGetAdminTwoFactorStatusUseCase() admin.GetTwoFactorStatusUseCase {
+	return m.GetAdminTwoFactorStatusUC
+}
+
+// NewSetupAdminTwoFactorUseCase creates a new SetupTwoFactorUseCase instance.
+func (m *MockRegistry) NewSetupAdminTwoFactorUseCase() admin.SetupTwoFactorUseCase {
+	return m.SetupAdminTwoFactorUC
+}
+
+// NewEnableAdminTwoFactorUseCase creates a new EnableTwoFactorUseCase instance.
+func (m *MockRegistry) NewEnableAdminTwoFactorUseCase() admin.EnableTwoFactorUseCase {
+	return m.EnableAdminTwoFactorUC
+}
+
+// NewDisableAdminTwoFactorUseCase creates a new DisableTwoFactorUseCase instance.
+func (m *MockRegistry) NewDisableAdminTwoFactorUseCase() admin.DisableTwoFactorUseCase {
+	return m.DisableAdminTwoFactorUC
+}
+
+// NewResetAdminTwoFactorUseCase creates a new ResetTwoFactorUseCase instance.
+func (m *MockRegistry) NewResetAdminTwoFactorUseCase() admin.ResetTwoFactorUseCase {
+	return m.ResetAdminTwoFactorUC
+}
+
+// NewListSessionsUseCase creates a new ListSessionsUseCase instance.
+func (m *MockRegistry) NewListSessionsUseCase() session.ListSessionsUseCase {
+	return m.ListSessionsUC
+}
+
+// NewRevokeSessionUseCase creates a new RevokeSessionUseCase instance.
+func (m *MockRegistry) NewRevokeSessionUseCase() session.RevokeSessionUseCase {
+	return m.RevokeSessionUC
+}
+
+// NewRevokeSessionsUseCase creates a new RevokeSessionsUseCase instance.
+func (m *MockRegistry) NewRevokeSessionsUseCase() session.RevokeSessionsUseCase {
+	return m.RevokeSessionsUC
+}
+
 // Ensure MockRegistry implements registry.UseCase
 var _ registry.UseCase = &MockRegistry{}
@@ -0,0 +1,74 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/usecase/result"
+)
+
+// MockEnterResultsUseCase is a mock implementation of EnterResultsUseCase for testing.
+type MockEnterResultsUseCase struct {
+	ExecuteFunc func(ctx context.Context, adminID, auctionID int, entries []model.ResultEntry) ([]model.Bid, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockEnterResultsUseCase) Execute(ctx context.Context, adminID, auctionID int, entries []model.ResultEntry) ([]model.Bid, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, adminID, auctionID, entries)
+	}
+	return nil, nil
+}
+
+// MockRequestCorrectionUseCase is a mock implementation of RequestCorrectionUseCase for testing.
+type MockRequestCorrectionUseCase struct {
+	ExecuteFunc func(ctx context.Context, adminID, itemID int, input result.RequestCorrectionInput) (*model.ResultCorrection, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockRequestCorrectionUseCase) Execute(ctx context.Context, adminID, itemID int, input result.RequestCorrectionInput) (*model.ResultCorrection, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, adminID, itemID, input)
+	}
+	return nil, nil
+}
+
+// MockApproveCorrectionUseCase is a mock implementation of ApproveCorrectionUseCase for testing.
+type MockApproveCorrectionUseCase struct {
+	ExecuteFunc func(ctx context.Context, id, adminID int, note string) (*model.ResultCorrection, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockApproveCorrectionUseCase) Execute(ctx context.Context, id, adminID int, note string) (*model.ResultCorrection, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, adminID, note)
+	}
+	return nil, nil
+}
+
+// MockRejectCorrectionUseCase is a mock implementation of RejectCorrectionUseCase for testing.
+type MockRejectCorrectionUseCase struct {
+	ExecuteFunc func(ctx context.Context, id, adminID int, note string) (*model.ResultCorrection, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockRejectCorrectionUseCase) Execute(ctx context.Context, id, adminID int, note string) (*model.ResultCorrection, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id, adminID, note)
+	}
+	return nil, nil
+}
+
+// MockListCorrectionsUseCase is a mock implementation of ListCorrectionsUseCase for testing.
+type MockListCorrectionsUseCase struct {
+	ExecuteFunc func(ctx context.Context, filters *repository.ResultCorrectionFilters) ([]model.ResultCorrection, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListCorrectionsUseCase) Execute(ctx context.Context, filters *repository.ResultCorrectionFilters) ([]model.ResultCorrection, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, filters)
+	}
+	return nil, nil
+}
@@ -21,6 +21,11 @@ func (m *MockSessionRepository) Create(ctx context.Context, userID int, role mod
 	if m.CreateFunc != nil {
 		return m.CreateFunc(ctx, userID, role)
 	}
+	return m.CreateForLogin(ctx, userID, 0, role)
+}
+
+// CreateForLogin creates a new record for one login of the user.
+func (m *MockSessionRepository) CreateForLogin(_ context.Context, userID, loginID int, role model.SessionRole) (string, error) {
 
 	sessionID := m.NextSessionID
 	if sessionID == "" {
@@ -31,14 +36,25 @@ func (m *MockSessionRepository) Create(ctx context.Context, userID int, role mod
 	}
 
 	m.Sessions[sessionID] = &model.Session{
-		ID:     sessionID,
-		UserID: userID,
-		Role:   role,
+		ID:      sessionID,
+		UserID:  userID,
+		LoginID: loginID,
+		Role:    role,
 	}
 
 	return sessionID, nil
 }
 
+// CreateForAdmin creates a new admin record carrying the admin's role.
+func (m *MockSessionRepository) CreateForAdmin(ctx context.Context, adminID int, role model.AdminRole) (string, error) {
+	sessionID, err := m.CreateForLogin(ctx, adminID, 0, model.SessionRoleAdmin)
+	if err != nil {
+		return "", err
+	}
+	m.Sessions[sessionID].AdminRole = role
+	return sessionID, nil
+}
+
 // FindByID retrieves a record based on criteria.
 func (m *MockSessionRepository) FindByID(ctx context.Context, sessionID string) (*model.Session, error) {
 	if m.FindByIDFunc != nil {
@@ -50,6 +66,26 @@ func (m *MockSessionRepository) FindByID(ctx context.Context, sessionID string)
 	return m.Sessions[sessionID], nil
 }
 
+// ListByUserID returns the records of the user.
+func (m *MockSessionRepository) ListByUserID(_ context.Context, userID int, role model.SessionRole) ([]model.Session, error) {
+	var sessions []model.Session
+	for _, s := range m.Sessions {
+		if s.UserID == userID && s.Role == role {
+			sessions = append(sessions, *s)
+		}
+	}
+	return sessions, nil
+}
+
+// Touch records the client of the session.
+func (m *MockSessionRepository) Touch(_ context.Context, sessionID, ipAddress, userAgent string) error {
+	if s, ok := m.Sessions[sessionID]; ok {
+		s.IPAddress = ipAddress
+		s.UserAgent = userAgent
+	}
+	return nil
+}
+
 // Delete removes a record by ID.
 func (m *MockSessionRepository) Delete(ctx context.Context, sessionID string) error {
 	if m.DeleteFunc != nil {
@@ -79,3 +115,14 @@ func (m *MockSessionRepository) DeleteAllByUserID(_ context.Context, userID int,
 
 	return nil
 }
+
+// DeleteAllByLoginID removes the records of one login.
+func (m *MockSessionRepository) DeleteAllByLoginID(_ context.Context, userID, loginID int, role model.SessionRole) error {
+	for id, s := range m.Sessions {
+		if s.UserID == userID && s.LoginID == loginID && s.Role == role {
+			delete(m.Sessions, id)
+			m.DeletedSessionIDs = append(m.DeletedSessionIDs, id)
+		}
+	}
+	return nil
+}
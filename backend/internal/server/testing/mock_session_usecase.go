@@ -0,0 +1,46 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// MockListSessionsUseCase is a mock implementation of ListSessionsUseCase for testing.
+type MockListSessionsUseCase struct {
+	ExecuteFunc func(ctx context.Context, owner model.SessionOwner) ([]model.Session, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListSessionsUseCase) Execute(ctx context.Context, owner model.SessionOwner) ([]model.Session, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, owner)
+	}
+	return nil, nil
+}
+
+// MockRevokeSessionUseCase is a mock implementation of RevokeSessionUseCase for testing.
+type MockRevokeSessionUseCase struct {
+	ExecuteFunc func(ctx context.Context, owner model.SessionOwner, publicID string) error
+}
+
+// Execute executes the use case logic.
+func (m *MockRevokeSessionUseCase) Execute(ctx context.Context, owner model.SessionOwner, publicID string) error {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, owner, publicID)
+	}
+	return nil
+}
+
+// MockRevokeSessionsUseCase is a mock implementation of RevokeSessionsUseCase for testing.
+type MockRevokeSessionsUseCase struct {
+	ExecuteFunc func(ctx context.Context, owner model.SessionOwner, keepSessionID string) (int, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockRevokeSessionsUseCase) Execute(ctx context.Context, owner model.SessionOwner, keepSessionID string) (int, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, owner, keepSessionID)
+	}
+	return 0, nil
+}
@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/settlement"
+)
+
+// MockGenerateSettlementsUseCase is a mock implementation of GenerateSettlementsUseCase for testing.
+type MockGenerateSettlementsUseCase struct {
+	ExecuteFunc func(ctx context.Context, auctionID, commissionRate int) ([]model.Settlement, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockGenerateSettlementsUseCase) Execute(ctx context.Context, auctionID, commissionRate int) ([]model.Settlement, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, auctionID, commissionRate)
+	}
+	return nil, nil
+}
+
+// MockSettleStatementUseCase is a mock implementation of SettleStatementUseCase for testing.
+type MockSettleStatementUseCase struct {
+	ExecuteFunc func(ctx context.Context, id int) (*model.Settlement, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockSettleStatementUseCase) Execute(ctx context.Context, id int) (*model.Settlement, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// MockListSettlementsUseCase is a mock implementation of ListSettlementsUseCase for testing.
+type MockListSettlementsUseCase struct {
+	ExecuteFunc func(ctx context.Context, auctionID int) ([]model.Settlement, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockListSettlementsUseCase) Execute(ctx context.Context, auctionID int) ([]model.Settlement, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, auctionID)
+	}
+	return nil, nil
+}
+
+// MockExportTransferFileUseCase is a mock implementation of ExportTransferFileUseCase for testing.
+type MockExportTransferFileUseCase struct {
+	ExecuteFunc func(ctx context.Context, input *settlement.ExportTransferFileInput) ([]byte, error)
+}
+
+// Execute executes the use case logic.
+func (m *MockExportTransferFileUseCase) Execute(ctx context.Context, input *settlement.ExportTransferFileInput) ([]byte, error) {
+	if m.ExecuteFunc != nil {
+		return m.ExecuteFunc(ctx, input)
+	}
+	return nil, nil
+}
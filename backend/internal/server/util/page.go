@@ -0,0 +1,22 @@
+package util
+
+import (
+	"net/http"
+	"strconv"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+)
+
+// ParseBidHistoryQuery reads the cursor and limit query parameters of a bid history request.
+func ParseBidHistoryQuery(r *http.Request) (*model.BidHistoryQuery, error) {
+	limit := 0
+	if s := r.URL.Query().Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, &domainErrors.ValidationError{Field: "limit", Message: "must be a whole number"}
+		}
+		limit = n
+	}
+	return model.NewBidHistoryQuery(r.URL.Query().Get("cursor"), limit)
+}
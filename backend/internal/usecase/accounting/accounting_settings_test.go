@@ -0,0 +1,118 @@
+package accounting_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/accounting"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestGetAccountingSettingsUseCase_Execute(t *testing.T) {
+	venueNotFound := &domainErrors.NotFoundError{Resource: "Venue", ID: 9}
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name      string
+		venueErr  error
+		stored    *model.AccountingSettings
+		findErr   error
+		wantSales string
+		wantErr   error
+	}{
+		{
+			name:      "Configured",
+			stored:    &model.AccountingSettings{VenueID: 1, SalesAccount: "水産物売上"},
+			wantSales: "水産物売上",
+		},
+		{
+			name:      "FallsBackToDefaults",
+			findErr:   &domainErrors.NotFoundError{Resource: "AccountingSettings", ID: 1},
+			wantSales: "売上高",
+		},
+		{name: "VenueNotFound", venueErr: venueNotFound, wantErr: venueNotFound},
+		{name: "RepositoryError", findErr: dbErr, wantErr: dbErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			venueRepo := &mock.MockVenueRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Venue, error) {
+					if tt.venueErr != nil {
+						return nil, tt.venueErr
+					}
+					return &model.Venue{ID: id}, nil
+				},
+			}
+			settingsRepo := &mock.MockAccountingSettingsRepository{
+				FindByVenueIDFunc: func(_ context.Context, _ int) (*model.AccountingSettings, error) {
+					return tt.stored, tt.findErr
+				},
+			}
+
+			uc := accounting.NewGetAccountingSettingsUseCase(venueRepo, settingsRepo)
+			got, err := uc.Execute(context.Background(), 1)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.SalesAccount != tt.wantSales || got.VenueID != 1 {
+				t.Errorf("unexpected settings: %+v", got)
+			}
+		})
+	}
+}
+
+func TestUpdateAccountingSettingsUseCase_Execute(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var upserted *model.AccountingSettings
+		settingsRepo := &mock.MockAccountingSettingsRepository{
+			UpsertFunc: func(_ context.Context, s *model.AccountingSettings) (*model.AccountingSettings, error) {
+				upserted = s
+				return s, nil
+			},
+		}
+		uc := accounting.NewUpdateAccountingSettingsUseCase(&mock.MockVenueRepository{}, settingsRepo)
+
+		in := model.DefaultAccountingSettings(1)
+		in.SalesAccount = " 水産物売上 "
+		if _, err := uc.Execute(context.Background(), in); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if upserted == nil || upserted.SalesAccount != "水産物売上" {
+			t.Errorf("expected normalized settings to be stored, got %+v", upserted)
+		}
+	})
+
+	t.Run("ValidationError", func(t *testing.T) {
+		called := false
+		settingsRepo := &mock.MockAccountingSettingsRepository{
+			UpsertFunc: func(_ context.Context, s *model.AccountingSettings) (*model.AccountingSettings, error) {
+				called = true
+				return s, nil
+			},
+		}
+		uc := accounting.NewUpdateAccountingSettingsUseCase(&mock.MockVenueRepository{}, settingsRepo)
+
+		in := model.DefaultAccountingSettings(1)
+		in.CashAccount = ""
+		_, err := uc.Execute(context.Background(), in)
+
+		var vErr *domainErrors.ValidationError
+		if !errors.As(err, &vErr) || vErr.Field != "cash_account" {
+			t.Fatalf("expected ValidationError on cash_account, got %v", err)
+		}
+		if called {
+			t.Error("expected settings not to be stored")
+		}
+	})
+}
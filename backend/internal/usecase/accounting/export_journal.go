@@ -0,0 +1,99 @@
+package accounting
+
+import (
+	"context"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// maxExportDays はエクスポート期間の上限（1 年分）。
+const maxExportDays = 366
+
+// ExportJournalInput is the input of ExportJournalUseCase. From and To are inclusive calendar dates.
+type ExportJournalInput struct {
+	VenueID int
+	From    time.Time
+	To      time.Time
+	Format  model.JournalFormat
+}
+
+// ExportJournalUseCase defines the interface for exporting journal entries for accounting software.
+type ExportJournalUseCase interface {
+	Execute(ctx context.Context, input *ExportJournalInput) ([]byte, error)
+}
+
+type exportJournalUseCase struct {
+	venueRepo      repository.VenueRepository
+	settingsRepo   repository.AccountingSettingsRepository
+	invoiceRepo    repository.InvoiceRepository
+	creditNoteRepo repository.CreditNoteRepository
+	paymentRepo    repository.PaymentRepository
+	settlementRepo repository.SettlementRepository
+}
+
+var _ ExportJournalUseCase = (*exportJournalUseCase)(nil)
+
+// NewExportJournalUseCase creates a new ExportJournalUseCase instance.
+func NewExportJournalUseCase(
+	venueRepo repository.VenueRepository,
+	settingsRepo repository.AccountingSettingsRepository,
+	invoiceRepo repository.InvoiceRepository,
+	creditNoteRepo repository.CreditNoteRepository,
+	paymentRepo repository.PaymentRepository,
+	settlementRepo repository.SettlementRepository,
+) ExportJournalUseCase {
+	return &exportJournalUseCase{
+		venueRepo:      venueRepo,
+		settingsRepo:   settingsRepo,
+		invoiceRepo:    invoiceRepo,
+		creditNoteRepo: creditNoteRepo,
+		paymentRepo:    paymentRepo,
+		settlementRepo: settlementRepo,
+	}
+}
+
+// Execute converts the venue's issued invoices, credit notes, received payments and settled statements
+// in the period into journal entries and renders them in the requested format.
+func (uc *exportJournalUseCase) Execute(ctx context.Context, input *ExportJournalInput) ([]byte, error) {
+	if !input.Format.IsValid() {
+		return nil, &apperrors.ValidationError{Field: "format", Message: "must be yayoi or freee"}
+	}
+	start, end := model.JournalDayRange(input.From, input.To)
+	if !start.Before(end) {
+		return nil, &apperrors.ValidationError{Field: "to", Message: "must not be before from"}
+	}
+	if end.Sub(start) > maxExportDays*24*time.Hour {
+		return nil, &apperrors.ValidationError{Field: "to", Message: "period must be at most 366 days"}
+	}
+
+	if _, err := uc.venueRepo.FindByID(ctx, input.VenueID); err != nil {
+		return nil, err
+	}
+	accounts, err := loadSettings(ctx, uc.settingsRepo, input.VenueID)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices, err := uc.invoiceRepo.ListIssuedByVenueBetween(ctx, input.VenueID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	creditNotes, err := uc.creditNoteRepo.ListByVenueBetween(ctx, input.VenueID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := uc.paymentRepo.ListReceiptsByVenueBetween(ctx, input.VenueID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	settlements, err := uc.settlementRepo.ListSettledByVenueBetween(ctx, input.VenueID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := model.BuildJournal(invoices, creditNotes, receipts, settlements, accounts)
+	return model.EncodeJournal(input.Format, entries)
+}
@@ -0,0 +1,91 @@
+package accounting_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/accounting"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestExportJournalUseCase_Execute(t *testing.T) {
+	issuedAt := time.Date(2024, 3, 1, 1, 0, 0, 0, time.UTC)
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		input      accounting.ExportJournalInput
+		wantPrefix string
+		wantErr    bool
+	}{
+		{
+			name:       "Freee",
+			input:      accounting.ExportJournalInput{VenueID: 1, From: from, To: to, Format: model.JournalFormatFreee},
+			wantPrefix: "伝票番号,取引日,",
+		},
+		{
+			name:       "Yayoi",
+			input:      accounting.ExportJournalInput{VenueID: 1, From: from, To: to, Format: model.JournalFormatYayoi},
+			wantPrefix: "2000,1,,2024/03/01,",
+		},
+		{
+			name:    "InvalidFormat",
+			input:   accounting.ExportJournalInput{VenueID: 1, From: from, To: to, Format: "csv"},
+			wantErr: true,
+		},
+		{
+			name:    "ToBeforeFrom",
+			input:   accounting.ExportJournalInput{VenueID: 1, From: to, To: from, Format: model.JournalFormatFreee},
+			wantErr: true,
+		},
+		{
+			name:    "PeriodTooLong",
+			input:   accounting.ExportJournalInput{VenueID: 1, From: from, To: from.AddDate(2, 0, 0), Format: model.JournalFormatFreee},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotStart, gotEnd time.Time
+			invoiceRepo := &mock.MockInvoiceRepository{
+				ListIssuedByVenueBetweenFunc: func(_ context.Context, _ int, start, end time.Time) ([]model.Invoice, error) {
+					gotStart, gotEnd = start, end
+					return []model.Invoice{{ID: 1, BuyerName: "B", IssuedAt: &issuedAt, TotalAmount: 1080, Lines: []model.InvoiceLine{{Amount: 1000, TaxRate: model.TaxRateReduced}}}}, nil
+				},
+			}
+			settingsRepo := &mock.MockAccountingSettingsRepository{
+				FindByVenueIDFunc: func(_ context.Context, venueID int) (*model.AccountingSettings, error) {
+					return nil, &domainErrors.NotFoundError{Resource: "AccountingSettings", ID: venueID}
+				},
+			}
+
+			uc := accounting.NewExportJournalUseCase(&mock.MockVenueRepository{}, settingsRepo, invoiceRepo, &mock.MockCreditNoteRepository{}, &mock.MockPaymentRepository{}, &mock.MockSettlementRepository{})
+			got, err := uc.Execute(context.Background(), &tt.input)
+
+			if tt.wantErr {
+				var vErr *domainErrors.ValidationError
+				if !errors.As(err, &vErr) {
+					t.Fatalf("expected ValidationError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !strings.HasPrefix(string(got), tt.wantPrefix) {
+				t.Errorf("expected output to start with %q, got %q", tt.wantPrefix, got)
+			}
+			// 期間は日本時間の 3/1 0:00 から 4/1 0:00 まで
+			if !gotStart.Equal(time.Date(2024, 2, 29, 15, 0, 0, 0, time.UTC)) || !gotEnd.Equal(time.Date(2024, 3, 31, 15, 0, 0, 0, time.UTC)) {
+				t.Errorf("unexpected period %v - %v", gotStart, gotEnd)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package accounting
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// GetAccountingSettingsUseCase defines the interface for getting the accounting settings of a venue.
+type GetAccountingSettingsUseCase interface {
+	Execute(ctx context.Context, venueID int) (*model.AccountingSettings, error)
+}
+
+type getAccountingSettingsUseCase struct {
+	venueRepo    repository.VenueRepository
+	settingsRepo repository.AccountingSettingsRepository
+}
+
+var _ GetAccountingSettingsUseCase = (*getAccountingSettingsUseCase)(nil)
+
+// NewGetAccountingSettingsUseCase creates a new GetAccountingSettingsUseCase instance.
+func NewGetAccountingSettingsUseCase(
+	venueRepo repository.VenueRepository,
+	settingsRepo repository.AccountingSettingsRepository,
+) GetAccountingSettingsUseCase {
+	return &getAccountingSettingsUseCase{venueRepo: venueRepo, settingsRepo: settingsRepo}
+}
+
+// Execute returns the venue's accounting settings, or the defaults when none are configured.
+func (uc *getAccountingSettingsUseCase) Execute(ctx context.Context, venueID int) (*model.AccountingSettings, error) {
+	if _, err := uc.venueRepo.FindByID(ctx, venueID); err != nil {
+		return nil, err
+	}
+	return loadSettings(ctx, uc.settingsRepo, venueID)
+}
+
+// loadSettings falls back to the default account titles for venues without their own settings.
+func loadSettings(ctx context.Context, repo repository.AccountingSettingsRepository, venueID int) (*model.AccountingSettings, error) {
+	settings, err := repo.FindByVenueID(ctx, venueID)
+	var notFound *apperrors.NotFoundError
+	if errors.As(err, &notFound) {
+		return model.DefaultAccountingSettings(venueID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
@@ -0,0 +1,40 @@
+package accounting
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UpdateAccountingSettingsUseCase defines the interface for updating the accounting settings of a venue.
+type UpdateAccountingSettingsUseCase interface {
+	Execute(ctx context.Context, settings *model.AccountingSettings) (*model.AccountingSettings, error)
+}
+
+type updateAccountingSettingsUseCase struct {
+	venueRepo    repository.VenueRepository
+	settingsRepo repository.AccountingSettingsRepository
+}
+
+var _ UpdateAccountingSettingsUseCase = (*updateAccountingSettingsUseCase)(nil)
+
+// NewUpdateAccountingSettingsUseCase creates a new UpdateAccountingSettingsUseCase instance.
+func NewUpdateAccountingSettingsUseCase(
+	venueRepo repository.VenueRepository,
+	settingsRepo repository.AccountingSettingsRepository,
+) UpdateAccountingSettingsUseCase {
+	return &updateAccountingSettingsUseCase{venueRepo: venueRepo, settingsRepo: settingsRepo}
+}
+
+// Execute validates and stores the account titles used for the venue's journal export.
+func (uc *updateAccountingSettingsUseCase) Execute(ctx context.Context, settings *model.AccountingSettings) (*model.AccountingSettings, error) {
+	settings.Normalize()
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := uc.venueRepo.FindByID(ctx, settings.VenueID); err != nil {
+		return nil, err
+	}
+	return uc.settingsRepo.Upsert(ctx, settings)
+}
@@ -0,0 +1,206 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/usecase/admin"
+	usetesting "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+type mockAdminRepositoryForStatus struct {
+	repository.AdminRepository
+	admins        map[int]*model.Admin
+	disabledAt    map[int]*time.Time
+	unlocked      []int
+	resetRequired []int
+	lockedUntil   map[int]*time.Time
+}
+
+func newMockAdminRepositoryForStatus() *mockAdminRepositoryForStatus {
+	past := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	lockedUntil := time.Date(2026, 5, 1, 10, 30, 0, 0, time.UTC)
+	return &mockAdminRepositoryForStatus{
+		admins: map[int]*model.Admin{
+			1: {ID: 1, Email: "boss@example.com", Role: model.AdminRoleSuperAdmin},
+			2: {ID: 2, Email: "clerk@example.com", Role: model.AdminRoleClerk, FailedAttempts: 5, LockedUntil: &lockedUntil},
+			3: {ID: 3, Email: "former@example.com", Role: model.AdminRoleClerk, DisabledAt: &past},
+		},
+		disabledAt:  map[int]*time.Time{},
+		lockedUntil: map[int]*time.Time{},
+	}
+}
+
+func (m *mockAdminRepositoryForStatus) FindByID(_ context.Context, id int) (*model.Admin, error) {
+	if a, ok := m.admins[id]; ok {
+		return a, nil
+	}
+	return nil, &apperrors.NotFoundError{Resource: "Admin", ID: id}
+}
+
+func (m *mockAdminRepositoryForStatus) UpdateDisabledAt(_ context.Context, id int, disabledAt *time.Time) error {
+	m.disabledAt[id] = disabledAt
+	return nil
+}
+
+func (m *mockAdminRepositoryForStatus) IncrementFailedAttempts(_ context.Context, id int) (int, error) {
+	m.admins[id].FailedAttempts++
+	return m.admins[id].FailedAttempts, nil
+}
+
+func (m *mockAdminRepositoryForStatus) LockAccount(_ context.Context, id int, until time.Time) error {
+	m.lockedUntil[id] = &until
+	return nil
+}
+
+func (m *mockAdminRepositoryForStatus) Unlock(_ context.Context, id int) error {
+	m.unlocked = append(m.unlocked, id)
+	return nil
+}
+
+func (m *mockAdminRepositoryForStatus) RequirePasswordReset(_ context.Context, id int) error {
+	m.resetRequired = append(m.resetRequired, id)
+	return nil
+}
+
+func TestSetDisabledUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		actorID     int
+		adminID     int
+		disabled    bool
+		wantErr     bool
+		wantStored  bool
+		wantRevoked bool
+	}{
+		{name: "Disable", actorID: 1, adminID: 2, disabled: true, wantStored: true, wantRevoked: true},
+		{name: "Enable", actorID: 1, adminID: 3, disabled: false, wantStored: true},
+		{name: "AlreadyDisabled", actorID: 1, adminID: 3, disabled: true},
+		{name: "DisableSelf", actorID: 1, adminID: 1, disabled: true, wantErr: true},
+		{name: "NotFound", actorID: 1, adminID: 9, disabled: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockAdminRepositoryForStatus()
+			sessionRepo := &revokingAdminSessionRepo{}
+
+			got, err := admin.NewSetDisabledUseCase(repo, sessionRepo, usetesting.NewMockClock(now)).
+				Execute(context.Background(), tt.actorID, tt.adminID, tt.disabled)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if len(repo.disabledAt) != 0 || len(sessionRepo.revoked) != 0 {
+					t.Error("expected nothing to change")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (got.DisabledAt != nil) != tt.disabled {
+				t.Errorf("expected disabled = %v, got %v", tt.disabled, got.DisabledAt)
+			}
+			stored, ok := repo.disabledAt[tt.adminID]
+			if ok != tt.wantStored {
+				t.Fatalf("expected stored = %v, got %v", tt.wantStored, repo.disabledAt)
+			}
+			if tt.wantStored && tt.disabled && !stored.Equal(now) {
+				t.Errorf("expected disabled at %v, got %v", now, stored)
+			}
+			if tt.wantRevoked != (len(sessionRepo.revoked) == 1 && sessionRepo.revoked[0] == tt.adminID) {
+				t.Errorf("unexpected revoked sessions: %v", sessionRepo.revoked)
+			}
+		})
+	}
+}
+
+func TestUnlockUseCase_Execute(t *testing.T) {
+	repo := newMockAdminRepositoryForStatus()
+
+	got, err := admin.NewUnlockUseCase(repo).Execute(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.LockedUntil != nil || got.FailedAttempts != 0 {
+		t.Errorf("expected the lock to be cleared, got %+v", got)
+	}
+	if len(repo.unlocked) != 1 || repo.unlocked[0] != 2 {
+		t.Errorf("expected admin 2 to be unlocked, got %v", repo.unlocked)
+	}
+
+	_, err = admin.NewUnlockUseCase(repo).Execute(context.Background(), 9)
+	var notFoundErr *apperrors.NotFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestForcePasswordResetUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	frontendURL, _ := url.Parse("https://example.com")
+
+	t.Run("Success", func(t *testing.T) {
+		repo := newMockAdminRepositoryForStatus()
+		sessionRepo := &revokingAdminSessionRepo{}
+		var sentTo, sentURL string
+		var expiresAt time.Time
+		pwdResetRepo := &usetesting.MockPasswordResetRepository{
+			CreateFunc: func(_ context.Context, userID int, role, _ string, e time.Time) error {
+				if userID != 2 || role != "admin" {
+					t.Errorf("unexpected token owner %d (%s)", userID, role)
+				}
+				expiresAt = e
+				return nil
+			},
+		}
+		outboxRepo := &usetesting.MockOutboxRepository{
+			InsertEmailJobFunc: func(_ context.Context, to, u, _ string) error {
+				sentTo, sentURL = to, u
+				return nil
+			},
+		}
+
+		uc := admin.NewForcePasswordResetUseCase(repo, sessionRepo, pwdResetRepo, outboxRepo, frontendURL, &usetesting.MockTransactionManager{}, usetesting.NewMockClock(now))
+		if err := uc.Execute(context.Background(), 1, 2); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(repo.resetRequired) != 1 || repo.resetRequired[0] != 2 {
+			t.Errorf("expected admin 2 to require a reset, got %v", repo.resetRequired)
+		}
+		if sentTo != "clerk@example.com" || !strings.HasPrefix(sentURL, "https://example.com/login/admin/reset_password?token=") {
+			t.Errorf("unexpected reset email to %q: %q", sentTo, sentURL)
+		}
+		if !expiresAt.Equal(now.Add(30 * time.Minute)) {
+			t.Errorf("unexpected expiry %v", expiresAt)
+		}
+		if len(sessionRepo.revoked) != 1 || sessionRepo.revoked[0] != 2 {
+			t.Errorf("expected sessions of admin 2 to be revoked, got %v", sessionRepo.revoked)
+		}
+	})
+
+	t.Run("Self", func(t *testing.T) {
+		repo := newMockAdminRepositoryForStatus()
+		uc := admin.NewForcePasswordResetUseCase(repo, &revokingAdminSessionRepo{}, &usetesting.MockPasswordResetRepository{}, &usetesting.MockOutboxRepository{}, frontendURL, &usetesting.MockTransactionManager{}, usetesting.NewMockClock(now))
+
+		err := uc.Execute(context.Background(), 1, 1)
+		var validationErr *apperrors.ValidationError
+		if !errors.As(err, &validationErr) {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+		if len(repo.resetRequired) != 0 {
+			t.Error("expected nothing to change")
+		}
+	})
+}
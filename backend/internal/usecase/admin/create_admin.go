@@ -12,8 +12,8 @@ import (
 
 // CreateAdminUseCase defines the interface for creating an admin.
 type CreateAdminUseCase interface {
-	// Execute creates a new admin with the given email and password.
-	Execute(ctx context.Context, email, password string) (*model.Admin, error)
+	// Execute creates a new admin with the given email, password and role.
+	Execute(ctx context.Context, email, password string, role model.AdminRole) (*model.Admin, error)
 }
 
 type createAdminUseCase struct {
@@ -27,7 +27,12 @@ func NewCreateAdminUseCase(adminRepo repository.AdminRepository) CreateAdminUseC
 	return &createAdminUseCase{adminRepo: adminRepo}
 }
 
-func (u *createAdminUseCase) Execute(ctx context.Context, email, password string) (*model.Admin, error) {
+func (u *createAdminUseCase) Execute(ctx context.Context, email, password string, role model.AdminRole) (*model.Admin, error) {
+	role, err := model.ParseAdminRole(string(role))
+	if err != nil {
+		return nil, err
+	}
+
 	pwd, err := model.NewPassword(password)
 	if err != nil {
 		return nil, err
@@ -53,6 +58,7 @@ func (u *createAdminUseCase) Execute(ctx context.Context, email, password string
 	admin := &model.Admin{
 		Email:        email,
 		PasswordHash: hashedPassword.Raw(),
+		Role:         role,
 	}
 
 	if err := u.adminRepo.Create(ctx, admin); err != nil {
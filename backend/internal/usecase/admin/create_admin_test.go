@@ -12,6 +12,7 @@ import (
 
 // Mock for CreateAdmin
 type mockAdminRepositoryForCreate struct {
+	created       *model.Admin
 	existingAdmin *model.Admin
 	createErr     error
 	repoErr       error
@@ -29,7 +30,8 @@ func (m *mockAdminRepositoryForCreate) FindOneByEmail(_ context.Context, email s
 	}
 	return nil, nil
 }
-func (m *mockAdminRepositoryForCreate) Create(_ context.Context, _ *model.Admin) error {
+func (m *mockAdminRepositoryForCreate) Create(_ context.Context, a *model.Admin) error {
+	m.created = a
 	return m.createErr
 }
 func (m *mockAdminRepositoryForCreate) Count(_ context.Context) (int, error) {
@@ -47,12 +49,28 @@ func (m *mockAdminRepositoryForCreate) LockAccount(_ context.Context, _ int, _ t
 func (m *mockAdminRepositoryForCreate) UpdateLoginSuccess(_ context.Context, _ int) error {
 	return nil
 }
+func (m *mockAdminRepositoryForCreate) List(_ context.Context) ([]model.Admin, error) {
+	return nil, nil
+}
+func (m *mockAdminRepositoryForCreate) UpdateRole(_ context.Context, _ int, _ model.AdminRole) error {
+	return nil
+}
+func (m *mockAdminRepositoryForCreate) UpdateDisabledAt(_ context.Context, _ int, _ *time.Time) error {
+	return nil
+}
+func (m *mockAdminRepositoryForCreate) Unlock(_ context.Context, _ int) error {
+	return nil
+}
+func (m *mockAdminRepositoryForCreate) RequirePasswordReset(_ context.Context, _ int) error {
+	return nil
+}
 
 func TestCreateAdminUseCase_Execute(t *testing.T) {
 	tests := []struct {
 		name          string
 		email         string
 		password      string
+		role          model.AdminRole
 		existingAdmin *model.Admin
 		repoErr       error
 		createErr     error
@@ -64,6 +82,13 @@ func TestCreateAdminUseCase_Execute(t *testing.T) {
 			password: "NewPassword123!",
 			wantErr:  false,
 		},
+		{
+			name:     "InvalidRole",
+			email:    "new@example.com",
+			password: "NewPassword123!",
+			role:     "owner",
+			wantErr:  true,
+		},
 		{
 			name:          "AlreadyExists",
 			email:         "existing@example.com",
@@ -95,11 +120,18 @@ func TestCreateAdminUseCase_Execute(t *testing.T) {
 				createErr:     tt.createErr,
 			}
 			uc := admin.NewCreateAdminUseCase(repo)
-			_, err := uc.Execute(context.Background(), tt.email, tt.password)
+			role := tt.role
+			if role == "" {
+				role = model.AdminRoleClerk
+			}
+			_, err := uc.Execute(context.Background(), tt.email, tt.password, role)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Execute() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if !tt.wantErr && repo.created.Role != model.AdminRoleClerk {
+				t.Errorf("expected the clerk role to be stored, got %q", repo.created.Role)
+			}
 		})
 	}
 }
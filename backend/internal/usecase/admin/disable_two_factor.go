@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	"github.com/seka/fish-auction/backend/internal/usecase/auth"
+)
+
+// DisableTwoFactorUseCase defines the interface for an admin turning off their own two-factor authentication.
+type DisableTwoFactorUseCase interface {
+	// Execute removes the admin's TOTP enrollment and recovery codes after checking the password and,
+	// once enabled, a current TOTP or recovery code.
+	Execute(ctx context.Context, adminID int, password, code string) error
+}
+
+type disableTwoFactorUseCase struct {
+	adminRepo     repository.AdminRepository
+	twoFactorRepo repository.AdminTwoFactorRepository
+	clock         service.Clock
+	totpKey       []byte
+}
+
+var _ DisableTwoFactorUseCase = (*disableTwoFactorUseCase)(nil)
+
+// NewDisableTwoFactorUseCase creates a new DisableTwoFactorUseCase instance.
+func NewDisableTwoFactorUseCase(
+	adminRepo repository.AdminRepository,
+	twoFactorRepo repository.AdminTwoFactorRepository,
+	clock service.Clock,
+	totpKey []byte,
+) DisableTwoFactorUseCase {
+	return &disableTwoFactorUseCase{
+		adminRepo:     adminRepo,
+		twoFactorRepo: twoFactorRepo,
+		clock:         clock,
+		totpKey:       totpKey,
+	}
+}
+
+// Execute removes the enrollment.
+// 離席中の画面や盗まれたセッションから無効化されないよう、パスワードと現在のコードを求め、
+// 誤りはログインと同じくロックアウトの試行回数に数える。
+func (uc *disableTwoFactorUseCase) Execute(ctx context.Context, adminID int, password, code string) error {
+	admin, err := uc.adminRepo.FindByID(ctx, adminID)
+	if err != nil {
+		return fmt.Errorf("failed to find admin: %w", err)
+	}
+	if admin.IsLocked(uc.clock.Now()) {
+		return &apperrors.UnauthorizedError{Message: "account is locked due to too many failed attempts"}
+	}
+	if err := model.NewHashedPassword(admin.PasswordHash).Verify(password); err != nil {
+		return auth.RecordAdminFailedAttempt(ctx, uc.adminRepo, uc.clock, admin, "bad_password", err)
+	}
+	if err := auth.VerifyAdminSecondFactor(ctx, uc.adminRepo, uc.twoFactorRepo, uc.clock, uc.totpKey, admin, code); err != nil {
+		if errors.Is(err, auth.ErrTwoFactorRequired) {
+			return &apperrors.ValidationError{Field: "code", Message: "is required"}
+		}
+		return err
+	}
+	if err := uc.twoFactorRepo.Delete(ctx, adminID); err != nil {
+		return fmt.Errorf("failed to delete two-factor enrollment: %w", err)
+	}
+	return nil
+}
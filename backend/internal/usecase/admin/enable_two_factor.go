@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// EnableTwoFactorUseCase defines the interface for confirming TOTP enrollment.
+type EnableTwoFactorUseCase interface {
+	// Execute enables two-factor authentication and returns the recovery codes in plain text.
+	// The codes are only stored hashed, so this is the only time they can be shown.
+	Execute(ctx context.Context, adminID int, code string) ([]string, error)
+}
+
+type enableTwoFactorUseCase struct {
+	twoFactorRepo repository.AdminTwoFactorRepository
+	txMgr         repository.TransactionManager
+	clock         service.Clock
+	totpKey       []byte
+}
+
+var _ EnableTwoFactorUseCase = (*enableTwoFactorUseCase)(nil)
+
+// NewEnableTwoFactorUseCase creates a new EnableTwoFactorUseCase instance.
+func NewEnableTwoFactorUseCase(
+	twoFactorRepo repository.AdminTwoFactorRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+	totpKey []byte,
+) EnableTwoFactorUseCase {
+	return &enableTwoFactorUseCase{
+		twoFactorRepo: twoFactorRepo,
+		txMgr:         txMgr,
+		clock:         clock,
+		totpKey:       totpKey,
+	}
+}
+
+// Execute checks a code from the authenticator app against the pending secret and enables it.
+func (uc *enableTwoFactorUseCase) Execute(ctx context.Context, adminID int, code string) ([]string, error) {
+	tf, err := uc.twoFactorRepo.FindByAdminID(ctx, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find two-factor enrollment: %w", err)
+	}
+	if tf == nil {
+		return nil, &apperrors.ValidationError{Field: "code", Message: "start the two-factor setup first"}
+	}
+	if tf.IsEnabled() {
+		return nil, &apperrors.ConflictError{Message: "Two-factor authentication is already enabled"}
+	}
+
+	secret, err := model.OpenTOTPSecret(uc.totpKey, tf.SecretEncrypted, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open TOTP secret: %w", err)
+	}
+	now := uc.clock.Now()
+	step, ok := model.VerifyTOTP(secret, code, now, tf.LastUsedStep)
+	if !ok {
+		return nil, &apperrors.ValidationError{Field: "code", Message: "the code is incorrect. Check the time on your device"}
+	}
+
+	codes, err := model.GenerateRecoveryCodes(model.RecoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, len(codes))
+	for i, c := range codes {
+		hashes[i] = model.HashRecoveryCode(c)
+	}
+
+	if err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.twoFactorRepo.Enable(txCtx, adminID, now, step); err != nil {
+			return err
+		}
+		return uc.twoFactorRepo.ReplaceRecoveryCodes(txCtx, adminID, hashes)
+	}); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
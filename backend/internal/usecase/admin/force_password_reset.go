@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// ForcePasswordResetUseCase defines the interface for forcing an admin to set a new password.
+type ForcePasswordResetUseCase interface {
+	// Execute blocks the admin's login until they reset their password, and emails them a reset link.
+	Execute(ctx context.Context, actorID, adminID int) error
+}
+
+type forcePasswordResetUseCase struct {
+	adminRepo   repository.AdminRepository
+	sessionRepo repository.SessionRepository
+	txMgr       repository.TransactionManager
+	resetLinks  *resetLinkSender
+}
+
+var _ ForcePasswordResetUseCase = (*forcePasswordResetUseCase)(nil)
+
+// NewForcePasswordResetUseCase creates a new ForcePasswordResetUseCase instance.
+func NewForcePasswordResetUseCase(
+	adminRepo repository.AdminRepository,
+	sessionRepo repository.SessionRepository,
+	pwdResetRepo repository.PasswordResetRepository,
+	outboxRepo repository.OutboxRepository,
+	frontendURL *url.URL,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) ForcePasswordResetUseCase {
+	return &forcePasswordResetUseCase{
+		adminRepo:   adminRepo,
+		sessionRepo: sessionRepo,
+		txMgr:       txMgr,
+		resetLinks: &resetLinkSender{
+			pwdResetRepo: pwdResetRepo,
+			outboxRepo:   outboxRepo,
+			frontendURL:  frontendURL,
+			clock:        clock,
+		},
+	}
+}
+
+// Execute forces the reset and signs the admin out everywhere.
+// パスワードが漏れた疑いがあるときに使う。現在のパスワードではログインできなくなり、届いたリンクから再設定するまで締め出す。
+func (uc *forcePasswordResetUseCase) Execute(ctx context.Context, actorID, adminID int) error {
+	if actorID == adminID {
+		return &apperrors.ValidationError{Field: "id", Message: "use the password change instead of resetting your own password"}
+	}
+
+	admin, err := uc.adminRepo.FindByID(ctx, adminID)
+	if err != nil {
+		return fmt.Errorf("failed to find admin: %w", err)
+	}
+
+	if err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.adminRepo.RequirePasswordReset(txCtx, adminID); err != nil {
+			return err
+		}
+		return uc.resetLinks.send(txCtx, admin)
+	}); err != nil {
+		return err
+	}
+
+	if err := uc.sessionRepo.DeleteAllByUserID(ctx, adminID, model.SessionRoleAdmin); err != nil {
+		return fmt.Errorf("failed to invalidate sessions after forced password reset: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// TwoFactorStatus describes an admin's two-factor authentication.
+type TwoFactorStatus struct {
+	Enabled   bool
+	EnabledAt *time.Time
+	// RecoveryCodesRemaining is the number of unused recovery codes.
+	RecoveryCodesRemaining int
+}
+
+// GetTwoFactorStatusUseCase defines the interface for reading an admin's two-factor status.
+type GetTwoFactorStatusUseCase interface {
+	Execute(ctx context.Context, adminID int) (*TwoFactorStatus, error)
+}
+
+type getTwoFactorStatusUseCase struct {
+	twoFactorRepo repository.AdminTwoFactorRepository
+}
+
+var _ GetTwoFactorStatusUseCase = (*getTwoFactorStatusUseCase)(nil)
+
+// NewGetTwoFactorStatusUseCase creates a new GetTwoFactorStatusUseCase instance.
+func NewGetTwoFactorStatusUseCase(twoFactorRepo repository.AdminTwoFactorRepository) GetTwoFactorStatusUseCase {
+	return &getTwoFactorStatusUseCase{twoFactorRepo: twoFactorRepo}
+}
+
+// Execute returns the status. A pending enrollment is reported as disabled.
+func (uc *getTwoFactorStatusUseCase) Execute(ctx context.Context, adminID int) (*TwoFactorStatus, error) {
+	tf, err := uc.twoFactorRepo.FindByAdminID(ctx, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find two-factor enrollment: %w", err)
+	}
+	if tf == nil || !tf.IsEnabled() {
+		return &TwoFactorStatus{}, nil
+	}
+
+	remaining, err := uc.twoFactorRepo.CountUnusedRecoveryCodes(ctx, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count recovery codes: %w", err)
+	}
+	return &TwoFactorStatus{Enabled: true, EnabledAt: tf.EnabledAt, RecoveryCodesRemaining: remaining}, nil
+}
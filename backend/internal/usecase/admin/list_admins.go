@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListAdminsUseCase defines the interface for listing admins.
+type ListAdminsUseCase interface {
+	// Execute returns all admins with their roles.
+	Execute(ctx context.Context) ([]model.Admin, error)
+}
+
+type listAdminsUseCase struct {
+	adminRepo repository.AdminRepository
+}
+
+var _ ListAdminsUseCase = (*listAdminsUseCase)(nil)
+
+// NewListAdminsUseCase creates a new ListAdminsUseCase instance.
+func NewListAdminsUseCase(adminRepo repository.AdminRepository) ListAdminsUseCase {
+	return &listAdminsUseCase{adminRepo: adminRepo}
+}
+
+// Execute lists the admins.
+func (uc *listAdminsUseCase) Execute(ctx context.Context) ([]model.Admin, error) {
+	return uc.adminRepo.List(ctx)
+}
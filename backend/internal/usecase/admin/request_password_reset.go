@@ -3,16 +3,12 @@ package admin
 import (
 	"context"
 	"crypto/rand"
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"net/url"
-	"time"
 
 	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/domain/service"
-	emailMessage "github.com/seka/fish-auction/backend/internal/event"
 )
 
 var randRead = rand.Read
@@ -24,12 +20,9 @@ type RequestPasswordResetUseCase interface {
 }
 
 type requestPasswordResetUseCase struct {
-	adminRepo    repository.AdminRepository
-	pwdResetRepo repository.PasswordResetRepository
-	outboxRepo   repository.OutboxRepository
-	frontendURL  *url.URL
-	txMgr        repository.TransactionManager
-	clock        service.Clock
+	adminRepo  repository.AdminRepository
+	txMgr      repository.TransactionManager
+	resetLinks *resetLinkSender
 }
 
 var _ RequestPasswordResetUseCase = (*requestPasswordResetUseCase)(nil)
@@ -44,12 +37,14 @@ func NewRequestPasswordResetUseCase(
 	clock service.Clock,
 ) RequestPasswordResetUseCase {
 	return &requestPasswordResetUseCase{
-		adminRepo:    adminRepo,
-		pwdResetRepo: pwdResetRepo,
-		outboxRepo:   outboxRepo,
-		frontendURL:  frontendURL,
-		txMgr:        txMgr,
-		clock:        clock,
+		adminRepo: adminRepo,
+		txMgr:     txMgr,
+		resetLinks: &resetLinkSender{
+			pwdResetRepo: pwdResetRepo,
+			outboxRepo:   outboxRepo,
+			frontendURL:  frontendURL,
+			clock:        clock,
+		},
 	}
 }
 
@@ -64,39 +59,7 @@ func (u *requestPasswordResetUseCase) Execute(ctx context.Context, email string)
 		return &apperrors.NotFoundError{Resource: "admin", ID: email}
 	}
 
-	// 1. Generate secure token
-	tokenBytes := make([]byte, 32)
-	if _, err := randRead(tokenBytes); err != nil {
-		return fmt.Errorf("failed to generate secure token: %w", err)
-	}
-	token := hex.EncodeToString(tokenBytes)
-
-	// 2. Hash token for DB
-	hash := sha256.Sum256([]byte(token))
-	tokenHash := hex.EncodeToString(hash[:])
-
-	// 3. Save token and enqueue email in the same transaction
-	resetURL := u.frontendURL.JoinPath("/login/admin/reset_password")
-	q := resetURL.Query()
-	q.Set("token", token)
-	resetURL.RawQuery = q.Encode()
-
-	expiresAt := u.clock.Now().Add(30 * time.Minute)
-	if err := u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
-		if err := u.pwdResetRepo.DeleteAllByUserID(txCtx, admin.ID, "admin"); err != nil {
-			return fmt.Errorf("failed to invalidate old reset tokens: %w", err)
-		}
-		if err = u.pwdResetRepo.Create(txCtx, admin.ID, "admin", tokenHash, expiresAt); err != nil {
-			return fmt.Errorf("failed to create new reset token: %w", err)
-		}
-
-		if err := u.outboxRepo.InsertEmailJob(txCtx, email, resetURL.String(), string(emailMessage.EmailTypeAdminPasswordReset)); err != nil {
-			return fmt.Errorf("failed to insert outbox message: %w", err)
-		}
-		return nil
-	}); err != nil {
-		return err
-	}
-
-	return nil
+	return u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		return u.resetLinks.send(txCtx, admin)
+	})
 }
@@ -48,6 +48,21 @@ func (m *mockAdminRepoForReqPwd) LockAccount(_ context.Context, _ int, _ time.Ti
 func (m *mockAdminRepoForReqPwd) UpdateLoginSuccess(_ context.Context, _ int) error {
 	return nil
 }
+func (m *mockAdminRepoForReqPwd) List(_ context.Context) ([]model.Admin, error) {
+	return nil, nil
+}
+func (m *mockAdminRepoForReqPwd) UpdateRole(_ context.Context, _ int, _ model.AdminRole) error {
+	return nil
+}
+func (m *mockAdminRepoForReqPwd) UpdateDisabledAt(_ context.Context, _ int, _ *time.Time) error {
+	return nil
+}
+func (m *mockAdminRepoForReqPwd) Unlock(_ context.Context, _ int) error {
+	return nil
+}
+func (m *mockAdminRepoForReqPwd) RequirePasswordReset(_ context.Context, _ int) error {
+	return nil
+}
 
 type mockPwdResetRepoForReqPwd struct {
 	mock.Mock
@@ -84,6 +99,10 @@ func (m *mockOutboxRepository) InsertEmailJob(_ context.Context, _, _, _ string)
 	return m.err
 }
 
+func (m *mockOutboxRepository) InsertRejectionEmailJob(_ context.Context, _, _, _ string) error {
+	return nil
+}
+
 func (m *mockOutboxRepository) InsertPushJob(_ context.Context, _ model.JobType, _ int, _, _, _ string) error {
 	return nil
 }
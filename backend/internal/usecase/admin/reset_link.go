@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+)
+
+// resetLinkSender issues a password reset token for an admin and emails the reset link.
+type resetLinkSender struct {
+	pwdResetRepo repository.PasswordResetRepository
+	outboxRepo   repository.OutboxRepository
+	frontendURL  *url.URL
+	clock        service.Clock
+}
+
+// send replaces the admin's earlier reset tokens and enqueues the email. Run it inside a transaction.
+func (s *resetLinkSender) send(ctx context.Context, admin *model.Admin) error {
+	// 1. Generate secure token
+	tokenBytes := make([]byte, 32)
+	if _, err := randRead(tokenBytes); err != nil {
+		return fmt.Errorf("failed to generate secure token: %w", err)
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	// 2. Hash token for DB
+	hash := sha256.Sum256([]byte(token))
+	tokenHash := hex.EncodeToString(hash[:])
+
+	// 3. Save token and enqueue email
+	resetURL := s.frontendURL.JoinPath("/login/admin/reset_password")
+	q := resetURL.Query()
+	q.Set("token", token)
+	resetURL.RawQuery = q.Encode()
+
+	expiresAt := s.clock.Now().Add(30 * time.Minute)
+	if err := s.pwdResetRepo.DeleteAllByUserID(ctx, admin.ID, "admin"); err != nil {
+		return fmt.Errorf("failed to invalidate old reset tokens: %w", err)
+	}
+	if err := s.pwdResetRepo.Create(ctx, admin.ID, "admin", tokenHash, expiresAt); err != nil {
+		return fmt.Errorf("failed to create new reset token: %w", err)
+	}
+	if err := s.outboxRepo.InsertEmailJob(ctx, admin.Email, resetURL.String(), string(emailMessage.EmailTypeAdminPasswordReset)); err != nil {
+		return fmt.Errorf("failed to insert outbox message: %w", err)
+	}
+	return nil
+}
@@ -40,6 +40,21 @@ func (m *mockAdminRepositoryForReset) LockAccount(_ context.Context, _ int, _ ti
 func (m *mockAdminRepositoryForReset) UpdateLoginSuccess(_ context.Context, _ int) error {
 	return nil
 }
+func (m *mockAdminRepositoryForReset) List(_ context.Context) ([]model.Admin, error) {
+	return nil, nil
+}
+func (m *mockAdminRepositoryForReset) UpdateRole(_ context.Context, _ int, _ model.AdminRole) error {
+	return nil
+}
+func (m *mockAdminRepositoryForReset) UpdateDisabledAt(_ context.Context, _ int, _ *time.Time) error {
+	return nil
+}
+func (m *mockAdminRepositoryForReset) Unlock(_ context.Context, _ int) error {
+	return nil
+}
+func (m *mockAdminRepositoryForReset) RequirePasswordReset(_ context.Context, _ int) error {
+	return nil
+}
 
 type mockAdminPasswordResetRepositoryForReset struct {
 	mock.Mock
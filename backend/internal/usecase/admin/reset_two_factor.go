@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ResetTwoFactorUseCase defines the interface for a super admin removing another admin's two-factor authentication.
+type ResetTwoFactorUseCase interface {
+	// Execute removes the admin's TOTP enrollment and recovery codes and signs the admin out everywhere.
+	Execute(ctx context.Context, actorID, adminID int) error
+}
+
+type resetTwoFactorUseCase struct {
+	adminRepo     repository.AdminRepository
+	twoFactorRepo repository.AdminTwoFactorRepository
+	sessionRepo   repository.SessionRepository
+}
+
+var _ ResetTwoFactorUseCase = (*resetTwoFactorUseCase)(nil)
+
+// NewResetTwoFactorUseCase creates a new ResetTwoFactorUseCase instance.
+func NewResetTwoFactorUseCase(
+	adminRepo repository.AdminRepository,
+	twoFactorRepo repository.AdminTwoFactorRepository,
+	sessionRepo repository.SessionRepository,
+) ResetTwoFactorUseCase {
+	return &resetTwoFactorUseCase{
+		adminRepo:     adminRepo,
+		twoFactorRepo: twoFactorRepo,
+		sessionRepo:   sessionRepo,
+	}
+}
+
+// Execute resets the enrollment.
+// 端末を紛失しリカバリーコードも失った管理者を復旧させるために使う。端末が第三者の手に渡った可能性もあるため、既存のセッションも破棄する。
+func (uc *resetTwoFactorUseCase) Execute(ctx context.Context, actorID, adminID int) error {
+	if actorID == adminID {
+		return &apperrors.ValidationError{Field: "id", Message: "turn off your own two-factor authentication from your account settings"}
+	}
+	if _, err := uc.adminRepo.FindByID(ctx, adminID); err != nil {
+		return fmt.Errorf("failed to find admin: %w", err)
+	}
+
+	if err := uc.twoFactorRepo.Delete(ctx, adminID); err != nil {
+		return fmt.Errorf("failed to delete two-factor enrollment: %w", err)
+	}
+	if err := uc.sessionRepo.DeleteAllByUserID(ctx, adminID, model.SessionRoleAdmin); err != nil {
+		return fmt.Errorf("failed to invalidate sessions after two-factor reset: %w", err)
+	}
+	return nil
+}
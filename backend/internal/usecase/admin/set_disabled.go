@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// SetDisabledUseCase defines the interface for disabling and enabling admins.
+type SetDisabledUseCase interface {
+	// Execute disables or enables the admin on behalf of the acting admin.
+	Execute(ctx context.Context, actorID, adminID int, disabled bool) (*model.Admin, error)
+}
+
+type setDisabledUseCase struct {
+	adminRepo   repository.AdminRepository
+	sessionRepo repository.SessionRepository
+	clock       service.Clock
+}
+
+var _ SetDisabledUseCase = (*setDisabledUseCase)(nil)
+
+// NewSetDisabledUseCase creates a new SetDisabledUseCase instance.
+func NewSetDisabledUseCase(
+	adminRepo repository.AdminRepository,
+	sessionRepo repository.SessionRepository,
+	clock service.Clock,
+) SetDisabledUseCase {
+	return &setDisabledUseCase{
+		adminRepo:   adminRepo,
+		sessionRepo: sessionRepo,
+		clock:       clock,
+	}
+}
+
+// Execute updates the admin and, when it is disabled, signs it out everywhere.
+// 退職した管理者は削除せず無効化する。入札の代理入力や訂正の承認者として記録が残っているため。
+func (uc *setDisabledUseCase) Execute(ctx context.Context, actorID, adminID int, disabled bool) (*model.Admin, error) {
+	if actorID == adminID && disabled {
+		return nil, &apperrors.ValidationError{Field: "disabled", Message: "you cannot disable your own account"}
+	}
+
+	admin, err := uc.adminRepo.FindByID(ctx, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find admin: %w", err)
+	}
+	if (admin.DisabledAt != nil) == disabled {
+		return admin, nil
+	}
+
+	admin.DisabledAt = nil
+	if disabled {
+		now := uc.clock.Now()
+		admin.DisabledAt = &now
+	}
+	if err := uc.adminRepo.UpdateDisabledAt(ctx, adminID, admin.DisabledAt); err != nil {
+		return nil, err
+	}
+	if disabled {
+		if err := uc.sessionRepo.DeleteAllByUserID(ctx, adminID, model.SessionRoleAdmin); err != nil {
+			return nil, fmt.Errorf("failed to invalidate sessions of disabled admin: %w", err)
+		}
+	}
+	return admin, nil
+}
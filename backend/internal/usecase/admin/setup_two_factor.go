@@ -0,0 +1,79 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// TwoFactorSetup is what an admin needs to register the TOTP secret in an authenticator app.
+type TwoFactorSetup struct {
+	// Secret is the base32 secret for entering by hand.
+	Secret string
+	// ProvisioningURI is the otpauth:// URI shown as a QR code.
+	ProvisioningURI string
+}
+
+// SetupTwoFactorUseCase defines the interface for starting TOTP enrollment.
+type SetupTwoFactorUseCase interface {
+	// Execute creates a new pending TOTP secret for the admin.
+	Execute(ctx context.Context, adminID int) (*TwoFactorSetup, error)
+}
+
+type setupTwoFactorUseCase struct {
+	adminRepo     repository.AdminRepository
+	twoFactorRepo repository.AdminTwoFactorRepository
+	totpKey       []byte
+}
+
+var _ SetupTwoFactorUseCase = (*setupTwoFactorUseCase)(nil)
+
+// NewSetupTwoFactorUseCase creates a new SetupTwoFactorUseCase instance.
+func NewSetupTwoFactorUseCase(
+	adminRepo repository.AdminRepository,
+	twoFactorRepo repository.AdminTwoFactorRepository,
+	totpKey []byte,
+) SetupTwoFactorUseCase {
+	return &setupTwoFactorUseCase{
+		adminRepo:     adminRepo,
+		twoFactorRepo: twoFactorRepo,
+		totpKey:       totpKey,
+	}
+}
+
+// Execute stores a new secret as pending. It is not required at login until confirmed by EnableTwoFactorUseCase,
+// so an admin who abandons the setup is not locked out. Calling it again replaces the pending secret.
+func (uc *setupTwoFactorUseCase) Execute(ctx context.Context, adminID int) (*TwoFactorSetup, error) {
+	admin, err := uc.adminRepo.FindByID(ctx, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find admin: %w", err)
+	}
+
+	existing, err := uc.twoFactorRepo.FindByAdminID(ctx, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find two-factor enrollment: %w", err)
+	}
+	if existing != nil && existing.IsEnabled() {
+		return nil, &apperrors.ConflictError{Message: "Two-factor authentication is already enabled"}
+	}
+
+	secret, err := model.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := model.SealTOTPSecret(uc.totpKey, secret, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt TOTP secret: %w", err)
+	}
+	if err := uc.twoFactorRepo.SavePending(ctx, adminID, sealed); err != nil {
+		return nil, err
+	}
+
+	return &TwoFactorSetup{
+		Secret:          model.EncodeTOTPSecret(secret),
+		ProvisioningURI: model.TOTPProvisioningURI(secret, admin.Email),
+	}, nil
+}
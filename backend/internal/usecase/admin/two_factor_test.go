@@ -0,0 +1,319 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/admin"
+	usetesting "github.com/seka/fish-auction/backend/internal/usecase/testing"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testTOTPKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestSetupTwoFactorUseCase_Execute(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		var sealed string
+		tfRepo := &usetesting.MockAdminTwoFactorRepository{
+			SavePendingFunc: func(_ context.Context, adminID int, s string) error {
+				if adminID != 2 {
+					t.Errorf("unexpected admin %d", adminID)
+				}
+				sealed = s
+				return nil
+			},
+		}
+
+		got, err := admin.NewSetupTwoFactorUseCase(newMockAdminRepositoryForStatus(), tfRepo, testTOTPKey).
+			Execute(context.Background(), 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		secret, err := model.OpenTOTPSecret(testTOTPKey, sealed, 2)
+		if err != nil {
+			t.Fatalf("expected the stored secret to be sealed for admin 2: %v", err)
+		}
+		if got.Secret != model.EncodeTOTPSecret(secret) {
+			t.Errorf("returned secret %q does not match the stored one", got.Secret)
+		}
+		u, _ := url.Parse(got.ProvisioningURI)
+		if u.Scheme != "otpauth" || u.Path != "/Fish Auction:clerk@example.com" || u.Query().Get("secret") != got.Secret {
+			t.Errorf("unexpected provisioning URI %q", got.ProvisioningURI)
+		}
+	})
+
+	t.Run("AlreadyEnabled", func(t *testing.T) {
+		enabledAt := time.Now()
+		tfRepo := &usetesting.MockAdminTwoFactorRepository{
+			FindByAdminIDFunc: func(_ context.Context, adminID int) (*model.AdminTwoFactor, error) {
+				return &model.AdminTwoFactor{AdminID: adminID, EnabledAt: &enabledAt}, nil
+			},
+			SavePendingFunc: func(_ context.Context, _ int, _ string) error {
+				t.Error("expected the enabled secret not to be replaced")
+				return nil
+			},
+		}
+
+		_, err := admin.NewSetupTwoFactorUseCase(newMockAdminRepositoryForStatus(), tfRepo, testTOTPKey).
+			Execute(context.Background(), 2)
+		var conflictErr *apperrors.ConflictError
+		if !errors.As(err, &conflictErr) {
+			t.Fatalf("expected conflict error, got %v", err)
+		}
+	})
+}
+
+func TestEnableTwoFactorUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	secret := []byte("12345678901234567890")
+	sealed, _ := model.SealTOTPSecret(testTOTPKey, secret, 2)
+	enabledAt := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		tf      *model.AdminTwoFactor
+		code    string
+		wantErr error
+	}{
+		{name: "Success", tf: &model.AdminTwoFactor{AdminID: 2, SecretEncrypted: sealed}, code: model.TOTPCode(secret, now)},
+		{name: "WrongCode", tf: &model.AdminTwoFactor{AdminID: 2, SecretEncrypted: sealed}, code: model.TOTPCode(secret, now.Add(-5*model.TOTPPeriod)), wantErr: &apperrors.ValidationError{}},
+		{name: "NotStarted", code: model.TOTPCode(secret, now), wantErr: &apperrors.ValidationError{}},
+		{name: "AlreadyEnabled", tf: &model.AdminTwoFactor{AdminID: 2, SecretEncrypted: sealed, EnabledAt: &enabledAt}, code: model.TOTPCode(secret, now), wantErr: &apperrors.ConflictError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var enabledStep int64
+			var storedHashes []string
+			tfRepo := &usetesting.MockAdminTwoFactorRepository{
+				FindByAdminIDFunc: func(_ context.Context, _ int) (*model.AdminTwoFactor, error) {
+					return tt.tf, nil
+				},
+				EnableFunc: func(_ context.Context, _ int, at time.Time, step int64) error {
+					if !at.Equal(now) {
+						t.Errorf("unexpected enabled time %v", at)
+					}
+					enabledStep = step
+					return nil
+				},
+				ReplaceRecoveryCodesFunc: func(_ context.Context, _ int, hashes []string) error {
+					storedHashes = hashes
+					return nil
+				},
+			}
+
+			codes, err := admin.NewEnableTwoFactorUseCase(tfRepo, &usetesting.MockTransactionManager{}, usetesting.NewMockClock(now), testTOTPKey).
+				Execute(context.Background(), 2, tt.code)
+
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if enabledStep != 0 || storedHashes != nil {
+					t.Error("expected nothing to be stored")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if enabledStep != model.TOTPStep(now) {
+				t.Errorf("expected the confirming step to be recorded, got %d", enabledStep)
+			}
+			if len(codes) != model.RecoveryCodeCount || len(storedHashes) != len(codes) {
+				t.Fatalf("expected %d recovery codes, got %d (stored %d)", model.RecoveryCodeCount, len(codes), len(storedHashes))
+			}
+			for i, c := range codes {
+				if storedHashes[i] != model.HashRecoveryCode(c) {
+					t.Errorf("recovery code %d is not stored as its hash", i)
+				}
+			}
+		})
+	}
+}
+
+func TestDisableTwoFactorUseCase_Execute(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	now := time.Date(2026, 5, 2, 10, 0, 0, 0, time.UTC)
+	secret, _ := model.GenerateTOTPSecret()
+	sealed, _ := model.SealTOTPSecret(testTOTPKey, secret, 2)
+	enabledAt := now.Add(-24 * time.Hour)
+	lockedUntil := now.Add(10 * time.Minute)
+
+	for _, tt := range []struct {
+		name        string
+		password    string
+		code        string
+		pending     bool
+		attempts    int
+		locked      bool
+		recoveryOK  bool
+		wantErr     error
+		wantCounted bool
+		wantLocked  bool
+	}{
+		{name: "Success", password: "password", code: model.TOTPCode(secret, now)},
+		{name: "RecoveryCode", password: "password", code: "ABCDE-FGHIJ", recoveryOK: true},
+		{name: "PendingEnrollmentNeedsNoCode", password: "password", pending: true},
+		{name: "MissingCode", password: "password", wantErr: &apperrors.ValidationError{}},
+		{name: "WrongPassword", password: "wrong", code: model.TOTPCode(secret, now), wantErr: &apperrors.UnauthorizedError{}, wantCounted: true},
+		{name: "WrongCode", password: "password", code: model.TOTPCode(secret, now.Add(-5*model.TOTPPeriod)), wantErr: &apperrors.UnauthorizedError{}, wantCounted: true},
+		{name: "LocksAfterTooManyFailures", password: "wrong", attempts: 4, wantErr: &apperrors.UnauthorizedError{}, wantCounted: true, wantLocked: true},
+		{name: "Locked", password: "password", code: model.TOTPCode(secret, now), locked: true, wantErr: &apperrors.UnauthorizedError{}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockAdminRepositoryForStatus()
+			repo.admins[2].PasswordHash = string(hash)
+			repo.admins[2].FailedAttempts = tt.attempts
+			repo.admins[2].LockedUntil = nil
+			if tt.locked {
+				repo.admins[2].LockedUntil = &lockedUntil
+			}
+			deleted := false
+			tfRepo := &usetesting.MockAdminTwoFactorRepository{
+				FindByAdminIDFunc: func(_ context.Context, adminID int) (*model.AdminTwoFactor, error) {
+					tf := &model.AdminTwoFactor{AdminID: adminID, SecretEncrypted: sealed, EnabledAt: &enabledAt}
+					if tt.pending {
+						tf.EnabledAt = nil
+					}
+					return tf, nil
+				},
+				AdvanceLastUsedStepFunc: func(_ context.Context, _ int, _ int64) (bool, error) {
+					return true, nil
+				},
+				UseRecoveryCodeFunc: func(_ context.Context, _ int, _ string, _ time.Time) (bool, error) {
+					return tt.recoveryOK, nil
+				},
+				DeleteFunc: func(_ context.Context, _ int) error {
+					deleted = true
+					return nil
+				},
+			}
+
+			err := admin.NewDisableTwoFactorUseCase(repo, tfRepo, usetesting.NewMockClock(now), testTOTPKey).
+				Execute(context.Background(), 2, tt.password, tt.code)
+
+			if counted := repo.admins[2].FailedAttempts > tt.attempts; counted != tt.wantCounted {
+				t.Errorf("expected the failure to be counted toward the lockout: %v, got %v", tt.wantCounted, counted)
+			}
+			if locked := repo.lockedUntil[2] != nil; locked != tt.wantLocked {
+				t.Errorf("expected the account to be locked: %v, got %v", tt.wantLocked, locked)
+			}
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if deleted {
+					t.Error("expected the enrollment to be kept")
+				}
+				return
+			}
+			if err != nil || !deleted {
+				t.Fatalf("expected the enrollment to be deleted, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetTwoFactorStatusUseCase_Execute(t *testing.T) {
+	enabledAt := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	tfRepo := &usetesting.MockAdminTwoFactorRepository{
+		FindByAdminIDFunc: func(_ context.Context, adminID int) (*model.AdminTwoFactor, error) {
+			switch adminID {
+			case 1:
+				return &model.AdminTwoFactor{AdminID: 1, EnabledAt: &enabledAt}, nil
+			case 2:
+				return &model.AdminTwoFactor{AdminID: 2}, nil
+			}
+			return nil, nil
+		},
+		CountUnusedRecoveryCodesFunc: func(_ context.Context, _ int) (int, error) {
+			return 7, nil
+		},
+	}
+	uc := admin.NewGetTwoFactorStatusUseCase(tfRepo)
+
+	got, err := uc.Execute(context.Background(), 1)
+	if err != nil || !got.Enabled || got.RecoveryCodesRemaining != 7 || !got.EnabledAt.Equal(enabledAt) {
+		t.Errorf("unexpected status %+v (%v)", got, err)
+	}
+	for _, id := range []int{2, 3} {
+		got, err = uc.Execute(context.Background(), id)
+		if err != nil || got.Enabled {
+			t.Errorf("expected admin %d to be reported as disabled, got %+v (%v)", id, got, err)
+		}
+	}
+}
+
+func TestResetTwoFactorUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		actorID int
+		adminID int
+		wantErr error
+	}{
+		{name: "Success", actorID: 1, adminID: 2},
+		{name: "Self", actorID: 1, adminID: 1, wantErr: &apperrors.ValidationError{}},
+		{name: "NotFound", actorID: 1, adminID: 9, wantErr: &apperrors.NotFoundError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted []int
+			tfRepo := &usetesting.MockAdminTwoFactorRepository{
+				DeleteFunc: func(_ context.Context, adminID int) error {
+					deleted = append(deleted, adminID)
+					return nil
+				},
+			}
+			sessionRepo := &revokingAdminSessionRepo{}
+
+			err := admin.NewResetTwoFactorUseCase(newMockAdminRepositoryForStatus(), tfRepo, sessionRepo).
+				Execute(context.Background(), tt.actorID, tt.adminID)
+
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if len(deleted) != 0 || len(sessionRepo.revoked) != 0 {
+					t.Error("expected nothing to change")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(deleted) != 1 || deleted[0] != 2 {
+				t.Errorf("expected the enrollment of admin 2 to be deleted, got %v", deleted)
+			}
+			if len(sessionRepo.revoked) != 1 || sessionRepo.revoked[0] != 2 {
+				t.Errorf("expected sessions of admin 2 to be revoked, got %v", sessionRepo.revoked)
+			}
+		})
+	}
+}
+
+// isErrorOfType reports whether err wraps a domain error of the same type as want.
+func isErrorOfType(err, want error) bool {
+	switch want.(type) {
+	case *apperrors.ValidationError:
+		var e *apperrors.ValidationError
+		return errors.As(err, &e)
+	case *apperrors.ConflictError:
+		var e *apperrors.ConflictError
+		return errors.As(err, &e)
+	case *apperrors.NotFoundError:
+		var e *apperrors.NotFoundError
+		return errors.As(err, &e)
+	case *apperrors.UnauthorizedError:
+		var e *apperrors.UnauthorizedError
+		return errors.As(err, &e)
+	}
+	return false
+}
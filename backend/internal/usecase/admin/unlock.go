@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UnlockUseCase defines the interface for lifting a login lockout of an admin.
+type UnlockUseCase interface {
+	// Execute clears the failed login attempts and the lock of the admin.
+	Execute(ctx context.Context, adminID int) (*model.Admin, error)
+}
+
+type unlockUseCase struct {
+	adminRepo repository.AdminRepository
+}
+
+var _ UnlockUseCase = (*unlockUseCase)(nil)
+
+// NewUnlockUseCase creates a new UnlockUseCase instance.
+func NewUnlockUseCase(adminRepo repository.AdminRepository) UnlockUseCase {
+	return &unlockUseCase{adminRepo: adminRepo}
+}
+
+// Execute unlocks the admin so that they need not wait for the lock to expire.
+func (uc *unlockUseCase) Execute(ctx context.Context, adminID int) (*model.Admin, error) {
+	admin, err := uc.adminRepo.FindByID(ctx, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find admin: %w", err)
+	}
+	if err := uc.adminRepo.Unlock(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	admin.FailedAttempts = 0
+	admin.LockedUntil = nil
+	return admin, nil
+}
@@ -50,6 +50,21 @@ func (m *mockAdminRepositoryForUpdate) LockAccount(_ context.Context, _ int, _ t
 func (m *mockAdminRepositoryForUpdate) UpdateLoginSuccess(_ context.Context, _ int) error {
 	return nil
 }
+func (m *mockAdminRepositoryForUpdate) List(_ context.Context) ([]model.Admin, error) {
+	return nil, nil
+}
+func (m *mockAdminRepositoryForUpdate) UpdateRole(_ context.Context, _ int, _ model.AdminRole) error {
+	return nil
+}
+func (m *mockAdminRepositoryForUpdate) UpdateDisabledAt(_ context.Context, _ int, _ *time.Time) error {
+	return nil
+}
+func (m *mockAdminRepositoryForUpdate) Unlock(_ context.Context, _ int) error {
+	return nil
+}
+func (m *mockAdminRepositoryForUpdate) RequirePasswordReset(_ context.Context, _ int) error {
+	return nil
+}
 
 type mockSessionRepo struct {
 	repository.SessionRepository
@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UpdateRoleUseCase defines the interface for changing the role of an admin.
+type UpdateRoleUseCase interface {
+	// Execute gives the admin the role on behalf of the acting admin.
+	Execute(ctx context.Context, actorID, adminID int, role model.AdminRole) (*model.Admin, error)
+}
+
+type updateRoleUseCase struct {
+	adminRepo   repository.AdminRepository
+	sessionRepo repository.SessionRepository
+}
+
+var _ UpdateRoleUseCase = (*updateRoleUseCase)(nil)
+
+// NewUpdateRoleUseCase creates a new UpdateRoleUseCase instance.
+func NewUpdateRoleUseCase(adminRepo repository.AdminRepository, sessionRepo repository.SessionRepository) UpdateRoleUseCase {
+	return &updateRoleUseCase{
+		adminRepo:   adminRepo,
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Execute changes the role and signs the admin out so that the new role applies at once.
+// 自分自身のロールは変更できない。最後の特権管理者が自分を降格して誰も管理者を管理できなくなるのを防ぐため。
+func (uc *updateRoleUseCase) Execute(ctx context.Context, actorID, adminID int, role model.AdminRole) (*model.Admin, error) {
+	role, err := model.ParseAdminRole(string(role))
+	if err != nil {
+		return nil, err
+	}
+	if actorID == adminID {
+		return nil, &apperrors.ValidationError{Field: "role", Message: "you cannot change your own role"}
+	}
+
+	admin, err := uc.adminRepo.FindByID(ctx, adminID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find admin: %w", err)
+	}
+	if admin.Role == role {
+		return admin, nil
+	}
+
+	if err := uc.adminRepo.UpdateRole(ctx, adminID, role); err != nil {
+		return nil, err
+	}
+	// ロールはセッションに保持しているため、既存のセッションを破棄して再ログインさせる
+	if err := uc.sessionRepo.DeleteAllByUserID(ctx, adminID, model.SessionRoleAdmin); err != nil {
+		return nil, fmt.Errorf("failed to invalidate sessions after role change: %w", err)
+	}
+
+	admin.Role = role
+	return admin, nil
+}
@@ -0,0 +1,107 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/usecase/admin"
+)
+
+type mockAdminRepositoryForRole struct {
+	repository.AdminRepository
+	admins  map[int]*model.Admin
+	updated map[int]model.AdminRole
+}
+
+func (m *mockAdminRepositoryForRole) FindByID(_ context.Context, id int) (*model.Admin, error) {
+	if a, ok := m.admins[id]; ok {
+		return a, nil
+	}
+	return nil, &apperrors.NotFoundError{Resource: "Admin", ID: id}
+}
+
+func (m *mockAdminRepositoryForRole) UpdateRole(_ context.Context, id int, role model.AdminRole) error {
+	m.updated[id] = role
+	return nil
+}
+
+type revokingAdminSessionRepo struct {
+	repository.SessionRepository
+	revoked []int
+}
+
+func (m *revokingAdminSessionRepo) DeleteAllByUserID(_ context.Context, userID int, _ model.SessionRole) error {
+	m.revoked = append(m.revoked, userID)
+	return nil
+}
+
+func TestUpdateRoleUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name        string
+		actorID     int
+		adminID     int
+		role        model.AdminRole
+		wantErr     error
+		wantRevoked bool
+	}{
+		{name: "Success", actorID: 1, adminID: 2, role: model.AdminRoleAccountant, wantRevoked: true},
+		{name: "Unchanged", actorID: 1, adminID: 2, role: model.AdminRoleClerk},
+		{name: "OwnRole", actorID: 1, adminID: 1, role: model.AdminRoleReadOnly, wantErr: &apperrors.ValidationError{}},
+		{name: "InvalidRole", actorID: 1, adminID: 2, role: "owner", wantErr: &apperrors.ValidationError{}},
+		{name: "NotFound", actorID: 1, adminID: 9, role: model.AdminRoleClerk, wantErr: &apperrors.NotFoundError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockAdminRepositoryForRole{
+				admins: map[int]*model.Admin{
+					1: {ID: 1, Role: model.AdminRoleSuperAdmin},
+					2: {ID: 2, Role: model.AdminRoleClerk},
+				},
+				updated: map[int]model.AdminRole{},
+			}
+			sessionRepo := &revokingAdminSessionRepo{}
+
+			got, err := admin.NewUpdateRoleUseCase(repo, sessionRepo).Execute(context.Background(), tt.actorID, tt.adminID, tt.role)
+
+			if tt.wantErr != nil {
+				var ok bool
+				switch tt.wantErr.(type) {
+				case *apperrors.ValidationError:
+					var e *apperrors.ValidationError
+					ok = errors.As(err, &e)
+				case *apperrors.NotFoundError:
+					var e *apperrors.NotFoundError
+					ok = errors.As(err, &e)
+				}
+				if !ok {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if len(repo.updated) != 0 || len(sessionRepo.revoked) != 0 {
+					t.Error("expected nothing to change")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Role != tt.role {
+				t.Errorf("expected role %q, got %q", tt.role, got.Role)
+			}
+			if tt.wantRevoked {
+				if repo.updated[tt.adminID] != tt.role {
+					t.Errorf("expected role to be stored, got %v", repo.updated)
+				}
+				if len(sessionRepo.revoked) != 1 || sessionRepo.revoked[0] != tt.adminID {
+					t.Errorf("expected sessions of admin %d to be revoked, got %v", tt.adminID, sessionRepo.revoked)
+				}
+			} else if len(repo.updated) != 0 || len(sessionRepo.revoked) != 0 {
+				t.Error("expected nothing to change for the same role")
+			}
+		})
+	}
+}
@@ -45,6 +45,9 @@ func (m *mockItemRepository) UpdateSortOrder(_ context.Context, _, _ int) error
 func (m *mockItemRepository) Reorder(_ context.Context, _ int, _ []int) error {
 	return nil
 }
+func (m *mockItemRepository) ListConsignedByFishermanID(_ context.Context, _ int, _ *model.AuctionStatus) ([]model.ConsignedLot, error) {
+	return nil, nil
+}
 
 func TestGetAuctionItemsUseCase_Execute(t *testing.T) {
 	items := []model.AuctionItem{
@@ -55,6 +55,24 @@ func (m *mockBuyerRepoForStatusUpdate) FindByName(_ context.Context, _ string) (
 func (m *mockBuyerRepoForStatusUpdate) FindByEmail(_ context.Context, _ string) (*model.Buyer, error) {
 	return nil, nil
 }
+func (m *mockBuyerRepoForStatusUpdate) FindByIDWithLock(_ context.Context, _ int) (*model.Buyer, error) {
+	return nil, nil
+}
+func (m *mockBuyerRepoForStatusUpdate) Update(_ context.Context, _ *model.Buyer) error {
+	return nil
+}
+func (m *mockBuyerRepoForStatusUpdate) UpdatePaddleNumber(_ context.Context, _ int, _ string) error {
+	return nil
+}
+func (m *mockBuyerRepoForStatusUpdate) UpdateCreditTerms(_ context.Context, _ int, _ model.CreditTerms) error {
+	return nil
+}
+func (m *mockBuyerRepoForStatusUpdate) UpdateRegistration(_ context.Context, _ int, _ model.BuyerRegistration) error {
+	return nil
+}
+func (m *mockBuyerRepoForStatusUpdate) ListPendingApplications(_ context.Context) ([]model.BuyerApplication, error) {
+	return nil, nil
+}
 func (m *mockBuyerRepoForStatusUpdate) Delete(_ context.Context, _ int) error { return nil }
 
 func TestUpdateAuctionStatusUseCase_Execute(t *testing.T) {
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// VerifyAdminSecondFactor checks the TOTP or recovery code of an admin with two-factor authentication enabled.
+// It returns ErrTwoFactorRequired when no code was given, and counts a wrong code as a failed attempt.
+// 誤ったコードもパスワード誤りと同じくロックアウトの試行回数に数え、6 桁のコードの総当たりを防ぐ。
+func VerifyAdminSecondFactor(
+	ctx context.Context,
+	adminRepo repository.AdminRepository,
+	twoFactorRepo repository.AdminTwoFactorRepository,
+	clock service.Clock,
+	totpKey []byte,
+	admin *model.Admin,
+	code string,
+) error {
+	tf, err := twoFactorRepo.FindByAdminID(ctx, admin.ID)
+	if err != nil {
+		return fmt.Errorf("failed to find two-factor enrollment: %w", err)
+	}
+	if tf == nil || !tf.IsEnabled() {
+		return nil
+	}
+	if strings.TrimSpace(code) == "" {
+		return ErrTwoFactorRequired
+	}
+
+	now := clock.Now()
+	invalid := &apperrors.UnauthorizedError{Message: "Invalid two-factor authentication code"}
+	if len(strings.TrimSpace(code)) == model.TOTPDigits {
+		secret, err := model.OpenTOTPSecret(totpKey, tf.SecretEncrypted, admin.ID)
+		if err != nil {
+			return fmt.Errorf("failed to open TOTP secret: %w", err)
+		}
+		step, ok := model.VerifyTOTP(secret, code, now, tf.LastUsedStep)
+		if !ok {
+			return RecordAdminFailedAttempt(ctx, adminRepo, clock, admin, "bad_totp_code", invalid)
+		}
+		// 同時に送られた同じコードの二重利用も防ぐため、ステップの更新は条件付きで行う
+		advanced, err := twoFactorRepo.AdvanceLastUsedStep(ctx, admin.ID, step)
+		if err != nil {
+			return fmt.Errorf("failed to record TOTP step: %w", err)
+		}
+		if !advanced {
+			return RecordAdminFailedAttempt(ctx, adminRepo, clock, admin, "reused_totp_code", invalid)
+		}
+		return nil
+	}
+
+	used, err := twoFactorRepo.UseRecoveryCode(ctx, admin.ID, model.HashRecoveryCode(code), now)
+	if err != nil {
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	if !used {
+		return RecordAdminFailedAttempt(ctx, adminRepo, clock, admin, "bad_recovery_code", invalid)
+	}
+	slog.InfoContext(ctx, "auth: admin used a recovery code", "admin_id", admin.ID)
+	return nil
+}
+
+// RecordAdminFailedAttempt counts a failed credential check and locks the account once the limit is reached.
+// It returns the error to report to the client.
+func RecordAdminFailedAttempt(
+	ctx context.Context,
+	adminRepo repository.AdminRepository,
+	clock service.Clock,
+	admin *model.Admin,
+	reason string,
+	cause error,
+) error {
+	newAttempts, incrErr := adminRepo.IncrementFailedAttempts(ctx, admin.ID)
+	if incrErr != nil {
+		slog.ErrorContext(ctx, "auth: failed to increment lockout counter", "err", incrErr)
+	}
+	slog.WarnContext(ctx, "auth: admin credential check failed", "reason", reason, "email", admin.Email, "attempts", newAttempts)
+
+	if newAttempts >= MaxAdminFailedLoginAttempts {
+		lockUntil := clock.Now().Add(AdminAccountLockDuration)
+		if lockErr := adminRepo.LockAccount(ctx, admin.ID, lockUntil); lockErr != nil {
+			slog.ErrorContext(ctx, "auth: failed to lock account", "err", lockErr)
+		}
+		return &apperrors.UnauthorizedError{Message: "account locked due to too many failed attempts"}
+	}
+
+	return cause
+}
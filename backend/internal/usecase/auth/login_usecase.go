@@ -23,27 +23,40 @@ const (
 	AdminAccountLockDuration = 30 * time.Minute
 )
 
+// ErrTwoFactorRequired is returned when the password is correct but the admin has
+// two-factor authentication enabled and no code was given. The client should ask for
+// a code and log in again with it.
+var ErrTwoFactorRequired = errors.New("two-factor authentication code required")
+
 // LoginUseCase defines the interface for user authentication.
 type LoginUseCase interface {
 	// Execute authenticates a user with the provided email and password.
-	Execute(ctx context.Context, email, password string) (*model.Admin, error)
+	// code is the TOTP or recovery code, required only when two-factor authentication is enabled.
+	Execute(ctx context.Context, email, password, code string) (*model.Admin, error)
 }
 
 // loginUseCase handles admin authentication with lockout support.
 type loginUseCase struct {
-	adminRepo repository.AdminRepository
-	clock     service.Clock
+	adminRepo     repository.AdminRepository
+	twoFactorRepo repository.AdminTwoFactorRepository
+	clock         service.Clock
+	totpKey       []byte
 }
 
 var _ LoginUseCase = (*loginUseCase)(nil)
 
 // NewLoginUseCase creates a new instance of LoginUseCase
-func NewLoginUseCase(adminRepo repository.AdminRepository, clock service.Clock) LoginUseCase {
-	return &loginUseCase{adminRepo: adminRepo, clock: clock}
+func NewLoginUseCase(
+	adminRepo repository.AdminRepository,
+	twoFactorRepo repository.AdminTwoFactorRepository,
+	clock service.Clock,
+	totpKey []byte,
+) LoginUseCase {
+	return &loginUseCase{adminRepo: adminRepo, twoFactorRepo: twoFactorRepo, clock: clock, totpKey: totpKey}
 }
 
-// Execute authenticates an admin with the provided email and password.
-func (u *loginUseCase) Execute(ctx context.Context, email, password string) (*model.Admin, error) {
+// Execute authenticates an admin with the provided email, password and, if enabled, second factor.
+func (u *loginUseCase) Execute(ctx context.Context, email, password, code string) (*model.Admin, error) {
 	admin, err := u.adminRepo.FindOneByEmail(ctx, email)
 	if err != nil {
 		var nfErr *apperrors.NotFoundError
@@ -59,7 +72,7 @@ func (u *loginUseCase) Execute(ctx context.Context, email, password string) (*mo
 	}
 
 	now := u.clock.Now()
-	if admin.LockedUntil != nil && now.Before(*admin.LockedUntil) {
+	if admin.IsLocked(now) {
 		slog.WarnContext(ctx, "auth: admin login failed", "reason", "account_locked", "email", email)
 		return nil, &apperrors.UnauthorizedError{Message: "account is locked due to too many failed attempts"}
 	}
@@ -68,20 +81,20 @@ func (u *loginUseCase) Execute(ctx context.Context, email, password string) (*mo
 	// We use HashedPassword which doesn't enforce complexity rules to avoid locking out existing users.
 	hp := model.NewHashedPassword(admin.PasswordHash)
 	if err := hp.Verify(password); err != nil {
-		newAttempts, incrErr := u.adminRepo.IncrementFailedAttempts(ctx, admin.ID)
-		if incrErr != nil {
-			slog.ErrorContext(ctx, "auth: failed to increment lockout counter", "err", incrErr)
-		}
-		slog.WarnContext(ctx, "auth: admin login failed", "reason", "bad_password", "email", email, "attempts", newAttempts)
-
-		if newAttempts >= MaxAdminFailedLoginAttempts {
-			lockUntil := u.clock.Now().Add(AdminAccountLockDuration)
-			if lockErr := u.adminRepo.LockAccount(ctx, admin.ID, lockUntil); lockErr != nil {
-				slog.ErrorContext(ctx, "auth: failed to lock account", "err", lockErr)
-			}
-			return nil, &apperrors.UnauthorizedError{Message: "account locked due to too many failed attempts"}
-		}
+		return nil, RecordAdminFailedAttempt(ctx, u.adminRepo, u.clock, admin, "bad_password", err)
+	}
+
+	// Reject accounts disabled or sent a forced reset by a super admin
+	if admin.DisabledAt != nil {
+		slog.WarnContext(ctx, "auth: admin login failed", "reason", "account_disabled", "email", email)
+		return nil, &apperrors.ForbiddenError{Message: "This account has been disabled"}
+	}
+	if admin.PasswordResetRequired {
+		slog.WarnContext(ctx, "auth: admin login failed", "reason", "password_reset_required", "email", email)
+		return nil, &apperrors.ForbiddenError{Message: "A password reset is required. Use the link sent to your email"}
+	}
 
+	if err := VerifyAdminSecondFactor(ctx, u.adminRepo, u.twoFactorRepo, u.clock, u.totpKey, admin, code); err != nil {
 		return nil, err
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/seka/fish-auction/backend/internal/domain/model"
 	"github.com/seka/fish-auction/backend/internal/domain/service"
 	"github.com/seka/fish-auction/backend/internal/usecase/auth"
+	usetesting "github.com/seka/fish-auction/backend/internal/usecase/testing"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -20,10 +21,13 @@ func (c *mockClock) NowIn(_ model.LocationName) time.Time { return time.Now() }
 
 var _ service.Clock = (*mockClock)(nil)
 
+var testTOTPKey = []byte("0123456789abcdef0123456789abcdef")
+
 type mockAdminRepository struct {
 	admin              *model.Admin
 	err                error
 	failedAttempts     int
+	incrementCalled    bool
 	lockCalled         bool
 	loginSuccessCalled bool
 }
@@ -62,6 +66,7 @@ func (m *mockAdminRepository) UpdatePassword(_ context.Context, _ int, _ string)
 }
 
 func (m *mockAdminRepository) IncrementFailedAttempts(_ context.Context, _ int) (int, error) {
+	m.incrementCalled = true
 	return m.failedAttempts, nil
 }
 
@@ -74,6 +79,21 @@ func (m *mockAdminRepository) UpdateLoginSuccess(_ context.Context, _ int) error
 	m.loginSuccessCalled = true
 	return nil
 }
+func (m *mockAdminRepository) List(_ context.Context) ([]model.Admin, error) {
+	return nil, nil
+}
+func (m *mockAdminRepository) UpdateRole(_ context.Context, _ int, _ model.AdminRole) error {
+	return nil
+}
+func (m *mockAdminRepository) UpdateDisabledAt(_ context.Context, _ int, _ *time.Time) error {
+	return nil
+}
+func (m *mockAdminRepository) Unlock(_ context.Context, _ int) error {
+	return nil
+}
+func (m *mockAdminRepository) RequirePasswordReset(_ context.Context, _ int) error {
+	return nil
+}
 
 func TestLoginUseCase_AccountLocked(t *testing.T) {
 	hash, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
@@ -83,9 +103,9 @@ func TestLoginUseCase_AccountLocked(t *testing.T) {
 		PasswordHash: string(hash),
 		LockedUntil:  &future,
 	}}
-	uc := auth.NewLoginUseCase(repo, &mockClock{})
+	uc := auth.NewLoginUseCase(repo, &usetesting.MockAdminTwoFactorRepository{}, &mockClock{}, testTOTPKey)
 
-	_, err := uc.Execute(context.Background(), "admin@example.com", "password")
+	_, err := uc.Execute(context.Background(), "admin@example.com", "password", "")
 	if err == nil {
 		t.Fatal("expected error for locked account, got nil")
 	}
@@ -103,9 +123,9 @@ func TestLoginUseCase_LockExpired(t *testing.T) {
 		PasswordHash: string(hash),
 		LockedUntil:  &past,
 	}}
-	uc := auth.NewLoginUseCase(repo, &mockClock{})
+	uc := auth.NewLoginUseCase(repo, &usetesting.MockAdminTwoFactorRepository{}, &mockClock{}, testTOTPKey)
 
-	got, err := uc.Execute(context.Background(), "admin@example.com", "password")
+	got, err := uc.Execute(context.Background(), "admin@example.com", "password", "")
 	if err != nil {
 		t.Fatalf("expected no error after lock expiry, got %v", err)
 	}
@@ -120,9 +140,9 @@ func TestLoginUseCase_LockTriggeredAtThreshold(t *testing.T) {
 		admin:          &model.Admin{Email: "admin@example.com", PasswordHash: string(hash)},
 		failedAttempts: auth.MaxAdminFailedLoginAttempts,
 	}
-	uc := auth.NewLoginUseCase(repo, &mockClock{})
+	uc := auth.NewLoginUseCase(repo, &usetesting.MockAdminTwoFactorRepository{}, &mockClock{}, testTOTPKey)
 
-	_, err := uc.Execute(context.Background(), "admin@example.com", "wrong")
+	_, err := uc.Execute(context.Background(), "admin@example.com", "wrong", "")
 	if err == nil {
 		t.Fatal("expected error on wrong password")
 	}
@@ -137,9 +157,9 @@ func TestLoginUseCase_SuccessCallsUpdateLoginSuccess(t *testing.T) {
 		Email:        "admin@example.com",
 		PasswordHash: string(hash),
 	}}
-	uc := auth.NewLoginUseCase(repo, &mockClock{})
+	uc := auth.NewLoginUseCase(repo, &usetesting.MockAdminTwoFactorRepository{}, &mockClock{}, testTOTPKey)
 
-	if _, err := uc.Execute(context.Background(), "admin@example.com", "password"); err != nil {
+	if _, err := uc.Execute(context.Background(), "admin@example.com", "password", ""); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if !repo.loginSuccessCalled {
@@ -147,6 +167,122 @@ func TestLoginUseCase_SuccessCallsUpdateLoginSuccess(t *testing.T) {
 	}
 }
 
+func TestLoginUseCase_AccountBlockedBySuperAdmin(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	disabledAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name  string
+		admin *model.Admin
+	}{
+		{name: "Disabled", admin: &model.Admin{Email: "admin@example.com", PasswordHash: string(hash), DisabledAt: &disabledAt}},
+		{name: "PasswordResetRequired", admin: &model.Admin{Email: "admin@example.com", PasswordHash: string(hash), PasswordResetRequired: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockAdminRepository{admin: tt.admin}
+			uc := auth.NewLoginUseCase(repo, &usetesting.MockAdminTwoFactorRepository{}, &mockClock{}, testTOTPKey)
+
+			_, err := uc.Execute(context.Background(), "admin@example.com", "password", "")
+			var forbidden *apperrors.ForbiddenError
+			if !errors.As(err, &forbidden) {
+				t.Fatalf("expected ForbiddenError, got %T: %v", err, err)
+			}
+			if repo.loginSuccessCalled {
+				t.Error("expected UpdateLoginSuccess not to be called")
+			}
+		})
+	}
+}
+
+func TestLoginUseCase_TwoFactor(t *testing.T) {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	secret, _ := model.GenerateTOTPSecret()
+	sealed, _ := model.SealTOTPSecret(testTOTPKey, secret, 1)
+	enabledAt := now.Add(-24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		code         string
+		pending      bool
+		replayed     bool
+		recoveryOK   bool
+		wantErr      error
+		wantRequired bool
+	}{
+		{name: "NoCode", wantRequired: true},
+		{name: "ValidCode", code: model.TOTPCode(secret, now)},
+		{name: "PreviousStep", code: model.TOTPCode(secret, now.Add(-model.TOTPPeriod))},
+		{name: "WrongCode", code: model.TOTPCode(secret, now.Add(-5*model.TOTPPeriod)), wantErr: &apperrors.UnauthorizedError{}},
+		{name: "ReplayedCode", code: model.TOTPCode(secret, now), replayed: true, wantErr: &apperrors.UnauthorizedError{}},
+		{name: "RecoveryCode", code: "ABCDE-FGHIJ", recoveryOK: true},
+		{name: "UnknownRecoveryCode", code: "abcde-fghij", wantErr: &apperrors.UnauthorizedError{}},
+		{name: "PendingEnrollmentIsNotRequired", pending: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockAdminRepository{admin: &model.Admin{ID: 1, Email: "admin@example.com", PasswordHash: string(hash)}}
+			var advancedTo int64
+			var usedHash string
+			tfRepo := &usetesting.MockAdminTwoFactorRepository{
+				FindByAdminIDFunc: func(_ context.Context, adminID int) (*model.AdminTwoFactor, error) {
+					tf := &model.AdminTwoFactor{AdminID: adminID, SecretEncrypted: sealed, EnabledAt: &enabledAt}
+					if tt.pending {
+						tf.EnabledAt = nil
+					}
+					return tf, nil
+				},
+				AdvanceLastUsedStepFunc: func(_ context.Context, _ int, step int64) (bool, error) {
+					advancedTo = step
+					return !tt.replayed, nil
+				},
+				UseRecoveryCodeFunc: func(_ context.Context, _ int, codeHash string, _ time.Time) (bool, error) {
+					usedHash = codeHash
+					return tt.recoveryOK, nil
+				},
+			}
+			uc := auth.NewLoginUseCase(repo, tfRepo, usetesting.NewMockClock(now), testTOTPKey)
+
+			got, err := uc.Execute(context.Background(), "admin@example.com", "password", tt.code)
+
+			if tt.wantRequired {
+				if !errors.Is(err, auth.ErrTwoFactorRequired) {
+					t.Fatalf("expected ErrTwoFactorRequired, got %v", err)
+				}
+				if repo.incrementCalled {
+					t.Error("a missing code should not count as a failed attempt")
+				}
+				return
+			}
+			if tt.wantErr != nil {
+				var unauth *apperrors.UnauthorizedError
+				if !errors.As(err, &unauth) {
+					t.Fatalf("expected UnauthorizedError, got %T: %v", err, err)
+				}
+				if !repo.incrementCalled {
+					t.Error("expected a wrong code to count as a failed attempt")
+				}
+				if repo.loginSuccessCalled {
+					t.Error("expected UpdateLoginSuccess not to be called")
+				}
+				return
+			}
+			if err != nil || got == nil {
+				t.Fatalf("expected login to succeed, got %v", err)
+			}
+			if tt.recoveryOK && usedHash != model.HashRecoveryCode("abcde-fghij") {
+				t.Errorf("expected the normalized recovery code to be used, got %q", usedHash)
+			}
+			if tt.name == "ValidCode" && advancedTo != model.TOTPStep(now) {
+				t.Errorf("expected the current step to be recorded, got %d", advancedTo)
+			}
+		})
+	}
+}
+
 func TestLoginUseCase_Execute(t *testing.T) {
 	// Generate a valid has for "admin-password"
 	hash, _ := bcrypt.GenerateFromPassword([]byte("admin-password"), bcrypt.MinCost)
@@ -205,9 +341,9 @@ func TestLoginUseCase_Execute(t *testing.T) {
 				admin: tt.mockAdmin,
 				err:   tt.mockErr,
 			}
-			uc := auth.NewLoginUseCase(repo, &mockClock{})
+			uc := auth.NewLoginUseCase(repo, &usetesting.MockAdminTwoFactorRepository{}, &mockClock{}, testTOTPKey)
 
-			gotAdmin, err := uc.Execute(context.Background(), tt.email, tt.password)
+			gotAdmin, err := uc.Execute(context.Background(), tt.email, tt.password, "")
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("expected error=%v, got %v", tt.wantErr, err)
 			}
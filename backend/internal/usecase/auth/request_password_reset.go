@@ -25,6 +25,7 @@ type RequestPasswordResetUseCase interface {
 
 type requestPasswordResetUseCase struct {
 	buyerRepo    repository.BuyerRepository
+	authRepo     repository.AuthenticationRepository
 	pwdResetRepo repository.PasswordResetRepository
 	outboxRepo   repository.OutboxRepository
 	frontendURL  *url.URL
@@ -37,6 +38,7 @@ var _ RequestPasswordResetUseCase = (*requestPasswordResetUseCase)(nil)
 // NewRequestPasswordResetUseCase creates a new instance of RequestPasswordResetUseCase
 func NewRequestPasswordResetUseCase(
 	buyerRepo repository.BuyerRepository,
+	authRepo repository.AuthenticationRepository,
 	pwdResetRepo repository.PasswordResetRepository,
 	outboxRepo repository.OutboxRepository,
 	frontendURL *url.URL,
@@ -45,6 +47,7 @@ func NewRequestPasswordResetUseCase(
 ) RequestPasswordResetUseCase {
 	return &requestPasswordResetUseCase{
 		buyerRepo:    buyerRepo,
+		authRepo:     authRepo,
 		pwdResetRepo: pwdResetRepo,
 		outboxRepo:   outboxRepo,
 		frontendURL:  frontendURL,
@@ -63,6 +66,14 @@ func (u *requestPasswordResetUseCase) Execute(ctx context.Context, email string)
 		// Obfuscation is handler responsibility.
 		return &apperrors.NotFoundError{Resource: "buyer", ID: email}
 	}
+	// パスワードは組織ではなくログインごとなので、トークンはメールアドレスのログインに紐づける。
+	login, err := u.authRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to find authentication by email: %w", err)
+	}
+	if login == nil || login.DisabledAt != nil {
+		return &apperrors.NotFoundError{Resource: "buyer", ID: email}
+	}
 
 	// 1. Generate secure token
 	tokenBytes := make([]byte, 32)
@@ -83,10 +94,10 @@ func (u *requestPasswordResetUseCase) Execute(ctx context.Context, email string)
 
 	expiresAt := u.clock.Now().Add(30 * time.Minute)
 	if err := u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
-		if err := u.pwdResetRepo.DeleteAllByUserID(txCtx, buyer.ID, "buyer"); err != nil {
+		if err := u.pwdResetRepo.DeleteAllByUserID(txCtx, login.ID, "buyer"); err != nil {
 			return fmt.Errorf("failed to invalidate old reset tokens: %w", err)
 		}
-		if err = u.pwdResetRepo.Create(txCtx, buyer.ID, "buyer", tokenHash, expiresAt); err != nil {
+		if err = u.pwdResetRepo.Create(txCtx, login.ID, "buyer", tokenHash, expiresAt); err != nil {
 			return fmt.Errorf("failed to create new reset token: %w", err)
 		}
 
@@ -41,6 +41,25 @@ func (m *mockBuyerRepository) FindByName(_ context.Context, _ string) (*model.Bu
 
 func (m *mockBuyerRepository) Count(_ context.Context) (int, error) { return 0, nil }
 
+func (m *mockBuyerRepository) FindByIDWithLock(_ context.Context, _ int) (*model.Buyer, error) {
+	return nil, nil
+}
+func (m *mockBuyerRepository) Update(_ context.Context, _ *model.Buyer) error {
+	return nil
+}
+func (m *mockBuyerRepository) UpdatePaddleNumber(_ context.Context, _ int, _ string) error {
+	return nil
+}
+func (m *mockBuyerRepository) UpdateCreditTerms(_ context.Context, _ int, _ model.CreditTerms) error {
+	return nil
+}
+func (m *mockBuyerRepository) UpdateRegistration(_ context.Context, _ int, _ model.BuyerRegistration) error {
+	return nil
+}
+func (m *mockBuyerRepository) ListPendingApplications(_ context.Context) ([]model.BuyerApplication, error) {
+	return nil, nil
+}
+
 func (m *mockBuyerRepository) Delete(_ context.Context, _ int) error { return nil }
 
 type mockBuyerPasswordResetRepository struct {
@@ -83,6 +102,10 @@ func (m *mockOutboxRepository) InsertEmailJob(_ context.Context, _, _, _ string)
 	return nil
 }
 
+func (m *mockOutboxRepository) InsertRejectionEmailJob(_ context.Context, _, _, _ string) error {
+	return nil
+}
+
 func (m *mockOutboxRepository) InsertPushJob(_ context.Context, _ model.JobType, _ int, _, _, _ string) error {
 	return nil
 }
@@ -114,6 +137,7 @@ func TestRequestPasswordResetUseCase_Execute(t *testing.T) {
 	expectedExpiresAt := fixedNow.Add(30 * time.Minute)
 
 	validBuyer := &model.Buyer{ID: 1, Name: "Test Buyer"}
+	disabledAt := fixedNow.Add(-time.Hour)
 
 	tests := []struct {
 		name          string
@@ -138,6 +162,13 @@ func TestRequestPasswordResetUseCase_Execute(t *testing.T) {
 			wantSent:  false,
 			wantError: true,
 		},
+		{
+			name:      "DisabledLogin",
+			email:     "staff@example.com",
+			mockBuyer: validBuyer,
+			wantSent:  false,
+			wantError: true,
+		},
 		{
 			name:        "RepoError",
 			email:       "buyer@example.com",
@@ -183,6 +214,14 @@ func TestRequestPasswordResetUseCase_Execute(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			buyerRepo := &mockBuyerRepository{buyer: tt.mockBuyer, err: tt.mockRepoErr}
+			authRepo := &usetesting.MockAuthenticationRepository{
+				FindByEmailFunc: func(_ context.Context, email string) (*model.Authentication, error) {
+					if email == "staff@example.com" {
+						return &model.Authentication{ID: 2, BuyerID: 1, Email: email, DisabledAt: &disabledAt}, nil
+					}
+					return &model.Authentication{ID: 1, BuyerID: 1, Email: email}, nil
+				},
+			}
 			resetRepo := &mockBuyerPasswordResetRepository{}
 			switch tt.name {
 			case "Success":
@@ -220,7 +259,7 @@ func TestRequestPasswordResetUseCase_Execute(t *testing.T) {
 			}
 
 			frontendURL, _ := url.Parse("https://localhost")
-			uc := auth.NewRequestPasswordResetUseCase(buyerRepo, resetRepo, outboxRepo, frontendURL, txMgr, mockClock)
+			uc := auth.NewRequestPasswordResetUseCase(buyerRepo, authRepo, resetRepo, outboxRepo, frontendURL, txMgr, mockClock)
 			err := uc.Execute(context.Background(), tt.email)
 
 			if (err != nil) != tt.wantError {
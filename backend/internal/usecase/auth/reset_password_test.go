@@ -30,6 +30,15 @@ func (m *mockAuthRepositoryForReset) FindByEmail(_ context.Context, _ string) (*
 func (m *mockAuthRepositoryForReset) FindByBuyerID(_ context.Context, _ int) (*model.Authentication, error) {
 	return nil, nil
 }
+func (m *mockAuthRepositoryForReset) FindByID(_ context.Context, _ int) (*model.Authentication, error) {
+	return nil, nil
+}
+func (m *mockAuthRepositoryForReset) ListByBuyerID(_ context.Context, _ int) ([]model.Authentication, error) {
+	return nil, nil
+}
+func (m *mockAuthRepositoryForReset) UpdateLogin(_ context.Context, _ *model.Authentication) error {
+	return nil
+}
 func (m *mockAuthRepositoryForReset) UpdateLoginSuccess(_ context.Context, _ int, _ time.Time) error {
 	return nil
 }
@@ -46,6 +55,10 @@ func (m *mockAuthRepositoryForReset) UpdatePassword(_ context.Context, _ int, _
 	return m.err
 }
 
+func (m *mockAuthRepositoryForReset) UpdateEmail(_ context.Context, _ int, _ string) error {
+	return nil
+}
+
 type mockBuyerPasswordResetRepositoryForReset struct {
 	mock.Mock
 }
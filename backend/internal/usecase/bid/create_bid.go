@@ -2,6 +2,7 @@ package bid
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -27,14 +28,18 @@ type CreateBidUseCase interface {
 }
 
 type createBidUseCase struct {
-	itemRepo     repository.ItemRepository
-	buyerRepo    repository.BuyerRepository
-	bidRepo      repository.BidRepository
-	auctionRepo  repository.AuctionRepository
-	outboxRepo   repository.OutboxRepository
-	txMgr        repository.TransactionManager
-	itemCacheInv repository.CacheInvalidator
-	clock        service.Clock
+	itemRepo       repository.ItemRepository
+	buyerRepo      repository.BuyerRepository
+	authRepo       repository.AuthenticationRepository
+	bidRepo        repository.BidRepository
+	auctionRepo    repository.AuctionRepository
+	invoiceRepo    repository.InvoiceRepository
+	regRepo        repository.VenueRegistrationRepository
+	suspensionRepo repository.BuyerSuspensionRepository
+	outboxRepo     repository.OutboxRepository
+	txMgr          repository.TransactionManager
+	itemCacheInv   repository.CacheInvalidator
+	clock          service.Clock
 }
 
 var _ CreateBidUseCase = (*createBidUseCase)(nil)
@@ -43,29 +48,46 @@ var _ CreateBidUseCase = (*createBidUseCase)(nil)
 func NewCreateBidUseCase(
 	itemRepo repository.ItemRepository,
 	buyerRepo repository.BuyerRepository,
+	authRepo repository.AuthenticationRepository,
 	bidRepo repository.BidRepository,
 	auctionRepo repository.AuctionRepository,
+	invoiceRepo repository.InvoiceRepository,
+	regRepo repository.VenueRegistrationRepository,
+	suspensionRepo repository.BuyerSuspensionRepository,
 	outboxRepo repository.OutboxRepository,
 	txMgr repository.TransactionManager,
 	itemCacheInv repository.CacheInvalidator,
 	clock service.Clock,
 ) CreateBidUseCase {
 	return &createBidUseCase{
-		itemRepo:     itemRepo,
-		buyerRepo:    buyerRepo,
-		bidRepo:      bidRepo,
-		auctionRepo:  auctionRepo,
-		outboxRepo:   outboxRepo,
-		txMgr:        txMgr,
-		itemCacheInv: itemCacheInv,
-		clock:        clock,
+		itemRepo:       itemRepo,
+		buyerRepo:      buyerRepo,
+		authRepo:       authRepo,
+		bidRepo:        bidRepo,
+		auctionRepo:    auctionRepo,
+		invoiceRepo:    invoiceRepo,
+		regRepo:        regRepo,
+		suspensionRepo: suspensionRepo,
+		outboxRepo:     outboxRepo,
+		txMgr:          txMgr,
+		itemCacheInv:   itemCacheInv,
+		clock:          clock,
 	}
 }
 
 func (u *createBidUseCase) Execute(ctx context.Context, bid *model.Bid) (*model.Bid, error) {
+	if bid.Channel == "" {
+		bid.Channel = model.BidChannelOnline
+	}
+	if err := bid.ValidateChannel(); err != nil {
+		return nil, err
+	}
+
 	var createdBid *model.Bid
 	err := u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
-		// 1. Verify buyer exists
+		now := u.clock.Now()
+
+		// 1. Verify buyer exists, has been approved and is not suspended
 		buyer, err := u.buyerRepo.FindByID(txCtx, bid.BuyerID)
 		if err != nil {
 			return fmt.Errorf("failed to verify buyer: %w", err)
@@ -73,6 +95,25 @@ func (u *createBidUseCase) Execute(ctx context.Context, bid *model.Bid) (*model.
 		if buyer == nil {
 			return &domainErrors.ForbiddenError{Message: "Buyer not found"}
 		}
+		if err := buyer.Registration.CheckBidding(); err != nil {
+			return err
+		}
+		if err := u.checkSuspensions(txCtx, bid.BuyerID, now); err != nil {
+			return err
+		}
+		if bid.LoginID != nil {
+			if err := u.checkLogin(txCtx, bid, *bid.LoginID); err != nil {
+				return err
+			}
+		}
+		// 与信枠のある買受人は行ロックを取り、別品目への並行入札で合計が限度額を超えないよう直列化する。
+		// キャッシュ上の与信枠は古い可能性があるため、ロック時に読み直した値を使う。
+		if buyer.CreditLimit != nil {
+			buyer, err = u.buyerRepo.FindByIDWithLock(txCtx, bid.BuyerID)
+			if err != nil {
+				return fmt.Errorf("failed to lock buyer: %w", err)
+			}
+		}
 
 		// 2. Get and lock item
 		item, err := u.itemRepo.FindByIDWithLock(txCtx, bid.ItemID)
@@ -97,19 +138,22 @@ func (u *createBidUseCase) Execute(ctx context.Context, bid *model.Bid) (*model.
 			return &domainErrors.ConflictError{Message: "Auction is not in progress"}
 		}
 
-		// 4. Validate bid time
-		now := u.clock.Now()
+		// 4. Verify the buyer is licensed at the auction's venue
+		if err := u.checkVenueAccess(txCtx, bid.BuyerID, auction.VenueID, now); err != nil {
+			return err
+		}
+
+		// 5. Validate bid time
 		if !auction.Period.IsBiddingOpen(now) {
 			return &domainErrors.ValidationError{Field: "auction_time", Message: "Bid is outside of auction period"}
 		}
 
-		// 5. Validate bid amount with minimum increment
+		// 6. Validate bid amount with minimum increment
 		currentPrice := model.NewBidPrice(0)
 		if item.HighestBid != nil {
 			currentPrice = *item.HighestBid
 		}
-		minIncrement := currentPrice.CalculateMinIncrement()
-		minAcceptable := currentPrice.Add(minIncrement)
+		minAcceptable := item.MinimumNextBid()
 		if bid.Price.LessThan(minAcceptable) {
 			return &domainErrors.ValidationError{
 				Field:   "price",
@@ -117,7 +161,14 @@ func (u *createBidUseCase) Execute(ctx context.Context, bid *model.Bid) (*model.
 			}
 		}
 
-		// 6. Create bid
+		// 7. Check credit limit
+		if buyer.CreditLimit != nil {
+			if err := u.checkCredit(txCtx, buyer, item, bid.Price.Amount()); err != nil {
+				return err
+			}
+		}
+
+		// 8. Create bid
 		// item.HighestBid / HighestBidderID は transactions テーブルから都度算出される
 		// derived 値であり、auction_items テーブルには永続化しないため明示的な Update は不要。
 		previousHighestBidderID := item.HighestBidderID
@@ -129,7 +180,7 @@ func (u *createBidUseCase) Execute(ctx context.Context, bid *model.Bid) (*model.
 			return fmt.Errorf("failed to create bid: %w", err)
 		}
 
-		// 7. Automatic Extension
+		// 9. Automatic Extension
 		if auction.Period.ShouldExtend(now, AuctionExtensionThreshold) {
 			auction.Period = auction.Period.Extend(AuctionExtensionDuration)
 			if err := u.auctionRepo.Update(txCtx, auction); err != nil {
@@ -137,7 +188,7 @@ func (u *createBidUseCase) Execute(ctx context.Context, bid *model.Bid) (*model.
 			}
 		}
 
-		// 8. Notify outbid buyer
+		// 10. Notify outbid buyer
 		if previousHighestBidderID != nil && *previousHighestBidderID != bid.BuyerID {
 			if err := u.notifyOutbid(txCtx, item, *previousHighestBidderID, previousAmount); err != nil {
 				fmt.Printf("failed to enqueue outbid notification: %v\n", err)
@@ -151,7 +202,7 @@ func (u *createBidUseCase) Execute(ctx context.Context, bid *model.Bid) (*model.
 		return nil, err
 	}
 
-	// 10. Invalidate cache
+	// 11. Invalidate cache
 	if err := u.itemCacheInv.InvalidateCache(ctx, bid.ItemID); err != nil {
 		fmt.Printf("failed to invalidate item cache: %v\n", err)
 	}
@@ -159,6 +210,60 @@ func (u *createBidUseCase) Execute(ctx context.Context, bid *model.Bid) (*model.
 	return createdBid, nil
 }
 
+// checkSuspensions rejects the bid while a login or bidding suspension is in force.
+func (u *createBidUseCase) checkSuspensions(ctx context.Context, buyerID int, now time.Time) error {
+	suspensions, err := u.suspensionRepo.List(ctx, &repository.BuyerSuspensionFilters{BuyerID: &buyerID, ActiveAt: &now})
+	if err != nil {
+		return fmt.Errorf("failed to list suspensions: %w", err)
+	}
+	return model.CheckSuspensions(suspensions, model.SuspensionSeverityBidding, now)
+}
+
+// checkLogin rejects the bid when the login no longer belongs to the buyer, has been disabled,
+// or the price is above the login's own bid limit.
+func (u *createBidUseCase) checkLogin(ctx context.Context, bid *model.Bid, loginID int) error {
+	login, err := u.authRepo.FindByID(ctx, loginID)
+	if err != nil {
+		return fmt.Errorf("failed to find login: %w", err)
+	}
+	if login == nil || login.BuyerID != bid.BuyerID || login.DisabledAt != nil {
+		return &domainErrors.ForbiddenError{Message: "This login cannot bid"}
+	}
+	return login.CheckBidLimit(bid.Price.Amount())
+}
+
+// checkVenueAccess rejects the bid unless the buyer has an approved, currently valid registration at the venue.
+func (u *createBidUseCase) checkVenueAccess(ctx context.Context, buyerID, venueID int, now time.Time) error {
+	reg, err := u.regRepo.FindByBuyerAndVenue(ctx, buyerID, venueID)
+	if err != nil {
+		var notFoundErr *domainErrors.NotFoundError
+		if !errors.As(err, &notFoundErr) {
+			return fmt.Errorf("failed to find venue registration: %w", err)
+		}
+		reg = nil
+	}
+	return model.CheckVenueAccess(reg, now)
+}
+
+// checkCredit rejects the bid when the buyer's leading bids and unpaid invoices would exceed their credit limit.
+// 自分が最高値の品目に競り上げる場合は、その品目の現在の最高値を新しい入札額で置き換えて数える。
+func (u *createBidUseCase) checkCredit(ctx context.Context, buyer *model.Buyer, item *model.AuctionItem, price int) error {
+	leading, err := u.bidRepo.SumLeadingByBuyerID(ctx, buyer.ID)
+	if err != nil {
+		return fmt.Errorf("failed to sum leading bids: %w", err)
+	}
+	invoices, err := u.invoiceRepo.ListByBuyerID(ctx, buyer.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list invoices: %w", err)
+	}
+
+	replacedLead := 0
+	if item.HighestBidderID != nil && *item.HighestBidderID == buyer.ID && item.HighestBid != nil {
+		replacedLead = item.HighestBid.Amount()
+	}
+	return model.NewCreditUtilization(buyer, leading, invoices).CheckBid(price, replacedLead)
+}
+
 func (u *createBidUseCase) notifyOutbid(ctx context.Context, item *model.AuctionItem, buyerID, previousAmount int) error {
 	title := "高値更新"
 	body := fmt.Sprintf("%s への入札が更新されました（¥%d → ¥%d）", item.FishType, previousAmount, item.HighestBid.Amount())
@@ -8,6 +8,7 @@ import (
 
 	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/usecase/bid"
 	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
 )
@@ -94,6 +95,39 @@ func TestCreateBidUseCase_Execute(t *testing.T) {
 				Status:  model.AuctionStatusInProgress,
 			},
 		},
+		{
+			name: "Success_FloorBid",
+			input: &model.Bid{
+				ItemID:    1,
+				BuyerID:   1,
+				Price:     bp(1000),
+				Channel:   model.BidChannelFloor,
+				EnteredBy: new(2),
+			},
+			buyerFound:       true,
+			itemFound:        true,
+			wantID:           1,
+			wantCreateCalled: true,
+			wantTxCalled:     true,
+			mockAuction: &model.Auction{
+				ID:      1,
+				VenueID: 1,
+				Period:  model.NewAuctionPeriod(&validStart, &validEnd),
+				Status:  model.AuctionStatusInProgress,
+			},
+		},
+		{
+			name: "Error_FloorBidWithoutClerk",
+			input: &model.Bid{
+				ItemID:  1,
+				BuyerID: 1,
+				Price:   bp(1000),
+				Channel: model.BidChannelFloor,
+			},
+			wantErr:          &domainErrors.ValidationError{Field: "entered_by"},
+			wantCreateCalled: false,
+			wantTxCalled:     false,
+		},
 		{
 			name: "Error_BidTooLow",
 			input: &model.Bid{
@@ -484,7 +518,7 @@ func TestCreateBidUseCase_Execute(t *testing.T) {
 				},
 			}
 
-			uc := bid.NewCreateBidUseCase(mockItemRepo, mockBuyerRepo, mockBidRepo, mockAuctionRepo, mockOutboxRepo, mockTxMgr, mockCacheInv, mockClock)
+			uc := bid.NewCreateBidUseCase(mockItemRepo, mockBuyerRepo, &mock.MockAuthenticationRepository{}, mockBidRepo, mockAuctionRepo, &mock.MockInvoiceRepository{}, &mock.MockVenueRegistrationRepository{}, &mock.MockBuyerSuspensionRepository{}, mockOutboxRepo, mockTxMgr, mockCacheInv, mockClock)
 			created, err := uc.Execute(context.Background(), tt.input)
 
 			if tt.wantErr != nil {
@@ -528,3 +562,417 @@ func TestCreateBidUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateBidUseCase_CreditLimit(t *testing.T) {
+	fixedNow := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	start := fixedNow.Add(-1 * time.Hour)
+	end := fixedNow.Add(1 * time.Hour)
+	issuedAt := fixedNow.Add(-24 * time.Hour)
+
+	invoices := []model.Invoice{
+		{ID: 1, BuyerID: 1, Status: model.InvoiceStatusIssued, TotalAmount: 10000, PaidAmount: 4000, IssuedAt: &issuedAt},
+		{ID: 2, BuyerID: 1, Status: model.InvoiceStatusPaid, TotalAmount: 8000, PaidAmount: 8000, IssuedAt: &issuedAt},
+		{ID: 3, BuyerID: 1, Status: model.InvoiceStatusDraft, TotalAmount: 9000},
+	}
+
+	tests := []struct {
+		name        string
+		creditLimit *int
+		leading     int
+		item        *model.AuctionItem
+		price       int
+		wantErr     bool
+	}{
+		{
+			name:    "NoLimit",
+			leading: 1000000,
+			item:    &model.AuctionItem{ID: 10, AuctionID: 1},
+			price:   5000,
+		},
+		{
+			// 請求残高 6000 + 最高値 20000 + 入札 4000 = 30000 で限度額ちょうど
+			name:        "WithinLimit",
+			creditLimit: new(30000),
+			leading:     20000,
+			item:        &model.AuctionItem{ID: 10, AuctionID: 1},
+			price:       4000,
+		},
+		{
+			name:        "OverLimit",
+			creditLimit: new(30000),
+			leading:     20000,
+			item:        &model.AuctionItem{ID: 10, AuctionID: 1},
+			price:       4500,
+			wantErr:     true,
+		},
+		{
+			// 自分の最高値 3000 を 7000 に競り上げるので、増えるのは差額の 4000 だけ
+			name:        "RaisingOwnLead",
+			creditLimit: new(30000),
+			leading:     20000,
+			item:        &model.AuctionItem{ID: 10, AuctionID: 1, HighestBid: bpp(3000), HighestBidderID: new(1)},
+			price:       7000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			buyer := &model.Buyer{ID: 1, CreditTerms: model.CreditTerms{CreditLimit: tt.creditLimit}}
+
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.Buyer, error) {
+					return buyer, nil
+				},
+				FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.Buyer, error) {
+					return buyer, nil
+				},
+			}
+			itemRepo := &mock.MockItemRepository{
+				FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.AuctionItem, error) {
+					return tt.item, nil
+				},
+			}
+			bidRepo := &mock.MockBidRepository{
+				SumLeadingByBuyerIDFunc: func(_ context.Context, _ int) (int, error) {
+					return tt.leading, nil
+				},
+				CreateFunc: func(_ context.Context, b *model.Bid) (*model.Bid, error) {
+					created = true
+					return b, nil
+				},
+			}
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, Period: model.NewAuctionPeriod(&start, &end), Status: model.AuctionStatusInProgress}, nil
+				},
+			}
+			invoiceRepo := &mock.MockInvoiceRepository{
+				ListByBuyerIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+					return invoices, nil
+				},
+			}
+
+			uc := bid.NewCreateBidUseCase(itemRepo, buyerRepo, &mock.MockAuthenticationRepository{}, bidRepo, auctionRepo, invoiceRepo, &mock.MockVenueRegistrationRepository{}, &mock.MockBuyerSuspensionRepository{}, &mock.MockOutboxRepository{},
+				&mock.MockTransactionManager{}, &mock.MockCacheInvalidator{}, mock.NewMockClock(fixedNow))
+			_, err := uc.Execute(context.Background(), &model.Bid{ItemID: 10, BuyerID: 1, Price: bp(tt.price)})
+
+			if tt.wantErr {
+				var target *domainErrors.ForbiddenError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected ForbiddenError, got %v", err)
+				}
+				if created {
+					t.Error("bid should not be created")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !created {
+				t.Error("bid should be created")
+			}
+		})
+	}
+}
+
+func TestCreateBidUseCase_VenueRegistration(t *testing.T) {
+	fixedNow := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	start := fixedNow.Add(-1 * time.Hour)
+	end := fixedNow.Add(1 * time.Hour)
+	expired := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		reg     *model.VenueRegistration
+		findErr error
+		wantErr bool
+	}{
+		{name: "Approved", reg: &model.VenueRegistration{Status: model.VenueRegistrationStatusApproved}},
+		{name: "NotRegistered", findErr: &domainErrors.NotFoundError{Resource: "VenueRegistration"}, wantErr: true},
+		{name: "Pending", reg: &model.VenueRegistration{Status: model.VenueRegistrationStatusPending}, wantErr: true},
+		{name: "Suspended", reg: &model.VenueRegistration{Status: model.VenueRegistrationStatusSuspended}, wantErr: true},
+		{name: "Expired", reg: &model.VenueRegistration{Status: model.VenueRegistrationStatusApproved, ValidUntil: &expired}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			var gotVenueID int
+
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					return &model.Buyer{ID: id}, nil
+				},
+			}
+			itemRepo := &mock.MockItemRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.AuctionItem, error) {
+					return &model.AuctionItem{ID: id, AuctionID: 1}, nil
+				},
+			}
+			bidRepo := &mock.MockBidRepository{
+				CreateFunc: func(_ context.Context, b *model.Bid) (*model.Bid, error) {
+					created = true
+					return b, nil
+				},
+			}
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, VenueID: 3, Period: model.NewAuctionPeriod(&start, &end), Status: model.AuctionStatusInProgress}, nil
+				},
+			}
+			regRepo := &mock.MockVenueRegistrationRepository{
+				FindByBuyerAndVenueFunc: func(_ context.Context, _, venueID int) (*model.VenueRegistration, error) {
+					gotVenueID = venueID
+					return tt.reg, tt.findErr
+				},
+			}
+
+			uc := bid.NewCreateBidUseCase(itemRepo, buyerRepo, &mock.MockAuthenticationRepository{}, bidRepo, auctionRepo, &mock.MockInvoiceRepository{}, regRepo, &mock.MockBuyerSuspensionRepository{}, &mock.MockOutboxRepository{},
+				&mock.MockTransactionManager{}, &mock.MockCacheInvalidator{}, mock.NewMockClock(fixedNow))
+			_, err := uc.Execute(context.Background(), &model.Bid{ItemID: 10, BuyerID: 1, Price: bp(1000)})
+
+			if gotVenueID != 3 {
+				t.Errorf("expected registration at venue 3, got %d", gotVenueID)
+			}
+			if tt.wantErr {
+				var target *domainErrors.ForbiddenError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected ForbiddenError, got %v", err)
+				}
+				if created {
+					t.Error("bid should not be created")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !created {
+				t.Error("bid should be created")
+			}
+		})
+	}
+}
+
+func TestCreateBidUseCase_Suspension(t *testing.T) {
+	fixedNow := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	start := fixedNow.Add(-1 * time.Hour)
+	end := fixedNow.Add(1 * time.Hour)
+
+	tests := []struct {
+		name        string
+		suspensions []model.BuyerSuspension
+		wantErr     bool
+	}{
+		{name: "NotSuspended"},
+		{
+			name:        "SuspendedFromBidding",
+			suspensions: []model.BuyerSuspension{{BuyerID: 1, Severity: model.SuspensionSeverityBidding, StartsAt: start}},
+			wantErr:     true,
+		},
+		{
+			name:        "SuspendedFromLogin",
+			suspensions: []model.BuyerSuspension{{BuyerID: 1, Severity: model.SuspensionSeverityLogin, StartsAt: start, EndsAt: &end}},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					return &model.Buyer{ID: id}, nil
+				},
+			}
+			itemRepo := &mock.MockItemRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.AuctionItem, error) {
+					return &model.AuctionItem{ID: id, AuctionID: 1}, nil
+				},
+			}
+			bidRepo := &mock.MockBidRepository{
+				CreateFunc: func(_ context.Context, b *model.Bid) (*model.Bid, error) {
+					created = true
+					return b, nil
+				},
+			}
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, Period: model.NewAuctionPeriod(&start, &end), Status: model.AuctionStatusInProgress}, nil
+				},
+			}
+			suspensionRepo := &mock.MockBuyerSuspensionRepository{
+				ListFunc: func(_ context.Context, filters *repository.BuyerSuspensionFilters) ([]model.BuyerSuspension, error) {
+					if *filters.BuyerID != 1 || !filters.ActiveAt.Equal(fixedNow) {
+						t.Errorf("unexpected filters: %+v", filters)
+					}
+					return tt.suspensions, nil
+				},
+			}
+
+			uc := bid.NewCreateBidUseCase(itemRepo, buyerRepo, &mock.MockAuthenticationRepository{}, bidRepo, auctionRepo, &mock.MockInvoiceRepository{}, &mock.MockVenueRegistrationRepository{}, suspensionRepo,
+				&mock.MockOutboxRepository{}, &mock.MockTransactionManager{}, &mock.MockCacheInvalidator{}, mock.NewMockClock(fixedNow))
+			_, err := uc.Execute(context.Background(), &model.Bid{ItemID: 10, BuyerID: 1, Price: bp(1000)})
+
+			if tt.wantErr {
+				var target *domainErrors.ForbiddenError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected ForbiddenError, got %v", err)
+				}
+				if created {
+					t.Error("bid should not be created")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !created {
+				t.Error("bid should be created")
+			}
+		})
+	}
+}
+
+func TestCreateBidUseCase_Registration(t *testing.T) {
+	fixedNow := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	start := fixedNow.Add(-1 * time.Hour)
+	end := fixedNow.Add(1 * time.Hour)
+
+	tests := []struct {
+		name    string
+		status  model.BuyerRegistrationStatus
+		wantErr bool
+	}{
+		{name: "Approved", status: model.BuyerRegistrationApproved},
+		{name: "Pending", status: model.BuyerRegistrationPending, wantErr: true},
+		{name: "Rejected", status: model.BuyerRegistrationRejected, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					return &model.Buyer{ID: id, Registration: model.BuyerRegistration{Status: tt.status}}, nil
+				},
+			}
+			itemRepo := &mock.MockItemRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.AuctionItem, error) {
+					return &model.AuctionItem{ID: id, AuctionID: 1}, nil
+				},
+			}
+			bidRepo := &mock.MockBidRepository{
+				CreateFunc: func(_ context.Context, b *model.Bid) (*model.Bid, error) {
+					created = true
+					return b, nil
+				},
+			}
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, Period: model.NewAuctionPeriod(&start, &end), Status: model.AuctionStatusInProgress}, nil
+				},
+			}
+
+			uc := bid.NewCreateBidUseCase(itemRepo, buyerRepo, &mock.MockAuthenticationRepository{}, bidRepo, auctionRepo, &mock.MockInvoiceRepository{}, &mock.MockVenueRegistrationRepository{}, &mock.MockBuyerSuspensionRepository{},
+				&mock.MockOutboxRepository{}, &mock.MockTransactionManager{}, &mock.MockCacheInvalidator{}, mock.NewMockClock(fixedNow))
+			_, err := uc.Execute(context.Background(), &model.Bid{ItemID: 10, BuyerID: 1, Price: bp(1000)})
+
+			if tt.wantErr {
+				var target *domainErrors.ForbiddenError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected ForbiddenError, got %v", err)
+				}
+				if created {
+					t.Error("bid should not be created")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !created {
+				t.Error("bid should be created")
+			}
+		})
+	}
+}
+
+func TestCreateBidUseCase_Login(t *testing.T) {
+	fixedNow := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	start := fixedNow.Add(-1 * time.Hour)
+	end := fixedNow.Add(1 * time.Hour)
+	limit := 5000
+
+	tests := []struct {
+		name    string
+		login   *model.Authentication
+		price   int
+		wantErr bool
+	}{
+		{name: "WithinLimit", login: &model.Authentication{ID: 12, BuyerID: 1, BidLimit: &limit}, price: 5000},
+		{name: "NoLimit", login: &model.Authentication{ID: 12, BuyerID: 1}, price: 9000},
+		{name: "OverLimit", login: &model.Authentication{ID: 12, BuyerID: 1, BidLimit: &limit}, price: 5100, wantErr: true},
+		{name: "Disabled", login: &model.Authentication{ID: 12, BuyerID: 1, DisabledAt: &start}, price: 1000, wantErr: true},
+		{name: "OtherBuyer", login: &model.Authentication{ID: 12, BuyerID: 2}, price: 1000, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *model.Bid
+
+			authRepo := &mock.MockAuthenticationRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.Authentication, error) {
+					return tt.login, nil
+				},
+			}
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					return &model.Buyer{ID: id}, nil
+				},
+			}
+			itemRepo := &mock.MockItemRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.AuctionItem, error) {
+					return &model.AuctionItem{ID: id, AuctionID: 1}, nil
+				},
+			}
+			bidRepo := &mock.MockBidRepository{
+				CreateFunc: func(_ context.Context, b *model.Bid) (*model.Bid, error) {
+					created = b
+					return b, nil
+				},
+			}
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, Period: model.NewAuctionPeriod(&start, &end), Status: model.AuctionStatusInProgress}, nil
+				},
+			}
+
+			uc := bid.NewCreateBidUseCase(itemRepo, buyerRepo, authRepo, bidRepo, auctionRepo, &mock.MockInvoiceRepository{}, &mock.MockVenueRegistrationRepository{}, &mock.MockBuyerSuspensionRepository{},
+				&mock.MockOutboxRepository{}, &mock.MockTransactionManager{}, &mock.MockCacheInvalidator{}, mock.NewMockClock(fixedNow))
+			loginID := 12
+			_, err := uc.Execute(context.Background(), &model.Bid{ItemID: 10, BuyerID: 1, LoginID: &loginID, Price: bp(tt.price)})
+
+			if tt.wantErr {
+				var target *domainErrors.ForbiddenError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected ForbiddenError, got %v", err)
+				}
+				if created != nil {
+					t.Error("bid should not be created")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if created == nil || created.LoginID == nil || *created.LoginID != 12 {
+				t.Errorf("expected the bid to record login 12, got %+v", created)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package bid
+
+import (
+	"context"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListItemBidsUseCase defines the interface for reading the bid history of a lot.
+type ListItemBidsUseCase interface {
+	// Execute returns one page of the lot's bids, newest first.
+	Execute(ctx context.Context, auctionID, itemID int, q *model.BidHistoryQuery) (*model.BidHistoryPage, error)
+}
+
+type listItemBidsUseCase struct {
+	itemRepo    repository.ItemRepository
+	auctionRepo repository.AuctionRepository
+	bidRepo     repository.BidRepository
+	visibleOnly bool
+}
+
+var _ ListItemBidsUseCase = (*listItemBidsUseCase)(nil)
+
+// NewListItemBidsUseCase creates a ListItemBidsUseCase for admins, who can read the history of any lot.
+func NewListItemBidsUseCase(
+	itemRepo repository.ItemRepository,
+	auctionRepo repository.AuctionRepository,
+	bidRepo repository.BidRepository,
+) ListItemBidsUseCase {
+	return &listItemBidsUseCase{itemRepo: itemRepo, auctionRepo: auctionRepo, bidRepo: bidRepo}
+}
+
+// NewListVisibleItemBidsUseCase creates a ListItemBidsUseCase for buyers,
+// who can read the history only once the auction has started.
+func NewListVisibleItemBidsUseCase(
+	itemRepo repository.ItemRepository,
+	auctionRepo repository.AuctionRepository,
+	bidRepo repository.BidRepository,
+) ListItemBidsUseCase {
+	return &listItemBidsUseCase{itemRepo: itemRepo, auctionRepo: auctionRepo, bidRepo: bidRepo, visibleOnly: true}
+}
+
+// Execute checks that the lot belongs to the auction and reads one page of its bids.
+func (uc *listItemBidsUseCase) Execute(ctx context.Context, auctionID, itemID int, q *model.BidHistoryQuery) (*model.BidHistoryPage, error) {
+	item, err := uc.itemRepo.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.AuctionID != auctionID || item.DeletedAt != nil {
+		return nil, &domainErrors.NotFoundError{Resource: "Item", ID: itemID}
+	}
+
+	if uc.visibleOnly {
+		auction, err := uc.auctionRepo.FindByID(ctx, auctionID)
+		if err != nil {
+			return nil, err
+		}
+		if !auction.BidsVisible() {
+			return nil, &domainErrors.ForbiddenError{Message: "bid history is not available for this auction"}
+		}
+	}
+
+	// 取り消された入札は管理者向けの監査履歴にだけ残す。
+	bids, err := uc.bidRepo.ListHistoryByItemID(ctx, itemID, q.BeforeID, q.Limit+1, !uc.visibleOnly)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewBidHistoryPage(q, bids), nil
+}
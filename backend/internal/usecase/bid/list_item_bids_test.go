@@ -0,0 +1,126 @@
+package bid_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/bid"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestListItemBidsUseCase_Execute(t *testing.T) {
+	deletedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		visibleOnly   bool
+		item          *model.AuctionItem
+		auctionStatus model.AuctionStatus
+		bids          []model.BidHistoryEntry
+		wantErr       error
+		wantBids      int
+		wantNext      bool
+	}{
+		{
+			name:          "BuyerLastPage",
+			visibleOnly:   true,
+			item:          &model.AuctionItem{ID: 10, AuctionID: 1},
+			auctionStatus: model.AuctionStatusInProgress,
+			bids:          []model.BidHistoryEntry{{ID: 5}, {ID: 3}},
+			wantBids:      2,
+		},
+		{
+			name:          "BuyerMorePages",
+			visibleOnly:   true,
+			item:          &model.AuctionItem{ID: 10, AuctionID: 1},
+			auctionStatus: model.AuctionStatusCompleted,
+			bids:          []model.BidHistoryEntry{{ID: 5}, {ID: 3}, {ID: 2}},
+			wantBids:      2,
+			wantNext:      true,
+		},
+		{
+			name:          "BuyerBeforeStart",
+			visibleOnly:   true,
+			item:          &model.AuctionItem{ID: 10, AuctionID: 1},
+			auctionStatus: model.AuctionStatusScheduled,
+			wantErr:       &domainErrors.ForbiddenError{},
+		},
+		{
+			name:          "AdminBeforeStart",
+			item:          &model.AuctionItem{ID: 10, AuctionID: 1},
+			auctionStatus: model.AuctionStatusScheduled,
+			bids:          []model.BidHistoryEntry{{ID: 5}},
+			wantBids:      1,
+		},
+		{
+			name:    "OtherAuction",
+			item:    &model.AuctionItem{ID: 10, AuctionID: 2},
+			wantErr: &domainErrors.NotFoundError{},
+		},
+		{
+			name:    "DeletedItem",
+			item:    &model.AuctionItem{ID: 10, AuctionID: 1, DeletedAt: &deletedAt},
+			wantErr: &domainErrors.NotFoundError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemRepo := &mock.MockItemRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.AuctionItem, error) {
+					return tt.item, nil
+				},
+			}
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, Status: tt.auctionStatus}, nil
+				},
+			}
+			var gotBefore, gotLimit int
+			bidRepo := &mock.MockBidRepository{
+				ListHistoryByItemIDFunc: func(_ context.Context, _, beforeID, limit int, includeVoided bool) ([]model.BidHistoryEntry, error) {
+					gotBefore, gotLimit = beforeID, limit
+					if includeVoided == tt.visibleOnly {
+						t.Errorf("expected includeVoided=%v for visibleOnly=%v", !tt.visibleOnly, tt.visibleOnly)
+					}
+					return tt.bids, nil
+				},
+			}
+
+			uc := bid.NewListItemBidsUseCase(itemRepo, auctionRepo, bidRepo)
+			if tt.visibleOnly {
+				uc = bid.NewListVisibleItemBidsUseCase(itemRepo, auctionRepo, bidRepo)
+			}
+			page, err := uc.Execute(context.Background(), 1, 10, &model.BidHistoryQuery{BeforeID: 8, Limit: 2})
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ForbiddenError:
+					var target *domainErrors.ForbiddenError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ForbiddenError, got %v", err)
+					}
+				case *domainErrors.NotFoundError:
+					var target *domainErrors.NotFoundError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected NotFoundError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if gotBefore != 8 || gotLimit != 3 {
+				t.Errorf("expected to read 3 bids before 8, got %d before %d", gotLimit, gotBefore)
+			}
+			if len(page.Bids) != tt.wantBids || (page.NextCursor != "") != tt.wantNext {
+				t.Errorf("unexpected page: %+v", page)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package bid
+
+import (
+	"context"
+	"fmt"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// VoidBidUseCase defines the interface for voiding a mistaken bid.
+type VoidBidUseCase interface {
+	// Execute voids the bid and lets the item's highest bid fall back to the remaining bids.
+	Execute(ctx context.Context, id, adminID int, reason string) (*model.Bid, error)
+}
+
+type voidBidUseCase struct {
+	bidRepo      repository.BidRepository
+	itemRepo     repository.ItemRepository
+	auctionRepo  repository.AuctionRepository
+	outboxRepo   repository.OutboxRepository
+	txMgr        repository.TransactionManager
+	clock        service.Clock
+	itemCacheInv repository.CacheInvalidator
+}
+
+var _ VoidBidUseCase = (*voidBidUseCase)(nil)
+
+// NewVoidBidUseCase creates a new VoidBidUseCase instance.
+func NewVoidBidUseCase(
+	bidRepo repository.BidRepository,
+	itemRepo repository.ItemRepository,
+	auctionRepo repository.AuctionRepository,
+	outboxRepo repository.OutboxRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+	itemCacheInv repository.CacheInvalidator,
+) VoidBidUseCase {
+	return &voidBidUseCase{
+		bidRepo:      bidRepo,
+		itemRepo:     itemRepo,
+		auctionRepo:  auctionRepo,
+		outboxRepo:   outboxRepo,
+		txMgr:        txMgr,
+		clock:        clock,
+		itemCacheInv: itemCacheInv,
+	}
+}
+
+// Execute voids a bid.
+// 最高値・最高値入札者は transactions から都度算出されるため、取り消した入札を集計から外すだけで再計算される。
+// 取り消しで最高値入札者が入れ替わった場合は、新たに最高値となった買受人へ通知する。
+func (uc *voidBidUseCase) Execute(ctx context.Context, id, adminID int, reason string) (*model.Bid, error) {
+	var voided *model.Bid
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		bid, err := uc.bidRepo.FindByIDWithLock(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		// 入札と同じ順（品目 → せり）でロックを取り、同じ品目への入札と直列化する。
+		item, err := uc.itemRepo.FindByIDWithLock(txCtx, bid.ItemID)
+		if err != nil {
+			return fmt.Errorf("failed to find item: %w", err)
+		}
+		auction, err := uc.auctionRepo.FindByIDWithLock(txCtx, item.AuctionID)
+		if err != nil {
+			return fmt.Errorf("failed to find auction: %w", err)
+		}
+		// 終了後の落札は請求・精算に使われているため、取り消しではなく結果訂正で直す。
+		if auction.Status == model.AuctionStatusCompleted || auction.Status == model.AuctionStatusCancelled {
+			return &domainErrors.ConflictError{Message: "bids of a finished auction cannot be voided; request a result correction instead"}
+		}
+
+		if err := bid.Void(adminID, reason, uc.clock.Now()); err != nil {
+			return err
+		}
+		if err := uc.bidRepo.Void(txCtx, bid); err != nil {
+			return fmt.Errorf("failed to void bid: %w", err)
+		}
+		voided = bid
+
+		if item.HighestBidderID == nil || *item.HighestBidderID != bid.BuyerID {
+			return nil
+		}
+		recomputed, err := uc.itemRepo.FindByIDWithLock(txCtx, bid.ItemID)
+		if err != nil {
+			return fmt.Errorf("failed to find item: %w", err)
+		}
+		if recomputed.HighestBidderID != nil && *recomputed.HighestBidderID != bid.BuyerID {
+			if err := uc.notifyLeadRestored(txCtx, recomputed); err != nil {
+				fmt.Printf("failed to enqueue lead restored notification: %v\n", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.itemCacheInv.InvalidateCache(ctx, voided.ItemID); err != nil {
+		fmt.Printf("failed to invalidate item cache: %v\n", err)
+	}
+	return voided, nil
+}
+
+func (uc *voidBidUseCase) notifyLeadRestored(ctx context.Context, item *model.AuctionItem) error {
+	title := "最高値に戻りました"
+	body := fmt.Sprintf("上位の入札が取り消されたため、%s の最高値入札者に戻りました（¥%d）", item.FishType, item.HighestBid.Amount())
+	url := fmt.Sprintf("/auctions/%d", item.AuctionID)
+	return uc.outboxRepo.InsertPushJob(ctx, model.JobTypePushLeadRestored, *item.HighestBidderID, title, body, url)
+}
@@ -0,0 +1,166 @@
+package bid_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/bid"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestVoidBidUseCase_Execute(t *testing.T) {
+	fixedNow := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		bid           *model.Bid
+		reason        string
+		auctionStatus model.AuctionStatus
+		before        *model.AuctionItem
+		after         *model.AuctionItem
+		wantErr       error
+		wantVoid      bool
+		wantNotified  int
+	}{
+		{
+			name:          "LeaderVoided_RunnerUpNotified",
+			bid:           &model.Bid{ID: 7, ItemID: 10, BuyerID: 1, Price: bp(99000)},
+			reason:        "桁の打ち間違い",
+			auctionStatus: model.AuctionStatusInProgress,
+			before:        &model.AuctionItem{ID: 10, AuctionID: 1, FishType: "マグロ", HighestBid: bpp(99000), HighestBidderID: new(1)},
+			after:         &model.AuctionItem{ID: 10, AuctionID: 1, FishType: "マグロ", HighestBid: bpp(9000), HighestBidderID: new(2)},
+			wantVoid:      true,
+			wantNotified:  2,
+		},
+		{
+			name:          "LeaderVoided_SameBuyerStillLeads",
+			bid:           &model.Bid{ID: 7, ItemID: 10, BuyerID: 1, Price: bp(99000)},
+			reason:        "二重入札",
+			auctionStatus: model.AuctionStatusInProgress,
+			before:        &model.AuctionItem{ID: 10, AuctionID: 1, HighestBid: bpp(99000), HighestBidderID: new(1)},
+			after:         &model.AuctionItem{ID: 10, AuctionID: 1, HighestBid: bpp(9000), HighestBidderID: new(1)},
+			wantVoid:      true,
+		},
+		{
+			name:          "OutbidBidVoided",
+			bid:           &model.Bid{ID: 7, ItemID: 10, BuyerID: 1, Price: bp(5000)},
+			reason:        "誤入力",
+			auctionStatus: model.AuctionStatusInProgress,
+			before:        &model.AuctionItem{ID: 10, AuctionID: 1, HighestBid: bpp(9000), HighestBidderID: new(2)},
+			wantVoid:      true,
+		},
+		{
+			name:          "CompletedAuction",
+			bid:           &model.Bid{ID: 7, ItemID: 10, BuyerID: 1, Price: bp(5000)},
+			reason:        "誤入力",
+			auctionStatus: model.AuctionStatusCompleted,
+			before:        &model.AuctionItem{ID: 10, AuctionID: 1},
+			wantErr:       &domainErrors.ConflictError{},
+		},
+		{
+			name:          "AlreadyVoided",
+			bid:           &model.Bid{ID: 7, ItemID: 10, BuyerID: 1, Price: bp(5000), VoidedAt: &fixedNow},
+			reason:        "誤入力",
+			auctionStatus: model.AuctionStatusInProgress,
+			before:        &model.AuctionItem{ID: 10, AuctionID: 1},
+			wantErr:       &domainErrors.ConflictError{},
+		},
+		{
+			name:          "MissingReason",
+			bid:           &model.Bid{ID: 7, ItemID: 10, BuyerID: 1, Price: bp(5000)},
+			reason:        "  ",
+			auctionStatus: model.AuctionStatusInProgress,
+			before:        &model.AuctionItem{ID: 10, AuctionID: 1},
+			wantErr:       &domainErrors.ValidationError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var voided *model.Bid
+			notified := 0
+			itemReads := 0
+			invalidated := false
+
+			bidRepo := &mock.MockBidRepository{
+				FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.Bid, error) {
+					return tt.bid, nil
+				},
+				VoidFunc: func(_ context.Context, b *model.Bid) error {
+					voided = b
+					return nil
+				},
+			}
+			itemRepo := &mock.MockItemRepository{
+				FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.AuctionItem, error) {
+					itemReads++
+					if itemReads == 1 {
+						return tt.before, nil
+					}
+					return tt.after, nil
+				},
+			}
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, Status: tt.auctionStatus}, nil
+				},
+			}
+			outboxRepo := &mock.MockOutboxRepository{
+				InsertPushJobFunc: func(_ context.Context, jobType model.JobType, buyerID int, _, _, _ string) error {
+					if jobType != model.JobTypePushLeadRestored {
+						t.Errorf("jobType = %q, want %q", jobType, model.JobTypePushLeadRestored)
+					}
+					notified = buyerID
+					return nil
+				},
+			}
+			cacheInv := &mock.MockCacheInvalidator{
+				InvalidateCacheFunc: func(_ context.Context, _ int) error {
+					invalidated = true
+					return nil
+				},
+			}
+
+			uc := bid.NewVoidBidUseCase(bidRepo, itemRepo, auctionRepo, outboxRepo, &mock.MockTransactionManager{}, mock.NewMockClock(fixedNow), cacheInv)
+			got, err := uc.Execute(context.Background(), 7, 3, tt.reason)
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ConflictError:
+					var target *domainErrors.ConflictError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ConflictError, got %v", err)
+					}
+				case *domainErrors.ValidationError:
+					var target *domainErrors.ValidationError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+				}
+				if voided != nil {
+					t.Error("bid should not be voided")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if voided == nil || got.VoidedAt == nil || !got.VoidedAt.Equal(fixedNow) {
+				t.Fatalf("bid not voided: %+v", got)
+			}
+			if got.VoidedBy == nil || *got.VoidedBy != 3 || got.VoidReason != tt.reason {
+				t.Errorf("void = (%v, %q), want (3, %q)", got.VoidedBy, got.VoidReason, tt.reason)
+			}
+			if notified != tt.wantNotified {
+				t.Errorf("notified buyer = %d, want %d", notified, tt.wantNotified)
+			}
+			if !invalidated {
+				t.Error("item cache should be invalidated")
+			}
+		})
+	}
+}
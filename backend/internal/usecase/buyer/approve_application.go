@@ -0,0 +1,81 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+)
+
+// ApproveApplicationUseCase defines the interface for approving a buyer's self-registration.
+type ApproveApplicationUseCase interface {
+	// Execute approves the application so the buyer can bid, and emails the applicant.
+	Execute(ctx context.Context, buyerID, adminID int) (*model.Buyer, error)
+}
+
+type approveApplicationUseCase struct {
+	buyerRepo   repository.BuyerRepository
+	authRepo    repository.AuthenticationRepository
+	outboxRepo  repository.OutboxRepository
+	frontendURL *url.URL
+	txMgr       repository.TransactionManager
+	clock       service.Clock
+}
+
+var _ ApproveApplicationUseCase = (*approveApplicationUseCase)(nil)
+
+// NewApproveApplicationUseCase creates a new instance of ApproveApplicationUseCase.
+func NewApproveApplicationUseCase(
+	buyerRepo repository.BuyerRepository,
+	authRepo repository.AuthenticationRepository,
+	outboxRepo repository.OutboxRepository,
+	frontendURL *url.URL,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) ApproveApplicationUseCase {
+	return &approveApplicationUseCase{
+		buyerRepo:   buyerRepo,
+		authRepo:    authRepo,
+		outboxRepo:  outboxRepo,
+		frontendURL: frontendURL,
+		txMgr:       txMgr,
+		clock:       clock,
+	}
+}
+
+func (u *approveApplicationUseCase) Execute(ctx context.Context, buyerID, adminID int) (*model.Buyer, error) {
+	loginURL := u.frontendURL.JoinPath("/login")
+
+	var approved *model.Buyer
+	err := u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 二人の管理者が同時に審査しても結果が一通だけ送られるよう、行ロックを取ってから状態を確認する。
+		buyer, err := u.buyerRepo.FindByIDWithLock(txCtx, buyerID)
+		if err != nil {
+			return fmt.Errorf("failed to find buyer: %w", err)
+		}
+		if err := buyer.Registration.Approve(adminID, u.clock.Now()); err != nil {
+			return err
+		}
+		if err := u.buyerRepo.UpdateRegistration(txCtx, buyer.ID, buyer.Registration); err != nil {
+			return fmt.Errorf("failed to update registration: %w", err)
+		}
+
+		auth, err := u.authRepo.FindByBuyerID(txCtx, buyer.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find authentication: %w", err)
+		}
+		if err := u.outboxRepo.InsertEmailJob(txCtx, auth.Email, loginURL.String(), string(emailMessage.EmailTypeBuyerRegistrationApproved)); err != nil {
+			return fmt.Errorf("failed to insert outbox message: %w", err)
+		}
+		approved = buyer
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return approved, nil
+}
@@ -0,0 +1,82 @@
+package buyer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+)
+
+// ConfirmEmailChangeUseCase defines the interface for applying a verified change of the buyer's login email.
+type ConfirmEmailChangeUseCase interface {
+	// Execute replaces the login email with the address the token was sent to and notifies the old address.
+	Execute(ctx context.Context, token string) error
+}
+
+type confirmEmailChangeUseCase struct {
+	authRepo   repository.AuthenticationRepository
+	changeRepo repository.EmailChangeRepository
+	outboxRepo repository.OutboxRepository
+	txMgr      repository.TransactionManager
+	clock      service.Clock
+}
+
+var _ ConfirmEmailChangeUseCase = (*confirmEmailChangeUseCase)(nil)
+
+// NewConfirmEmailChangeUseCase creates a new instance of ConfirmEmailChangeUseCase.
+func NewConfirmEmailChangeUseCase(
+	authRepo repository.AuthenticationRepository,
+	changeRepo repository.EmailChangeRepository,
+	outboxRepo repository.OutboxRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) ConfirmEmailChangeUseCase {
+	return &confirmEmailChangeUseCase{
+		authRepo:   authRepo,
+		changeRepo: changeRepo,
+		outboxRepo: outboxRepo,
+		txMgr:      txMgr,
+		clock:      clock,
+	}
+}
+
+func (u *confirmEmailChangeUseCase) Execute(ctx context.Context, token string) error {
+	hash := sha256.Sum256([]byte(token))
+	change, err := u.changeRepo.FindByTokenHash(ctx, hex.EncodeToString(hash[:]))
+	if err != nil {
+		return fmt.Errorf("failed to find email change token: %w", err)
+	}
+	if change == nil || u.clock.Now().After(change.ExpiresAt) {
+		return &apperrors.UnauthorizedError{Message: "Invalid or expired token"}
+	}
+
+	auth, err := u.authRepo.FindByBuyerID(ctx, change.BuyerID)
+	if err != nil {
+		return fmt.Errorf("failed to find authentication: %w", err)
+	}
+	oldEmail := auth.Email
+
+	return u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.authRepo.UpdateEmail(txCtx, auth.ID, change.NewEmail); err != nil {
+			var conflictErr *apperrors.ConflictError
+			if errors.As(err, &conflictErr) {
+				return &apperrors.ConflictError{Message: "email is already in use"}
+			}
+			return fmt.Errorf("failed to update email: %w", err)
+		}
+		if err := u.changeRepo.DeleteAllByBuyerID(txCtx, change.BuyerID); err != nil {
+			return fmt.Errorf("failed to invalidate email change tokens: %w", err)
+		}
+		// 乗っ取りに気付けるよう、変更前のアドレスにも通知する。
+		if err := u.outboxRepo.InsertEmailJob(txCtx, oldEmail, "", string(emailMessage.EmailTypeBuyerEmailChanged)); err != nil {
+			return fmt.Errorf("failed to insert outbox message: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,96 @@
+package buyer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// CreateLoginInput is the input of CreateLoginUseCase.
+type CreateLoginInput struct {
+	Name     string
+	Email    string
+	Password string
+	BidLimit *int
+}
+
+// CreateLoginUseCase defines the interface for the owner adding a staff login to the organization.
+type CreateLoginUseCase interface {
+	// Execute creates a staff login with its own credentials and optional bid limit.
+	Execute(ctx context.Context, ownerLoginID int, input *CreateLoginInput) (*model.Authentication, error)
+}
+
+type createLoginUseCase struct {
+	buyerRepo repository.BuyerRepository
+	authRepo  repository.AuthenticationRepository
+}
+
+var _ CreateLoginUseCase = (*createLoginUseCase)(nil)
+
+// NewCreateLoginUseCase creates a new CreateLoginUseCase instance.
+func NewCreateLoginUseCase(buyerRepo repository.BuyerRepository, authRepo repository.AuthenticationRepository) CreateLoginUseCase {
+	return &createLoginUseCase{buyerRepo: buyerRepo, authRepo: authRepo}
+}
+
+// Execute validates the input and stores the staff login.
+// メールアドレスはログイン ID を兼ねるため、他の買受人・ログインと重複させない。
+func (uc *createLoginUseCase) Execute(ctx context.Context, ownerLoginID int, input *CreateLoginInput) (*model.Authentication, error) {
+	owner, err := uc.authRepo.FindByID(ctx, ownerLoginID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find authentication: %w", err)
+	}
+	if err := owner.CheckOwner(); err != nil {
+		return nil, err
+	}
+	buyer, err := uc.buyerRepo.FindByID(ctx, owner.BuyerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find buyer: %w", err)
+	}
+	if err := buyer.Registration.CheckStaffLogins(); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, &apperrors.ValidationError{Field: "name", Message: "is required"}
+	}
+	email := strings.TrimSpace(input.Email)
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return nil, &apperrors.ValidationError{Field: "email", Message: "must be a valid email address"}
+	}
+	if err := model.ValidateBidLimit(input.BidLimit); err != nil {
+		return nil, err
+	}
+	pwd, err := model.NewPassword(input.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uc.authRepo.FindByEmail(ctx, email)
+	var notFoundErr *apperrors.NotFoundError
+	switch {
+	case err == nil:
+		return nil, &apperrors.ConflictError{Message: "email is already in use"}
+	case !errors.As(err, &notFoundErr):
+		return nil, fmt.Errorf("failed to check email: %w", err)
+	}
+
+	hashed, err := pwd.Hash()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+	return uc.authRepo.Create(ctx, &model.Authentication{
+		BuyerID:      owner.BuyerID,
+		Email:        email,
+		PasswordHash: hashed.Raw(),
+		Name:         name,
+		Role:         model.BuyerLoginStaff,
+		BidLimit:     input.BidLimit,
+	})
+}
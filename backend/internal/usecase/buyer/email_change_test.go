@@ -0,0 +1,214 @@
+package buyer_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestRequestEmailChangeUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	frontendURL, _ := url.Parse("https://example.com")
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	current := &model.Authentication{ID: 1, BuyerID: 7, Email: "old@example.com", PasswordHash: string(hashed)}
+
+	tests := []struct {
+		name     string
+		loginID  int
+		email    string
+		password string
+		taken    bool
+		wantErr  error
+	}{
+		{name: "Success", email: " new@example.com ", password: "password"},
+		{name: "StaffLogin", loginID: 2, email: "new@example.com", password: "password", wantErr: &apperrors.ForbiddenError{}},
+		{name: "InvalidEmail", email: "not-an-email", password: "password", wantErr: &apperrors.ValidationError{}},
+		{name: "WrongPassword", email: "new@example.com", password: "wrong", wantErr: &apperrors.UnauthorizedError{}},
+		{name: "SameEmail", email: "OLD@example.com", password: "password", wantErr: &apperrors.ValidationError{}},
+		{name: "EmailInUse", email: "taken@example.com", password: "password", taken: true, wantErr: &apperrors.ConflictError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *model.EmailChangeToken
+			var sentTo, sentURL, sentType string
+			deleted := false
+
+			authRepo := &mock.MockAuthenticationRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Authentication, error) {
+					if id == 2 {
+						return &model.Authentication{ID: 2, BuyerID: 7, Email: "staff@example.com", PasswordHash: string(hashed), Role: model.BuyerLoginStaff}, nil
+					}
+					return current, nil
+				},
+				FindByEmailFunc: func(_ context.Context, email string) (*model.Authentication, error) {
+					if tt.taken {
+						return &model.Authentication{ID: 2, BuyerID: 8, Email: email}, nil
+					}
+					return nil, &apperrors.NotFoundError{Resource: "Authentication"}
+				},
+			}
+			changeRepo := &mock.MockEmailChangeRepository{
+				DeleteAllByBuyerIDFunc: func(_ context.Context, _ int) error {
+					deleted = true
+					return nil
+				},
+				CreateFunc: func(_ context.Context, token *model.EmailChangeToken) error {
+					created = token
+					return nil
+				},
+			}
+			outboxRepo := &mock.MockOutboxRepository{
+				InsertEmailJobFunc: func(_ context.Context, to, u, typ string) error {
+					sentTo, sentURL, sentType = to, u, typ
+					return nil
+				},
+			}
+
+			uc := buyer.NewRequestEmailChangeUseCase(authRepo, changeRepo, outboxRepo, frontendURL, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			loginID := tt.loginID
+			if loginID == 0 {
+				loginID = current.ID
+			}
+			err := uc.Execute(context.Background(), loginID, tt.email, tt.password)
+
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if created != nil || sentTo != "" {
+					t.Error("expected nothing to be stored or sent")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !deleted {
+				t.Error("expected earlier links to be invalidated")
+			}
+			if created == nil || created.BuyerID != 7 || created.NewEmail != "new@example.com" || !created.ExpiresAt.Equal(now.Add(buyer.EmailChangeTTL)) {
+				t.Fatalf("unexpected token: %+v", created)
+			}
+			if sentTo != "new@example.com" || sentType != string(emailMessage.EmailTypeBuyerEmailChangeVerification) {
+				t.Errorf("expected verification email to new address, got %q (%s)", sentTo, sentType)
+			}
+			token := strings.TrimPrefix(sentURL, "https://example.com/mypage/email/verify?token=")
+			hash := sha256.Sum256([]byte(token))
+			if token == sentURL || hex.EncodeToString(hash[:]) != created.TokenHash {
+				t.Errorf("link %q does not match the stored token hash", sentURL)
+			}
+		})
+	}
+}
+
+func TestConfirmEmailChangeUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	hash := sha256.Sum256([]byte("token"))
+	tokenHash := hex.EncodeToString(hash[:])
+
+	tests := []struct {
+		name      string
+		change    *model.EmailChangeToken
+		updateErr error
+		wantErr   error
+	}{
+		{name: "Success", change: &model.EmailChangeToken{BuyerID: 7, NewEmail: "new@example.com", ExpiresAt: now.Add(time.Hour)}},
+		{name: "UnknownToken", wantErr: &apperrors.UnauthorizedError{}},
+		{name: "Expired", change: &model.EmailChangeToken{BuyerID: 7, NewEmail: "new@example.com", ExpiresAt: now.Add(-time.Minute)}, wantErr: &apperrors.UnauthorizedError{}},
+		{
+			name:      "TakenMeanwhile",
+			change:    &model.EmailChangeToken{BuyerID: 7, NewEmail: "new@example.com", ExpiresAt: now.Add(time.Hour)},
+			updateErr: &apperrors.ConflictError{Message: "Authentication already exists"},
+			wantErr:   &apperrors.ConflictError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updatedTo, notified, notifyType string
+			authRepo := &mock.MockAuthenticationRepository{
+				FindByBuyerIDFunc: func(_ context.Context, buyerID int) (*model.Authentication, error) {
+					return &model.Authentication{ID: 3, BuyerID: buyerID, Email: "old@example.com", Role: model.BuyerLoginOwner}, nil
+				},
+				UpdateEmailFunc: func(_ context.Context, id int, email string) error {
+					if id != 3 {
+						t.Errorf("expected the owner login to be updated, got %d", id)
+					}
+					updatedTo = email
+					return tt.updateErr
+				},
+			}
+			changeRepo := &mock.MockEmailChangeRepository{
+				FindByTokenHashFunc: func(_ context.Context, h string) (*model.EmailChangeToken, error) {
+					if h != tokenHash {
+						t.Errorf("unexpected token hash %q", h)
+					}
+					return tt.change, nil
+				},
+			}
+			outboxRepo := &mock.MockOutboxRepository{
+				InsertEmailJobFunc: func(_ context.Context, to, _, typ string) error {
+					notified, notifyType = to, typ
+					return nil
+				},
+			}
+
+			uc := buyer.NewConfirmEmailChangeUseCase(authRepo, changeRepo, outboxRepo, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			err := uc.Execute(context.Background(), "token")
+
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if notified != "" {
+					t.Error("expected no notification")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if updatedTo != "new@example.com" {
+				t.Errorf("expected email to be updated, got %q", updatedTo)
+			}
+			if notified != "old@example.com" || notifyType != string(emailMessage.EmailTypeBuyerEmailChanged) {
+				t.Errorf("expected old address to be notified, got %q (%s)", notified, notifyType)
+			}
+		})
+	}
+}
+
+// isErrorOfType reports whether err wraps a domain error of the same type as want.
+func isErrorOfType(err, want error) bool {
+	switch want.(type) {
+	case *apperrors.ValidationError:
+		var e *apperrors.ValidationError
+		return errors.As(err, &e)
+	case *apperrors.UnauthorizedError:
+		var e *apperrors.UnauthorizedError
+		return errors.As(err, &e)
+	case *apperrors.ConflictError:
+		var e *apperrors.ConflictError
+		return errors.As(err, &e)
+	case *apperrors.ForbiddenError:
+		var e *apperrors.ForbiddenError
+		return errors.As(err, &e)
+	case *apperrors.NotFoundError:
+		var e *apperrors.NotFoundError
+		return errors.As(err, &e)
+	}
+	return false
+}
@@ -35,6 +35,33 @@ func (m *mockBidRepoForAuctions) ListPurchasesByBuyerID(_ context.Context, _ int
 func (m *mockBidRepoForAuctions) GetHighestBid(_ context.Context, _ int) (*model.Bid, error) {
 	return nil, nil
 }
+func (m *mockBidRepoForAuctions) FindPurchaseByID(_ context.Context, _ int) (*model.Purchase, error) {
+	return nil, nil
+}
+
+func (m *mockBidRepoForAuctions) ListAwardsByAuctionID(_ context.Context, _ int) ([]model.Purchase, error) {
+	return nil, nil
+}
+
+func (m *mockBidRepoForAuctions) UpdateAward(_ context.Context, _, _, _ int) error {
+	return nil
+}
+
+func (m *mockBidRepoForAuctions) ListHistoryByItemID(_ context.Context, _, _, _ int, _ bool) ([]model.BidHistoryEntry, error) {
+	return nil, nil
+}
+
+func (m *mockBidRepoForAuctions) FindByIDWithLock(_ context.Context, _ int) (*model.Bid, error) {
+	return nil, nil
+}
+
+func (m *mockBidRepoForAuctions) Void(_ context.Context, _ *model.Bid) error {
+	return nil
+}
+
+func (m *mockBidRepoForAuctions) SumLeadingByBuyerID(_ context.Context, _ int) (int, error) {
+	return 0, nil
+}
 
 func TestGetBuyerAuctionsUseCase_Execute(t *testing.T) {
 	auctions := []model.Auction{
@@ -35,6 +35,33 @@ func (m *mockBidRepoForPurchases) ListPurchasesByBuyerID(_ context.Context, _ in
 func (m *mockBidRepoForPurchases) GetHighestBid(_ context.Context, _ int) (*model.Bid, error) {
 	return nil, nil
 }
+func (m *mockBidRepoForPurchases) FindPurchaseByID(_ context.Context, _ int) (*model.Purchase, error) {
+	return nil, nil
+}
+
+func (m *mockBidRepoForPurchases) ListAwardsByAuctionID(_ context.Context, _ int) ([]model.Purchase, error) {
+	return nil, nil
+}
+
+func (m *mockBidRepoForPurchases) UpdateAward(_ context.Context, _, _, _ int) error {
+	return nil
+}
+
+func (m *mockBidRepoForPurchases) ListHistoryByItemID(_ context.Context, _, _, _ int, _ bool) ([]model.BidHistoryEntry, error) {
+	return nil, nil
+}
+
+func (m *mockBidRepoForPurchases) FindByIDWithLock(_ context.Context, _ int) (*model.Bid, error) {
+	return nil, nil
+}
+
+func (m *mockBidRepoForPurchases) Void(_ context.Context, _ *model.Bid) error {
+	return nil
+}
+
+func (m *mockBidRepoForPurchases) SumLeadingByBuyerID(_ context.Context, _ int) (int, error) {
+	return 0, nil
+}
 
 func TestGetBuyerPurchasesUseCase_Execute(t *testing.T) {
 	purchases := []model.Purchase{
@@ -0,0 +1,47 @@
+package buyer
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// GetCreditUtilizationUseCase defines the interface for showing how much of a buyer's credit limit is in use.
+type GetCreditUtilizationUseCase interface {
+	Execute(ctx context.Context, id int) (*model.CreditUtilization, error)
+}
+
+type getCreditUtilizationUseCase struct {
+	buyerRepo   repository.BuyerRepository
+	bidRepo     repository.BidRepository
+	invoiceRepo repository.InvoiceRepository
+}
+
+var _ GetCreditUtilizationUseCase = (*getCreditUtilizationUseCase)(nil)
+
+// NewGetCreditUtilizationUseCase creates a new GetCreditUtilizationUseCase instance.
+func NewGetCreditUtilizationUseCase(
+	buyerRepo repository.BuyerRepository,
+	bidRepo repository.BidRepository,
+	invoiceRepo repository.InvoiceRepository,
+) GetCreditUtilizationUseCase {
+	return &getCreditUtilizationUseCase{buyerRepo: buyerRepo, bidRepo: bidRepo, invoiceRepo: invoiceRepo}
+}
+
+// Execute returns the buyer's credit terms together with their leading bids and unpaid invoices.
+func (uc *getCreditUtilizationUseCase) Execute(ctx context.Context, id int) (*model.CreditUtilization, error) {
+	b, err := uc.buyerRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	leading, err := uc.bidRepo.SumLeadingByBuyerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := uc.invoiceRepo.ListByBuyerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return model.NewCreditUtilization(b, leading, invoices), nil
+}
@@ -0,0 +1,41 @@
+package buyer
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// LiftSuspensionUseCase defines the interface for ending a buyer suspension early.
+type LiftSuspensionUseCase interface {
+	Execute(ctx context.Context, id, adminID int) (*model.BuyerSuspension, error)
+}
+
+type liftSuspensionUseCase struct {
+	suspensionRepo repository.BuyerSuspensionRepository
+	clock          service.Clock
+}
+
+var _ LiftSuspensionUseCase = (*liftSuspensionUseCase)(nil)
+
+// NewLiftSuspensionUseCase creates a new LiftSuspensionUseCase instance.
+func NewLiftSuspensionUseCase(suspensionRepo repository.BuyerSuspensionRepository, clock service.Clock) LiftSuspensionUseCase {
+	return &liftSuspensionUseCase{suspensionRepo: suspensionRepo, clock: clock}
+}
+
+// Execute lifts the suspension. The record is kept for the audit list.
+func (uc *liftSuspensionUseCase) Execute(ctx context.Context, id, adminID int) (*model.BuyerSuspension, error) {
+	s, err := uc.suspensionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Lift(adminID, uc.clock.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.suspensionRepo.Lift(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
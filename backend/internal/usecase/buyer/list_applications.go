@@ -0,0 +1,33 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListApplicationsUseCase defines the interface for the admin review queue of self-registrations.
+type ListApplicationsUseCase interface {
+	Execute(ctx context.Context) ([]model.BuyerApplication, error)
+}
+
+type listApplicationsUseCase struct {
+	buyerRepo repository.BuyerRepository
+}
+
+var _ ListApplicationsUseCase = (*listApplicationsUseCase)(nil)
+
+// NewListApplicationsUseCase creates a new instance of ListApplicationsUseCase.
+func NewListApplicationsUseCase(buyerRepo repository.BuyerRepository) ListApplicationsUseCase {
+	return &listApplicationsUseCase{buyerRepo: buyerRepo}
+}
+
+func (u *listApplicationsUseCase) Execute(ctx context.Context) ([]model.BuyerApplication, error) {
+	apps, err := u.buyerRepo.ListPendingApplications(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	return apps, nil
+}
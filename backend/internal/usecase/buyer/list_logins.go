@@ -0,0 +1,38 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListLoginsUseCase defines the interface for listing the logins of the buyer organization.
+type ListLoginsUseCase interface {
+	// Execute returns the owner and staff logins of the organization the given login belongs to.
+	Execute(ctx context.Context, loginID int) ([]model.Authentication, error)
+}
+
+type listLoginsUseCase struct {
+	authRepo repository.AuthenticationRepository
+}
+
+var _ ListLoginsUseCase = (*listLoginsUseCase)(nil)
+
+// NewListLoginsUseCase creates a new ListLoginsUseCase instance.
+func NewListLoginsUseCase(authRepo repository.AuthenticationRepository) ListLoginsUseCase {
+	return &listLoginsUseCase{authRepo: authRepo}
+}
+
+// Execute lists the logins. Only the owner manages the staff logins, so staff cannot see the list.
+func (uc *listLoginsUseCase) Execute(ctx context.Context, loginID int) ([]model.Authentication, error) {
+	owner, err := uc.authRepo.FindByID(ctx, loginID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find authentication: %w", err)
+	}
+	if err := owner.CheckOwner(); err != nil {
+		return nil, err
+	}
+	return uc.authRepo.ListByBuyerID(ctx, owner.BuyerID)
+}
@@ -0,0 +1,37 @@
+package buyer
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// ListSuspensionsUseCase defines the interface for the buyer suspension audit list.
+type ListSuspensionsUseCase interface {
+	// Execute lists suspensions, optionally only those of one buyer and only those in force now.
+	Execute(ctx context.Context, buyerID *int, activeOnly bool) ([]model.BuyerSuspension, error)
+}
+
+type listSuspensionsUseCase struct {
+	suspensionRepo repository.BuyerSuspensionRepository
+	clock          service.Clock
+}
+
+var _ ListSuspensionsUseCase = (*listSuspensionsUseCase)(nil)
+
+// NewListSuspensionsUseCase creates a new ListSuspensionsUseCase instance.
+func NewListSuspensionsUseCase(suspensionRepo repository.BuyerSuspensionRepository, clock service.Clock) ListSuspensionsUseCase {
+	return &listSuspensionsUseCase{suspensionRepo: suspensionRepo, clock: clock}
+}
+
+// Execute lists suspensions. Without activeOnly, lifted and expired ones are included.
+func (uc *listSuspensionsUseCase) Execute(ctx context.Context, buyerID *int, activeOnly bool) ([]model.BuyerSuspension, error) {
+	filters := &repository.BuyerSuspensionFilters{BuyerID: buyerID}
+	if activeOnly {
+		now := uc.clock.Now()
+		filters.ActiveAt = &now
+	}
+	return uc.suspensionRepo.List(ctx, filters)
+}
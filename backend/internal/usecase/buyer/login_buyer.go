@@ -25,45 +25,53 @@ const (
 
 // LoginBuyerUseCase defines the interface for buyer login
 type LoginBuyerUseCase interface {
-	Execute(ctx context.Context, email, password string) (*model.Buyer, error)
+	// Execute authenticates one login of a buyer organization and returns the organization and the login.
+	Execute(ctx context.Context, email, password string) (*model.Buyer, *model.Authentication, error)
 }
 
 // LoginBuyerUseCase handles buyer login
 type loginBuyerUseCase struct {
-	buyerRepo repository.BuyerRepository
-	authRepo  repository.AuthenticationRepository
-	clock     service.Clock
+	buyerRepo      repository.BuyerRepository
+	authRepo       repository.AuthenticationRepository
+	suspensionRepo repository.BuyerSuspensionRepository
+	clock          service.Clock
 }
 
 var _ LoginBuyerUseCase = (*loginBuyerUseCase)(nil)
 
 // NewLoginBuyerUseCase creates a new instance of LoginBuyerUseCase
-func NewLoginBuyerUseCase(buyerRepo repository.BuyerRepository, authRepo repository.AuthenticationRepository, clock service.Clock) LoginBuyerUseCase {
+func NewLoginBuyerUseCase(
+	buyerRepo repository.BuyerRepository,
+	authRepo repository.AuthenticationRepository,
+	suspensionRepo repository.BuyerSuspensionRepository,
+	clock service.Clock,
+) LoginBuyerUseCase {
 	return &loginBuyerUseCase{
-		buyerRepo: buyerRepo,
-		authRepo:  authRepo,
-		clock:     clock,
+		buyerRepo:      buyerRepo,
+		authRepo:       authRepo,
+		suspensionRepo: suspensionRepo,
+		clock:          clock,
 	}
 }
 
 // Execute authenticates a buyer
-func (uc *loginBuyerUseCase) Execute(ctx context.Context, email, password string) (*model.Buyer, error) {
+func (uc *loginBuyerUseCase) Execute(ctx context.Context, email, password string) (*model.Buyer, *model.Authentication, error) {
 	// Find authentication by email
 	auth, err := uc.authRepo.FindByEmail(ctx, email)
 	if err != nil {
 		var nfErr *apperrors.NotFoundError
 		if errors.As(err, &nfErr) {
 			slog.WarnContext(ctx, "auth: buyer login failed", "reason", "user_not_found", "email", email)
-			return nil, &apperrors.UnauthorizedError{Message: "invalid credentials"}
+			return nil, nil, &apperrors.UnauthorizedError{Message: "invalid credentials"}
 		}
-		return nil, fmt.Errorf("failed to find authentication during login: %w", err)
+		return nil, nil, fmt.Errorf("failed to find authentication during login: %w", err)
 	}
 
 	// Check if account is locked
 	now := uc.clock.Now()
 	if auth.LockedUntil != nil && now.Before(*auth.LockedUntil) {
 		slog.WarnContext(ctx, "auth: buyer login failed", "reason", "account_locked", "email", email)
-		return nil, &apperrors.UnauthorizedError{Message: "account is locked due to too many failed attempts"}
+		return nil, nil, &apperrors.UnauthorizedError{Message: "account is locked due to too many failed attempts"}
 	}
 
 	// Verify password using HashedPassword to allow existing simple passwords
@@ -72,7 +80,7 @@ func (uc *loginBuyerUseCase) Execute(ctx context.Context, email, password string
 		// Increment failed attempts
 		newAttempts, incrErr := uc.authRepo.IncrementFailedAttempts(ctx, auth.ID)
 		if incrErr != nil {
-			return nil, fmt.Errorf("failed to increment failed attempts: %w", incrErr)
+			return nil, nil, fmt.Errorf("failed to increment failed attempts: %w", incrErr)
 		}
 		slog.WarnContext(ctx, "auth: buyer login failed", "reason", "bad_password", "email", email, "attempts", newAttempts)
 
@@ -82,22 +90,44 @@ func (uc *loginBuyerUseCase) Execute(ctx context.Context, email, password string
 			if lockErr := uc.authRepo.LockAccount(ctx, auth.ID, lockUntil); lockErr != nil {
 				slog.ErrorContext(ctx, "auth: failed to lock buyer account", "err", lockErr)
 			}
-			return nil, &apperrors.UnauthorizedError{Message: "account locked due to too many failed attempts"}
+			return nil, nil, &apperrors.UnauthorizedError{Message: "account locked due to too many failed attempts"}
 		}
 
-		return nil, err // Verify already returns UnauthorizedError for mismatches
+		return nil, nil, err // Verify already returns UnauthorizedError for mismatches
 	}
 
-	// Update last login and reset failed attempts
-	if err := uc.authRepo.UpdateLoginSuccess(ctx, auth.ID, uc.clock.Now()); err != nil {
-		return nil, fmt.Errorf("failed to update login success: %w", err)
+	// Reject logins disabled by the organization's owner
+	if auth.DisabledAt != nil {
+		slog.WarnContext(ctx, "auth: buyer login failed", "reason", "login_disabled", "email", email)
+		return nil, nil, &apperrors.ForbiddenError{Message: "This login has been disabled"}
+	}
+
+	// Reject suspended buyers. パスワード確認後に判定し、停止中であることを第三者に知られないようにする。
+	suspensions, err := uc.suspensionRepo.List(ctx, &repository.BuyerSuspensionFilters{BuyerID: &auth.BuyerID, ActiveAt: &now})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list suspensions: %w", err)
+	}
+	if err := model.CheckSuspensions(suspensions, model.SuspensionSeverityLogin, now); err != nil {
+		slog.WarnContext(ctx, "auth: buyer login failed", "reason", "suspended", "email", email)
+		return nil, nil, err
 	}
 
 	// Get buyer details
 	buyer, err := uc.buyerRepo.FindByID(ctx, auth.BuyerID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find buyer details: %w", err)
+		return nil, nil, fmt.Errorf("failed to find buyer details: %w", err)
+	}
+
+	// Reject applicants who have not verified their email or whose registration was rejected
+	if err := buyer.Registration.CheckLogin(); err != nil {
+		slog.WarnContext(ctx, "auth: buyer login failed", "reason", "registration_"+string(buyer.Registration.Status), "email", email)
+		return nil, nil, err
+	}
+
+	// Update last login and reset failed attempts
+	if err := uc.authRepo.UpdateLoginSuccess(ctx, auth.ID, uc.clock.Now()); err != nil {
+		return nil, nil, fmt.Errorf("failed to update login success: %w", err)
 	}
 
-	return buyer, nil
+	return buyer, auth, nil
 }
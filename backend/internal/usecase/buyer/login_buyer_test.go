@@ -8,6 +8,7 @@ import (
 
 	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
 	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
 	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
 	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
 	"golang.org/x/crypto/bcrypt"
@@ -44,6 +45,15 @@ func TestLoginBuyerUseCase_Execute(t *testing.T) {
 		FailedAttempts: 4, // Next fail will lock
 	}
 
+	disabledAuth := &model.Authentication{
+		ID:           4,
+		BuyerID:      1,
+		Email:        "test@example.com",
+		PasswordHash: string(hashedPassword),
+		Role:         model.BuyerLoginStaff,
+		DisabledAt:   &fixedNow,
+	}
+
 	tests := []struct {
 		name           string
 		email          string
@@ -53,7 +63,9 @@ func TestLoginBuyerUseCase_Execute(t *testing.T) {
 		mockAuthErr    error
 		mockBuyerErr   error
 		mockIncrErr    error
+		suspensions    []model.BuyerSuspension
 		wantErr        bool
+		wantForbidden  bool
 		wantLockCalled bool
 		wantLocked     bool
 	}{
@@ -108,6 +120,58 @@ func TestLoginBuyerUseCase_Execute(t *testing.T) {
 			mockBuyerErr: errors.New("db error"),
 			wantErr:      true,
 		},
+		{
+			name:          "Suspended",
+			email:         "test@example.com",
+			password:      "password",
+			mockAuth:      validAuth,
+			mockBuyer:     validBuyer,
+			suspensions:   []model.BuyerSuspension{{BuyerID: 1, Severity: model.SuspensionSeverityLogin, StartsAt: fixedNow.Add(-time.Hour)}},
+			wantErr:       true,
+			wantForbidden: true,
+		},
+		{
+			name:        "SuspendedFromBiddingOnly",
+			email:       "test@example.com",
+			password:    "password",
+			mockAuth:    validAuth,
+			mockBuyer:   validBuyer,
+			suspensions: []model.BuyerSuspension{{BuyerID: 1, Severity: model.SuspensionSeverityBidding, StartsAt: fixedNow.Add(-time.Hour)}},
+		},
+		{
+			name:          "RegistrationUnverified",
+			email:         "test@example.com",
+			password:      "password",
+			mockAuth:      validAuth,
+			mockBuyer:     &model.Buyer{ID: 1, Registration: model.BuyerRegistration{Status: model.BuyerRegistrationPending}},
+			wantErr:       true,
+			wantForbidden: true,
+		},
+		{
+			name:      "RegistrationAwaitingReview",
+			email:     "test@example.com",
+			password:  "password",
+			mockAuth:  validAuth,
+			mockBuyer: &model.Buyer{ID: 1, Registration: model.BuyerRegistration{Status: model.BuyerRegistrationPending, EmailVerifiedAt: &fixedNow}},
+		},
+		{
+			name:          "LoginDisabled",
+			email:         "test@example.com",
+			password:      "password",
+			mockAuth:      disabledAuth,
+			mockBuyer:     validBuyer,
+			wantErr:       true,
+			wantForbidden: true,
+		},
+		{
+			name:          "RegistrationRejected",
+			email:         "test@example.com",
+			password:      "password",
+			mockAuth:      validAuth,
+			mockBuyer:     &model.Buyer{ID: 1, Registration: model.BuyerRegistration{Status: model.BuyerRegistrationRejected}},
+			wantErr:       true,
+			wantForbidden: true,
+		},
 		{
 			name:        "IncrementFailedAttempts_DBError",
 			email:       "test@example.com",
@@ -159,15 +223,31 @@ func TestLoginBuyerUseCase_Execute(t *testing.T) {
 				},
 			}
 
-			uc := buyer.NewLoginBuyerUseCase(mockBuyerRepo, mockAuthRepo, mockClock)
-			_, err := uc.Execute(context.Background(), tt.email, tt.password)
+			mockSuspensionRepo := &mock.MockBuyerSuspensionRepository{
+				ListFunc: func(_ context.Context, filters *repository.BuyerSuspensionFilters) ([]model.BuyerSuspension, error) {
+					if *filters.BuyerID != tt.mockAuth.BuyerID || !filters.ActiveAt.Equal(fixedNow) {
+						t.Errorf("unexpected filters: %+v", filters)
+					}
+					return tt.suspensions, nil
+				},
+			}
+
+			uc := buyer.NewLoginBuyerUseCase(mockBuyerRepo, mockAuthRepo, mockSuspensionRepo, mockClock)
+			_, login, err := uc.Execute(context.Background(), tt.email, tt.password)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
 			}
+			var forbiddenErr *apperrors.ForbiddenError
+			if errors.As(err, &forbiddenErr) != tt.wantForbidden {
+				t.Errorf("error = %v, wantForbidden %v", err, tt.wantForbidden)
+			}
 			if tt.wantLockCalled != lockCalled {
 				t.Errorf("lockCalled = %v, want %v", lockCalled, tt.wantLockCalled)
 			}
+			if err == nil && login != tt.mockAuth {
+				t.Errorf("expected the authenticated login to be returned, got %+v", login)
+			}
 		})
 	}
 }
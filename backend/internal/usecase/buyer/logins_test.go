@@ -0,0 +1,184 @@
+package buyer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+// newLoginsRepo returns logins of buyer 7 (owner 1, staff 2) and buyer 8 (owner 3).
+func newLoginsRepo() *mock.MockAuthenticationRepository {
+	logins := map[int]*model.Authentication{
+		1: {ID: 1, BuyerID: 7, Email: "owner@example.com", Name: "山田", Role: model.BuyerLoginOwner},
+		2: {ID: 2, BuyerID: 7, Email: "staff@example.com", Name: "佐藤", Role: model.BuyerLoginStaff},
+		3: {ID: 3, BuyerID: 8, Email: "other@example.com", Name: "鈴木", Role: model.BuyerLoginOwner},
+	}
+	return &mock.MockAuthenticationRepository{
+		FindByIDFunc: func(_ context.Context, id int) (*model.Authentication, error) {
+			login, ok := logins[id]
+			if !ok {
+				return nil, &apperrors.NotFoundError{Resource: "Authentication", ID: id}
+			}
+			copied := *login
+			return &copied, nil
+		},
+		FindByEmailFunc: func(_ context.Context, email string) (*model.Authentication, error) {
+			for _, login := range logins {
+				if login.Email == email {
+					return login, nil
+				}
+			}
+			return nil, &apperrors.NotFoundError{Resource: "Authentication", ID: 0}
+		},
+		ListByBuyerIDFunc: func(_ context.Context, buyerID int) ([]model.Authentication, error) {
+			var list []model.Authentication
+			for _, id := range []int{1, 2, 3} {
+				if logins[id].BuyerID == buyerID {
+					list = append(list, *logins[id])
+				}
+			}
+			return list, nil
+		},
+	}
+}
+
+func TestListLoginsUseCase_Execute(t *testing.T) {
+	uc := buyer.NewListLoginsUseCase(newLoginsRepo())
+
+	got, err := uc.Execute(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || got[0].ID != 1 || got[1].ID != 2 {
+		t.Errorf("unexpected logins: %+v", got)
+	}
+
+	if _, err := uc.Execute(context.Background(), 2); !isErrorOfType(err, &apperrors.ForbiddenError{}) {
+		t.Errorf("expected staff to be forbidden, got %v", err)
+	}
+}
+
+func TestCreateLoginUseCase_Execute(t *testing.T) {
+	limit := 30000
+	zero := 0
+
+	tests := []struct {
+		name    string
+		ownerID int
+		input   buyer.CreateLoginInput
+		wantErr error
+	}{
+		{name: "Success", ownerID: 1, input: buyer.CreateLoginInput{Name: " 田中 ", Email: "tanaka@example.com", Password: "Password1!", BidLimit: &limit}},
+		{name: "StaffLogin", ownerID: 2, input: buyer.CreateLoginInput{Name: "田中", Email: "tanaka@example.com", Password: "Password1!"}, wantErr: &apperrors.ForbiddenError{}},
+		{name: "MissingName", ownerID: 1, input: buyer.CreateLoginInput{Email: "tanaka@example.com", Password: "Password1!"}, wantErr: &apperrors.ValidationError{}},
+		{name: "InvalidEmail", ownerID: 1, input: buyer.CreateLoginInput{Name: "田中", Email: "tanaka", Password: "Password1!"}, wantErr: &apperrors.ValidationError{}},
+		{name: "InvalidBidLimit", ownerID: 1, input: buyer.CreateLoginInput{Name: "田中", Email: "tanaka@example.com", Password: "Password1!", BidLimit: &zero}, wantErr: &apperrors.ValidationError{}},
+		{name: "WeakPassword", ownerID: 1, input: buyer.CreateLoginInput{Name: "田中", Email: "tanaka@example.com", Password: "short"}, wantErr: &apperrors.ValidationError{}},
+		{name: "EmailInUse", ownerID: 1, input: buyer.CreateLoginInput{Name: "田中", Email: "other@example.com", Password: "Password1!"}, wantErr: &apperrors.ConflictError{}},
+		// 買受人 8 は審査待ちの申請者
+		{name: "PendingBuyer", ownerID: 3, input: buyer.CreateLoginInput{Name: "田中", Email: "tanaka@example.com", Password: "Password1!"}, wantErr: &apperrors.ForbiddenError{}},
+	}
+	buyerRepo := &mock.MockBuyerRepository{
+		FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+			status := model.BuyerRegistrationApproved
+			if id == 8 {
+				status = model.BuyerRegistrationPending
+			}
+			return &model.Buyer{ID: id, Registration: model.BuyerRegistration{Status: status}}, nil
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *model.Authentication
+			repo := newLoginsRepo()
+			repo.CreateFunc = func(_ context.Context, auth *model.Authentication) (*model.Authentication, error) {
+				created = auth
+				auth.ID = 4
+				return auth, nil
+			}
+
+			got, err := buyer.NewCreateLoginUseCase(buyerRepo, repo).Execute(context.Background(), tt.ownerID, &tt.input)
+
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if created != nil {
+					t.Error("login should not be created")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.BuyerID != 7 || got.Role != model.BuyerLoginStaff || got.Name != "田中" || got.BidLimit == nil || *got.BidLimit != limit {
+				t.Errorf("unexpected login: %+v", got)
+			}
+			if got.PasswordHash == "" || got.PasswordHash == tt.input.Password {
+				t.Error("expected the password to be hashed")
+			}
+		})
+	}
+}
+
+func TestUpdateLoginUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	limit := 50000
+
+	tests := []struct {
+		name        string
+		ownerID     int
+		loginID     int
+		input       buyer.UpdateLoginInput
+		wantErr     error
+		wantRevoked bool
+	}{
+		{name: "SetBidLimit", ownerID: 1, loginID: 2, input: buyer.UpdateLoginInput{Name: "佐藤", BidLimit: &limit}},
+		{name: "Disable", ownerID: 1, loginID: 2, input: buyer.UpdateLoginInput{Name: "佐藤", Disabled: true}, wantRevoked: true},
+		{name: "DisableOwner", ownerID: 1, loginID: 1, input: buyer.UpdateLoginInput{Name: "山田", Disabled: true}, wantErr: &apperrors.ValidationError{}},
+		{name: "StaffLogin", ownerID: 2, loginID: 2, input: buyer.UpdateLoginInput{Name: "佐藤"}, wantErr: &apperrors.ForbiddenError{}},
+		{name: "OtherOrganization", ownerID: 1, loginID: 3, input: buyer.UpdateLoginInput{Name: "鈴木", Disabled: true}, wantErr: &apperrors.NotFoundError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *model.Authentication
+			repo := newLoginsRepo()
+			repo.UpdateLoginFunc = func(_ context.Context, auth *model.Authentication) error {
+				updated = auth
+				return nil
+			}
+			sessionRepo := &revokingSessionRepo{}
+
+			_, err := buyer.NewUpdateLoginUseCase(repo, sessionRepo, mock.NewMockClock(now)).Execute(context.Background(), tt.ownerID, tt.loginID, &tt.input)
+
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if updated != nil || len(sessionRepo.revokedLogins) != 0 {
+					t.Error("expected nothing to be updated or revoked")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if updated == nil || updated.ID != tt.loginID {
+				t.Fatalf("expected login %d to be updated, got %+v", tt.loginID, updated)
+			}
+			if tt.input.Disabled != (updated.DisabledAt != nil) {
+				t.Errorf("unexpected disabled_at: %v", updated.DisabledAt)
+			}
+			if tt.wantRevoked != (len(sessionRepo.revokedLogins) == 1 && sessionRepo.revokedLogins[0] == tt.loginID) {
+				t.Errorf("unexpected revoked sessions: %v", sessionRepo.revokedLogins)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package buyer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+)
+
+// RegistrationVerificationTTL is how long the verification link sent to a new applicant stays valid.
+const RegistrationVerificationTTL = 24 * time.Hour
+
+// RegisterBuyerInput holds what an applicant enters on the public sign-up form.
+type RegisterBuyerInput struct {
+	Name                      string
+	Email                     string
+	Password                  string
+	Organization              string
+	ContactInfo               string
+	BusinessName              string
+	InvoiceRegistrationNumber string
+	Address                   string
+	Phone                     string
+}
+
+// RegisterBuyerUseCase defines the interface for a buyer signing themselves up.
+type RegisterBuyerUseCase interface {
+	// Execute creates the buyer as pending and sends a link that verifies their email address.
+	Execute(ctx context.Context, input *RegisterBuyerInput) (*model.Buyer, error)
+}
+
+type registerBuyerUseCase struct {
+	buyerRepo   repository.BuyerRepository
+	authRepo    repository.AuthenticationRepository
+	verifyRepo  repository.EmailVerificationRepository
+	outboxRepo  repository.OutboxRepository
+	frontendURL *url.URL
+	txMgr       repository.TransactionManager
+	clock       service.Clock
+}
+
+var _ RegisterBuyerUseCase = (*registerBuyerUseCase)(nil)
+
+// NewRegisterBuyerUseCase creates a new instance of RegisterBuyerUseCase.
+func NewRegisterBuyerUseCase(
+	buyerRepo repository.BuyerRepository,
+	authRepo repository.AuthenticationRepository,
+	verifyRepo repository.EmailVerificationRepository,
+	outboxRepo repository.OutboxRepository,
+	frontendURL *url.URL,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) RegisterBuyerUseCase {
+	return &registerBuyerUseCase{
+		buyerRepo:   buyerRepo,
+		authRepo:    authRepo,
+		verifyRepo:  verifyRepo,
+		outboxRepo:  outboxRepo,
+		frontendURL: frontendURL,
+		txMgr:       txMgr,
+		clock:       clock,
+	}
+}
+
+func (u *registerBuyerUseCase) Execute(ctx context.Context, input *RegisterBuyerInput) (*model.Buyer, error) {
+	email := strings.TrimSpace(input.Email)
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return nil, &apperrors.ValidationError{Field: "email", Message: "must be a valid email address"}
+	}
+
+	pwd, err := model.NewPassword(input.Password)
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := pwd.Hash()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	_, err = u.authRepo.FindByEmail(ctx, email)
+	var notFoundErr *apperrors.NotFoundError
+	switch {
+	case err == nil:
+		return nil, &apperrors.ConflictError{Message: "email is already in use"}
+	case !errors.As(err, &notFoundErr):
+		return nil, fmt.Errorf("failed to check email: %w", err)
+	}
+
+	tokenHash, verifyURL, err := newRegistrationVerificationLink(u.frontendURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *model.Buyer
+	err = u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 免許番号や有効期限は書類を確認した管理者が登録するため、申請フォームでは受け付けない。
+		buyer, err := u.buyerRepo.Create(txCtx, &model.Buyer{
+			Name:         input.Name,
+			Organization: input.Organization,
+			ContactInfo:  input.ContactInfo,
+			BuyerProfile: model.BuyerProfile{
+				BusinessName:              input.BusinessName,
+				InvoiceRegistrationNumber: input.InvoiceRegistrationNumber,
+				Address:                   input.Address,
+				Phone:                     input.Phone,
+			},
+			Registration: model.BuyerRegistration{Status: model.BuyerRegistrationPending},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create buyer profile: %w", err)
+		}
+		created = buyer
+
+		if _, err := u.authRepo.Create(txCtx, &model.Authentication{
+			BuyerID:      buyer.ID,
+			Email:        email,
+			PasswordHash: hashedPassword.Raw(),
+			AuthType:     "password",
+		}); err != nil {
+			var conflictErr *apperrors.ConflictError
+			if errors.As(err, &conflictErr) {
+				return &apperrors.ConflictError{Message: "email is already in use"}
+			}
+			return fmt.Errorf("failed to create auth record: %w", err)
+		}
+
+		if err := u.verifyRepo.Create(txCtx, &model.EmailVerificationToken{
+			BuyerID:   buyer.ID,
+			TokenHash: tokenHash,
+			ExpiresAt: u.clock.Now().Add(RegistrationVerificationTTL),
+		}); err != nil {
+			return fmt.Errorf("failed to create email verification token: %w", err)
+		}
+		if err := u.outboxRepo.InsertEmailJob(txCtx, email, verifyURL, string(emailMessage.EmailTypeBuyerRegistrationVerification)); err != nil {
+			return fmt.Errorf("failed to insert outbox message: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// newRegistrationVerificationLink generates a verification token and returns its hash and the link that carries it.
+func newRegistrationVerificationLink(frontendURL *url.URL) (string, string, error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", "", fmt.Errorf("failed to generate secure token: %w", err)
+	}
+	token := hex.EncodeToString(tokenBytes)
+	hash := sha256.Sum256([]byte(token))
+
+	verifyURL := frontendURL.JoinPath("/signup/verify")
+	q := verifyURL.Query()
+	q.Set("token", token)
+	verifyURL.RawQuery = q.Encode()
+	return hex.EncodeToString(hash[:]), verifyURL.String(), nil
+}
@@ -0,0 +1,386 @@
+package buyer_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestRegisterBuyerUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	frontendURL, _ := url.Parse("https://example.com")
+	valid := buyer.RegisterBuyerInput{
+		Name:         "魚一",
+		Email:        " new@example.com ",
+		Password:     "Password1!",
+		Organization: "株式会社魚一",
+		ContactInfo:  "0225-00-0000",
+		BusinessName: "株式会社魚一",
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(in *buyer.RegisterBuyerInput)
+		taken   bool
+		wantErr error
+	}{
+		{name: "Success"},
+		{name: "InvalidEmail", mutate: func(in *buyer.RegisterBuyerInput) { in.Email = "not-an-email" }, wantErr: &apperrors.ValidationError{}},
+		{name: "WeakPassword", mutate: func(in *buyer.RegisterBuyerInput) { in.Password = "short" }, wantErr: &apperrors.ValidationError{}},
+		{name: "EmailInUse", taken: true, wantErr: &apperrors.ConflictError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := valid
+			if tt.mutate != nil {
+				tt.mutate(&in)
+			}
+			var createdBuyer *model.Buyer
+			var createdAuth *model.Authentication
+			var token *model.EmailVerificationToken
+			var sentTo, sentURL, sentType string
+
+			buyerRepo := &mock.MockBuyerRepository{
+				CreateFunc: func(_ context.Context, b *model.Buyer) (*model.Buyer, error) {
+					b.ID = 7
+					createdBuyer = b
+					return b, nil
+				},
+			}
+			authRepo := &mock.MockAuthenticationRepository{
+				FindByEmailFunc: func(_ context.Context, email string) (*model.Authentication, error) {
+					if tt.taken {
+						return &model.Authentication{ID: 2, BuyerID: 8, Email: email}, nil
+					}
+					return nil, &apperrors.NotFoundError{Resource: "Authentication"}
+				},
+				CreateFunc: func(_ context.Context, a *model.Authentication) (*model.Authentication, error) {
+					createdAuth = a
+					return a, nil
+				},
+			}
+			verifyRepo := &mock.MockEmailVerificationRepository{
+				CreateFunc: func(_ context.Context, v *model.EmailVerificationToken) error {
+					token = v
+					return nil
+				},
+			}
+			outboxRepo := &mock.MockOutboxRepository{
+				InsertEmailJobFunc: func(_ context.Context, to, u, typ string) error {
+					sentTo, sentURL, sentType = to, u, typ
+					return nil
+				},
+			}
+
+			uc := buyer.NewRegisterBuyerUseCase(buyerRepo, authRepo, verifyRepo, outboxRepo, frontendURL, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			got, err := uc.Execute(context.Background(), &in)
+
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if createdBuyer != nil || sentTo != "" {
+					t.Error("expected nothing to be stored or sent")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != 7 || createdBuyer.Registration.Status != model.BuyerRegistrationPending || createdBuyer.BusinessName != "株式会社魚一" {
+				t.Errorf("expected a pending buyer with the profile, got %+v", createdBuyer)
+			}
+			if createdAuth == nil || createdAuth.BuyerID != 7 || createdAuth.Email != "new@example.com" || createdAuth.PasswordHash == in.Password {
+				t.Errorf("unexpected authentication: %+v", createdAuth)
+			}
+			if token == nil || token.BuyerID != 7 || !token.ExpiresAt.Equal(now.Add(buyer.RegistrationVerificationTTL)) {
+				t.Fatalf("unexpected token: %+v", token)
+			}
+			if sentTo != "new@example.com" || sentType != string(emailMessage.EmailTypeBuyerRegistrationVerification) {
+				t.Errorf("expected verification email to the applicant, got %q (%s)", sentTo, sentType)
+			}
+			raw := strings.TrimPrefix(sentURL, "https://example.com/signup/verify?token=")
+			hash := sha256.Sum256([]byte(raw))
+			if raw == sentURL || hex.EncodeToString(hash[:]) != token.TokenHash {
+				t.Errorf("link %q does not match the stored token hash", sentURL)
+			}
+		})
+	}
+}
+
+func TestVerifyRegistrationEmailUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		verification *model.EmailVerificationToken
+		wantErr      error
+	}{
+		{name: "Success", verification: &model.EmailVerificationToken{BuyerID: 7, ExpiresAt: now.Add(time.Hour)}},
+		{name: "UnknownToken", wantErr: &apperrors.UnauthorizedError{}},
+		{name: "Expired", verification: &model.EmailVerificationToken{BuyerID: 7, ExpiresAt: now.Add(-time.Minute)}, wantErr: &apperrors.UnauthorizedError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *model.BuyerRegistration
+			deleted := false
+
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					return &model.Buyer{ID: id, Registration: model.BuyerRegistration{Status: model.BuyerRegistrationPending}}, nil
+				},
+				UpdateRegistrationFunc: func(_ context.Context, _ int, reg model.BuyerRegistration) error {
+					updated = &reg
+					return nil
+				},
+			}
+			verifyRepo := &mock.MockEmailVerificationRepository{
+				FindByTokenHashFunc: func(_ context.Context, _ string) (*model.EmailVerificationToken, error) {
+					return tt.verification, nil
+				},
+				DeleteAllByBuyerIDFunc: func(_ context.Context, _ int) error {
+					deleted = true
+					return nil
+				},
+			}
+
+			uc := buyer.NewVerifyRegistrationEmailUseCase(buyerRepo, verifyRepo, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			err := uc.Execute(context.Background(), "token")
+
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if updated != nil {
+					t.Error("expected the registration to be left as is")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if updated == nil || updated.EmailVerifiedAt == nil || !updated.EmailVerifiedAt.Equal(now) || updated.Status != model.BuyerRegistrationPending {
+				t.Errorf("expected the email to be verified, got %+v", updated)
+			}
+			if !deleted {
+				t.Error("expected the token to be used up")
+			}
+		})
+	}
+}
+
+func TestResendRegistrationVerificationUseCase_Execute(t *testing.T) {
+	// 最初のリンクの期限 (24 時間) を過ぎてから再送を求めた場合
+	now := time.Date(2026, 5, 3, 10, 0, 0, 0, time.UTC)
+	frontendURL, _ := url.Parse("https://example.com")
+	verifiedAt := now.Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		unknown      bool
+		registration model.BuyerRegistration
+		wantErr      error
+	}{
+		{name: "ExpiredLink", registration: model.BuyerRegistration{Status: model.BuyerRegistrationPending}},
+		{name: "UnknownEmail", unknown: true, wantErr: &apperrors.NotFoundError{}},
+		{name: "AlreadyVerified", registration: model.BuyerRegistration{Status: model.BuyerRegistrationPending, EmailVerifiedAt: &verifiedAt}, wantErr: &apperrors.NotFoundError{}},
+		{name: "AlreadyReviewed", registration: model.BuyerRegistration{Status: model.BuyerRegistrationApproved, EmailVerifiedAt: &verifiedAt}, wantErr: &apperrors.NotFoundError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var token *model.EmailVerificationToken
+			var sentTo, sentURL, sentType string
+			deleted := false
+
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					return &model.Buyer{ID: id, Registration: tt.registration}, nil
+				},
+			}
+			authRepo := &mock.MockAuthenticationRepository{
+				FindByEmailFunc: func(_ context.Context, email string) (*model.Authentication, error) {
+					if tt.unknown || email != "new@example.com" {
+						return nil, &apperrors.NotFoundError{Resource: "Authentication"}
+					}
+					return &model.Authentication{ID: 2, BuyerID: 7, Email: email}, nil
+				},
+			}
+			verifyRepo := &mock.MockEmailVerificationRepository{
+				DeleteAllByBuyerIDFunc: func(_ context.Context, buyerID int) error {
+					deleted = buyerID == 7
+					return nil
+				},
+				CreateFunc: func(_ context.Context, v *model.EmailVerificationToken) error {
+					token = v
+					return nil
+				},
+			}
+			outboxRepo := &mock.MockOutboxRepository{
+				InsertEmailJobFunc: func(_ context.Context, to, u, typ string) error {
+					sentTo, sentURL, sentType = to, u, typ
+					return nil
+				},
+			}
+
+			uc := buyer.NewResendRegistrationVerificationUseCase(buyerRepo, authRepo, verifyRepo, outboxRepo, frontendURL, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			err := uc.Execute(context.Background(), " new@example.com ")
+
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if token != nil || sentTo != "" {
+					t.Error("expected nothing to be stored or sent")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !deleted {
+				t.Error("expected the old links to be invalidated")
+			}
+			if token == nil || token.BuyerID != 7 || !token.ExpiresAt.Equal(now.Add(buyer.RegistrationVerificationTTL)) {
+				t.Fatalf("unexpected token: %+v", token)
+			}
+			if sentTo != "new@example.com" || sentType != string(emailMessage.EmailTypeBuyerRegistrationVerification) {
+				t.Errorf("expected verification email to the applicant, got %q (%s)", sentTo, sentType)
+			}
+			raw := strings.TrimPrefix(sentURL, "https://example.com/signup/verify?token=")
+			hash := sha256.Sum256([]byte(raw))
+			if raw == sentURL || hex.EncodeToString(hash[:]) != token.TokenHash {
+				t.Errorf("link %q does not match the stored token hash", sentURL)
+			}
+		})
+	}
+}
+
+func TestReviewApplicationUseCases(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	verified := now.Add(-time.Hour)
+	frontendURL, _ := url.Parse("https://example.com")
+
+	tests := []struct {
+		name       string
+		reg        model.BuyerRegistration
+		reject     bool
+		reason     string
+		wantStatus model.BuyerRegistrationStatus
+		wantType   emailMessage.EmailType
+		wantErr    error
+	}{
+		{
+			name:       "Approve",
+			reg:        model.BuyerRegistration{Status: model.BuyerRegistrationPending, EmailVerifiedAt: &verified},
+			wantStatus: model.BuyerRegistrationApproved,
+			wantType:   emailMessage.EmailTypeBuyerRegistrationApproved,
+		},
+		{
+			name:    "ApproveUnverified",
+			reg:     model.BuyerRegistration{Status: model.BuyerRegistrationPending},
+			wantErr: &apperrors.ConflictError{},
+		},
+		{
+			name:       "Reject",
+			reg:        model.BuyerRegistration{Status: model.BuyerRegistrationPending, EmailVerifiedAt: &verified},
+			reject:     true,
+			reason:     "買参権が確認できません",
+			wantStatus: model.BuyerRegistrationRejected,
+			wantType:   emailMessage.EmailTypeBuyerRegistrationRejected,
+		},
+		{
+			name:    "RejectWithoutReason",
+			reg:     model.BuyerRegistration{Status: model.BuyerRegistrationPending, EmailVerifiedAt: &verified},
+			reject:  true,
+			wantErr: &apperrors.ValidationError{},
+		},
+		{
+			name:    "AlreadyReviewed",
+			reg:     model.BuyerRegistration{Status: model.BuyerRegistrationApproved},
+			reject:  true,
+			reason:  "重複",
+			wantErr: &apperrors.ConflictError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *model.BuyerRegistration
+			var sentTo, sentDetail, sentType string
+
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					return &model.Buyer{ID: id, Registration: tt.reg}, nil
+				},
+				UpdateRegistrationFunc: func(_ context.Context, _ int, reg model.BuyerRegistration) error {
+					updated = &reg
+					return nil
+				},
+			}
+			authRepo := &mock.MockAuthenticationRepository{
+				FindByBuyerIDFunc: func(_ context.Context, buyerID int) (*model.Authentication, error) {
+					return &model.Authentication{BuyerID: buyerID, Email: "new@example.com"}, nil
+				},
+			}
+			outboxRepo := &mock.MockOutboxRepository{
+				InsertEmailJobFunc: func(_ context.Context, to, u, typ string) error {
+					sentTo, sentDetail, sentType = to, u, typ
+					return nil
+				},
+				InsertRejectionEmailJobFunc: func(_ context.Context, to, reason, typ string) error {
+					sentTo, sentDetail, sentType = to, reason, typ
+					return nil
+				},
+			}
+			txMgr := &mock.MockTransactionManager{}
+			clock := mock.NewMockClock(now)
+
+			var got *model.Buyer
+			var err error
+			if tt.reject {
+				got, err = buyer.NewRejectApplicationUseCase(buyerRepo, authRepo, outboxRepo, txMgr, clock).Execute(context.Background(), 7, 3, tt.reason)
+			} else {
+				got, err = buyer.NewApproveApplicationUseCase(buyerRepo, authRepo, outboxRepo, frontendURL, txMgr, clock).Execute(context.Background(), 7, 3)
+			}
+
+			if tt.wantErr != nil {
+				if !isErrorOfType(err, tt.wantErr) {
+					t.Fatalf("expected %T, got %v", tt.wantErr, err)
+				}
+				if updated != nil || sentTo != "" {
+					t.Error("expected nothing to be stored or sent")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Registration.Status != tt.wantStatus || updated == nil || updated.Status != tt.wantStatus || *updated.ReviewedBy != 3 {
+				t.Errorf("expected %s reviewed by admin 3, got %+v", tt.wantStatus, updated)
+			}
+			if sentTo != "new@example.com" || sentType != string(tt.wantType) {
+				t.Errorf("expected %s email to the applicant, got %q (%s)", tt.wantType, sentTo, sentType)
+			}
+			wantDetail := "https://example.com/login"
+			if tt.reject {
+				wantDetail = tt.reason
+			}
+			if sentDetail != wantDetail {
+				t.Errorf("expected %q in the email, got %q", wantDetail, sentDetail)
+			}
+		})
+	}
+}
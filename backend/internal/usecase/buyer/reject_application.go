@@ -0,0 +1,74 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+)
+
+// RejectApplicationUseCase defines the interface for turning down a buyer's self-registration.
+type RejectApplicationUseCase interface {
+	// Execute rejects the application and emails the reason to the applicant.
+	Execute(ctx context.Context, buyerID, adminID int, reason string) (*model.Buyer, error)
+}
+
+type rejectApplicationUseCase struct {
+	buyerRepo  repository.BuyerRepository
+	authRepo   repository.AuthenticationRepository
+	outboxRepo repository.OutboxRepository
+	txMgr      repository.TransactionManager
+	clock      service.Clock
+}
+
+var _ RejectApplicationUseCase = (*rejectApplicationUseCase)(nil)
+
+// NewRejectApplicationUseCase creates a new instance of RejectApplicationUseCase.
+func NewRejectApplicationUseCase(
+	buyerRepo repository.BuyerRepository,
+	authRepo repository.AuthenticationRepository,
+	outboxRepo repository.OutboxRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) RejectApplicationUseCase {
+	return &rejectApplicationUseCase{
+		buyerRepo:  buyerRepo,
+		authRepo:   authRepo,
+		outboxRepo: outboxRepo,
+		txMgr:      txMgr,
+		clock:      clock,
+	}
+}
+
+func (u *rejectApplicationUseCase) Execute(ctx context.Context, buyerID, adminID int, reason string) (*model.Buyer, error) {
+	var rejected *model.Buyer
+	err := u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		buyer, err := u.buyerRepo.FindByIDWithLock(txCtx, buyerID)
+		if err != nil {
+			return fmt.Errorf("failed to find buyer: %w", err)
+		}
+		if err := buyer.Registration.Reject(adminID, reason, u.clock.Now()); err != nil {
+			return err
+		}
+		if err := u.buyerRepo.UpdateRegistration(txCtx, buyer.ID, buyer.Registration); err != nil {
+			return fmt.Errorf("failed to update registration: %w", err)
+		}
+
+		auth, err := u.authRepo.FindByBuyerID(txCtx, buyer.ID)
+		if err != nil {
+			return fmt.Errorf("failed to find authentication: %w", err)
+		}
+		if err := u.outboxRepo.InsertRejectionEmailJob(txCtx, auth.Email, buyer.Registration.RejectionReason, string(emailMessage.EmailTypeBuyerRegistrationRejected)); err != nil {
+			return fmt.Errorf("failed to insert outbox message: %w", err)
+		}
+		rejected = buyer
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rejected, nil
+}
@@ -0,0 +1,124 @@
+package buyer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+)
+
+// EmailChangeTTL is how long the verification link sent to the new address stays valid.
+const EmailChangeTTL = 24 * time.Hour
+
+// RequestEmailChangeUseCase defines the interface for starting a change of the buyer's login email.
+// Only the organization's owner can change the address, since it is also where the market contacts the buyer.
+type RequestEmailChangeUseCase interface {
+	// Execute sends a verification link to the new address. The login email is not changed yet.
+	Execute(ctx context.Context, loginID int, newEmail, password string) error
+}
+
+type requestEmailChangeUseCase struct {
+	authRepo    repository.AuthenticationRepository
+	changeRepo  repository.EmailChangeRepository
+	outboxRepo  repository.OutboxRepository
+	frontendURL *url.URL
+	txMgr       repository.TransactionManager
+	clock       service.Clock
+}
+
+var _ RequestEmailChangeUseCase = (*requestEmailChangeUseCase)(nil)
+
+// NewRequestEmailChangeUseCase creates a new instance of RequestEmailChangeUseCase.
+func NewRequestEmailChangeUseCase(
+	authRepo repository.AuthenticationRepository,
+	changeRepo repository.EmailChangeRepository,
+	outboxRepo repository.OutboxRepository,
+	frontendURL *url.URL,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) RequestEmailChangeUseCase {
+	return &requestEmailChangeUseCase{
+		authRepo:    authRepo,
+		changeRepo:  changeRepo,
+		outboxRepo:  outboxRepo,
+		frontendURL: frontendURL,
+		txMgr:       txMgr,
+		clock:       clock,
+	}
+}
+
+func (u *requestEmailChangeUseCase) Execute(ctx context.Context, loginID int, newEmail, password string) error {
+	newEmail = strings.TrimSpace(newEmail)
+	if addr, err := mail.ParseAddress(newEmail); err != nil || addr.Address != newEmail {
+		return &apperrors.ValidationError{Field: "email", Message: "must be a valid email address"}
+	}
+
+	auth, err := u.authRepo.FindByID(ctx, loginID)
+	if err != nil {
+		return fmt.Errorf("failed to find authentication: %w", err)
+	}
+	if err := auth.CheckOwner(); err != nil {
+		return err
+	}
+	buyerID := auth.BuyerID
+	// セッションを奪われた場合にログイン用アドレスまで乗っ取られないよう、現在のパスワードを確認する。
+	if err := model.NewHashedPassword(auth.PasswordHash).Verify(password); err != nil {
+		return err
+	}
+	if strings.EqualFold(newEmail, auth.Email) {
+		return &apperrors.ValidationError{Field: "email", Message: "must differ from the current email"}
+	}
+
+	// 確定時にも一意制約で弾かれるが、使えないアドレスに確認メールを送らないよう先に確認する。
+	_, err = u.authRepo.FindByEmail(ctx, newEmail)
+	var notFoundErr *apperrors.NotFoundError
+	switch {
+	case err == nil:
+		return &apperrors.ConflictError{Message: "email is already in use"}
+	case !errors.As(err, &notFoundErr):
+		return fmt.Errorf("failed to check email: %w", err)
+	}
+
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return fmt.Errorf("failed to generate secure token: %w", err)
+	}
+	token := hex.EncodeToString(tokenBytes)
+	hash := sha256.Sum256([]byte(token))
+
+	verifyURL := u.frontendURL.JoinPath("/mypage/email/verify")
+	q := verifyURL.Query()
+	q.Set("token", token)
+	verifyURL.RawQuery = q.Encode()
+
+	// 以前のリンクは無効にし、最後に申請したアドレスだけを確定できるようにする。
+	return u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.changeRepo.DeleteAllByBuyerID(txCtx, buyerID); err != nil {
+			return fmt.Errorf("failed to invalidate old email change tokens: %w", err)
+		}
+		if err := u.changeRepo.Create(txCtx, &model.EmailChangeToken{
+			BuyerID:   buyerID,
+			NewEmail:  newEmail,
+			TokenHash: hex.EncodeToString(hash[:]),
+			ExpiresAt: u.clock.Now().Add(EmailChangeTTL),
+		}); err != nil {
+			return fmt.Errorf("failed to create email change token: %w", err)
+		}
+		if err := u.outboxRepo.InsertEmailJob(txCtx, newEmail, verifyURL.String(), string(emailMessage.EmailTypeBuyerEmailChangeVerification)); err != nil {
+			return fmt.Errorf("failed to insert outbox message: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,93 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+)
+
+// ResendRegistrationVerificationUseCase defines the interface for sending an applicant a new verification link.
+type ResendRegistrationVerificationUseCase interface {
+	// Execute replaces the applicant's verification link and emails the new one.
+	// It returns a NotFoundError when there is no application still waiting for its email to be verified.
+	Execute(ctx context.Context, email string) error
+}
+
+type resendRegistrationVerificationUseCase struct {
+	buyerRepo   repository.BuyerRepository
+	authRepo    repository.AuthenticationRepository
+	verifyRepo  repository.EmailVerificationRepository
+	outboxRepo  repository.OutboxRepository
+	frontendURL *url.URL
+	txMgr       repository.TransactionManager
+	clock       service.Clock
+}
+
+var _ ResendRegistrationVerificationUseCase = (*resendRegistrationVerificationUseCase)(nil)
+
+// NewResendRegistrationVerificationUseCase creates a new instance of ResendRegistrationVerificationUseCase.
+func NewResendRegistrationVerificationUseCase(
+	buyerRepo repository.BuyerRepository,
+	authRepo repository.AuthenticationRepository,
+	verifyRepo repository.EmailVerificationRepository,
+	outboxRepo repository.OutboxRepository,
+	frontendURL *url.URL,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) ResendRegistrationVerificationUseCase {
+	return &resendRegistrationVerificationUseCase{
+		buyerRepo:   buyerRepo,
+		authRepo:    authRepo,
+		verifyRepo:  verifyRepo,
+		outboxRepo:  outboxRepo,
+		frontendURL: frontendURL,
+		txMgr:       txMgr,
+		clock:       clock,
+	}
+}
+
+func (u *resendRegistrationVerificationUseCase) Execute(ctx context.Context, email string) error {
+	email = strings.TrimSpace(email)
+	auth, err := u.authRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	tokenHash, verifyURL, err := newRegistrationVerificationLink(u.frontendURL)
+	if err != nil {
+		return err
+	}
+
+	return u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		buyer, err := u.buyerRepo.FindByIDWithLock(txCtx, auth.BuyerID)
+		if err != nil {
+			return fmt.Errorf("failed to find buyer: %w", err)
+		}
+		// 確認済み・審査済みの申請には送らない。存在しない場合と同じ扱いにしてメールアドレスの有無を漏らさない。
+		if buyer.Registration.Status != model.BuyerRegistrationPending || buyer.Registration.EmailVerifiedAt != nil {
+			return &apperrors.NotFoundError{Resource: "Pending registration", ID: auth.BuyerID}
+		}
+
+		if err := u.verifyRepo.DeleteAllByBuyerID(txCtx, buyer.ID); err != nil {
+			return fmt.Errorf("failed to invalidate email verification tokens: %w", err)
+		}
+		if err := u.verifyRepo.Create(txCtx, &model.EmailVerificationToken{
+			BuyerID:   buyer.ID,
+			TokenHash: tokenHash,
+			ExpiresAt: u.clock.Now().Add(RegistrationVerificationTTL),
+		}); err != nil {
+			return fmt.Errorf("failed to create email verification token: %w", err)
+		}
+		if err := u.outboxRepo.InsertEmailJob(txCtx, auth.Email, verifyURL, string(emailMessage.EmailTypeBuyerRegistrationVerification)); err != nil {
+			return fmt.Errorf("failed to insert outbox message: %w", err)
+		}
+		return nil
+	})
+}
@@ -78,7 +78,7 @@ func TestSignupAndSigninFlow(t *testing.T) {
 
 	txMgr := &mock.MockTransactionManager{}
 	createUC := buyer.NewCreateBuyerUseCase(buyerRepo, authRepo, txMgr)
-	loginUC := buyer.NewLoginBuyerUseCase(buyerRepo, authRepo, service.NewRealClock())
+	loginUC := buyer.NewLoginBuyerUseCase(buyerRepo, authRepo, &mock.MockBuyerSuspensionRepository{}, service.NewRealClock())
 
 	ctx := context.Background()
 	email := "test@example.com"
@@ -96,7 +96,7 @@ func TestSignupAndSigninFlow(t *testing.T) {
 	}
 
 	// 2. Signin (Success)
-	loggedInBuyer, err := loginUC.Execute(ctx, email, password)
+	loggedInBuyer, _, err := loginUC.Execute(ctx, email, password)
 	if err != nil {
 		t.Fatalf("Signin failed with correct password: %v", err)
 		return
@@ -110,13 +110,13 @@ func TestSignupAndSigninFlow(t *testing.T) {
 	}
 
 	// 3. Signin (Failure - Wrong Password)
-	_, err = loginUC.Execute(ctx, email, "wrongpassword")
+	_, _, err = loginUC.Execute(ctx, email, "wrongpassword")
 	if err == nil {
 		t.Error("Signin should fail with wrong password, but it succeeded")
 	}
 
 	// 4. Signin (Failure - Wrong Email)
-	_, err = loginUC.Execute(ctx, "wrong@example.com", password)
+	_, _, err = loginUC.Execute(ctx, "wrong@example.com", password)
 	if err == nil {
 		t.Error("Signin should fail with wrong email, but it succeeded")
 	}
@@ -124,16 +124,16 @@ func TestSignupAndSigninFlow(t *testing.T) {
 	// 5. Lockout: step 3 already counted as 1 failure; do MaxFailedLoginAttempts-2 more
 	// to reach the attempt just before lockout, then confirm the threshold attempt locks the account.
 	for i := 0; i < buyer.MaxFailedLoginAttempts-2; i++ {
-		_, err = loginUC.Execute(ctx, email, "wrongpassword")
+		_, _, err = loginUC.Execute(ctx, email, "wrongpassword")
 		if err == nil {
 			t.Errorf("pre-lockout attempt %d: expected error but got nil", i+1)
 		}
 	}
-	_, err = loginUC.Execute(ctx, email, "wrongpassword")
+	_, _, err = loginUC.Execute(ctx, email, "wrongpassword")
 	if err == nil {
 		t.Error("lockout-triggering attempt should return error")
 	}
-	_, err = loginUC.Execute(ctx, email, password)
+	_, _, err = loginUC.Execute(ctx, email, password)
 	if err == nil {
 		t.Error("correct password should be rejected while account is locked")
 	}
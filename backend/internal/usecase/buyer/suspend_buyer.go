@@ -0,0 +1,71 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// SuspendBuyerUseCase defines the interface for suspending a buyer.
+type SuspendBuyerUseCase interface {
+	// Execute records the suspension and logs the buyer out everywhere.
+	// A suspension without a start begins immediately.
+	Execute(ctx context.Context, s *model.BuyerSuspension) (*model.BuyerSuspension, error)
+}
+
+type suspendBuyerUseCase struct {
+	buyerRepo      repository.BuyerRepository
+	suspensionRepo repository.BuyerSuspensionRepository
+	sessionRepo    repository.SessionRepository
+	clock          service.Clock
+}
+
+var _ SuspendBuyerUseCase = (*suspendBuyerUseCase)(nil)
+
+// NewSuspendBuyerUseCase creates a new SuspendBuyerUseCase instance.
+func NewSuspendBuyerUseCase(
+	buyerRepo repository.BuyerRepository,
+	suspensionRepo repository.BuyerSuspensionRepository,
+	sessionRepo repository.SessionRepository,
+	clock service.Clock,
+) SuspendBuyerUseCase {
+	return &suspendBuyerUseCase{
+		buyerRepo:      buyerRepo,
+		suspensionRepo: suspensionRepo,
+		sessionRepo:    sessionRepo,
+		clock:          clock,
+	}
+}
+
+// Execute creates the suspension.
+func (uc *suspendBuyerUseCase) Execute(ctx context.Context, s *model.BuyerSuspension) (*model.BuyerSuspension, error) {
+	if s.StartsAt.IsZero() {
+		s.StartsAt = uc.clock.Now()
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	buyer, err := uc.buyerRepo.FindByID(ctx, s.BuyerID)
+	if err != nil {
+		return nil, err
+	}
+	if buyer == nil {
+		return nil, &apperrors.NotFoundError{Resource: "Buyer", ID: s.BuyerID}
+	}
+
+	created, err := uc.suspensionRepo.Create(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	// 入札のみの停止でもログイン中の画面から入札できないよう、重大度にかかわらず全セッションを失効させる。
+	// 再ログイン時に停止が判定される。開始日時が先の停止で停止前に作られたセッションは、認証ミドルウェアが開始時点で失効させる。
+	if err := uc.sessionRepo.DeleteAllByUserID(ctx, s.BuyerID, model.SessionRoleBuyer); err != nil {
+		return nil, fmt.Errorf("failed to invalidate sessions: %w", err)
+	}
+	return created, nil
+}
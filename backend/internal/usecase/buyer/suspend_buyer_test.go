@@ -0,0 +1,179 @@
+package buyer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+type revokingSessionRepo struct {
+	repository.SessionRepository
+	revoked       []int
+	revokedLogins []int
+}
+
+func (m *revokingSessionRepo) DeleteAllByUserID(_ context.Context, userID int, role model.SessionRole) error {
+	if role == model.SessionRoleBuyer {
+		m.revoked = append(m.revoked, userID)
+	}
+	return nil
+}
+
+func (m *revokingSessionRepo) DeleteAllByLoginID(_ context.Context, _, loginID int, role model.SessionRole) error {
+	if role == model.SessionRoleBuyer {
+		m.revokedLogins = append(m.revokedLogins, loginID)
+	}
+	return nil
+}
+
+func TestSuspendBuyerUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	later := now.Add(24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		input      *model.BuyerSuspension
+		buyerErr   error
+		wantErr    error
+		wantStarts time.Time
+	}{
+		{
+			name:       "StartsNow",
+			input:      &model.BuyerSuspension{BuyerID: 1, Severity: model.SuspensionSeverityLogin, Reason: "未払い", CreatedBy: 7},
+			wantStarts: now,
+		},
+		{
+			name:       "Scheduled",
+			input:      &model.BuyerSuspension{BuyerID: 1, Severity: model.SuspensionSeverityBidding, Reason: "未払い", StartsAt: later, CreatedBy: 7},
+			wantStarts: later,
+		},
+		{
+			name:    "MissingReason",
+			input:   &model.BuyerSuspension{BuyerID: 1, Severity: model.SuspensionSeverityLogin, CreatedBy: 7},
+			wantErr: &domainErrors.ValidationError{},
+		},
+		{
+			name:     "UnknownBuyer",
+			input:    &model.BuyerSuspension{BuyerID: 9, Severity: model.SuspensionSeverityLogin, Reason: "未払い", CreatedBy: 7},
+			buyerErr: &domainErrors.NotFoundError{Resource: "Buyer", ID: 9},
+			wantErr:  &domainErrors.NotFoundError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					if tt.buyerErr != nil {
+						return nil, tt.buyerErr
+					}
+					return &model.Buyer{ID: id}, nil
+				},
+			}
+			var stored *model.BuyerSuspension
+			suspensionRepo := &mock.MockBuyerSuspensionRepository{
+				CreateFunc: func(_ context.Context, s *model.BuyerSuspension) (*model.BuyerSuspension, error) {
+					stored = s
+					created := *s
+					created.ID = 3
+					return &created, nil
+				},
+			}
+			sessionRepo := &revokingSessionRepo{}
+
+			uc := buyer.NewSuspendBuyerUseCase(buyerRepo, suspensionRepo, sessionRepo, mock.NewMockClock(now))
+			got, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ValidationError:
+					var target *domainErrors.ValidationError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+				case *domainErrors.NotFoundError:
+					var target *domainErrors.NotFoundError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected NotFoundError, got %v", err)
+					}
+				}
+				if stored != nil || len(sessionRepo.revoked) != 0 {
+					t.Error("expected nothing to be stored or revoked")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.ID != 3 || !got.StartsAt.Equal(tt.wantStarts) {
+				t.Errorf("unexpected suspension: %+v", got)
+			}
+			if len(sessionRepo.revoked) != 1 || sessionRepo.revoked[0] != 1 {
+				t.Errorf("expected sessions of buyer 1 to be revoked, got %v", sessionRepo.revoked)
+			}
+		})
+	}
+}
+
+func TestLiftSuspensionUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		stored   *model.BuyerSuspension
+		wantErr  bool
+		wantLift bool
+	}{
+		{
+			name:     "Success",
+			stored:   &model.BuyerSuspension{ID: 3, BuyerID: 1, Severity: model.SuspensionSeverityLogin, StartsAt: earlier},
+			wantLift: true,
+		},
+		{
+			name:    "AlreadyLifted",
+			stored:  &model.BuyerSuspension{ID: 3, BuyerID: 1, Severity: model.SuspensionSeverityLogin, StartsAt: earlier, LiftedAt: &earlier},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lifted := false
+			suspensionRepo := &mock.MockBuyerSuspensionRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.BuyerSuspension, error) {
+					return tt.stored, nil
+				},
+				LiftFunc: func(_ context.Context, s *model.BuyerSuspension) error {
+					lifted = true
+					if s.LiftedBy == nil || *s.LiftedBy != 7 || !s.LiftedAt.Equal(now) {
+						t.Errorf("unexpected lift: %+v", s)
+					}
+					return nil
+				},
+			}
+
+			uc := buyer.NewLiftSuspensionUseCase(suspensionRepo, mock.NewMockClock(now))
+			_, err := uc.Execute(context.Background(), 3, 7)
+
+			if tt.wantErr {
+				var target *domainErrors.ConflictError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected ConflictError, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if lifted != tt.wantLift {
+				t.Errorf("lifted = %v, want %v", lifted, tt.wantLift)
+			}
+		})
+	}
+}
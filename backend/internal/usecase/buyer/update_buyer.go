@@ -0,0 +1,61 @@
+package buyer
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UpdateBuyerInput is the input of UpdateBuyerUseCase.
+type UpdateBuyerInput struct {
+	Name                      string
+	Organization              string
+	ContactInfo               string
+	BusinessName              string
+	InvoiceRegistrationNumber string
+	Address                   string
+	Phone                     string
+	LicenseNumber             string
+	LicenseExpiresOn          *time.Time
+}
+
+// UpdateBuyerUseCase defines the interface for updating a buyer's details and profile.
+type UpdateBuyerUseCase interface {
+	Execute(ctx context.Context, id int, input *UpdateBuyerInput) (*model.Buyer, error)
+}
+
+type updateBuyerUseCase struct {
+	repo repository.BuyerRepository
+}
+
+var _ UpdateBuyerUseCase = (*updateBuyerUseCase)(nil)
+
+// NewUpdateBuyerUseCase creates a new UpdateBuyerUseCase instance.
+func NewUpdateBuyerUseCase(repo repository.BuyerRepository) UpdateBuyerUseCase {
+	return &updateBuyerUseCase{repo: repo}
+}
+
+// Execute stores the details and profile in place, returning the updated buyer.
+// The paddle number and credit terms have their own endpoints and are left as is.
+func (uc *updateBuyerUseCase) Execute(ctx context.Context, id int, input *UpdateBuyerInput) (*model.Buyer, error) {
+	b := &model.Buyer{
+		ID:           id,
+		Name:         input.Name,
+		Organization: input.Organization,
+		ContactInfo:  input.ContactInfo,
+		BuyerProfile: model.BuyerProfile{
+			BusinessName:              input.BusinessName,
+			InvoiceRegistrationNumber: input.InvoiceRegistrationNumber,
+			Address:                   input.Address,
+			Phone:                     input.Phone,
+			LicenseNumber:             input.LicenseNumber,
+			LicenseExpiresOn:          input.LicenseExpiresOn,
+		},
+	}
+	if err := uc.repo.Update(ctx, b); err != nil {
+		return nil, err
+	}
+	return uc.repo.FindByID(ctx, id)
+}
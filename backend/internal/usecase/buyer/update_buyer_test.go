@@ -0,0 +1,82 @@
+package buyer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestUpdateBuyerUseCase_Execute(t *testing.T) {
+	expires := time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC)
+	input := &buyer.UpdateBuyerInput{
+		Name:                      "Buyer A",
+		Organization:              "丸魚商店",
+		BusinessName:              "株式会社丸魚商店",
+		InvoiceRegistrationNumber: "T1234567890123",
+		LicenseNumber:             "128",
+		LicenseExpiresOn:          &expires,
+	}
+
+	tests := []struct {
+		name      string
+		updateErr error
+		findErr   error
+		wantErr   error
+	}{
+		{name: "Success"},
+		{name: "Invalid", updateErr: &domainErrors.ValidationError{Field: "phone"}, wantErr: &domainErrors.ValidationError{}},
+		{name: "NotFound", updateErr: &domainErrors.NotFoundError{Resource: "Buyer", ID: 1}, wantErr: &domainErrors.NotFoundError{}},
+		{name: "FindError", findErr: errors.New("db error"), wantErr: errors.New("db error")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored *model.Buyer
+			repo := &mock.MockBuyerRepository{
+				UpdateFunc: func(_ context.Context, b *model.Buyer) error {
+					stored = b
+					return tt.updateErr
+				},
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					if tt.findErr != nil {
+						return nil, tt.findErr
+					}
+					return &model.Buyer{ID: id, Name: stored.Name, BuyerProfile: stored.BuyerProfile}, nil
+				},
+			}
+
+			got, err := buyer.NewUpdateBuyerUseCase(repo).Execute(context.Background(), 1, input)
+
+			if stored == nil || stored.ID != 1 || stored.Organization != "丸魚商店" || !stored.LicenseExpiresOn.Equal(expires) {
+				t.Fatalf("unexpected buyer passed to repository: %+v", stored)
+			}
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.InvoiceRegistrationNumber != "T1234567890123" {
+					t.Errorf("unexpected buyer: %+v", got)
+				}
+			case *domainErrors.ValidationError:
+				if !errors.As(err, &want) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+			case *domainErrors.NotFoundError:
+				if !errors.As(err, &want) {
+					t.Errorf("expected not found error, got %v", err)
+				}
+			default:
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package buyer
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UpdateCreditTermsUseCase defines the interface for setting a buyer's credit limit and deposit.
+type UpdateCreditTermsUseCase interface {
+	Execute(ctx context.Context, id int, terms model.CreditTerms) (*model.CreditUtilization, error)
+}
+
+type updateCreditTermsUseCase struct {
+	repo        repository.BuyerRepository
+	utilization GetCreditUtilizationUseCase
+}
+
+var _ UpdateCreditTermsUseCase = (*updateCreditTermsUseCase)(nil)
+
+// NewUpdateCreditTermsUseCase creates a new UpdateCreditTermsUseCase instance.
+func NewUpdateCreditTermsUseCase(
+	buyerRepo repository.BuyerRepository,
+	bidRepo repository.BidRepository,
+	invoiceRepo repository.InvoiceRepository,
+) UpdateCreditTermsUseCase {
+	return &updateCreditTermsUseCase{
+		repo:        buyerRepo,
+		utilization: NewGetCreditUtilizationUseCase(buyerRepo, bidRepo, invoiceRepo),
+	}
+}
+
+// Execute stores the new terms and returns the resulting utilization.
+// 限度額を現在の利用額より下げることもでき、その場合は利用額が下がるまで新たな入札を受け付けない。
+func (uc *updateCreditTermsUseCase) Execute(ctx context.Context, id int, terms model.CreditTerms) (*model.CreditUtilization, error) {
+	if err := terms.Validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateCreditTerms(ctx, id, terms); err != nil {
+		return nil, err
+	}
+	return uc.utilization.Execute(ctx, id)
+}
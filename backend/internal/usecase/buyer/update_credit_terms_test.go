@@ -0,0 +1,87 @@
+package buyer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestUpdateCreditTermsUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name      string
+		terms     model.CreditTerms
+		updateErr error
+		wantErr   error
+	}{
+		{name: "Success", terms: model.CreditTerms{CreditLimit: new(500000), Deposit: 100000}},
+		{name: "RemoveLimit", terms: model.CreditTerms{Deposit: 100000}},
+		{name: "NegativeLimit", terms: model.CreditTerms{CreditLimit: new(-1)}, wantErr: &domainErrors.ValidationError{}},
+		{
+			name:      "NotFound",
+			terms:     model.CreditTerms{CreditLimit: new(500000)},
+			updateErr: &domainErrors.NotFoundError{Resource: "Buyer", ID: 1},
+			wantErr:   &domainErrors.NotFoundError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored *model.CreditTerms
+			buyerRepo := &mock.MockBuyerRepository{
+				UpdateCreditTermsFunc: func(_ context.Context, _ int, terms model.CreditTerms) error {
+					stored = &terms
+					return tt.updateErr
+				},
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					return &model.Buyer{ID: id, CreditTerms: *stored}, nil
+				},
+			}
+			bidRepo := &mock.MockBidRepository{
+				SumLeadingByBuyerIDFunc: func(_ context.Context, _ int) (int, error) {
+					return 120000, nil
+				},
+			}
+			invoiceRepo := &mock.MockInvoiceRepository{
+				ListByBuyerIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+					return []model.Invoice{{Status: model.InvoiceStatusIssued, TotalAmount: 80000}}, nil
+				},
+			}
+
+			uc := buyer.NewUpdateCreditTermsUseCase(buyerRepo, bidRepo, invoiceRepo)
+			got, err := uc.Execute(context.Background(), 1, tt.terms)
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ValidationError:
+					var target *domainErrors.ValidationError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+					if stored != nil {
+						t.Error("expected nothing stored")
+					}
+				case *domainErrors.NotFoundError:
+					var target *domainErrors.NotFoundError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected NotFoundError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.Deposit != tt.terms.Deposit || got.Committed() != 200000 {
+				t.Errorf("unexpected utilization: %+v", got)
+			}
+			if (got.CreditLimit == nil) != (tt.terms.CreditLimit == nil) {
+				t.Errorf("expected limit %v, got %v", tt.terms.CreditLimit, got.CreditLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// UpdateLoginInput is the input of UpdateLoginUseCase.
+type UpdateLoginInput struct {
+	Name     string
+	BidLimit *int
+	Disabled bool
+}
+
+// UpdateLoginUseCase defines the interface for the owner changing a login of the organization.
+type UpdateLoginUseCase interface {
+	// Execute stores the name, bid limit and disabled state of the login.
+	Execute(ctx context.Context, ownerLoginID, loginID int, input *UpdateLoginInput) (*model.Authentication, error)
+}
+
+type updateLoginUseCase struct {
+	authRepo    repository.AuthenticationRepository
+	sessionRepo repository.SessionRepository
+	clock       service.Clock
+}
+
+var _ UpdateLoginUseCase = (*updateLoginUseCase)(nil)
+
+// NewUpdateLoginUseCase creates a new UpdateLoginUseCase instance.
+func NewUpdateLoginUseCase(
+	authRepo repository.AuthenticationRepository,
+	sessionRepo repository.SessionRepository,
+	clock service.Clock,
+) UpdateLoginUseCase {
+	return &updateLoginUseCase{
+		authRepo:    authRepo,
+		sessionRepo: sessionRepo,
+		clock:       clock,
+	}
+}
+
+// Execute updates the login and, when it is disabled, signs it out everywhere.
+// 退職したスタッフのログインは削除せず無効化する。過去の入札がどのログインによるものか追えるようにするため。
+func (uc *updateLoginUseCase) Execute(ctx context.Context, ownerLoginID, loginID int, input *UpdateLoginInput) (*model.Authentication, error) {
+	owner, err := uc.authRepo.FindByID(ctx, ownerLoginID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find authentication: %w", err)
+	}
+	if err := owner.CheckOwner(); err != nil {
+		return nil, err
+	}
+
+	login, err := uc.authRepo.FindByID(ctx, loginID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find authentication: %w", err)
+	}
+	if login.BuyerID != owner.BuyerID {
+		return nil, &apperrors.NotFoundError{Resource: "Authentication", ID: loginID}
+	}
+	if input.Disabled && login.IsOwner() {
+		return nil, &apperrors.ValidationError{Field: "disabled", Message: "the owner login cannot be disabled"}
+	}
+
+	login.Name = input.Name
+	login.BidLimit = input.BidLimit
+	wasDisabled := login.DisabledAt != nil
+	switch {
+	case !input.Disabled:
+		login.DisabledAt = nil
+	case !wasDisabled:
+		now := uc.clock.Now()
+		login.DisabledAt = &now
+	}
+	if err := uc.authRepo.UpdateLogin(ctx, login); err != nil {
+		return nil, err
+	}
+
+	if login.DisabledAt != nil && !wasDisabled {
+		if err := uc.sessionRepo.DeleteAllByLoginID(ctx, login.BuyerID, login.ID, model.SessionRoleBuyer); err != nil {
+			return nil, fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+	}
+	return login, nil
+}
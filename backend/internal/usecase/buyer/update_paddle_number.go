@@ -0,0 +1,43 @@
+package buyer
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UpdatePaddleNumberUseCase defines the interface for assigning a buyer's paddle number.
+type UpdatePaddleNumberUseCase interface {
+	Execute(ctx context.Context, id int, paddleNumber string) (*model.Buyer, error)
+}
+
+type updatePaddleNumberUseCase struct {
+	repo repository.BuyerRepository
+}
+
+var _ UpdatePaddleNumberUseCase = (*updatePaddleNumberUseCase)(nil)
+
+// NewUpdatePaddleNumberUseCase creates a new UpdatePaddleNumberUseCase instance.
+func NewUpdatePaddleNumberUseCase(repo repository.BuyerRepository) UpdatePaddleNumberUseCase {
+	return &updatePaddleNumberUseCase{repo: repo}
+}
+
+// Execute normalizes and stores the paddle number, returning the updated buyer.
+// An empty number takes the paddle back from the buyer.
+func (uc *updatePaddleNumberUseCase) Execute(ctx context.Context, id int, paddleNumber string) (*model.Buyer, error) {
+	n, err := model.NormalizePaddleNumber(paddleNumber)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdatePaddleNumber(ctx, id, n); err != nil {
+		var conflictErr *apperrors.ConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, &apperrors.ConflictError{Message: "paddle number is already assigned to another buyer"}
+		}
+		return nil, err
+	}
+	return uc.repo.FindByID(ctx, id)
+}
@@ -0,0 +1,88 @@
+package buyer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestUpdatePaddleNumberUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		updateErr  error
+		wantStored string
+		wantErr    error
+	}{
+		{name: "Success", input: " b-12 ", wantStored: "B-12"},
+		{name: "Clear", input: ""},
+		{name: "Invalid", input: "12 34", wantErr: &domainErrors.ValidationError{}},
+		{
+			name:       "AlreadyAssigned",
+			input:      "128",
+			updateErr:  &domainErrors.ConflictError{Message: "Buyer already exists"},
+			wantStored: "128",
+			wantErr:    &domainErrors.ConflictError{},
+		},
+		{
+			name:       "NotFound",
+			input:      "128",
+			updateErr:  &domainErrors.NotFoundError{Resource: "Buyer", ID: 1},
+			wantStored: "128",
+			wantErr:    &domainErrors.NotFoundError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := "unchanged"
+			repo := &mock.MockBuyerRepository{
+				UpdatePaddleNumberFunc: func(_ context.Context, _ int, paddleNumber string) error {
+					stored = paddleNumber
+					return tt.updateErr
+				},
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					return &model.Buyer{ID: id, PaddleNumber: stored}, nil
+				},
+			}
+
+			uc := buyer.NewUpdatePaddleNumberUseCase(repo)
+			got, err := uc.Execute(context.Background(), 1, tt.input)
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ValidationError:
+					var target *domainErrors.ValidationError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+					if stored != "unchanged" {
+						t.Errorf("expected nothing stored, got %q", stored)
+					}
+				case *domainErrors.ConflictError:
+					var target *domainErrors.ConflictError
+					if !errors.As(err, &target) || target.Message != "paddle number is already assigned to another buyer" {
+						t.Fatalf("expected paddle ConflictError, got %v", err)
+					}
+				case *domainErrors.NotFoundError:
+					var target *domainErrors.NotFoundError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected NotFoundError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.PaddleNumber != tt.wantStored {
+				t.Errorf("expected paddle %q, got %q", tt.wantStored, got.PaddleNumber)
+			}
+		})
+	}
+}
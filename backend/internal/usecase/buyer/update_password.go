@@ -9,9 +9,9 @@ import (
 	"github.com/seka/fish-auction/backend/internal/domain/repository"
 )
 
-// UpdatePasswordUseCase defines the interface for updating a buyer password.
+// UpdatePasswordUseCase defines the interface for updating the password of a buyer login.
 type UpdatePasswordUseCase interface {
-	Execute(ctx context.Context, buyerID int, currentPassword, newPassword string) error
+	Execute(ctx context.Context, loginID int, currentPassword, newPassword string) error
 }
 
 type updatePasswordUseCase struct {
@@ -27,14 +27,15 @@ func NewUpdatePasswordUseCase(authRepo repository.AuthenticationRepository, sess
 	}
 }
 
-// Execute updates the buyer password after verifying the current one.
-func (uc *updatePasswordUseCase) Execute(ctx context.Context, buyerID int, currentPassword, newPassword string) error {
-	auth, err := uc.authRepo.FindByBuyerID(ctx, buyerID)
+// Execute updates the login's password after verifying the current one.
+// Other logins of the same organization keep their passwords and sessions.
+func (uc *updatePasswordUseCase) Execute(ctx context.Context, loginID int, currentPassword, newPassword string) error {
+	auth, err := uc.authRepo.FindByID(ctx, loginID)
 	if err != nil {
 		return fmt.Errorf("failed to find authentication: %w", err)
 	}
 	if auth == nil {
-		return &apperrors.NotFoundError{Resource: "authentication", ID: buyerID}
+		return &apperrors.NotFoundError{Resource: "authentication", ID: loginID}
 	}
 
 	// 0. Verify current password
@@ -55,12 +56,12 @@ func (uc *updatePasswordUseCase) Execute(ctx context.Context, buyerID int, curre
 	}
 
 	// 2. Update password
-	if err := uc.authRepo.UpdatePassword(ctx, buyerID, hp.Raw()); err != nil {
+	if err := uc.authRepo.UpdatePassword(ctx, auth.ID, hp.Raw()); err != nil {
 		return fmt.Errorf("failed to update password in repository: %w", err)
 	}
 
-	// 3. Invalidate all sessions after password change for security
-	if err := uc.sessionRepo.DeleteAllByUserID(ctx, buyerID, model.SessionRoleBuyer); err != nil {
+	// 3. Invalidate all sessions of this login after password change for security
+	if err := uc.sessionRepo.DeleteAllByLoginID(ctx, auth.BuyerID, auth.ID, model.SessionRoleBuyer); err != nil {
 		return fmt.Errorf("failed to invalidate sessions: %w", err)
 	}
 
@@ -28,11 +28,20 @@ func (m *mockAuthRepoForUpdate) FindByEmail(_ context.Context, _ string) (*model
 	return nil, nil
 }
 func (m *mockAuthRepoForUpdate) FindByBuyerID(_ context.Context, _ int) (*model.Authentication, error) {
+	return nil, nil
+}
+func (m *mockAuthRepoForUpdate) FindByID(_ context.Context, _ int) (*model.Authentication, error) {
 	if m.findErr != nil {
 		return nil, m.findErr
 	}
 	return m.auth, nil
 }
+func (m *mockAuthRepoForUpdate) ListByBuyerID(_ context.Context, _ int) ([]model.Authentication, error) {
+	return nil, nil
+}
+func (m *mockAuthRepoForUpdate) UpdateLogin(_ context.Context, _ *model.Authentication) error {
+	return nil
+}
 func (m *mockAuthRepoForUpdate) UpdateLoginSuccess(_ context.Context, _ int, _ time.Time) error {
 	return nil
 }
@@ -52,22 +61,26 @@ func (m *mockAuthRepoForUpdate) UpdatePassword(_ context.Context, _ int, _ strin
 	return nil
 }
 
+func (m *mockAuthRepoForUpdate) UpdateEmail(_ context.Context, _ int, _ string) error {
+	return nil
+}
+
 type mockSessionRepo struct {
 	repository.SessionRepository
 }
 
-func (m *mockSessionRepo) DeleteAllByUserID(_ context.Context, _ int, _ model.SessionRole) error {
+func (m *mockSessionRepo) DeleteAllByLoginID(_ context.Context, _, _ int, _ model.SessionRole) error {
 	return nil
 }
 
 func TestUpdatePasswordUseCase_Execute(t *testing.T) {
 	password := "CurrentPass1"
 	hash, _ := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	validAuth := &model.Authentication{BuyerID: 1, PasswordHash: string(hash)}
+	validAuth := &model.Authentication{ID: 5, BuyerID: 1, PasswordHash: string(hash)}
 
 	tests := []struct {
 		name        string
-		buyerID     int
+		loginID     int
 		currentPass string
 		newPass     string
 		mockAuth    *model.Authentication
@@ -77,14 +90,14 @@ func TestUpdatePasswordUseCase_Execute(t *testing.T) {
 	}{
 		{
 			name:        "Success",
-			buyerID:     1,
+			loginID:     5,
 			currentPass: "CurrentPass1",
 			newPass:     "NewPass123",
 			mockAuth:    validAuth,
 		},
 		{
 			name:        "IncorrectCurrentPassword",
-			buyerID:     1,
+			loginID:     5,
 			currentPass: "WrongPass1",
 			newPass:     "NewPass123",
 			mockAuth:    validAuth,
@@ -92,14 +105,14 @@ func TestUpdatePasswordUseCase_Execute(t *testing.T) {
 		},
 		{
 			name:        "NotFound",
-			buyerID:     99,
+			loginID:     99,
 			currentPass: "CurrentPass1",
 			mockAuth:    nil,
 			wantErr:     true,
 		},
 		{
 			name:        "FindRepoError",
-			buyerID:     1,
+			loginID:     5,
 			currentPass: "CurrentPass1",
 			mockAuth:    validAuth,
 			findErr:     errors.New("find error"),
@@ -107,7 +120,7 @@ func TestUpdatePasswordUseCase_Execute(t *testing.T) {
 		},
 		{
 			name:        "UpdateRepoError",
-			buyerID:     1,
+			loginID:     5,
 			currentPass: "CurrentPass1",
 			newPass:     "NewPass123",
 			mockAuth:    validAuth,
@@ -116,7 +129,7 @@ func TestUpdatePasswordUseCase_Execute(t *testing.T) {
 		},
 		{
 			name:        "PasswordTooLong",
-			buyerID:     1,
+			loginID:     5,
 			currentPass: "CurrentPass1",
 			newPass:     "this_password_is_definitely_way_too_long_to_be_hashed_by_bcrypt_because_it_exceeds_seventy_two_bytes_limit",
 			mockAuth:    validAuth,
@@ -133,7 +146,7 @@ func TestUpdatePasswordUseCase_Execute(t *testing.T) {
 			}
 			uc := buyer.NewUpdatePasswordUseCase(repo, &mockSessionRepo{})
 
-			err := uc.Execute(context.Background(), tt.buyerID, tt.currentPass, tt.newPass)
+			err := uc.Execute(context.Background(), tt.loginID, tt.currentPass, tt.newPass)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
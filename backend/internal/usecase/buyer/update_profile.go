@@ -0,0 +1,75 @@
+package buyer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UpdateProfileInput is the input of UpdateProfileUseCase.
+type UpdateProfileInput struct {
+	Name                      string
+	Organization              string
+	ContactInfo               string
+	BusinessName              string
+	InvoiceRegistrationNumber string
+	Address                   string
+	Phone                     string
+}
+
+// UpdateProfileUseCase defines the interface for buyers updating their own details.
+// The details belong to the organization, so only its owner login can change them.
+type UpdateProfileUseCase interface {
+	Execute(ctx context.Context, loginID int, input *UpdateProfileInput) (*model.Buyer, error)
+}
+
+type updateProfileUseCase struct {
+	repo     repository.BuyerRepository
+	authRepo repository.AuthenticationRepository
+}
+
+var _ UpdateProfileUseCase = (*updateProfileUseCase)(nil)
+
+// NewUpdateProfileUseCase creates a new UpdateProfileUseCase instance.
+func NewUpdateProfileUseCase(repo repository.BuyerRepository, authRepo repository.AuthenticationRepository) UpdateProfileUseCase {
+	return &updateProfileUseCase{repo: repo, authRepo: authRepo}
+}
+
+// Execute stores the buyer's contact and business details, returning the updated buyer.
+// 仲買人の許可番号と有効期限は市場が確認した値なので、買受人自身には変更させず現在の値を引き継ぐ。
+func (uc *updateProfileUseCase) Execute(ctx context.Context, loginID int, input *UpdateProfileInput) (*model.Buyer, error) {
+	login, err := uc.authRepo.FindByID(ctx, loginID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find authentication: %w", err)
+	}
+	if err := login.CheckOwner(); err != nil {
+		return nil, err
+	}
+	buyerID := login.BuyerID
+
+	current, err := uc.repo.FindByID(ctx, buyerID)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &model.Buyer{
+		ID:           buyerID,
+		Name:         input.Name,
+		Organization: input.Organization,
+		ContactInfo:  input.ContactInfo,
+		BuyerProfile: model.BuyerProfile{
+			BusinessName:              input.BusinessName,
+			InvoiceRegistrationNumber: input.InvoiceRegistrationNumber,
+			Address:                   input.Address,
+			Phone:                     input.Phone,
+			LicenseNumber:             current.LicenseNumber,
+			LicenseExpiresOn:          current.LicenseExpiresOn,
+		},
+	}
+	if err := uc.repo.Update(ctx, b); err != nil {
+		return nil, err
+	}
+	return uc.repo.FindByID(ctx, buyerID)
+}
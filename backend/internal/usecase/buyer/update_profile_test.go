@@ -0,0 +1,89 @@
+package buyer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/buyer"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestUpdateProfileUseCase_Execute(t *testing.T) {
+	expires := time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC)
+	current := &model.Buyer{ID: 1, Name: "Buyer A", BuyerProfile: model.BuyerProfile{LicenseNumber: "128", LicenseExpiresOn: &expires}}
+	authRepo := &mock.MockAuthenticationRepository{
+		FindByIDFunc: func(_ context.Context, id int) (*model.Authentication, error) {
+			switch id {
+			case 11:
+				return &model.Authentication{ID: 11, BuyerID: 1, Role: model.BuyerLoginOwner}, nil
+			case 12:
+				return &model.Authentication{ID: 12, BuyerID: 1, Role: model.BuyerLoginStaff}, nil
+			}
+			return &model.Authentication{ID: id, BuyerID: 9, Role: model.BuyerLoginOwner}, nil
+		},
+	}
+
+	t.Run("KeepsLicense", func(t *testing.T) {
+		var stored *model.Buyer
+		repo := &mock.MockBuyerRepository{
+			FindByIDFunc: func(_ context.Context, _ int) (*model.Buyer, error) {
+				if stored != nil {
+					return stored, nil
+				}
+				return current, nil
+			},
+			UpdateFunc: func(_ context.Context, b *model.Buyer) error {
+				stored = b
+				return nil
+			},
+		}
+
+		got, err := buyer.NewUpdateProfileUseCase(repo, authRepo).Execute(context.Background(), 11, &buyer.UpdateProfileInput{
+			Name:  "Buyer A",
+			Phone: "090-0000-0000",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.Phone != "090-0000-0000" || got.LicenseNumber != "128" || got.LicenseExpiresOn != &expires {
+			t.Errorf("unexpected buyer: %+v", got)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := &mock.MockBuyerRepository{
+			FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+				return nil, &domainErrors.NotFoundError{Resource: "Buyer", ID: id}
+			},
+			UpdateFunc: func(_ context.Context, _ *model.Buyer) error {
+				t.Error("Update should not be called")
+				return nil
+			},
+		}
+
+		_, err := buyer.NewUpdateProfileUseCase(repo, authRepo).Execute(context.Background(), 19, &buyer.UpdateProfileInput{Name: "X"})
+		var notFoundErr *domainErrors.NotFoundError
+		if !errors.As(err, &notFoundErr) {
+			t.Errorf("expected not found error, got %v", err)
+		}
+	})
+
+	t.Run("StaffLogin", func(t *testing.T) {
+		repo := &mock.MockBuyerRepository{
+			UpdateFunc: func(_ context.Context, _ *model.Buyer) error {
+				t.Error("Update should not be called")
+				return nil
+			},
+		}
+
+		_, err := buyer.NewUpdateProfileUseCase(repo, authRepo).Execute(context.Background(), 12, &buyer.UpdateProfileInput{Name: "X"})
+		var forbiddenErr *domainErrors.ForbiddenError
+		if !errors.As(err, &forbiddenErr) {
+			t.Errorf("expected forbidden error, got %v", err)
+		}
+	})
+}
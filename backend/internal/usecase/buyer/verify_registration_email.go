@@ -0,0 +1,69 @@
+package buyer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// VerifyRegistrationEmailUseCase defines the interface for confirming a new applicant's email address.
+type VerifyRegistrationEmailUseCase interface {
+	// Execute marks the applicant's email as verified, which puts the application in the admin review queue.
+	Execute(ctx context.Context, token string) error
+}
+
+type verifyRegistrationEmailUseCase struct {
+	buyerRepo  repository.BuyerRepository
+	verifyRepo repository.EmailVerificationRepository
+	txMgr      repository.TransactionManager
+	clock      service.Clock
+}
+
+var _ VerifyRegistrationEmailUseCase = (*verifyRegistrationEmailUseCase)(nil)
+
+// NewVerifyRegistrationEmailUseCase creates a new instance of VerifyRegistrationEmailUseCase.
+func NewVerifyRegistrationEmailUseCase(
+	buyerRepo repository.BuyerRepository,
+	verifyRepo repository.EmailVerificationRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) VerifyRegistrationEmailUseCase {
+	return &verifyRegistrationEmailUseCase{
+		buyerRepo:  buyerRepo,
+		verifyRepo: verifyRepo,
+		txMgr:      txMgr,
+		clock:      clock,
+	}
+}
+
+func (u *verifyRegistrationEmailUseCase) Execute(ctx context.Context, token string) error {
+	hash := sha256.Sum256([]byte(token))
+	verification, err := u.verifyRepo.FindByTokenHash(ctx, hex.EncodeToString(hash[:]))
+	if err != nil {
+		return fmt.Errorf("failed to find email verification token: %w", err)
+	}
+	now := u.clock.Now()
+	if verification == nil || now.After(verification.ExpiresAt) {
+		return &apperrors.UnauthorizedError{Message: "Invalid or expired token"}
+	}
+
+	return u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		buyer, err := u.buyerRepo.FindByIDWithLock(txCtx, verification.BuyerID)
+		if err != nil {
+			return fmt.Errorf("failed to find buyer: %w", err)
+		}
+		buyer.Registration.VerifyEmail(now)
+		if err := u.buyerRepo.UpdateRegistration(txCtx, buyer.ID, buyer.Registration); err != nil {
+			return fmt.Errorf("failed to update registration: %w", err)
+		}
+		if err := u.verifyRepo.DeleteAllByBuyerID(txCtx, buyer.ID); err != nil {
+			return fmt.Errorf("failed to invalidate email verification tokens: %w", err)
+		}
+		return nil
+	})
+}
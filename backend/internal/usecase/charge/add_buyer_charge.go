@@ -0,0 +1,115 @@
+package charge
+
+import (
+	"context"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// AddBuyerChargeInput holds the charge an admin bills to a buyer for an auction day.
+type AddBuyerChargeInput struct {
+	AuctionID    int
+	BuyerID      int
+	ChargeItemID int
+	Quantity     int
+	Note         string
+}
+
+// AddBuyerChargeUseCase defines the interface for billing a miscellaneous charge to a buyer.
+type AddBuyerChargeUseCase interface {
+	Execute(ctx context.Context, adminID int, input *AddBuyerChargeInput) (*model.BuyerCharge, error)
+}
+
+type addBuyerChargeUseCase struct {
+	auctionRepo    repository.AuctionRepository
+	buyerRepo      repository.BuyerRepository
+	chargeItemRepo repository.ChargeItemRepository
+	chargeRepo     repository.BuyerChargeRepository
+	invoiceRepo    repository.InvoiceRepository
+	txMgr          repository.TransactionManager
+}
+
+var _ AddBuyerChargeUseCase = (*addBuyerChargeUseCase)(nil)
+
+// NewAddBuyerChargeUseCase creates a new AddBuyerChargeUseCase instance.
+func NewAddBuyerChargeUseCase(
+	auctionRepo repository.AuctionRepository,
+	buyerRepo repository.BuyerRepository,
+	chargeItemRepo repository.ChargeItemRepository,
+	chargeRepo repository.BuyerChargeRepository,
+	invoiceRepo repository.InvoiceRepository,
+	txMgr repository.TransactionManager,
+) AddBuyerChargeUseCase {
+	return &addBuyerChargeUseCase{
+		auctionRepo:    auctionRepo,
+		buyerRepo:      buyerRepo,
+		chargeItemRepo: chargeItemRepo,
+		chargeRepo:     chargeRepo,
+		invoiceRepo:    invoiceRepo,
+		txMgr:          txMgr,
+	}
+}
+
+// Execute records quantity units of a catalog charge for the buyer on the auction.
+// The charge is billed the next time the auction's draft invoices are generated.
+func (uc *addBuyerChargeUseCase) Execute(ctx context.Context, adminID int, input *AddBuyerChargeInput) (*model.BuyerCharge, error) {
+	auction, err := uc.auctionRepo.FindByID(ctx, input.AuctionID)
+	if err != nil {
+		return nil, err
+	}
+	if auction.Status == model.AuctionStatusCancelled {
+		return nil, &apperrors.ConflictError{Message: "charges cannot be added to a canceled auction"}
+	}
+	item, err := uc.chargeItemRepo.FindByID(ctx, input.ChargeItemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.VenueID != auction.VenueID {
+		return nil, &apperrors.ValidationError{Field: "charge_item_id", Message: "charge item belongs to another venue"}
+	}
+	if _, err := uc.buyerRepo.FindByID(ctx, input.BuyerID); err != nil {
+		return nil, err
+	}
+
+	charge, err := model.NewBuyerCharge(item, input.AuctionID, input.BuyerID, input.Quantity, input.Note, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *model.BuyerCharge
+	err = uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := ensureNotInvoiced(txCtx, uc.invoiceRepo, input.AuctionID, input.BuyerID); err != nil {
+			return err
+		}
+		created, err = uc.chargeRepo.Create(txCtx, charge)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// ensureNotInvoiced rejects changes to a buyer's charges once their invoice for the auction has been issued.
+// draft の請求書は行ロックを取り、計上中に発行されないようにする。
+func ensureNotInvoiced(ctx context.Context, invoiceRepo repository.InvoiceRepository, auctionID, buyerID int) error {
+	invoices, err := invoiceRepo.ListByAuctionID(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+	for _, inv := range invoices {
+		if inv.BuyerID != buyerID {
+			continue
+		}
+		locked, err := invoiceRepo.FindByIDWithLock(ctx, inv.ID)
+		if err != nil {
+			return err
+		}
+		if locked.Status != model.InvoiceStatusDraft {
+			return &apperrors.ConflictError{Message: "the buyer's invoice for this auction has already been issued"}
+		}
+	}
+	return nil
+}
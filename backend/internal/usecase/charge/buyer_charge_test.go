@@ -0,0 +1,180 @@
+package charge_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/charge"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestAddBuyerChargeUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		auction    *model.Auction
+		item       *model.ChargeItem
+		invoices   []model.Invoice
+		quantity   int
+		wantErr    error
+		wantAmount int
+	}{
+		{
+			name:       "Success",
+			auction:    &model.Auction{ID: 1, VenueID: 1, Status: model.AuctionStatusCompleted},
+			item:       &model.ChargeItem{ID: 5, VenueID: 1, Name: "氷", UnitPrice: 300, TaxRate: model.TaxRateStandard, Active: true},
+			quantity:   3,
+			wantAmount: 900,
+		},
+		{
+			name:       "DraftInvoiceAllowed",
+			auction:    &model.Auction{ID: 1, VenueID: 1, Status: model.AuctionStatusCompleted},
+			item:       &model.ChargeItem{ID: 5, VenueID: 1, Name: "氷", UnitPrice: 300, TaxRate: model.TaxRateStandard, Active: true},
+			invoices:   []model.Invoice{{ID: 7, BuyerID: 2, Status: model.InvoiceStatusDraft}},
+			quantity:   1,
+			wantAmount: 300,
+		},
+		{
+			name:     "InvoiceIssued",
+			auction:  &model.Auction{ID: 1, VenueID: 1, Status: model.AuctionStatusCompleted},
+			item:     &model.ChargeItem{ID: 5, VenueID: 1, Name: "氷", UnitPrice: 300, TaxRate: model.TaxRateStandard, Active: true},
+			invoices: []model.Invoice{{ID: 7, BuyerID: 2, Status: model.InvoiceStatusIssued}},
+			quantity: 1,
+			wantErr:  &domainErrors.ConflictError{},
+		},
+		{
+			name:     "OtherVenueItem",
+			auction:  &model.Auction{ID: 1, VenueID: 1, Status: model.AuctionStatusCompleted},
+			item:     &model.ChargeItem{ID: 5, VenueID: 2, Name: "氷", Active: true},
+			quantity: 1,
+			wantErr:  &domainErrors.ValidationError{},
+		},
+		{
+			name:     "CanceledAuction",
+			auction:  &model.Auction{ID: 1, VenueID: 1, Status: model.AuctionStatusCancelled},
+			quantity: 1,
+			wantErr:  &domainErrors.ConflictError{},
+		},
+		{
+			name:     "InvalidQuantity",
+			auction:  &model.Auction{ID: 1, VenueID: 1, Status: model.AuctionStatusCompleted},
+			item:     &model.ChargeItem{ID: 5, VenueID: 1, Name: "氷", Active: true},
+			quantity: 0,
+			wantErr:  &domainErrors.ValidationError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.Auction, error) { return tt.auction, nil },
+			}
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) { return &model.Buyer{ID: id}, nil },
+			}
+			itemRepo := &mock.MockChargeItemRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.ChargeItem, error) { return tt.item, nil },
+			}
+			chargeRepo := &mock.MockBuyerChargeRepository{
+				CreateFunc: func(_ context.Context, c *model.BuyerCharge) (*model.BuyerCharge, error) {
+					created = true
+					c.ID = 12
+					return c, nil
+				},
+			}
+			invoiceRepo := &mock.MockInvoiceRepository{
+				ListByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) { return tt.invoices, nil },
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Invoice, error) {
+					for i := range tt.invoices {
+						if tt.invoices[i].ID == id {
+							return &tt.invoices[i], nil
+						}
+					}
+					return nil, &domainErrors.NotFoundError{Resource: "Invoice", ID: id}
+				},
+			}
+
+			uc := charge.NewAddBuyerChargeUseCase(auctionRepo, buyerRepo, itemRepo, chargeRepo, invoiceRepo, &mock.MockTransactionManager{})
+			got, err := uc.Execute(context.Background(), 9, &charge.AddBuyerChargeInput{
+				AuctionID: 1, BuyerID: 2, ChargeItemID: 5, Quantity: tt.quantity,
+			})
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ConflictError:
+					var target *domainErrors.ConflictError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ConflictError, got %v", err)
+					}
+				case *domainErrors.ValidationError:
+					var target *domainErrors.ValidationError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+				}
+				if created {
+					t.Error("expected no charge to be created")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Amount() != tt.wantAmount {
+				t.Errorf("expected amount %d, got %d", tt.wantAmount, got.Amount())
+			}
+			if got.RecordedBy == nil || *got.RecordedBy != 9 {
+				t.Errorf("expected recorded by admin 9, got %v", got.RecordedBy)
+			}
+		})
+	}
+}
+
+func TestDeleteBuyerChargeUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     model.InvoiceStatus
+		wantErr    bool
+		wantDelete bool
+	}{
+		{name: "DraftInvoice", status: model.InvoiceStatusDraft, wantDelete: true},
+		{name: "PaidInvoice", status: model.InvoiceStatusPaid, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+			chargeRepo := &mock.MockBuyerChargeRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.BuyerCharge, error) {
+					return &model.BuyerCharge{ID: id, AuctionID: 1, BuyerID: 2}, nil
+				},
+				DeleteFunc: func(_ context.Context, _ int) error {
+					deleted = true
+					return nil
+				},
+			}
+			invoiceRepo := &mock.MockInvoiceRepository{
+				ListByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+					return []model.Invoice{{ID: 7, BuyerID: 2, Status: tt.status}, {ID: 8, BuyerID: 3, Status: model.InvoiceStatusIssued}}, nil
+				},
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Invoice, error) {
+					return &model.Invoice{ID: id, BuyerID: 2, Status: tt.status}, nil
+				},
+			}
+
+			uc := charge.NewDeleteBuyerChargeUseCase(chargeRepo, invoiceRepo, &mock.MockTransactionManager{})
+			err := uc.Execute(context.Background(), 12)
+
+			var conflictErr *domainErrors.ConflictError
+			if tt.wantErr != errors.As(err, &conflictErr) {
+				t.Fatalf("expected conflict=%v, got %v", tt.wantErr, err)
+			}
+			if deleted != tt.wantDelete {
+				t.Errorf("expected deleted=%v, got %v", tt.wantDelete, deleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package charge_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/charge"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestCreateChargeItemUseCase_Execute(t *testing.T) {
+	venueNotFound := &domainErrors.NotFoundError{Resource: "Venue", ID: 9}
+
+	tests := []struct {
+		name     string
+		item     *model.ChargeItem
+		venueErr error
+		wantErr  bool
+	}{
+		{name: "Success", item: &model.ChargeItem{VenueID: 1, Name: " 氷 ", Unit: "袋", UnitPrice: 300, TaxRate: model.TaxRateStandard}},
+		{name: "InvalidTaxRate", item: &model.ChargeItem{VenueID: 1, Name: "氷", TaxRate: 3}, wantErr: true},
+		{name: "VenueNotFound", item: &model.ChargeItem{VenueID: 9, Name: "氷", TaxRate: model.TaxRateStandard}, venueErr: venueNotFound, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			venueRepo := &mock.MockVenueRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Venue, error) {
+					if tt.venueErr != nil {
+						return nil, tt.venueErr
+					}
+					return &model.Venue{ID: id}, nil
+				},
+			}
+			itemRepo := &mock.MockChargeItemRepository{}
+
+			uc := charge.NewCreateChargeItemUseCase(venueRepo, itemRepo)
+			got, err := uc.Execute(context.Background(), tt.item)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tt.venueErr != nil && !errors.Is(err, tt.venueErr) {
+					t.Fatalf("expected %v, got %v", tt.venueErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Name != "氷" || !got.Active {
+				t.Errorf("expected trimmed active item, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestUpdateChargeItemUseCase_Execute_KeepsVenue(t *testing.T) {
+	var saved *model.ChargeItem
+	itemRepo := &mock.MockChargeItemRepository{
+		FindByIDFunc: func(_ context.Context, id int) (*model.ChargeItem, error) {
+			return &model.ChargeItem{ID: id, VenueID: 1}, nil
+		},
+		UpdateFunc: func(_ context.Context, item *model.ChargeItem) (*model.ChargeItem, error) {
+			saved = item
+			return item, nil
+		},
+	}
+
+	uc := charge.NewUpdateChargeItemUseCase(itemRepo)
+	_, err := uc.Execute(context.Background(), &model.ChargeItem{ID: 5, VenueID: 2, Name: "氷", UnitPrice: 350, TaxRate: model.TaxRateStandard})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if saved.VenueID != 1 {
+		t.Errorf("expected venue to stay 1, got %d", saved.VenueID)
+	}
+	if saved.Active {
+		t.Error("expected item to be deactivated")
+	}
+}
@@ -0,0 +1,41 @@
+package charge
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// CreateChargeItemUseCase defines the interface for adding an entry to a venue's charge catalog.
+type CreateChargeItemUseCase interface {
+	Execute(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error)
+}
+
+type createChargeItemUseCase struct {
+	venueRepo      repository.VenueRepository
+	chargeItemRepo repository.ChargeItemRepository
+}
+
+var _ CreateChargeItemUseCase = (*createChargeItemUseCase)(nil)
+
+// NewCreateChargeItemUseCase creates a new CreateChargeItemUseCase instance.
+func NewCreateChargeItemUseCase(
+	venueRepo repository.VenueRepository,
+	chargeItemRepo repository.ChargeItemRepository,
+) CreateChargeItemUseCase {
+	return &createChargeItemUseCase{venueRepo: venueRepo, chargeItemRepo: chargeItemRepo}
+}
+
+// Execute validates and stores a new, active catalog entry for the venue.
+func (uc *createChargeItemUseCase) Execute(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error) {
+	item.Normalize()
+	if err := item.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := uc.venueRepo.FindByID(ctx, item.VenueID); err != nil {
+		return nil, err
+	}
+	item.Active = true
+	return uc.chargeItemRepo.Create(ctx, item)
+}
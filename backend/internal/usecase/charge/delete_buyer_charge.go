@@ -0,0 +1,43 @@
+package charge
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// DeleteBuyerChargeUseCase defines the interface for removing a charge billed to a buyer.
+type DeleteBuyerChargeUseCase interface {
+	Execute(ctx context.Context, id int) error
+}
+
+type deleteBuyerChargeUseCase struct {
+	chargeRepo  repository.BuyerChargeRepository
+	invoiceRepo repository.InvoiceRepository
+	txMgr       repository.TransactionManager
+}
+
+var _ DeleteBuyerChargeUseCase = (*deleteBuyerChargeUseCase)(nil)
+
+// NewDeleteBuyerChargeUseCase creates a new DeleteBuyerChargeUseCase instance.
+func NewDeleteBuyerChargeUseCase(
+	chargeRepo repository.BuyerChargeRepository,
+	invoiceRepo repository.InvoiceRepository,
+	txMgr repository.TransactionManager,
+) DeleteBuyerChargeUseCase {
+	return &deleteBuyerChargeUseCase{chargeRepo: chargeRepo, invoiceRepo: invoiceRepo, txMgr: txMgr}
+}
+
+// Execute removes a charge that has not yet been billed on an issued invoice.
+func (uc *deleteBuyerChargeUseCase) Execute(ctx context.Context, id int) error {
+	return uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		charge, err := uc.chargeRepo.FindByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if err := ensureNotInvoiced(txCtx, uc.invoiceRepo, charge.AuctionID, charge.BuyerID); err != nil {
+			return err
+		}
+		return uc.chargeRepo.Delete(txCtx, id)
+	})
+}
@@ -0,0 +1,29 @@
+package charge
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListBuyerChargesUseCase defines the interface for listing the charges billed on an auction.
+type ListBuyerChargesUseCase interface {
+	Execute(ctx context.Context, auctionID int) ([]model.BuyerCharge, error)
+}
+
+type listBuyerChargesUseCase struct {
+	chargeRepo repository.BuyerChargeRepository
+}
+
+var _ ListBuyerChargesUseCase = (*listBuyerChargesUseCase)(nil)
+
+// NewListBuyerChargesUseCase creates a new ListBuyerChargesUseCase instance.
+func NewListBuyerChargesUseCase(chargeRepo repository.BuyerChargeRepository) ListBuyerChargesUseCase {
+	return &listBuyerChargesUseCase{chargeRepo: chargeRepo}
+}
+
+// Execute returns the charges of every buyer on the auction.
+func (uc *listBuyerChargesUseCase) Execute(ctx context.Context, auctionID int) ([]model.BuyerCharge, error) {
+	return uc.chargeRepo.ListByAuctionID(ctx, auctionID)
+}
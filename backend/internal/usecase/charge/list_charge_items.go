@@ -0,0 +1,29 @@
+package charge
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListChargeItemsUseCase defines the interface for listing a venue's charge catalog.
+type ListChargeItemsUseCase interface {
+	Execute(ctx context.Context, venueID int) ([]model.ChargeItem, error)
+}
+
+type listChargeItemsUseCase struct {
+	chargeItemRepo repository.ChargeItemRepository
+}
+
+var _ ListChargeItemsUseCase = (*listChargeItemsUseCase)(nil)
+
+// NewListChargeItemsUseCase creates a new ListChargeItemsUseCase instance.
+func NewListChargeItemsUseCase(chargeItemRepo repository.ChargeItemRepository) ListChargeItemsUseCase {
+	return &listChargeItemsUseCase{chargeItemRepo: chargeItemRepo}
+}
+
+// Execute returns every catalog entry of the venue, including inactive ones.
+func (uc *listChargeItemsUseCase) Execute(ctx context.Context, venueID int) ([]model.ChargeItem, error) {
+	return uc.chargeItemRepo.ListByVenueID(ctx, venueID)
+}
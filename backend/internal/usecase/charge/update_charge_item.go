@@ -0,0 +1,39 @@
+package charge
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UpdateChargeItemUseCase defines the interface for editing or retiring a charge catalog entry.
+type UpdateChargeItemUseCase interface {
+	Execute(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error)
+}
+
+type updateChargeItemUseCase struct {
+	chargeItemRepo repository.ChargeItemRepository
+}
+
+var _ UpdateChargeItemUseCase = (*updateChargeItemUseCase)(nil)
+
+// NewUpdateChargeItemUseCase creates a new UpdateChargeItemUseCase instance.
+func NewUpdateChargeItemUseCase(chargeItemRepo repository.ChargeItemRepository) UpdateChargeItemUseCase {
+	return &updateChargeItemUseCase{chargeItemRepo: chargeItemRepo}
+}
+
+// Execute replaces the catalog entry. Charges already recorded keep the values they were billed at.
+// 品目は計上済みの諸掛から参照されるため削除せず、active = false で新規計上を止める。
+func (uc *updateChargeItemUseCase) Execute(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error) {
+	item.Normalize()
+	if err := item.Validate(); err != nil {
+		return nil, err
+	}
+	current, err := uc.chargeItemRepo.FindByID(ctx, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	item.VenueID = current.VenueID
+	return uc.chargeItemRepo.Update(ctx, item)
+}
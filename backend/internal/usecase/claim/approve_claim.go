@@ -0,0 +1,154 @@
+package claim
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
+)
+
+// ApproveClaimInput holds an admin's decision on a claim.
+// Amount zero approves the requested amount.
+type ApproveClaimInput struct {
+	Amount   int
+	Clawback bool
+	Note     string
+}
+
+// ApproveClaimUseCase defines the interface for approving a claim and issuing its credit note.
+type ApproveClaimUseCase interface {
+	// Execute approves the claim, credits the buyer's invoice and optionally claws back from the fisherman.
+	Execute(ctx context.Context, id, adminID int, input ApproveClaimInput) (*model.Claim, error)
+}
+
+type approveClaimUseCase struct {
+	claimRepo      repository.ClaimRepository
+	invoiceRepo    repository.InvoiceRepository
+	creditNoteRepo repository.CreditNoteRepository
+	paymentRepo    repository.PaymentRepository
+	adjustmentRepo repository.SettlementAdjustmentRepository
+	txMgr          repository.TransactionManager
+	clock          service.Clock
+}
+
+var _ ApproveClaimUseCase = (*approveClaimUseCase)(nil)
+
+// NewApproveClaimUseCase creates a new ApproveClaimUseCase instance.
+func NewApproveClaimUseCase(
+	claimRepo repository.ClaimRepository,
+	invoiceRepo repository.InvoiceRepository,
+	creditNoteRepo repository.CreditNoteRepository,
+	paymentRepo repository.PaymentRepository,
+	adjustmentRepo repository.SettlementAdjustmentRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) ApproveClaimUseCase {
+	return &approveClaimUseCase{
+		claimRepo:      claimRepo,
+		invoiceRepo:    invoiceRepo,
+		creditNoteRepo: creditNoteRepo,
+		paymentRepo:    paymentRepo,
+		adjustmentRepo: adjustmentRepo,
+		txMgr:          txMgr,
+		clock:          clock,
+	}
+}
+
+// Execute approves a claim.
+// 赤伝は落札品が計上された請求書に対して、その明細と同じ税率で発行する。
+// 下書きの請求書は再生成で消えるため、発行済みになるまで承認できない。
+// 入金済みの請求書で過入金になった分は入金へ戻し、買受人の前受金として次の請求書に充当する。
+func (uc *approveClaimUseCase) Execute(ctx context.Context, id, adminID int, input ApproveClaimInput) (*model.Claim, error) {
+	var approved *model.Claim
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		claim, err := uc.claimRepo.FindByIDWithLock(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		now := uc.clock.Now()
+		if err := claim.Approve(adminID, input.Amount, input.Clawback, input.Note, now); err != nil {
+			return err
+		}
+
+		invoice, err := uc.findInvoice(txCtx, claim)
+		if err != nil {
+			return err
+		}
+		taxRate, ok := invoice.LineTaxRate(claim.ItemID)
+		if !ok {
+			return &apperrors.ConflictError{Message: "the claimed lot is not on the buyer's invoice"}
+		}
+
+		note := model.NewCreditNote(claim, invoice.ID, taxRate)
+		if note.TotalAmount > invoice.NetAmount() {
+			return &apperrors.ConflictError{Message: "credit exceeds the remaining invoice amount"}
+		}
+		note, err = uc.creditNoteRepo.Create(txCtx, note)
+		if err != nil {
+			return fmt.Errorf("failed to create credit note: %w", err)
+		}
+		invoice.ApplyCredit(note.TotalAmount, now)
+		if err := payment.ReleaseOverpayment(txCtx, uc.paymentRepo, invoice); err != nil {
+			return err
+		}
+		if err := uc.invoiceRepo.Update(txCtx, invoice); err != nil {
+			return fmt.Errorf("failed to update invoice: %w", err)
+		}
+
+		if claim.Clawback {
+			if _, err := uc.adjustmentRepo.Create(txCtx, &model.SettlementAdjustment{
+				FishermanID:  claim.FishermanID,
+				CreditNoteID: note.ID,
+				Amount:       note.Amount,
+				Description:  fmt.Sprintf("クレーム控除 %s", claim.FishType),
+			}); err != nil {
+				return fmt.Errorf("failed to create settlement adjustment: %w", err)
+			}
+		}
+
+		if err := uc.claimRepo.Update(txCtx, claim); err != nil {
+			return fmt.Errorf("failed to update claim: %w", err)
+		}
+		if err := uc.claimRepo.CreateEvent(txCtx, &model.ClaimEvent{
+			ClaimID:   claim.ID,
+			Action:    model.ClaimActionApproved,
+			ActorType: model.ClaimActorAdmin,
+			ActorID:   adminID,
+			Amount:    claim.ApprovedAmount,
+			Note:      claim.ResolutionNote,
+		}); err != nil {
+			return fmt.Errorf("failed to record claim event: %w", err)
+		}
+
+		claim.CreditNote = note
+		approved = claim
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return approved, nil
+}
+
+// findInvoice returns the buyer's locked invoice for the auction of the claimed lot.
+func (uc *approveClaimUseCase) findInvoice(ctx context.Context, claim *model.Claim) (*model.Invoice, error) {
+	invoices, err := uc.invoiceRepo.ListByBuyerID(ctx, claim.BuyerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoices: %w", err)
+	}
+	for _, inv := range invoices {
+		if inv.AuctionID != claim.AuctionID {
+			continue
+		}
+		if inv.Status == model.InvoiceStatusDraft {
+			return nil, &apperrors.ConflictError{Message: "the invoice must be issued before a claim can be approved"}
+		}
+		return uc.invoiceRepo.FindByIDWithLock(ctx, inv.ID)
+	}
+	return nil, &apperrors.ConflictError{Message: "no invoice has been issued for the claimed lot"}
+}
@@ -0,0 +1,239 @@
+package claim_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
+	"github.com/seka/fish-auction/backend/internal/usecase/invoice"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestApproveClaimUseCase_Execute(t *testing.T) {
+	now := time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+	itemID := 10
+
+	pendingClaim := func() *model.Claim {
+		return &model.Claim{
+			ID: 1, BuyerID: 2, ItemID: itemID, AuctionID: 4, FishermanID: 3, FishType: "Tuna",
+			PurchasePrice: 5000, RequestedAmount: 1000, Status: model.ClaimStatusPending,
+		}
+	}
+	issuedInvoice := func() *model.Invoice {
+		return &model.Invoice{
+			ID: 7, BuyerID: 2, AuctionID: 4, Status: model.InvoiceStatusIssued, TotalAmount: 5400,
+			Lines: []model.InvoiceLine{{ItemID: &itemID, Amount: 5000, TaxRate: model.TaxRateReduced}},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		claim          *model.Claim
+		invoice        *model.Invoice
+		input          claim.ApproveClaimInput
+		wantErr        any
+		wantCredited   int
+		wantAdjustment bool
+	}{
+		{
+			name:           "CreditWithClawback",
+			claim:          pendingClaim(),
+			invoice:        issuedInvoice(),
+			input:          claim.ApproveClaimInput{Clawback: true, Note: "確認済み"},
+			wantCredited:   1080,
+			wantAdjustment: true,
+		},
+		{
+			name:         "AdjustedAmountWithoutClawback",
+			claim:        pendingClaim(),
+			invoice:      issuedInvoice(),
+			input:        claim.ApproveClaimInput{Amount: 500},
+			wantCredited: 540,
+		},
+		{
+			name:    "DraftInvoice",
+			claim:   pendingClaim(),
+			invoice: &model.Invoice{ID: 7, BuyerID: 2, AuctionID: 4, Status: model.InvoiceStatusDraft},
+			wantErr: &domainErrors.ConflictError{},
+		},
+		{
+			name:    "NoInvoice",
+			claim:   pendingClaim(),
+			wantErr: &domainErrors.ConflictError{},
+		},
+		{
+			name:    "AlreadyResolved",
+			claim:   &model.Claim{ID: 1, Status: model.ClaimStatusRejected},
+			invoice: issuedInvoice(),
+			wantErr: &domainErrors.ConflictError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updatedInvoice *model.Invoice
+			var adjustments []model.SettlementAdjustment
+			var events []model.ClaimEvent
+
+			claimRepo := &mock.MockClaimRepository{
+				FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.Claim, error) {
+					return tt.claim, nil
+				},
+				CreateEventFunc: func(_ context.Context, e *model.ClaimEvent) error {
+					events = append(events, *e)
+					return nil
+				},
+			}
+			invoiceRepo := &mock.MockInvoiceRepository{
+				ListByBuyerIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+					if tt.invoice == nil {
+						return nil, nil
+					}
+					return []model.Invoice{*tt.invoice}, nil
+				},
+				FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.Invoice, error) {
+					return tt.invoice, nil
+				},
+				UpdateFunc: func(_ context.Context, inv *model.Invoice) error {
+					updatedInvoice = inv
+					return nil
+				},
+			}
+			creditNoteRepo := &mock.MockCreditNoteRepository{
+				CreateFunc: func(_ context.Context, n *model.CreditNote) (*model.CreditNote, error) {
+					created := *n
+					created.ID = 30
+					return &created, nil
+				},
+			}
+			adjustmentRepo := &mock.MockSettlementAdjustmentRepository{
+				CreateFunc: func(_ context.Context, a *model.SettlementAdjustment) (*model.SettlementAdjustment, error) {
+					adjustments = append(adjustments, *a)
+					return a, nil
+				},
+			}
+
+			uc := claim.NewApproveClaimUseCase(claimRepo, invoiceRepo, creditNoteRepo, &mock.MockPaymentRepository{}, adjustmentRepo, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			got, err := uc.Execute(context.Background(), 1, 9, tt.input)
+
+			if tt.wantErr != nil {
+				var target *domainErrors.ConflictError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected ConflictError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.Status != model.ClaimStatusApproved || got.CreditNote == nil || got.CreditNote.ID != 30 {
+				t.Errorf("unexpected claim: %+v", got)
+			}
+			if updatedInvoice == nil || updatedInvoice.CreditedAmount != tt.wantCredited {
+				t.Errorf("expected credited %d, got %+v", tt.wantCredited, updatedInvoice)
+			}
+			if tt.wantAdjustment != (len(adjustments) == 1) {
+				t.Errorf("unexpected adjustments: %+v", adjustments)
+			}
+			if tt.wantAdjustment && (adjustments[0].FishermanID != 3 || adjustments[0].Amount != 1000 || adjustments[0].CreditNoteID != 30) {
+				t.Errorf("unexpected adjustment: %+v", adjustments[0])
+			}
+			if len(events) != 1 || events[0].Action != model.ClaimActionApproved || events[0].ActorID != 9 {
+				t.Errorf("expected an approved event by the admin, got %+v", events)
+			}
+		})
+	}
+}
+
+func TestApproveClaimUseCase_Execute_CreditAppliedToNextInvoice(t *testing.T) {
+	now := time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+	itemID := 10
+
+	paidAt := now.Add(-24 * time.Hour)
+	invoices := map[int]*model.Invoice{
+		7: {
+			ID: 7, BuyerID: 2, AuctionID: 4, Status: model.InvoiceStatusPaid, TotalAmount: 5400, PaidAmount: 5400, PaidAt: &paidAt,
+			Lines: []model.InvoiceLine{{ItemID: &itemID, Amount: 5000, TaxRate: model.TaxRateReduced}},
+		},
+		8: {ID: 8, BuyerID: 2, AuctionID: 5, Status: model.InvoiceStatusDraft, TotalAmount: 3000},
+	}
+	allocations := []model.PaymentAllocation{{PaymentID: 1, InvoiceID: 7, Amount: 5400}}
+
+	invoiceRepo := &mock.MockInvoiceRepository{
+		ListByBuyerIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+			return []model.Invoice{*invoices[7], *invoices[8]}, nil
+		},
+		FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Invoice, error) {
+			inv := *invoices[id]
+			return &inv, nil
+		},
+		UpdateFunc: func(_ context.Context, inv *model.Invoice) error {
+			invoices[inv.ID] = inv
+			return nil
+		},
+	}
+	paymentRepo := &mock.MockPaymentRepository{
+		ListByBuyerIDWithLockFunc: func(_ context.Context, _ int) ([]model.Payment, error) {
+			p := model.Payment{ID: 1, BuyerID: 2, Amount: 5400}
+			for _, a := range allocations {
+				p.AllocatedAmount += a.Amount
+			}
+			return []model.Payment{p}, nil
+		},
+		ListAllocationsByInvoiceIDFunc: func(_ context.Context, invoiceID int) ([]model.PaymentAllocation, error) {
+			net := 0
+			for _, a := range allocations {
+				if a.InvoiceID == invoiceID {
+					net += a.Amount
+				}
+			}
+			return []model.PaymentAllocation{{PaymentID: 1, InvoiceID: invoiceID, Amount: net}}, nil
+		},
+		CreateAllocationFunc: func(_ context.Context, a *model.PaymentAllocation) error {
+			allocations = append(allocations, *a)
+			return nil
+		},
+	}
+	claimRepo := &mock.MockClaimRepository{
+		FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.Claim, error) {
+			return &model.Claim{
+				ID: 1, BuyerID: 2, ItemID: itemID, AuctionID: 4, FishermanID: 3, FishType: "Tuna",
+				PurchasePrice: 5000, RequestedAmount: 1000, Status: model.ClaimStatusPending,
+			}, nil
+		},
+	}
+	creditNoteRepo := &mock.MockCreditNoteRepository{
+		CreateFunc: func(_ context.Context, n *model.CreditNote) (*model.CreditNote, error) {
+			return n, nil
+		},
+	}
+
+	approve := claim.NewApproveClaimUseCase(
+		claimRepo, invoiceRepo, creditNoteRepo, paymentRepo, &mock.MockSettlementAdjustmentRepository{},
+		&mock.MockTransactionManager{}, mock.NewMockClock(now),
+	)
+	if _, err := approve.Execute(context.Background(), 1, 9, claim.ApproveClaimInput{}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := invoices[7]; got.PaidAmount != 4320 || got.OverpaidAmount() != 0 || got.Status != model.InvoiceStatusPaid {
+		t.Errorf("expected the credited invoice to keep only its net amount paid, got %+v", got)
+	}
+
+	issue := invoice.NewIssueInvoiceUseCase(invoiceRepo, paymentRepo, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+	issued, err := issue.Execute(context.Background(), 8)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if issued.PaidAmount != 1080 || issued.OutstandingAmount() != 1920 {
+		t.Errorf("expected the credit to be applied to the next invoice, got %+v", issued)
+	}
+
+	balance := model.NewBuyerBalance(2, []model.Invoice{*invoices[7], *invoices[8]}, []model.Payment{{ID: 1, Amount: 5400, AllocatedAmount: 5400}})
+	if balance.CreditAmount != 0 || balance.OutstandingAmount != 1920 {
+		t.Errorf("unexpected balance: %+v", balance)
+	}
+}
@@ -0,0 +1,121 @@
+package claim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// FileClaimInput holds what a buyer submits when filing a claim.
+type FileClaimInput struct {
+	PurchaseID int
+	Type       model.ClaimType
+	Reason     string
+	Amount     int
+}
+
+// FileClaimUseCase defines the interface for a buyer filing a quality claim against a purchase.
+type FileClaimUseCase interface {
+	// Execute files a pending claim for one of the buyer's awarded lots.
+	Execute(ctx context.Context, buyerID int, input FileClaimInput) (*model.Claim, error)
+}
+
+type fileClaimUseCase struct {
+	bidRepo   repository.BidRepository
+	claimRepo repository.ClaimRepository
+	txMgr     repository.TransactionManager
+}
+
+var _ FileClaimUseCase = (*fileClaimUseCase)(nil)
+
+// NewFileClaimUseCase creates a new FileClaimUseCase instance.
+func NewFileClaimUseCase(
+	bidRepo repository.BidRepository,
+	claimRepo repository.ClaimRepository,
+	txMgr repository.TransactionManager,
+) FileClaimUseCase {
+	return &fileClaimUseCase{
+		bidRepo:   bidRepo,
+		claimRepo: claimRepo,
+		txMgr:     txMgr,
+	}
+}
+
+// Execute files a claim.
+// 他の買受人の落札や、落札に至らなかった入札は存在しないものとして扱う。
+func (uc *fileClaimUseCase) Execute(ctx context.Context, buyerID int, input FileClaimInput) (*model.Claim, error) {
+	notFound := &apperrors.NotFoundError{Resource: "Purchase", ID: input.PurchaseID}
+
+	purchase, err := uc.bidRepo.FindPurchaseByID(ctx, input.PurchaseID)
+	if err != nil {
+		var nfErr *apperrors.NotFoundError
+		if errors.As(err, &nfErr) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+	if purchase.BuyerID != buyerID {
+		return nil, notFound
+	}
+
+	awarded, err := uc.isAwarded(ctx, purchase)
+	if err != nil {
+		return nil, err
+	}
+	if !awarded {
+		return nil, notFound
+	}
+
+	claim, err := model.NewClaim(purchase, input.Type, input.Reason, input.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var filed *model.Claim
+	err = uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		created, err := uc.claimRepo.Create(txCtx, claim)
+		if err != nil {
+			var cErr *apperrors.ConflictError
+			if errors.As(err, &cErr) {
+				return &apperrors.ConflictError{Message: "a claim for this purchase is already pending"}
+			}
+			return fmt.Errorf("failed to create claim: %w", err)
+		}
+
+		amount := created.RequestedAmount
+		if err := uc.claimRepo.CreateEvent(txCtx, &model.ClaimEvent{
+			ClaimID:   created.ID,
+			Action:    model.ClaimActionFiled,
+			ActorType: model.ClaimActorBuyer,
+			ActorID:   buyerID,
+			Amount:    &amount,
+			Note:      created.Reason,
+		}); err != nil {
+			return fmt.Errorf("failed to record claim event: %w", err)
+		}
+
+		filed = created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filed, nil
+}
+
+func (uc *fileClaimUseCase) isAwarded(ctx context.Context, purchase *model.Purchase) (bool, error) {
+	awards, err := uc.bidRepo.ListAwardsByAuctionID(ctx, purchase.AuctionID)
+	if err != nil {
+		return false, fmt.Errorf("failed to list awards: %w", err)
+	}
+	for _, a := range awards {
+		if a.ItemID == purchase.ItemID {
+			return a.ID == purchase.ID, nil
+		}
+	}
+	return false, nil
+}
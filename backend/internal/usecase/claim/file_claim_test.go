@@ -0,0 +1,125 @@
+package claim_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestFileClaimUseCase_Execute(t *testing.T) {
+	purchase := &model.Purchase{ID: 1, ItemID: 10, BuyerID: 2, FishermanID: 3, AuctionID: 4, Price: 5000, FishType: "Tuna"}
+
+	tests := []struct {
+		name       string
+		buyerID    int
+		awardID    int
+		input      claim.FileClaimInput
+		createErr  error
+		wantErr    any
+		wantAmount int
+	}{
+		{
+			name:       "Success",
+			buyerID:    2,
+			awardID:    1,
+			input:      claim.FileClaimInput{PurchaseID: 1, Type: model.ClaimTypeReturn, Reason: "鮮度不良"},
+			wantAmount: 5000,
+		},
+		{
+			name:    "OtherBuyersPurchase",
+			buyerID: 9,
+			awardID: 1,
+			input:   claim.FileClaimInput{PurchaseID: 1, Type: model.ClaimTypeReturn, Reason: "鮮度不良"},
+			wantErr: &domainErrors.NotFoundError{},
+		},
+		{
+			name:    "OutbidPurchase",
+			buyerID: 2,
+			awardID: 8,
+			input:   claim.FileClaimInput{PurchaseID: 1, Type: model.ClaimTypeReturn, Reason: "鮮度不良"},
+			wantErr: &domainErrors.NotFoundError{},
+		},
+		{
+			name:    "InvalidAmount",
+			buyerID: 2,
+			awardID: 1,
+			input:   claim.FileClaimInput{PurchaseID: 1, Type: model.ClaimTypeDiscount, Reason: "身割れ", Amount: 6000},
+			wantErr: &domainErrors.ValidationError{},
+		},
+		{
+			name:      "AlreadyPending",
+			buyerID:   2,
+			awardID:   1,
+			input:     claim.FileClaimInput{PurchaseID: 1, Type: model.ClaimTypeReturn, Reason: "鮮度不良"},
+			createErr: &domainErrors.ConflictError{Message: "duplicate"},
+			wantErr:   &domainErrors.ConflictError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []model.ClaimEvent
+			bidRepo := &mock.MockBidRepository{
+				FindPurchaseByIDFunc: func(_ context.Context, _ int) (*model.Purchase, error) {
+					p := *purchase
+					return &p, nil
+				},
+				ListAwardsByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Purchase, error) {
+					return []model.Purchase{{ID: tt.awardID, ItemID: 10}}, nil
+				},
+			}
+			claimRepo := &mock.MockClaimRepository{
+				CreateFunc: func(_ context.Context, c *model.Claim) (*model.Claim, error) {
+					if tt.createErr != nil {
+						return nil, tt.createErr
+					}
+					created := *c
+					created.ID = 50
+					return &created, nil
+				},
+				CreateEventFunc: func(_ context.Context, e *model.ClaimEvent) error {
+					events = append(events, *e)
+					return nil
+				},
+			}
+
+			uc := claim.NewFileClaimUseCase(bidRepo, claimRepo, &mock.MockTransactionManager{})
+			got, err := uc.Execute(context.Background(), tt.buyerID, tt.input)
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.NotFoundError:
+					var target *domainErrors.NotFoundError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected NotFoundError, got %v", err)
+					}
+				case *domainErrors.ValidationError:
+					var target *domainErrors.ValidationError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+				case *domainErrors.ConflictError:
+					var target *domainErrors.ConflictError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ConflictError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.RequestedAmount != tt.wantAmount || got.FishermanID != 3 {
+				t.Errorf("unexpected claim: %+v", got)
+			}
+			if len(events) != 1 || events[0].Action != model.ClaimActionFiled || events[0].ActorID != tt.buyerID {
+				t.Errorf("expected a filed event by the buyer, got %+v", events)
+			}
+		})
+	}
+}
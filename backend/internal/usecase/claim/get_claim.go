@@ -0,0 +1,56 @@
+package claim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// GetClaimUseCase defines the interface for retrieving a claim with its audit trail.
+type GetClaimUseCase interface {
+	// Execute returns the claim, its events and the credit note issued for it, if any.
+	Execute(ctx context.Context, id int) (*model.Claim, error)
+}
+
+type getClaimUseCase struct {
+	claimRepo      repository.ClaimRepository
+	creditNoteRepo repository.CreditNoteRepository
+}
+
+var _ GetClaimUseCase = (*getClaimUseCase)(nil)
+
+// NewGetClaimUseCase creates a new GetClaimUseCase instance.
+func NewGetClaimUseCase(claimRepo repository.ClaimRepository, creditNoteRepo repository.CreditNoteRepository) GetClaimUseCase {
+	return &getClaimUseCase{
+		claimRepo:      claimRepo,
+		creditNoteRepo: creditNoteRepo,
+	}
+}
+
+// Execute retrieves a claim.
+func (uc *getClaimUseCase) Execute(ctx context.Context, id int) (*model.Claim, error) {
+	claim, err := uc.claimRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := uc.claimRepo.ListEvents(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list claim events: %w", err)
+	}
+	claim.Events = events
+
+	if claim.Status == model.ClaimStatusApproved {
+		note, err := uc.creditNoteRepo.FindByClaimID(ctx, id)
+		var nfErr *apperrors.NotFoundError
+		if err != nil && !errors.As(err, &nfErr) {
+			return nil, fmt.Errorf("failed to find credit note: %w", err)
+		}
+		claim.CreditNote = note
+	}
+	return claim, nil
+}
@@ -0,0 +1,41 @@
+package claim_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestGetClaimUseCase_Execute(t *testing.T) {
+	claimRepo := &mock.MockClaimRepository{
+		FindByIDFunc: func(_ context.Context, id int) (*model.Claim, error) {
+			return &model.Claim{ID: id, Status: model.ClaimStatusApproved}, nil
+		},
+		ListEventsFunc: func(_ context.Context, claimID int) ([]model.ClaimEvent, error) {
+			return []model.ClaimEvent{
+				{ClaimID: claimID, Action: model.ClaimActionFiled},
+				{ClaimID: claimID, Action: model.ClaimActionApproved},
+			}, nil
+		},
+	}
+	creditNoteRepo := &mock.MockCreditNoteRepository{
+		FindByClaimIDFunc: func(_ context.Context, claimID int) (*model.CreditNote, error) {
+			return &model.CreditNote{ID: 3, ClaimID: &claimID, TotalAmount: 1080}, nil
+		},
+	}
+
+	uc := claim.NewGetClaimUseCase(claimRepo, creditNoteRepo)
+	got, err := uc.Execute(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got.Events) != 2 {
+		t.Errorf("expected 2 events, got %d", len(got.Events))
+	}
+	if got.CreditNote == nil || got.CreditNote.TotalAmount != 1080 {
+		t.Errorf("expected credit note, got %+v", got.CreditNote)
+	}
+}
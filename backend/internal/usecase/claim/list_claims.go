@@ -0,0 +1,30 @@
+package claim
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListClaimsUseCase defines the interface for listing claims.
+type ListClaimsUseCase interface {
+	// Execute returns the claims matching the filters, newest first.
+	Execute(ctx context.Context, filters *repository.ClaimFilters) ([]model.Claim, error)
+}
+
+type listClaimsUseCase struct {
+	claimRepo repository.ClaimRepository
+}
+
+var _ ListClaimsUseCase = (*listClaimsUseCase)(nil)
+
+// NewListClaimsUseCase creates a new ListClaimsUseCase instance.
+func NewListClaimsUseCase(claimRepo repository.ClaimRepository) ListClaimsUseCase {
+	return &listClaimsUseCase{claimRepo: claimRepo}
+}
+
+// Execute lists claims.
+func (uc *listClaimsUseCase) Execute(ctx context.Context, filters *repository.ClaimFilters) ([]model.Claim, error) {
+	return uc.claimRepo.List(ctx, filters)
+}
@@ -0,0 +1,71 @@
+package claim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// RejectClaimUseCase defines the interface for rejecting a claim.
+type RejectClaimUseCase interface {
+	// Execute rejects the pending claim with the given note.
+	Execute(ctx context.Context, id, adminID int, note string) (*model.Claim, error)
+}
+
+type rejectClaimUseCase struct {
+	claimRepo repository.ClaimRepository
+	txMgr     repository.TransactionManager
+	clock     service.Clock
+}
+
+var _ RejectClaimUseCase = (*rejectClaimUseCase)(nil)
+
+// NewRejectClaimUseCase creates a new RejectClaimUseCase instance.
+func NewRejectClaimUseCase(
+	claimRepo repository.ClaimRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) RejectClaimUseCase {
+	return &rejectClaimUseCase{
+		claimRepo: claimRepo,
+		txMgr:     txMgr,
+		clock:     clock,
+	}
+}
+
+// Execute rejects a claim.
+func (uc *rejectClaimUseCase) Execute(ctx context.Context, id, adminID int, note string) (*model.Claim, error) {
+	var rejected *model.Claim
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		claim, err := uc.claimRepo.FindByIDWithLock(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if err := claim.Reject(adminID, note, uc.clock.Now()); err != nil {
+			return err
+		}
+
+		if err := uc.claimRepo.Update(txCtx, claim); err != nil {
+			return fmt.Errorf("failed to update claim: %w", err)
+		}
+		if err := uc.claimRepo.CreateEvent(txCtx, &model.ClaimEvent{
+			ClaimID:   claim.ID,
+			Action:    model.ClaimActionRejected,
+			ActorType: model.ClaimActorAdmin,
+			ActorID:   adminID,
+			Note:      claim.ResolutionNote,
+		}); err != nil {
+			return fmt.Errorf("failed to record claim event: %w", err)
+		}
+
+		rejected = claim
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rejected, nil
+}
@@ -0,0 +1,71 @@
+package claim_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/claim"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestRejectClaimUseCase_Execute(t *testing.T) {
+	now := time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		status  model.ClaimStatus
+		note    string
+		wantErr any
+	}{
+		{name: "Success", status: model.ClaimStatusPending, note: "写真では確認できず"},
+		{name: "MissingNote", status: model.ClaimStatusPending, wantErr: &domainErrors.ValidationError{}},
+		{name: "AlreadyApproved", status: model.ClaimStatusApproved, note: "x", wantErr: &domainErrors.ConflictError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []model.ClaimEvent
+			claimRepo := &mock.MockClaimRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Claim, error) {
+					return &model.Claim{ID: id, Status: tt.status}, nil
+				},
+				CreateEventFunc: func(_ context.Context, e *model.ClaimEvent) error {
+					events = append(events, *e)
+					return nil
+				},
+			}
+
+			uc := claim.NewRejectClaimUseCase(claimRepo, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			got, err := uc.Execute(context.Background(), 1, 9, tt.note)
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ValidationError:
+					var target *domainErrors.ValidationError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+				case *domainErrors.ConflictError:
+					var target *domainErrors.ConflictError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ConflictError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.Status != model.ClaimStatusRejected || got.ResolvedAt == nil || !got.ResolvedAt.Equal(now) {
+				t.Errorf("unexpected claim: %+v", got)
+			}
+			if len(events) != 1 || events[0].Action != model.ClaimActionRejected {
+				t.Errorf("expected a rejected event, got %+v", events)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package fisherman
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// CreateLoginUseCase defines the interface for issuing a fisherman's portal login.
+type CreateLoginUseCase interface {
+	// Execute creates the portal login of the fisherman. A fisherman has at most one login.
+	Execute(ctx context.Context, fishermanID int, email, password string) (*model.FishermanAuthentication, error)
+}
+
+type createLoginUseCase struct {
+	fishermanRepo repository.FishermanRepository
+	authRepo      repository.FishermanAuthenticationRepository
+}
+
+var _ CreateLoginUseCase = (*createLoginUseCase)(nil)
+
+// NewCreateLoginUseCase creates a new CreateLoginUseCase instance.
+func NewCreateLoginUseCase(
+	fishermanRepo repository.FishermanRepository,
+	authRepo repository.FishermanAuthenticationRepository,
+) CreateLoginUseCase {
+	return &createLoginUseCase{
+		fishermanRepo: fishermanRepo,
+		authRepo:      authRepo,
+	}
+}
+
+// Execute validates the email and password and stores the login.
+// 既にログインがある場合は一意制約により ConflictError になる。
+func (uc *createLoginUseCase) Execute(ctx context.Context, fishermanID int, email, password string) (*model.FishermanAuthentication, error) {
+	email = strings.TrimSpace(email)
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return nil, &apperrors.ValidationError{Field: "email", Message: "must be a valid email address"}
+	}
+	pwd, err := model.NewPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.fishermanRepo.FindByID(ctx, fishermanID); err != nil {
+		return nil, err
+	}
+
+	hashed, err := pwd.Hash()
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+	return uc.authRepo.Create(ctx, &model.FishermanAuthentication{
+		FishermanID:  fishermanID,
+		Email:        email,
+		PasswordHash: hashed.Raw(),
+	})
+}
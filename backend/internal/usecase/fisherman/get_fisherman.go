@@ -0,0 +1,29 @@
+package fisherman
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// GetFishermanUseCase defines the interface for getting a fisherman.
+type GetFishermanUseCase interface {
+	Execute(ctx context.Context, id int) (*model.Fisherman, error)
+}
+
+type getFishermanUseCase struct {
+	repo repository.FishermanRepository
+}
+
+var _ GetFishermanUseCase = (*getFishermanUseCase)(nil)
+
+// NewGetFishermanUseCase creates a new GetFishermanUseCase instance.
+func NewGetFishermanUseCase(repo repository.FishermanRepository) GetFishermanUseCase {
+	return &getFishermanUseCase{repo: repo}
+}
+
+// Execute returns the fisherman with the given ID.
+func (uc *getFishermanUseCase) Execute(ctx context.Context, id int) (*model.Fisherman, error) {
+	return uc.repo.FindByID(ctx, id)
+}
@@ -0,0 +1,66 @@
+package fisherman
+
+import (
+	"context"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListConsignedLotsUseCase defines the interface for listing the lots a fisherman consigned.
+type ListConsignedLotsUseCase interface {
+	// Execute returns the fisherman's lots, optionally limited to auctions in the given status.
+	Execute(ctx context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error)
+}
+
+type listConsignedLotsUseCase struct {
+	itemRepo repository.ItemRepository
+}
+
+var _ ListConsignedLotsUseCase = (*listConsignedLotsUseCase)(nil)
+
+// NewListConsignedLotsUseCase creates a new ListConsignedLotsUseCase instance.
+func NewListConsignedLotsUseCase(itemRepo repository.ItemRepository) ListConsignedLotsUseCase {
+	return &listConsignedLotsUseCase{itemRepo: itemRepo}
+}
+
+// Execute returns the lots with their current or final price.
+func (uc *listConsignedLotsUseCase) Execute(ctx context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error) {
+	if status != nil && !status.IsValid() {
+		return nil, &apperrors.ValidationError{Field: "status", Message: "is not a valid auction status"}
+	}
+	return uc.itemRepo.ListConsignedByFishermanID(ctx, fishermanID, status)
+}
+
+// ListSaleResultsUseCase defines the interface for listing a fisherman's lots sold at completed auctions.
+type ListSaleResultsUseCase interface {
+	Execute(ctx context.Context, fishermanID int) ([]model.ConsignedLot, error)
+}
+
+type listSaleResultsUseCase struct {
+	itemRepo repository.ItemRepository
+}
+
+var _ ListSaleResultsUseCase = (*listSaleResultsUseCase)(nil)
+
+// NewListSaleResultsUseCase creates a new ListSaleResultsUseCase instance.
+func NewListSaleResultsUseCase(itemRepo repository.ItemRepository) ListSaleResultsUseCase {
+	return &listSaleResultsUseCase{itemRepo: itemRepo}
+}
+
+// Execute returns the sold lots of completed auctions. 入札のなかった不落の品目は含めない。
+func (uc *listSaleResultsUseCase) Execute(ctx context.Context, fishermanID int) ([]model.ConsignedLot, error) {
+	completed := model.AuctionStatusCompleted
+	lots, err := uc.itemRepo.ListConsignedByFishermanID(ctx, fishermanID, &completed)
+	if err != nil {
+		return nil, err
+	}
+	sold := make([]model.ConsignedLot, 0, len(lots))
+	for i := range lots {
+		if lots[i].IsSold() {
+			sold = append(sold, lots[i])
+		}
+	}
+	return sold, nil
+}
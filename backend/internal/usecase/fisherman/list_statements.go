@@ -0,0 +1,60 @@
+package fisherman
+
+import (
+	"context"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListStatementsUseCase defines the interface for listing a fisherman's settlement statements (仕切書).
+type ListStatementsUseCase interface {
+	Execute(ctx context.Context, fishermanID int) ([]model.Settlement, error)
+}
+
+type listStatementsUseCase struct {
+	settlementRepo repository.SettlementRepository
+}
+
+var _ ListStatementsUseCase = (*listStatementsUseCase)(nil)
+
+// NewListStatementsUseCase creates a new ListStatementsUseCase instance.
+func NewListStatementsUseCase(settlementRepo repository.SettlementRepository) ListStatementsUseCase {
+	return &listStatementsUseCase{settlementRepo: settlementRepo}
+}
+
+// Execute returns the settled statements of the fisherman.
+// 下書きは再生成で内容が変わるため、確定したものだけを見せる。
+func (uc *listStatementsUseCase) Execute(ctx context.Context, fishermanID int) ([]model.Settlement, error) {
+	return uc.settlementRepo.ListSettledByFishermanID(ctx, fishermanID)
+}
+
+// GetStatementUseCase defines the interface for getting one of a fisherman's settlement statements with its lines.
+type GetStatementUseCase interface {
+	Execute(ctx context.Context, fishermanID, id int) (*model.Settlement, error)
+}
+
+type getStatementUseCase struct {
+	settlementRepo repository.SettlementRepository
+}
+
+var _ GetStatementUseCase = (*getStatementUseCase)(nil)
+
+// NewGetStatementUseCase creates a new GetStatementUseCase instance.
+func NewGetStatementUseCase(settlementRepo repository.SettlementRepository) GetStatementUseCase {
+	return &getStatementUseCase{settlementRepo: settlementRepo}
+}
+
+// Execute returns the statement when it is settled and belongs to the fisherman.
+// 他の漁業者の仕切書や下書きは、存在を知られないよう NotFound として扱う。
+func (uc *getStatementUseCase) Execute(ctx context.Context, fishermanID, id int) (*model.Settlement, error) {
+	s, err := uc.settlementRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil || s.FishermanID != fishermanID || s.Status != model.SettlementStatusSettled {
+		return nil, &apperrors.NotFoundError{Resource: "Settlement", ID: id}
+	}
+	return s, nil
+}
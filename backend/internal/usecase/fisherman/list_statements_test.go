@@ -0,0 +1,73 @@
+package fisherman_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestGetStatementUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name         string
+		settlement   *model.Settlement
+		wantNotFound bool
+	}{
+		{name: "Own", settlement: &model.Settlement{ID: 5, FishermanID: 3, Status: model.SettlementStatusSettled}},
+		{name: "OtherFisherman", settlement: &model.Settlement{ID: 5, FishermanID: 4, Status: model.SettlementStatusSettled}, wantNotFound: true},
+		{name: "Draft", settlement: &model.Settlement{ID: 5, FishermanID: 3, Status: model.SettlementStatusDraft}, wantNotFound: true},
+		{name: "Missing", wantNotFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mock.MockSettlementRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.Settlement, error) {
+					return tt.settlement, nil
+				},
+			}
+
+			got, err := fisherman.NewGetStatementUseCase(repo).Execute(context.Background(), 3, 5)
+			if tt.wantNotFound {
+				var notFoundErr *apperrors.NotFoundError
+				if !errors.As(err, &notFoundErr) {
+					t.Fatalf("expected NotFoundError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != 5 {
+				t.Errorf("expected statement 5, got %d", got.ID)
+			}
+		})
+	}
+}
+
+func TestListSaleResultsUseCase_Execute(t *testing.T) {
+	price := 120000
+	repo := &mock.MockItemRepository{
+		ListConsignedByFishermanIDFunc: func(_ context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error) {
+			if fishermanID != 3 || status == nil || *status != model.AuctionStatusCompleted {
+				t.Fatalf("unexpected query fisherman=%d status=%v", fishermanID, status)
+			}
+			return []model.ConsignedLot{
+				{ItemID: 1, AuctionStatus: model.AuctionStatusCompleted, Price: &price},
+				{ItemID: 2, AuctionStatus: model.AuctionStatusCompleted},
+			}, nil
+		},
+	}
+
+	lots, err := fisherman.NewListSaleResultsUseCase(repo).Execute(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(lots) != 1 || lots[0].ItemID != 1 {
+		t.Errorf("expected only the sold lot, got %+v", lots)
+	}
+}
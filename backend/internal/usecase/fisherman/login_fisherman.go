@@ -0,0 +1,97 @@
+package fisherman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+const (
+	// MaxFailedLoginAttempts is the number of consecutive failed login
+	// attempts before a portal login is locked.
+	MaxFailedLoginAttempts = 5
+
+	// AccountLockDuration is the duration for which a portal login is locked
+	// after exceeding MaxFailedLoginAttempts.
+	AccountLockDuration = 30 * time.Minute
+)
+
+// LoginFishermanUseCase defines the interface for fisherman portal login.
+type LoginFishermanUseCase interface {
+	Execute(ctx context.Context, email, password string) (*model.Fisherman, error)
+}
+
+type loginFishermanUseCase struct {
+	fishermanRepo repository.FishermanRepository
+	authRepo      repository.FishermanAuthenticationRepository
+	clock         service.Clock
+}
+
+var _ LoginFishermanUseCase = (*loginFishermanUseCase)(nil)
+
+// NewLoginFishermanUseCase creates a new instance of LoginFishermanUseCase
+func NewLoginFishermanUseCase(
+	fishermanRepo repository.FishermanRepository,
+	authRepo repository.FishermanAuthenticationRepository,
+	clock service.Clock,
+) LoginFishermanUseCase {
+	return &loginFishermanUseCase{
+		fishermanRepo: fishermanRepo,
+		authRepo:      authRepo,
+		clock:         clock,
+	}
+}
+
+// Execute authenticates a fisherman
+func (uc *loginFishermanUseCase) Execute(ctx context.Context, email, password string) (*model.Fisherman, error) {
+	auth, err := uc.authRepo.FindByEmail(ctx, email)
+	if err != nil {
+		var nfErr *apperrors.NotFoundError
+		if errors.As(err, &nfErr) {
+			slog.WarnContext(ctx, "auth: fisherman login failed", "reason", "user_not_found", "email", email)
+			return nil, &apperrors.UnauthorizedError{Message: "invalid credentials"}
+		}
+		return nil, fmt.Errorf("failed to find fisherman authentication during login: %w", err)
+	}
+
+	now := uc.clock.Now()
+	if auth.LockedUntil != nil && now.Before(*auth.LockedUntil) {
+		slog.WarnContext(ctx, "auth: fisherman login failed", "reason", "account_locked", "email", email)
+		return nil, &apperrors.UnauthorizedError{Message: "account is locked due to too many failed attempts"}
+	}
+
+	hp := model.NewHashedPassword(auth.PasswordHash)
+	if err := hp.Verify(password); err != nil {
+		newAttempts, incrErr := uc.authRepo.IncrementFailedAttempts(ctx, auth.ID)
+		if incrErr != nil {
+			return nil, fmt.Errorf("failed to increment failed attempts: %w", incrErr)
+		}
+		slog.WarnContext(ctx, "auth: fisherman login failed", "reason", "bad_password", "email", email, "attempts", newAttempts)
+
+		if newAttempts >= MaxFailedLoginAttempts {
+			if lockErr := uc.authRepo.LockAccount(ctx, auth.ID, now.Add(AccountLockDuration)); lockErr != nil {
+				slog.ErrorContext(ctx, "auth: failed to lock fisherman account", "err", lockErr)
+			}
+			return nil, &apperrors.UnauthorizedError{Message: "account locked due to too many failed attempts"}
+		}
+
+		return nil, err
+	}
+
+	if err := uc.authRepo.UpdateLoginSuccess(ctx, auth.ID, now); err != nil {
+		return nil, fmt.Errorf("failed to update login success: %w", err)
+	}
+
+	fm, err := uc.fishermanRepo.FindByID(ctx, auth.FishermanID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find fisherman details: %w", err)
+	}
+	return fm, nil
+}
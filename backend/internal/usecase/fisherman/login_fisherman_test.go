@@ -0,0 +1,90 @@
+package fisherman_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestLoginFishermanUseCase_Execute(t *testing.T) {
+	fixedNow := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	hashedPassword, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	lockedUntil := fixedNow.Add(time.Hour)
+
+	validAuth := &model.FishermanAuthentication{ID: 1, FishermanID: 3, Email: "fisher@example.com", PasswordHash: string(hashedPassword)}
+	lockedAuth := &model.FishermanAuthentication{ID: 2, FishermanID: 4, Email: "locked@example.com", PasswordHash: string(hashedPassword), LockedUntil: &lockedUntil}
+
+	tests := []struct {
+		name           string
+		password       string
+		auth           *model.FishermanAuthentication
+		authErr        error
+		attempts       int
+		wantErr        bool
+		wantUnauth     bool
+		wantLockCalled bool
+	}{
+		{name: "Success", password: "password", auth: validAuth},
+		{name: "UnknownEmail", password: "password", authErr: &apperrors.NotFoundError{Resource: "FishermanAuthentication"}, wantErr: true, wantUnauth: true},
+		{name: "DBError", password: "password", authErr: errors.New("db error"), wantErr: true},
+		{name: "WrongPassword", password: "wrong", auth: validAuth, attempts: 1, wantErr: true, wantUnauth: true},
+		{name: "LockoutTriggered", password: "wrong", auth: validAuth, attempts: fisherman.MaxFailedLoginAttempts, wantErr: true, wantUnauth: true, wantLockCalled: true},
+		{name: "Locked", password: "password", auth: lockedAuth, wantErr: true, wantUnauth: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockCalled := false
+			authRepo := &mock.MockFishermanAuthenticationRepository{
+				FindByEmailFunc: func(_ context.Context, _ string) (*model.FishermanAuthentication, error) {
+					return tt.auth, tt.authErr
+				},
+				IncrementFailedAttemptsFunc: func(_ context.Context, _ int) (int, error) {
+					return tt.attempts, nil
+				},
+				LockAccountFunc: func(_ context.Context, _ int, until time.Time) error {
+					lockCalled = true
+					if !until.Equal(fixedNow.Add(fisherman.AccountLockDuration)) {
+						t.Errorf("unexpected lock until %v", until)
+					}
+					return nil
+				},
+			}
+			fishermanRepo := &mock.MockFishermanRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Fisherman, error) {
+					return &model.Fisherman{ID: id, Name: "第一漁丸"}, nil
+				},
+			}
+
+			uc := fisherman.NewLoginFishermanUseCase(fishermanRepo, authRepo, mock.NewMockClock(fixedNow))
+			got, err := uc.Execute(context.Background(), "fisher@example.com", tt.password)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				var unauthorizedErr *apperrors.UnauthorizedError
+				if errors.As(err, &unauthorizedErr) != tt.wantUnauth {
+					t.Errorf("unexpected error type %T: %v", err, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.ID != 3 {
+					t.Errorf("expected fisherman 3, got %d", got.ID)
+				}
+			}
+			if lockCalled != tt.wantLockCalled {
+				t.Errorf("lock called = %v, want %v", lockCalled, tt.wantLockCalled)
+			}
+		})
+	}
+}
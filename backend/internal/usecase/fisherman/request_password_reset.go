@@ -0,0 +1,96 @@
+package fisherman
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+)
+
+var randRead = rand.Read
+
+// resetRole is the user_role of password reset tokens issued to fishermen.
+var resetRole = string(model.SessionRoleFisherman)
+
+// RequestPasswordResetUseCase defines the interface for requesting a fisherman portal password reset.
+type RequestPasswordResetUseCase interface {
+	// Execute initiates the password reset process for the given email.
+	Execute(ctx context.Context, email string) error
+}
+
+type requestPasswordResetUseCase struct {
+	authRepo     repository.FishermanAuthenticationRepository
+	pwdResetRepo repository.PasswordResetRepository
+	outboxRepo   repository.OutboxRepository
+	frontendURL  *url.URL
+	txMgr        repository.TransactionManager
+	clock        service.Clock
+}
+
+var _ RequestPasswordResetUseCase = (*requestPasswordResetUseCase)(nil)
+
+// NewRequestPasswordResetUseCase creates a new instance of RequestPasswordResetUseCase
+func NewRequestPasswordResetUseCase(
+	authRepo repository.FishermanAuthenticationRepository,
+	pwdResetRepo repository.PasswordResetRepository,
+	outboxRepo repository.OutboxRepository,
+	frontendURL *url.URL,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) RequestPasswordResetUseCase {
+	return &requestPasswordResetUseCase{
+		authRepo:     authRepo,
+		pwdResetRepo: pwdResetRepo,
+		outboxRepo:   outboxRepo,
+		frontendURL:  frontendURL,
+		txMgr:        txMgr,
+		clock:        clock,
+	}
+}
+
+func (u *requestPasswordResetUseCase) Execute(ctx context.Context, email string) error {
+	// Not found is returned as is; obfuscation is handler responsibility.
+	auth, err := u.authRepo.FindByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	// 1. Generate secure token
+	tokenBytes := make([]byte, 32)
+	if _, err := randRead(tokenBytes); err != nil {
+		return fmt.Errorf("failed to generate secure token: %w", err)
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	// 2. Hash token for DB
+	hash := sha256.Sum256([]byte(token))
+	tokenHash := hex.EncodeToString(hash[:])
+
+	// 3. Save token and enqueue email in the same transaction
+	resetURL := u.frontendURL.JoinPath("/login/fisherman/reset_password")
+	q := resetURL.Query()
+	q.Set("token", token)
+	resetURL.RawQuery = q.Encode()
+
+	expiresAt := u.clock.Now().Add(30 * time.Minute)
+	return u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.pwdResetRepo.DeleteAllByUserID(txCtx, auth.FishermanID, resetRole); err != nil {
+			return fmt.Errorf("failed to invalidate old reset tokens: %w", err)
+		}
+		if err := u.pwdResetRepo.Create(txCtx, auth.FishermanID, resetRole, tokenHash, expiresAt); err != nil {
+			return fmt.Errorf("failed to create new reset token: %w", err)
+		}
+		if err := u.outboxRepo.InsertEmailJob(txCtx, auth.Email, resetURL.String(), string(emailMessage.EmailTypeFishermanPasswordReset)); err != nil {
+			return fmt.Errorf("failed to insert outbox message: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,78 @@
+package fisherman
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// ResetPasswordUseCase defines the interface for resetting a fisherman portal password.
+type ResetPasswordUseCase interface {
+	// Execute resets the password using the reset token.
+	Execute(ctx context.Context, token, newPassword string) error
+}
+
+type resetPasswordUseCase struct {
+	pwdResetRepo repository.PasswordResetRepository
+	authRepo     repository.FishermanAuthenticationRepository
+	sessionRepo  repository.SessionRepository
+	txMgr        repository.TransactionManager
+	clock        service.Clock
+}
+
+var _ ResetPasswordUseCase = (*resetPasswordUseCase)(nil)
+
+// NewResetPasswordUseCase creates a new ResetPasswordUseCase instance.
+func NewResetPasswordUseCase(
+	pwdResetRepo repository.PasswordResetRepository,
+	authRepo repository.FishermanAuthenticationRepository,
+	sessionRepo repository.SessionRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) ResetPasswordUseCase {
+	return &resetPasswordUseCase{
+		pwdResetRepo: pwdResetRepo,
+		authRepo:     authRepo,
+		sessionRepo:  sessionRepo,
+		txMgr:        txMgr,
+		clock:        clock,
+	}
+}
+
+func (u *resetPasswordUseCase) Execute(ctx context.Context, token, newPassword string) error {
+	newPwd, err := model.NewPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
+	resetToken, err := findValidResetToken(ctx, u.pwdResetRepo, u.clock, token)
+	if err != nil {
+		return err
+	}
+
+	hashedPwd, err := newPwd.Hash()
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err := u.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.authRepo.UpdatePassword(txCtx, resetToken.UserID, hashedPwd.Raw()); err != nil {
+			return fmt.Errorf("failed to update password: %w", err)
+		}
+		if err := u.pwdResetRepo.DeleteAllByUserID(txCtx, resetToken.UserID, resetRole); err != nil {
+			return fmt.Errorf("failed to invalidate reset token after successful reset: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// パスワードを知った第三者のセッションが残らないよう、既存のセッションをすべて失効させる。
+	if err := u.sessionRepo.DeleteAllByUserID(ctx, resetToken.UserID, model.SessionRoleFisherman); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+	return nil
+}
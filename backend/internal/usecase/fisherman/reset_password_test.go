@@ -0,0 +1,174 @@
+package fisherman_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	emailMessage "github.com/seka/fish-auction/backend/internal/event"
+	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestRequestPasswordResetUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+	frontendURL, _ := url.Parse("https://example.com")
+
+	t.Run("Success", func(t *testing.T) {
+		var createdRole, emailType, resetURL string
+		pwdResetRepo := &mock.MockPasswordResetRepository{
+			CreateFunc: func(_ context.Context, userID int, role, _ string, expiresAt time.Time) error {
+				if userID != 3 || !expiresAt.Equal(now.Add(30*time.Minute)) {
+					t.Errorf("unexpected token for user %d expiring at %v", userID, expiresAt)
+				}
+				createdRole = role
+				return nil
+			},
+		}
+		outboxRepo := &mock.MockOutboxRepository{
+			InsertEmailJobFunc: func(_ context.Context, _, u, typ string) error {
+				resetURL, emailType = u, typ
+				return nil
+			},
+		}
+		authRepo := &mock.MockFishermanAuthenticationRepository{
+			FindByEmailFunc: func(_ context.Context, email string) (*model.FishermanAuthentication, error) {
+				return &model.FishermanAuthentication{ID: 1, FishermanID: 3, Email: email}, nil
+			},
+		}
+
+		uc := fisherman.NewRequestPasswordResetUseCase(authRepo, pwdResetRepo, outboxRepo, frontendURL, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+		if err := uc.Execute(context.Background(), "fisher@example.com"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if createdRole != "fisherman" {
+			t.Errorf("expected role fisherman, got %q", createdRole)
+		}
+		if emailType != string(emailMessage.EmailTypeFishermanPasswordReset) {
+			t.Errorf("unexpected email type %q", emailType)
+		}
+		if !strings.HasPrefix(resetURL, "https://example.com/login/fisherman/reset_password?token=") {
+			t.Errorf("unexpected reset URL %q", resetURL)
+		}
+	})
+
+	t.Run("UnknownEmail", func(t *testing.T) {
+		authRepo := &mock.MockFishermanAuthenticationRepository{
+			FindByEmailFunc: func(_ context.Context, _ string) (*model.FishermanAuthentication, error) {
+				return nil, &apperrors.NotFoundError{Resource: "FishermanAuthentication"}
+			},
+		}
+		uc := fisherman.NewRequestPasswordResetUseCase(authRepo, &mock.MockPasswordResetRepository{}, &mock.MockOutboxRepository{}, frontendURL, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+		var notFoundErr *apperrors.NotFoundError
+		if err := uc.Execute(context.Background(), "nobody@example.com"); !errors.As(err, &notFoundErr) {
+			t.Fatalf("expected NotFoundError, got %v", err)
+		}
+	})
+}
+
+func TestResetPasswordUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		token       *model.PasswordResetToken
+		password    string
+		wantUnauth  bool
+		wantInvalid bool
+	}{
+		{name: "Success", token: &model.PasswordResetToken{UserID: 3, Role: "fisherman", ExpiresAt: now.Add(time.Minute)}, password: "NewPassword1!"},
+		{name: "BuyerToken", token: &model.PasswordResetToken{UserID: 3, Role: "buyer", ExpiresAt: now.Add(time.Minute)}, password: "NewPassword1!", wantUnauth: true},
+		{name: "Expired", token: &model.PasswordResetToken{UserID: 3, Role: "fisherman", ExpiresAt: now.Add(-time.Minute)}, password: "NewPassword1!", wantUnauth: true},
+		{name: "UnknownToken", password: "NewPassword1!", wantUnauth: true},
+		{name: "WeakPassword", token: &model.PasswordResetToken{UserID: 3, Role: "fisherman", ExpiresAt: now.Add(time.Minute)}, password: "short", wantInvalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, revoked := false, false
+			pwdResetRepo := &mock.MockPasswordResetRepository{
+				FindByTokenHashFunc: func(_ context.Context, _ string) (*model.PasswordResetToken, error) {
+					return tt.token, nil
+				},
+			}
+			authRepo := &mock.MockFishermanAuthenticationRepository{
+				UpdatePasswordFunc: func(_ context.Context, fishermanID int, _ string) error {
+					updated = fishermanID == 3
+					return nil
+				},
+			}
+			sessionRepo := &revokingSessionRepo{onDeleteAll: func(userID int, role model.SessionRole) {
+				revoked = userID == 3 && role == model.SessionRoleFisherman
+			}}
+
+			uc := fisherman.NewResetPasswordUseCase(pwdResetRepo, authRepo, sessionRepo, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			err := uc.Execute(context.Background(), "token", tt.password)
+
+			var unauthorizedErr *apperrors.UnauthorizedError
+			var validationErr *apperrors.ValidationError
+			switch {
+			case tt.wantUnauth:
+				if !errors.As(err, &unauthorizedErr) {
+					t.Fatalf("expected UnauthorizedError, got %v", err)
+				}
+			case tt.wantInvalid:
+				if !errors.As(err, &validationErr) {
+					t.Fatalf("expected ValidationError, got %v", err)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !updated || !revoked {
+					t.Errorf("expected password update and session revocation, got updated=%v revoked=%v", updated, revoked)
+				}
+			}
+		})
+	}
+}
+
+type revokingSessionRepo struct {
+	onDeleteAll func(userID int, role model.SessionRole)
+}
+
+func (r *revokingSessionRepo) Create(_ context.Context, _ int, _ model.SessionRole) (string, error) {
+	return "", nil
+}
+
+func (r *revokingSessionRepo) CreateForLogin(_ context.Context, _, _ int, _ model.SessionRole) (string, error) {
+	return "", nil
+}
+
+func (r *revokingSessionRepo) CreateForAdmin(_ context.Context, _ int, _ model.AdminRole) (string, error) {
+	return "", nil
+}
+
+func (r *revokingSessionRepo) DeleteAllByLoginID(_ context.Context, _, _ int, _ model.SessionRole) error {
+	return nil
+}
+
+func (r *revokingSessionRepo) FindByID(_ context.Context, _ string) (*model.Session, error) {
+	return nil, nil
+}
+
+func (r *revokingSessionRepo) ListByUserID(_ context.Context, _ int, _ model.SessionRole) ([]model.Session, error) {
+	return nil, nil
+}
+
+func (r *revokingSessionRepo) Touch(_ context.Context, _, _, _ string) error {
+	return nil
+}
+
+func (r *revokingSessionRepo) Delete(_ context.Context, _ string) error {
+	return nil
+}
+
+func (r *revokingSessionRepo) DeleteAllByUserID(_ context.Context, userID int, role model.SessionRole) error {
+	r.onDeleteAll(userID, role)
+	return nil
+}
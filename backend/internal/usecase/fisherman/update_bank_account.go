@@ -0,0 +1,51 @@
+package fisherman
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UpdateBankAccountInput is the input of UpdateBankAccountUseCase.
+type UpdateBankAccountInput struct {
+	BankCode      string
+	BranchCode    string
+	AccountType   int
+	AccountNumber string
+	HolderName    string
+}
+
+// UpdateBankAccountUseCase defines the interface for registering a fisherman's payout bank account.
+type UpdateBankAccountUseCase interface {
+	Execute(ctx context.Context, id int, input *UpdateBankAccountInput) (*model.Fisherman, error)
+}
+
+type updateBankAccountUseCase struct {
+	repo repository.FishermanRepository
+}
+
+var _ UpdateBankAccountUseCase = (*updateBankAccountUseCase)(nil)
+
+// NewUpdateBankAccountUseCase creates a new UpdateBankAccountUseCase instance.
+func NewUpdateBankAccountUseCase(repo repository.FishermanRepository) UpdateBankAccountUseCase {
+	return &updateBankAccountUseCase{repo: repo}
+}
+
+// Execute validates and stores the bank account, returning the updated fisherman.
+func (uc *updateBankAccountUseCase) Execute(ctx context.Context, id int, input *UpdateBankAccountInput) (*model.Fisherman, error) {
+	account, err := model.NewBankAccount(
+		input.BankCode,
+		input.BranchCode,
+		model.BankAccountType(input.AccountType),
+		input.AccountNumber,
+		input.HolderName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateBankAccount(ctx, id, account); err != nil {
+		return nil, err
+	}
+	return uc.repo.FindByID(ctx, id)
+}
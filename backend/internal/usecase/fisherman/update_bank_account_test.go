@@ -0,0 +1,89 @@
+package fisherman_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestUpdateBankAccountUseCase_Execute(t *testing.T) {
+	valid := fisherman.UpdateBankAccountInput{
+		BankCode:      "0001",
+		BranchCode:    "100",
+		AccountType:   1,
+		AccountNumber: "1234567",
+		HolderName:    "ヤマダ タロウ",
+	}
+	repoErr := &domainErrors.NotFoundError{Resource: "Fisherman", ID: 1}
+
+	tests := []struct {
+		name       string
+		input      fisherman.UpdateBankAccountInput
+		updateErr  error
+		wantStored bool
+		wantErr    bool
+	}{
+		{name: "Success", input: valid, wantStored: true},
+		{
+			name: "InvalidBankCode",
+			input: func() fisherman.UpdateBankAccountInput {
+				in := valid
+				in.BankCode = "1"
+				return in
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "InvalidHolderName",
+			input: func() fisherman.UpdateBankAccountInput {
+				in := valid
+				in.HolderName = "山田太郎"
+				return in
+			}(),
+			wantErr: true,
+		},
+		{name: "NotFound", input: valid, updateErr: repoErr, wantStored: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored *model.BankAccount
+			repo := &mock.MockFishermanRepository{
+				UpdateBankAccountFunc: func(_ context.Context, _ int, account *model.BankAccount) error {
+					stored = account
+					return tt.updateErr
+				},
+				FindByIDFunc: func(_ context.Context, id int) (*model.Fisherman, error) {
+					return &model.Fisherman{ID: id, BankAccount: stored}, nil
+				},
+			}
+
+			uc := fisherman.NewUpdateBankAccountUseCase(repo)
+			got, err := uc.Execute(context.Background(), 1, &tt.input)
+
+			if (stored != nil) != tt.wantStored {
+				t.Errorf("expected stored=%v, got %v", tt.wantStored, stored != nil)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tt.updateErr != nil && !errors.Is(err, tt.updateErr) {
+					t.Fatalf("expected error %v, got %v", tt.updateErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.BankAccount.HolderKana != "ﾔﾏﾀﾞ ﾀﾛｳ" {
+				t.Errorf("expected normalized kana, got %q", got.BankAccount.HolderKana)
+			}
+		})
+	}
+}
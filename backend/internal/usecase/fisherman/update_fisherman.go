@@ -0,0 +1,60 @@
+package fisherman
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UpdateFishermanInput is the input of UpdateFishermanUseCase.
+type UpdateFishermanInput struct {
+	Name                     string
+	CooperativeMemberNumber  string
+	VesselName               string
+	VesselRegistrationNumber string
+	Phone                    string
+	Address                  string
+}
+
+// UpdateFishermanUseCase defines the interface for updating a fisherman's name and profile.
+type UpdateFishermanUseCase interface {
+	Execute(ctx context.Context, id int, input *UpdateFishermanInput) (*model.Fisherman, error)
+}
+
+type updateFishermanUseCase struct {
+	repo repository.FishermanRepository
+}
+
+var _ UpdateFishermanUseCase = (*updateFishermanUseCase)(nil)
+
+// NewUpdateFishermanUseCase creates a new UpdateFishermanUseCase instance.
+func NewUpdateFishermanUseCase(repo repository.FishermanRepository) UpdateFishermanUseCase {
+	return &updateFishermanUseCase{repo: repo}
+}
+
+// Execute stores the name and profile in place, returning the updated fisherman.
+// 削除・再作成すると出荷品や仕切書の参照が切れるため、同じ ID のまま更新する。
+func (uc *updateFishermanUseCase) Execute(ctx context.Context, id int, input *UpdateFishermanInput) (*model.Fisherman, error) {
+	f := &model.Fisherman{
+		ID:   id,
+		Name: input.Name,
+		FishermanProfile: model.FishermanProfile{
+			CooperativeMemberNumber:  input.CooperativeMemberNumber,
+			VesselName:               input.VesselName,
+			VesselRegistrationNumber: input.VesselRegistrationNumber,
+			Phone:                    input.Phone,
+			Address:                  input.Address,
+		},
+	}
+	if err := uc.repo.Update(ctx, f); err != nil {
+		var conflictErr *apperrors.ConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, &apperrors.ConflictError{Message: "cooperative member number is already registered to another fisherman"}
+		}
+		return nil, err
+	}
+	return uc.repo.FindByID(ctx, id)
+}
@@ -0,0 +1,76 @@
+package fisherman_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/fisherman"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestUpdateFishermanUseCase_Execute(t *testing.T) {
+	input := &fisherman.UpdateFishermanInput{
+		Name:                     "Captain Jack",
+		CooperativeMemberNumber:  "1024",
+		VesselName:               "第一漁丸",
+		VesselRegistrationNumber: "MG2-1234",
+		Phone:                    "090-0000-0000",
+		Address:                  "石巻市",
+	}
+
+	tests := []struct {
+		name      string
+		updateErr error
+		wantErr   error
+	}{
+		{name: "Success"},
+		{name: "MemberNumberTaken", updateErr: &domainErrors.ConflictError{Message: "Fisherman already exists"}, wantErr: &domainErrors.ConflictError{}},
+		{name: "NotFound", updateErr: &domainErrors.NotFoundError{Resource: "Fisherman", ID: 1}, wantErr: &domainErrors.NotFoundError{}},
+		{name: "Invalid", updateErr: &domainErrors.ValidationError{Field: "phone"}, wantErr: &domainErrors.ValidationError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stored *model.Fisherman
+			repo := &mock.MockFishermanRepository{
+				UpdateFunc: func(_ context.Context, f *model.Fisherman) error {
+					stored = f
+					return tt.updateErr
+				},
+				FindByIDFunc: func(_ context.Context, id int) (*model.Fisherman, error) {
+					return &model.Fisherman{ID: id, Name: stored.Name, FishermanProfile: stored.FishermanProfile}, nil
+				},
+			}
+
+			got, err := fisherman.NewUpdateFishermanUseCase(repo).Execute(context.Background(), 1, input)
+
+			if stored == nil || stored.ID != 1 || stored.VesselName != "第一漁丸" {
+				t.Fatalf("unexpected fisherman passed to repository: %+v", stored)
+			}
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if got.CooperativeMemberNumber != "1024" {
+					t.Errorf("unexpected fisherman: %+v", got)
+				}
+			case *domainErrors.ConflictError:
+				if !errors.As(err, &want) || want.Message != "cooperative member number is already registered to another fisherman" {
+					t.Errorf("expected conflict error, got %v", err)
+				}
+			case *domainErrors.NotFoundError:
+				if !errors.As(err, &want) {
+					t.Errorf("expected not found error, got %v", err)
+				}
+			case *domainErrors.ValidationError:
+				if !errors.As(err, &want) {
+					t.Errorf("expected validation error, got %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package fisherman
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// VerifyResetTokenUseCase defines the interface for verifying a fisherman password reset token.
+type VerifyResetTokenUseCase interface {
+	// Execute verifies the reset token.
+	Execute(ctx context.Context, token string) error
+}
+
+type verifyResetTokenUseCase struct {
+	pwdResetRepo repository.PasswordResetRepository
+	clock        service.Clock
+}
+
+var _ VerifyResetTokenUseCase = (*verifyResetTokenUseCase)(nil)
+
+// NewVerifyResetTokenUseCase creates a new instance of VerifyResetTokenUseCase.
+func NewVerifyResetTokenUseCase(
+	pwdResetRepo repository.PasswordResetRepository,
+	clock service.Clock,
+) VerifyResetTokenUseCase {
+	return &verifyResetTokenUseCase{
+		pwdResetRepo: pwdResetRepo,
+		clock:        clock,
+	}
+}
+
+func (u *verifyResetTokenUseCase) Execute(ctx context.Context, token string) error {
+	_, err := findValidResetToken(ctx, u.pwdResetRepo, u.clock, token)
+	return err
+}
+
+// findValidResetToken looks up an unexpired fisherman reset token, deleting it if it has expired.
+func findValidResetToken(ctx context.Context, repo repository.PasswordResetRepository, clock service.Clock, token string) (*model.PasswordResetToken, error) {
+	hash := sha256.Sum256([]byte(token))
+	tokenHash := hex.EncodeToString(hash[:])
+
+	resetToken, err := repo.FindByTokenHash(ctx, tokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if resetToken == nil || resetToken.Role != resetRole {
+		return nil, &apperrors.UnauthorizedError{Message: "Invalid or expired token"}
+	}
+	if clock.Now().After(resetToken.ExpiresAt) {
+		_ = repo.DeleteByTokenHash(ctx, tokenHash)
+		return nil, &apperrors.UnauthorizedError{Message: "Invalid or expired token"}
+	}
+	return resetToken, nil
+}
@@ -0,0 +1,98 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// GenerateInvoicesUseCase defines the interface for generating draft invoices of an auction.
+type GenerateInvoicesUseCase interface {
+	// Execute (re)generates draft invoices for every winning or charged buyer of the auction.
+	Execute(ctx context.Context, auctionID int) ([]model.Invoice, error)
+}
+
+type generateInvoicesUseCase struct {
+	auctionRepo repository.AuctionRepository
+	bidRepo     repository.BidRepository
+	invoiceRepo repository.InvoiceRepository
+	chargeRepo  repository.BuyerChargeRepository
+	txMgr       repository.TransactionManager
+}
+
+var _ GenerateInvoicesUseCase = (*generateInvoicesUseCase)(nil)
+
+// NewGenerateInvoicesUseCase creates a new GenerateInvoicesUseCase instance.
+func NewGenerateInvoicesUseCase(
+	auctionRepo repository.AuctionRepository,
+	bidRepo repository.BidRepository,
+	invoiceRepo repository.InvoiceRepository,
+	chargeRepo repository.BuyerChargeRepository,
+	txMgr repository.TransactionManager,
+) GenerateInvoicesUseCase {
+	return &generateInvoicesUseCase{
+		auctionRepo: auctionRepo,
+		bidRepo:     bidRepo,
+		invoiceRepo: invoiceRepo,
+		chargeRepo:  chargeRepo,
+		txMgr:       txMgr,
+	}
+}
+
+// Execute (re)generates draft invoices for the auction.
+// 発行済み（issued / paid）の請求書がある買受人はスキップし、draft のみを作り直す。
+func (uc *generateInvoicesUseCase) Execute(ctx context.Context, auctionID int) ([]model.Invoice, error) {
+	var created []model.Invoice
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		auction, err := uc.auctionRepo.FindByIDWithLock(txCtx, auctionID)
+		if err != nil {
+			return err
+		}
+		if auction.Status != model.AuctionStatusCompleted {
+			return &apperrors.ConflictError{Message: "invoices can only be generated for a completed auction"}
+		}
+
+		existing, err := uc.invoiceRepo.ListByAuctionID(txCtx, auctionID)
+		if err != nil {
+			return fmt.Errorf("failed to list invoices: %w", err)
+		}
+		finalized := make(map[int]bool)
+		for _, inv := range existing {
+			if inv.Status != model.InvoiceStatusDraft {
+				finalized[inv.BuyerID] = true
+			}
+		}
+
+		if err := uc.invoiceRepo.DeleteDraftsByAuctionID(txCtx, auctionID); err != nil {
+			return fmt.Errorf("failed to delete draft invoices: %w", err)
+		}
+
+		awards, err := uc.bidRepo.ListAwardsByAuctionID(txCtx, auctionID)
+		if err != nil {
+			return fmt.Errorf("failed to list awards: %w", err)
+		}
+		charges, err := uc.chargeRepo.ListByAuctionID(txCtx, auctionID)
+		if err != nil {
+			return fmt.Errorf("failed to list charges: %w", err)
+		}
+
+		for _, inv := range model.BuildDraftInvoices(auctionID, awards, charges) {
+			if finalized[inv.BuyerID] {
+				continue
+			}
+			saved, err := uc.invoiceRepo.Create(txCtx, inv)
+			if err != nil {
+				return fmt.Errorf("failed to create invoice: %w", err)
+			}
+			created = append(created, *saved)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
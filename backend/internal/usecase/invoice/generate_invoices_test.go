@@ -0,0 +1,150 @@
+package invoice_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/invoice"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestGenerateInvoicesUseCase_Execute(t *testing.T) {
+	awards := []model.Purchase{
+		{ItemID: 1, FishType: "Tuna", Quantity: 1, Unit: "kg", Price: 10000, BuyerID: 2},
+		{ItemID: 2, FishType: "Mackerel", Quantity: 3, Unit: "box", Price: 3000, BuyerID: 1},
+		{ItemID: 3, FishType: "Squid", Quantity: 2, Unit: "box", Price: 2000, BuyerID: 2},
+	}
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name          string
+		auction       *model.Auction
+		existing      []model.Invoice
+		charges       []model.BuyerCharge
+		awardsErr     error
+		wantBuyerIDs  []int
+		wantTotals    []int
+		wantErr       error
+		wantDeleteRun bool
+	}{
+		{
+			name:          "Success",
+			auction:       &model.Auction{ID: 1, Status: model.AuctionStatusCompleted},
+			wantBuyerIDs:  []int{1, 2},
+			wantTotals:    []int{3240, 12960},
+			wantDeleteRun: true,
+		},
+		{
+			name:    "IncludesMiscCharges",
+			auction: &model.Auction{ID: 1, Status: model.AuctionStatusCompleted},
+			charges: []model.BuyerCharge{
+				{ID: 1, BuyerID: 1, Description: "氷", UnitPrice: 300, Quantity: 2, TaxRate: model.TaxRateStandard},
+				{ID: 2, BuyerID: 3, Description: "配送料", UnitPrice: 1500, Quantity: 1, TaxRate: model.TaxRateStandard},
+			},
+			wantBuyerIDs:  []int{1, 2, 3},
+			wantTotals:    []int{3240 + 660, 12960, 1650},
+			wantDeleteRun: true,
+		},
+		{
+			name:    "SkipsBuyersWithIssuedInvoice",
+			auction: &model.Auction{ID: 1, Status: model.AuctionStatusCompleted},
+			existing: []model.Invoice{
+				{ID: 9, BuyerID: 2, Status: model.InvoiceStatusIssued},
+				{ID: 8, BuyerID: 1, Status: model.InvoiceStatusDraft},
+			},
+			wantBuyerIDs:  []int{1},
+			wantTotals:    []int{3240},
+			wantDeleteRun: true,
+		},
+		{
+			name:    "AuctionNotCompleted",
+			auction: &model.Auction{ID: 1, Status: model.AuctionStatusInProgress},
+			wantErr: &domainErrors.ConflictError{},
+		},
+		{
+			name:          "AwardsError",
+			auction:       &model.Auction{ID: 1, Status: model.AuctionStatusCompleted},
+			awardsErr:     dbErr,
+			wantErr:       dbErr,
+			wantDeleteRun: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleteRun := false
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.Auction, error) {
+					return tt.auction, nil
+				},
+			}
+			bidRepo := &mock.MockBidRepository{
+				ListAwardsByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Purchase, error) {
+					return awards, tt.awardsErr
+				},
+			}
+			nextID := 100
+			invoiceRepo := &mock.MockInvoiceRepository{
+				ListByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+					return tt.existing, nil
+				},
+				DeleteDraftsByAuctionIDFunc: func(_ context.Context, _ int) error {
+					deleteRun = true
+					return nil
+				},
+				CreateFunc: func(_ context.Context, inv *model.Invoice) (*model.Invoice, error) {
+					nextID++
+					inv.ID = nextID
+					return inv, nil
+				},
+			}
+
+			chargeRepo := &mock.MockBuyerChargeRepository{
+				ListByAuctionIDFunc: func(_ context.Context, _ int) ([]model.BuyerCharge, error) {
+					return tt.charges, nil
+				},
+			}
+
+			uc := invoice.NewGenerateInvoicesUseCase(auctionRepo, bidRepo, invoiceRepo, chargeRepo, &mock.MockTransactionManager{})
+			got, err := uc.Execute(context.Background(), 1)
+
+			if deleteRun != tt.wantDeleteRun {
+				t.Errorf("expected delete drafts called=%v, got %v", tt.wantDeleteRun, deleteRun)
+			}
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error %T, got nil", tt.wantErr)
+				}
+				var conflictErr *domainErrors.ConflictError
+				if errors.As(tt.wantErr, &conflictErr) {
+					if !errors.As(err, &conflictErr) {
+						t.Fatalf("expected ConflictError, got %T", err)
+					}
+				} else if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(got) != len(tt.wantBuyerIDs) {
+				t.Fatalf("expected %d invoices, got %d", len(tt.wantBuyerIDs), len(got))
+			}
+			for i, inv := range got {
+				if inv.BuyerID != tt.wantBuyerIDs[i] {
+					t.Errorf("invoice %d: expected buyer %d, got %d", i, tt.wantBuyerIDs[i], inv.BuyerID)
+				}
+				if inv.TotalAmount != tt.wantTotals[i] {
+					t.Errorf("invoice %d: expected total %d, got %d", i, tt.wantTotals[i], inv.TotalAmount)
+				}
+				if inv.Status != model.InvoiceStatusDraft {
+					t.Errorf("invoice %d: expected draft, got %s", i, inv.Status)
+				}
+			}
+		})
+	}
+}
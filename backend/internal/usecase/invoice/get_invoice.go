@@ -0,0 +1,30 @@
+package invoice
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// GetInvoiceUseCase defines the interface for retrieving a single invoice.
+type GetInvoiceUseCase interface {
+	// Execute returns the invoice with its lines.
+	Execute(ctx context.Context, id int) (*model.Invoice, error)
+}
+
+type getInvoiceUseCase struct {
+	invoiceRepo repository.InvoiceRepository
+}
+
+var _ GetInvoiceUseCase = (*getInvoiceUseCase)(nil)
+
+// NewGetInvoiceUseCase creates a new GetInvoiceUseCase instance.
+func NewGetInvoiceUseCase(invoiceRepo repository.InvoiceRepository) GetInvoiceUseCase {
+	return &getInvoiceUseCase{invoiceRepo: invoiceRepo}
+}
+
+// Execute returns the invoice with its lines.
+func (uc *getInvoiceUseCase) Execute(ctx context.Context, id int) (*model.Invoice, error) {
+	return uc.invoiceRepo.FindByID(ctx, id)
+}
@@ -0,0 +1,40 @@
+package invoice_test
+
+import (
+	"context"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/invoice"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestGetInvoiceUseCase_Execute(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		repo := &mock.MockInvoiceRepository{
+			FindByIDFunc: func(_ context.Context, id int) (*model.Invoice, error) {
+				return &model.Invoice{ID: id}, nil
+			},
+		}
+		got, err := invoice.NewGetInvoiceUseCase(repo).Execute(context.Background(), 3)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if got.ID != 3 {
+			t.Errorf("expected ID 3, got %d", got.ID)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repo := &mock.MockInvoiceRepository{
+			FindByIDFunc: func(_ context.Context, id int) (*model.Invoice, error) {
+				return nil, &domainErrors.NotFoundError{Resource: "Invoice", ID: id}
+			},
+		}
+		_, err := invoice.NewGetInvoiceUseCase(repo).Execute(context.Background(), 3)
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// IssueInvoiceUseCase defines the interface for issuing a draft invoice.
+type IssueInvoiceUseCase interface {
+	// Execute issues the invoice and applies any credit the buyer holds.
+	Execute(ctx context.Context, id int) (*model.Invoice, error)
+}
+
+type issueInvoiceUseCase struct {
+	invoiceRepo repository.InvoiceRepository
+	paymentRepo repository.PaymentRepository
+	txMgr       repository.TransactionManager
+	clock       service.Clock
+}
+
+var _ IssueInvoiceUseCase = (*issueInvoiceUseCase)(nil)
+
+// NewIssueInvoiceUseCase creates a new IssueInvoiceUseCase instance.
+func NewIssueInvoiceUseCase(
+	invoiceRepo repository.InvoiceRepository,
+	paymentRepo repository.PaymentRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) IssueInvoiceUseCase {
+	return &issueInvoiceUseCase{
+		invoiceRepo: invoiceRepo,
+		paymentRepo: paymentRepo,
+		txMgr:       txMgr,
+		clock:       clock,
+	}
+}
+
+// Execute issues a draft invoice.
+// 過入金による前受金（クレジット）が残っていれば、発行と同時に古い入金から充当する。
+func (uc *issueInvoiceUseCase) Execute(ctx context.Context, id int) (*model.Invoice, error) {
+	var issued *model.Invoice
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		inv, err := uc.invoiceRepo.FindByIDWithLock(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		now := uc.clock.Now()
+		if !inv.Issue(now) {
+			return &apperrors.ConflictError{Message: "only draft invoices can be issued"}
+		}
+
+		payments, err := uc.paymentRepo.ListByBuyerIDWithLock(txCtx, inv.BuyerID)
+		if err != nil {
+			return fmt.Errorf("failed to list payments: %w", err)
+		}
+		for i := range payments {
+			for _, a := range payments[i].Allocate([]*model.Invoice{inv}, now) {
+				if err := uc.paymentRepo.CreateAllocation(txCtx, &a); err != nil {
+					return fmt.Errorf("failed to allocate credit: %w", err)
+				}
+			}
+		}
+
+		if err := uc.invoiceRepo.Update(txCtx, inv); err != nil {
+			return fmt.Errorf("failed to update invoice: %w", err)
+		}
+		issued = inv
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issued, nil
+}
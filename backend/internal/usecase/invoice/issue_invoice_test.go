@@ -0,0 +1,114 @@
+package invoice_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/invoice"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestIssueInvoiceUseCase_Execute(t *testing.T) {
+	now := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		status          model.InvoiceStatus
+		payments        []model.Payment
+		wantStatus      model.InvoiceStatus
+		wantPaid        int
+		wantAllocations int
+		wantConflict    bool
+	}{
+		{
+			name:       "IssuesDraft",
+			status:     model.InvoiceStatusDraft,
+			wantStatus: model.InvoiceStatusIssued,
+		},
+		{
+			name:   "AppliesCredit",
+			status: model.InvoiceStatusDraft,
+			payments: []model.Payment{
+				{ID: 1, Amount: 1000, AllocatedAmount: 1000},
+				{ID: 2, Amount: 800, AllocatedAmount: 200},
+			},
+			wantStatus:      model.InvoiceStatusIssued,
+			wantPaid:        600,
+			wantAllocations: 1,
+		},
+		{
+			name:   "CreditCoversInvoice",
+			status: model.InvoiceStatusDraft,
+			payments: []model.Payment{
+				{ID: 1, Amount: 700},
+				{ID: 2, Amount: 700},
+			},
+			wantStatus:      model.InvoiceStatusPaid,
+			wantPaid:        1080,
+			wantAllocations: 2,
+		},
+		{
+			name:         "AlreadyIssued",
+			status:       model.InvoiceStatusIssued,
+			wantConflict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *model.Invoice
+			invoiceRepo := &mock.MockInvoiceRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Invoice, error) {
+					return &model.Invoice{ID: id, BuyerID: 1, Status: tt.status, TotalAmount: 1080}, nil
+				},
+				UpdateFunc: func(_ context.Context, inv *model.Invoice) error {
+					updated = inv
+					return nil
+				},
+			}
+			allocations := 0
+			paymentRepo := &mock.MockPaymentRepository{
+				ListByBuyerIDWithLockFunc: func(_ context.Context, _ int) ([]model.Payment, error) {
+					return tt.payments, nil
+				},
+				CreateAllocationFunc: func(_ context.Context, _ *model.PaymentAllocation) error {
+					allocations++
+					return nil
+				},
+			}
+
+			uc := invoice.NewIssueInvoiceUseCase(invoiceRepo, paymentRepo, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			got, err := uc.Execute(context.Background(), 10)
+
+			if tt.wantConflict {
+				var conflictErr *domainErrors.ConflictError
+				if !errors.As(err, &conflictErr) {
+					t.Fatalf("expected ConflictError, got %v", err)
+				}
+				if updated != nil {
+					t.Error("expected invoice not to be updated")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("expected status %s, got %s", tt.wantStatus, got.Status)
+			}
+			if got.PaidAmount != tt.wantPaid {
+				t.Errorf("expected paid %d, got %d", tt.wantPaid, got.PaidAmount)
+			}
+			if allocations != tt.wantAllocations {
+				t.Errorf("expected %d allocations, got %d", tt.wantAllocations, allocations)
+			}
+			if updated == nil || updated.IssuedAt == nil || !updated.IssuedAt.Equal(now) {
+				t.Error("expected invoice to be persisted with issued_at")
+			}
+		})
+	}
+}
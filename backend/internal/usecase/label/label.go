@@ -0,0 +1,64 @@
+package label
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// scanPath is the frontend page opened when a label QR code is scanned.
+const scanPath = "/scan"
+
+// labelIssuer builds printable labels and signs the item reference carried by their QR codes.
+type labelIssuer struct {
+	fishermanRepo repository.FishermanRepository
+	clock         service.Clock
+	frontendURL   *url.URL
+	signingKey    []byte
+	codeTTL       time.Duration
+}
+
+// issue builds labels for the items in the given order.
+// 印刷のたびに新しい有効期限で署名し直すため、再印刷したラベルは古いラベルより長く使える。
+func (i *labelIssuer) issue(ctx context.Context, items []model.AuctionItem) ([]model.Label, error) {
+	expiresAt := i.clock.Now().Add(i.codeTTL)
+	names := make(map[int]string)
+
+	labels := make([]model.Label, 0, len(items))
+	for _, item := range items {
+		name, ok := names[item.FishermanID]
+		if !ok {
+			fisherman, err := i.fishermanRepo.FindByID(ctx, item.FishermanID)
+			if err != nil {
+				return nil, err
+			}
+			name = fisherman.Name
+			names[item.FishermanID] = name
+		}
+
+		code := model.LabelCode{ItemID: item.ID, ExpiresAt: expiresAt}.Sign(i.signingKey)
+		labels = append(labels, model.Label{
+			ItemID:        item.ID,
+			AuctionID:     item.AuctionID,
+			LotNumber:     item.SortOrder,
+			FishType:      item.FishType,
+			Quantity:      item.Quantity,
+			Unit:          item.Unit,
+			FishermanName: name,
+			QRContent:     i.scanURL(code),
+		})
+	}
+	return labels, nil
+}
+
+func (i *labelIssuer) scanURL(code string) string {
+	u := i.frontendURL.JoinPath(scanPath)
+	q := u.Query()
+	q.Set("code", code)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,68 @@
+package label
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// PrintAuctionLabelsUseCase defines the interface for printing the lot labels of an auction.
+type PrintAuctionLabelsUseCase interface {
+	// Execute returns a printable document with one label per item, in lot order.
+	Execute(ctx context.Context, auctionID int) ([]byte, error)
+}
+
+type printAuctionLabelsUseCase struct {
+	auctionRepo repository.AuctionRepository
+	itemRepo    repository.ItemRepository
+	renderer    service.LabelRenderer
+	issuer      *labelIssuer
+}
+
+var _ PrintAuctionLabelsUseCase = (*printAuctionLabelsUseCase)(nil)
+
+// NewPrintAuctionLabelsUseCase creates a new PrintAuctionLabelsUseCase instance.
+func NewPrintAuctionLabelsUseCase(
+	auctionRepo repository.AuctionRepository,
+	itemRepo repository.ItemRepository,
+	fishermanRepo repository.FishermanRepository,
+	renderer service.LabelRenderer,
+	clock service.Clock,
+	frontendURL *url.URL,
+	signingKey []byte,
+	codeTTL time.Duration,
+) PrintAuctionLabelsUseCase {
+	return &printAuctionLabelsUseCase{
+		auctionRepo: auctionRepo,
+		itemRepo:    itemRepo,
+		renderer:    renderer,
+		issuer: &labelIssuer{
+			fishermanRepo: fishermanRepo,
+			clock:         clock,
+			frontendURL:   frontendURL,
+			signingKey:    signingKey,
+			codeTTL:       codeTTL,
+		},
+	}
+}
+
+// Execute renders labels for every item of the auction that has not been deleted.
+func (uc *printAuctionLabelsUseCase) Execute(ctx context.Context, auctionID int) ([]byte, error) {
+	if _, err := uc.auctionRepo.FindByID(ctx, auctionID); err != nil {
+		return nil, err
+	}
+
+	items, err := uc.itemRepo.ListByAuction(ctx, auctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := uc.issuer.issue(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	return uc.renderer.Render(labels)
+}
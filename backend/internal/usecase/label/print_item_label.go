@@ -0,0 +1,66 @@
+package label
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// PrintItemLabelUseCase defines the interface for reprinting the label of a single item.
+type PrintItemLabelUseCase interface {
+	// Execute returns a printable document with the label of the item.
+	Execute(ctx context.Context, itemID int) ([]byte, error)
+}
+
+type printItemLabelUseCase struct {
+	itemRepo repository.ItemRepository
+	renderer service.LabelRenderer
+	issuer   *labelIssuer
+}
+
+var _ PrintItemLabelUseCase = (*printItemLabelUseCase)(nil)
+
+// NewPrintItemLabelUseCase creates a new PrintItemLabelUseCase instance.
+func NewPrintItemLabelUseCase(
+	itemRepo repository.ItemRepository,
+	fishermanRepo repository.FishermanRepository,
+	renderer service.LabelRenderer,
+	clock service.Clock,
+	frontendURL *url.URL,
+	signingKey []byte,
+	codeTTL time.Duration,
+) PrintItemLabelUseCase {
+	return &printItemLabelUseCase{
+		itemRepo: itemRepo,
+		renderer: renderer,
+		issuer: &labelIssuer{
+			fishermanRepo: fishermanRepo,
+			clock:         clock,
+			frontendURL:   frontendURL,
+			signingKey:    signingKey,
+			codeTTL:       codeTTL,
+		},
+	}
+}
+
+// Execute renders the label of the item, e.g. to replace a damaged one.
+func (uc *printItemLabelUseCase) Execute(ctx context.Context, itemID int) ([]byte, error) {
+	item, err := uc.itemRepo.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.DeletedAt != nil {
+		return nil, &apperrors.NotFoundError{Resource: "Item", ID: itemID}
+	}
+
+	labels, err := uc.issuer.issue(ctx, []model.AuctionItem{*item})
+	if err != nil {
+		return nil, err
+	}
+	return uc.renderer.Render(labels)
+}
@@ -0,0 +1,183 @@
+package label_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/label"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+var (
+	testSigningKey  = []byte("0123456789abcdef0123456789abcdef")
+	testFrontendURL = &url.URL{Scheme: "https", Host: "auction.example.com"}
+	testNow         = time.Date(2026, 3, 1, 5, 0, 0, 0, time.UTC)
+)
+
+func newFishermanRepo(calls *int) *mock.MockFishermanRepository {
+	return &mock.MockFishermanRepository{
+		FindByIDFunc: func(_ context.Context, id int) (*model.Fisherman, error) {
+			*calls++
+			return &model.Fisherman{ID: id, Name: map[int]string{1: "山田丸", 2: "佐藤丸"}[id]}, nil
+		},
+	}
+}
+
+func TestPrintAuctionLabelsUseCase_Execute(t *testing.T) {
+	auctionNotFound := &domainErrors.NotFoundError{Resource: "Auction", ID: 9}
+
+	tests := []struct {
+		name       string
+		auctionID  int
+		auctionErr error
+		items      []model.AuctionItem
+		wantLabels int
+	}{
+		{
+			name:      "Success",
+			auctionID: 1,
+			items: []model.AuctionItem{
+				{ID: 10, AuctionID: 1, FishermanID: 1, FishType: "マグロ", Quantity: 2, Unit: "本", SortOrder: 1},
+				{ID: 11, AuctionID: 1, FishermanID: 2, FishType: "ブリ", Quantity: 5, Unit: "kg", SortOrder: 2},
+				{ID: 12, AuctionID: 1, FishermanID: 1, FishType: "タイ", Quantity: 3, Unit: "尾", SortOrder: 3},
+			},
+			wantLabels: 3,
+		},
+		{name: "NoItems", auctionID: 1, wantLabels: 0},
+		{name: "AuctionNotFound", auctionID: 9, auctionErr: auctionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					if tt.auctionErr != nil {
+						return nil, tt.auctionErr
+					}
+					return &model.Auction{ID: id}, nil
+				},
+			}
+			itemRepo := &mock.MockItemRepository{
+				ListByAuctionFunc: func(_ context.Context, _ int) ([]model.AuctionItem, error) {
+					return tt.items, nil
+				},
+			}
+			var fishermanCalls int
+			var rendered []model.Label
+			renderer := &mock.MockLabelRenderer{
+				RenderFunc: func(labels []model.Label) ([]byte, error) {
+					rendered = labels
+					return []byte("pdf"), nil
+				},
+			}
+
+			uc := label.NewPrintAuctionLabelsUseCase(
+				auctionRepo, itemRepo, newFishermanRepo(&fishermanCalls), renderer,
+				mock.NewMockClock(testNow), testFrontendURL, testSigningKey, 72*time.Hour,
+			)
+			got, err := uc.Execute(context.Background(), tt.auctionID)
+
+			if tt.auctionErr != nil {
+				if !errors.Is(err, tt.auctionErr) {
+					t.Fatalf("expected %v, got %v", tt.auctionErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != "pdf" {
+				t.Errorf("expected rendered document, got %q", got)
+			}
+			if len(rendered) != tt.wantLabels {
+				t.Fatalf("expected %d labels, got %d", tt.wantLabels, len(rendered))
+			}
+			if tt.wantLabels == 0 {
+				return
+			}
+			if fishermanCalls != 2 {
+				t.Errorf("expected fishermen to be looked up once each, got %d calls", fishermanCalls)
+			}
+
+			first := rendered[0]
+			if first.LotNumber != 1 || first.FishermanName != "山田丸" || first.FishType != "マグロ" || first.Unit != "本" {
+				t.Errorf("unexpected label: %+v", first)
+			}
+			prefix := "https://auction.example.com/scan?code="
+			if !strings.HasPrefix(first.QRContent, prefix) {
+				t.Fatalf("expected scan URL, got %q", first.QRContent)
+			}
+			code, err := url.QueryUnescape(strings.TrimPrefix(first.QRContent, prefix))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			parsed, err := model.ParseLabelCode(testSigningKey, code)
+			if err != nil {
+				t.Fatalf("expected a verifiable code, got %v", err)
+			}
+			if parsed.ItemID != 10 || !parsed.ExpiresAt.Equal(testNow.Add(72*time.Hour)) {
+				t.Errorf("unexpected code: %+v", parsed)
+			}
+		})
+	}
+}
+
+func TestPrintItemLabelUseCase_Execute(t *testing.T) {
+	deletedAt := testNow.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		item    *model.AuctionItem
+		wantErr bool
+	}{
+		{name: "Success", item: &model.AuctionItem{ID: 10, AuctionID: 1, FishermanID: 2, FishType: "ブリ", Quantity: 5, Unit: "kg", SortOrder: 4}},
+		{name: "DeletedItem", item: &model.AuctionItem{ID: 10, AuctionID: 1, FishermanID: 2, DeletedAt: &deletedAt}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemRepo := &mock.MockItemRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.AuctionItem, error) {
+					return tt.item, nil
+				},
+			}
+			var fishermanCalls int
+			var rendered []model.Label
+			renderer := &mock.MockLabelRenderer{
+				RenderFunc: func(labels []model.Label) ([]byte, error) {
+					rendered = labels
+					return []byte("pdf"), nil
+				},
+			}
+
+			uc := label.NewPrintItemLabelUseCase(
+				itemRepo, newFishermanRepo(&fishermanCalls), renderer,
+				mock.NewMockClock(testNow), testFrontendURL, testSigningKey, 72*time.Hour,
+			)
+			_, err := uc.Execute(context.Background(), tt.item.ID)
+
+			if tt.wantErr {
+				var nfErr *domainErrors.NotFoundError
+				if !errors.As(err, &nfErr) {
+					t.Fatalf("expected NotFoundError, got %v", err)
+				}
+				if rendered != nil {
+					t.Error("expected nothing to be rendered")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rendered) != 1 || rendered[0].LotNumber != 4 || rendered[0].FishermanName != "佐藤丸" {
+				t.Errorf("unexpected labels: %+v", rendered)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package label
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// ScanLabelUseCase defines the interface for resolving a scanned label code to its lot.
+type ScanLabelUseCase interface {
+	// Execute verifies the code and returns the item with its current bidding state.
+	Execute(ctx context.Context, code string) (*model.LabelScan, error)
+}
+
+type scanLabelUseCase struct {
+	itemRepo    repository.ItemRepository
+	auctionRepo repository.AuctionRepository
+	clock       service.Clock
+	signingKey  []byte
+}
+
+var _ ScanLabelUseCase = (*scanLabelUseCase)(nil)
+
+// NewScanLabelUseCase creates a new ScanLabelUseCase instance.
+func NewScanLabelUseCase(
+	itemRepo repository.ItemRepository,
+	auctionRepo repository.AuctionRepository,
+	clock service.Clock,
+	signingKey []byte,
+) ScanLabelUseCase {
+	return &scanLabelUseCase{
+		itemRepo:    itemRepo,
+		auctionRepo: auctionRepo,
+		clock:       clock,
+		signingKey:  signingKey,
+	}
+}
+
+// Execute resolves the code.
+// 署名が正しい（＝一度は発行された）コードなので、商品が見つからない場合も削除済みとして扱う。
+func (uc *scanLabelUseCase) Execute(ctx context.Context, code string) (*model.LabelScan, error) {
+	labelCode, err := model.ParseLabelCode(uc.signingKey, code)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.clock.Now()
+	if labelCode.Expired(now) {
+		return nil, &apperrors.GoneError{Resource: "LabelCode", Message: "label code has expired; ask staff to reprint the label"}
+	}
+
+	withdrawn := &apperrors.GoneError{Resource: "Item", ID: labelCode.ItemID, Message: "this lot has been withdrawn from the auction"}
+	item, err := uc.itemRepo.FindByID(ctx, labelCode.ItemID)
+	if err != nil {
+		var nfErr *apperrors.NotFoundError
+		if errors.As(err, &nfErr) {
+			return nil, withdrawn
+		}
+		return nil, err
+	}
+	if item.DeletedAt != nil {
+		return nil, withdrawn
+	}
+
+	auction, err := uc.auctionRepo.FindByID(ctx, item.AuctionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.LabelScan{
+		Item:        item,
+		Auction:     auction,
+		BiddingOpen: auction.Status == model.AuctionStatusInProgress && auction.Period.IsBiddingOpen(now),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package label_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/label"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestScanLabelUseCase_Execute(t *testing.T) {
+	validCode := model.LabelCode{ItemID: 10, ExpiresAt: testNow.Add(time.Hour)}.Sign(testSigningKey)
+	expiredCode := model.LabelCode{ItemID: 10, ExpiresAt: testNow}.Sign(testSigningKey)
+	start, end := testNow.Add(-time.Hour), testNow.Add(time.Hour)
+	deletedAt := testNow.Add(-time.Minute)
+	highest := model.NewBidPrice(3000)
+
+	tests := []struct {
+		name        string
+		code        string
+		item        *model.AuctionItem
+		itemErr     error
+		status      model.AuctionStatus
+		wantErr     error
+		wantMessage string
+		wantOpen    bool
+	}{
+		{
+			name:     "Success",
+			code:     validCode,
+			item:     &model.AuctionItem{ID: 10, AuctionID: 1, HighestBid: &highest},
+			status:   model.AuctionStatusInProgress,
+			wantOpen: true,
+		},
+		{
+			name:   "AuctionNotStarted",
+			code:   validCode,
+			item:   &model.AuctionItem{ID: 10, AuctionID: 1},
+			status: model.AuctionStatusScheduled,
+		},
+		{name: "InvalidSignature", code: validCode + "x", wantErr: &domainErrors.ValidationError{}, wantMessage: "code: label code is invalid"},
+		{name: "Expired", code: expiredCode, wantErr: &domainErrors.GoneError{}, wantMessage: "label code has expired; ask staff to reprint the label"},
+		{
+			name:        "DeletedItem",
+			code:        validCode,
+			item:        &model.AuctionItem{ID: 10, AuctionID: 1, DeletedAt: &deletedAt},
+			wantErr:     &domainErrors.GoneError{},
+			wantMessage: "this lot has been withdrawn from the auction",
+		},
+		{
+			name:        "MissingItem",
+			code:        validCode,
+			itemErr:     &domainErrors.NotFoundError{Resource: "Item", ID: 10},
+			wantErr:     &domainErrors.GoneError{},
+			wantMessage: "this lot has been withdrawn from the auction",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemRepo := &mock.MockItemRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.AuctionItem, error) {
+					return tt.item, tt.itemErr
+				},
+			}
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, Status: tt.status, Period: model.NewAuctionPeriod(&start, &end)}, nil
+				},
+			}
+
+			uc := label.NewScanLabelUseCase(itemRepo, auctionRepo, mock.NewMockClock(testNow), testSigningKey)
+			got, err := uc.Execute(context.Background(), tt.code)
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ValidationError:
+					var vErr *domainErrors.ValidationError
+					if !errors.As(err, &vErr) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+				case *domainErrors.GoneError:
+					var gErr *domainErrors.GoneError
+					if !errors.As(err, &gErr) {
+						t.Fatalf("expected GoneError, got %v", err)
+					}
+				}
+				if err.Error() != tt.wantMessage {
+					t.Errorf("expected message %q, got %q", tt.wantMessage, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Item.ID != 10 || got.Auction.ID != 1 {
+				t.Errorf("unexpected scan: %+v", got)
+			}
+			if got.BiddingOpen != tt.wantOpen {
+				t.Errorf("expected bidding open %v, got %v", tt.wantOpen, got.BiddingOpen)
+			}
+		})
+	}
+}
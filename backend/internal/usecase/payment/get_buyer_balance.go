@@ -0,0 +1,43 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// GetBuyerBalanceUseCase defines the interface for retrieving a buyer's outstanding balance.
+type GetBuyerBalanceUseCase interface {
+	// Execute returns the balance summary of the buyer.
+	Execute(ctx context.Context, buyerID int) (*model.BuyerBalance, error)
+}
+
+type getBuyerBalanceUseCase struct {
+	invoiceRepo repository.InvoiceRepository
+	paymentRepo repository.PaymentRepository
+}
+
+var _ GetBuyerBalanceUseCase = (*getBuyerBalanceUseCase)(nil)
+
+// NewGetBuyerBalanceUseCase creates a new GetBuyerBalanceUseCase instance.
+func NewGetBuyerBalanceUseCase(invoiceRepo repository.InvoiceRepository, paymentRepo repository.PaymentRepository) GetBuyerBalanceUseCase {
+	return &getBuyerBalanceUseCase{
+		invoiceRepo: invoiceRepo,
+		paymentRepo: paymentRepo,
+	}
+}
+
+// Execute returns the balance summary of the buyer.
+func (uc *getBuyerBalanceUseCase) Execute(ctx context.Context, buyerID int) (*model.BuyerBalance, error) {
+	invoices, err := uc.invoiceRepo.ListByBuyerID(ctx, buyerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoices: %w", err)
+	}
+	payments, err := uc.paymentRepo.ListByBuyerID(ctx, buyerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+	return model.NewBuyerBalance(buyerID, invoices, payments), nil
+}
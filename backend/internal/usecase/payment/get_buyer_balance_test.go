@@ -0,0 +1,65 @@
+package payment_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestGetBuyerBalanceUseCase_Execute(t *testing.T) {
+	issued := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name            string
+		invoiceErr      error
+		paymentErr      error
+		wantOutstanding int
+		wantCredit      int
+		wantErr         error
+	}{
+		{name: "Success", wantOutstanding: 1500, wantCredit: 200},
+		{name: "InvoiceError", invoiceErr: dbErr, wantErr: dbErr},
+		{name: "PaymentError", paymentErr: dbErr, wantErr: dbErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoiceRepo := &mock.MockInvoiceRepository{
+				ListByBuyerIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+					return []model.Invoice{
+						{ID: 1, Status: model.InvoiceStatusIssued, TotalAmount: 2000, PaidAmount: 500, IssuedAt: &issued},
+					}, tt.invoiceErr
+				},
+			}
+			paymentRepo := &mock.MockPaymentRepository{
+				ListByBuyerIDFunc: func(_ context.Context, _ int) ([]model.Payment, error) {
+					return []model.Payment{{ID: 1, Amount: 700, AllocatedAmount: 500}}, tt.paymentErr
+				},
+			}
+
+			got, err := payment.NewGetBuyerBalanceUseCase(invoiceRepo, paymentRepo).Execute(context.Background(), 1)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.OutstandingAmount != tt.wantOutstanding {
+				t.Errorf("expected outstanding %d, got %d", tt.wantOutstanding, got.OutstandingAmount)
+			}
+			if got.CreditAmount != tt.wantCredit {
+				t.Errorf("expected credit %d, got %d", tt.wantCredit, got.CreditAmount)
+			}
+		})
+	}
+}
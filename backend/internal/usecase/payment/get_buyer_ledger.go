@@ -0,0 +1,60 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// GetBuyerLedgerUseCase defines the interface for retrieving a buyer's running ledger.
+type GetBuyerLedgerUseCase interface {
+	// Execute returns the buyer's invoices, credit notes and payments as a chronological ledger.
+	Execute(ctx context.Context, buyerID int) ([]model.LedgerEntry, error)
+}
+
+type getBuyerLedgerUseCase struct {
+	buyerRepo      repository.BuyerRepository
+	invoiceRepo    repository.InvoiceRepository
+	creditNoteRepo repository.CreditNoteRepository
+	paymentRepo    repository.PaymentRepository
+}
+
+var _ GetBuyerLedgerUseCase = (*getBuyerLedgerUseCase)(nil)
+
+// NewGetBuyerLedgerUseCase creates a new GetBuyerLedgerUseCase instance.
+func NewGetBuyerLedgerUseCase(
+	buyerRepo repository.BuyerRepository,
+	invoiceRepo repository.InvoiceRepository,
+	creditNoteRepo repository.CreditNoteRepository,
+	paymentRepo repository.PaymentRepository,
+) GetBuyerLedgerUseCase {
+	return &getBuyerLedgerUseCase{
+		buyerRepo:      buyerRepo,
+		invoiceRepo:    invoiceRepo,
+		creditNoteRepo: creditNoteRepo,
+		paymentRepo:    paymentRepo,
+	}
+}
+
+// Execute returns the buyer's ledger with a running balance.
+func (uc *getBuyerLedgerUseCase) Execute(ctx context.Context, buyerID int) ([]model.LedgerEntry, error) {
+	if _, err := uc.buyerRepo.FindByID(ctx, buyerID); err != nil {
+		return nil, err
+	}
+
+	invoices, err := uc.invoiceRepo.ListByBuyerID(ctx, buyerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoices: %w", err)
+	}
+	creditNotes, err := uc.creditNoteRepo.ListByBuyerID(ctx, buyerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credit notes: %w", err)
+	}
+	payments, err := uc.paymentRepo.ListByBuyerID(ctx, buyerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+	return model.BuildLedger(invoices, creditNotes, payments), nil
+}
@@ -0,0 +1,62 @@
+package payment_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestGetBuyerLedgerUseCase_Execute(t *testing.T) {
+	issued := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	received := issued.AddDate(0, 0, 3)
+
+	t.Run("Success", func(t *testing.T) {
+		buyerRepo := &mock.MockBuyerRepository{
+			FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+				return &model.Buyer{ID: id}, nil
+			},
+		}
+		invoiceRepo := &mock.MockInvoiceRepository{
+			ListByBuyerIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+				return []model.Invoice{{ID: 1, Status: model.InvoiceStatusIssued, TotalAmount: 2000, IssuedAt: &issued}}, nil
+			},
+		}
+		paymentRepo := &mock.MockPaymentRepository{
+			ListByBuyerIDFunc: func(_ context.Context, _ int) ([]model.Payment, error) {
+				return []model.Payment{{ID: 1, Method: model.PaymentMethodCash, Amount: 500, ReceivedAt: received}}, nil
+			},
+		}
+
+		got, err := payment.NewGetBuyerLedgerUseCase(buyerRepo, invoiceRepo, &mock.MockCreditNoteRepository{}, paymentRepo).Execute(context.Background(), 1)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("expected 2 entries, got %d", len(got))
+		}
+		if got[1].Balance != 1500 {
+			t.Errorf("expected running balance 1500, got %d", got[1].Balance)
+		}
+	})
+
+	t.Run("BuyerNotFound", func(t *testing.T) {
+		buyerRepo := &mock.MockBuyerRepository{
+			FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+				return nil, &domainErrors.NotFoundError{Resource: "Buyer", ID: id}
+			},
+		}
+
+		_, err := payment.NewGetBuyerLedgerUseCase(buyerRepo, &mock.MockInvoiceRepository{}, &mock.MockCreditNoteRepository{}, &mock.MockPaymentRepository{}).Execute(context.Background(), 1)
+
+		var nfErr *domainErrors.NotFoundError
+		if !errors.As(err, &nfErr) {
+			t.Fatalf("expected NotFoundError, got %v", err)
+		}
+	})
+}
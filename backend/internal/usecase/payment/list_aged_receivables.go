@@ -0,0 +1,40 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// ListAgedReceivablesUseCase defines the interface for the aged-receivables report.
+type ListAgedReceivablesUseCase interface {
+	// Execute returns each buyer's outstanding balance bucketed by invoice age.
+	Execute(ctx context.Context) ([]model.AgedReceivable, error)
+}
+
+type listAgedReceivablesUseCase struct {
+	invoiceRepo repository.InvoiceRepository
+	clock       service.Clock
+}
+
+var _ ListAgedReceivablesUseCase = (*listAgedReceivablesUseCase)(nil)
+
+// NewListAgedReceivablesUseCase creates a new ListAgedReceivablesUseCase instance.
+func NewListAgedReceivablesUseCase(invoiceRepo repository.InvoiceRepository, clock service.Clock) ListAgedReceivablesUseCase {
+	return &listAgedReceivablesUseCase{
+		invoiceRepo: invoiceRepo,
+		clock:       clock,
+	}
+}
+
+// Execute returns the aged-receivables report as of now.
+func (uc *listAgedReceivablesUseCase) Execute(ctx context.Context) ([]model.AgedReceivable, error) {
+	invoices, err := uc.invoiceRepo.ListOpen(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list open invoices: %w", err)
+	}
+	return model.BuildAgedReceivables(invoices, uc.clock.Now()), nil
+}
@@ -0,0 +1,54 @@
+package payment_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestListAgedReceivablesUseCase_Execute(t *testing.T) {
+	now := time.Date(2024, 4, 30, 12, 0, 0, 0, time.UTC)
+	old := now.AddDate(0, 0, -100)
+	recent := now.AddDate(0, 0, -5)
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &mock.MockInvoiceRepository{
+			ListOpenFunc: func(_ context.Context) ([]model.Invoice, error) {
+				return []model.Invoice{
+					{BuyerID: 1, BuyerName: "A", Status: model.InvoiceStatusIssued, TotalAmount: 100, IssuedAt: &recent},
+					{BuyerID: 1, BuyerName: "A", Status: model.InvoiceStatusIssued, TotalAmount: 300, IssuedAt: &old},
+				}, nil
+			},
+		}
+
+		got, err := payment.NewListAgedReceivablesUseCase(repo, mock.NewMockClock(now)).Execute(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(got) != 1 {
+			t.Fatalf("expected 1 row, got %d", len(got))
+		}
+		if got[0].Current != 100 || got[0].Over90 != 300 || got[0].Total != 400 {
+			t.Errorf("unexpected buckets: %+v", got[0])
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		dbErr := errors.New("db error")
+		repo := &mock.MockInvoiceRepository{
+			ListOpenFunc: func(_ context.Context) ([]model.Invoice, error) {
+				return nil, dbErr
+			},
+		}
+
+		_, err := payment.NewListAgedReceivablesUseCase(repo, mock.NewMockClock(now)).Execute(context.Background())
+		if !errors.Is(err, dbErr) {
+			t.Fatalf("expected error %v, got %v", dbErr, err)
+		}
+	})
+}
@@ -0,0 +1,129 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// RecordPaymentUseCase defines the interface for recording a payment received from a buyer.
+type RecordPaymentUseCase interface {
+	// Execute records the payment and applies it to the buyer's open invoices.
+	Execute(ctx context.Context, payment *model.Payment) (*model.Payment, error)
+}
+
+type recordPaymentUseCase struct {
+	buyerRepo   repository.BuyerRepository
+	invoiceRepo repository.InvoiceRepository
+	paymentRepo repository.PaymentRepository
+	txMgr       repository.TransactionManager
+	clock       service.Clock
+}
+
+var _ RecordPaymentUseCase = (*recordPaymentUseCase)(nil)
+
+// NewRecordPaymentUseCase creates a new RecordPaymentUseCase instance.
+func NewRecordPaymentUseCase(
+	buyerRepo repository.BuyerRepository,
+	invoiceRepo repository.InvoiceRepository,
+	paymentRepo repository.PaymentRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) RecordPaymentUseCase {
+	return &recordPaymentUseCase{
+		buyerRepo:   buyerRepo,
+		invoiceRepo: invoiceRepo,
+		paymentRepo: paymentRepo,
+		txMgr:       txMgr,
+		clock:       clock,
+	}
+}
+
+// Execute records a payment.
+// 指定された請求書があればそこへ優先的に充当し、残りは発行日の古い未収請求書から順に消し込む。
+// それでも余った金額は前受金（クレジット）として次回発行分に充当される。
+func (uc *recordPaymentUseCase) Execute(ctx context.Context, payment *model.Payment) (*model.Payment, error) {
+	if payment.Amount <= 0 {
+		return nil, &apperrors.ValidationError{Field: "amount", Message: "amount must be positive"}
+	}
+	if !payment.Method.IsValid() {
+		return nil, &apperrors.ValidationError{Field: "method", Message: "method must be cash or bank_transfer"}
+	}
+	if payment.ReceivedAt.IsZero() {
+		payment.ReceivedAt = uc.clock.Now()
+	}
+
+	if _, err := uc.buyerRepo.FindByID(ctx, payment.BuyerID); err != nil {
+		return nil, err
+	}
+
+	var recorded *model.Payment
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		targets, err := uc.allocationTargets(txCtx, payment)
+		if err != nil {
+			return err
+		}
+
+		created, err := uc.paymentRepo.Create(txCtx, payment)
+		if err != nil {
+			return fmt.Errorf("failed to create payment: %w", err)
+		}
+
+		touched := make(map[int]bool)
+		for _, a := range created.Allocate(targets, created.ReceivedAt) {
+			if err := uc.paymentRepo.CreateAllocation(txCtx, &a); err != nil {
+				return fmt.Errorf("failed to allocate payment: %w", err)
+			}
+			touched[a.InvoiceID] = true
+		}
+		for _, inv := range targets {
+			if !touched[inv.ID] {
+				continue
+			}
+			if err := uc.invoiceRepo.Update(txCtx, inv); err != nil {
+				return fmt.Errorf("failed to update invoice: %w", err)
+			}
+		}
+
+		recorded = created
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recorded, nil
+}
+
+// allocationTargets returns the invoices the payment should be applied to, in order.
+func (uc *recordPaymentUseCase) allocationTargets(ctx context.Context, payment *model.Payment) ([]*model.Invoice, error) {
+	var targets []*model.Invoice
+	if payment.InvoiceID != nil {
+		inv, err := uc.invoiceRepo.FindByIDWithLock(ctx, *payment.InvoiceID)
+		if err != nil {
+			return nil, err
+		}
+		if inv.BuyerID != payment.BuyerID {
+			return nil, &apperrors.ValidationError{Field: "invoice_id", Message: "invoice does not belong to the buyer"}
+		}
+		if inv.Status != model.InvoiceStatusIssued {
+			return nil, &apperrors.ConflictError{Message: "invoice is not open for payment"}
+		}
+		targets = append(targets, inv)
+	}
+
+	open, err := uc.invoiceRepo.ListOpenByBuyerIDWithLock(ctx, payment.BuyerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list open invoices: %w", err)
+	}
+	for i := range open {
+		if payment.InvoiceID != nil && open[i].ID == *payment.InvoiceID {
+			continue
+		}
+		targets = append(targets, &open[i])
+	}
+	return targets, nil
+}
@@ -0,0 +1,159 @@
+package payment_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestRecordPaymentUseCase_Execute(t *testing.T) {
+	now := time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+	issued := now.AddDate(0, 0, -10)
+
+	openInvoices := func() []model.Invoice {
+		return []model.Invoice{
+			{ID: 1, BuyerID: 1, Status: model.InvoiceStatusIssued, TotalAmount: 1000, IssuedAt: &issued},
+			{ID: 2, BuyerID: 1, Status: model.InvoiceStatusIssued, TotalAmount: 2000, IssuedAt: &issued},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		input          *model.Payment
+		target         *model.Invoice
+		wantErr        any
+		wantAllocated  int
+		wantPaidStatus map[int]model.InvoiceStatus
+	}{
+		{
+			name:           "PartialPaymentOldestFirst",
+			input:          &model.Payment{BuyerID: 1, Method: model.PaymentMethodCash, Amount: 1500},
+			wantAllocated:  1500,
+			wantPaidStatus: map[int]model.InvoiceStatus{1: model.InvoiceStatusPaid, 2: model.InvoiceStatusIssued},
+		},
+		{
+			name:           "OverpaymentBecomesCredit",
+			input:          &model.Payment{BuyerID: 1, Method: model.PaymentMethodBankTransfer, Amount: 5000},
+			wantAllocated:  3000,
+			wantPaidStatus: map[int]model.InvoiceStatus{1: model.InvoiceStatusPaid, 2: model.InvoiceStatusPaid},
+		},
+		{
+			name:           "TargetInvoiceFirst",
+			input:          &model.Payment{BuyerID: 1, InvoiceID: intPtr(2), Method: model.PaymentMethodCash, Amount: 2000},
+			target:         &model.Invoice{ID: 2, BuyerID: 1, Status: model.InvoiceStatusIssued, TotalAmount: 2000, IssuedAt: &issued},
+			wantAllocated:  2000,
+			wantPaidStatus: map[int]model.InvoiceStatus{2: model.InvoiceStatusPaid},
+		},
+		{
+			name:    "TargetInvoiceOfAnotherBuyer",
+			input:   &model.Payment{BuyerID: 1, InvoiceID: intPtr(9), Method: model.PaymentMethodCash, Amount: 2000},
+			target:  &model.Invoice{ID: 9, BuyerID: 2, Status: model.InvoiceStatusIssued, TotalAmount: 2000},
+			wantErr: &domainErrors.ValidationError{},
+		},
+		{
+			name:    "TargetInvoiceDraft",
+			input:   &model.Payment{BuyerID: 1, InvoiceID: intPtr(9), Method: model.PaymentMethodCash, Amount: 2000},
+			target:  &model.Invoice{ID: 9, BuyerID: 1, Status: model.InvoiceStatusDraft, TotalAmount: 2000},
+			wantErr: &domainErrors.ConflictError{},
+		},
+		{
+			name:    "InvalidAmount",
+			input:   &model.Payment{BuyerID: 1, Method: model.PaymentMethodCash, Amount: 0},
+			wantErr: &domainErrors.ValidationError{},
+		},
+		{
+			name:    "InvalidMethod",
+			input:   &model.Payment{BuyerID: 1, Method: "cheque", Amount: 100},
+			wantErr: &domainErrors.ValidationError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := make(map[int]model.InvoiceStatus)
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					return &model.Buyer{ID: id}, nil
+				},
+			}
+			invoiceRepo := &mock.MockInvoiceRepository{
+				FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.Invoice, error) {
+					return tt.target, nil
+				},
+				ListOpenByBuyerIDWithLockFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+					return openInvoices(), nil
+				},
+				UpdateFunc: func(_ context.Context, inv *model.Invoice) error {
+					updated[inv.ID] = inv.Status
+					return nil
+				},
+			}
+			paymentRepo := &mock.MockPaymentRepository{
+				CreateFunc: func(_ context.Context, p *model.Payment) (*model.Payment, error) {
+					created := *p
+					created.ID = 50
+					return &created, nil
+				},
+			}
+
+			uc := payment.NewRecordPaymentUseCase(buyerRepo, invoiceRepo, paymentRepo, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			got, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ValidationError:
+					var target *domainErrors.ValidationError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+				case *domainErrors.ConflictError:
+					var target *domainErrors.ConflictError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ConflictError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.AllocatedAmount != tt.wantAllocated {
+				t.Errorf("expected allocated %d, got %d", tt.wantAllocated, got.AllocatedAmount)
+			}
+			if !got.ReceivedAt.Equal(now) {
+				t.Errorf("expected received_at to default to now, got %v", got.ReceivedAt)
+			}
+			for id, status := range tt.wantPaidStatus {
+				if updated[id] != status {
+					t.Errorf("invoice %d: expected status %s, got %s", id, status, updated[id])
+				}
+			}
+		})
+	}
+}
+
+func TestRecordPaymentUseCase_Execute_BuyerNotFound(t *testing.T) {
+	buyerRepo := &mock.MockBuyerRepository{
+		FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+			return nil, &domainErrors.NotFoundError{Resource: "Buyer", ID: id}
+		},
+	}
+	uc := payment.NewRecordPaymentUseCase(buyerRepo, &mock.MockInvoiceRepository{}, &mock.MockPaymentRepository{}, &mock.MockTransactionManager{}, mock.NewMockClock(time.Now()))
+
+	_, err := uc.Execute(context.Background(), &model.Payment{BuyerID: 99, Method: model.PaymentMethodCash, Amount: 100})
+
+	var nfErr *domainErrors.NotFoundError
+	if !errors.As(err, &nfErr) {
+		t.Fatalf("expected NotFoundError, got %v", err)
+	}
+}
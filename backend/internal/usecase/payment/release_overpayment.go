@@ -0,0 +1,31 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ReleaseOverpayment gives the part of a credited invoice paid beyond its net amount back to the payments,
+// so the next invoice issued to the buyer can consume it. It must run in the transaction that credits the invoice.
+// 請求書の発行と直列化するため、買受人の入金を先にロックする。
+func ReleaseOverpayment(ctx context.Context, paymentRepo repository.PaymentRepository, invoice *model.Invoice) error {
+	if invoice.OverpaidAmount() == 0 {
+		return nil
+	}
+	if _, err := paymentRepo.ListByBuyerIDWithLock(ctx, invoice.BuyerID); err != nil {
+		return fmt.Errorf("failed to list payments: %w", err)
+	}
+	allocations, err := paymentRepo.ListAllocationsByInvoiceID(ctx, invoice.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list payment allocations: %w", err)
+	}
+	for _, a := range invoice.ReleaseOverpayment(allocations) {
+		if err := paymentRepo.CreateAllocation(ctx, &a); err != nil {
+			return fmt.Errorf("failed to release overpayment: %w", err)
+		}
+	}
+	return nil
+}
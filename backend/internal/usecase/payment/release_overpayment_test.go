@@ -0,0 +1,72 @@
+package payment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestReleaseOverpayment(t *testing.T) {
+	tests := []struct {
+		name         string
+		invoice      model.Invoice
+		wantReleased []model.PaymentAllocation
+	}{
+		{
+			// 入金 5000 + 3000 で全額消込済みの請求書に 4000 の赤伝 → 後の入金から順に戻す
+			name:    "Overpaid",
+			invoice: model.Invoice{ID: 10, BuyerID: 7, TotalAmount: 8000, CreditedAmount: 4000, PaidAmount: 8000, Status: model.InvoiceStatusPaid},
+			wantReleased: []model.PaymentAllocation{
+				{PaymentID: 2, InvoiceID: 10, Amount: -3000},
+				{PaymentID: 1, InvoiceID: 10, Amount: -1000},
+			},
+		},
+		{
+			name:    "NotOverpaid",
+			invoice: model.Invoice{ID: 10, BuyerID: 7, TotalAmount: 8000, CreditedAmount: 4000, PaidAmount: 4000, Status: model.InvoiceStatusPaid},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locked := false
+			var released []model.PaymentAllocation
+			repo := &mock.MockPaymentRepository{
+				ListByBuyerIDWithLockFunc: func(_ context.Context, buyerID int) ([]model.Payment, error) {
+					locked = buyerID == 7
+					return nil, nil
+				},
+				ListAllocationsByInvoiceIDFunc: func(_ context.Context, _ int) ([]model.PaymentAllocation, error) {
+					return []model.PaymentAllocation{
+						{PaymentID: 1, InvoiceID: 10, Amount: 5000},
+						{PaymentID: 2, InvoiceID: 10, Amount: 3000},
+					}, nil
+				},
+				CreateAllocationFunc: func(_ context.Context, a *model.PaymentAllocation) error {
+					released = append(released, *a)
+					return nil
+				},
+			}
+
+			invoice := tt.invoice
+			if err := payment.ReleaseOverpayment(context.Background(), repo, &invoice); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(released) != len(tt.wantReleased) {
+				t.Fatalf("expected %d released allocations, got %+v", len(tt.wantReleased), released)
+			}
+			for i, want := range tt.wantReleased {
+				if released[i] != want {
+					t.Errorf("allocation %d: expected %+v, got %+v", i, want, released[i])
+				}
+			}
+			if tt.wantReleased != nil && (!locked || invoice.PaidAmount != invoice.NetAmount()) {
+				t.Errorf("expected the payments to be locked and the invoice paid exactly, got locked=%v paid=%d", locked, invoice.PaidAmount)
+			}
+		})
+	}
+}
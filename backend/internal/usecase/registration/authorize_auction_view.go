@@ -0,0 +1,65 @@
+package registration
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// AuthorizeAuctionViewUseCase defines the interface for checking whether a buyer may view an auction's lots.
+type AuthorizeAuctionViewUseCase interface {
+	// Execute returns a forbidden error when the buyer may not view the auction.
+	Execute(ctx context.Context, buyerID, auctionID int) error
+}
+
+type authorizeAuctionViewUseCase struct {
+	auctionRepo repository.AuctionRepository
+	regRepo     repository.VenueRegistrationRepository
+	clock       service.Clock
+	required    bool
+}
+
+var _ AuthorizeAuctionViewUseCase = (*authorizeAuctionViewUseCase)(nil)
+
+// NewAuthorizeAuctionViewUseCase creates a new AuthorizeAuctionViewUseCase instance.
+// required は閲覧にも会場の登録を求めるかどうか。入札には常に登録が必要で、閲覧の制限は市場の運用に合わせて選べる。
+func NewAuthorizeAuctionViewUseCase(
+	auctionRepo repository.AuctionRepository,
+	regRepo repository.VenueRegistrationRepository,
+	clock service.Clock,
+	required bool,
+) AuthorizeAuctionViewUseCase {
+	return &authorizeAuctionViewUseCase{auctionRepo: auctionRepo, regRepo: regRepo, clock: clock, required: required}
+}
+
+// Execute checks the buyer's registration at the auction's venue.
+// buyerID 0 is an anonymous viewer, who may view only while registration is not required.
+func (uc *authorizeAuctionViewUseCase) Execute(ctx context.Context, buyerID, auctionID int) error {
+	if !uc.required {
+		return nil
+	}
+	if buyerID == 0 {
+		return &apperrors.UnauthorizedError{Message: "sign in to view the lots of this auction"}
+	}
+
+	auction, err := uc.auctionRepo.FindByID(ctx, auctionID)
+	if err != nil {
+		return err
+	}
+	if auction == nil {
+		return &apperrors.NotFoundError{Resource: "Auction", ID: auctionID}
+	}
+	reg, err := uc.regRepo.FindByBuyerAndVenue(ctx, buyerID, auction.VenueID)
+	if err != nil {
+		var notFoundErr *apperrors.NotFoundError
+		if !errors.As(err, &notFoundErr) {
+			return err
+		}
+		reg = nil
+	}
+	return model.CheckVenueAccess(reg, uc.clock.Now())
+}
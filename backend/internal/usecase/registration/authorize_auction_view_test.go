@@ -0,0 +1,86 @@
+package registration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestAuthorizeAuctionViewUseCase_Execute(t *testing.T) {
+	now := time.Date(2026, 5, 1, 3, 0, 0, 0, time.UTC)
+	expired := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		required bool
+		reg      *model.VenueRegistration
+		regErr   error
+		wantErr  bool
+	}{
+		{name: "NotRequired", regErr: &apperrors.NotFoundError{Resource: "VenueRegistration"}},
+		{name: "Approved", required: true, reg: &model.VenueRegistration{Status: model.VenueRegistrationStatusApproved}},
+		{name: "NotRegistered", required: true, regErr: &apperrors.NotFoundError{Resource: "VenueRegistration"}, wantErr: true},
+		{name: "Pending", required: true, reg: &model.VenueRegistration{Status: model.VenueRegistrationStatusPending}, wantErr: true},
+		{
+			name:     "Expired",
+			required: true,
+			reg:      &model.VenueRegistration{Status: model.VenueRegistrationStatusApproved, ValidUntil: &expired},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, VenueID: 2}, nil
+				},
+			}
+			regRepo := &mock.MockVenueRegistrationRepository{
+				FindByBuyerAndVenueFunc: func(_ context.Context, buyerID, venueID int) (*model.VenueRegistration, error) {
+					if buyerID != 1 || venueID != 2 {
+						t.Errorf("unexpected arguments: %d %d", buyerID, venueID)
+					}
+					return tt.reg, tt.regErr
+				},
+			}
+
+			uc := registration.NewAuthorizeAuctionViewUseCase(auctionRepo, regRepo, mock.NewMockClock(now), tt.required)
+			err := uc.Execute(context.Background(), 1, 7)
+
+			if tt.wantErr {
+				var target *apperrors.ForbiddenError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected ForbiddenError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthorizeAuctionViewUseCase_Execute_Anonymous(t *testing.T) {
+	now := time.Date(2026, 5, 1, 3, 0, 0, 0, time.UTC)
+	auctionRepo := &mock.MockAuctionRepository{}
+	regRepo := &mock.MockVenueRegistrationRepository{}
+
+	open := registration.NewAuthorizeAuctionViewUseCase(auctionRepo, regRepo, mock.NewMockClock(now), false)
+	if err := open.Execute(context.Background(), 0, 7); err != nil {
+		t.Fatalf("expected anonymous viewers to be allowed, got %v", err)
+	}
+
+	restricted := registration.NewAuthorizeAuctionViewUseCase(auctionRepo, regRepo, mock.NewMockClock(now), true)
+	var target *apperrors.UnauthorizedError
+	if err := restricted.Execute(context.Background(), 0, 7); !errors.As(err, &target) {
+		t.Fatalf("expected UnauthorizedError, got %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package registration
+
+import (
+	"context"
+	"errors"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// CreateRegistrationUseCase defines the interface for registering a buyer at a venue.
+type CreateRegistrationUseCase interface {
+	// Execute registers the buyer. A registration without a status starts pending.
+	Execute(ctx context.Context, reg *model.VenueRegistration) (*model.VenueRegistration, error)
+}
+
+type createRegistrationUseCase struct {
+	regRepo   repository.VenueRegistrationRepository
+	buyerRepo repository.BuyerRepository
+	venueRepo repository.VenueRepository
+}
+
+var _ CreateRegistrationUseCase = (*createRegistrationUseCase)(nil)
+
+// NewCreateRegistrationUseCase creates a new CreateRegistrationUseCase instance.
+func NewCreateRegistrationUseCase(
+	regRepo repository.VenueRegistrationRepository,
+	buyerRepo repository.BuyerRepository,
+	venueRepo repository.VenueRepository,
+) CreateRegistrationUseCase {
+	return &createRegistrationUseCase{regRepo: regRepo, buyerRepo: buyerRepo, venueRepo: venueRepo}
+}
+
+// Execute creates a registration.
+func (uc *createRegistrationUseCase) Execute(ctx context.Context, reg *model.VenueRegistration) (*model.VenueRegistration, error) {
+	if reg.Status == "" {
+		reg.Status = model.VenueRegistrationStatusPending
+	}
+	if err := reg.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := uc.buyerRepo.FindByID(ctx, reg.BuyerID); err != nil {
+		return nil, err
+	}
+	if _, err := uc.venueRepo.FindByID(ctx, reg.VenueID); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.regRepo.Create(ctx, reg)
+	if err != nil {
+		var conflictErr *apperrors.ConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, &apperrors.ConflictError{Message: "buyer is already registered at this venue"}
+		}
+		return nil, err
+	}
+	return created, nil
+}
@@ -0,0 +1,109 @@
+package registration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestCreateRegistrationUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		reg        *model.VenueRegistration
+		buyerErr   error
+		createErr  error
+		wantErr    error
+		wantStatus model.VenueRegistrationStatus
+	}{
+		{
+			name:       "DefaultsToPending",
+			reg:        &model.VenueRegistration{BuyerID: 1, VenueID: 2},
+			wantStatus: model.VenueRegistrationStatusPending,
+		},
+		{
+			name:       "Approved",
+			reg:        &model.VenueRegistration{BuyerID: 1, VenueID: 2, Status: model.VenueRegistrationStatusApproved},
+			wantStatus: model.VenueRegistrationStatusApproved,
+		},
+		{
+			name:    "InvalidStatus",
+			reg:     &model.VenueRegistration{BuyerID: 1, VenueID: 2, Status: "revoked"},
+			wantErr: &apperrors.ValidationError{},
+		},
+		{
+			name:     "UnknownBuyer",
+			reg:      &model.VenueRegistration{BuyerID: 9, VenueID: 2},
+			buyerErr: &apperrors.NotFoundError{Resource: "Buyer", ID: 9},
+			wantErr:  &apperrors.NotFoundError{},
+		},
+		{
+			name:      "AlreadyRegistered",
+			reg:       &model.VenueRegistration{BuyerID: 1, VenueID: 2},
+			createErr: &apperrors.ConflictError{Message: "VenueRegistration already exists"},
+			wantErr:   &apperrors.ConflictError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regRepo := &mock.MockVenueRegistrationRepository{
+				CreateFunc: func(_ context.Context, reg *model.VenueRegistration) (*model.VenueRegistration, error) {
+					if tt.createErr != nil {
+						return nil, tt.createErr
+					}
+					created := *reg
+					created.ID = 5
+					return &created, nil
+				},
+			}
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					if tt.buyerErr != nil {
+						return nil, tt.buyerErr
+					}
+					return &model.Buyer{ID: id}, nil
+				},
+			}
+			venueRepo := &mock.MockVenueRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Venue, error) {
+					return &model.Venue{ID: id}, nil
+				},
+			}
+
+			uc := registration.NewCreateRegistrationUseCase(regRepo, buyerRepo, venueRepo)
+			got, err := uc.Execute(context.Background(), tt.reg)
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *apperrors.ValidationError:
+					var target *apperrors.ValidationError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+				case *apperrors.NotFoundError:
+					var target *apperrors.NotFoundError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected NotFoundError, got %v", err)
+					}
+				case *apperrors.ConflictError:
+					var target *apperrors.ConflictError
+					if !errors.As(err, &target) || target.Message != "buyer is already registered at this venue" {
+						t.Fatalf("expected ConflictError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.ID != 5 || got.Status != tt.wantStatus {
+				t.Errorf("unexpected registration: %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package registration
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListRegistrationsUseCase defines the interface for listing buyer–venue registrations.
+type ListRegistrationsUseCase interface {
+	Execute(ctx context.Context, filters *repository.VenueRegistrationFilters) ([]model.VenueRegistration, error)
+}
+
+type listRegistrationsUseCase struct {
+	regRepo repository.VenueRegistrationRepository
+}
+
+var _ ListRegistrationsUseCase = (*listRegistrationsUseCase)(nil)
+
+// NewListRegistrationsUseCase creates a new ListRegistrationsUseCase instance.
+func NewListRegistrationsUseCase(regRepo repository.VenueRegistrationRepository) ListRegistrationsUseCase {
+	return &listRegistrationsUseCase{regRepo: regRepo}
+}
+
+// Execute lists registrations.
+func (uc *listRegistrationsUseCase) Execute(ctx context.Context, filters *repository.VenueRegistrationFilters) ([]model.VenueRegistration, error) {
+	return uc.regRepo.List(ctx, filters)
+}
@@ -0,0 +1,51 @@
+package registration
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// UpdateRegistrationInput holds the new status and validity period of a registration.
+type UpdateRegistrationInput struct {
+	Status     model.VenueRegistrationStatus
+	ValidFrom  *time.Time
+	ValidUntil *time.Time
+}
+
+// UpdateRegistrationUseCase defines the interface for approving, suspending or renewing a registration.
+type UpdateRegistrationUseCase interface {
+	Execute(ctx context.Context, id int, input UpdateRegistrationInput) (*model.VenueRegistration, error)
+}
+
+type updateRegistrationUseCase struct {
+	regRepo repository.VenueRegistrationRepository
+}
+
+var _ UpdateRegistrationUseCase = (*updateRegistrationUseCase)(nil)
+
+// NewUpdateRegistrationUseCase creates a new UpdateRegistrationUseCase instance.
+func NewUpdateRegistrationUseCase(regRepo repository.VenueRegistrationRepository) UpdateRegistrationUseCase {
+	return &updateRegistrationUseCase{regRepo: regRepo}
+}
+
+// Execute replaces the status and validity period of the registration.
+func (uc *updateRegistrationUseCase) Execute(ctx context.Context, id int, input UpdateRegistrationInput) (*model.VenueRegistration, error) {
+	reg, err := uc.regRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reg.Status = input.Status
+	reg.ValidFrom = input.ValidFrom
+	reg.ValidUntil = input.ValidUntil
+	if err := reg.Validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.regRepo.Update(ctx, reg); err != nil {
+		return nil, err
+	}
+	return uc.regRepo.FindByID(ctx, id)
+}
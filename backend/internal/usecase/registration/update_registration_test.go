@@ -0,0 +1,79 @@
+package registration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/registration"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestUpdateRegistrationUseCase_Execute(t *testing.T) {
+	from := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2027, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		input      registration.UpdateRegistrationInput
+		wantErr    bool
+		wantUpdate bool
+	}{
+		{
+			name:       "Renew",
+			input:      registration.UpdateRegistrationInput{Status: model.VenueRegistrationStatusApproved, ValidFrom: &from, ValidUntil: &until},
+			wantUpdate: true,
+		},
+		{
+			name:       "Suspend",
+			input:      registration.UpdateRegistrationInput{Status: model.VenueRegistrationStatusSuspended},
+			wantUpdate: true,
+		},
+		{
+			name:    "UntilBeforeFrom",
+			input:   registration.UpdateRegistrationInput{Status: model.VenueRegistrationStatusApproved, ValidFrom: &until, ValidUntil: &from},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := &model.VenueRegistration{ID: 5, BuyerID: 1, VenueID: 2, Status: model.VenueRegistrationStatusPending}
+			updated := false
+			regRepo := &mock.MockVenueRegistrationRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.VenueRegistration, error) {
+					reg := *stored
+					return &reg, nil
+				},
+				UpdateFunc: func(_ context.Context, reg *model.VenueRegistration) error {
+					updated = true
+					stored = reg
+					return nil
+				},
+			}
+
+			uc := registration.NewUpdateRegistrationUseCase(regRepo)
+			got, err := uc.Execute(context.Background(), 5, tt.input)
+
+			if tt.wantErr {
+				var target *apperrors.ValidationError
+				if !errors.As(err, &target) {
+					t.Fatalf("expected ValidationError, got %v", err)
+				}
+				if updated {
+					t.Error("expected an invalid registration not to be saved")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if updated != tt.wantUpdate || got.Status != tt.input.Status || got.ValidUntil != tt.input.ValidUntil {
+				t.Errorf("unexpected registration: %+v", got)
+			}
+		})
+	}
+}
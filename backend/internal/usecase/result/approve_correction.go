@@ -0,0 +1,206 @@
+package result
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+	"github.com/seka/fish-auction/backend/internal/usecase/payment"
+)
+
+// ApproveCorrectionUseCase defines the interface for approving a result correction.
+type ApproveCorrectionUseCase interface {
+	// Execute applies the correction to the award and brings the affected invoices in line with it.
+	Execute(ctx context.Context, id, adminID int, note string) (*model.ResultCorrection, error)
+}
+
+type approveCorrectionUseCase struct {
+	correctionRepo repository.ResultCorrectionRepository
+	auctionRepo    repository.AuctionRepository
+	bidRepo        repository.BidRepository
+	invoiceRepo    repository.InvoiceRepository
+	creditNoteRepo repository.CreditNoteRepository
+	paymentRepo    repository.PaymentRepository
+	chargeRepo     repository.BuyerChargeRepository
+	txMgr          repository.TransactionManager
+	clock          service.Clock
+	itemCacheInv   repository.CacheInvalidator
+}
+
+var _ ApproveCorrectionUseCase = (*approveCorrectionUseCase)(nil)
+
+// NewApproveCorrectionUseCase creates a new ApproveCorrectionUseCase instance.
+func NewApproveCorrectionUseCase(
+	correctionRepo repository.ResultCorrectionRepository,
+	auctionRepo repository.AuctionRepository,
+	bidRepo repository.BidRepository,
+	invoiceRepo repository.InvoiceRepository,
+	creditNoteRepo repository.CreditNoteRepository,
+	paymentRepo repository.PaymentRepository,
+	chargeRepo repository.BuyerChargeRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+	itemCacheInv repository.CacheInvalidator,
+) ApproveCorrectionUseCase {
+	return &approveCorrectionUseCase{
+		correctionRepo: correctionRepo,
+		auctionRepo:    auctionRepo,
+		bidRepo:        bidRepo,
+		invoiceRepo:    invoiceRepo,
+		creditNoteRepo: creditNoteRepo,
+		paymentRepo:    paymentRepo,
+		chargeRepo:     chargeRepo,
+		txMgr:          txMgr,
+		clock:          clock,
+		itemCacheInv:   itemCacheInv,
+	}
+}
+
+// Execute approves a correction.
+// 下書きの請求書は訂正後の落札から作り直し、発行済みの請求書は書き換えずに元の買受人へ赤伝を発行する。
+// 発行済みの請求書への増額は赤伝で表せないため、訂正後の買受人の請求書が発行済みなら承認できない。
+func (uc *approveCorrectionUseCase) Execute(ctx context.Context, id, adminID int, note string) (*model.ResultCorrection, error) {
+	var approved *model.ResultCorrection
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		correction, err := uc.correctionRepo.FindByIDWithLock(txCtx, id)
+		if err != nil {
+			return err
+		}
+		now := uc.clock.Now()
+		if err := correction.Approve(adminID, note, now); err != nil {
+			return err
+		}
+
+		// 入札・値札入力と直列化するため、せりの行ロックを取る。
+		if _, err := uc.auctionRepo.FindByIDWithLock(txCtx, correction.AuctionID); err != nil {
+			return err
+		}
+
+		award, err := findAward(txCtx, uc.bidRepo, correction.AuctionID, correction.ItemID)
+		if err != nil {
+			return err
+		}
+		if award == nil || award.ID != correction.PurchaseID ||
+			award.BuyerID != correction.OldBuyerID || award.Price != correction.OldPrice {
+			return &apperrors.ConflictError{Message: "the result has changed since the correction was requested"}
+		}
+
+		invoices, err := uc.invoiceRepo.ListByAuctionID(txCtx, correction.AuctionID)
+		if err != nil {
+			return fmt.Errorf("failed to list invoices: %w", err)
+		}
+		var oldInvoice *model.Invoice
+		finalized := make(map[int]bool)
+		for i := range invoices {
+			if invoices[i].Status == model.InvoiceStatusDraft {
+				continue
+			}
+			finalized[invoices[i].BuyerID] = true
+			if invoices[i].BuyerID == correction.OldBuyerID {
+				oldInvoice = &invoices[i]
+			}
+		}
+		if finalized[correction.NewBuyerID] && (correction.BuyerChanged() || correction.NewPrice > correction.OldPrice) {
+			return &apperrors.ConflictError{Message: "the corrected buyer's invoice has already been issued and cannot be increased"}
+		}
+
+		if err := uc.bidRepo.UpdateAward(txCtx, correction.PurchaseID, correction.NewBuyerID, correction.NewPrice); err != nil {
+			return fmt.Errorf("failed to update award: %w", err)
+		}
+		// 減額で他の入札を下回ると落札者が入れ替わってしまうため、訂正後も同じ入札が落札であることを確かめる。
+		corrected, err := findAward(txCtx, uc.bidRepo, correction.AuctionID, correction.ItemID)
+		if err != nil {
+			return err
+		}
+		if corrected == nil || corrected.ID != correction.PurchaseID {
+			return &apperrors.ConflictError{Message: "the corrected price must stay above the other bids on the lot"}
+		}
+
+		if oldInvoice != nil && correction.CreditAmount() > 0 {
+			cn, err := uc.issueCreditNote(txCtx, correction, oldInvoice.ID, now)
+			if err != nil {
+				return err
+			}
+			correction.CreditNote = cn
+		}
+
+		if len(invoices) > 0 {
+			if err := uc.regenerateDrafts(txCtx, correction.AuctionID, finalized); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.correctionRepo.Update(txCtx, correction); err != nil {
+			return fmt.Errorf("failed to update result correction: %w", err)
+		}
+		approved = correction
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.itemCacheInv.InvalidateCache(ctx, approved.ItemID); err != nil {
+		fmt.Printf("failed to invalidate item cache: %v\n", err)
+	}
+	return approved, nil
+}
+
+// issueCreditNote credits the original buyer's issued invoice with the same tax rate as the corrected line.
+// 入金済みの請求書で過入金になった分は入金へ戻し、買受人の前受金として次の請求書に充当する。
+func (uc *approveCorrectionUseCase) issueCreditNote(ctx context.Context, correction *model.ResultCorrection, invoiceID int, now time.Time) (*model.CreditNote, error) {
+	invoice, err := uc.invoiceRepo.FindByIDWithLock(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	taxRate, ok := invoice.LineTaxRate(correction.ItemID)
+	if !ok {
+		return nil, &apperrors.ConflictError{Message: "the corrected lot is not on the buyer's invoice"}
+	}
+
+	note := model.NewCorrectionCreditNote(correction, invoice.ID, taxRate)
+	if note.TotalAmount > invoice.NetAmount() {
+		return nil, &apperrors.ConflictError{Message: "credit exceeds the remaining invoice amount"}
+	}
+	note, err = uc.creditNoteRepo.Create(ctx, note)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create credit note: %w", err)
+	}
+	invoice.ApplyCredit(note.TotalAmount, now)
+	if err := payment.ReleaseOverpayment(ctx, uc.paymentRepo, invoice); err != nil {
+		return nil, err
+	}
+	if err := uc.invoiceRepo.Update(ctx, invoice); err != nil {
+		return nil, fmt.Errorf("failed to update invoice: %w", err)
+	}
+	return note, nil
+}
+
+// regenerateDrafts rebuilds the auction's draft invoices from the corrected awards.
+// 請求書の生成と同じく、発行済みの請求書がある買受人には下書きを作らない。
+func (uc *approveCorrectionUseCase) regenerateDrafts(ctx context.Context, auctionID int, finalized map[int]bool) error {
+	if err := uc.invoiceRepo.DeleteDraftsByAuctionID(ctx, auctionID); err != nil {
+		return fmt.Errorf("failed to delete draft invoices: %w", err)
+	}
+	awards, err := uc.bidRepo.ListAwardsByAuctionID(ctx, auctionID)
+	if err != nil {
+		return fmt.Errorf("failed to list awards: %w", err)
+	}
+	charges, err := uc.chargeRepo.ListByAuctionID(ctx, auctionID)
+	if err != nil {
+		return fmt.Errorf("failed to list charges: %w", err)
+	}
+	for _, inv := range model.BuildDraftInvoices(auctionID, awards, charges) {
+		if finalized[inv.BuyerID] {
+			continue
+		}
+		if _, err := uc.invoiceRepo.Create(ctx, inv); err != nil {
+			return fmt.Errorf("failed to create invoice: %w", err)
+		}
+	}
+	return nil
+}
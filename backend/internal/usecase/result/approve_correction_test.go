@@ -0,0 +1,243 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/result"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestApproveCorrectionUseCase_Execute(t *testing.T) {
+	now := time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+	itemID := 10
+
+	issuedInvoice := func(buyerID int) model.Invoice {
+		return model.Invoice{
+			ID: 70 + buyerID, BuyerID: buyerID, AuctionID: 4, Status: model.InvoiceStatusIssued, TotalAmount: 5400,
+			Lines: []model.InvoiceLine{{ItemID: &itemID, Amount: 5000, TaxRate: model.TaxRateReduced}},
+		}
+	}
+	draftInvoice := func(buyerID int) model.Invoice {
+		return model.Invoice{ID: 70 + buyerID, BuyerID: buyerID, AuctionID: 4, Status: model.InvoiceStatusDraft}
+	}
+
+	tests := []struct {
+		name         string
+		newBuyerID   int
+		newPrice     int
+		awardPrice   int
+		otherBid     int
+		invoices     []model.Invoice
+		adminID      int
+		wantErr      error
+		wantCredited int
+		wantDrafts   []int
+	}{
+		{
+			name:         "PriceReducedOnIssuedInvoice",
+			newBuyerID:   2,
+			newPrice:     4000,
+			invoices:     []model.Invoice{issuedInvoice(2), draftInvoice(5)},
+			wantCredited: 1080,
+		},
+		{
+			name:       "BuyerChangedBeforeIssue",
+			newBuyerID: 3,
+			newPrice:   5000,
+			invoices:   []model.Invoice{draftInvoice(2)},
+			wantDrafts: []int{3},
+		},
+		{
+			name:         "BuyerChangedAfterIssue",
+			newBuyerID:   3,
+			newPrice:     5000,
+			invoices:     []model.Invoice{issuedInvoice(2)},
+			wantCredited: 5400,
+			wantDrafts:   []int{3},
+		},
+		{
+			name:       "NotInvoicedYet",
+			newBuyerID: 3,
+			newPrice:   5000,
+		},
+		{
+			name:       "NewBuyerAlreadyIssued",
+			newBuyerID: 3,
+			newPrice:   5000,
+			invoices:   []model.Invoice{draftInvoice(2), issuedInvoice(3)},
+			wantErr:    &domainErrors.ConflictError{},
+		},
+		{
+			name:       "IncreaseOnIssuedInvoice",
+			newBuyerID: 2,
+			newPrice:   6000,
+			invoices:   []model.Invoice{issuedInvoice(2)},
+			wantErr:    &domainErrors.ConflictError{},
+		},
+		{
+			name:       "BelowOtherBid",
+			newBuyerID: 2,
+			newPrice:   2500,
+			otherBid:   3000,
+			wantErr:    &domainErrors.ConflictError{},
+		},
+		{
+			name:       "ResultChanged",
+			newBuyerID: 3,
+			newPrice:   5000,
+			awardPrice: 5500,
+			wantErr:    &domainErrors.ConflictError{},
+		},
+		{
+			name:       "SameAdmin",
+			newBuyerID: 3,
+			newPrice:   5000,
+			adminID:    7,
+			wantErr:    &domainErrors.ForbiddenError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			correction := &model.ResultCorrection{
+				ID: 1, PurchaseID: 100, ItemID: itemID, AuctionID: 4,
+				OldBuyerID: 2, OldPrice: 5000, NewBuyerID: tt.newBuyerID, NewPrice: tt.newPrice,
+				Status: model.ResultCorrectionStatusPending, RequestedBy: 7,
+			}
+			adminID := tt.adminID
+			if adminID == 0 {
+				adminID = 8
+			}
+
+			// 落札は最高値の入札。訂正で他の入札を下回れば、そちらが落札になる。
+			award := model.Purchase{ID: 100, ItemID: itemID, AuctionID: 4, BuyerID: 2, Price: 5000, FishType: "Tuna"}
+			if tt.awardPrice != 0 {
+				award.Price = tt.awardPrice
+			}
+			bidRepo := &mock.MockBidRepository{
+				ListAwardsByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Purchase, error) {
+					if tt.otherBid > award.Price {
+						return []model.Purchase{{ID: 101, ItemID: itemID, BuyerID: 6, Price: tt.otherBid}}, nil
+					}
+					return []model.Purchase{award}, nil
+				},
+				UpdateAwardFunc: func(_ context.Context, id, buyerID, price int) error {
+					award.BuyerID, award.Price = buyerID, price
+					return nil
+				},
+			}
+
+			var updatedInvoice *model.Invoice
+			var drafts []int
+			invoiceRepo := &mock.MockInvoiceRepository{
+				ListByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+					return tt.invoices, nil
+				},
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Invoice, error) {
+					for _, inv := range tt.invoices {
+						if inv.ID == id {
+							return &inv, nil
+						}
+					}
+					return nil, &domainErrors.NotFoundError{Resource: "Invoice", ID: id}
+				},
+				UpdateFunc: func(_ context.Context, inv *model.Invoice) error {
+					updatedInvoice = inv
+					return nil
+				},
+				CreateFunc: func(_ context.Context, inv *model.Invoice) (*model.Invoice, error) {
+					drafts = append(drafts, inv.BuyerID)
+					return inv, nil
+				},
+			}
+			var notes []model.CreditNote
+			creditNoteRepo := &mock.MockCreditNoteRepository{
+				CreateFunc: func(_ context.Context, n *model.CreditNote) (*model.CreditNote, error) {
+					notes = append(notes, *n)
+					return n, nil
+				},
+			}
+			var saved *model.ResultCorrection
+			correctionRepo := &mock.MockResultCorrectionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.ResultCorrection, error) {
+					return correction, nil
+				},
+				UpdateFunc: func(_ context.Context, c *model.ResultCorrection) error {
+					saved = c
+					return nil
+				},
+			}
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, Status: model.AuctionStatusCompleted}, nil
+				},
+			}
+			var invalidated []int
+			cacheInv := &mock.MockCacheInvalidator{
+				InvalidateCacheFunc: func(_ context.Context, id int) error {
+					invalidated = append(invalidated, id)
+					return nil
+				},
+			}
+
+			uc := result.NewApproveCorrectionUseCase(
+				correctionRepo, auctionRepo, bidRepo, invoiceRepo, creditNoteRepo, &mock.MockPaymentRepository{},
+				&mock.MockBuyerChargeRepository{}, &mock.MockTransactionManager{}, mock.NewMockClock(now), cacheInv,
+			)
+			got, err := uc.Execute(context.Background(), 1, adminID, "確認済み")
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ConflictError:
+					var target *domainErrors.ConflictError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ConflictError, got %v", err)
+					}
+				case *domainErrors.ForbiddenError:
+					var target *domainErrors.ForbiddenError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ForbiddenError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.Status != model.ResultCorrectionStatusApproved || saved == nil || *saved.ReviewedBy != adminID {
+				t.Errorf("unexpected correction: %+v", got)
+			}
+			if award.BuyerID != tt.newBuyerID || award.Price != tt.newPrice {
+				t.Errorf("award was not corrected: %+v", award)
+			}
+			if tt.wantCredited == 0 {
+				if len(notes) != 0 || updatedInvoice != nil {
+					t.Errorf("expected no credit note, got %+v", notes)
+				}
+			} else {
+				if len(notes) != 1 || notes[0].BuyerID != 2 || *notes[0].CorrectionID != 1 || got.CreditNote == nil {
+					t.Fatalf("unexpected credit notes: %+v", notes)
+				}
+				if updatedInvoice == nil || updatedInvoice.CreditedAmount != tt.wantCredited {
+					t.Errorf("expected credited %d, got %+v", tt.wantCredited, updatedInvoice)
+				}
+			}
+			if len(drafts) != len(tt.wantDrafts) {
+				t.Fatalf("expected drafts for %v, got %v", tt.wantDrafts, drafts)
+			}
+			for i := range drafts {
+				if drafts[i] != tt.wantDrafts[i] {
+					t.Errorf("expected drafts for %v, got %v", tt.wantDrafts, drafts)
+				}
+			}
+			if len(invalidated) != 1 || invalidated[0] != itemID {
+				t.Errorf("expected item cache invalidation, got %v", invalidated)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// EnterResultsUseCase defines the interface for entering the results of a paper seri in one batch.
+type EnterResultsUseCase interface {
+	// Execute records every row as a floor bid entered by the admin, or nothing at all.
+	// When rows are invalid the error is a *model.ResultBatchError listing each of them.
+	Execute(ctx context.Context, adminID, auctionID int, entries []model.ResultEntry) ([]model.Bid, error)
+}
+
+type enterResultsUseCase struct {
+	auctionRepo  repository.AuctionRepository
+	itemRepo     repository.ItemRepository
+	buyerRepo    repository.BuyerRepository
+	bidRepo      repository.BidRepository
+	invoiceRepo  repository.InvoiceRepository
+	txMgr        repository.TransactionManager
+	itemCacheInv repository.CacheInvalidator
+}
+
+var _ EnterResultsUseCase = (*enterResultsUseCase)(nil)
+
+// NewEnterResultsUseCase creates a new EnterResultsUseCase instance.
+func NewEnterResultsUseCase(
+	auctionRepo repository.AuctionRepository,
+	itemRepo repository.ItemRepository,
+	buyerRepo repository.BuyerRepository,
+	bidRepo repository.BidRepository,
+	invoiceRepo repository.InvoiceRepository,
+	txMgr repository.TransactionManager,
+	itemCacheInv repository.CacheInvalidator,
+) EnterResultsUseCase {
+	return &enterResultsUseCase{
+		auctionRepo:  auctionRepo,
+		itemRepo:     itemRepo,
+		buyerRepo:    buyerRepo,
+		bidRepo:      bidRepo,
+		invoiceRepo:  invoiceRepo,
+		txMgr:        txMgr,
+		itemCacheInv: itemCacheInv,
+	}
+}
+
+// Execute validates the whole batch before writing anything.
+// 値札は紙のせりで既に確定した価格なので、オンライン入札の開催時間・最低刻みの検証は行わない。
+// 代わりに入札が 1 件もない商品に限って登録し、既に結果がある商品は訂正として扱わせる。
+func (uc *enterResultsUseCase) Execute(ctx context.Context, adminID, auctionID int, entries []model.ResultEntry) ([]model.Bid, error) {
+	if len(entries) == 0 {
+		return nil, &apperrors.ValidationError{Field: "rows", Message: "at least one row is required"}
+	}
+	if len(entries) > model.MaxResultEntries {
+		return nil, &apperrors.ValidationError{Field: "rows", Message: fmt.Sprintf("must be at most %d rows", model.MaxResultEntries)}
+	}
+
+	var created []model.Bid
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		// 同じせりへのオンライン入札と直列化するため、入札と同じくせりの行ロックを取る。
+		auction, err := uc.auctionRepo.FindByIDWithLock(txCtx, auctionID)
+		if err != nil {
+			return err
+		}
+		if auction == nil {
+			return &apperrors.NotFoundError{Resource: "Auction", ID: auctionID}
+		}
+		if auction.Status == model.AuctionStatusCancelled {
+			return &apperrors.ConflictError{Message: "cannot enter results for a cancelled auction"}
+		}
+
+		items, err := uc.itemRepo.ListByAuction(txCtx, auctionID)
+		if err != nil {
+			return err
+		}
+		lots := make(map[int]*model.AuctionItem, len(items))
+		for i := range items {
+			lots[items[i].SortOrder] = &items[i]
+		}
+
+		buyers, err := uc.buyerRepo.List(txCtx)
+		if err != nil {
+			return err
+		}
+		knownBuyers := make(map[int]*model.Buyer, len(buyers))
+		paddles := make(map[string][]*model.Buyer)
+		for i := range buyers {
+			knownBuyers[buyers[i].ID] = &buyers[i]
+			if n := buyers[i].PaddleNumber; n != "" {
+				paddles[n] = append(paddles[n], &buyers[i])
+			}
+		}
+
+		invoices, err := uc.invoiceRepo.ListByAuctionID(txCtx, auctionID)
+		if err != nil {
+			return err
+		}
+		invoiced := make(map[int]bool)
+		for _, inv := range invoices {
+			if inv.Status != model.InvoiceStatusDraft {
+				invoiced[inv.BuyerID] = true
+			}
+		}
+
+		var rowErrs []model.ResultEntryError
+		seen := make(map[int]int)
+		bids := make([]model.Bid, 0, len(entries))
+		for _, e := range entries {
+			if errs := e.Validate(); len(errs) > 0 {
+				rowErrs = append(rowErrs, errs...)
+				continue
+			}
+			item, ok := lots[e.LotNumber]
+			switch {
+			case !ok:
+				rowErrs = append(rowErrs, model.ResultEntryError{Row: e.Row, Field: "lot_number", Message: "no such lot in this auction"})
+			case seen[e.LotNumber] != 0:
+				rowErrs = append(rowErrs, model.ResultEntryError{Row: e.Row, Field: "lot_number", Message: fmt.Sprintf("lot is also entered on row %d", seen[e.LotNumber])})
+			case item.HighestBid != nil:
+				rowErrs = append(rowErrs, model.ResultEntryError{Row: e.Row, Field: "lot_number", Message: "lot already has a result; correct it instead"})
+			}
+			buyer, field, buyerErr := resolveResultBuyer(e, knownBuyers, paddles)
+			switch {
+			case buyerErr != "":
+				rowErrs = append(rowErrs, model.ResultEntryError{Row: e.Row, Field: field, Message: buyerErr})
+			case buyer.Registration.CheckBidding() != nil:
+				rowErrs = append(rowErrs, model.ResultEntryError{Row: e.Row, Field: field, Message: "the buyer's registration has not been approved"})
+			case invoiced[buyer.ID]:
+				rowErrs = append(rowErrs, model.ResultEntryError{Row: e.Row, Field: field, Message: "the buyer's invoice for this auction has already been issued"})
+			}
+			if seen[e.LotNumber] == 0 {
+				seen[e.LotNumber] = e.Row
+			}
+			if ok && buyer != nil {
+				bids = append(bids, model.Bid{
+					ItemID:    item.ID,
+					BuyerID:   buyer.ID,
+					Price:     model.NewBidPrice(e.Price),
+					Channel:   model.BidChannelFloor,
+					EnteredBy: &adminID,
+				})
+			}
+		}
+		if len(rowErrs) > 0 {
+			return &model.ResultBatchError{Rows: rowErrs}
+		}
+
+		created = make([]model.Bid, 0, len(bids))
+		for i := range bids {
+			b, err := uc.bidRepo.Create(txCtx, &bids[i])
+			if err != nil {
+				return fmt.Errorf("failed to create bid: %w", err)
+			}
+			created = append(created, *b)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range created {
+		if err := uc.itemCacheInv.InvalidateCache(ctx, b.ItemID); err != nil {
+			fmt.Printf("failed to invalidate item cache: %v\n", err)
+		}
+	}
+	return created, nil
+}
+
+// resolveResultBuyer finds the buyer a row names, by paddle number when one is written and by ID otherwise.
+// It returns the field the row named the buyer with and, when the buyer cannot be determined, why.
+// 値札には買参権番号しか書かれないため、番号から買受人を引き当てる。ID も書かれていれば一致を確認する。
+func resolveResultBuyer(e model.ResultEntry, byID map[int]*model.Buyer, byPaddle map[string][]*model.Buyer) (*model.Buyer, string, string) {
+	if e.PaddleNumber == "" {
+		if buyer := byID[e.BuyerID]; buyer != nil {
+			return buyer, "buyer_id", ""
+		}
+		return nil, "buyer_id", "no such buyer"
+	}
+
+	paddle, err := model.NormalizePaddleNumber(e.PaddleNumber)
+	var vErr *apperrors.ValidationError
+	if errors.As(err, &vErr) {
+		return nil, "paddle_number", vErr.Message
+	}
+	matches := byPaddle[paddle]
+	switch {
+	case len(matches) == 0:
+		return nil, "paddle_number", "no buyer has this paddle number"
+	case len(matches) > 1:
+		return nil, "paddle_number", "the paddle number is assigned to more than one buyer"
+	case e.BuyerID != 0 && e.BuyerID != matches[0].ID:
+		return nil, "paddle_number", "the paddle number belongs to a different buyer than buyer_id"
+	}
+	return matches[0], "paddle_number", ""
+}
@@ -0,0 +1,209 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/result"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestEnterResultsUseCase_Execute(t *testing.T) {
+	sold := model.NewBidPrice(5000)
+	items := []model.AuctionItem{
+		{ID: 101, AuctionID: 1, SortOrder: 1},
+		{ID: 102, AuctionID: 1, SortOrder: 2},
+		{ID: 103, AuctionID: 1, SortOrder: 3, HighestBid: &sold},
+	}
+
+	tests := []struct {
+		name          string
+		entries       []model.ResultEntry
+		auctionStatus model.AuctionStatus
+		invoices      []model.Invoice
+		wantCreated   int
+		wantBuyerIDs  []int
+		wantRowErrs   []model.ResultEntryError
+		wantErr       error
+	}{
+		{
+			name: "Success",
+			entries: []model.ResultEntry{
+				{Row: 1, LotNumber: 1, BuyerID: 1, Price: 1200},
+				{Row: 2, LotNumber: 2, BuyerID: 2, Price: 800},
+			},
+			invoices:    []model.Invoice{{ID: 1, BuyerID: 2, Status: model.InvoiceStatusDraft}},
+			wantCreated: 2,
+		},
+		{
+			name: "RowErrorsRejectWholeBatch",
+			entries: []model.ResultEntry{
+				{Row: 2, LotNumber: 1, BuyerID: 1, Price: 1200},
+				{Row: 3, LotNumber: 9, BuyerID: 1, Price: 1000},
+				{Row: 4, LotNumber: 1, BuyerID: 2, Price: 1000},
+				{Row: 5, LotNumber: 3, BuyerID: 99, Price: 1000},
+				{Row: 6, LotNumber: 2, BuyerID: 3, Price: 0},
+				{Row: 7, LotNumber: 2, BuyerID: 3, Price: 700},
+				{Row: 8, LotNumber: 2, BuyerID: 4, Price: 700},
+			},
+			invoices: []model.Invoice{{ID: 1, BuyerID: 3, Status: model.InvoiceStatusIssued}},
+			wantRowErrs: []model.ResultEntryError{
+				{Row: 3, Field: "lot_number", Message: "no such lot in this auction"},
+				{Row: 4, Field: "lot_number", Message: "lot is also entered on row 2"},
+				{Row: 5, Field: "lot_number", Message: "lot already has a result; correct it instead"},
+				{Row: 5, Field: "buyer_id", Message: "no such buyer"},
+				{Row: 6, Field: "price", Message: "must be positive"},
+				{Row: 7, Field: "buyer_id", Message: "the buyer's invoice for this auction has already been issued"},
+				{Row: 8, Field: "lot_number", Message: "lot is also entered on row 7"},
+				{Row: 8, Field: "buyer_id", Message: "the buyer's registration has not been approved"},
+			},
+		},
+		{
+			name: "PaddleNumber",
+			entries: []model.ResultEntry{
+				{Row: 1, LotNumber: 1, PaddleNumber: " a-1 ", Price: 1200},
+				{Row: 2, LotNumber: 2, BuyerID: 2, PaddleNumber: "B-2", Price: 800},
+			},
+			wantCreated:  2,
+			wantBuyerIDs: []int{1, 2},
+		},
+		{
+			name: "UnknownOrAmbiguousPaddleNumber",
+			entries: []model.ResultEntry{
+				{Row: 2, LotNumber: 1, PaddleNumber: "Z-9", Price: 1200},
+				{Row: 3, LotNumber: 2, PaddleNumber: "77", Price: 800},
+				{Row: 4, LotNumber: 4, BuyerID: 1, PaddleNumber: "B-2", Price: 800},
+				{Row: 5, LotNumber: 5, PaddleNumber: "A_1", Price: 800},
+			},
+			wantRowErrs: []model.ResultEntryError{
+				{Row: 2, Field: "paddle_number", Message: "no buyer has this paddle number"},
+				{Row: 3, Field: "paddle_number", Message: "the paddle number is assigned to more than one buyer"},
+				{Row: 4, Field: "lot_number", Message: "no such lot in this auction"},
+				{Row: 4, Field: "paddle_number", Message: "the paddle number belongs to a different buyer than buyer_id"},
+				{Row: 5, Field: "lot_number", Message: "no such lot in this auction"},
+				{Row: 5, Field: "paddle_number", Message: "must contain only letters, digits and hyphens"},
+			},
+		},
+		{
+			name:          "CancelledAuction",
+			entries:       []model.ResultEntry{{Row: 1, LotNumber: 1, BuyerID: 1, Price: 1200}},
+			auctionStatus: model.AuctionStatusCancelled,
+			wantErr:       &domainErrors.ConflictError{},
+		},
+		{name: "EmptyBatch", wantErr: &domainErrors.ValidationError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.auctionStatus
+			if status == "" {
+				status = model.AuctionStatusCompleted
+			}
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Auction, error) {
+					return &model.Auction{ID: id, Status: status}, nil
+				},
+			}
+			itemRepo := &mock.MockItemRepository{
+				ListByAuctionFunc: func(_ context.Context, _ int) ([]model.AuctionItem, error) {
+					return items, nil
+				},
+			}
+			buyerRepo := &mock.MockBuyerRepository{
+				ListFunc: func(_ context.Context) ([]model.Buyer, error) {
+					return []model.Buyer{
+						{ID: 1, PaddleNumber: "A-1"},
+						{ID: 2, PaddleNumber: "B-2"},
+						{ID: 3},
+						{ID: 4, Registration: model.BuyerRegistration{Status: model.BuyerRegistrationPending}},
+						{ID: 5, PaddleNumber: "77"},
+						{ID: 6, PaddleNumber: "77"},
+					}, nil
+				},
+			}
+			var createdBids []model.Bid
+			bidRepo := &mock.MockBidRepository{
+				CreateFunc: func(_ context.Context, b *model.Bid) (*model.Bid, error) {
+					created := *b
+					created.ID = len(createdBids) + 1
+					createdBids = append(createdBids, created)
+					return &created, nil
+				},
+			}
+			invoiceRepo := &mock.MockInvoiceRepository{
+				ListByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Invoice, error) {
+					return tt.invoices, nil
+				},
+			}
+			var invalidated []int
+			cacheInv := &mock.MockCacheInvalidator{
+				InvalidateCacheFunc: func(_ context.Context, id int) error {
+					invalidated = append(invalidated, id)
+					return nil
+				},
+			}
+
+			uc := result.NewEnterResultsUseCase(auctionRepo, itemRepo, buyerRepo, bidRepo, invoiceRepo, &mock.MockTransactionManager{}, cacheInv)
+			got, err := uc.Execute(context.Background(), 7, 1, tt.entries)
+
+			if tt.wantRowErrs != nil {
+				var batchErr *model.ResultBatchError
+				if !errors.As(err, &batchErr) {
+					t.Fatalf("expected ResultBatchError, got %v", err)
+				}
+				if len(batchErr.Rows) != len(tt.wantRowErrs) {
+					t.Fatalf("expected %d row errors, got %+v", len(tt.wantRowErrs), batchErr.Rows)
+				}
+				for i, want := range tt.wantRowErrs {
+					if batchErr.Rows[i] != want {
+						t.Errorf("row error %d: expected %+v, got %+v", i, want, batchErr.Rows[i])
+					}
+				}
+				if len(createdBids) != 0 {
+					t.Errorf("expected nothing to be recorded, got %d bids", len(createdBids))
+				}
+				return
+			}
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.ConflictError:
+					var cErr *domainErrors.ConflictError
+					if !errors.As(err, &cErr) {
+						t.Fatalf("expected ConflictError, got %v", err)
+					}
+				case *domainErrors.ValidationError:
+					var vErr *domainErrors.ValidationError
+					if !errors.As(err, &vErr) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != tt.wantCreated {
+				t.Fatalf("expected %d bids, got %d", tt.wantCreated, len(got))
+			}
+			for _, b := range got {
+				if b.Channel != model.BidChannelFloor || b.EnteredBy == nil || *b.EnteredBy != 7 {
+					t.Errorf("expected floor bid entered by admin 7, got %+v", b)
+				}
+			}
+			if got[0].ItemID != 101 || got[1].ItemID != 102 || got[1].Price.Amount() != 800 {
+				t.Errorf("unexpected bids: %+v", got)
+			}
+			for i, id := range tt.wantBuyerIDs {
+				if got[i].BuyerID != id {
+					t.Errorf("bid %d: expected buyer %d, got %d", i, id, got[i].BuyerID)
+				}
+			}
+			if len(invalidated) != tt.wantCreated {
+				t.Errorf("expected item caches to be invalidated, got %v", invalidated)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package result
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListCorrectionsUseCase defines the interface for listing result corrections.
+type ListCorrectionsUseCase interface {
+	// Execute returns the corrections matching the filters, newest first.
+	Execute(ctx context.Context, filters *repository.ResultCorrectionFilters) ([]model.ResultCorrection, error)
+}
+
+type listCorrectionsUseCase struct {
+	correctionRepo repository.ResultCorrectionRepository
+}
+
+var _ ListCorrectionsUseCase = (*listCorrectionsUseCase)(nil)
+
+// NewListCorrectionsUseCase creates a new ListCorrectionsUseCase instance.
+func NewListCorrectionsUseCase(correctionRepo repository.ResultCorrectionRepository) ListCorrectionsUseCase {
+	return &listCorrectionsUseCase{correctionRepo: correctionRepo}
+}
+
+// Execute lists corrections.
+func (uc *listCorrectionsUseCase) Execute(ctx context.Context, filters *repository.ResultCorrectionFilters) ([]model.ResultCorrection, error) {
+	return uc.correctionRepo.List(ctx, filters)
+}
@@ -0,0 +1,60 @@
+package result
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// RejectCorrectionUseCase defines the interface for rejecting a result correction.
+type RejectCorrectionUseCase interface {
+	// Execute rejects the correction and leaves the award unchanged.
+	Execute(ctx context.Context, id, adminID int, note string) (*model.ResultCorrection, error)
+}
+
+type rejectCorrectionUseCase struct {
+	correctionRepo repository.ResultCorrectionRepository
+	txMgr          repository.TransactionManager
+	clock          service.Clock
+}
+
+var _ RejectCorrectionUseCase = (*rejectCorrectionUseCase)(nil)
+
+// NewRejectCorrectionUseCase creates a new RejectCorrectionUseCase instance.
+func NewRejectCorrectionUseCase(
+	correctionRepo repository.ResultCorrectionRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) RejectCorrectionUseCase {
+	return &rejectCorrectionUseCase{
+		correctionRepo: correctionRepo,
+		txMgr:          txMgr,
+		clock:          clock,
+	}
+}
+
+// Execute rejects a correction.
+func (uc *rejectCorrectionUseCase) Execute(ctx context.Context, id, adminID int, note string) (*model.ResultCorrection, error) {
+	var rejected *model.ResultCorrection
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		correction, err := uc.correctionRepo.FindByIDWithLock(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if err := correction.Reject(adminID, note, uc.clock.Now()); err != nil {
+			return err
+		}
+		if err := uc.correctionRepo.Update(txCtx, correction); err != nil {
+			return fmt.Errorf("failed to update result correction: %w", err)
+		}
+		rejected = correction
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rejected, nil
+}
@@ -0,0 +1,106 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// RequestCorrectionInput holds the corrected result of a lot and why it changes.
+type RequestCorrectionInput struct {
+	BuyerID int
+	Price   int
+	Reason  string
+}
+
+// RequestCorrectionUseCase defines the interface for requesting a correction of an awarded lot.
+type RequestCorrectionUseCase interface {
+	// Execute records a pending correction. Nothing changes until a second admin approves it.
+	Execute(ctx context.Context, adminID, itemID int, input RequestCorrectionInput) (*model.ResultCorrection, error)
+}
+
+type requestCorrectionUseCase struct {
+	itemRepo       repository.ItemRepository
+	buyerRepo      repository.BuyerRepository
+	bidRepo        repository.BidRepository
+	correctionRepo repository.ResultCorrectionRepository
+}
+
+var _ RequestCorrectionUseCase = (*requestCorrectionUseCase)(nil)
+
+// NewRequestCorrectionUseCase creates a new RequestCorrectionUseCase instance.
+func NewRequestCorrectionUseCase(
+	itemRepo repository.ItemRepository,
+	buyerRepo repository.BuyerRepository,
+	bidRepo repository.BidRepository,
+	correctionRepo repository.ResultCorrectionRepository,
+) RequestCorrectionUseCase {
+	return &requestCorrectionUseCase{
+		itemRepo:       itemRepo,
+		buyerRepo:      buyerRepo,
+		bidRepo:        bidRepo,
+		correctionRepo: correctionRepo,
+	}
+}
+
+// Execute requests a correction.
+// 訂正前の値は申請時点の落札から写し取り、承認時に落札が変わっていないかの照合に使う。
+func (uc *requestCorrectionUseCase) Execute(ctx context.Context, adminID, itemID int, input RequestCorrectionInput) (*model.ResultCorrection, error) {
+	item, err := uc.itemRepo.FindByID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil || item.DeletedAt != nil {
+		return nil, &apperrors.NotFoundError{Resource: "AuctionItem", ID: itemID}
+	}
+
+	award, err := findAward(ctx, uc.bidRepo, item.AuctionID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if award == nil {
+		return nil, &apperrors.ConflictError{Message: "the lot has no result to correct"}
+	}
+
+	correction, err := model.NewResultCorrection(award, input.BuyerID, input.Price, input.Reason, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if correction.BuyerChanged() {
+		if _, err := uc.buyerRepo.FindByID(ctx, input.BuyerID); err != nil {
+			var nfErr *apperrors.NotFoundError
+			if errors.As(err, &nfErr) {
+				return nil, &apperrors.ValidationError{Field: "buyer_id", Message: "no such buyer"}
+			}
+			return nil, err
+		}
+	}
+
+	created, err := uc.correctionRepo.Create(ctx, correction)
+	if err != nil {
+		var cErr *apperrors.ConflictError
+		if errors.As(err, &cErr) {
+			return nil, &apperrors.ConflictError{Message: "a correction for this lot is already pending"}
+		}
+		return nil, fmt.Errorf("failed to create result correction: %w", err)
+	}
+	return created, nil
+}
+
+// findAward returns the winning transaction of the item, or nil when the item has no bids.
+func findAward(ctx context.Context, bidRepo repository.BidRepository, auctionID, itemID int) (*model.Purchase, error) {
+	awards, err := bidRepo.ListAwardsByAuctionID(ctx, auctionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list awards: %w", err)
+	}
+	for i := range awards {
+		if awards[i].ItemID == itemID {
+			return &awards[i], nil
+		}
+	}
+	return nil, nil
+}
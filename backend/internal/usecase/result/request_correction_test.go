@@ -0,0 +1,123 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/result"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestRequestCorrectionUseCase_Execute(t *testing.T) {
+	deletedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		item      *model.AuctionItem
+		awards    []model.Purchase
+		input     result.RequestCorrectionInput
+		createErr error
+		wantErr   error
+	}{
+		{
+			name:   "Success",
+			item:   &model.AuctionItem{ID: 10, AuctionID: 4},
+			awards: []model.Purchase{{ID: 100, ItemID: 10, AuctionID: 4, BuyerID: 2, Price: 5000}},
+			input:  result.RequestCorrectionInput{BuyerID: 3, Price: 5000, Reason: "札の読み違い"},
+		},
+		{
+			name:    "DeletedItem",
+			item:    &model.AuctionItem{ID: 10, AuctionID: 4, DeletedAt: &deletedAt},
+			input:   result.RequestCorrectionInput{BuyerID: 3, Price: 5000, Reason: "札の読み違い"},
+			wantErr: &domainErrors.NotFoundError{},
+		},
+		{
+			name:    "NoResult",
+			item:    &model.AuctionItem{ID: 10, AuctionID: 4},
+			input:   result.RequestCorrectionInput{BuyerID: 3, Price: 5000, Reason: "札の読み違い"},
+			wantErr: &domainErrors.ConflictError{},
+		},
+		{
+			name:    "UnknownBuyer",
+			item:    &model.AuctionItem{ID: 10, AuctionID: 4},
+			awards:  []model.Purchase{{ID: 100, ItemID: 10, AuctionID: 4, BuyerID: 2, Price: 5000}},
+			input:   result.RequestCorrectionInput{BuyerID: 99, Price: 5000, Reason: "札の読み違い"},
+			wantErr: &domainErrors.ValidationError{},
+		},
+		{
+			name:      "AlreadyPending",
+			item:      &model.AuctionItem{ID: 10, AuctionID: 4},
+			awards:    []model.Purchase{{ID: 100, ItemID: 10, AuctionID: 4, BuyerID: 2, Price: 5000}},
+			input:     result.RequestCorrectionInput{BuyerID: 2, Price: 4000, Reason: "入力ミス"},
+			createErr: &domainErrors.ConflictError{Message: "ResultCorrection already exists"},
+			wantErr:   &domainErrors.ConflictError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			itemRepo := &mock.MockItemRepository{
+				FindByIDFunc: func(_ context.Context, _ int) (*model.AuctionItem, error) {
+					return tt.item, nil
+				},
+			}
+			buyerRepo := &mock.MockBuyerRepository{
+				FindByIDFunc: func(_ context.Context, id int) (*model.Buyer, error) {
+					if id == 99 {
+						return nil, &domainErrors.NotFoundError{Resource: "Buyer", ID: id}
+					}
+					return &model.Buyer{ID: id}, nil
+				},
+			}
+			bidRepo := &mock.MockBidRepository{
+				ListAwardsByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Purchase, error) {
+					return tt.awards, nil
+				},
+			}
+			correctionRepo := &mock.MockResultCorrectionRepository{
+				CreateFunc: func(_ context.Context, c *model.ResultCorrection) (*model.ResultCorrection, error) {
+					if tt.createErr != nil {
+						return nil, tt.createErr
+					}
+					created := *c
+					created.ID = 1
+					return &created, nil
+				},
+			}
+
+			uc := result.NewRequestCorrectionUseCase(itemRepo, buyerRepo, bidRepo, correctionRepo)
+			got, err := uc.Execute(context.Background(), 7, 10, tt.input)
+
+			if tt.wantErr != nil {
+				switch tt.wantErr.(type) {
+				case *domainErrors.NotFoundError:
+					var target *domainErrors.NotFoundError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected NotFoundError, got %v", err)
+					}
+				case *domainErrors.ConflictError:
+					var target *domainErrors.ConflictError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ConflictError, got %v", err)
+					}
+				case *domainErrors.ValidationError:
+					var target *domainErrors.ValidationError
+					if !errors.As(err, &target) {
+						t.Fatalf("expected ValidationError, got %v", err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.ID != 1 || got.PurchaseID != 100 || got.OldBuyerID != 2 || got.OldPrice != 5000 || got.RequestedBy != 7 {
+				t.Errorf("unexpected correction: %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package session
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListSessionsUseCase defines the interface for listing the active sessions of a user.
+type ListSessionsUseCase interface {
+	// Execute returns the owner's sessions, most recently used first.
+	Execute(ctx context.Context, owner model.SessionOwner) ([]model.Session, error)
+}
+
+type listSessionsUseCase struct {
+	sessionRepo repository.SessionRepository
+}
+
+var _ ListSessionsUseCase = (*listSessionsUseCase)(nil)
+
+// NewListSessionsUseCase creates a new ListSessionsUseCase instance.
+func NewListSessionsUseCase(sessionRepo repository.SessionRepository) ListSessionsUseCase {
+	return &listSessionsUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute lists the sessions.
+func (uc *listSessionsUseCase) Execute(ctx context.Context, owner model.SessionOwner) ([]model.Session, error) {
+	sessions, err := listOwnedSessions(ctx, uc.sessionRepo, owner)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(sessions, func(a, b model.Session) int {
+		return cmp.Or(b.LastSeenAt.Compare(a.LastSeenAt), b.CreatedAt.Compare(a.CreatedAt))
+	})
+	return sessions, nil
+}
+
+// listOwnedSessions returns the sessions of the owner.
+// ストアは利用者単位でしか引けないため、組織の個別ログインはここで絞り込む。
+func listOwnedSessions(ctx context.Context, sessionRepo repository.SessionRepository, owner model.SessionOwner) ([]model.Session, error) {
+	sessions, err := sessionRepo.ListByUserID(ctx, owner.UserID, owner.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return slices.DeleteFunc(sessions, func(s model.Session) bool {
+		return !owner.Owns(&s)
+	}), nil
+}
@@ -0,0 +1,46 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// RevokeSessionUseCase defines the interface for signing out a single session.
+type RevokeSessionUseCase interface {
+	// Execute revokes the owner's session with the given public ID.
+	Execute(ctx context.Context, owner model.SessionOwner, publicID string) error
+}
+
+type revokeSessionUseCase struct {
+	sessionRepo repository.SessionRepository
+}
+
+var _ RevokeSessionUseCase = (*revokeSessionUseCase)(nil)
+
+// NewRevokeSessionUseCase creates a new RevokeSessionUseCase instance.
+func NewRevokeSessionUseCase(sessionRepo repository.SessionRepository) RevokeSessionUseCase {
+	return &revokeSessionUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute revokes the session.
+// 他人のセッションは存在しないものとして扱い、ID の有無を推測させない。
+func (uc *revokeSessionUseCase) Execute(ctx context.Context, owner model.SessionOwner, publicID string) error {
+	sessions, err := listOwnedSessions(ctx, uc.sessionRepo, owner)
+	if err != nil {
+		return err
+	}
+	for _, s := range sessions {
+		if s.PublicID() != publicID {
+			continue
+		}
+		if err := uc.sessionRepo.Delete(ctx, s.ID); err != nil {
+			return fmt.Errorf("failed to delete session: %w", err)
+		}
+		return nil
+	}
+	return &apperrors.NotFoundError{Resource: "Session", ID: publicID}
+}
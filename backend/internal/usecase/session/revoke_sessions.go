@@ -0,0 +1,45 @@
+package session
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// RevokeSessionsUseCase defines the interface for signing out every session of a user but one.
+type RevokeSessionsUseCase interface {
+	// Execute revokes the owner's sessions except keepSessionID, which may be empty, and returns how many were revoked.
+	Execute(ctx context.Context, owner model.SessionOwner, keepSessionID string) (int, error)
+}
+
+type revokeSessionsUseCase struct {
+	sessionRepo repository.SessionRepository
+}
+
+var _ RevokeSessionsUseCase = (*revokeSessionsUseCase)(nil)
+
+// NewRevokeSessionsUseCase creates a new RevokeSessionsUseCase instance.
+func NewRevokeSessionsUseCase(sessionRepo repository.SessionRepository) RevokeSessionsUseCase {
+	return &revokeSessionsUseCase{sessionRepo: sessionRepo}
+}
+
+// Execute revokes the sessions.
+func (uc *revokeSessionsUseCase) Execute(ctx context.Context, owner model.SessionOwner, keepSessionID string) (int, error) {
+	sessions, err := listOwnedSessions(ctx, uc.sessionRepo, owner)
+	if err != nil {
+		return 0, err
+	}
+	revoked := 0
+	for _, s := range sessions {
+		if s.ID == keepSessionID {
+			continue
+		}
+		if err := uc.sessionRepo.Delete(ctx, s.ID); err != nil {
+			return revoked, fmt.Errorf("failed to delete session: %w", err)
+		}
+		revoked++
+	}
+	return revoked, nil
+}
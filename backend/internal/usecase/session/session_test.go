@@ -0,0 +1,132 @@
+package session_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/usecase/session"
+)
+
+type fakeSessionRepo struct {
+	repository.SessionRepository
+	sessions []model.Session
+	deleted  []string
+}
+
+func (m *fakeSessionRepo) ListByUserID(_ context.Context, userID int, role model.SessionRole) ([]model.Session, error) {
+	var res []model.Session
+	for _, s := range m.sessions {
+		if s.UserID == userID && s.Role == role {
+			res = append(res, s)
+		}
+	}
+	return res, nil
+}
+
+func (m *fakeSessionRepo) Delete(_ context.Context, sessionID string) error {
+	m.deleted = append(m.deleted, sessionID)
+	return nil
+}
+
+func newFakeSessionRepo() *fakeSessionRepo {
+	now := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	return &fakeSessionRepo{sessions: []model.Session{
+		{ID: "phone", UserID: 1, LoginID: 10, Role: model.SessionRoleBuyer, LastSeenAt: now.Add(-time.Hour)},
+		{ID: "laptop", UserID: 1, LoginID: 10, Role: model.SessionRoleBuyer, LastSeenAt: now},
+		{ID: "staff", UserID: 1, LoginID: 11, Role: model.SessionRoleBuyer, LastSeenAt: now},
+		{ID: "admin", UserID: 1, Role: model.SessionRoleAdmin, LastSeenAt: now},
+	}}
+}
+
+func sessionIDs(sessions []model.Session) []string {
+	ids := make([]string, 0, len(sessions))
+	for _, s := range sessions {
+		ids = append(ids, s.ID)
+	}
+	return ids
+}
+
+func TestListSessionsUseCase_Execute(t *testing.T) {
+	uc := session.NewListSessionsUseCase(newFakeSessionRepo())
+
+	got, err := uc.Execute(context.Background(), model.SessionOwner{Role: model.SessionRoleBuyer, UserID: 1, LoginID: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ids := sessionIDs(got); !slices.Equal(ids, []string{"laptop", "phone"}) {
+		t.Errorf("expected the login's sessions, most recent first, got %v", ids)
+	}
+
+	got, err = uc.Execute(context.Background(), model.SessionOwner{Role: model.SessionRoleBuyer, UserID: 1})
+	if err != nil || len(got) != 3 {
+		t.Errorf("expected every login of the buyer, got %v (%v)", sessionIDs(got), err)
+	}
+}
+
+func TestRevokeSessionUseCase_Execute(t *testing.T) {
+	owner := model.SessionOwner{Role: model.SessionRoleBuyer, UserID: 1, LoginID: 10}
+
+	tests := []struct {
+		name     string
+		publicID string
+		wantErr  bool
+	}{
+		{name: "Success", publicID: (&model.Session{ID: "phone"}).PublicID()},
+		{name: "OtherLogin", publicID: (&model.Session{ID: "staff"}).PublicID(), wantErr: true},
+		{name: "OtherRole", publicID: (&model.Session{ID: "admin"}).PublicID(), wantErr: true},
+		{name: "RawSessionID", publicID: "phone", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeSessionRepo()
+			err := session.NewRevokeSessionUseCase(repo).Execute(context.Background(), owner, tt.publicID)
+
+			if tt.wantErr {
+				var nfErr *domainErrors.NotFoundError
+				if !errors.As(err, &nfErr) {
+					t.Fatalf("expected not found error, got %v", err)
+				}
+				if len(repo.deleted) != 0 {
+					t.Errorf("expected nothing to be revoked, got %v", repo.deleted)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(repo.deleted, []string{"phone"}) {
+				t.Errorf("expected the phone session to be revoked, got %v", repo.deleted)
+			}
+		})
+	}
+}
+
+func TestRevokeSessionsUseCase_Execute(t *testing.T) {
+	t.Run("AllOthers", func(t *testing.T) {
+		repo := newFakeSessionRepo()
+		n, err := session.NewRevokeSessionsUseCase(repo).
+			Execute(context.Background(), model.SessionOwner{Role: model.SessionRoleBuyer, UserID: 1, LoginID: 10}, "laptop")
+		if err != nil || n != 1 || !slices.Equal(repo.deleted, []string{"phone"}) {
+			t.Errorf("expected only the phone session to be revoked, got %d %v (%v)", n, repo.deleted, err)
+		}
+	})
+
+	t.Run("EveryLogin", func(t *testing.T) {
+		repo := newFakeSessionRepo()
+		n, err := session.NewRevokeSessionsUseCase(repo).
+			Execute(context.Background(), model.SessionOwner{Role: model.SessionRoleBuyer, UserID: 1}, "")
+		if err != nil || n != 3 {
+			t.Fatalf("expected 3 sessions to be revoked, got %d (%v)", n, err)
+		}
+		if slices.Contains(repo.deleted, "admin") {
+			t.Error("expected the admin session with the same user ID to be kept")
+		}
+	})
+}
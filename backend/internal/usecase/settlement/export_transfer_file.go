@@ -0,0 +1,148 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// ExportTransferFileInput is the input of ExportTransferFileUseCase.
+type ExportTransferFileInput struct {
+	SettlementIDs []int
+	TransferDate  time.Time
+	Remitter      model.ZenginRemitter
+	// Reissue allows statements that were already exported to be written again, e.g. when the bank rejected the file.
+	Reissue bool
+}
+
+// ExportTransferFileUseCase defines the interface for exporting a Zengin transfer file for fisherman payouts.
+type ExportTransferFileUseCase interface {
+	// Execute returns the Shift_JIS encoded transfer file.
+	Execute(ctx context.Context, input *ExportTransferFileInput) ([]byte, error)
+}
+
+type exportTransferFileUseCase struct {
+	settlementRepo repository.SettlementRepository
+	fishermanRepo  repository.FishermanRepository
+	txMgr          repository.TransactionManager
+	clock          service.Clock
+}
+
+var _ ExportTransferFileUseCase = (*exportTransferFileUseCase)(nil)
+
+// NewExportTransferFileUseCase creates a new ExportTransferFileUseCase instance.
+func NewExportTransferFileUseCase(
+	settlementRepo repository.SettlementRepository,
+	fishermanRepo repository.FishermanRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) ExportTransferFileUseCase {
+	return &exportTransferFileUseCase{
+		settlementRepo: settlementRepo,
+		fishermanRepo:  fishermanRepo,
+		txMgr:          txMgr,
+		clock:          clock,
+	}
+}
+
+// Execute builds a 総合振込 file from settled statements and records that they were exported.
+// 同じ漁業者の仕切書は 1 件の振込にまとめる（振込手数料を抑えるため）。
+// 書き出し済みの仕切書は二重振込を防ぐため、再発行を明示した場合にだけ再び書き出す。
+func (uc *exportTransferFileUseCase) Execute(ctx context.Context, input *ExportTransferFileInput) ([]byte, error) {
+	ids := uniqueIDs(input.SettlementIDs)
+	if len(ids) == 0 {
+		return nil, &apperrors.ValidationError{Field: "settlement_ids", Message: "at least one settlement is required"}
+	}
+
+	var data []byte
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		settlements, err := uc.settlementRepo.ListByIDsWithLock(txCtx, ids)
+		if err != nil {
+			return err
+		}
+		file, err := uc.buildFile(txCtx, ids, settlements, input)
+		if err != nil {
+			return err
+		}
+		if data, err = file.Encode(); err != nil {
+			return err
+		}
+		return uc.settlementRepo.MarkExported(txCtx, ids, uc.clock.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (uc *exportTransferFileUseCase) buildFile(ctx context.Context, ids []int, settlements []model.Settlement, input *ExportTransferFileInput) (*model.ZenginTransferFile, error) {
+	found := make(map[int]bool, len(settlements))
+	for _, s := range settlements {
+		found[s.ID] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return nil, &apperrors.NotFoundError{Resource: "Settlement", ID: id}
+		}
+	}
+
+	amounts := make(map[int]int)
+	for _, s := range settlements {
+		if s.Status != model.SettlementStatusSettled {
+			return nil, &apperrors.ConflictError{Message: fmt.Sprintf("settlement %d is not settled", s.ID)}
+		}
+		if s.IsExported() && !input.Reissue {
+			return nil, &apperrors.ConflictError{Message: fmt.Sprintf("settlement %d was already exported on %s", s.ID, s.ExportedAt.Format(time.DateOnly))}
+		}
+		amounts[s.FishermanID] += s.NetAmount
+	}
+
+	fishermanIDs := make([]int, 0, len(amounts))
+	for id := range amounts {
+		fishermanIDs = append(fishermanIDs, id)
+	}
+	sort.Ints(fishermanIDs)
+
+	file := &model.ZenginTransferFile{
+		Remitter:     input.Remitter,
+		TransferDate: input.TransferDate,
+	}
+	for _, id := range fishermanIDs {
+		f, err := uc.fishermanRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if f.BankAccount == nil {
+			return nil, &apperrors.ValidationError{Field: "bank_account", Message: fmt.Sprintf("fisherman %d has no bank account registered", id)}
+		}
+		if amounts[id] <= 0 {
+			return nil, &apperrors.ValidationError{Field: "amount", Message: fmt.Sprintf("fisherman %d has nothing to pay out", id)}
+		}
+		file.Transfers = append(file.Transfers, model.ZenginTransfer{
+			Account:      *f.BankAccount,
+			Amount:       amounts[id],
+			CustomerCode: strconv.Itoa(id),
+		})
+	}
+	return file, nil
+}
+
+func uniqueIDs(ids []int) []int {
+	seen := make(map[int]bool, len(ids))
+	out := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
@@ -0,0 +1,236 @@
+package settlement_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/settlement"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+func exportInput(ids ...int) *settlement.ExportTransferFileInput {
+	return &settlement.ExportTransferFileInput{
+		SettlementIDs: ids,
+		TransferDate:  time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+		Remitter: model.ZenginRemitter{
+			CompanyCode:    "1234567890",
+			NameKana:       "ｻｶﾅｲﾁﾊﾞ(ｶ",
+			BankCode:       "0001",
+			BankNameKana:   "ﾐｽﾞﾎ",
+			BranchCode:     "100",
+			BranchNameKana: "ﾄｳｷﾖｳ",
+			AccountType:    model.BankAccountTypeOrdinary,
+			AccountNumber:  "1234567",
+		},
+	}
+}
+
+var exportNow = time.Date(2024, 3, 4, 15, 0, 0, 0, time.UTC)
+
+func newExportUseCase(settlementRepo *mock.MockSettlementRepository, fishermanRepo *mock.MockFishermanRepository) settlement.ExportTransferFileUseCase {
+	return settlement.NewExportTransferFileUseCase(settlementRepo, fishermanRepo, &mock.MockTransactionManager{}, mock.NewMockClock(exportNow))
+}
+
+// newExportRepos returns repositories over the given settlements. MarkExported updates the slice,
+// so that a later export sees the statements as exported.
+func newExportRepos(settlements []model.Settlement, fishermen map[int]*model.Fisherman) (*mock.MockSettlementRepository, *mock.MockFishermanRepository) {
+	settlementRepo := &mock.MockSettlementRepository{
+		ListByIDsWithLockFunc: func(_ context.Context, ids []int) ([]model.Settlement, error) {
+			var out []model.Settlement
+			for _, s := range settlements {
+				for _, id := range ids {
+					if s.ID == id {
+						out = append(out, s)
+					}
+				}
+			}
+			return out, nil
+		},
+		MarkExportedFunc: func(_ context.Context, ids []int, exportedAt time.Time) error {
+			for i := range settlements {
+				for _, id := range ids {
+					if settlements[i].ID == id {
+						settlements[i].ExportedAt = &exportedAt
+					}
+				}
+			}
+			return nil
+		},
+	}
+	fishermanRepo := &mock.MockFishermanRepository{
+		FindByIDFunc: func(_ context.Context, id int) (*model.Fisherman, error) {
+			if f, ok := fishermen[id]; ok {
+				return f, nil
+			}
+			return nil, &domainErrors.NotFoundError{Resource: "Fisherman", ID: id}
+		},
+	}
+	return settlementRepo, fishermanRepo
+}
+
+func TestExportTransferFileUseCase_Execute_Golden(t *testing.T) {
+	settlements := []model.Settlement{
+		{ID: 1, FishermanID: 2, NetAmount: 100000, Status: model.SettlementStatusSettled},
+		{ID: 2, FishermanID: 1, NetAmount: 98000, Status: model.SettlementStatusSettled},
+		{ID: 3, FishermanID: 2, NetAmount: 23456, Status: model.SettlementStatusSettled},
+	}
+	fishermen := map[int]*model.Fisherman{
+		1: {ID: 1, BankAccount: &model.BankAccount{BankCode: "0009", BranchCode: "010", AccountType: model.BankAccountTypeCurrent, AccountNumber: "42", HolderKana: "ｷﾞﾖｷﾞﾖｳｸﾐｱｲ"}},
+		2: {ID: 2, BankAccount: &model.BankAccount{BankCode: "0005", BranchCode: "201", AccountType: model.BankAccountTypeOrdinary, AccountNumber: "7654321", HolderKana: "ﾔﾏﾀﾞ ﾀﾛｳ"}},
+	}
+	settlementRepo, fishermanRepo := newExportRepos(settlements, fishermen)
+
+	uc := newExportUseCase(settlementRepo, fishermanRepo)
+	got, err := uc.Execute(context.Background(), exportInput(1, 2, 3, 1))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	path := filepath.Join("testdata", "transfer_file.golden")
+	if *updateGolden {
+		if err := os.MkdirAll("testdata", 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path) // #nosec G304 -- test fixture path
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("transfer file does not match golden file\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestExportTransferFileUseCase_Execute_Errors(t *testing.T) {
+	withAccount := &model.Fisherman{ID: 1, BankAccount: &model.BankAccount{BankCode: "0005", BranchCode: "201", AccountType: model.BankAccountTypeOrdinary, AccountNumber: "7654321", HolderKana: "ﾔﾏﾀﾞ ﾀﾛｳ"}}
+
+	tests := []struct {
+		name        string
+		ids         []int
+		settlements []model.Settlement
+		fishermen   map[int]*model.Fisherman
+		wantErr     error
+	}{
+		{
+			name:    "NoSettlements",
+			wantErr: &domainErrors.ValidationError{},
+		},
+		{
+			name:        "MissingSettlement",
+			ids:         []int{1, 2},
+			settlements: []model.Settlement{{ID: 1, FishermanID: 1, NetAmount: 100, Status: model.SettlementStatusSettled}},
+			fishermen:   map[int]*model.Fisherman{1: withAccount},
+			wantErr:     &domainErrors.NotFoundError{},
+		},
+		{
+			name:        "NotSettled",
+			ids:         []int{1},
+			settlements: []model.Settlement{{ID: 1, FishermanID: 1, NetAmount: 100, Status: model.SettlementStatusDraft}},
+			fishermen:   map[int]*model.Fisherman{1: withAccount},
+			wantErr:     &domainErrors.ConflictError{},
+		},
+		{
+			name:        "AlreadyExported",
+			ids:         []int{1},
+			settlements: []model.Settlement{{ID: 1, FishermanID: 1, NetAmount: 100, Status: model.SettlementStatusSettled, ExportedAt: &exportNow}},
+			fishermen:   map[int]*model.Fisherman{1: withAccount},
+			wantErr:     &domainErrors.ConflictError{},
+		},
+		{
+			name:        "NoBankAccount",
+			ids:         []int{1},
+			settlements: []model.Settlement{{ID: 1, FishermanID: 3, NetAmount: 100, Status: model.SettlementStatusSettled}},
+			fishermen:   map[int]*model.Fisherman{3: {ID: 3}},
+			wantErr:     &domainErrors.ValidationError{},
+		},
+		{
+			name:        "NothingToPay",
+			ids:         []int{1},
+			settlements: []model.Settlement{{ID: 1, FishermanID: 1, NetAmount: 0, Status: model.SettlementStatusSettled}},
+			fishermen:   map[int]*model.Fisherman{1: withAccount},
+			wantErr:     &domainErrors.ValidationError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settlementRepo, fishermanRepo := newExportRepos(tt.settlements, tt.fishermen)
+			uc := newExportUseCase(settlementRepo, fishermanRepo)
+
+			_, err := uc.Execute(context.Background(), exportInput(tt.ids...))
+			if err == nil {
+				t.Fatalf("expected error %T, got nil", tt.wantErr)
+			}
+			if reflect.TypeOf(errors.Unwrap(err)) != reflect.TypeOf(tt.wantErr) && reflect.TypeOf(err) != reflect.TypeOf(tt.wantErr) {
+				t.Fatalf("expected error %T, got %T (%v)", tt.wantErr, err, err)
+			}
+		})
+	}
+}
+
+func TestExportTransferFileUseCase_Execute_SecondExport(t *testing.T) {
+	settlements := []model.Settlement{
+		{ID: 1, FishermanID: 1, NetAmount: 100000, Status: model.SettlementStatusSettled},
+		{ID: 2, FishermanID: 1, NetAmount: 5000, Status: model.SettlementStatusSettled},
+	}
+	fishermen := map[int]*model.Fisherman{
+		1: {ID: 1, BankAccount: &model.BankAccount{BankCode: "0005", BranchCode: "201", AccountType: model.BankAccountTypeOrdinary, AccountNumber: "7654321", HolderKana: "ﾔﾏﾀﾞ ﾀﾛｳ"}},
+	}
+	settlementRepo, fishermanRepo := newExportRepos(settlements, fishermen)
+	uc := newExportUseCase(settlementRepo, fishermanRepo)
+
+	first, err := uc.Execute(context.Background(), exportInput(1))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if settlements[0].ExportedAt == nil || !settlements[0].ExportedAt.Equal(exportNow) {
+		t.Fatalf("expected the export to be recorded, got %v", settlements[0].ExportedAt)
+	}
+
+	// 書き出し済みの仕切書を含む限り、他の仕切書と一緒でも書き出さない
+	_, err = uc.Execute(context.Background(), exportInput(2, 1))
+	var conflictErr *domainErrors.ConflictError
+	if !errors.As(err, &conflictErr) {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+
+	input := exportInput(1)
+	input.Reissue = true
+	reissued, err := uc.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("expected a deliberate reissue to succeed, got %v", err)
+	}
+	if !bytes.Equal(first, reissued) {
+		t.Error("expected the reissued file to match the first one")
+	}
+}
+
+func TestListSettlementsUseCase_Execute(t *testing.T) {
+	repo := &mock.MockSettlementRepository{
+		ListByAuctionIDFunc: func(_ context.Context, auctionID int) ([]model.Settlement, error) {
+			return []model.Settlement{{ID: 1, AuctionID: auctionID}}, nil
+		},
+	}
+
+	got, err := settlement.NewListSettlementsUseCase(repo).Execute(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got) != 1 || got[0].AuctionID != 7 {
+		t.Errorf("unexpected settlements: %+v", got)
+	}
+}
@@ -0,0 +1,154 @@
+package settlement
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// GenerateSettlementsUseCase defines the interface for generating draft fisherman settlements of an auction.
+type GenerateSettlementsUseCase interface {
+	// Execute (re)generates draft settlements for every fisherman whose lots were sold.
+	Execute(ctx context.Context, auctionID, commissionRate int) ([]model.Settlement, error)
+}
+
+type generateSettlementsUseCase struct {
+	auctionRepo    repository.AuctionRepository
+	bidRepo        repository.BidRepository
+	settlementRepo repository.SettlementRepository
+	adjustmentRepo repository.SettlementAdjustmentRepository
+	txMgr          repository.TransactionManager
+}
+
+var _ GenerateSettlementsUseCase = (*generateSettlementsUseCase)(nil)
+
+// NewGenerateSettlementsUseCase creates a new GenerateSettlementsUseCase instance.
+func NewGenerateSettlementsUseCase(
+	auctionRepo repository.AuctionRepository,
+	bidRepo repository.BidRepository,
+	settlementRepo repository.SettlementRepository,
+	adjustmentRepo repository.SettlementAdjustmentRepository,
+	txMgr repository.TransactionManager,
+) GenerateSettlementsUseCase {
+	return &generateSettlementsUseCase{
+		auctionRepo:    auctionRepo,
+		bidRepo:        bidRepo,
+		settlementRepo: settlementRepo,
+		adjustmentRepo: adjustmentRepo,
+		txMgr:          txMgr,
+	}
+}
+
+// Execute (re)generates draft settlements for the auction.
+// 確定済み（settled）の仕切書がある漁業者はスキップし、draft のみを作り直す。
+// 未適用のクレーム控除は控除行として取り込む（削除された draft に紐づいていた分も未適用に戻っている）。
+func (uc *generateSettlementsUseCase) Execute(ctx context.Context, auctionID, commissionRate int) ([]model.Settlement, error) {
+	if commissionRate < 0 || commissionRate > 100 {
+		return nil, &apperrors.ValidationError{Field: "commission_rate", Message: "must be between 0 and 100"}
+	}
+
+	var created []model.Settlement
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		auction, err := uc.auctionRepo.FindByIDWithLock(txCtx, auctionID)
+		if err != nil {
+			return err
+		}
+		if auction.Status != model.AuctionStatusCompleted {
+			return &apperrors.ConflictError{Message: "settlements can only be generated for a completed auction"}
+		}
+
+		existing, err := uc.settlementRepo.ListByAuctionID(txCtx, auctionID)
+		if err != nil {
+			return fmt.Errorf("failed to list settlements: %w", err)
+		}
+		finalized := make(map[int]bool)
+		for _, s := range existing {
+			if s.Status != model.SettlementStatusDraft {
+				finalized[s.FishermanID] = true
+			}
+		}
+
+		if err := uc.settlementRepo.DeleteDraftsByAuctionID(txCtx, auctionID); err != nil {
+			return fmt.Errorf("failed to delete draft settlements: %w", err)
+		}
+
+		awards, err := uc.bidRepo.ListAwardsByAuctionID(txCtx, auctionID)
+		if err != nil {
+			return fmt.Errorf("failed to list awards: %w", err)
+		}
+
+		var drafts []*model.Settlement
+		var fishermanIDs []int
+		for _, s := range buildDraftSettlements(auctionID, commissionRate, awards) {
+			if finalized[s.FishermanID] {
+				continue
+			}
+			drafts = append(drafts, s)
+			fishermanIDs = append(fishermanIDs, s.FishermanID)
+		}
+		if len(drafts) == 0 {
+			return nil
+		}
+
+		adjustments, err := uc.adjustmentRepo.ListPendingByFishermanIDs(txCtx, fishermanIDs)
+		if err != nil {
+			return fmt.Errorf("failed to list settlement adjustments: %w", err)
+		}
+
+		for _, s := range drafts {
+			applied := s.ApplyAdjustments(adjustments)
+			saved, err := uc.settlementRepo.Create(txCtx, s)
+			if err != nil {
+				return fmt.Errorf("failed to create settlement: %w", err)
+			}
+			if len(applied) > 0 {
+				if err := uc.adjustmentRepo.AssignToSettlement(txCtx, applied, saved.ID); err != nil {
+					return fmt.Errorf("failed to assign settlement adjustments: %w", err)
+				}
+			}
+			created = append(created, *saved)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// buildDraftSettlements groups awarded items by fisherman into draft settlements ordered by fisherman ID.
+func buildDraftSettlements(auctionID, commissionRate int, awards []model.Purchase) []*model.Settlement {
+	byFisherman := make(map[int]*model.Settlement)
+	for _, a := range awards {
+		s, ok := byFisherman[a.FishermanID]
+		if !ok {
+			s = &model.Settlement{
+				FishermanID:    a.FishermanID,
+				AuctionID:      auctionID,
+				CommissionRate: commissionRate,
+				Status:         model.SettlementStatusDraft,
+			}
+			byFisherman[a.FishermanID] = s
+		}
+		itemID := a.ItemID
+		s.Lines = append(s.Lines, model.SettlementLine{
+			ItemID:      &itemID,
+			Description: a.FishType,
+			Quantity:    a.Quantity,
+			Unit:        a.Unit,
+			Amount:      a.Price,
+		})
+	}
+
+	settlements := make([]*model.Settlement, 0, len(byFisherman))
+	for _, s := range byFisherman {
+		s.Recalculate()
+		settlements = append(settlements, s)
+	}
+	sort.Slice(settlements, func(i, j int) bool { return settlements[i].FishermanID < settlements[j].FishermanID })
+	return settlements
+}
@@ -0,0 +1,183 @@
+package settlement_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/settlement"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestGenerateSettlementsUseCase_Execute(t *testing.T) {
+	awards := []model.Purchase{
+		{ItemID: 1, FishType: "Tuna", Quantity: 1, Unit: "kg", Price: 10000, BuyerID: 1, FishermanID: 5},
+		{ItemID: 2, FishType: "Mackerel", Quantity: 3, Unit: "box", Price: 3000, BuyerID: 2, FishermanID: 4},
+		{ItemID: 3, FishType: "Squid", Quantity: 2, Unit: "box", Price: 2000, BuyerID: 2, FishermanID: 5},
+	}
+	dbErr := errors.New("db error")
+
+	tests := []struct {
+		name             string
+		rate             int
+		auction          *model.Auction
+		existing         []model.Settlement
+		awardsErr        error
+		wantFishermanIDs []int
+		wantNet          []int
+		wantErr          error
+	}{
+		{
+			name:             "Success",
+			rate:             5,
+			auction:          &model.Auction{ID: 1, Status: model.AuctionStatusCompleted},
+			wantFishermanIDs: []int{4, 5},
+			// 3000 - 150 - 15 / 12000 - 600 - 60
+			wantNet: []int{2835, 11340},
+		},
+		{
+			name:    "SkipsSettledFishermen",
+			rate:    5,
+			auction: &model.Auction{ID: 1, Status: model.AuctionStatusCompleted},
+			existing: []model.Settlement{
+				{ID: 9, FishermanID: 5, Status: model.SettlementStatusSettled},
+			},
+			wantFishermanIDs: []int{4},
+			wantNet:          []int{2835},
+		},
+		{
+			name:    "InvalidRate",
+			rate:    101,
+			wantErr: &domainErrors.ValidationError{},
+		},
+		{
+			name:    "AuctionNotCompleted",
+			rate:    5,
+			auction: &model.Auction{ID: 1, Status: model.AuctionStatusInProgress},
+			wantErr: &domainErrors.ConflictError{},
+		},
+		{
+			name:      "AwardsError",
+			rate:      5,
+			auction:   &model.Auction{ID: 1, Status: model.AuctionStatusCompleted},
+			awardsErr: dbErr,
+			wantErr:   dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auctionRepo := &mock.MockAuctionRepository{
+				FindByIDWithLockFunc: func(_ context.Context, _ int) (*model.Auction, error) {
+					return tt.auction, nil
+				},
+			}
+			bidRepo := &mock.MockBidRepository{
+				ListAwardsByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Purchase, error) {
+					return awards, tt.awardsErr
+				},
+			}
+			settlementRepo := &mock.MockSettlementRepository{
+				ListByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Settlement, error) {
+					return tt.existing, nil
+				},
+			}
+
+			uc := settlement.NewGenerateSettlementsUseCase(auctionRepo, bidRepo, settlementRepo, &mock.MockSettlementAdjustmentRepository{}, &mock.MockTransactionManager{})
+			got, err := uc.Execute(context.Background(), 1, tt.rate)
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatalf("expected error %T, got nil", tt.wantErr)
+				}
+				var vErr *domainErrors.ValidationError
+				var cErr *domainErrors.ConflictError
+				switch {
+				case errors.As(tt.wantErr, &vErr):
+					if !errors.As(err, &vErr) {
+						t.Fatalf("expected ValidationError, got %T", err)
+					}
+				case errors.As(tt.wantErr, &cErr):
+					if !errors.As(err, &cErr) {
+						t.Fatalf("expected ConflictError, got %T", err)
+					}
+				default:
+					if !errors.Is(err, tt.wantErr) {
+						t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(got) != len(tt.wantFishermanIDs) {
+				t.Fatalf("expected %d settlements, got %d", len(tt.wantFishermanIDs), len(got))
+			}
+			for i, s := range got {
+				if s.FishermanID != tt.wantFishermanIDs[i] {
+					t.Errorf("settlement %d: expected fisherman %d, got %d", i, tt.wantFishermanIDs[i], s.FishermanID)
+				}
+				if s.NetAmount != tt.wantNet[i] {
+					t.Errorf("settlement %d: expected net %d, got %d", i, tt.wantNet[i], s.NetAmount)
+				}
+				if s.Status != model.SettlementStatusDraft {
+					t.Errorf("settlement %d: expected draft, got %s", i, s.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateSettlementsUseCase_Execute_DeductsClawbacks(t *testing.T) {
+	auctionRepo := &mock.MockAuctionRepository{
+		FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Auction, error) {
+			return &model.Auction{ID: id, Status: model.AuctionStatusCompleted}, nil
+		},
+	}
+	bidRepo := &mock.MockBidRepository{
+		ListAwardsByAuctionIDFunc: func(_ context.Context, _ int) ([]model.Purchase, error) {
+			return []model.Purchase{{ItemID: 1, FishType: "Tuna", Price: 10000, FishermanID: 5}}, nil
+		},
+	}
+	settlementRepo := &mock.MockSettlementRepository{
+		CreateFunc: func(_ context.Context, s *model.Settlement) (*model.Settlement, error) {
+			created := *s
+			created.ID = 70
+			return &created, nil
+		},
+	}
+	var assigned []int
+	var assignedTo int
+	adjustmentRepo := &mock.MockSettlementAdjustmentRepository{
+		ListPendingByFishermanIDsFunc: func(_ context.Context, ids []int) ([]model.SettlementAdjustment, error) {
+			if len(ids) != 1 || ids[0] != 5 {
+				t.Errorf("unexpected fisherman IDs: %v", ids)
+			}
+			return []model.SettlementAdjustment{{ID: 3, FishermanID: 5, Amount: 2000, Description: "クレーム控除 Tuna"}}, nil
+		},
+		AssignToSettlementFunc: func(_ context.Context, ids []int, settlementID int) error {
+			assigned = ids
+			assignedTo = settlementID
+			return nil
+		},
+	}
+
+	uc := settlement.NewGenerateSettlementsUseCase(auctionRepo, bidRepo, settlementRepo, adjustmentRepo, &mock.MockTransactionManager{})
+	got, err := uc.Execute(context.Background(), 1, 5)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(got) != 1 || len(got[0].Lines) != 2 {
+		t.Fatalf("expected one settlement with a deduction line, got %+v", got)
+	}
+	// 8000 - 400 - 40
+	if got[0].GrossAmount != 8000 || got[0].NetAmount != 7560 {
+		t.Errorf("unexpected amounts: gross %d, net %d", got[0].GrossAmount, got[0].NetAmount)
+	}
+	if len(assigned) != 1 || assigned[0] != 3 || assignedTo != 70 {
+		t.Errorf("expected adjustment 3 assigned to 70, got %v to %d", assigned, assignedTo)
+	}
+}
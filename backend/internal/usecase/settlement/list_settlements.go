@@ -0,0 +1,29 @@
+package settlement
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// ListSettlementsUseCase defines the interface for listing the settlements of an auction.
+type ListSettlementsUseCase interface {
+	Execute(ctx context.Context, auctionID int) ([]model.Settlement, error)
+}
+
+type listSettlementsUseCase struct {
+	settlementRepo repository.SettlementRepository
+}
+
+var _ ListSettlementsUseCase = (*listSettlementsUseCase)(nil)
+
+// NewListSettlementsUseCase creates a new ListSettlementsUseCase instance.
+func NewListSettlementsUseCase(settlementRepo repository.SettlementRepository) ListSettlementsUseCase {
+	return &listSettlementsUseCase{settlementRepo: settlementRepo}
+}
+
+// Execute returns the settlements generated for the auction.
+func (uc *listSettlementsUseCase) Execute(ctx context.Context, auctionID int) ([]model.Settlement, error) {
+	return uc.settlementRepo.ListByAuctionID(ctx, auctionID)
+}
@@ -0,0 +1,59 @@
+package settlement
+
+import (
+	"context"
+
+	apperrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+	"github.com/seka/fish-auction/backend/internal/domain/service"
+)
+
+// SettleStatementUseCase defines the interface for finalizing a draft settlement.
+type SettleStatementUseCase interface {
+	Execute(ctx context.Context, id int) (*model.Settlement, error)
+}
+
+type settleStatementUseCase struct {
+	settlementRepo repository.SettlementRepository
+	txMgr          repository.TransactionManager
+	clock          service.Clock
+}
+
+var _ SettleStatementUseCase = (*settleStatementUseCase)(nil)
+
+// NewSettleStatementUseCase creates a new SettleStatementUseCase instance.
+func NewSettleStatementUseCase(
+	settlementRepo repository.SettlementRepository,
+	txMgr repository.TransactionManager,
+	clock service.Clock,
+) SettleStatementUseCase {
+	return &settleStatementUseCase{
+		settlementRepo: settlementRepo,
+		txMgr:          txMgr,
+		clock:          clock,
+	}
+}
+
+// Execute finalizes a draft settlement so that it can be paid out.
+func (uc *settleStatementUseCase) Execute(ctx context.Context, id int) (*model.Settlement, error) {
+	var settled *model.Settlement
+	err := uc.txMgr.WithTransaction(ctx, func(txCtx context.Context) error {
+		s, err := uc.settlementRepo.FindByIDWithLock(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if !s.Settle(uc.clock.Now()) {
+			return &apperrors.ConflictError{Message: "only draft settlements can be settled"}
+		}
+		if err := uc.settlementRepo.Update(txCtx, s); err != nil {
+			return err
+		}
+		settled = s
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return settled, nil
+}
@@ -0,0 +1,62 @@
+package settlement_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	domainErrors "github.com/seka/fish-auction/backend/internal/domain/errors"
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/usecase/settlement"
+	mock "github.com/seka/fish-auction/backend/internal/usecase/testing"
+)
+
+func TestSettleStatementUseCase_Execute(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		status      model.SettlementStatus
+		wantUpdated bool
+		wantErr     bool
+	}{
+		{name: "Success", status: model.SettlementStatusDraft, wantUpdated: true},
+		{name: "AlreadySettled", status: model.SettlementStatusSettled, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			repo := &mock.MockSettlementRepository{
+				FindByIDWithLockFunc: func(_ context.Context, id int) (*model.Settlement, error) {
+					return &model.Settlement{ID: id, Status: tt.status}, nil
+				},
+				UpdateFunc: func(_ context.Context, _ *model.Settlement) error {
+					updated = true
+					return nil
+				},
+			}
+
+			uc := settlement.NewSettleStatementUseCase(repo, &mock.MockTransactionManager{}, mock.NewMockClock(now))
+			got, err := uc.Execute(context.Background(), 1)
+
+			if updated != tt.wantUpdated {
+				t.Errorf("expected updated=%v, got %v", tt.wantUpdated, updated)
+			}
+			if tt.wantErr {
+				var conflictErr *domainErrors.ConflictError
+				if !errors.As(err, &conflictErr) {
+					t.Fatalf("expected ConflictError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got.Status != model.SettlementStatusSettled || !got.SettledAt.Equal(now) {
+				t.Errorf("unexpected settlement state: %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockAccountingSettingsRepository is a mock implementation of repository.AccountingSettingsRepository
+type MockAccountingSettingsRepository struct {
+	FindByVenueIDFunc func(ctx context.Context, venueID int) (*model.AccountingSettings, error)
+	UpsertFunc        func(ctx context.Context, settings *model.AccountingSettings) (*model.AccountingSettings, error)
+}
+
+var _ repository.AccountingSettingsRepository = (*MockAccountingSettingsRepository)(nil)
+
+// FindByVenueID retrieves a record based on criteria.
+func (m *MockAccountingSettingsRepository) FindByVenueID(ctx context.Context, venueID int) (*model.AccountingSettings, error) {
+	if m.FindByVenueIDFunc != nil {
+		return m.FindByVenueIDFunc(ctx, venueID)
+	}
+	return nil, nil
+}
+
+// Upsert creates or updates a record.
+func (m *MockAccountingSettingsRepository) Upsert(ctx context.Context, settings *model.AccountingSettings) (*model.AccountingSettings, error) {
+	if m.UpsertFunc != nil {
+		return m.UpsertFunc(ctx, settings)
+	}
+	return settings, nil
+}
@@ -0,0 +1,87 @@
+package testing
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockAdminTwoFactorRepository is a mock implementation of repository.AdminTwoFactorRepository
+type MockAdminTwoFactorRepository struct {
+	FindByAdminIDFunc            func(ctx context.Context, adminID int) (*model.AdminTwoFactor, error)
+	SavePendingFunc              func(ctx context.Context, adminID int, secretEncrypted string) error
+	EnableFunc                   func(ctx context.Context, adminID int, enabledAt time.Time, step int64) error
+	AdvanceLastUsedStepFunc      func(ctx context.Context, adminID int, step int64) (bool, error)
+	DeleteFunc                   func(ctx context.Context, adminID int) error
+	ReplaceRecoveryCodesFunc     func(ctx context.Context, adminID int, codeHashes []string) error
+	UseRecoveryCodeFunc          func(ctx context.Context, adminID int, codeHash string, usedAt time.Time) (bool, error)
+	CountUnusedRecoveryCodesFunc func(ctx context.Context, adminID int) (int, error)
+}
+
+var _ repository.AdminTwoFactorRepository = (*MockAdminTwoFactorRepository)(nil)
+
+// FindByAdminID retrieves a record based on criteria.
+func (m *MockAdminTwoFactorRepository) FindByAdminID(ctx context.Context, adminID int) (*model.AdminTwoFactor, error) {
+	if m.FindByAdminIDFunc != nil {
+		return m.FindByAdminIDFunc(ctx, adminID)
+	}
+	return nil, nil
+}
+
+// SavePending creates or replaces a pending record.
+func (m *MockAdminTwoFactorRepository) SavePending(ctx context.Context, adminID int, secretEncrypted string) error {
+	if m.SavePendingFunc != nil {
+		return m.SavePendingFunc(ctx, adminID, secretEncrypted)
+	}
+	return nil
+}
+
+// Enable updates a record.
+func (m *MockAdminTwoFactorRepository) Enable(ctx context.Context, adminID int, enabledAt time.Time, step int64) error {
+	if m.EnableFunc != nil {
+		return m.EnableFunc(ctx, adminID, enabledAt, step)
+	}
+	return nil
+}
+
+// AdvanceLastUsedStep updates a record.
+func (m *MockAdminTwoFactorRepository) AdvanceLastUsedStep(ctx context.Context, adminID int, step int64) (bool, error) {
+	if m.AdvanceLastUsedStepFunc != nil {
+		return m.AdvanceLastUsedStepFunc(ctx, adminID, step)
+	}
+	return true, nil
+}
+
+// Delete deletes a record.
+func (m *MockAdminTwoFactorRepository) Delete(ctx context.Context, adminID int) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, adminID)
+	}
+	return nil
+}
+
+// ReplaceRecoveryCodes replaces records.
+func (m *MockAdminTwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, adminID int, codeHashes []string) error {
+	if m.ReplaceRecoveryCodesFunc != nil {
+		return m.ReplaceRecoveryCodesFunc(ctx, adminID, codeHashes)
+	}
+	return nil
+}
+
+// UseRecoveryCode updates a record.
+func (m *MockAdminTwoFactorRepository) UseRecoveryCode(ctx context.Context, adminID int, codeHash string, usedAt time.Time) (bool, error) {
+	if m.UseRecoveryCodeFunc != nil {
+		return m.UseRecoveryCodeFunc(ctx, adminID, codeHash, usedAt)
+	}
+	return false, nil
+}
+
+// CountUnusedRecoveryCodes counts records.
+func (m *MockAdminTwoFactorRepository) CountUnusedRecoveryCodes(ctx context.Context, adminID int) (int, error) {
+	if m.CountUnusedRecoveryCodesFunc != nil {
+		return m.CountUnusedRecoveryCodesFunc(ctx, adminID)
+	}
+	return 0, nil
+}
@@ -16,7 +16,11 @@ type MockAuthenticationRepository struct {
 	IncrementFailedAttemptsFunc func(ctx context.Context, id int) (int, error)
 	ResetFailedAttemptsFunc     func(ctx context.Context, id int) error
 	LockAccountFunc             func(ctx context.Context, id int, until time.Time) error
-	UpdatePasswordFunc          func(ctx context.Context, buyerID int, passwordHash string) error
+	UpdatePasswordFunc          func(ctx context.Context, id int, passwordHash string) error
+	UpdateEmailFunc             func(ctx context.Context, id int, email string) error
+	FindByIDFunc                func(ctx context.Context, id int) (*model.Authentication, error)
+	ListByBuyerIDFunc           func(ctx context.Context, buyerID int) ([]model.Authentication, error)
+	UpdateLoginFunc             func(ctx context.Context, auth *model.Authentication) error
 }
 
 // Create creates a new record.
@@ -55,6 +59,38 @@ func (m *MockAuthenticationRepository) LockAccount(ctx context.Context, id int,
 }
 
 // UpdatePassword updates an existing record.
-func (m *MockAuthenticationRepository) UpdatePassword(ctx context.Context, buyerID int, passwordHash string) error {
-	return m.UpdatePasswordFunc(ctx, buyerID, passwordHash)
+func (m *MockAuthenticationRepository) UpdatePassword(ctx context.Context, id int, passwordHash string) error {
+	return m.UpdatePasswordFunc(ctx, id, passwordHash)
+}
+
+// UpdateEmail updates the login email.
+func (m *MockAuthenticationRepository) UpdateEmail(ctx context.Context, id int, email string) error {
+	if m.UpdateEmailFunc != nil {
+		return m.UpdateEmailFunc(ctx, id, email)
+	}
+	return nil
+}
+
+// FindByID retrieves a record based on criteria.
+func (m *MockAuthenticationRepository) FindByID(ctx context.Context, id int) (*model.Authentication, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// ListByBuyerID retrieves a list of records.
+func (m *MockAuthenticationRepository) ListByBuyerID(ctx context.Context, buyerID int) ([]model.Authentication, error) {
+	if m.ListByBuyerIDFunc != nil {
+		return m.ListByBuyerIDFunc(ctx, buyerID)
+	}
+	return nil, nil
+}
+
+// UpdateLogin updates an existing record.
+func (m *MockAuthenticationRepository) UpdateLogin(ctx context.Context, auth *model.Authentication) error {
+	if m.UpdateLoginFunc != nil {
+		return m.UpdateLoginFunc(ctx, auth)
+	}
+	return nil
 }
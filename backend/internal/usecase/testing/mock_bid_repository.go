@@ -10,8 +10,15 @@ import (
 type MockBidRepository struct {
 	CreateFunc                 func(ctx context.Context, bid *model.Bid) (*model.Bid, error)
 	ListInvoicesFunc           func(ctx context.Context) ([]model.InvoiceItem, error)
+	FindPurchaseByIDFunc       func(ctx context.Context, id int) (*model.Purchase, error)
 	ListPurchasesByBuyerIDFunc func(ctx context.Context, buyerID int) ([]model.Purchase, error)
 	ListAuctionsByBuyerIDFunc  func(ctx context.Context, buyerID int) ([]model.Auction, error)
+	ListAwardsByAuctionIDFunc  func(ctx context.Context, auctionID int) ([]model.Purchase, error)
+	UpdateAwardFunc            func(ctx context.Context, id, buyerID, price int) error
+	ListHistoryByItemIDFunc    func(ctx context.Context, itemID, beforeID, limit int, includeVoided bool) ([]model.BidHistoryEntry, error)
+	FindByIDWithLockFunc       func(ctx context.Context, id int) (*model.Bid, error)
+	VoidFunc                   func(ctx context.Context, bid *model.Bid) error
+	SumLeadingByBuyerIDFunc    func(ctx context.Context, buyerID int) (int, error)
 }
 
 // Create creates a new record.
@@ -24,6 +31,11 @@ func (m *MockBidRepository) ListInvoices(ctx context.Context) ([]model.InvoiceIt
 	return m.ListInvoicesFunc(ctx)
 }
 
+// FindPurchaseByID retrieves a record by ID.
+func (m *MockBidRepository) FindPurchaseByID(ctx context.Context, id int) (*model.Purchase, error) {
+	return m.FindPurchaseByIDFunc(ctx, id)
+}
+
 // ListPurchasesByBuyerID retrieves a list of records.
 func (m *MockBidRepository) ListPurchasesByBuyerID(ctx context.Context, buyerID int) ([]model.Purchase, error) {
 	return m.ListPurchasesByBuyerIDFunc(ctx, buyerID)
@@ -33,3 +45,33 @@ func (m *MockBidRepository) ListPurchasesByBuyerID(ctx context.Context, buyerID
 func (m *MockBidRepository) ListAuctionsByBuyerID(ctx context.Context, buyerID int) ([]model.Auction, error) {
 	return m.ListAuctionsByBuyerIDFunc(ctx, buyerID)
 }
+
+// ListAwardsByAuctionID retrieves a list of records.
+func (m *MockBidRepository) ListAwardsByAuctionID(ctx context.Context, auctionID int) ([]model.Purchase, error) {
+	return m.ListAwardsByAuctionIDFunc(ctx, auctionID)
+}
+
+// UpdateAward updates an existing record.
+func (m *MockBidRepository) UpdateAward(ctx context.Context, id, buyerID, price int) error {
+	return m.UpdateAwardFunc(ctx, id, buyerID, price)
+}
+
+// ListHistoryByItemID retrieves a list of records.
+func (m *MockBidRepository) ListHistoryByItemID(ctx context.Context, itemID, beforeID, limit int, includeVoided bool) ([]model.BidHistoryEntry, error) {
+	return m.ListHistoryByItemIDFunc(ctx, itemID, beforeID, limit, includeVoided)
+}
+
+// FindByIDWithLock retrieves a record by ID and locks it.
+func (m *MockBidRepository) FindByIDWithLock(ctx context.Context, id int) (*model.Bid, error) {
+	return m.FindByIDWithLockFunc(ctx, id)
+}
+
+// Void updates a record.
+func (m *MockBidRepository) Void(ctx context.Context, bid *model.Bid) error {
+	return m.VoidFunc(ctx, bid)
+}
+
+// SumLeadingByBuyerID aggregates records.
+func (m *MockBidRepository) SumLeadingByBuyerID(ctx context.Context, buyerID int) (int, error) {
+	return m.SumLeadingByBuyerIDFunc(ctx, buyerID)
+}
@@ -8,12 +8,18 @@ import (
 
 // MockBuyerRepository is a mock implementation of BuyerRepository
 type MockBuyerRepository struct {
-	CreateFunc      func(ctx context.Context, buyer *model.Buyer) (*model.Buyer, error)
-	ListFunc        func(ctx context.Context) ([]model.Buyer, error)
-	FindByIDFunc    func(ctx context.Context, id int) (*model.Buyer, error)
-	FindByNameFunc  func(ctx context.Context, name string) (*model.Buyer, error)
-	FindByEmailFunc func(ctx context.Context, email string) (*model.Buyer, error)
-	DeleteFunc      func(ctx context.Context, id int) error
+	CreateFunc                  func(ctx context.Context, buyer *model.Buyer) (*model.Buyer, error)
+	ListFunc                    func(ctx context.Context) ([]model.Buyer, error)
+	FindByIDFunc                func(ctx context.Context, id int) (*model.Buyer, error)
+	FindByNameFunc              func(ctx context.Context, name string) (*model.Buyer, error)
+	FindByEmailFunc             func(ctx context.Context, email string) (*model.Buyer, error)
+	FindByIDWithLockFunc        func(ctx context.Context, id int) (*model.Buyer, error)
+	UpdatePaddleNumberFunc      func(ctx context.Context, id int, paddleNumber string) error
+	UpdateCreditTermsFunc       func(ctx context.Context, id int, terms model.CreditTerms) error
+	DeleteFunc                  func(ctx context.Context, id int) error
+	UpdateFunc                  func(ctx context.Context, buyer *model.Buyer) error
+	UpdateRegistrationFunc      func(ctx context.Context, id int, reg model.BuyerRegistration) error
+	ListPendingApplicationsFunc func(ctx context.Context) ([]model.BuyerApplication, error)
 }
 
 // Create creates a new record.
@@ -41,7 +47,46 @@ func (m *MockBuyerRepository) FindByEmail(ctx context.Context, email string) (*m
 	return m.FindByEmailFunc(ctx, email)
 }
 
+// FindByIDWithLock retrieves a record based on criteria.
+func (m *MockBuyerRepository) FindByIDWithLock(ctx context.Context, id int) (*model.Buyer, error) {
+	return m.FindByIDWithLockFunc(ctx, id)
+}
+
+// UpdatePaddleNumber updates a record.
+func (m *MockBuyerRepository) UpdatePaddleNumber(ctx context.Context, id int, paddleNumber string) error {
+	return m.UpdatePaddleNumberFunc(ctx, id, paddleNumber)
+}
+
+// UpdateCreditTerms updates a record.
+func (m *MockBuyerRepository) UpdateCreditTerms(ctx context.Context, id int, terms model.CreditTerms) error {
+	return m.UpdateCreditTermsFunc(ctx, id, terms)
+}
+
 // Delete removes a record by ID.
 func (m *MockBuyerRepository) Delete(ctx context.Context, id int) error {
 	return m.DeleteFunc(ctx, id)
 }
+
+// Update updates a record.
+func (m *MockBuyerRepository) Update(ctx context.Context, buyer *model.Buyer) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, buyer)
+	}
+	return nil
+}
+
+// UpdateRegistration stores a buyer's registration review.
+func (m *MockBuyerRepository) UpdateRegistration(ctx context.Context, id int, reg model.BuyerRegistration) error {
+	if m.UpdateRegistrationFunc != nil {
+		return m.UpdateRegistrationFunc(ctx, id, reg)
+	}
+	return nil
+}
+
+// ListPendingApplications returns the applications awaiting review.
+func (m *MockBuyerRepository) ListPendingApplications(ctx context.Context) ([]model.BuyerApplication, error) {
+	if m.ListPendingApplicationsFunc != nil {
+		return m.ListPendingApplicationsFunc(ctx)
+	}
+	return nil, nil
+}
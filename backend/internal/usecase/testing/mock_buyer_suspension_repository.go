@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockBuyerSuspensionRepository is a mock implementation of repository.BuyerSuspensionRepository
+type MockBuyerSuspensionRepository struct {
+	CreateFunc   func(ctx context.Context, s *model.BuyerSuspension) (*model.BuyerSuspension, error)
+	FindByIDFunc func(ctx context.Context, id int) (*model.BuyerSuspension, error)
+	ListFunc     func(ctx context.Context, filters *repository.BuyerSuspensionFilters) ([]model.BuyerSuspension, error)
+	LiftFunc     func(ctx context.Context, s *model.BuyerSuspension) error
+}
+
+var _ repository.BuyerSuspensionRepository = (*MockBuyerSuspensionRepository)(nil)
+
+// Create creates a new record.
+func (m *MockBuyerSuspensionRepository) Create(ctx context.Context, s *model.BuyerSuspension) (*model.BuyerSuspension, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, s)
+	}
+	return s, nil
+}
+
+// FindByID retrieves a record based on criteria.
+func (m *MockBuyerSuspensionRepository) FindByID(ctx context.Context, id int) (*model.BuyerSuspension, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// List retrieves a list of records.
+// Without a stub the buyer has no suspensions.
+func (m *MockBuyerSuspensionRepository) List(ctx context.Context, filters *repository.BuyerSuspensionFilters) ([]model.BuyerSuspension, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, filters)
+	}
+	return []model.BuyerSuspension{}, nil
+}
+
+// Lift updates an existing record.
+func (m *MockBuyerSuspensionRepository) Lift(ctx context.Context, s *model.BuyerSuspension) error {
+	if m.LiftFunc != nil {
+		return m.LiftFunc(ctx, s)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockChargeItemRepository is a mock implementation of repository.ChargeItemRepository
+type MockChargeItemRepository struct {
+	CreateFunc        func(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error)
+	FindByIDFunc      func(ctx context.Context, id int) (*model.ChargeItem, error)
+	ListByVenueIDFunc func(ctx context.Context, venueID int) ([]model.ChargeItem, error)
+	UpdateFunc        func(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error)
+}
+
+var _ repository.ChargeItemRepository = (*MockChargeItemRepository)(nil)
+
+// Create creates a new record.
+func (m *MockChargeItemRepository) Create(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return item, nil
+}
+
+// FindByID retrieves a record based on criteria.
+func (m *MockChargeItemRepository) FindByID(ctx context.Context, id int) (*model.ChargeItem, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// ListByVenueID retrieves a list of records.
+func (m *MockChargeItemRepository) ListByVenueID(ctx context.Context, venueID int) ([]model.ChargeItem, error) {
+	if m.ListByVenueIDFunc != nil {
+		return m.ListByVenueIDFunc(ctx, venueID)
+	}
+	return nil, nil
+}
+
+// Update updates an existing record.
+func (m *MockChargeItemRepository) Update(ctx context.Context, item *model.ChargeItem) (*model.ChargeItem, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, item)
+	}
+	return item, nil
+}
+
+// MockBuyerChargeRepository is a mock implementation of repository.BuyerChargeRepository
+type MockBuyerChargeRepository struct {
+	CreateFunc          func(ctx context.Context, charge *model.BuyerCharge) (*model.BuyerCharge, error)
+	FindByIDFunc        func(ctx context.Context, id int) (*model.BuyerCharge, error)
+	ListByAuctionIDFunc func(ctx context.Context, auctionID int) ([]model.BuyerCharge, error)
+	DeleteFunc          func(ctx context.Context, id int) error
+}
+
+var _ repository.BuyerChargeRepository = (*MockBuyerChargeRepository)(nil)
+
+// Create creates a new record.
+func (m *MockBuyerChargeRepository) Create(ctx context.Context, charge *model.BuyerCharge) (*model.BuyerCharge, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, charge)
+	}
+	return charge, nil
+}
+
+// FindByID retrieves a record based on criteria.
+func (m *MockBuyerChargeRepository) FindByID(ctx context.Context, id int) (*model.BuyerCharge, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// ListByAuctionID retrieves a list of records.
+func (m *MockBuyerChargeRepository) ListByAuctionID(ctx context.Context, auctionID int) ([]model.BuyerCharge, error) {
+	if m.ListByAuctionIDFunc != nil {
+		return m.ListByAuctionIDFunc(ctx, auctionID)
+	}
+	return nil, nil
+}
+
+// Delete removes a record.
+func (m *MockBuyerChargeRepository) Delete(ctx context.Context, id int) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package testing
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockClaimRepository is a mock implementation of repository.ClaimRepository
+type MockClaimRepository struct {
+	CreateFunc           func(ctx context.Context, claim *model.Claim) (*model.Claim, error)
+	FindByIDFunc         func(ctx context.Context, id int) (*model.Claim, error)
+	FindByIDWithLockFunc func(ctx context.Context, id int) (*model.Claim, error)
+	ListFunc             func(ctx context.Context, filters *repository.ClaimFilters) ([]model.Claim, error)
+	UpdateFunc           func(ctx context.Context, claim *model.Claim) error
+	CreateEventFunc      func(ctx context.Context, event *model.ClaimEvent) error
+	ListEventsFunc       func(ctx context.Context, claimID int) ([]model.ClaimEvent, error)
+}
+
+var _ repository.ClaimRepository = (*MockClaimRepository)(nil)
+
+// Create creates a new record.
+func (m *MockClaimRepository) Create(ctx context.Context, claim *model.Claim) (*model.Claim, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, claim)
+	}
+	return claim, nil
+}
+
+// FindByID retrieves a record based on criteria.
+func (m *MockClaimRepository) FindByID(ctx context.Context, id int) (*model.Claim, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// FindByIDWithLock retrieves a record based on criteria with a lock.
+func (m *MockClaimRepository) FindByIDWithLock(ctx context.Context, id int) (*model.Claim, error) {
+	if m.FindByIDWithLockFunc != nil {
+		return m.FindByIDWithLockFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// List retrieves a list of records.
+func (m *MockClaimRepository) List(ctx context.Context, filters *repository.ClaimFilters) ([]model.Claim, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, filters)
+	}
+	return nil, nil
+}
+
+// Update updates an existing record.
+func (m *MockClaimRepository) Update(ctx context.Context, claim *model.Claim) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, claim)
+	}
+	return nil
+}
+
+// CreateEvent creates a new record.
+func (m *MockClaimRepository) CreateEvent(ctx context.Context, event *model.ClaimEvent) error {
+	if m.CreateEventFunc != nil {
+		return m.CreateEventFunc(ctx, event)
+	}
+	return nil
+}
+
+// ListEvents retrieves a list of records.
+func (m *MockClaimRepository) ListEvents(ctx context.Context, claimID int) ([]model.ClaimEvent, error) {
+	if m.ListEventsFunc != nil {
+		return m.ListEventsFunc(ctx, claimID)
+	}
+	return nil, nil
+}
+
+// MockCreditNoteRepository is a mock implementation of repository.CreditNoteRepository
+type MockCreditNoteRepository struct {
+	CreateFunc             func(ctx context.Context, note *model.CreditNote) (*model.CreditNote, error)
+	FindByClaimIDFunc      func(ctx context.Context, claimID int) (*model.CreditNote, error)
+	ListByBuyerIDFunc      func(ctx context.Context, buyerID int) ([]model.CreditNote, error)
+	ListByVenueBetweenFunc func(ctx context.Context, venueID int, start, end time.Time) ([]model.CreditNote, error)
+}
+
+var _ repository.CreditNoteRepository = (*MockCreditNoteRepository)(nil)
+
+// Create creates a new record.
+func (m *MockCreditNoteRepository) Create(ctx context.Context, note *model.CreditNote) (*model.CreditNote, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, note)
+	}
+	return note, nil
+}
+
+// FindByClaimID retrieves a record based on criteria.
+func (m *MockCreditNoteRepository) FindByClaimID(ctx context.Context, claimID int) (*model.CreditNote, error) {
+	if m.FindByClaimIDFunc != nil {
+		return m.FindByClaimIDFunc(ctx, claimID)
+	}
+	return nil, nil
+}
+
+// ListByBuyerID retrieves a list of records.
+func (m *MockCreditNoteRepository) ListByBuyerID(ctx context.Context, buyerID int) ([]model.CreditNote, error) {
+	if m.ListByBuyerIDFunc != nil {
+		return m.ListByBuyerIDFunc(ctx, buyerID)
+	}
+	return nil, nil
+}
+
+// ListByVenueBetween retrieves a list of records.
+func (m *MockCreditNoteRepository) ListByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.CreditNote, error) {
+	if m.ListByVenueBetweenFunc != nil {
+		return m.ListByVenueBetweenFunc(ctx, venueID, start, end)
+	}
+	return nil, nil
+}
+
+// MockSettlementAdjustmentRepository is a mock implementation of repository.SettlementAdjustmentRepository
+type MockSettlementAdjustmentRepository struct {
+	CreateFunc                    func(ctx context.Context, adjustment *model.SettlementAdjustment) (*model.SettlementAdjustment, error)
+	ListPendingByFishermanIDsFunc func(ctx context.Context, fishermanIDs []int) ([]model.SettlementAdjustment, error)
+	AssignToSettlementFunc        func(ctx context.Context, ids []int, settlementID int) error
+}
+
+var _ repository.SettlementAdjustmentRepository = (*MockSettlementAdjustmentRepository)(nil)
+
+// Create creates a new record.
+func (m *MockSettlementAdjustmentRepository) Create(ctx context.Context, adjustment *model.SettlementAdjustment) (*model.SettlementAdjustment, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, adjustment)
+	}
+	return adjustment, nil
+}
+
+// ListPendingByFishermanIDs retrieves a list of records.
+func (m *MockSettlementAdjustmentRepository) ListPendingByFishermanIDs(ctx context.Context, fishermanIDs []int) ([]model.SettlementAdjustment, error) {
+	if m.ListPendingByFishermanIDsFunc != nil {
+		return m.ListPendingByFishermanIDsFunc(ctx, fishermanIDs)
+	}
+	return nil, nil
+}
+
+// AssignToSettlement updates an existing record.
+func (m *MockSettlementAdjustmentRepository) AssignToSettlement(ctx context.Context, ids []int, settlementID int) error {
+	if m.AssignToSettlementFunc != nil {
+		return m.AssignToSettlementFunc(ctx, ids, settlementID)
+	}
+	return nil
+}
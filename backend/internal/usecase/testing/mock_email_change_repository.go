@@ -0,0 +1,41 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockEmailChangeRepository is a mock implementation of repository.EmailChangeRepository
+type MockEmailChangeRepository struct {
+	CreateFunc             func(ctx context.Context, token *model.EmailChangeToken) error
+	FindByTokenHashFunc    func(ctx context.Context, tokenHash string) (*model.EmailChangeToken, error)
+	DeleteAllByBuyerIDFunc func(ctx context.Context, buyerID int) error
+}
+
+var _ repository.EmailChangeRepository = (*MockEmailChangeRepository)(nil)
+
+// Create creates a new record.
+func (m *MockEmailChangeRepository) Create(ctx context.Context, token *model.EmailChangeToken) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, token)
+	}
+	return nil
+}
+
+// FindByTokenHash retrieves a record based on criteria.
+func (m *MockEmailChangeRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*model.EmailChangeToken, error) {
+	if m.FindByTokenHashFunc != nil {
+		return m.FindByTokenHashFunc(ctx, tokenHash)
+	}
+	return nil, nil
+}
+
+// DeleteAllByBuyerID deletes records.
+func (m *MockEmailChangeRepository) DeleteAllByBuyerID(ctx context.Context, buyerID int) error {
+	if m.DeleteAllByBuyerIDFunc != nil {
+		return m.DeleteAllByBuyerIDFunc(ctx, buyerID)
+	}
+	return nil
+}
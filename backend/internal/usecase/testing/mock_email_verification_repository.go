@@ -0,0 +1,41 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockEmailVerificationRepository is a mock implementation of repository.EmailVerificationRepository
+type MockEmailVerificationRepository struct {
+	CreateFunc             func(ctx context.Context, token *model.EmailVerificationToken) error
+	FindByTokenHashFunc    func(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error)
+	DeleteAllByBuyerIDFunc func(ctx context.Context, buyerID int) error
+}
+
+var _ repository.EmailVerificationRepository = (*MockEmailVerificationRepository)(nil)
+
+// Create creates a new record.
+func (m *MockEmailVerificationRepository) Create(ctx context.Context, token *model.EmailVerificationToken) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, token)
+	}
+	return nil
+}
+
+// FindByTokenHash retrieves a record based on criteria.
+func (m *MockEmailVerificationRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*model.EmailVerificationToken, error) {
+	if m.FindByTokenHashFunc != nil {
+		return m.FindByTokenHashFunc(ctx, tokenHash)
+	}
+	return nil, nil
+}
+
+// DeleteAllByBuyerID deletes records.
+func (m *MockEmailVerificationRepository) DeleteAllByBuyerID(ctx context.Context, buyerID int) error {
+	if m.DeleteAllByBuyerIDFunc != nil {
+		return m.DeleteAllByBuyerIDFunc(ctx, buyerID)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package testing
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockFishermanAuthenticationRepository is a mock implementation of repository.FishermanAuthenticationRepository
+type MockFishermanAuthenticationRepository struct {
+	CreateFunc                  func(ctx context.Context, auth *model.FishermanAuthentication) (*model.FishermanAuthentication, error)
+	FindByEmailFunc             func(ctx context.Context, email string) (*model.FishermanAuthentication, error)
+	FindByFishermanIDFunc       func(ctx context.Context, fishermanID int) (*model.FishermanAuthentication, error)
+	UpdateLoginSuccessFunc      func(ctx context.Context, id int, loginAt time.Time) error
+	IncrementFailedAttemptsFunc func(ctx context.Context, id int) (int, error)
+	LockAccountFunc             func(ctx context.Context, id int, until time.Time) error
+	UpdatePasswordFunc          func(ctx context.Context, fishermanID int, passwordHash string) error
+}
+
+var _ repository.FishermanAuthenticationRepository = (*MockFishermanAuthenticationRepository)(nil)
+
+// Create creates a new record.
+func (m *MockFishermanAuthenticationRepository) Create(ctx context.Context, auth *model.FishermanAuthentication) (*model.FishermanAuthentication, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, auth)
+	}
+	return auth, nil
+}
+
+// FindByEmail retrieves a record based on criteria.
+func (m *MockFishermanAuthenticationRepository) FindByEmail(ctx context.Context, email string) (*model.FishermanAuthentication, error) {
+	if m.FindByEmailFunc != nil {
+		return m.FindByEmailFunc(ctx, email)
+	}
+	return nil, nil
+}
+
+// FindByFishermanID retrieves a record based on criteria.
+func (m *MockFishermanAuthenticationRepository) FindByFishermanID(ctx context.Context, fishermanID int) (*model.FishermanAuthentication, error) {
+	if m.FindByFishermanIDFunc != nil {
+		return m.FindByFishermanIDFunc(ctx, fishermanID)
+	}
+	return nil, nil
+}
+
+// UpdateLoginSuccess updates an existing record.
+func (m *MockFishermanAuthenticationRepository) UpdateLoginSuccess(ctx context.Context, id int, loginAt time.Time) error {
+	if m.UpdateLoginSuccessFunc != nil {
+		return m.UpdateLoginSuccessFunc(ctx, id, loginAt)
+	}
+	return nil
+}
+
+// IncrementFailedAttempts updates an existing record.
+func (m *MockFishermanAuthenticationRepository) IncrementFailedAttempts(ctx context.Context, id int) (int, error) {
+	if m.IncrementFailedAttemptsFunc != nil {
+		return m.IncrementFailedAttemptsFunc(ctx, id)
+	}
+	return 1, nil
+}
+
+// LockAccount updates an existing record.
+func (m *MockFishermanAuthenticationRepository) LockAccount(ctx context.Context, id int, until time.Time) error {
+	if m.LockAccountFunc != nil {
+		return m.LockAccountFunc(ctx, id, until)
+	}
+	return nil
+}
+
+// UpdatePassword updates an existing record.
+func (m *MockFishermanAuthenticationRepository) UpdatePassword(ctx context.Context, fishermanID int, passwordHash string) error {
+	if m.UpdatePasswordFunc != nil {
+		return m.UpdatePasswordFunc(ctx, fishermanID, passwordHash)
+	}
+	return nil
+}
@@ -12,6 +12,9 @@ type MockFishermanRepository struct {
 	ListFunc     func(ctx context.Context) ([]model.Fisherman, error)
 	FindByIDFunc func(ctx context.Context, id int) (*model.Fisherman, error)
 	DeleteFunc   func(ctx context.Context, id int) error
+
+	UpdateBankAccountFunc func(ctx context.Context, id int, account *model.BankAccount) error
+	UpdateFunc            func(ctx context.Context, fisherman *model.Fisherman) error
 }
 
 // Create creates a new record.
@@ -29,7 +32,23 @@ func (m *MockFishermanRepository) FindByID(ctx context.Context, id int) (*model.
 	return m.FindByIDFunc(ctx, id)
 }
 
+// UpdateBankAccount updates the bank account of a record.
+func (m *MockFishermanRepository) UpdateBankAccount(ctx context.Context, id int, account *model.BankAccount) error {
+	if m.UpdateBankAccountFunc != nil {
+		return m.UpdateBankAccountFunc(ctx, id, account)
+	}
+	return nil
+}
+
 // Delete removes a record by ID.
 func (m *MockFishermanRepository) Delete(ctx context.Context, id int) error {
 	return m.DeleteFunc(ctx, id)
 }
+
+// Update updates a record.
+func (m *MockFishermanRepository) Update(ctx context.Context, fisherman *model.Fisherman) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, fisherman)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockInvoiceRepository is a mock implementation of repository.InvoiceRepository
+type MockInvoiceRepository struct {
+	CreateFunc                    func(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error)
+	FindByIDFunc                  func(ctx context.Context, id int) (*model.Invoice, error)
+	FindByIDWithLockFunc          func(ctx context.Context, id int) (*model.Invoice, error)
+	ListByAuctionIDFunc           func(ctx context.Context, auctionID int) ([]model.Invoice, error)
+	ListByBuyerIDFunc             func(ctx context.Context, buyerID int) ([]model.Invoice, error)
+	ListOpenByBuyerIDWithLockFunc func(ctx context.Context, buyerID int) ([]model.Invoice, error)
+	ListOpenFunc                  func(ctx context.Context) ([]model.Invoice, error)
+	UpdateFunc                    func(ctx context.Context, invoice *model.Invoice) error
+	DeleteDraftsByAuctionIDFunc   func(ctx context.Context, auctionID int) error
+	ListIssuedByVenueBetweenFunc  func(ctx context.Context, venueID int, start, end time.Time) ([]model.Invoice, error)
+}
+
+var _ repository.InvoiceRepository = (*MockInvoiceRepository)(nil)
+
+// Create creates a new record.
+func (m *MockInvoiceRepository) Create(ctx context.Context, invoice *model.Invoice) (*model.Invoice, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, invoice)
+	}
+	return invoice, nil
+}
+
+// FindByID retrieves a record based on criteria.
+func (m *MockInvoiceRepository) FindByID(ctx context.Context, id int) (*model.Invoice, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// FindByIDWithLock retrieves a record based on criteria.
+func (m *MockInvoiceRepository) FindByIDWithLock(ctx context.Context, id int) (*model.Invoice, error) {
+	if m.FindByIDWithLockFunc != nil {
+		return m.FindByIDWithLockFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// ListByAuctionID retrieves a list of records.
+func (m *MockInvoiceRepository) ListByAuctionID(ctx context.Context, auctionID int) ([]model.Invoice, error) {
+	if m.ListByAuctionIDFunc != nil {
+		return m.ListByAuctionIDFunc(ctx, auctionID)
+	}
+	return nil, nil
+}
+
+// ListByBuyerID retrieves a list of records.
+func (m *MockInvoiceRepository) ListByBuyerID(ctx context.Context, buyerID int) ([]model.Invoice, error) {
+	if m.ListByBuyerIDFunc != nil {
+		return m.ListByBuyerIDFunc(ctx, buyerID)
+	}
+	return nil, nil
+}
+
+// ListOpenByBuyerIDWithLock retrieves a list of records.
+func (m *MockInvoiceRepository) ListOpenByBuyerIDWithLock(ctx context.Context, buyerID int) ([]model.Invoice, error) {
+	if m.ListOpenByBuyerIDWithLockFunc != nil {
+		return m.ListOpenByBuyerIDWithLockFunc(ctx, buyerID)
+	}
+	return nil, nil
+}
+
+// ListOpen retrieves a list of records.
+func (m *MockInvoiceRepository) ListOpen(ctx context.Context) ([]model.Invoice, error) {
+	if m.ListOpenFunc != nil {
+		return m.ListOpenFunc(ctx)
+	}
+	return nil, nil
+}
+
+// Update updates a record.
+func (m *MockInvoiceRepository) Update(ctx context.Context, invoice *model.Invoice) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, invoice)
+	}
+	return nil
+}
+
+// DeleteDraftsByAuctionID deletes records.
+func (m *MockInvoiceRepository) DeleteDraftsByAuctionID(ctx context.Context, auctionID int) error {
+	if m.DeleteDraftsByAuctionIDFunc != nil {
+		return m.DeleteDraftsByAuctionIDFunc(ctx, auctionID)
+	}
+	return nil
+}
+
+// ListIssuedByVenueBetween retrieves a list of records.
+func (m *MockInvoiceRepository) ListIssuedByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.Invoice, error) {
+	if m.ListIssuedByVenueBetweenFunc != nil {
+		return m.ListIssuedByVenueBetweenFunc(ctx, venueID, start, end)
+	}
+	return nil, nil
+}
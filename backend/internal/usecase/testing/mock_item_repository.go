@@ -8,15 +8,16 @@ import (
 
 // MockItemRepository is a mock implementation of ItemRepository
 type MockItemRepository struct {
-	CreateFunc           func(ctx context.Context, item *model.AuctionItem) (*model.AuctionItem, error)
-	ListFunc             func(ctx context.Context) ([]model.AuctionItem, error)
-	ListByAuctionFunc    func(ctx context.Context, auctionID int) ([]model.AuctionItem, error)
-	FindByIDFunc         func(ctx context.Context, id int) (*model.AuctionItem, error)
-	FindByIDWithLockFunc func(ctx context.Context, id int) (*model.AuctionItem, error)
-	UpdateFunc           func(ctx context.Context, item *model.AuctionItem) (*model.AuctionItem, error)
-	DeleteFunc           func(ctx context.Context, id int) error
-	UpdateSortOrderFunc  func(ctx context.Context, id int, sortOrder int) error
-	ReorderFunc          func(ctx context.Context, auctionID int, ids []int) error
+	CreateFunc                     func(ctx context.Context, item *model.AuctionItem) (*model.AuctionItem, error)
+	ListFunc                       func(ctx context.Context) ([]model.AuctionItem, error)
+	ListByAuctionFunc              func(ctx context.Context, auctionID int) ([]model.AuctionItem, error)
+	ListConsignedByFishermanIDFunc func(ctx context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error)
+	FindByIDFunc                   func(ctx context.Context, id int) (*model.AuctionItem, error)
+	FindByIDWithLockFunc           func(ctx context.Context, id int) (*model.AuctionItem, error)
+	UpdateFunc                     func(ctx context.Context, item *model.AuctionItem) (*model.AuctionItem, error)
+	DeleteFunc                     func(ctx context.Context, id int) error
+	UpdateSortOrderFunc            func(ctx context.Context, id int, sortOrder int) error
+	ReorderFunc                    func(ctx context.Context, auctionID int, ids []int) error
 }
 
 // Create creates a new record.
@@ -34,6 +35,11 @@ func (m *MockItemRepository) ListByAuction(ctx context.Context, auctionID int) (
 	return m.ListByAuctionFunc(ctx, auctionID)
 }
 
+// ListConsignedByFishermanID retrieves a list of records.
+func (m *MockItemRepository) ListConsignedByFishermanID(ctx context.Context, fishermanID int, status *model.AuctionStatus) ([]model.ConsignedLot, error) {
+	return m.ListConsignedByFishermanIDFunc(ctx, fishermanID, status)
+}
+
 // FindByID retrieves a record based on criteria.
 func (m *MockItemRepository) FindByID(ctx context.Context, id int) (*model.AuctionItem, error) {
 	return m.FindByIDFunc(ctx, id)
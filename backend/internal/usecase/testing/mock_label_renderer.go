@@ -0,0 +1,16 @@
+package testing
+
+import "github.com/seka/fish-auction/backend/internal/domain/model"
+
+// MockLabelRenderer is a mock implementation of LabelRenderer
+type MockLabelRenderer struct {
+	RenderFunc func(labels []model.Label) ([]byte, error)
+}
+
+// Render renders the labels.
+func (m *MockLabelRenderer) Render(labels []model.Label) ([]byte, error) {
+	if m.RenderFunc != nil {
+		return m.RenderFunc(labels)
+	}
+	return []byte("%PDF-1.4"), nil
+}
@@ -10,20 +10,28 @@ import (
 
 // MockOutboxRepository is a mock implementation of OutboxRepository for testing.
 type MockOutboxRepository struct {
-	InsertEmailJobFunc        func(ctx context.Context, to string, resetURL string, emailType string) error
-	InsertPushJobFunc         func(ctx context.Context, jobType model.JobType, buyerID int, title, body, url string) error
-	ClaimFunc                 func(ctx context.Context, batchSize int, instanceID string) ([]*model.OutboxMessage, error)
-	MarkProcessedFunc         func(ctx context.Context, ids []int64, claimedBy string) error
-	MarkFailedFunc            func(ctx context.Context, id int64, lastError string, claimedBy string) error
-	RecoverStaleFunc          func(ctx context.Context, timeout time.Duration) (int64, error)
-	DeleteProcessedBeforeFunc func(ctx context.Context, before time.Time) (int64, error)
+	InsertEmailJobFunc          func(ctx context.Context, to string, url string, emailType string) error
+	InsertRejectionEmailJobFunc func(ctx context.Context, to string, reason string, emailType string) error
+	InsertPushJobFunc           func(ctx context.Context, jobType model.JobType, buyerID int, title, body, url string) error
+	ClaimFunc                   func(ctx context.Context, batchSize int, instanceID string) ([]*model.OutboxMessage, error)
+	MarkProcessedFunc           func(ctx context.Context, ids []int64, claimedBy string) error
+	MarkFailedFunc              func(ctx context.Context, id int64, lastError string, claimedBy string) error
+	RecoverStaleFunc            func(ctx context.Context, timeout time.Duration) (int64, error)
+	DeleteProcessedBeforeFunc   func(ctx context.Context, before time.Time) (int64, error)
 }
 
 var _ repository.OutboxRepository = (*MockOutboxRepository)(nil)
 
-func (m *MockOutboxRepository) InsertEmailJob(ctx context.Context, to, resetURL, emailType string) error {
+func (m *MockOutboxRepository) InsertEmailJob(ctx context.Context, to, url, emailType string) error {
 	if m.InsertEmailJobFunc != nil {
-		return m.InsertEmailJobFunc(ctx, to, resetURL, emailType)
+		return m.InsertEmailJobFunc(ctx, to, url, emailType)
+	}
+	return nil
+}
+
+func (m *MockOutboxRepository) InsertRejectionEmailJob(ctx context.Context, to, reason, emailType string) error {
+	if m.InsertRejectionEmailJobFunc != nil {
+		return m.InsertRejectionEmailJobFunc(ctx, to, reason, emailType)
 	}
 	return nil
 }
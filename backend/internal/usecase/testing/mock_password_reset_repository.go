@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockPasswordResetRepository is a mock implementation of repository.PasswordResetRepository
+type MockPasswordResetRepository struct {
+	CreateFunc            func(ctx context.Context, userID int, role, tokenHash string, expiresAt time.Time) error
+	FindByTokenHashFunc   func(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error)
+	DeleteByTokenHashFunc func(ctx context.Context, tokenHash string) error
+	DeleteAllByUserIDFunc func(ctx context.Context, userID int, role string) error
+}
+
+var _ repository.PasswordResetRepository = (*MockPasswordResetRepository)(nil)
+
+// Create creates a new record.
+func (m *MockPasswordResetRepository) Create(ctx context.Context, userID int, role, tokenHash string, expiresAt time.Time) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, userID, role, tokenHash, expiresAt)
+	}
+	return nil
+}
+
+// FindByTokenHash retrieves a record based on criteria.
+func (m *MockPasswordResetRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*model.PasswordResetToken, error) {
+	if m.FindByTokenHashFunc != nil {
+		return m.FindByTokenHashFunc(ctx, tokenHash)
+	}
+	return nil, nil
+}
+
+// DeleteByTokenHash deletes a record.
+func (m *MockPasswordResetRepository) DeleteByTokenHash(ctx context.Context, tokenHash string) error {
+	if m.DeleteByTokenHashFunc != nil {
+		return m.DeleteByTokenHashFunc(ctx, tokenHash)
+	}
+	return nil
+}
+
+// DeleteAllByUserID deletes records.
+func (m *MockPasswordResetRepository) DeleteAllByUserID(ctx context.Context, userID int, role string) error {
+	if m.DeleteAllByUserIDFunc != nil {
+		return m.DeleteAllByUserIDFunc(ctx, userID, role)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package testing
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockPaymentRepository is a mock implementation of repository.PaymentRepository
+type MockPaymentRepository struct {
+	CreateFunc                     func(ctx context.Context, payment *model.Payment) (*model.Payment, error)
+	CreateAllocationFunc           func(ctx context.Context, allocation *model.PaymentAllocation) error
+	ListAllocationsByInvoiceIDFunc func(ctx context.Context, invoiceID int) ([]model.PaymentAllocation, error)
+	ListByBuyerIDFunc              func(ctx context.Context, buyerID int) ([]model.Payment, error)
+	ListByBuyerIDWithLockFunc      func(ctx context.Context, buyerID int) ([]model.Payment, error)
+	ListReceiptsByVenueBetweenFunc func(ctx context.Context, venueID int, start, end time.Time) ([]model.PaymentReceipt, error)
+}
+
+var _ repository.PaymentRepository = (*MockPaymentRepository)(nil)
+
+// Create creates a new record.
+func (m *MockPaymentRepository) Create(ctx context.Context, payment *model.Payment) (*model.Payment, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, payment)
+	}
+	return payment, nil
+}
+
+// CreateAllocation creates a new record.
+func (m *MockPaymentRepository) CreateAllocation(ctx context.Context, allocation *model.PaymentAllocation) error {
+	if m.CreateAllocationFunc != nil {
+		return m.CreateAllocationFunc(ctx, allocation)
+	}
+	return nil
+}
+
+// ListAllocationsByInvoiceID retrieves a list of records.
+func (m *MockPaymentRepository) ListAllocationsByInvoiceID(ctx context.Context, invoiceID int) ([]model.PaymentAllocation, error) {
+	if m.ListAllocationsByInvoiceIDFunc != nil {
+		return m.ListAllocationsByInvoiceIDFunc(ctx, invoiceID)
+	}
+	return nil, nil
+}
+
+// ListByBuyerID retrieves a list of records.
+func (m *MockPaymentRepository) ListByBuyerID(ctx context.Context, buyerID int) ([]model.Payment, error) {
+	if m.ListByBuyerIDFunc != nil {
+		return m.ListByBuyerIDFunc(ctx, buyerID)
+	}
+	return nil, nil
+}
+
+// ListByBuyerIDWithLock retrieves a list of records.
+func (m *MockPaymentRepository) ListByBuyerIDWithLock(ctx context.Context, buyerID int) ([]model.Payment, error) {
+	if m.ListByBuyerIDWithLockFunc != nil {
+		return m.ListByBuyerIDWithLockFunc(ctx, buyerID)
+	}
+	return nil, nil
+}
+
+// ListReceiptsByVenueBetween retrieves a list of records.
+func (m *MockPaymentRepository) ListReceiptsByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.PaymentReceipt, error) {
+	if m.ListReceiptsByVenueBetweenFunc != nil {
+		return m.ListReceiptsByVenueBetweenFunc(ctx, venueID, start, end)
+	}
+	return nil, nil
+}
@@ -0,0 +1,59 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockResultCorrectionRepository is a mock implementation of repository.ResultCorrectionRepository
+type MockResultCorrectionRepository struct {
+	CreateFunc           func(ctx context.Context, correction *model.ResultCorrection) (*model.ResultCorrection, error)
+	FindByIDFunc         func(ctx context.Context, id int) (*model.ResultCorrection, error)
+	FindByIDWithLockFunc func(ctx context.Context, id int) (*model.ResultCorrection, error)
+	ListFunc             func(ctx context.Context, filters *repository.ResultCorrectionFilters) ([]model.ResultCorrection, error)
+	UpdateFunc           func(ctx context.Context, correction *model.ResultCorrection) error
+}
+
+var _ repository.ResultCorrectionRepository = (*MockResultCorrectionRepository)(nil)
+
+// Create creates a new record.
+func (m *MockResultCorrectionRepository) Create(ctx context.Context, correction *model.ResultCorrection) (*model.ResultCorrection, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, correction)
+	}
+	return correction, nil
+}
+
+// FindByID retrieves a record based on criteria.
+func (m *MockResultCorrectionRepository) FindByID(ctx context.Context, id int) (*model.ResultCorrection, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// FindByIDWithLock retrieves a record based on criteria with a lock.
+func (m *MockResultCorrectionRepository) FindByIDWithLock(ctx context.Context, id int) (*model.ResultCorrection, error) {
+	if m.FindByIDWithLockFunc != nil {
+		return m.FindByIDWithLockFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// List retrieves a list of records.
+func (m *MockResultCorrectionRepository) List(ctx context.Context, filters *repository.ResultCorrectionFilters) ([]model.ResultCorrection, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, filters)
+	}
+	return nil, nil
+}
+
+// Update updates an existing record.
+func (m *MockResultCorrectionRepository) Update(ctx context.Context, correction *model.ResultCorrection) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, correction)
+	}
+	return nil
+}
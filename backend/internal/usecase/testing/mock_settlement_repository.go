@@ -0,0 +1,114 @@
+package testing
+
+import (
+	"context"
+	"time"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockSettlementRepository is a mock implementation of repository.SettlementRepository
+type MockSettlementRepository struct {
+	CreateFunc                    func(ctx context.Context, settlement *model.Settlement) (*model.Settlement, error)
+	FindByIDFunc                  func(ctx context.Context, id int) (*model.Settlement, error)
+	FindByIDWithLockFunc          func(ctx context.Context, id int) (*model.Settlement, error)
+	ListByAuctionIDFunc           func(ctx context.Context, auctionID int) ([]model.Settlement, error)
+	ListByIDsFunc                 func(ctx context.Context, ids []int) ([]model.Settlement, error)
+	ListByIDsWithLockFunc         func(ctx context.Context, ids []int) ([]model.Settlement, error)
+	MarkExportedFunc              func(ctx context.Context, ids []int, exportedAt time.Time) error
+	UpdateFunc                    func(ctx context.Context, settlement *model.Settlement) error
+	DeleteDraftsByAuctionIDFunc   func(ctx context.Context, auctionID int) error
+	ListSettledByVenueBetweenFunc func(ctx context.Context, venueID int, start, end time.Time) ([]model.Settlement, error)
+	ListSettledByFishermanIDFunc  func(ctx context.Context, fishermanID int) ([]model.Settlement, error)
+}
+
+var _ repository.SettlementRepository = (*MockSettlementRepository)(nil)
+
+// Create creates a new record.
+func (m *MockSettlementRepository) Create(ctx context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, settlement)
+	}
+	return settlement, nil
+}
+
+// FindByID retrieves a record based on criteria.
+func (m *MockSettlementRepository) FindByID(ctx context.Context, id int) (*model.Settlement, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// FindByIDWithLock retrieves a record based on criteria.
+func (m *MockSettlementRepository) FindByIDWithLock(ctx context.Context, id int) (*model.Settlement, error) {
+	if m.FindByIDWithLockFunc != nil {
+		return m.FindByIDWithLockFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// ListByAuctionID retrieves a list of records.
+func (m *MockSettlementRepository) ListByAuctionID(ctx context.Context, auctionID int) ([]model.Settlement, error) {
+	if m.ListByAuctionIDFunc != nil {
+		return m.ListByAuctionIDFunc(ctx, auctionID)
+	}
+	return nil, nil
+}
+
+// ListByIDs retrieves a list of records.
+func (m *MockSettlementRepository) ListByIDs(ctx context.Context, ids []int) ([]model.Settlement, error) {
+	if m.ListByIDsFunc != nil {
+		return m.ListByIDsFunc(ctx, ids)
+	}
+	return nil, nil
+}
+
+// ListByIDsWithLock retrieves a list of records with a lock.
+func (m *MockSettlementRepository) ListByIDsWithLock(ctx context.Context, ids []int) ([]model.Settlement, error) {
+	if m.ListByIDsWithLockFunc != nil {
+		return m.ListByIDsWithLockFunc(ctx, ids)
+	}
+	return m.ListByIDs(ctx, ids)
+}
+
+// MarkExported updates records.
+func (m *MockSettlementRepository) MarkExported(ctx context.Context, ids []int, exportedAt time.Time) error {
+	if m.MarkExportedFunc != nil {
+		return m.MarkExportedFunc(ctx, ids, exportedAt)
+	}
+	return nil
+}
+
+// Update updates an existing record.
+func (m *MockSettlementRepository) Update(ctx context.Context, settlement *model.Settlement) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, settlement)
+	}
+	return nil
+}
+
+// DeleteDraftsByAuctionID removes draft records.
+func (m *MockSettlementRepository) DeleteDraftsByAuctionID(ctx context.Context, auctionID int) error {
+	if m.DeleteDraftsByAuctionIDFunc != nil {
+		return m.DeleteDraftsByAuctionIDFunc(ctx, auctionID)
+	}
+	return nil
+}
+
+// ListSettledByVenueBetween retrieves a list of records.
+func (m *MockSettlementRepository) ListSettledByVenueBetween(ctx context.Context, venueID int, start, end time.Time) ([]model.Settlement, error) {
+	if m.ListSettledByVenueBetweenFunc != nil {
+		return m.ListSettledByVenueBetweenFunc(ctx, venueID, start, end)
+	}
+	return nil, nil
+}
+
+// ListSettledByFishermanID retrieves a list of records.
+func (m *MockSettlementRepository) ListSettledByFishermanID(ctx context.Context, fishermanID int) ([]model.Settlement, error) {
+	if m.ListSettledByFishermanIDFunc != nil {
+		return m.ListSettledByFishermanIDFunc(ctx, fishermanID)
+	}
+	return nil, nil
+}
@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockVenueRegistrationRepository is a mock implementation of repository.VenueRegistrationRepository
+type MockVenueRegistrationRepository struct {
+	CreateFunc              func(ctx context.Context, reg *model.VenueRegistration) (*model.VenueRegistration, error)
+	FindByIDFunc            func(ctx context.Context, id int) (*model.VenueRegistration, error)
+	FindByBuyerAndVenueFunc func(ctx context.Context, buyerID, venueID int) (*model.VenueRegistration, error)
+	ListFunc                func(ctx context.Context, filters *repository.VenueRegistrationFilters) ([]model.VenueRegistration, error)
+	UpdateFunc              func(ctx context.Context, reg *model.VenueRegistration) error
+}
+
+var _ repository.VenueRegistrationRepository = (*MockVenueRegistrationRepository)(nil)
+
+// Create creates a new record.
+func (m *MockVenueRegistrationRepository) Create(ctx context.Context, reg *model.VenueRegistration) (*model.VenueRegistration, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, reg)
+	}
+	return reg, nil
+}
+
+// FindByID retrieves a record based on criteria.
+func (m *MockVenueRegistrationRepository) FindByID(ctx context.Context, id int) (*model.VenueRegistration, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// FindByBuyerAndVenue retrieves a record based on criteria.
+// Without a stub the buyer is treated as approved at every venue.
+func (m *MockVenueRegistrationRepository) FindByBuyerAndVenue(ctx context.Context, buyerID, venueID int) (*model.VenueRegistration, error) {
+	if m.FindByBuyerAndVenueFunc != nil {
+		return m.FindByBuyerAndVenueFunc(ctx, buyerID, venueID)
+	}
+	return &model.VenueRegistration{BuyerID: buyerID, VenueID: venueID, Status: model.VenueRegistrationStatusApproved}, nil
+}
+
+// List retrieves a list of records.
+func (m *MockVenueRegistrationRepository) List(ctx context.Context, filters *repository.VenueRegistrationFilters) ([]model.VenueRegistration, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, filters)
+	}
+	return []model.VenueRegistration{}, nil
+}
+
+// Update updates an existing record.
+func (m *MockVenueRegistrationRepository) Update(ctx context.Context, reg *model.VenueRegistration) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, reg)
+	}
+	return nil
+}
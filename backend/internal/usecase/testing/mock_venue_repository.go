@@ -0,0 +1,59 @@
+package testing
+
+import (
+	"context"
+
+	"github.com/seka/fish-auction/backend/internal/domain/model"
+	"github.com/seka/fish-auction/backend/internal/domain/repository"
+)
+
+// MockVenueRepository is a mock implementation of repository.VenueRepository
+type MockVenueRepository struct {
+	CreateFunc   func(ctx context.Context, venue *model.Venue) (*model.Venue, error)
+	FindByIDFunc func(ctx context.Context, id int) (*model.Venue, error)
+	ListFunc     func(ctx context.Context) ([]model.Venue, error)
+	UpdateFunc   func(ctx context.Context, venue *model.Venue) error
+	DeleteFunc   func(ctx context.Context, id int) error
+}
+
+var _ repository.VenueRepository = (*MockVenueRepository)(nil)
+
+// Create creates a new record.
+func (m *MockVenueRepository) Create(ctx context.Context, venue *model.Venue) (*model.Venue, error) {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, venue)
+	}
+	return venue, nil
+}
+
+// FindByID retrieves a record based on criteria.
+func (m *MockVenueRepository) FindByID(ctx context.Context, id int) (*model.Venue, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return &model.Venue{ID: id}, nil
+}
+
+// List retrieves a list of records.
+func (m *MockVenueRepository) List(ctx context.Context) ([]model.Venue, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+// Update updates an existing record.
+func (m *MockVenueRepository) Update(ctx context.Context, venue *model.Venue) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, venue)
+	}
+	return nil
+}
+
+// Delete deletes a record.
+func (m *MockVenueRepository) Delete(ctx context.Context, id int) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
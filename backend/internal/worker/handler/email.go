@@ -13,13 +13,19 @@ import (
 type emailHandler struct {
 	buyerEmailSvc service.BuyerEmailService
 	adminEmailSvc service.AdminEmailService
+	fishermanSvc  service.FishermanEmailService
 }
 
 // NewEmailHandler creates a new handler for email jobs.
-func NewEmailHandler(buyerEmailSvc service.BuyerEmailService, adminEmailSvc service.AdminEmailService) *emailHandler {
+func NewEmailHandler(
+	buyerEmailSvc service.BuyerEmailService,
+	adminEmailSvc service.AdminEmailService,
+	fishermanSvc service.FishermanEmailService,
+) *emailHandler {
 	return &emailHandler{
 		buyerEmailSvc: buyerEmailSvc,
 		adminEmailSvc: adminEmailSvc,
+		fishermanSvc:  fishermanSvc,
 	}
 }
 
@@ -31,9 +37,21 @@ func (h *emailHandler) Handle(ctx context.Context, msg *model.JobMessage) error
 
 	switch emailMsg.EmailType {
 	case emailMessage.EmailTypeBuyerPasswordReset:
-		return h.buyerEmailSvc.SendBuyerPasswordReset(ctx, emailMsg.To, emailMsg.ResetURL)
+		return h.buyerEmailSvc.SendBuyerPasswordReset(ctx, emailMsg.To, emailMsg.URL)
+	case emailMessage.EmailTypeBuyerEmailChangeVerification:
+		return h.buyerEmailSvc.SendBuyerEmailChangeVerification(ctx, emailMsg.To, emailMsg.URL)
+	case emailMessage.EmailTypeBuyerEmailChanged:
+		return h.buyerEmailSvc.SendBuyerEmailChanged(ctx, emailMsg.To)
+	case emailMessage.EmailTypeBuyerRegistrationVerification:
+		return h.buyerEmailSvc.SendBuyerRegistrationVerification(ctx, emailMsg.To, emailMsg.URL)
+	case emailMessage.EmailTypeBuyerRegistrationApproved:
+		return h.buyerEmailSvc.SendBuyerRegistrationApproved(ctx, emailMsg.To, emailMsg.URL)
+	case emailMessage.EmailTypeBuyerRegistrationRejected:
+		return h.buyerEmailSvc.SendBuyerRegistrationRejected(ctx, emailMsg.To, emailMsg.Reason)
 	case emailMessage.EmailTypeAdminPasswordReset:
-		return h.adminEmailSvc.SendAdminPasswordReset(ctx, emailMsg.To, emailMsg.ResetURL)
+		return h.adminEmailSvc.SendAdminPasswordReset(ctx, emailMsg.To, emailMsg.URL)
+	case emailMessage.EmailTypeFishermanPasswordReset:
+		return h.fishermanSvc.SendFishermanPasswordReset(ctx, emailMsg.To, emailMsg.URL)
 	default:
 		return fmt.Errorf("unsupported email type: %s", emailMsg.EmailType)
 	}
@@ -17,6 +17,26 @@ func (m *mockBuyerEmailSvc) SendBuyerPasswordReset(_ context.Context, _, _ strin
 	return m.err
 }
 
+func (m *mockBuyerEmailSvc) SendBuyerEmailChangeVerification(_ context.Context, _, _ string) error {
+	return m.err
+}
+
+func (m *mockBuyerEmailSvc) SendBuyerEmailChanged(_ context.Context, _ string) error {
+	return m.err
+}
+
+func (m *mockBuyerEmailSvc) SendBuyerRegistrationVerification(_ context.Context, _, _ string) error {
+	return m.err
+}
+
+func (m *mockBuyerEmailSvc) SendBuyerRegistrationApproved(_ context.Context, _, _ string) error {
+	return m.err
+}
+
+func (m *mockBuyerEmailSvc) SendBuyerRegistrationRejected(_ context.Context, _, _ string) error {
+	return m.err
+}
+
 type mockAdminEmailSvc struct {
 	err error
 }
@@ -25,16 +45,31 @@ func (m *mockAdminEmailSvc) SendAdminPasswordReset(_ context.Context, _, _ strin
 	return m.err
 }
 
+type mockFishermanEmailSvc struct {
+	err error
+}
+
+func (m *mockFishermanEmailSvc) SendFishermanPasswordReset(_ context.Context, _, _ string) error {
+	return m.err
+}
+
 func TestEmailHandler_Handle(t *testing.T) {
 	buyerPayload := `{"email_type":"buyer_password_reset","to":"buyer@example.com","reset_url":"https://example.com/reset"}`
 	adminPayload := `{"email_type":"admin_password_reset","to":"admin@example.com","reset_url":"https://example.com/admin/reset"}`
+	fishermanPayload := `{"email_type":"fisherman_password_reset","to":"fisher@example.com","reset_url":"https://example.com/fisherman/reset"}`
+	verifyPayload := `{"email_type":"buyer_email_change_verification","to":"new@example.com","reset_url":"https://example.com/email/verify"}`
+	changedPayload := `{"email_type":"buyer_email_changed","to":"old@example.com"}`
+	signupPayload := `{"email_type":"buyer_registration_verification","to":"new@example.com","reset_url":"https://example.com/signup/verify"}`
+	approvedPayload := `{"email_type":"buyer_registration_approved","to":"new@example.com","reset_url":"https://example.com/login"}`
+	rejectedPayload := `{"email_type":"buyer_registration_rejected","to":"new@example.com","reason":"買参権が確認できません"}`
 
 	tests := []struct {
-		name     string
-		payload  string
-		buyerErr error
-		adminErr error
-		wantErr  bool
+		name         string
+		payload      string
+		buyerErr     error
+		adminErr     error
+		fishermanErr error
+		wantErr      bool
 	}{
 		{
 			name:    "buyer password reset success",
@@ -44,6 +79,42 @@ func TestEmailHandler_Handle(t *testing.T) {
 			name:    "admin password reset success",
 			payload: adminPayload,
 		},
+		{
+			name:    "fisherman password reset success",
+			payload: fishermanPayload,
+		},
+		{
+			name:    "buyer email change verification success",
+			payload: verifyPayload,
+		},
+		{
+			name:    "buyer email changed success",
+			payload: changedPayload,
+		},
+		{
+			name:    "buyer registration verification success",
+			payload: signupPayload,
+		},
+		{
+			name:    "buyer registration approved success",
+			payload: approvedPayload,
+		},
+		{
+			name:    "buyer registration rejected success",
+			payload: rejectedPayload,
+		},
+		{
+			name:     "buyer registration rejected service error",
+			payload:  rejectedPayload,
+			buyerErr: errors.New("smtp error"),
+			wantErr:  true,
+		},
+		{
+			name:     "buyer email changed service error",
+			payload:  changedPayload,
+			buyerErr: errors.New("smtp error"),
+			wantErr:  true,
+		},
 		{
 			name:     "buyer email service error",
 			payload:  buyerPayload,
@@ -56,6 +127,12 @@ func TestEmailHandler_Handle(t *testing.T) {
 			adminErr: errors.New("smtp error"),
 			wantErr:  true,
 		},
+		{
+			name:         "fisherman email service error",
+			payload:      fishermanPayload,
+			fishermanErr: errors.New("smtp error"),
+			wantErr:      true,
+		},
 		{
 			name:    "invalid payload",
 			payload: `{invalid json`,
@@ -73,6 +150,7 @@ func TestEmailHandler_Handle(t *testing.T) {
 			h := handler.NewEmailHandler(
 				&mockBuyerEmailSvc{err: tt.buyerErr},
 				&mockAdminEmailSvc{err: tt.adminErr},
+				&mockFishermanEmailSvc{err: tt.fishermanErr},
 			)
 			err := h.Handle(context.Background(), &model.JobMessage{Payload: []byte(tt.payload)})
 			if (err != nil) != tt.wantErr {
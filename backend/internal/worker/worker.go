@@ -125,7 +125,7 @@ func (w *Worker) selectHandler(jobType model.JobType) (HandlerFunc, error) {
 	switch jobType {
 	case model.JobTypeEmail:
 		return w.emailHandler, nil
-	case model.JobTypePushOutbid, model.JobTypePushAuctionStatusChanged:
+	case model.JobTypePushOutbid, model.JobTypePushAuctionStatusChanged, model.JobTypePushLeadRestored:
 		return w.pushHandler, nil
 	default:
 		return nil, fmt.Errorf("unsupported job type: %s", jobType)
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS payment_allocations;
+DROP TABLE IF EXISTS payments;
+DROP TABLE IF EXISTS invoice_lines;
+DROP TABLE IF EXISTS invoices;
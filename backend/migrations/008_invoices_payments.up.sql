@@ -0,0 +1,62 @@
+-- 008_invoices_payments.up.sql
+-- せり単位・買受人単位の請求書と入金（消し込み）を管理するテーブルを追加する。
+-- 請求書は draft で生成され、issued で確定、入金で残高が 0 になった時点で paid に遷移する。
+
+CREATE TABLE IF NOT EXISTS invoices (
+    id SERIAL PRIMARY KEY,
+    buyer_id INTEGER NOT NULL REFERENCES buyers(id),
+    auction_id INTEGER NOT NULL REFERENCES auctions(id),
+    subtotal BIGINT NOT NULL DEFAULT 0,
+    tax_amount BIGINT NOT NULL DEFAULT 0,
+    total_amount BIGINT NOT NULL DEFAULT 0,
+    paid_amount BIGINT NOT NULL DEFAULT 0 CHECK (paid_amount >= 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'issued', 'paid')),
+    issued_at TIMESTAMPTZ,
+    paid_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (buyer_id, auction_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoices_auction_id ON invoices(auction_id);
+-- 未収一覧・年齢調べ (aging) 用に issued の請求書のみを対象とした部分インデックス
+CREATE INDEX IF NOT EXISTS idx_invoices_open ON invoices(buyer_id, issued_at) WHERE status = 'issued';
+
+CREATE TABLE IF NOT EXISTS invoice_lines (
+    id SERIAL PRIMARY KEY,
+    invoice_id INTEGER NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    item_id INTEGER REFERENCES auction_items(id),
+    description TEXT NOT NULL,
+    quantity INTEGER NOT NULL DEFAULT 1,
+    unit VARCHAR(50) NOT NULL DEFAULT '',
+    amount BIGINT NOT NULL,
+    tax_rate INTEGER NOT NULL CHECK (tax_rate >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoice_lines_invoice_id ON invoice_lines(invoice_id);
+
+CREATE TABLE IF NOT EXISTS payments (
+    id SERIAL PRIMARY KEY,
+    buyer_id INTEGER NOT NULL REFERENCES buyers(id),
+    invoice_id INTEGER REFERENCES invoices(id),
+    method VARCHAR(20) NOT NULL CHECK (method IN ('cash', 'bank_transfer')),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    received_at TIMESTAMPTZ NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    recorded_by INTEGER REFERENCES admins(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_payments_buyer_id ON payments(buyer_id, received_at);
+
+-- 入金をどの請求書に充当したかの内訳。充当されていない残額は買受人の前受金（クレジット）となる。
+CREATE TABLE IF NOT EXISTS payment_allocations (
+    id SERIAL PRIMARY KEY,
+    payment_id INTEGER NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    invoice_id INTEGER NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_allocations_payment_id ON payment_allocations(payment_id);
+CREATE INDEX IF NOT EXISTS idx_payment_allocations_invoice_id ON payment_allocations(invoice_id);
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS settlement_lines;
+DROP TABLE IF EXISTS settlements;
+
+ALTER TABLE fishermen
+    DROP COLUMN IF EXISTS account_holder_kana,
+    DROP COLUMN IF EXISTS account_number,
+    DROP COLUMN IF EXISTS account_type,
+    DROP COLUMN IF EXISTS branch_code,
+    DROP COLUMN IF EXISTS bank_code;
@@ -0,0 +1,42 @@
+-- 009_fisherman_settlements.up.sql
+-- 漁業者への支払い（仕切り）を管理するテーブルと、全銀協フォーマット振込用の口座情報を追加する。
+-- 仕切書は draft で生成され、settled で確定したものだけが振込ファイルの出力対象になる。
+
+-- 口座情報は未登録を許容する（振込ファイル出力時に検証する）
+-- account_type: 1=普通, 2=当座, 4=貯蓄（全銀協の預金種目コード）
+ALTER TABLE fishermen
+    ADD COLUMN IF NOT EXISTS bank_code           VARCHAR(4),
+    ADD COLUMN IF NOT EXISTS branch_code         VARCHAR(3),
+    ADD COLUMN IF NOT EXISTS account_type        SMALLINT CHECK (account_type IN (1, 2, 4)),
+    ADD COLUMN IF NOT EXISTS account_number      VARCHAR(7),
+    ADD COLUMN IF NOT EXISTS account_holder_kana VARCHAR(30);
+
+CREATE TABLE IF NOT EXISTS settlements (
+    id SERIAL PRIMARY KEY,
+    fisherman_id INTEGER NOT NULL REFERENCES fishermen(id),
+    auction_id INTEGER NOT NULL REFERENCES auctions(id),
+    gross_amount BIGINT NOT NULL DEFAULT 0,
+    commission_rate INTEGER NOT NULL CHECK (commission_rate BETWEEN 0 AND 100),
+    commission_amount BIGINT NOT NULL DEFAULT 0,
+    commission_tax BIGINT NOT NULL DEFAULT 0,
+    net_amount BIGINT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'settled')),
+    settled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (fisherman_id, auction_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_settlements_auction_id ON settlements(auction_id);
+
+CREATE TABLE IF NOT EXISTS settlement_lines (
+    id SERIAL PRIMARY KEY,
+    settlement_id INTEGER NOT NULL REFERENCES settlements(id) ON DELETE CASCADE,
+    item_id INTEGER REFERENCES auction_items(id),
+    description TEXT NOT NULL,
+    quantity INTEGER NOT NULL DEFAULT 1,
+    unit VARCHAR(50) NOT NULL DEFAULT '',
+    amount BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_settlement_lines_settlement_id ON settlement_lines(settlement_id);
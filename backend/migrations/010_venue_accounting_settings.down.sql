@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_settlements_settled_at;
+DROP INDEX IF EXISTS idx_payments_received_at;
+DROP INDEX IF EXISTS idx_invoices_issued_at;
+DROP TABLE IF EXISTS venue_accounting_settings;
@@ -0,0 +1,20 @@
+-- 010_venue_accounting_settings.up.sql
+-- 会計ソフト（弥生・freee）向け仕訳エクスポートで使用する勘定科目を会場ごとに設定するテーブルを追加する。
+-- 行が存在しない会場は既定の勘定科目で出力する。
+
+CREATE TABLE IF NOT EXISTS venue_accounting_settings (
+    venue_id INTEGER PRIMARY KEY REFERENCES venues(id),
+    sales_account VARCHAR(24) NOT NULL,
+    receivable_account VARCHAR(24) NOT NULL,
+    cash_account VARCHAR(24) NOT NULL,
+    deposit_account VARCHAR(24) NOT NULL,
+    purchase_account VARCHAR(24) NOT NULL,
+    payable_account VARCHAR(24) NOT NULL,
+    commission_account VARCHAR(24) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 期間指定の仕訳エクスポート用
+CREATE INDEX IF NOT EXISTS idx_invoices_issued_at ON invoices(issued_at) WHERE issued_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_payments_received_at ON payments(received_at);
+CREATE INDEX IF NOT EXISTS idx_settlements_settled_at ON settlements(settled_at) WHERE settled_at IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS settlement_adjustments;
+DROP TABLE IF EXISTS credit_notes;
+DROP TABLE IF EXISTS claim_events;
+DROP TABLE IF EXISTS claims;
+
+ALTER TABLE invoices DROP COLUMN IF EXISTS credited_amount;
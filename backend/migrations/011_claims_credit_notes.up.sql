@@ -0,0 +1,74 @@
+-- 011_claims_credit_notes.up.sql
+-- 納品後の品質クレーム（返品・値引き）と、承認時に発行する赤伝（クレジットノート）を管理するテーブルを追加する。
+-- クレジットノートは請求書の残高を減額し、必要に応じて漁業者の仕切から控除（クローバック）する。
+
+-- 請求書に対する減額の累計。未払残高 = total_amount - credited_amount - paid_amount
+ALTER TABLE invoices
+    ADD COLUMN IF NOT EXISTS credited_amount BIGINT NOT NULL DEFAULT 0 CHECK (credited_amount >= 0);
+
+CREATE TABLE IF NOT EXISTS claims (
+    id SERIAL PRIMARY KEY,
+    buyer_id INTEGER NOT NULL REFERENCES buyers(id),
+    purchase_id INTEGER NOT NULL REFERENCES transactions(id),
+    claim_type VARCHAR(20) NOT NULL CHECK (claim_type IN ('return', 'discount')),
+    reason TEXT NOT NULL CHECK (TRIM(reason) <> ''),
+    requested_amount BIGINT NOT NULL CHECK (requested_amount > 0),
+    approved_amount BIGINT CHECK (approved_amount > 0),
+    clawback BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    resolution_note TEXT NOT NULL DEFAULT '',
+    resolved_by INTEGER REFERENCES admins(id),
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_claims_buyer_id ON claims(buyer_id);
+CREATE INDEX IF NOT EXISTS idx_claims_status ON claims(status, created_at);
+-- 1 つの落札に対して審査中のクレームは 1 件まで
+CREATE UNIQUE INDEX IF NOT EXISTS idx_claims_pending_purchase ON claims(purchase_id) WHERE status = 'pending';
+
+-- 監査証跡。クレームの状態変更は追記のみで、更新・削除はしない。
+CREATE TABLE IF NOT EXISTS claim_events (
+    id SERIAL PRIMARY KEY,
+    claim_id INTEGER NOT NULL REFERENCES claims(id),
+    action VARCHAR(20) NOT NULL CHECK (action IN ('filed', 'approved', 'rejected')),
+    actor_type VARCHAR(20) NOT NULL CHECK (actor_type IN ('buyer', 'admin')),
+    actor_id INTEGER NOT NULL,
+    amount BIGINT,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_claim_events_claim_id ON claim_events(claim_id);
+
+CREATE TABLE IF NOT EXISTS credit_notes (
+    id SERIAL PRIMARY KEY,
+    claim_id INTEGER NOT NULL UNIQUE REFERENCES claims(id),
+    invoice_id INTEGER NOT NULL REFERENCES invoices(id),
+    buyer_id INTEGER NOT NULL REFERENCES buyers(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    tax_rate INTEGER NOT NULL CHECK (tax_rate >= 0),
+    tax_amount BIGINT NOT NULL DEFAULT 0,
+    total_amount BIGINT NOT NULL,
+    issued_by INTEGER REFERENCES admins(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_notes_invoice_id ON credit_notes(invoice_id);
+CREATE INDEX IF NOT EXISTS idx_credit_notes_buyer_id ON credit_notes(buyer_id);
+CREATE INDEX IF NOT EXISTS idx_credit_notes_created_at ON credit_notes(created_at);
+
+-- 漁業者の仕切から控除する金額。次に生成される仕切書に控除行として取り込まれる。
+-- 取り込み先の下書き仕切書が再生成で削除された場合は未適用に戻る。
+CREATE TABLE IF NOT EXISTS settlement_adjustments (
+    id SERIAL PRIMARY KEY,
+    fisherman_id INTEGER NOT NULL REFERENCES fishermen(id),
+    credit_note_id INTEGER NOT NULL UNIQUE REFERENCES credit_notes(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    description TEXT NOT NULL DEFAULT '',
+    settlement_id INTEGER REFERENCES settlements(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_settlement_adjustments_pending ON settlement_adjustments(fisherman_id) WHERE settlement_id IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE invoice_lines DROP COLUMN IF EXISTS charge_id;
+DROP TABLE IF EXISTS buyer_charges;
+DROP TABLE IF EXISTS charge_items;
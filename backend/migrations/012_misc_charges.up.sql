@@ -0,0 +1,38 @@
+-- 012_misc_charges.up.sql
+-- 氷・発泡スチロール箱・配送などの諸掛（鮮魚以外の請求項目）を管理するテーブルを追加する。
+-- 会場ごとに品目マスタを持ち、せり（開催日）単位で買受人に数量を計上する。計上した諸掛は請求書の明細になる。
+
+CREATE TABLE IF NOT EXISTS charge_items (
+    id SERIAL PRIMARY KEY,
+    venue_id INTEGER NOT NULL REFERENCES venues(id),
+    name VARCHAR(100) NOT NULL CHECK (TRIM(name) <> ''),
+    unit VARCHAR(50) NOT NULL DEFAULT '',
+    unit_price BIGINT NOT NULL CHECK (unit_price >= 0),
+    tax_rate INTEGER NOT NULL CHECK (tax_rate IN (0, 8, 10)),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (venue_id, name)
+);
+
+-- 品名・単価・税率は計上時点の値を保持し、マスタ変更の影響を受けないようにする。
+CREATE TABLE IF NOT EXISTS buyer_charges (
+    id SERIAL PRIMARY KEY,
+    auction_id INTEGER NOT NULL REFERENCES auctions(id),
+    buyer_id INTEGER NOT NULL REFERENCES buyers(id),
+    charge_item_id INTEGER NOT NULL REFERENCES charge_items(id),
+    description VARCHAR(100) NOT NULL,
+    unit VARCHAR(50) NOT NULL DEFAULT '',
+    unit_price BIGINT NOT NULL CHECK (unit_price >= 0),
+    tax_rate INTEGER NOT NULL CHECK (tax_rate >= 0),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    note TEXT NOT NULL DEFAULT '',
+    recorded_by INTEGER REFERENCES admins(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_buyer_charges_auction_buyer ON buyer_charges(auction_id, buyer_id);
+
+-- 諸掛の明細は落札品を持たないため、どの計上から作られたかを記録する
+ALTER TABLE invoice_lines
+    ADD COLUMN IF NOT EXISTS charge_id INTEGER REFERENCES buyer_charges(id) ON DELETE SET NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_entered_by_channel_check;
+ALTER TABLE transactions DROP COLUMN IF EXISTS entered_by;
+ALTER TABLE transactions DROP COLUMN IF EXISTS channel;
@@ -0,0 +1,13 @@
+-- 013_bid_channels.up.sql
+-- 入札の経路（オンライン／場内の手やり）と、場内入札を代理入力した事務員を記録する。
+-- 既存の入札はすべて買受人本人のオンライン入札として扱う。
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS channel VARCHAR(20) NOT NULL DEFAULT 'online'
+        CHECK (channel IN ('online', 'floor')),
+    ADD COLUMN IF NOT EXISTS entered_by INTEGER REFERENCES admins(id);
+
+-- 場内入札は必ず入力した事務員を持ち、オンライン入札は持たない。
+ALTER TABLE transactions
+    ADD CONSTRAINT transactions_entered_by_channel_check
+        CHECK ((channel = 'floor') = (entered_by IS NOT NULL));
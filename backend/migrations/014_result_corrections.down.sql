@@ -0,0 +1,8 @@
+-- 訂正起因の赤伝はクレームを持たないため、NOT NULL に戻す前に削除する。
+DELETE FROM settlement_adjustments WHERE credit_note_id IN (SELECT id FROM credit_notes WHERE correction_id IS NOT NULL);
+DELETE FROM credit_notes WHERE correction_id IS NOT NULL;
+ALTER TABLE credit_notes DROP CONSTRAINT IF EXISTS credit_notes_source_check;
+ALTER TABLE credit_notes DROP COLUMN IF EXISTS correction_id;
+ALTER TABLE credit_notes ALTER COLUMN claim_id SET NOT NULL;
+
+DROP TABLE IF EXISTS result_corrections;
@@ -0,0 +1,35 @@
+-- 014_result_corrections.up.sql
+-- 確定した落札結果（落札者・落札額）の訂正申請を管理するテーブルを追加する。
+-- 訂正は申請者とは別の管理者が承認して初めて反映され、発行済みの請求書には赤伝で減額する。
+
+CREATE TABLE IF NOT EXISTS result_corrections (
+    id SERIAL PRIMARY KEY,
+    purchase_id INTEGER NOT NULL REFERENCES transactions(id),
+    old_buyer_id INTEGER NOT NULL REFERENCES buyers(id),
+    old_price BIGINT NOT NULL CHECK (old_price > 0),
+    new_buyer_id INTEGER NOT NULL REFERENCES buyers(id),
+    new_price BIGINT NOT NULL CHECK (new_price > 0),
+    reason TEXT NOT NULL CHECK (TRIM(reason) <> ''),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    requested_by INTEGER NOT NULL REFERENCES admins(id),
+    reviewed_by INTEGER REFERENCES admins(id),
+    review_note TEXT NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (old_buyer_id <> new_buyer_id OR old_price <> new_price),
+    -- 二者承認: 承認者は申請者と別の管理者でなければならない
+    CHECK (status <> 'approved' OR reviewed_by <> requested_by)
+);
+
+CREATE INDEX IF NOT EXISTS idx_result_corrections_status ON result_corrections(status, created_at);
+-- 1 つの落札に対して審査中の訂正は 1 件まで
+CREATE UNIQUE INDEX IF NOT EXISTS idx_result_corrections_pending_purchase ON result_corrections(purchase_id) WHERE status = 'pending';
+
+-- 赤伝はクレームまたは結果訂正のいずれか一方に対して発行する。
+ALTER TABLE credit_notes ALTER COLUMN claim_id DROP NOT NULL;
+ALTER TABLE credit_notes
+    ADD COLUMN IF NOT EXISTS correction_id INTEGER UNIQUE REFERENCES result_corrections(id);
+ALTER TABLE credit_notes
+    ADD CONSTRAINT credit_notes_source_check
+        CHECK ((claim_id IS NULL) <> (correction_id IS NULL));
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_buyers_paddle_number;
+ALTER TABLE buyers DROP COLUMN IF EXISTS paddle_number;
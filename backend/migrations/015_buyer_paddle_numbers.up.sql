@@ -0,0 +1,9 @@
+-- 015_buyer_paddle_numbers.up.sql
+-- 買受人ごとに管理者が割り当てる買参権番号（パドル番号）を持たせる。
+-- 公開画面や他の買受人にはこの番号だけを見せ、名前や ID は出さない。
+
+ALTER TABLE buyers ADD COLUMN IF NOT EXISTS paddle_number VARCHAR(10);
+
+-- 削除済みの買受人の番号は再割り当てできるよう、有効な買受人の間でのみ一意にする。
+CREATE UNIQUE INDEX IF NOT EXISTS idx_buyers_paddle_number
+    ON buyers(paddle_number) WHERE deleted_at IS NULL;
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_transactions_item_id ON transactions(item_id);
+DROP INDEX IF EXISTS idx_transactions_item_id_id;
@@ -0,0 +1,6 @@
+-- 016_bid_history_index.up.sql
+-- 品目ごとの入札履歴を新しい順にカーソルでたどるための索引。
+-- (item_id, id) の複合索引は item_id 単独の検索も賄うため、既存の単独索引は置き換える。
+
+CREATE INDEX IF NOT EXISTS idx_transactions_item_id_id ON transactions(item_id, id DESC);
+DROP INDEX IF EXISTS idx_transactions_item_id;
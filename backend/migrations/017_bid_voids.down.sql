@@ -0,0 +1,4 @@
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_void_check;
+ALTER TABLE transactions DROP COLUMN IF EXISTS void_reason;
+ALTER TABLE transactions DROP COLUMN IF EXISTS voided_by;
+ALTER TABLE transactions DROP COLUMN IF EXISTS voided_at;
@@ -0,0 +1,13 @@
+-- 017_bid_voids.up.sql
+-- 桁違いなどの誤入札を管理者が取り消せるようにする。
+-- 取り消した入札は削除せず監査のために残し、最高値・落札・請求などの集計からだけ除外する。
+
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS voided_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS voided_by INTEGER REFERENCES admins(id),
+    ADD COLUMN IF NOT EXISTS void_reason TEXT;
+
+-- 取り消しの日時・実施者・理由は揃って記録する。
+ALTER TABLE transactions
+    ADD CONSTRAINT transactions_void_check
+        CHECK ((voided_at IS NULL) = (voided_by IS NULL) AND (voided_at IS NULL) = (void_reason IS NULL));
@@ -0,0 +1,4 @@
+ALTER TABLE buyers DROP CONSTRAINT IF EXISTS buyers_deposit_check;
+ALTER TABLE buyers DROP CONSTRAINT IF EXISTS buyers_credit_limit_check;
+ALTER TABLE buyers DROP COLUMN IF EXISTS deposit;
+ALTER TABLE buyers DROP COLUMN IF EXISTS credit_limit;
@@ -0,0 +1,11 @@
+-- 018_buyer_credit_limits.up.sql
+-- 買受人ごとに与信枠（購買限度額）と預かり保証金を持たせる。
+-- 与信枠は保証金と支払実績をもとに管理者が決める。NULL は限度額なし（既存の買受人はこの扱い）。
+
+ALTER TABLE buyers
+    ADD COLUMN IF NOT EXISTS credit_limit INTEGER,
+    ADD COLUMN IF NOT EXISTS deposit INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE buyers
+    ADD CONSTRAINT buyers_credit_limit_check CHECK (credit_limit IS NULL OR credit_limit >= 0),
+    ADD CONSTRAINT buyers_deposit_check CHECK (deposit >= 0);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS buyer_venue_registrations;
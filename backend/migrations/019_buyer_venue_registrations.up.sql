@@ -0,0 +1,27 @@
+-- 019_buyer_venue_registrations.up.sql
+-- 買受人ごとに、どの市場（会場）で買参権を持つかを登録するテーブルを追加する。
+-- せりへの入札には、その会場で承認済みかつ有効期間内の登録が必要になる。
+
+CREATE TABLE IF NOT EXISTS buyer_venue_registrations (
+    id SERIAL PRIMARY KEY,
+    buyer_id INTEGER NOT NULL REFERENCES buyers(id),
+    venue_id INTEGER NOT NULL REFERENCES venues(id),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'suspended')),
+    -- 有効期間は日付単位（JST）で、両端を含む。NULL は期限なし。
+    valid_from DATE,
+    valid_until DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (buyer_id, venue_id),
+    CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_from <= valid_until)
+);
+
+CREATE INDEX IF NOT EXISTS idx_buyer_venue_registrations_venue_id ON buyer_venue_registrations(venue_id, status);
+
+-- 既存の買受人はこれまでどおりすべての会場で入札できるよう、承認済み・期限なしの登録を作っておく。
+INSERT INTO buyer_venue_registrations (buyer_id, venue_id, status)
+SELECT b.id, v.id, 'approved'
+FROM buyers b
+CROSS JOIN venues v
+WHERE b.deleted_at IS NULL AND v.deleted_at IS NULL
+ON CONFLICT (buyer_id, venue_id) DO NOTHING;
@@ -0,0 +1,22 @@
+-- 020_buyer_suspensions.up.sql
+-- 未払いや迷惑行為のある買受人を、削除せずに期間を区切って利用停止できるようにする。
+-- 停止は理由とともに記録し、解除後も監査のために残す。
+
+CREATE TABLE IF NOT EXISTS buyer_suspensions (
+    id SERIAL PRIMARY KEY,
+    buyer_id INTEGER NOT NULL REFERENCES buyers(id),
+    -- login: ログインと入札の両方を止める / bidding: 入札だけを止める
+    severity VARCHAR(20) NOT NULL CHECK (severity IN ('login', 'bidding')),
+    reason TEXT NOT NULL CHECK (TRIM(reason) <> ''),
+    starts_at TIMESTAMPTZ NOT NULL,
+    -- NULL は無期限（ブラックリスト）
+    ends_at TIMESTAMPTZ,
+    created_by INTEGER NOT NULL REFERENCES admins(id),
+    lifted_at TIMESTAMPTZ,
+    lifted_by INTEGER REFERENCES admins(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (ends_at IS NULL OR starts_at < ends_at),
+    CHECK ((lifted_at IS NULL) = (lifted_by IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_buyer_suspensions_buyer_id ON buyer_suspensions(buyer_id, starts_at);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_auction_items_fisherman_id;
+-- 漁業者向けのトークンが残っていると制約を戻せないため、先に削除する。
+DELETE FROM password_reset_tokens WHERE user_role = 'fisherman';
+ALTER TABLE password_reset_tokens DROP CONSTRAINT IF EXISTS password_reset_tokens_user_role_check;
+ALTER TABLE password_reset_tokens
+    ADD CONSTRAINT password_reset_tokens_user_role_check CHECK (user_role IN ('admin', 'buyer'));
+DROP TABLE IF EXISTS fisherman_authentications;
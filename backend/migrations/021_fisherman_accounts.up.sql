@@ -0,0 +1,23 @@
+-- 021_fisherman_accounts.up.sql
+-- 漁業者（出荷者）が自分の出荷品・せりの状況・仕切書を確認できるよう、ポータル用のログイン情報を追加する。
+-- 買受人の authentications と同じく、連続失敗によるロックアウトのためのカウンタを持つ。
+
+CREATE TABLE IF NOT EXISTS fisherman_authentications (
+    id SERIAL PRIMARY KEY,
+    fisherman_id INTEGER NOT NULL UNIQUE REFERENCES fishermen(id) ON DELETE CASCADE,
+    email VARCHAR(255) UNIQUE NOT NULL CHECK (TRIM(email) <> ''),
+    password_hash TEXT NOT NULL,
+    failed_attempts INT NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ,
+    last_login_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- パスワードリセットトークンを漁業者にも発行できるようにする。
+ALTER TABLE password_reset_tokens DROP CONSTRAINT IF EXISTS password_reset_tokens_user_role_check;
+ALTER TABLE password_reset_tokens
+    ADD CONSTRAINT password_reset_tokens_user_role_check CHECK (user_role IN ('admin', 'buyer', 'fisherman'));
+
+-- ポータルは漁業者単位で出荷品を引くため、fisherman_id に索引を張る。
+CREATE INDEX IF NOT EXISTS idx_auction_items_fisherman_id ON auction_items(fisherman_id);
@@ -0,0 +1,15 @@
+ALTER TABLE buyers
+    DROP COLUMN IF EXISTS license_expires_on,
+    DROP COLUMN IF EXISTS license_number,
+    DROP COLUMN IF EXISTS phone,
+    DROP COLUMN IF EXISTS address,
+    DROP COLUMN IF EXISTS invoice_registration_number,
+    DROP COLUMN IF EXISTS business_name;
+
+DROP INDEX IF EXISTS idx_fishermen_cooperative_member_number;
+ALTER TABLE fishermen
+    DROP COLUMN IF EXISTS address,
+    DROP COLUMN IF EXISTS phone,
+    DROP COLUMN IF EXISTS vessel_registration_number,
+    DROP COLUMN IF EXISTS vessel_name,
+    DROP COLUMN IF EXISTS cooperative_member_number;
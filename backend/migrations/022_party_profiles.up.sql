@@ -0,0 +1,22 @@
+-- 022_party_profiles.up.sql
+-- 漁業者・買受人の台帳情報を持たせ、削除・再作成せずに更新できるようにする。
+-- 未登録の項目は空文字列とし、書式の検証はアプリケーション側（entity）で行う。
+
+ALTER TABLE fishermen
+    ADD COLUMN IF NOT EXISTS cooperative_member_number VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS vessel_name VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS vessel_registration_number VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS phone VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS address VARCHAR(255) NOT NULL DEFAULT '';
+
+-- 組合員番号は有効な漁業者の間で一意にする。未登録（空文字列）は重複を許す。
+CREATE UNIQUE INDEX IF NOT EXISTS idx_fishermen_cooperative_member_number
+    ON fishermen(cooperative_member_number) WHERE deleted_at IS NULL AND cooperative_member_number <> '';
+
+ALTER TABLE buyers
+    ADD COLUMN IF NOT EXISTS business_name VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS invoice_registration_number VARCHAR(14) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS address VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS phone VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS license_number VARCHAR(30) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS license_expires_on DATE;
@@ -0,0 +1,14 @@
+-- 023_buyer_email_changes.up.sql
+-- 買受人が自分でメールアドレスを変更できるよう、確認リンク用のトークンを保存する。
+-- authentications.email は新しいアドレスでリンクが開かれるまで書き換えない。
+
+CREATE TABLE IF NOT EXISTS email_change_tokens (
+    id SERIAL PRIMARY KEY,
+    buyer_id INTEGER NOT NULL REFERENCES buyers(id) ON DELETE CASCADE,
+    new_email VARCHAR(255) NOT NULL CHECK (TRIM(new_email) <> ''),
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_change_tokens_buyer_id ON email_change_tokens(buyer_id);
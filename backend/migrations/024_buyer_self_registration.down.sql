@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS email_verification_tokens;
+
+DROP INDEX IF EXISTS idx_buyers_pending_registrations;
+ALTER TABLE buyers
+    DROP COLUMN IF EXISTS reviewed_at,
+    DROP COLUMN IF EXISTS reviewed_by,
+    DROP COLUMN IF EXISTS rejection_reason,
+    DROP COLUMN IF EXISTS email_verified_at,
+    DROP COLUMN IF EXISTS registration_status;
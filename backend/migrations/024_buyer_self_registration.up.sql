@@ -0,0 +1,25 @@
+-- 024_buyer_self_registration.up.sql
+-- 買受人が自分で利用申請できるようにする。申請者はメールアドレスの確認後に管理者の審査を受け、
+-- 承認されるまで入札できない。既存の買受人は管理者が登録したものなので承認済みとする。
+
+ALTER TABLE buyers
+    ADD COLUMN IF NOT EXISTS registration_status VARCHAR(20) NOT NULL DEFAULT 'approved'
+        CHECK (registration_status IN ('pending', 'approved', 'rejected')),
+    ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS rejection_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS reviewed_by INTEGER REFERENCES admins(id),
+    ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMPTZ;
+
+-- 審査待ちの一覧はメール確認済みの申請だけを確認日時の順に引く。
+CREATE INDEX IF NOT EXISTS idx_buyers_pending_registrations
+    ON buyers(email_verified_at) WHERE registration_status = 'pending' AND deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS email_verification_tokens (
+    id SERIAL PRIMARY KEY,
+    buyer_id INTEGER NOT NULL REFERENCES buyers(id) ON DELETE CASCADE,
+    token_hash VARCHAR(255) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_email_verification_tokens_buyer_id ON email_verification_tokens(buyer_id);
@@ -0,0 +1,15 @@
+UPDATE password_reset_tokens t
+SET user_id = a.buyer_id
+FROM authentications a
+WHERE t.user_role = 'buyer' AND a.id = t.user_id;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS login_id;
+
+DELETE FROM authentications WHERE role = 'staff';
+DROP INDEX IF EXISTS idx_authentications_buyer_owner;
+
+ALTER TABLE authentications
+    DROP COLUMN IF EXISTS disabled_at,
+    DROP COLUMN IF EXISTS bid_limit,
+    DROP COLUMN IF EXISTS role,
+    DROP COLUMN IF EXISTS name;
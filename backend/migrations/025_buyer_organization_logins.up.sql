@@ -0,0 +1,23 @@
+-- 025_buyer_organization_logins.up.sql
+-- 買受人（組織）ごとに複数のログインを持てるようにする。
+-- 既存の authentications は組織の代表者（owner）とし、追加のログインは担当者（staff）として扱う。
+
+ALTER TABLE authentications
+    ADD COLUMN IF NOT EXISTS name VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'owner' CHECK (role IN ('owner', 'staff')),
+    ADD COLUMN IF NOT EXISTS bid_limit BIGINT CHECK (bid_limit IS NULL OR bid_limit > 0),
+    ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMPTZ;
+
+-- 代表者は組織ごとに一人だけ。
+CREATE UNIQUE INDEX IF NOT EXISTS idx_authentications_buyer_owner ON authentications(buyer_id) WHERE role = 'owner';
+
+-- 入札がどのログインによるものかを記録する。導入前の入札と場内入札は NULL のまま。
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS login_id INTEGER REFERENCES authentications(id);
+
+-- 買受人のパスワード再設定トークンは買受人IDではなくログインIDを指すようにする。
+-- この時点では買受人ごとにログインが一つしかないため、一意に置き換えられる。
+UPDATE password_reset_tokens t
+SET user_id = a.id
+FROM authentications a
+WHERE t.user_role = 'buyer' AND a.buyer_id = t.user_id;
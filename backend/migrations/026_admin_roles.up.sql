@@ -0,0 +1,10 @@
+-- 026_admin_roles.up.sql
+-- 管理者に役割を持たせ、役割ごとに操作できる範囲を制限する。
+-- 既存の管理者はこれまで通りすべての操作ができるよう super_admin とする。
+
+ALTER TABLE admins
+    ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'super_admin'
+        CHECK (role IN ('super_admin', 'clerk', 'accountant', 'read_only'));
+
+-- 以降に追加される管理者は、役割を指定しなければ閲覧のみとする。
+ALTER TABLE admins ALTER COLUMN role SET DEFAULT 'read_only';
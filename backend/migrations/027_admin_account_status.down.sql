@@ -0,0 +1,3 @@
+ALTER TABLE admins
+    DROP COLUMN IF EXISTS password_reset_required,
+    DROP COLUMN IF EXISTS disabled_at;
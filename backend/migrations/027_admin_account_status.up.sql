@@ -0,0 +1,7 @@
+-- 027_admin_account_status.up.sql
+-- 退職した事務員などの管理者アカウントを削除せずに無効化できるようにする。
+-- password_reset_required は特権管理者がパスワードの再設定を強制したときに立て、再設定が済むまでログインさせない。
+
+ALTER TABLE admins
+    ADD COLUMN IF NOT EXISTS disabled_at             TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS password_reset_required BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS admin_recovery_codes;
+DROP TABLE IF EXISTS admin_two_factors;
@@ -0,0 +1,23 @@
+-- 028_admin_two_factor.up.sql
+-- 管理者の TOTP による二要素認証（任意）。
+-- シークレットはアプリ側で AES-GCM により暗号化して保存し、enabled_at が NULL の間は登録途中として扱う。
+-- last_used_step は最後に受け付けたコードの時間ステップで、同じコードの再利用を防ぐ。
+
+CREATE TABLE IF NOT EXISTS admin_two_factors (
+    admin_id         INTEGER PRIMARY KEY REFERENCES admins(id) ON DELETE CASCADE,
+    secret_encrypted TEXT NOT NULL,
+    enabled_at       TIMESTAMPTZ,
+    last_used_step   BIGINT NOT NULL DEFAULT 0,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 認証アプリを失くしたときのための使い捨てリカバリーコード。SHA-256 のハッシュだけを保存する。
+-- 二要素認証の登録を削除するとリカバリーコードも消える。
+CREATE TABLE IF NOT EXISTS admin_recovery_codes (
+    id         SERIAL PRIMARY KEY,
+    admin_id   INTEGER NOT NULL REFERENCES admin_two_factors(admin_id) ON DELETE CASCADE,
+    code_hash  VARCHAR(64) NOT NULL,
+    used_at    TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (admin_id, code_hash)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE settlements
+    DROP COLUMN IF EXISTS exported_at;
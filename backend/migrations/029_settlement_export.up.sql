@@ -0,0 +1,5 @@
+-- 029_settlement_export.up.sql
+-- 全銀ファイルに書き出した仕切書を記録し、同じ仕切書を二重に振り込まないようにする。
+
+ALTER TABLE settlements
+    ADD COLUMN IF NOT EXISTS exported_at TIMESTAMPTZ;
@@ -0,0 +1,5 @@
+DELETE FROM payment_allocations WHERE amount < 0;
+ALTER TABLE payment_allocations
+    DROP CONSTRAINT IF EXISTS payment_allocations_amount_check;
+ALTER TABLE payment_allocations
+    ADD CONSTRAINT payment_allocations_amount_check CHECK (amount > 0);
@@ -0,0 +1,7 @@
+-- 030_payment_allocation_release.up.sql
+-- 入金後の赤伝で生じた過入金を、負の充当で入金側の未充当残額（前受金）へ戻せるようにする。
+
+ALTER TABLE payment_allocations
+    DROP CONSTRAINT IF EXISTS payment_allocations_amount_check;
+ALTER TABLE payment_allocations
+    ADD CONSTRAINT payment_allocations_amount_check CHECK (amount <> 0);